@@ -0,0 +1,160 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/series/field"
+)
+
+// adjacentPoint represents the last data point of series.
+type adjacentPoint struct {
+	timestamp int64
+	value     float64
+}
+
+// AdjacentAggregator calculates the agg types which depend on two adjacent data points of one series(e.g. increase),
+// so that counter resets are handled for each series before the series are merged into grouping aggregator.
+// The data points of one series in a family may be stored in memory database and files, so the down sampling
+// values of series are collected from all result sets of family first, then flushed in time order.
+// NOTICE: families must be flushed in time order.
+type AdjacentAggregator struct {
+	aggTypes   []field.AggType
+	interval   int64
+	lastPoints map[uint32]adjacentPoint           // key: series index, value: last data point of series
+	pending    map[uint32]*collections.FloatArray // key: series index, value: collected values of current family
+	values     *collections.FloatArray            // down sampling values of current series
+}
+
+// NewAdjacentAggregator creates an adjacent aggregator for the adjacent agg types of aggregator spec,
+// returns nil if aggregator spec hasn't any adjacent agg type.
+func NewAdjacentAggregator(aggSpec AggregatorSpec, interval int64) *AdjacentAggregator {
+	var aggTypes []field.AggType
+	for f := range aggSpec.Functions() {
+		for _, aggType := range aggSpec.GetFieldType().GetFuncFieldParams(f) {
+			if aggType.IsAdjacent() && !containsAggType(aggTypes, aggType) {
+				aggTypes = append(aggTypes, aggType)
+			}
+		}
+	}
+	if len(aggTypes) == 0 {
+		return nil
+	}
+	return &AdjacentAggregator{
+		aggTypes:   aggTypes,
+		interval:   interval,
+		lastPoints: make(map[uint32]adjacentPoint),
+		pending:    make(map[uint32]*collections.FloatArray),
+	}
+}
+
+// Prepare resets the down sampling values for aggregating a new series.
+func (a *AdjacentAggregator) Prepare(capacity int) {
+	if a.values == nil || a.values.Capacity() < capacity {
+		a.values = collections.NewFloatArray(capacity)
+		return
+	}
+	a.values.Reset()
+}
+
+// AggregateBySlot keeps the last value of current series in each time slot.
+func (a *AdjacentAggregator) AggregateBySlot(pos int, value float64) {
+	// drop inf value
+	if math.IsInf(value, 1) {
+		return
+	}
+	a.values.SetValue(pos, value)
+}
+
+// Collect merges the down sampling values of current series into the collected values of current family.
+// Memory database is loaded before files and holds the newest data, so the value collected first is kept
+// if the time slot has been collected from other result set.
+func (a *AdjacentAggregator) Collect(seriesIdx uint32) {
+	if a.values == nil || a.values.IsEmpty() {
+		return
+	}
+	values, ok := a.pending[seriesIdx]
+	if !ok {
+		values = collections.NewFloatArray(a.values.Capacity())
+		a.pending[seriesIdx] = values
+	}
+	itr := a.values.NewIterator()
+	for itr.HasNext() {
+		pos, value := itr.Next()
+		if !values.HasValue(pos) {
+			values.SetValue(pos, value)
+		}
+	}
+}
+
+// IsEmpty returns if there isn't any collected values of current family.
+func (a *AdjacentAggregator) IsEmpty() bool {
+	return len(a.pending) == 0
+}
+
+// Flush calculates the adjacent agg types of the series collected in current family, then aggregates them into
+// field aggregator of each series, the last data point of series is kept for calculating with the data points
+// of next family.
+func (a *AdjacentAggregator) Flush(
+	getAggregator func(seriesIdx uint32) (FieldAggregator, bool),
+	timestamp func(pos int) int64,
+) {
+	seriesIdxs := make([]uint32, 0, len(a.pending))
+	for seriesIdx := range a.pending {
+		seriesIdxs = append(seriesIdxs, seriesIdx)
+	}
+	// flush in series order, keeps the float sum of grouping series stable
+	sort.Slice(seriesIdxs, func(i, j int) bool { return seriesIdxs[i] < seriesIdxs[j] })
+	for _, seriesIdx := range seriesIdxs {
+		if agg, ok := getAggregator(seriesIdx); ok {
+			a.flushSeries(seriesIdx, a.pending[seriesIdx], agg, timestamp)
+		}
+		delete(a.pending, seriesIdx)
+	}
+}
+
+// flushSeries calculates the adjacent agg types of one series, the data points which aren't after the last
+// data point of series are ignored.
+func (a *AdjacentAggregator) flushSeries(seriesIdx uint32, values *collections.FloatArray,
+	agg FieldAggregator, timestamp func(pos int) int64) {
+	last, ok := a.lastPoints[seriesIdx]
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		pos, value := itr.Next()
+		ts := timestamp(pos)
+		if ok && ts <= last.timestamp {
+			continue
+		}
+		if ok {
+			slots := int((ts - last.timestamp) / a.interval)
+			for _, aggType := range a.aggTypes {
+				agg.AggregatePartialBySlot(aggType, pos,
+					function.AdjacentCall(aggType.AdjacentFunc(), a.interval, last.value, value, slots))
+			}
+		}
+		last = adjacentPoint{timestamp: ts, value: value}
+		ok = true
+	}
+	if ok {
+		a.lastPoints[seriesIdx] = last
+	}
+}
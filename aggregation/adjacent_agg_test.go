@@ -0,0 +1,108 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/series/field"
+)
+
+func TestNewAdjacentAggregator(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.GaugeField)
+	aggSpec.AddFunctionType(function.Max)
+	assert.Nil(t, NewAdjacentAggregator(aggSpec, 10))
+	aggSpec.AddFunctionType(function.Increase)
+	assert.NotNil(t, NewAdjacentAggregator(aggSpec, 10))
+}
+
+func TestAdjacentAggregator_Flush(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.GaugeField)
+	aggSpec.AddFunctionType(function.Increase)
+	aggSpec.AddFunctionType(function.Max)
+	agg := NewFieldAggregator(aggSpec, 0, 0, 20)
+	adjacentAgg := NewAdjacentAggregator(aggSpec, 10)
+	assert.True(t, adjacentAgg.IsEmpty())
+
+	collect := func(seriesIdx uint32, values map[int]float64) {
+		adjacentAgg.Prepare(20)
+		for pos, value := range values {
+			agg.AggregateBySlot(pos, value)
+			adjacentAgg.AggregateBySlot(pos, value)
+		}
+		adjacentAgg.Collect(seriesIdx)
+	}
+	flush := func() {
+		adjacentAgg.Flush(func(seriesIdx uint32) (FieldAggregator, bool) {
+			return agg, seriesIdx != 2
+		}, func(pos int) int64 {
+			return int64(pos) * 10
+		})
+		assert.True(t, adjacentAgg.IsEmpty())
+	}
+	// family 1
+	collect(0, map[int]float64{1: 100, 2: 150, 3: math.Inf(1)})
+	collect(1, map[int]float64{2: 10})
+	collect(2, map[int]float64{2: 10}) // aggregator not found
+	collect(3, nil)                    // no data
+	flush()
+	// family 2, last point of series is carried from family 1
+	// memory database(newer data points) is collected before file
+	collect(0, map[int]float64{12: 30, 13: 40}) // counter reset
+	collect(0, map[int]float64{11: 160, 12: 1})
+	collect(1, map[int]float64{11: 20})
+	flush()
+
+	expect := map[field.AggType]map[int]float64{
+		field.Increase: {2: 50, 11: 10 + 10, 12: 30, 13: 10},
+		field.Max:      {1: 100, 2: 150, 11: 160, 12: 30, 13: 40},
+	}
+	_, rs := agg.ResultSet()
+	for rs.HasNext() {
+		pIt := rs.Next()
+		values := make(map[int]float64)
+		for pIt.HasNext() {
+			slot, value := pIt.Next()
+			values[slot] = value
+		}
+		assert.Equal(t, expect[pIt.AggType()], values)
+	}
+}
+
+func TestAdjacentAggregator_flushSeries(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.GaugeField)
+	aggSpec.AddFunctionType(function.Increase)
+	agg := NewFieldAggregator(aggSpec, 0, 0, 20)
+	adjacentAgg := NewAdjacentAggregator(aggSpec, 10)
+	adjacentAgg.lastPoints[0] = adjacentPoint{timestamp: 50, value: 10}
+	adjacentAgg.Prepare(20)
+	adjacentAgg.AggregateBySlot(3, 5)
+	adjacentAgg.AggregateBySlot(6, 30)
+	adjacentAgg.Collect(0)
+	adjacentAgg.Flush(func(seriesIdx uint32) (FieldAggregator, bool) {
+		return agg, true
+	}, func(pos int) int64 {
+		return int64(pos) * 10
+	})
+	// data point before last point is ignored, last point won't be moved backwards
+	assert.Equal(t, adjacentPoint{timestamp: 60, value: 30}, adjacentAgg.lastPoints[0])
+}
@@ -65,6 +65,22 @@ func eval(binaryOp stmt.BinaryOP, left, right float64) float64 {
 			return 0
 		}
 		return left / right
+	case stmt.EQUAL:
+		return boolToFloat(left == right)
+	case stmt.NOTEQUAL:
+		return boolToFloat(left != right)
+	case stmt.LESS:
+		return boolToFloat(left < right)
+	case stmt.LESSEQUAL:
+		return boolToFloat(left <= right)
+	case stmt.GREATER:
+		return boolToFloat(left > right)
+	case stmt.GREATEREQUAL:
+		return boolToFloat(left >= right)
+	case stmt.AND:
+		return boolToFloat(left != 0 && right != 0)
+	case stmt.OR:
+		return boolToFloat(left != 0 || right != 0)
 	default:
 		return 0
 	}
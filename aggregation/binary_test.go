@@ -34,8 +34,18 @@ func TestBinary_eval(t *testing.T) {
 	assert.Equal(t, 0.5, eval(stmt.DIV, 4, 8))
 	assert.Equal(t, float64(0), eval(stmt.DIV, 4, 0))
 
+	// compare/logical operator
+	assert.Equal(t, float64(1), eval(stmt.EQUAL, 4, 4))
+	assert.Equal(t, float64(0), eval(stmt.NOTEQUAL, 4, 4))
+	assert.Equal(t, float64(1), eval(stmt.LESS, 4, 8))
+	assert.Equal(t, float64(0), eval(stmt.LESSEQUAL, 9, 8))
+	assert.Equal(t, float64(0), eval(stmt.GREATER, 4, 8))
+	assert.Equal(t, float64(1), eval(stmt.GREATEREQUAL, 8, 8))
+	assert.Equal(t, float64(0), eval(stmt.AND, 4, 0))
+	assert.Equal(t, float64(1), eval(stmt.OR, 4, 0))
+
 	// wrong binary operator
-	assert.Equal(t, float64(0), eval(stmt.OR, 4, 8))
+	assert.Equal(t, float64(0), eval(stmt.UNKNOWN, 4, 8))
 }
 
 func TestBinary_Eval_Single(t *testing.T) {
@@ -75,6 +75,17 @@ func DownSamplingMultiSeriesInto(
 	target timeutil.SlotRange, ratio uint16, baseSlot uint16,
 	fieldType field.Type, decoders []*encoding.TSDDecoder,
 	emitValue func(targetPos int, value float64),
+) {
+	DownSamplingAggregateInto(target, ratio, baseSlot, fieldType.AggType(), decoders, nil, emitValue)
+}
+
+// DownSamplingAggregateInto merges field data like DownSamplingMultiSeriesInto, but aggregates by given agg type,
+// if pointValues[i] is true, the data of decoders[i] is raw data point which need be converted by agg type first,
+// it is used to materialize the rollup aggregates(e.g. max/count of gauge) when doing rollup.
+func DownSamplingAggregateInto(
+	target timeutil.SlotRange, ratio uint16, baseSlot uint16,
+	aggType field.AggType, decoders []*encoding.TSDDecoder, pointValues []bool,
+	emitValue func(targetPos int, value float64),
 ) {
 	targetValues := make([]float64, infBlockSize)
 	length := int(target.End-target.Start) + 1
@@ -91,15 +102,19 @@ func DownSamplingMultiSeriesInto(
 	fillInfBlock(targetValues)
 	bs := int(baseSlot)
 	// second loop: iterating tsd decoder
-	for _, decoder := range decoders {
+	for idx, decoder := range decoders {
 		if decoder == nil {
 			continue
 		}
+		pointValue := idx < len(pointValues) && pointValues[idx]
 		for movingSourceSlot := decoder.StartTime(); movingSourceSlot <= decoder.EndTime(); movingSourceSlot++ {
 			if !decoder.HasValueWithSlot(movingSourceSlot) {
 				continue
 			}
 			value := math.Float64frombits(decoder.Value())
+			if pointValue {
+				value = aggType.PointValue(value)
+			}
 			targetPos := bs + int(movingSourceSlot/ratio) - int(target.Start)
 			if targetPos < 0 {
 				continue
@@ -113,7 +128,7 @@ func DownSamplingMultiSeriesInto(
 				targetValues[targetPos] = value
 				// set before, aggregate
 			} else {
-				targetValues[targetPos] = fieldType.AggType().Aggregate(targetValues[targetPos], value)
+				targetValues[targetPos] = aggType.Aggregate(targetValues[targetPos], value)
 			}
 		}
 	}
@@ -24,8 +24,10 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/pkg/bit"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
 )
 
 func Test_fillInfBlock(t *testing.T) {
@@ -72,3 +74,34 @@ func TestDownSampling(t *testing.T) {
 	})
 	assert.Equal(t, 1, found)
 }
+
+func TestDownSamplingAggregateInto(t *testing.T) {
+	newDecoder := func(values ...float64) *encoding.TSDDecoder {
+		encoder := encoding.NewTSDEncoder(0)
+		for _, value := range values {
+			encoder.AppendTime(bit.One)
+			encoder.AppendValue(math.Float64bits(value))
+		}
+		data, _ := encoder.BytesWithoutTime()
+		decoder := encoding.GetTSDDecoder()
+		decoder.ResetWithTimeRange(data, 0, uint16(len(values)-1))
+		return decoder
+	}
+	// raw data points, 4 source slots => 2 target slots
+	raw := newDecoder(1, 5, 3, 2)
+	var rs []float64
+	DownSamplingAggregateInto(timeutil.SlotRange{Start: 0, End: 1}, 2, 0, field.Count,
+		[]*encoding.TSDDecoder{nil, raw}, []bool{false, true}, func(targetPos int, value float64) {
+			rs = append(rs, value)
+		})
+	assert.Equal(t, []float64{2, 2}, rs)
+
+	// materialized rollup aggregate
+	rs = rs[:0]
+	materialized := newDecoder(2, 3)
+	DownSamplingAggregateInto(timeutil.SlotRange{Start: 0, End: 0}, 2, 0, field.Count,
+		[]*encoding.TSDDecoder{materialized}, nil, func(targetPos int, value float64) {
+			rs = append(rs, value)
+		})
+	assert.Equal(t, []float64{5}, rs)
+}
@@ -18,10 +18,12 @@
 package aggregation
 
 import (
+	"sort"
 	"strconv"
 
 	"github.com/lindb/lindb/aggregation/fields"
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
@@ -36,18 +38,37 @@ import (
 // 3. build result set
 type Expression struct {
 	pointCount  int
+	shiftSlots  int // leading slots which are only used for reading data of time shift function
+	shifting    int // shifted slots of the time shift function which is being evaluated
 	interval    int64
 	timeRange   timeutil.TimeRange
 	selectItems []stmt.Expr
 
 	fieldStore map[field.Name]fields.Field
 	resultSet  map[string]*collections.FloatArray
+	heatmap    []*HistogramBucket
+	// values of fields with occurrences for count distinct/median function, field name => values
+	fieldValues map[field.Name]*models.StringValues
+}
+
+// HistogramBucket represents the values of histogram bucket with upper bound.
+type HistogramBucket struct {
+	UpperBound float64
+	Values     *collections.FloatArray
 }
 
 // NewExpression creates an Expression
 func NewExpression(timeRange timeutil.TimeRange, interval int64, selectItems []stmt.Expr) *Expression {
+	// widen the time range, so that the data points shifted into query time range can be evaluated.
+	shift := MaxTimeShift(selectItems, interval)
+	timeRange.Start -= shift
+	shiftSlots := 0
+	if shift > 0 {
+		shiftSlots = int(shift / interval)
+	}
 	return &Expression{
 		pointCount:  timeutil.CalPointCount(timeRange.Start, timeRange.End, interval) + 1,
+		shiftSlots:  shiftSlots,
 		interval:    interval,
 		timeRange:   timeRange,
 		selectItems: selectItems,
@@ -72,10 +93,11 @@ func (e *Expression) Eval(timeSeries series.GroupedIterator) {
 		values := e.eval(nil, selectItem)
 		if len(values) != 0 {
 			item, ok := selectItem.(*stmt.SelectItem)
+			result := e.trim(values[0])
 			if ok && len(item.Alias) > 0 {
-				e.resultSet[item.Alias] = values[0]
+				e.resultSet[item.Alias] = result
 			} else {
-				e.resultSet[item.Rewrite()] = values[0]
+				e.resultSet[item.Rewrite()] = result
 			}
 		}
 	}
@@ -86,6 +108,63 @@ func (e *Expression) ResultSet() map[string]*collections.FloatArray {
 	return e.resultSet
 }
 
+// Heatmap returns the histogram buckets sorted by upper bound if it selects heatmap.
+func (e *Expression) Heatmap() []*HistogramBucket {
+	return e.heatmap
+}
+
+// PrepareStringValues prepares the values of fields for count distinct/median function.
+func (e *Expression) PrepareStringValues(values []*models.StringValues) {
+	for _, sv := range values {
+		if e.fieldValues == nil {
+			e.fieldValues = make(map[field.Name]*models.StringValues)
+		}
+		e.fieldValues[field.Name(sv.Field)] = sv
+	}
+}
+
+// trim removes the leading slots for time shift, returns the values in query time range.
+func (e *Expression) trim(values *collections.FloatArray) *collections.FloatArray {
+	if e.shiftSlots == 0 || values == nil {
+		return values
+	}
+	result := collections.NewFloatArray(e.pointCount - e.shiftSlots)
+	result.SetSingle(values.IsSingle())
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if idx >= e.shiftSlots {
+			result.SetValue(idx-e.shiftSlots, val)
+		}
+	}
+	return result
+}
+
+// mask drops the values in leading slots which current expression cannot read,
+// only the data points shifted into query time range are visible when evaluating time shift function.
+func (e *Expression) mask(values []*collections.FloatArray) []*collections.FloatArray {
+	invisible := e.shiftSlots - e.shifting
+	if invisible <= 0 {
+		return values
+	}
+	result := make([]*collections.FloatArray, len(values))
+	for i, array := range values {
+		if array == nil {
+			continue
+		}
+		masked := collections.NewFloatArray(array.Capacity())
+		itr := array.NewIterator()
+		for itr.HasNext() {
+			idx, val := itr.Next()
+			if idx >= invisible {
+				masked.SetValue(idx, val)
+			}
+		}
+		result[i] = masked
+	}
+	return result
+}
+
 // prepare the field store
 func (e *Expression) prepare(timeSeries series.GroupedIterator) {
 	if timeSeries == nil {
@@ -109,8 +188,32 @@ func (e *Expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 	case *stmt.CallExpr:
 		switch ex.FuncType {
 		case function.Quantile:
-			return e.quantile(ex)
+			return e.mask(e.quantile(ex))
+		case function.Median:
+			if len(ex.Params) > 0 {
+				return e.mask(e.median(ex))
+			}
+			return e.mask(e.histogramQuantile(0.5))
+		case function.HistogramCount:
+			return e.mask(e.histogramField(metric.HistogramCount))
+		case function.HistogramSum:
+			return e.mask(e.histogramField(metric.HistogramSum))
+		case function.HistogramAvg:
+			return e.mask(e.histogramAvg())
+		case function.HistogramFraction:
+			return e.mask(e.histogramFraction(ex))
+		case function.Heatmap:
+			e.heatmap = e.histogramBuckets()
+			return nil
+		case function.CountDistinct:
+			return e.mask(e.countDistinct(ex))
 		default:
+			if ex.FuncType.IsTransform() {
+				return e.transform(ex)
+			}
+			if ex.FuncType.IsScalar() {
+				return e.scalarCall(ex)
+			}
 			return e.funcCall(ex)
 		}
 	case *stmt.ParenExpr:
@@ -133,19 +236,16 @@ func (e *Expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 
 		// tests if has func with field
 		if parentFunc == nil {
-			return fieldValues.GetDefaultValues()
+			return e.mask(fieldValues.GetDefaultValues())
 		}
 		// get field data by function type
-		return fieldValues.GetValues(parentFunc.FuncType)
+		return e.mask(fieldValues.GetValues(parentFunc.FuncType))
 	default:
 		return nil
 	}
 }
 
 func (e *Expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
-	var (
-		histogramFields = make(map[float64][]*collections.FloatArray)
-	)
 	if len(expr.Params) != 1 {
 		return nil
 	}
@@ -153,16 +253,71 @@ func (e *Expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
 	if err != nil {
 		return nil
 	}
-	for fieldName, df := range e.fieldStore {
-		if df.Type() == field.HistogramField {
-			var upperBound float64
-			upperBound, err = metric.UpperBound(fieldName.String())
+	return e.histogramQuantile(quantileValue)
+}
+
+// countDistinct returns the distinct value count of field.
+func (e *Expression) countDistinct(expr *stmt.CallExpr) []*collections.FloatArray {
+	sv := e.getFieldValues(expr)
+	if sv == nil {
+		return nil
+	}
+	counts := collections.NewFloatArray(e.pointCount)
+	for i, timestamp := range sv.Timestamps {
+		idx := int((timestamp - e.timeRange.Start) / e.interval)
+		if idx < 0 || idx >= e.pointCount || i >= len(sv.Values) {
+			continue
+		}
+		counts.SetValue(idx, float64(len(sv.Values[i])))
+	}
+	return []*collections.FloatArray{counts}
+}
+
+// median returns the median of numeric field by the values of all data points.
+func (e *Expression) median(expr *stmt.CallExpr) []*collections.FloatArray {
+	sv := e.getFieldValues(expr)
+	if sv == nil {
+		return nil
+	}
+	medians := collections.NewFloatArray(e.pointCount)
+	for i, timestamp := range sv.Timestamps {
+		idx := int((timestamp - e.timeRange.Start) / e.interval)
+		if idx < 0 || idx >= e.pointCount || i >= len(sv.Values) {
+			continue
+		}
+		values := make([]float64, 0, len(sv.Values[i]))
+		counts := make([]uint32, 0, len(sv.Values[i]))
+		for j, value := range sv.Values[i] {
+			v, err := strconv.ParseFloat(value, 64)
 			if err != nil {
+				// not numeric value(e.g. string field)
 				continue
 			}
-			histogramFields[upperBound] = df.GetDefaultValues()
+			values = append(values, v)
+			counts = append(counts, sv.Count(i, j))
 		}
+		if median, ok := function.MedianOfValues(values, counts); ok {
+			medians.SetValue(idx, median)
+		}
+	}
+	return []*collections.FloatArray{medians}
+}
+
+// getFieldValues returns the values of field which is the param of function.
+func (e *Expression) getFieldValues(expr *stmt.CallExpr) *models.StringValues {
+	if len(expr.Params) != 1 {
+		return nil
 	}
+	fieldExpr, ok := expr.Params[0].(*stmt.FieldExpr)
+	if !ok {
+		return nil
+	}
+	return e.fieldValues[field.Name(fieldExpr.Name)]
+}
+
+// histogramQuantile calculates the quantile by histogram fields.
+func (e *Expression) histogramQuantile(quantileValue float64) []*collections.FloatArray {
+	histogramFields := e.histogramFields()
 	if len(histogramFields) == 0 {
 		return nil
 	}
@@ -173,6 +328,83 @@ func (e *Expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
 	return []*collections.FloatArray{array}
 }
 
+// histogramFraction calculates the fraction of observations less than or equal to upper bound by histogram fields.
+func (e *Expression) histogramFraction(expr *stmt.CallExpr) []*collections.FloatArray {
+	if len(expr.Params) != 1 {
+		return nil
+	}
+	upperBound, err := strconv.ParseFloat(expr.Params[0].Rewrite(), 64)
+	if err != nil {
+		return nil
+	}
+	histogramFields := e.histogramFields()
+	if len(histogramFields) == 0 {
+		return nil
+	}
+	array, err := function.HistogramFractionCall(upperBound, histogramFields)
+	if err != nil {
+		return nil
+	}
+	return []*collections.FloatArray{array}
+}
+
+// histogramAvg calculates the average of observations by histogram sum/count fields.
+func (e *Expression) histogramAvg() []*collections.FloatArray {
+	sum := e.histogramField(metric.HistogramSum)
+	count := e.histogramField(metric.HistogramCount)
+	if len(sum) == 0 || len(count) == 0 {
+		return nil
+	}
+	array := function.AvgCall(sum[0], count[0])
+	if array == nil {
+		return nil
+	}
+	return []*collections.FloatArray{array}
+}
+
+// histogramField returns the values of histogram reserved field(sum/count).
+func (e *Expression) histogramField(fieldName field.Name) []*collections.FloatArray {
+	df, ok := e.fieldStore[fieldName]
+	if !ok {
+		return nil
+	}
+	return df.GetDefaultValues()
+}
+
+// histogramBuckets returns the histogram buckets sorted by upper bound, values are in query time range.
+func (e *Expression) histogramBuckets() []*HistogramBucket {
+	histogramFields := e.histogramFields()
+	buckets := make([]*HistogramBucket, 0, len(histogramFields))
+	for upperBound, values := range histogramFields {
+		if len(values) != 1 || values[0] == nil {
+			continue
+		}
+		buckets = append(buckets, &HistogramBucket{
+			UpperBound: upperBound,
+			Values:     e.trim(values[0]),
+		})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].UpperBound < buckets[j].UpperBound
+	})
+	return buckets
+}
+
+// histogramFields returns the values of histogram bucket fields, upper bound => values.
+func (e *Expression) histogramFields() map[float64][]*collections.FloatArray {
+	histogramFields := make(map[float64][]*collections.FloatArray)
+	for fieldName, df := range e.fieldStore {
+		if df.Type() == field.HistogramField {
+			upperBound, err := metric.UpperBound(fieldName.String())
+			if err != nil {
+				continue
+			}
+			histogramFields[upperBound] = df.GetDefaultValues()
+		}
+	}
+	return histogramFields
+}
+
 // funcCall calls the function
 func (e *Expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 	var params []*collections.FloatArray
@@ -189,6 +421,10 @@ func (e *Expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 		result = function.AvgCall(params...)
 	case function.Rate:
 		result = function.RateCall(e.interval, params...)
+	case function.Stddev:
+		result = function.StddevCall(params...)
+	case function.Variance:
+		result = function.VarianceCall(params...)
 	default:
 		result = function.FuncCall(expr.FuncType, params...)
 	}
@@ -198,10 +434,32 @@ func (e *Expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 	return []*collections.FloatArray{result}
 }
 
-// binaryEval evaluates binary operator
+// scalarCall calls the function which is applied on each data point, the field in params uses default values.
+func (e *Expression) scalarCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	var params []*collections.FloatArray
+	for _, param := range expr.Params {
+		paramValues := e.eval(nil, param)
+		if len(paramValues) != 1 {
+			return nil
+		}
+		params = append(params, paramValues[0])
+	}
+	var result *collections.FloatArray
+	if expr.FuncType == function.If {
+		result = function.IfCall(params...)
+	} else {
+		result = function.MathCall(expr.FuncType, params...)
+	}
+	if result == nil {
+		return nil
+	}
+	return []*collections.FloatArray{result}
+}
+
+// binaryEval evaluates binary operator, the result of compare/logical operator is 1(true) or 0(false).
 func (e *Expression) binaryEval(expr *stmt.BinaryExpr) []*collections.FloatArray {
 	binaryOP := expr.Operator
-	if binaryOP == stmt.ADD || binaryOP == stmt.SUB || binaryOP == stmt.DIV || binaryOP == stmt.MUL {
+	if binaryOP >= stmt.AND && binaryOP < stmt.UNKNOWN {
 		left := e.eval(nil, expr.Left)
 		if len(left) != 1 {
 			return nil
@@ -223,4 +481,6 @@ func (e *Expression) Reset() {
 		f.Reset()
 	}
 	e.resultSet = make(map[string]*collections.FloatArray)
+	e.heatmap = nil
+	e.fieldValues = nil
 }
@@ -18,15 +18,18 @@
 package aggregation
 
 import (
+	"math"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/sql"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -240,7 +243,7 @@ func TestExpression_BinaryEval(t *testing.T) {
 		End:   now + timeutil.OneHour*2,
 	}, timeutil.OneMinute, []stmt.Expr{&stmt.SelectItem{Expr: &stmt.BinaryExpr{
 		Left:     &stmt.FieldExpr{Name: "f1"},
-		Operator: stmt.AND,
+		Operator: stmt.UNKNOWN,
 		Right:    &stmt.FieldExpr{Name: "f2"},
 	}}})
 	gomock.InOrder(
@@ -340,6 +343,319 @@ func TestExpression_FuncCall_Rate(t *testing.T) {
 	assert.Equal(t, 50.0/60, value.GetValue(50-10))
 }
 
+func TestExpression_FuncCall_Stddev(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f1", field.SumField)
+	aggSpec.AddFunctionType(function.Stddev)
+	aggSpec.AddFunctionType(function.Variance)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+	for _, v := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		agg.AggregateBySlot(50, v)
+	}
+	startTime, it := agg.ResultSet()
+	series1 := series.NewMockIterator(ctrl)
+	series1.EXPECT().FieldType().Return(field.SumField)
+	series1.EXPECT().FieldName().Return(field.Name("f1"))
+	series1.EXPECT().HasNext().Return(true)
+	series1.EXPECT().Next().Return(startTime, it)
+	series1.EXPECT().HasNext().Return(false)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select stddev(f1),variance(f1) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 2, len(resultSet))
+	assert.Equal(t, 2.0, resultSet["stddev(f1)"].GetValue(50-10))
+	assert.Equal(t, 4.0, resultSet["variance(f1)"].GetValue(50-10))
+}
+
+func TestExpression_FuncCall_Median(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f1", field.GaugeField)
+	aggSpec.AddFunctionType(function.Median)
+	aggSpec.AddFunctionType(function.CountDistinct)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+	agg.AggregateBySlot(50, 9)
+	startTime, it := agg.ResultSet()
+	series1 := series.NewMockIterator(ctrl)
+	series1.EXPECT().FieldType().Return(field.GaugeField)
+	series1.EXPECT().FieldName().Return(field.Name("f1"))
+	series1.EXPECT().HasNext().Return(true)
+	series1.EXPECT().Next().Return(startTime, it)
+	series1.EXPECT().HasNext().Return(false)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select median(f1),count_distinct(f1) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	// values: 1,3,3,9 at slot 40, value is not numeric at slot 41
+	expression.PrepareStringValues([]*models.StringValues{{
+		Field:      "f1",
+		Timestamps: []int64{now + 40*timeutil.OneMinute, now + 41*timeutil.OneMinute},
+		Values:     [][]string{{"1", "3", "9"}, {"a"}},
+		Counts:     [][]uint32{{1, 2, 1}},
+	}})
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 2, len(resultSet))
+	assert.Equal(t, 3.0, resultSet["median(f1)"].GetValue(40))
+	assert.Equal(t, 1, resultSet["median(f1)"].Size())
+	assert.Equal(t, 3.0, resultSet["count_distinct(f1)"].GetValue(40))
+	assert.Equal(t, 1.0, resultSet["count_distinct(f1)"].GetValue(41))
+}
+
+func TestExpression_FuncCall_Counter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f1", field.GaugeField)
+	aggSpec.AddFunctionType(function.Increase)
+	aggSpec.AddFunctionType(function.IRate)
+	aggSpec.AddFunctionType(function.Derivative)
+	aggSpec.AddFunctionType(function.Delta)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+	adjacentAgg := NewAdjacentAggregator(aggSpec, timeutil.OneMinute)
+	timestamp := func(pos int) int64 { return int64(pos) * timeutil.OneMinute }
+	// series 1 resets, series 2 doesn't
+	for idx, values := range [][]float64{{100, 160, 40}, {10, 20, 30}} {
+		adjacentAgg.Prepare(100)
+		for i, v := range values {
+			agg.AggregateBySlot(50+i, v)
+			adjacentAgg.AggregateBySlot(50+i, v)
+		}
+		adjacentAgg.Collect(uint32(idx))
+	}
+	adjacentAgg.Flush(func(seriesIdx uint32) (FieldAggregator, bool) {
+		return agg, true
+	}, timestamp)
+	startTime, it := agg.ResultSet()
+	series1 := series.NewMockIterator(ctrl)
+	series1.EXPECT().FieldType().Return(field.GaugeField)
+	series1.EXPECT().FieldName().Return(field.Name("f1"))
+	series1.EXPECT().HasNext().Return(true)
+	series1.EXPECT().Next().Return(startTime, it)
+	series1.EXPECT().HasNext().Return(false)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select increase(f1),irate(f1),derivative(f1),delta(f1) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 4, len(resultSet))
+	assert.False(t, resultSet["increase(f1)"].HasValue(50-10))
+	assert.Equal(t, 70.0, resultSet["increase(f1)"].GetValue(51-10))
+	// counter reset of series 1 is handled before merged with series 2
+	assert.Equal(t, 50.0, resultSet["increase(f1)"].GetValue(52-10))
+	assert.Equal(t, 70.0/60, resultSet["irate(f1)"].GetValue(51-10))
+	assert.Equal(t, -110.0/60, resultSet["derivative(f1)"].GetValue(52-10))
+	assert.Equal(t, -110.0, resultSet["delta(f1)"].GetValue(52-10))
+}
+
+func TestExpression_ScalarCall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f1", field.GaugeField)
+	aggSpec.AddFunctionType(function.LastValue)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+	agg.AggregateBySlot(50, -4)
+	agg.AggregateBySlot(51, 2048)
+	agg.AggregateBySlot(52, 16)
+	startTime, it := agg.ResultSet()
+	series1 := series.NewMockIterator(ctrl)
+	series1.EXPECT().FieldType().Return(field.GaugeField)
+	series1.EXPECT().FieldName().Return(field.Name("f1"))
+	series1.EXPECT().HasNext().Return(true)
+	series1.EXPECT().Next().Return(startTime, it)
+	series1.EXPECT().HasNext().Return(false)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select abs(f1) as a, sqrt(f1) as s, clamp_max(f1, 100) as c, " +
+		"if(f1 >= 1024, f1/1024, f1) as i, round(log(f1, 2)) + 1 as l from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 5, len(resultSet))
+	assert.Equal(t, 4.0, resultSet["a"].GetValue(50-10))
+	assert.Equal(t, 2048.0, resultSet["a"].GetValue(51-10))
+	// sqrt of negative value is dropped
+	assert.False(t, resultSet["s"].HasValue(50-10))
+	assert.Equal(t, 4.0, resultSet["s"].GetValue(52-10))
+	assert.Equal(t, 100.0, resultSet["c"].GetValue(51-10))
+	assert.Equal(t, -4.0, resultSet["i"].GetValue(50-10))
+	assert.Equal(t, 2.0, resultSet["i"].GetValue(51-10))
+	assert.Equal(t, 16.0, resultSet["i"].GetValue(52-10))
+	assert.False(t, resultSet["l"].HasValue(50-10))
+	assert.Equal(t, 12.0, resultSet["l"].GetValue(51-10))
+	assert.Equal(t, 5.0, resultSet["l"].GetValue(52-10))
+}
+
+func TestExpression_Histogram(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFieldSeries := func(fieldName field.Name, fieldType field.Type, values ...float64) series.Iterator {
+		aggSpec := NewAggregatorSpec(fieldName, fieldType)
+		aggSpec.AddFunctionType(function.Sum)
+		agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+		for idx, value := range values {
+			agg.AggregateBySlot(50+idx, value)
+		}
+		startTime, it := agg.ResultSet()
+		fieldSeries := series.NewMockIterator(ctrl)
+		fieldSeries.EXPECT().FieldType().Return(fieldType)
+		fieldSeries.EXPECT().FieldName().Return(fieldName)
+		gomock.InOrder(
+			fieldSeries.EXPECT().HasNext().Return(true),
+			fieldSeries.EXPECT().Next().Return(startTime, it),
+			fieldSeries.EXPECT().HasNext().Return(false),
+		)
+		return fieldSeries
+	}
+	fieldSeriesList := []series.Iterator{
+		mockFieldSeries(field.Name(metric.BucketNameOfHistogramExplicitBound(1)), field.HistogramField, 1, 2),
+		mockFieldSeries(field.Name(metric.BucketNameOfHistogramExplicitBound(2)), field.HistogramField, 1, 0),
+		mockFieldSeries(field.Name(metric.BucketNameOfHistogramExplicitBound(4)), field.HistogramField, 2, 0),
+		mockFieldSeries(field.Name(metric.BucketNameOfHistogramExplicitBound(math.Inf(1))), field.HistogramField, 0, 2),
+		mockFieldSeries(metric.HistogramSum, field.SumField, 10, 20),
+		mockFieldSeries(metric.HistogramCount, field.SumField, 4, 4),
+	}
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+	var calls []*gomock.Call
+	for _, fieldSeries := range fieldSeriesList {
+		calls = append(calls, timeSeries.EXPECT().HasNext().Return(true), timeSeries.EXPECT().Next().Return(fieldSeries))
+	}
+	calls = append(calls, timeSeries.EXPECT().HasNext().Return(false))
+	gomock.InOrder(calls...)
+
+	q, _ := sql.Parse("select histogram_count() as c, histogram_sum() as s, histogram_avg() as a, " +
+		"histogram_fraction(3) as f, heatmap() from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 4, len(resultSet))
+	assert.Equal(t, 4.0, resultSet["c"].GetValue(40))
+	assert.Equal(t, 20.0, resultSet["s"].GetValue(41))
+	assert.Equal(t, 2.5, resultSet["a"].GetValue(40))
+	assert.Equal(t, 5.0, resultSet["a"].GetValue(41))
+	assert.Equal(t, 0.75, resultSet["f"].GetValue(40))
+	assert.Equal(t, 0.5, resultSet["f"].GetValue(41))
+
+	heatmap := expression.Heatmap()
+	assert.Len(t, heatmap, 4)
+	for idx, upperBound := range []float64{1, 2, 4, math.Inf(1)} {
+		assert.Equal(t, upperBound, heatmap[idx].UpperBound)
+	}
+	assert.Equal(t, 2.0, heatmap[0].Values.GetValue(41))
+	assert.Equal(t, 2.0, heatmap[2].Values.GetValue(40))
+
+	expression.Reset()
+	assert.Empty(t, expression.Heatmap())
+	assert.Empty(t, expression.ResultSet())
+	// histogram fields not found
+	expression = NewExpression(timeutil.TimeRange{Start: now, End: now + timeutil.OneHour}, timeutil.OneMinute, query.SelectItems)
+	assert.Nil(t, expression.histogramAvg())
+	assert.Nil(t, expression.histogramFraction(&stmt.CallExpr{FuncType: function.HistogramFraction}))
+	assert.Nil(t, expression.histogramFraction(&stmt.CallExpr{
+		FuncType: function.HistogramFraction,
+		Params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+	}))
+}
+
+func TestExpression_StringField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("status", field.StringField)
+	aggSpec.AddFunctionType(function.LastValue)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+	agg.AggregateBySlot(50, field.StringCode("running"))
+	agg.AggregateBySlot(50, field.StringCode("stopped"))
+	startTime, it := agg.ResultSet()
+	fieldSeries := series.NewMockIterator(ctrl)
+	fieldSeries.EXPECT().FieldType().Return(field.StringField)
+	fieldSeries.EXPECT().FieldName().Return(field.Name("status"))
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(fieldSeries),
+		fieldSeries.EXPECT().HasNext().Return(true),
+		fieldSeries.EXPECT().Next().Return(startTime, it),
+		fieldSeries.EXPECT().HasNext().Return(false),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+
+	q, _ := sql.Parse("select last(status) as s, count_distinct(status) as c, count_distinct(f) as f from app")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	expression.PrepareStringValues([]*models.StringValues{{
+		Field:      "status",
+		Timestamps: []int64{now - timeutil.OneHour, now + 40*timeutil.OneMinute},
+		Values:     [][]string{{"running"}, {"running", "stopped"}},
+	}})
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 2, len(resultSet))
+	assert.Equal(t, field.StringCode("stopped"), resultSet["s"].GetValue(40))
+	assert.Equal(t, 2.0, resultSet["c"].GetValue(40))
+	assert.Equal(t, 1, resultSet["c"].Size())
+
+	expression.Reset()
+	assert.Empty(t, expression.ResultSet())
+	assert.Nil(t, expression.countDistinct(&stmt.CallExpr{FuncType: function.CountDistinct,
+		Params: []stmt.Expr{&stmt.FieldExpr{Name: "status"}}}))
+	assert.Nil(t, expression.countDistinct(&stmt.CallExpr{FuncType: function.CountDistinct}))
+	assert.Nil(t, expression.countDistinct(&stmt.CallExpr{FuncType: function.CountDistinct,
+		Params: []stmt.Expr{&stmt.NumberLiteral{Val: 1}}}))
+}
+
 func TestExpression_NotSupport_Expr(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
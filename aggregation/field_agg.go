@@ -33,6 +33,11 @@ type FieldAggregator interface {
 	Aggregate(it series.FieldIterator)
 	// AggregateBySlot aggregates the field series into current aggregator.
 	AggregateBySlot(pos int, value float64)
+	// AggregateRollupBySlot aggregates the raw data point into current aggregator,
+	// but skips the agg types which are materialized as rollup aggregates.
+	AggregateRollupBySlot(pos int, value float64, rollupAggTypes []field.AggType)
+	// AggregatePartialBySlot aggregates the partial value(materialized rollup aggregate) into the series of given agg type.
+	AggregatePartialBySlot(aggType field.AggType, pos int, value float64)
 	// ResultSet returns the result set of field aggregator.
 	ResultSet() (startTime int64, it series.FieldIterator)
 	// reset aggregator context for reusing.
@@ -53,10 +58,14 @@ type fieldAggregator struct {
 // e.g. segment start time = 20190905 10:00:00, start = 10, end = 50, interval = 10 seconds,
 // real query time range {20190905 10:01:40 ~ 20190905 10:08:20}
 func NewFieldAggregator(aggSpec AggregatorSpec, segmentStartTime int64, start, end int) FieldAggregator {
-	// TODO maybe agg type has duplicate?
+	// functions may share the same agg type(e.g. sum and avg), keep one field series for each agg type
 	var aggTypes []field.AggType
 	for f := range aggSpec.Functions() {
-		aggTypes = append(aggTypes, aggSpec.GetFieldType().GetFuncFieldParams(f)...)
+		for _, aggType := range aggSpec.GetFieldType().GetFuncFieldParams(f) {
+			if !containsAggType(aggTypes, aggType) {
+				aggTypes = append(aggTypes, aggType)
+			}
+		}
 	}
 
 	agg := &fieldAggregator{
@@ -74,18 +83,29 @@ func (a *fieldAggregator) ResultSet() (startTime int64, it series.FieldIterator)
 	return a.segmentStartTime, newFieldIterator(a.start, a.aggTypes, a.fieldSeriesList)
 }
 
-// Aggregate aggregates the field series into current aggregator
+// Aggregate aggregates the field series(partial result) into current aggregator,
+// each primitive series only merges into the field series with same agg type.
 func (a *fieldAggregator) Aggregate(it series.FieldIterator) {
 	for it.HasNext() {
 		pIt := it.Next()
-		for pIt.HasNext() {
-			slot, value := pIt.Next()
-			a.AggregateBySlot(slot-a.start, value)
+		pAggType := pIt.AggType()
+		for idx, aggType := range a.aggTypes {
+			if aggType != pAggType {
+				continue
+			}
+			for pIt.HasNext() {
+				slot, value := pIt.Next()
+				if math.IsInf(value, 1) {
+					continue
+				}
+				a.aggregate(idx, slot-a.start, value)
+			}
+			break
 		}
 	}
 }
 
-// AggregateBySlot aggregates the field series into current aggregator
+// AggregateBySlot aggregates the raw data point into current aggregator
 func (a *fieldAggregator) AggregateBySlot(pos int, value float64) {
 	// drop inf value
 	if math.IsInf(value, 1) {
@@ -93,19 +113,58 @@ func (a *fieldAggregator) AggregateBySlot(pos int, value float64) {
 	}
 
 	for idx, aggType := range a.aggTypes {
-		values := a.fieldSeriesList[idx]
-		if values == nil {
-			values = collections.NewFloatArray(a.end - a.start + 1)
-			values.SetValue(pos, value)
-			a.fieldSeriesList[idx] = values
-		} else {
-			// slot too large for last family
-			if values.HasValue(pos) {
-				values.SetValue(pos, aggType.Aggregate(values.GetValue(pos), value))
-			} else {
-				values.SetValue(pos, value)
-			}
+		if aggType.IsAdjacent() {
+			// calculated by adjacent data points of series, see AdjacentAggregator
+			continue
+		}
+		a.aggregate(idx, pos, aggType.PointValue(value))
+	}
+}
+
+// AggregateRollupBySlot aggregates the raw data point into current aggregator, skips the materialized agg types.
+func (a *fieldAggregator) AggregateRollupBySlot(pos int, value float64, rollupAggTypes []field.AggType) {
+	// drop inf value
+	if math.IsInf(value, 1) {
+		return
+	}
+
+	for idx, aggType := range a.aggTypes {
+		if aggType.IsAdjacent() || containsAggType(rollupAggTypes, aggType) {
+			continue
 		}
+		a.aggregate(idx, pos, aggType.PointValue(value))
+	}
+}
+
+// AggregatePartialBySlot aggregates the partial value into the field series with same agg type.
+func (a *fieldAggregator) AggregatePartialBySlot(aggType field.AggType, pos int, value float64) {
+	// drop inf value
+	if math.IsInf(value, 1) {
+		return
+	}
+
+	for idx, t := range a.aggTypes {
+		if t == aggType {
+			a.aggregate(idx, pos, value)
+			return
+		}
+	}
+}
+
+// aggregate aggregates the value into the field series of given index.
+func (a *fieldAggregator) aggregate(idx, pos int, value float64) {
+	values := a.fieldSeriesList[idx]
+	if values == nil {
+		values = collections.NewFloatArray(a.end - a.start + 1)
+		values.SetValue(pos, value)
+		a.fieldSeriesList[idx] = values
+		return
+	}
+	// slot too large for last family
+	if values.HasValue(pos) {
+		values.SetValue(pos, a.aggTypes[idx].Aggregate(values.GetValue(pos), value))
+	} else {
+		values.SetValue(pos, value)
 	}
 }
 
@@ -118,3 +177,13 @@ func (a *fieldAggregator) reset() {
 		a.fieldSeriesList[idx].Reset()
 	}
 }
+
+// containsAggType checks if the agg type in agg type list.
+func containsAggType(aggTypes []field.AggType, aggType field.AggType) bool {
+	for _, t := range aggTypes {
+		if t == aggType {
+			return true
+		}
+	}
+	return false
+}
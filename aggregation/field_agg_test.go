@@ -47,6 +47,7 @@ func TestFieldAggregator_Aggregate(t *testing.T) {
 	it.EXPECT().HasNext().Return(false)
 	pIt := series.NewMockPrimitiveIterator(ctrl)
 	it.EXPECT().Next().Return(pIt)
+	pIt.EXPECT().AggType().Return(field.Sum)
 	pIt.EXPECT().HasNext().Return(true)
 	pIt.EXPECT().Next().Return(20, 10.0)
 	pIt.EXPECT().HasNext().Return(false)
@@ -58,3 +59,80 @@ func TestFieldAggregator_Aggregate(t *testing.T) {
 
 	agg.reset()
 }
+
+func TestFieldAggregator_Stddev(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.SumField)
+	aggSpec.AddFunctionType(function.Stddev)
+	aggSpec.AddFunctionType(function.Avg)
+	aggSpec.AddFunctionType(function.FirstValue)
+
+	// raw data points of two families
+	agg1 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	agg1.AggregateBySlot(1, 2.0)
+	agg1.AggregateBySlot(1, 4.0)
+	agg1.AggregateBySlot(1, 4.0)
+	agg1.AggregateBySlot(1, 4.0)
+	agg2 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	agg2.AggregateBySlot(1, 5.0)
+	agg2.AggregateBySlot(1, 5.0)
+	agg2.AggregateBySlot(1, 7.0)
+	agg2.AggregateBySlot(1, 9.0)
+
+	// merge partial results
+	agg := NewFieldAggregator(aggSpec, 1, 10, 20)
+	_, rs := agg1.ResultSet()
+	agg.Aggregate(rs)
+	_, rs = agg2.ResultSet()
+	agg.Aggregate(rs)
+
+	expect := map[field.AggType]float64{
+		field.Sum:          40,
+		field.Count:        8,
+		field.SumOfSquares: 232,
+		field.FirstValue:   2,
+	}
+	_, rs = agg.ResultSet()
+	count := 0
+	for rs.HasNext() {
+		pIt := rs.Next()
+		assert.True(t, pIt.HasNext())
+		slot, value := pIt.Next()
+		assert.Equal(t, 11, slot)
+		assert.Equal(t, expect[pIt.AggType()], value)
+		count++
+	}
+	// sum shared by stddev and avg
+	assert.Equal(t, len(expect), count)
+}
+
+func TestFieldAggregator_Rollup(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.GaugeField)
+	aggSpec.AddFunctionType(function.Max)
+	aggSpec.AddFunctionType(function.Avg)
+
+	agg := NewFieldAggregator(aggSpec, 1, 10, 20)
+	rollupAggTypes := []field.AggType{field.Max, field.Count}
+	// last value of rolled up slot only contributes to the agg types which are not materialized
+	agg.AggregateRollupBySlot(1, math.Inf(1), rollupAggTypes)
+	agg.AggregateRollupBySlot(1, 3.0, rollupAggTypes)
+	agg.AggregatePartialBySlot(field.Max, 1, math.Inf(1))
+	agg.AggregatePartialBySlot(field.Max, 1, 9.0)
+	agg.AggregatePartialBySlot(field.Count, 1, 6.0)
+	agg.AggregatePartialBySlot(field.Min, 1, 1.0)
+
+	expect := map[field.AggType]float64{
+		field.Max:   9,
+		field.Sum:   3,
+		field.Count: 6,
+	}
+	_, rs := agg.ResultSet()
+	count := 0
+	for rs.HasNext() {
+		pIt := rs.Next()
+		assert.True(t, pIt.HasNext())
+		_, value := pIt.Next()
+		assert.Equal(t, expect[pIt.AggType()], value)
+		count++
+	}
+	assert.Equal(t, len(expect), count)
+}
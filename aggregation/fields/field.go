@@ -88,8 +88,12 @@ func (f *dynamicField) SetValue(fieldSeries series.Iterator) {
 			}
 			for pIt.HasNext() {
 				slot, val := pIt.Next()
-				idx := ((int64(slot)*f.interval + startTime) - f.startTime) / f.interval
-				fieldValues.SetValue(int(idx), val)
+				idx := int(((int64(slot)*f.interval + startTime) - f.startTime) / f.interval)
+				if fieldValues.HasValue(idx) {
+					// time slot aligned to session time zone may be split across two families
+					val = aggType.Aggregate(fieldValues.GetValue(idx), val)
+				}
+				fieldValues.SetValue(idx, val)
 			}
 		}
 	}
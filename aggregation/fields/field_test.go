@@ -56,6 +56,19 @@ func TestNewDynamicField(t *testing.T) {
 	assert.Equal(t, field.SumField, f.Type())
 }
 
+func TestDynamicField_SetValue_SameSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	f := NewDynamicField(field.SumField, 10, 10, 10)
+	f.SetValue(mockSingleIterator(ctrl))
+	f.SetValue(mockSingleIterator(ctrl))
+	values := f.GetDefaultValues()
+	assert.Equal(t, 1, len(values))
+	assert.Equal(t, 2.2, values[0].GetValue(4))
+	assert.Equal(t, 1, values[0].Size())
+}
+
 func TestDynamicField_UnknownType(t *testing.T) {
 	f := NewDynamicField(field.Unknown, 10, 10, 10)
 	values := f.GetDefaultValues()
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// Fill fills the empty slots of values based on fill type.
+// 1. FillNone: keeps the empty slots;
+// 2. FillPrevious: fills the empty slot with previous value, keeps the empty slots before first value;
+// 3. FillValue: fills all empty slots with given value.
+func Fill(values *collections.FloatArray, fillType stmt.FillType, fillValue float64) {
+	if values == nil {
+		return
+	}
+	switch fillType {
+	case stmt.FillPrevious:
+		if values.IsEmpty() {
+			return
+		}
+		hasPrevious := false
+		previous := 0.0
+		for i := 0; i < values.Capacity(); i++ {
+			if values.HasValue(i) {
+				hasPrevious = true
+				previous = values.GetValue(i)
+			} else if hasPrevious {
+				values.SetValue(i, previous)
+			}
+		}
+	case stmt.FillValue:
+		for i := 0; i < values.Capacity(); i++ {
+			if !values.HasValue(i) {
+				values.SetValue(i, fillValue)
+			}
+		}
+	default:
+	}
+}
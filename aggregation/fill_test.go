@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestFill(t *testing.T) {
+	newValues := func() *collections.FloatArray {
+		values := collections.NewFloatArray(5)
+		values.SetValue(1, 1)
+		values.SetValue(3, 3)
+		return values
+	}
+	collect := func(values *collections.FloatArray) map[int]float64 {
+		result := make(map[int]float64)
+		it := values.NewIterator()
+		for it.HasNext() {
+			idx, val := it.Next()
+			result[idx] = val
+		}
+		return result
+	}
+	Fill(nil, stmt.FillValue, 1)
+
+	values := newValues()
+	Fill(values, stmt.FillNone, 0)
+	assert.Equal(t, map[int]float64{1: 1, 3: 3}, collect(values))
+
+	values = newValues()
+	Fill(values, stmt.FillPrevious, 0)
+	assert.Equal(t, map[int]float64{1: 1, 2: 1, 3: 3, 4: 3}, collect(values))
+
+	values = newValues()
+	Fill(values, stmt.FillValue, 10)
+	assert.Equal(t, map[int]float64{0: 10, 1: 1, 2: 10, 3: 3, 4: 10}, collect(values))
+
+	values = collections.NewFloatArray(5)
+	Fill(values, stmt.FillPrevious, 0)
+	assert.True(t, values.IsEmpty())
+}
@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// DeltaCall calculates the difference between each value and its previous value,
+// which is used for gauge field.
+func DeltaCall(params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	prevIdx := -1
+	prev := 0.0
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if prevIdx >= 0 {
+			result.SetValue(idx, AdjacentCall(Delta, 0, prev, val, idx-prevIdx))
+		}
+		prevIdx = idx
+		prev = val
+	}
+	return result
+}
+
+// AdjacentCall calculates the value by two adjacent data points of one series(maybe with empty slots between them),
+// for the functions which are aware of adjacent data points:
+// 1. increase: the increase of cumulative counter, if counter resets(current value less than previous value),
+// current value is the increase;
+// 2. irate: the per-second instant rate of cumulative counter, counter resets are handled same as increase;
+// 3. delta: the difference between current value and previous value;
+// 4. derivative: the per-second derivative of gauge, the result may be negative.
+func AdjacentCall(funcType FuncType, interval int64, prev, cur float64, slots int) float64 {
+	seconds := float64(slots) * float64(interval) / float64(timeutil.OneSecond)
+	switch funcType {
+	case Increase:
+		return counterIncrease(prev, cur)
+	case IRate:
+		return counterIncrease(prev, cur) / seconds
+	case Derivative:
+		return (cur - prev) / seconds
+	default:
+		return cur - prev
+	}
+}
+
+// counterIncrease returns the increase of counter, handles counter reset.
+func counterIncrease(prev, cur float64) float64 {
+	if cur < prev {
+		// counter reset
+		return cur
+	}
+	return cur - prev
+}
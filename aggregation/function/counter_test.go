@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestDeltaCall(t *testing.T) {
+	assert.Nil(t, DeltaCall())
+	assert.Nil(t, DeltaCall(nil))
+
+	values := collections.NewFloatArray(10)
+	values.SetValue(0, 10)
+	values.SetValue(1, 70)
+	values.SetValue(2, 130)
+	values.SetValue(4, 60)
+	values.SetValue(5, 30)
+	result := DeltaCall(values)
+	expect := map[int]float64{1: 60, 2: 60, 4: -70, 5: -30}
+	assert.Equal(t, len(expect), result.Size())
+	for idx, val := range expect {
+		assert.Equal(t, val, result.GetValue(idx), "slot %d", idx)
+	}
+}
+
+func TestAdjacentCall(t *testing.T) {
+	cases := []struct {
+		funcType  FuncType
+		prev, cur float64
+		slots     int
+		expect    float64
+	}{
+		{funcType: Delta, prev: 10, cur: 70, slots: 1, expect: 60},
+		{funcType: Delta, prev: 130, cur: 60, slots: 2, expect: -70},
+		{funcType: Increase, prev: 10, cur: 70, slots: 1, expect: 60},
+		// counter reset
+		{funcType: Increase, prev: 130, cur: 60, slots: 2, expect: 60},
+		{funcType: IRate, prev: 10, cur: 70, slots: 1, expect: 1},
+		{funcType: IRate, prev: 130, cur: 60, slots: 2, expect: 0.5},
+		{funcType: Derivative, prev: 10, cur: 70, slots: 1, expect: 1},
+		{funcType: Derivative, prev: 130, cur: 60, slots: 2, expect: -70.0 / 120},
+	}
+	for _, tt := range cases {
+		assert.Equal(t, tt.expect, AdjacentCall(tt.funcType, timeutil.OneMinute, tt.prev, tt.cur, tt.slots),
+			"%s(%f,%f)", tt.funcType, tt.prev, tt.cur)
+	}
+}
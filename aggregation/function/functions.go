@@ -24,7 +24,9 @@ import (
 // FuncCall calls the function calc by function type and params
 func FuncCall(funcType FuncType, params ...*collections.FloatArray) *collections.FloatArray {
 	switch funcType {
-	case Sum, Min, Max, Count:
+	case Sum, Min, Max, Count, FirstValue, LastValue,
+		// calculated by adjacent data points of each series when loading data, then summed
+		IRate, Increase, Derivative, Delta:
 		if len(params) == 0 {
 			return nil
 		}
@@ -40,4 +40,6 @@ func TestFuncCall_Sum(t *testing.T) {
 	array2 := collections.NewFloatArray(20)
 	result = FuncCall(Sum, array1, array2)
 	assert.Equal(t, array1, result)
+	result = FuncCall(FirstValue, array1)
+	assert.Equal(t, array1, result)
 }
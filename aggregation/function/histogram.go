@@ -0,0 +1,114 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// HistogramFractionCall calculates the fraction of observations which are less than or equal to upper bound,
+// the observations in bucket are assumed to be uniformly distributed(linear interpolation like quantile).
+// 0 <= result <= 1, the fraction of the slot without observation is ignored.
+func HistogramFractionCall(upperBound float64, histogramFields map[float64][]*collections.FloatArray) (*collections.FloatArray, error) {
+	if math.IsNaN(upperBound) {
+		return nil, fmt.Errorf("HistogramFractionCall with illegal upper bound: %f", upperBound)
+	}
+	var histogramBuckets = make(buckets, len(histogramFields))
+
+	var idx = 0
+	for bound, arrays := range histogramFields {
+		if len(arrays) != 1 {
+			return nil, fmt.Errorf("HistogramFractionCall buckets's floatArray count: %d not equals 1", len(arrays))
+		}
+		histogramBuckets[idx] = bucket{upperBound: bound, itr: arrays[0].NewIterator()}
+		idx++
+	}
+	sort.Sort(histogramBuckets)
+
+	if len(histogramBuckets) < 2 {
+		return nil, fmt.Errorf("HistogramFractionCall with buckets count: %d less than 2", len(histogramBuckets))
+	}
+	if !math.IsInf(histogramBuckets[len(histogramBuckets)-1].upperBound, +1) {
+		return nil, fmt.Errorf("HistogramFractionCall's largest upper bound is not +Inf")
+	}
+	capacity := histogramFields[histogramBuckets[0].upperBound][0].Capacity()
+	targetFloatArray := collections.NewFloatArray(capacity)
+
+	itr := histogramBuckets[0].itr
+	for itr.HasNext() {
+		pos, v := itr.Next()
+		histogramBuckets[0].count = v
+
+		for bucketIdx := 1; bucketIdx < len(histogramBuckets); bucketIdx++ {
+			if !histogramBuckets[bucketIdx].itr.HasNext() {
+				return nil, fmt.Errorf("HistogramFractionCall floatArray length")
+			}
+			_, v := histogramBuckets[bucketIdx].itr.Next()
+			histogramBuckets[bucketIdx].count = v
+		}
+		histogramBuckets.EnsureCountFieldCumulative()
+
+		observations := histogramBuckets[len(histogramBuckets)-1].count
+		if observations == 0 {
+			continue
+		}
+		targetFloatArray.SetValue(pos, histogramBuckets.fraction(upperBound)/observations)
+	}
+
+	return targetFloatArray, nil
+}
+
+// fraction returns the count of observations which are less than or equal to upper bound,
+// buckets need be sorted and cumulative.
+func (bkt buckets) fraction(upperBound float64) float64 {
+	last := len(bkt) - 1
+	if math.IsInf(upperBound, +1) {
+		return bkt[last].count
+	}
+	b := sort.Search(last, func(i int) bool { return bkt[i].upperBound >= upperBound })
+	if b == last {
+		// upper bound is greater than the largest bound before +Inf
+		return bkt[last-1].count
+	}
+	if b == 0 && bkt[0].upperBound <= 0 {
+		// cannot interpolate in the first bucket with non-positive upper bound
+		if upperBound == bkt[0].upperBound {
+			return bkt[0].count
+		}
+		return 0
+	}
+	var (
+		bucketStart float64
+		bucketEnd   = bkt[b].upperBound
+		count       = bkt[b].count
+		below       float64
+	)
+	if b > 0 {
+		bucketStart = bkt[b-1].upperBound
+		below = bkt[b-1].count
+		count -= below
+	}
+	if upperBound <= bucketStart {
+		return below
+	}
+	return below + count*(upperBound-bucketStart)/(bucketEnd-bucketStart)
+}
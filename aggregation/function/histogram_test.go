@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func Test_HistogramFractionCall(t *testing.T) {
+	fields := map[float64][]*collections.FloatArray{
+		1:           makeFloatArray([]float64{1, 0}),
+		2:           makeFloatArray([]float64{1, 0}),
+		4:           makeFloatArray([]float64{2, 0}),
+		math.Inf(1): makeFloatArray([]float64{0, 0}),
+	}
+	cases := []struct {
+		upperBound float64
+		fraction   float64
+	}{
+		{upperBound: -1, fraction: 0},
+		{upperBound: 0.5, fraction: 0.125},
+		{upperBound: 1, fraction: 0.25},
+		{upperBound: 3, fraction: 0.75},
+		{upperBound: 10, fraction: 1},
+		{upperBound: math.Inf(1), fraction: 1},
+	}
+	for _, tt := range cases {
+		array, err := HistogramFractionCall(tt.upperBound, fields)
+		assert.NoError(t, err)
+		// slot without observation is ignored
+		assert.Equal(t, []float64{tt.fraction}, getDataFloatArray(array))
+	}
+
+	// first bucket with non-positive upper bound
+	fields = map[float64][]*collections.FloatArray{
+		0:           makeFloatArray([]float64{1}),
+		2:           makeFloatArray([]float64{1}),
+		math.Inf(1): makeFloatArray([]float64{0}),
+	}
+	array, _ := HistogramFractionCall(0, fields)
+	assert.Equal(t, []float64{0.5}, getDataFloatArray(array))
+	array, _ = HistogramFractionCall(-1, fields)
+	assert.Equal(t, []float64{0}, getDataFloatArray(array))
+}
+
+func Test_HistogramFractionCall_Error(t *testing.T) {
+	fields := map[float64][]*collections.FloatArray{
+		1:           makeFloatArray([]float64{1}),
+		math.Inf(1): makeFloatArray([]float64{1}),
+	}
+	_, err := HistogramFractionCall(math.NaN(), fields)
+	assert.Error(t, err)
+	// bad float array count
+	_, err = HistogramFractionCall(1, map[float64][]*collections.FloatArray{1: nil})
+	assert.Error(t, err)
+	// bucket count less than 2
+	_, err = HistogramFractionCall(1, map[float64][]*collections.FloatArray{math.Inf(1): makeFloatArray([]float64{1})})
+	assert.Error(t, err)
+	// largest upper bound is not +Inf
+	_, err = HistogramFractionCall(1, map[float64][]*collections.FloatArray{
+		1: makeFloatArray([]float64{1}),
+		2: makeFloatArray([]float64{1}),
+	})
+	assert.Error(t, err)
+	// float array length not match
+	_, err = HistogramFractionCall(1, map[float64][]*collections.FloatArray{
+		1:           makeFloatArray([]float64{1, 2}),
+		math.Inf(1): makeFloatArray([]float64{1}),
+	})
+	assert.Error(t, err)
+}
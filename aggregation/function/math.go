@@ -0,0 +1,132 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// MathCall applies the math function on each data point of first param,
+// the optional second param is the argument of function(e.g. pow(f, 2), clamp_min(f, 0)),
+// the data point is dropped if the result is not a finite number(e.g. sqrt(-1), log(0)).
+func MathCall(funcType FuncType, params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	var arg *collections.FloatArray
+	switch funcType {
+	case Abs, Ceil, Floor, Sqrt:
+		if len(params) != 1 {
+			return nil
+		}
+	case Round, Log:
+		if len(params) > 2 {
+			return nil
+		}
+		if len(params) == 2 {
+			arg = params[1]
+		}
+	case Pow, ClampMin, ClampMax:
+		if len(params) != 2 {
+			return nil
+		}
+		arg = params[1]
+	default:
+		return nil
+	}
+	if len(params) == 2 && arg == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if arg != nil && !arg.HasValue(idx) {
+			continue
+		}
+		var argVal float64
+		if arg != nil {
+			argVal = arg.GetValue(idx)
+		}
+		val = mathEval(funcType, val, argVal, arg != nil)
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			continue
+		}
+		result.SetValue(idx, val)
+	}
+	return result
+}
+
+// mathEval evaluates the math function of value, hasArg represents if function has argument.
+func mathEval(funcType FuncType, val, arg float64, hasArg bool) float64 {
+	switch funcType {
+	case Abs:
+		return math.Abs(val)
+	case Ceil:
+		return math.Ceil(val)
+	case Floor:
+		return math.Floor(val)
+	case Sqrt:
+		return math.Sqrt(val)
+	case Round:
+		if !hasArg {
+			return math.Round(val)
+		}
+		// round to given decimal places
+		pow := math.Pow(10, math.Trunc(arg))
+		return math.Round(val*pow) / pow
+	case Log:
+		if !hasArg {
+			return math.Log(val)
+		}
+		// logarithm of given base
+		return math.Log(val) / math.Log(arg)
+	case Pow:
+		return math.Pow(val, arg)
+	case ClampMin:
+		return math.Max(val, arg)
+	case ClampMax:
+		return math.Min(val, arg)
+	default:
+		return math.NaN()
+	}
+}
+
+// IfCall returns the value of second param if the condition(first param) is true(not zero),
+// else returns the value of third param for each data point of condition.
+func IfCall(params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) != 3 || params[0] == nil || params[1] == nil || params[2] == nil {
+		return nil
+	}
+	cond, then, otherwise := params[0], params[1], params[2]
+	result := collections.NewFloatArray(cond.Capacity())
+	itr := cond.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		values := otherwise
+		if val != 0 {
+			values = then
+		}
+		if values.HasValue(idx) {
+			result.SetValue(idx, values.GetValue(idx))
+		}
+	}
+	return result
+}
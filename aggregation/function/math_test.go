@@ -0,0 +1,153 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// newValues returns the float array which has values from first slot, NaN means empty slot.
+func newValues(capacity int, values ...float64) *collections.FloatArray {
+	array := collections.NewFloatArray(capacity)
+	for idx, val := range values {
+		if !math.IsNaN(val) {
+			array.SetValue(idx, val)
+		}
+	}
+	return array
+}
+
+// newSingle returns the float array which has same value in all slots, like number literal.
+func newSingle(capacity int, value float64) *collections.FloatArray {
+	array := collections.NewFloatArray(capacity)
+	for idx := 0; idx < capacity; idx++ {
+		array.SetValue(idx, value)
+	}
+	array.SetSingle(true)
+	return array
+}
+
+func TestMathCall(t *testing.T) {
+	nan := math.NaN()
+	values := newValues(5, -1.25, nan, 4, 100)
+	cases := []struct {
+		name   string
+		result *collections.FloatArray
+		expect map[int]float64
+	}{
+		{
+			name:   "abs",
+			result: MathCall(Abs, values),
+			expect: map[int]float64{0: 1.25, 2: 4, 3: 100},
+		},
+		{
+			name:   "ceil",
+			result: MathCall(Ceil, values),
+			expect: map[int]float64{0: -1, 2: 4, 3: 100},
+		},
+		{
+			name:   "floor",
+			result: MathCall(Floor, values),
+			expect: map[int]float64{0: -2, 2: 4, 3: 100},
+		},
+		{
+			name:   "sqrt, drop NaN",
+			result: MathCall(Sqrt, values),
+			expect: map[int]float64{2: 2, 3: 10},
+		},
+		{
+			name:   "round",
+			result: MathCall(Round, newValues(3, 1.5, 2.345)),
+			expect: map[int]float64{0: 2, 1: 2},
+		},
+		{
+			name:   "round with decimal places",
+			result: MathCall(Round, newValues(3, 1.5, 2.345), newSingle(3, 2)),
+			expect: map[int]float64{0: 1.5, 1: 2.35},
+		},
+		{
+			name:   "log, drop Inf",
+			result: MathCall(Log, newValues(3, math.E, 0)),
+			expect: map[int]float64{0: 1},
+		},
+		{
+			name:   "log with base",
+			result: MathCall(Log, values, newSingle(5, 10)),
+			expect: map[int]float64{3: 2, 2: math.Log(4) / math.Log(10)},
+		},
+		{
+			name:   "pow",
+			result: MathCall(Pow, values, newSingle(5, 2)),
+			expect: map[int]float64{0: 1.5625, 2: 16, 3: 10000},
+		},
+		{
+			name:   "pow with series, drop empty argument",
+			result: MathCall(Pow, values, newValues(5, 2, 2, nan, 0.5)),
+			expect: map[int]float64{0: 1.5625, 3: 10},
+		},
+		{
+			name:   "clamp min",
+			result: MathCall(ClampMin, values, newSingle(5, 0)),
+			expect: map[int]float64{0: 0, 2: 4, 3: 100},
+		},
+		{
+			name:   "clamp max",
+			result: MathCall(ClampMax, values, newSingle(5, 10)),
+			expect: map[int]float64{0: -1.25, 2: 4, 3: 10},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, len(tt.expect), tt.result.Size())
+			for idx, val := range tt.expect {
+				assert.InDelta(t, val, tt.result.GetValue(idx), 1e-9, "slot %d", idx)
+			}
+		})
+	}
+}
+
+func TestMathCall_BadParams(t *testing.T) {
+	values := newValues(3, 1, 2)
+	assert.Nil(t, MathCall(Abs))
+	assert.Nil(t, MathCall(Abs, nil))
+	assert.Nil(t, MathCall(Abs, values, values))
+	assert.Nil(t, MathCall(Round, values, values, values))
+	assert.Nil(t, MathCall(Log, values, nil))
+	assert.Nil(t, MathCall(Pow, values))
+	assert.Nil(t, MathCall(Sum, values))
+}
+
+func TestIfCall(t *testing.T) {
+	nan := math.NaN()
+	cond := newValues(5, 1, 0, nan, 1, 0)
+	then := newValues(5, 10, 20, 30, nan, 50)
+	result := IfCall(cond, then, newSingle(5, -1))
+	assert.Equal(t, 3, result.Size())
+	assert.Equal(t, 10.0, result.GetValue(0))
+	assert.Equal(t, -1.0, result.GetValue(1))
+	assert.Equal(t, -1.0, result.GetValue(4))
+
+	assert.Nil(t, IfCall(cond, then))
+	assert.Nil(t, IfCall(cond, then, nil))
+}
@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import "sort"
+
+// MedianOfValues calculates the median of the distinct values with the number of occurrences of each value,
+// returns false if no values.
+func MedianOfValues(values []float64, counts []uint32) (float64, bool) {
+	if len(values) == 0 || len(values) != len(counts) {
+		return 0, false
+	}
+	indexes := make([]int, len(values))
+	total := uint64(0)
+	for idx := range values {
+		indexes[idx] = idx
+		total += uint64(counts[idx])
+	}
+	if total == 0 {
+		return 0, false
+	}
+	sort.Slice(indexes, func(i, j int) bool { return values[indexes[i]] < values[indexes[j]] })
+	// returns the value at the rank(0 based) of sorted values
+	valueAt := func(rank uint64) float64 {
+		for _, idx := range indexes {
+			if rank < uint64(counts[idx]) {
+				return values[idx]
+			}
+			rank -= uint64(counts[idx])
+		}
+		return values[indexes[len(indexes)-1]]
+	}
+	if total%2 == 1 {
+		return valueAt(total / 2), true
+	}
+	return (valueAt(total/2-1) + valueAt(total/2)) / 2, true
+}
@@ -0,0 +1,46 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedianOfValues(t *testing.T) {
+	_, ok := MedianOfValues(nil, nil)
+	assert.False(t, ok)
+	_, ok = MedianOfValues([]float64{1}, nil)
+	assert.False(t, ok)
+	_, ok = MedianOfValues([]float64{1}, []uint32{0})
+	assert.False(t, ok)
+
+	// values: 1,3,3,9 => (3+3)/2
+	median, ok := MedianOfValues([]float64{9, 3, 1}, []uint32{1, 2, 1})
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, median)
+	// values: 1,3,9 => 3
+	median, ok = MedianOfValues([]float64{9, 3, 1}, []uint32{1, 1, 1})
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, median)
+	// values: 1,9 => 5
+	median, ok = MedianOfValues([]float64{9, 1}, []uint32{1, 1})
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, median)
+}
@@ -0,0 +1,71 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func Test_VarianceCall(t *testing.T) {
+	assert.Nil(t, VarianceCall())
+
+	// values: 2,4,4,4,5,5,7,9 => mean 5, variance 4
+	sum := collections.NewFloatArray(10)
+	sum.SetValue(1, 40.0)
+	sum.SetValue(2, 10.0) // count is nil
+	sum.SetValue(3, 10.0) // count is 0
+	count := collections.NewFloatArray(10)
+	count.SetValue(1, 8.0)
+	count.SetValue(3, 0.0)
+	sumOfSquares := collections.NewFloatArray(10)
+	sumOfSquares.SetValue(1, 232.0)
+	sumOfSquares.SetValue(2, 100.0)
+	sumOfSquares.SetValue(3, 100.0)
+	assert.Nil(t, VarianceCall(sum, count))
+
+	result := VarianceCall(sum, count, sumOfSquares)
+	assert.Equal(t, 4.0, result.GetValue(1))
+	assert.False(t, result.HasValue(2))
+	assert.False(t, result.HasValue(3))
+
+	// same values => variance 0
+	sum.SetValue(4, 0.3)
+	count.SetValue(4, 3)
+	sumOfSquares.SetValue(4, 0.03)
+	result = VarianceCall(sum, count, sumOfSquares)
+	assert.True(t, result.GetValue(4) >= 0)
+	assert.True(t, math.Abs(result.GetValue(4)) < 1e-12)
+}
+
+func Test_StddevCall(t *testing.T) {
+	assert.Nil(t, StddevCall())
+
+	sum := collections.NewFloatArray(10)
+	sum.SetValue(1, 40.0)
+	count := collections.NewFloatArray(10)
+	count.SetValue(1, 8.0)
+	sumOfSquares := collections.NewFloatArray(10)
+	sumOfSquares.SetValue(1, 232.0)
+	result := StddevCall(sum, count, sumOfSquares)
+	assert.Equal(t, 2.0, result.GetValue(1))
+}
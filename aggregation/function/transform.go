@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// MovingAvgCall calculates the average of values in the moving window which ends with current slot,
+// window is the number of slots.
+func MovingAvgCall(window int, params ...*collections.FloatArray) *collections.FloatArray {
+	return movingWindowCall(window, params, func(sum float64, count int) float64 {
+		return sum / float64(count)
+	})
+}
+
+// MovingSumCall calculates the sum of values in the moving window which ends with current slot,
+// window is the number of slots.
+func MovingSumCall(window int, params ...*collections.FloatArray) *collections.FloatArray {
+	return movingWindowCall(window, params, func(sum float64, _ int) float64 {
+		return sum
+	})
+}
+
+// CumulativeSumCall calculates the running total of values.
+func CumulativeSumCall(params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	sum := 0.0
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		sum += val
+		result.SetValue(idx, sum)
+	}
+	return result
+}
+
+// EwmaCall calculates the exponentially weighted moving average of values,
+// alpha is the smoothing factor in (0,1], larger alpha discounts older values faster.
+func EwmaCall(alpha float64, params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil || alpha <= 0 || alpha > 1 {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	first := true
+	avg := 0.0
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if first {
+			avg = val
+			first = false
+		} else {
+			avg = alpha*val + (1-alpha)*avg
+		}
+		result.SetValue(idx, avg)
+	}
+	return result
+}
+
+// TimeShiftCall moves values forward by given slots, value of slot i is the value of slot i-slots.
+func TimeShiftCall(slots int, params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil || slots < 0 {
+		return nil
+	}
+	capacity := params[0].Capacity()
+	result := collections.NewFloatArray(capacity)
+	itr := params[0].NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if idx+slots >= capacity {
+			break
+		}
+		result.SetValue(idx+slots, val)
+	}
+	return result
+}
+
+// movingWindowCall calculates the value by the sum and count of values in the moving window,
+// only the slot which has value returns result.
+func movingWindowCall(window int, params []*collections.FloatArray, fn func(sum float64, count int) float64) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil || window <= 0 {
+		return nil
+	}
+	values := params[0]
+	result := collections.NewFloatArray(values.Capacity())
+	itr := values.NewIterator()
+	sum := 0.0
+	count := 0
+	// slots in window: (idx-window, idx]
+	var slots []int
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		for len(slots) > 0 && slots[0] <= idx-window {
+			sum -= values.GetValue(slots[0])
+			count--
+			slots = slots[1:]
+		}
+		slots = append(slots, idx)
+		sum += val
+		count++
+		result.SetValue(idx, fn(sum, count))
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func TestTransformFuncs_Empty(t *testing.T) {
+	values := collections.NewFloatArray(10)
+	assert.Nil(t, MovingAvgCall(3))
+	assert.Nil(t, MovingAvgCall(0, values))
+	assert.Nil(t, MovingSumCall(-1, values))
+	assert.Nil(t, CumulativeSumCall())
+	assert.Nil(t, EwmaCall(0.5))
+	assert.Nil(t, EwmaCall(0, values))
+	assert.Nil(t, EwmaCall(1.5, values))
+	assert.Nil(t, TimeShiftCall(1))
+	assert.Nil(t, TimeShiftCall(-1, values))
+}
+
+func TestTransformFuncs(t *testing.T) {
+	// slot 3 is empty
+	values := collections.NewFloatArray(6)
+	values.SetValue(0, 1)
+	values.SetValue(1, 2)
+	values.SetValue(2, 3)
+	values.SetValue(4, 5)
+	values.SetValue(5, 6)
+
+	cases := []struct {
+		name   string
+		result *collections.FloatArray
+		expect map[int]float64
+	}{
+		{
+			name:   "moving avg",
+			result: MovingAvgCall(3, values),
+			expect: map[int]float64{0: 1, 1: 1.5, 2: 2, 4: 4, 5: 5.5},
+		},
+		{
+			name:   "moving sum",
+			result: MovingSumCall(2, values),
+			expect: map[int]float64{0: 1, 1: 3, 2: 5, 4: 5, 5: 11},
+		},
+		{
+			name:   "cumulative sum",
+			result: CumulativeSumCall(values),
+			expect: map[int]float64{0: 1, 1: 3, 2: 6, 4: 11, 5: 17},
+		},
+		{
+			name:   "ewma",
+			result: EwmaCall(0.5, values),
+			expect: map[int]float64{0: 1, 1: 1.5, 2: 2.25, 4: 3.625, 5: 4.8125},
+		},
+		{
+			name:   "time shift",
+			result: TimeShiftCall(2, values),
+			expect: map[int]float64{2: 1, 3: 2, 4: 3},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, len(tt.expect), tt.result.Size())
+			for idx, val := range tt.expect {
+				assert.Equal(t, val, tt.result.GetValue(idx), "slot %d", idx)
+			}
+		})
+	}
+}
@@ -30,6 +30,35 @@ const (
 	Quantile
 	Stddev
 	Rate
+	Variance
+	FirstValue
+	Median
+	IRate
+	Increase
+	Derivative
+	Delta
+	MovingAvg
+	MovingSum
+	CumulativeSum
+	Ewma
+	TimeShift
+	Diff
+	Abs
+	Ceil
+	Floor
+	Round
+	Log
+	Pow
+	Sqrt
+	ClampMin
+	ClampMax
+	If
+	HistogramCount
+	HistogramSum
+	HistogramAvg
+	HistogramFraction
+	Heatmap
+	CountDistinct
 
 	Unknown
 )
@@ -55,7 +84,98 @@ func (t FuncType) String() string {
 		return "stddev"
 	case Rate:
 		return "rate"
+	case Variance:
+		return "variance"
+	case FirstValue:
+		return "first_value"
+	case Median:
+		return "median"
+	case IRate:
+		return "irate"
+	case Increase:
+		return "increase"
+	case Derivative:
+		return "derivative"
+	case Delta:
+		return "delta"
+	case MovingAvg:
+		return "moving_avg"
+	case MovingSum:
+		return "moving_sum"
+	case CumulativeSum:
+		return "cumulative_sum"
+	case Ewma:
+		return "ewma"
+	case TimeShift:
+		return "time_shift"
+	case Diff:
+		return "diff"
+	case Abs:
+		return "abs"
+	case Ceil:
+		return "ceil"
+	case Floor:
+		return "floor"
+	case Round:
+		return "round"
+	case Log:
+		return "log"
+	case Pow:
+		return "pow"
+	case Sqrt:
+		return "sqrt"
+	case ClampMin:
+		return "clamp_min"
+	case ClampMax:
+		return "clamp_max"
+	case If:
+		return "if"
+	case HistogramCount:
+		return "histogram_count"
+	case HistogramSum:
+		return "histogram_sum"
+	case HistogramAvg:
+		return "histogram_avg"
+	case HistogramFraction:
+		return "histogram_fraction"
+	case Heatmap:
+		return "heatmap"
+	case CountDistinct:
+		return "count_distinct"
 	default:
 		return "unknown"
 	}
 }
+
+// IsTransform returns if the function transforms the series of its first param(e.g. moving window, time shift),
+// the field in param uses default down sampling function.
+func (t FuncType) IsTransform() bool {
+	switch t {
+	case MovingAvg, MovingSum, CumulativeSum, Ewma, TimeShift, Diff:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsScalar returns if the function is applied on each data point of its params(e.g. abs(f), if(f > 0, f, 0)),
+// the field in params uses default down sampling function.
+func (t FuncType) IsScalar() bool {
+	switch t {
+	case Abs, Ceil, Floor, Round, Log, Pow, Sqrt, ClampMin, ClampMax, If:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsHistogram returns if the function is calculated by the histogram fields of metric(e.g. quantile, histogram_count),
+// the histogram fields are not referenced by params.
+func (t FuncType) IsHistogram() bool {
+	switch t {
+	case Quantile, Median, HistogramCount, HistogramSum, HistogramAvg, HistogramFraction, Heatmap:
+		return true
+	default:
+		return false
+	}
+}
@@ -33,5 +33,61 @@ func TestFuncTypeString(t *testing.T) {
 	assert.Equal(t, "quantile", Quantile.String())
 	assert.Equal(t, "stddev", Stddev.String())
 	assert.Equal(t, "rate", Rate.String())
+	assert.Equal(t, "variance", Variance.String())
+	assert.Equal(t, "first_value", FirstValue.String())
+	assert.Equal(t, "median", Median.String())
+	assert.Equal(t, "irate", IRate.String())
+	assert.Equal(t, "increase", Increase.String())
+	assert.Equal(t, "derivative", Derivative.String())
+	assert.Equal(t, "delta", Delta.String())
+	assert.Equal(t, "moving_avg", MovingAvg.String())
+	assert.Equal(t, "moving_sum", MovingSum.String())
+	assert.Equal(t, "cumulative_sum", CumulativeSum.String())
+	assert.Equal(t, "ewma", Ewma.String())
+	assert.Equal(t, "time_shift", TimeShift.String())
+	assert.Equal(t, "diff", Diff.String())
+	assert.Equal(t, "abs", Abs.String())
+	assert.Equal(t, "ceil", Ceil.String())
+	assert.Equal(t, "floor", Floor.String())
+	assert.Equal(t, "round", Round.String())
+	assert.Equal(t, "log", Log.String())
+	assert.Equal(t, "pow", Pow.String())
+	assert.Equal(t, "sqrt", Sqrt.String())
+	assert.Equal(t, "clamp_min", ClampMin.String())
+	assert.Equal(t, "clamp_max", ClampMax.String())
+	assert.Equal(t, "if", If.String())
+	assert.Equal(t, "histogram_count", HistogramCount.String())
+	assert.Equal(t, "histogram_sum", HistogramSum.String())
+	assert.Equal(t, "histogram_avg", HistogramAvg.String())
+	assert.Equal(t, "histogram_fraction", HistogramFraction.String())
+	assert.Equal(t, "heatmap", Heatmap.String())
+	assert.Equal(t, "count_distinct", CountDistinct.String())
 	assert.Equal(t, "unknown", Unknown.String())
 }
+
+func TestFuncType_IsTransform(t *testing.T) {
+	for _, funcType := range []FuncType{MovingAvg, MovingSum, CumulativeSum, Ewma, TimeShift, Diff} {
+		assert.True(t, funcType.IsTransform())
+	}
+	assert.False(t, Sum.IsTransform())
+	assert.False(t, Rate.IsTransform())
+}
+
+func TestFuncType_IsScalar(t *testing.T) {
+	for _, funcType := range []FuncType{Abs, Ceil, Floor, Round, Log, Pow, Sqrt, ClampMin, ClampMax, If} {
+		assert.True(t, funcType.IsScalar())
+		assert.False(t, funcType.IsTransform())
+	}
+	assert.False(t, Sum.IsScalar())
+	assert.False(t, MovingAvg.IsScalar())
+}
+
+func TestFuncType_IsHistogram(t *testing.T) {
+	for _, funcType := range []FuncType{Quantile, Median, HistogramCount, HistogramSum, HistogramAvg, HistogramFraction, Heatmap} {
+		assert.True(t, funcType.IsHistogram())
+		assert.False(t, funcType.IsScalar())
+		assert.False(t, funcType.IsTransform())
+	}
+	assert.False(t, Sum.IsHistogram())
+	assert.False(t, Abs.IsHistogram())
+}
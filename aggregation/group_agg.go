@@ -18,6 +18,8 @@
 package aggregation
 
 import (
+	"time"
+
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 )
@@ -37,6 +39,7 @@ type groupingAggregator struct {
 	interval      timeutil.Interval
 	intervalRatio int
 	timeRange     timeutil.TimeRange
+	location      *time.Location
 	aggregates    map[string]FieldAggregates // tag values => field aggregates
 }
 
@@ -45,6 +48,7 @@ func NewGroupingAggregator(
 	interval timeutil.Interval,
 	intervalRatio int,
 	timeRange timeutil.TimeRange,
+	location *time.Location,
 	aggSpecs AggregatorSpecs,
 ) GroupingAggregator {
 	return &groupingAggregator{
@@ -52,6 +56,7 @@ func NewGroupingAggregator(
 		interval:      interval,
 		intervalRatio: intervalRatio,
 		timeRange:     timeRange,
+		location:      location,
 		aggregates:    make(map[string]FieldAggregates),
 	}
 }
@@ -112,7 +117,7 @@ func (ga *groupingAggregator) getAggregator(tags string) (agg FieldAggregates) {
 	// get series aggregator
 	agg, ok := ga.aggregates[tags]
 	if !ok {
-		agg = NewFieldAggregates(ga.interval, ga.intervalRatio, ga.timeRange, ga.aggSpecs)
+		agg = NewFieldAggregates(ga.interval, ga.intervalRatio, ga.timeRange, ga.location, ga.aggSpecs)
 		ga.aggregates[tags] = agg
 	}
 	return
@@ -19,6 +19,7 @@ package aggregation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -93,6 +94,7 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 					Start: now,
 					End:   now + 3*timeutil.OneHour,
 				},
+				time.Local,
 				AggregatorSpecs{
 					NewAggregatorSpec("b", field.SumField),
 					NewAggregatorSpec("a", field.SumField),
@@ -114,6 +116,7 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 			Start: now,
 			End:   now + 3*timeutil.OneHour,
 		},
+		time.Local,
 		AggregatorSpecs{})
 	rs := agg.ResultSet()
 	assert.Nil(t, rs)
@@ -0,0 +1,194 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// EvalScalar evaluates the expression of having/order by clause based on the result of select items,
+// the values of result field are reduced to a scalar value:
+// 1. references result field by alias or select item(e.g. max(f)), reduces by the function of select item;
+// 2. aggregates result field by function(e.g. max(alias)), reduces by function.
+// Returns false if expression cannot be evaluated, such as result field not found.
+func EvalScalar(expr stmt.Expr, selectItems []stmt.Expr, resultSet map[string]*collections.FloatArray) (float64, bool) {
+	if expr == nil {
+		return 0, false
+	}
+	key := expr.Rewrite()
+	if values, ok := resultSet[key]; ok {
+		return reduce(reduceFuncType(key, expr, selectItems), values)
+	}
+	switch e := expr.(type) {
+	case *stmt.NumberLiteral:
+		return e.Val, true
+	case *stmt.ParenExpr:
+		return EvalScalar(e.Expr, selectItems, resultSet)
+	case *stmt.CallExpr:
+		if len(e.Params) != 1 {
+			return 0, false
+		}
+		values, ok := resultSet[e.Params[0].Rewrite()]
+		if !ok {
+			return 0, false
+		}
+		return reduce(e.FuncType, values)
+	case *stmt.BinaryExpr:
+		left, ok := EvalScalar(e.Left, selectItems, resultSet)
+		if !ok {
+			return 0, false
+		}
+		right, ok := EvalScalar(e.Right, selectItems, resultSet)
+		if !ok {
+			return 0, false
+		}
+		return binaryScalar(e.Operator, left, right)
+	default:
+		return 0, false
+	}
+}
+
+// reduceFuncType returns the function which reduces the values of result field,
+// the values of each time slot are calculated by the function of select item,
+// so they are reduced by same function(e.g. max(f) => max), values of sum/count are summed.
+// Other expressions are reduced by sum.
+func reduceFuncType(key string, expr stmt.Expr, selectItems []stmt.Expr) function.FuncType {
+	for _, item := range selectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if !ok {
+			continue
+		}
+		if (selectItem.Alias != "" && selectItem.Alias == key) || (selectItem.Alias == "" && selectItem.Rewrite() == key) {
+			expr = selectItem.Expr
+			break
+		}
+	}
+	for {
+		paren, ok := expr.(*stmt.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	callExpr, ok := expr.(*stmt.CallExpr)
+	if !ok {
+		return function.Sum
+	}
+	switch callExpr.FuncType {
+	case function.Max, function.Min, function.Avg, function.LastValue, function.FirstValue:
+		return callExpr.FuncType
+	default:
+		return function.Sum
+	}
+}
+
+// reduce reduces the values to a scalar value by function type.
+func reduce(funcType function.FuncType, values *collections.FloatArray) (float64, bool) {
+	if values == nil || values.IsEmpty() {
+		return 0, false
+	}
+	var (
+		sum, first, last float64
+		min              = math.Inf(1)
+		max              = math.Inf(-1)
+		count            = 0
+	)
+	it := values.NewIterator()
+	for it.HasNext() {
+		_, val := it.Next()
+		if math.IsNaN(val) {
+			continue
+		}
+		if count == 0 {
+			first = val
+		}
+		sum += val
+		last = val
+		min = math.Min(min, val)
+		max = math.Max(max, val)
+		count++
+	}
+	if count == 0 {
+		return 0, false
+	}
+	switch funcType {
+	case function.Sum:
+		return sum, true
+	case function.Min:
+		return min, true
+	case function.Max:
+		return max, true
+	case function.Count:
+		return float64(count), true
+	case function.Avg:
+		return sum / float64(count), true
+	case function.FirstValue:
+		return first, true
+	case function.LastValue:
+		return last, true
+	default:
+		return 0, false
+	}
+}
+
+// binaryScalar calculates the binary operation of two scalar values,
+// the result of compare/logical operation is 1(true) or 0(false).
+func binaryScalar(op stmt.BinaryOP, left, right float64) (float64, bool) {
+	switch op {
+	case stmt.ADD:
+		return left + right, true
+	case stmt.SUB:
+		return left - right, true
+	case stmt.MUL:
+		return left * right, true
+	case stmt.DIV:
+		if right == 0 {
+			return 0, false
+		}
+		return left / right, true
+	case stmt.EQUAL:
+		return boolToFloat(left == right), true
+	case stmt.NOTEQUAL:
+		return boolToFloat(left != right), true
+	case stmt.LESS:
+		return boolToFloat(left < right), true
+	case stmt.LESSEQUAL:
+		return boolToFloat(left <= right), true
+	case stmt.GREATER:
+		return boolToFloat(left > right), true
+	case stmt.GREATEREQUAL:
+		return boolToFloat(left >= right), true
+	case stmt.AND:
+		return boolToFloat(left != 0 && right != 0), true
+	case stmt.OR:
+		return boolToFloat(left != 0 || right != 0), true
+	default:
+		return 0, false
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,120 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestEvalScalar(t *testing.T) {
+	f := collections.NewFloatArray(5)
+	f.SetValue(0, 1)
+	f.SetValue(2, 4)
+	f.SetValue(4, 7)
+	nan := collections.NewFloatArray(5)
+	nan.SetValue(0, math.NaN())
+	resultSet := map[string]*collections.FloatArray{
+		"f":      f,
+		"max(f)": f,
+		"min(f)": f,
+		"avg(f)": f,
+		"last":   f,
+		"first":  f,
+		"total":  f,
+		"nan":    nan,
+		"empty":  collections.NewFloatArray(5),
+	}
+	call := func(funcType function.FuncType, name string) stmt.Expr {
+		return &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{&stmt.FieldExpr{Name: name}}}
+	}
+	binary := func(left stmt.Expr, op stmt.BinaryOP, right stmt.Expr) stmt.Expr {
+		return &stmt.BinaryExpr{Left: left, Operator: op, Right: right}
+	}
+	num := func(val float64) stmt.Expr {
+		return &stmt.NumberLiteral{Val: val}
+	}
+	selectItems := []stmt.Expr{
+		&stmt.SelectItem{Expr: call(function.Max, "f")},
+		&stmt.SelectItem{Expr: &stmt.ParenExpr{Expr: call(function.Min, "f")}},
+		&stmt.SelectItem{Expr: call(function.Avg, "f")},
+		&stmt.SelectItem{Expr: call(function.LastValue, "f"), Alias: "last"},
+		&stmt.SelectItem{Expr: call(function.FirstValue, "f"), Alias: "first"},
+		&stmt.SelectItem{Expr: call(function.Count, "f"), Alias: "total"},
+	}
+	cases := []struct {
+		name  string
+		expr  stmt.Expr
+		value float64
+		ok    bool
+	}{
+		{name: "nil expr", expr: nil},
+		{name: "field reduce by sum", expr: &stmt.FieldExpr{Name: "f"}, value: 12, ok: true},
+		{name: "select item reduce by max", expr: call(function.Max, "f"), value: 7, ok: true},
+		{name: "select item reduce by min", expr: call(function.Min, "f"), value: 1, ok: true},
+		{name: "select item reduce by avg", expr: call(function.Avg, "f"), value: 4, ok: true},
+		{name: "alias reduce by last", expr: &stmt.FieldExpr{Name: "last"}, value: 7, ok: true},
+		{name: "alias reduce by first", expr: &stmt.FieldExpr{Name: "first"}, value: 1, ok: true},
+		{name: "alias reduce by sum of count", expr: &stmt.FieldExpr{Name: "total"}, value: 12, ok: true},
+		{name: "field not found", expr: &stmt.FieldExpr{Name: "g"}},
+		{name: "empty field", expr: &stmt.FieldExpr{Name: "empty"}},
+		{name: "nan field", expr: &stmt.FieldExpr{Name: "nan"}},
+		{name: "number", expr: num(10), value: 10, ok: true},
+		{name: "paren", expr: &stmt.ParenExpr{Expr: num(10)}, value: 10, ok: true},
+		{name: "sum", expr: call(function.Sum, "f"), value: 12, ok: true},
+		{name: "min", expr: call(function.Min, "f"), value: 1, ok: true},
+		{name: "max", expr: call(function.Max, "max(f)"), value: 7, ok: true},
+		{name: "count", expr: call(function.Count, "f"), value: 3, ok: true},
+		{name: "avg", expr: call(function.Avg, "f"), value: 4, ok: true},
+		{name: "last", expr: call(function.LastValue, "f"), value: 7, ok: true},
+		{name: "not support func", expr: call(function.Stddev, "f")},
+		{name: "func field not found", expr: call(function.Sum, "g")},
+		{name: "func params not match", expr: &stmt.CallExpr{FuncType: function.Sum}},
+		{name: "add", expr: binary(call(function.Max, "f"), stmt.ADD, num(1)), value: 8, ok: true},
+		{name: "sub", expr: binary(num(3), stmt.SUB, num(1)), value: 2, ok: true},
+		{name: "mul", expr: binary(num(3), stmt.MUL, num(2)), value: 6, ok: true},
+		{name: "div", expr: binary(num(3), stmt.DIV, num(2)), value: 1.5, ok: true},
+		{name: "div zero", expr: binary(num(3), stmt.DIV, num(0))},
+		{name: "equal", expr: binary(num(3), stmt.EQUAL, num(3)), value: 1, ok: true},
+		{name: "not equal", expr: binary(num(3), stmt.NOTEQUAL, num(3)), value: 0, ok: true},
+		{name: "less", expr: binary(num(2), stmt.LESS, num(3)), value: 1, ok: true},
+		{name: "less equal", expr: binary(num(4), stmt.LESSEQUAL, num(3)), value: 0, ok: true},
+		{name: "greater", expr: binary(num(4), stmt.GREATER, num(3)), value: 1, ok: true},
+		{name: "greater equal", expr: binary(num(3), stmt.GREATEREQUAL, num(3)), value: 1, ok: true},
+		{name: "and", expr: binary(num(1), stmt.AND, num(0)), value: 0, ok: true},
+		{name: "or", expr: binary(num(1), stmt.OR, num(0)), value: 1, ok: true},
+		{name: "unknown operator", expr: binary(num(1), stmt.UNKNOWN, num(0))},
+		{name: "left not found", expr: binary(&stmt.FieldExpr{Name: "g"}, stmt.ADD, num(0))},
+		{name: "right not found", expr: binary(num(0), stmt.ADD, &stmt.FieldExpr{Name: "g"})},
+		{name: "unknown expr", expr: &stmt.EqualsExpr{Key: "k", Value: "v"}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := EvalScalar(tt.expr, selectItems, resultSet)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+}
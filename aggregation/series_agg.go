@@ -18,6 +18,8 @@
 package aggregation
 
 import (
+	"time"
+
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -40,17 +42,19 @@ func (agg FieldAggregates) Reset() {
 	}
 }
 
-// NewFieldAggregates creates the field aggregates based on aggregator specs and query time range.
+// NewFieldAggregates creates the field aggregates based on aggregator specs and query time range,
+// the time slots are aligned to the zone of given location.
 // NOTICE: if it does down sampling aggregator, aggregator specs must be in order by field id.
 func NewFieldAggregates(
 	queryInterval timeutil.Interval,
 	intervalRatio int,
 	queryTimeRange timeutil.TimeRange,
+	location *time.Location,
 	aggSpecs AggregatorSpecs,
 ) FieldAggregates {
 	aggregates := make(FieldAggregates, len(aggSpecs))
 	for idx, aggSpec := range aggSpecs {
-		aggregates[idx] = NewSeriesAggregator(queryInterval, intervalRatio, queryTimeRange, aggSpec)
+		aggregates[idx] = NewSeriesAggregator(queryInterval, intervalRatio, queryTimeRange, location, aggSpec)
 	}
 	return aggregates
 }
@@ -79,6 +83,7 @@ type seriesAggregator struct {
 	queryInterval  timeutil.Interval
 	queryTimeRange timeutil.TimeRange
 	intervalRatio  int
+	location       *time.Location
 
 	aggregates []FieldAggregator
 	aggSpec    AggregatorSpec
@@ -92,6 +97,7 @@ func NewSeriesAggregator(
 	queryInterval timeutil.Interval,
 	intervalRatio int,
 	queryTimeRange timeutil.TimeRange,
+	location *time.Location,
 	aggSpec AggregatorSpec,
 ) SeriesAggregator {
 	calc := queryInterval.Calculator()
@@ -108,6 +114,7 @@ func NewSeriesAggregator(
 		intervalRatio:  intervalRatio,
 		queryInterval:  queryInterval,
 		queryTimeRange: queryTimeRange,
+		location:       location,
 		aggSpec:        aggSpec,
 	}
 	if length > 0 {
@@ -156,7 +163,7 @@ func (a *seriesAggregator) GetAggregator(segmentStartTime int64) (agg FieldAggre
 	}
 	agg = a.aggregates[idx]
 	if agg == nil {
-		slotRange := a.queryInterval.CalcSlotRange(segmentStartTime, a.queryTimeRange)
+		slotRange := a.queryInterval.CalcSlotRangeInLocation(segmentStartTime, a.queryTimeRange, a.location)
 		agg = NewFieldAggregator(a.aggSpec, segmentStartTime, int(slotRange.Start), int(slotRange.End))
 		a.aggregates[idx] = agg
 	}
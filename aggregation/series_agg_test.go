@@ -19,6 +19,7 @@ package aggregation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -34,6 +35,7 @@ func TestNewFieldAggregates(t *testing.T) {
 			Start: 10,
 			End:   20,
 		},
+		time.Local,
 		AggregatorSpecs{
 			NewAggregatorSpec("b", field.SumField),
 			NewAggregatorSpec("a", field.SumField),
@@ -50,6 +52,7 @@ func TestNewFieldAggregates(t *testing.T) {
 			Start: 10,
 			End:   20,
 		},
+		time.Local,
 		AggregatorSpecs{
 			NewAggregatorSpec("a", field.SumField),
 			NewAggregatorSpec("b", field.SumField),
@@ -81,6 +84,7 @@ func TestNewSeriesAggregator(t *testing.T) {
 			Start: now,
 			End:   now + 3*timeutil.OneHour,
 		},
+		time.Local,
 		NewAggregatorSpec("b", field.SumField),
 	)
 
@@ -123,6 +127,7 @@ func TestNewSeriesAggregator(t *testing.T) {
 			Start: now,
 			End:   now - 3*timeutil.OneHour,
 		},
+		time.Local,
 		NewAggregatorSpec("b", field.SumField),
 	)
 	fAgg, ok = agg.GetAggregator(familyTime)
@@ -0,0 +1,137 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// MaxTimeShift returns the max shifted duration of time_shift functions in select items(aligned with interval),
+// query needs to read the data points which are shifted into query time range.
+func MaxTimeShift(selectItems []stmt.Expr, interval int64) int64 {
+	if interval <= 0 {
+		return 0
+	}
+	var max int64
+	for _, item := range selectItems {
+		if shift := timeShift(item); shift > max {
+			max = shift
+		}
+	}
+	return max / interval * interval
+}
+
+// timeShift returns the shifted duration of expr, nested time shift will be accumulated.
+func timeShift(expr stmt.Expr) int64 {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return timeShift(e.Expr)
+	case *stmt.ParenExpr:
+		return timeShift(e.Expr)
+	case *stmt.BinaryExpr:
+		left, right := timeShift(e.Left), timeShift(e.Right)
+		if left > right {
+			return left
+		}
+		return right
+	case *stmt.CallExpr:
+		var max int64
+		for _, param := range e.Params {
+			if shift := timeShift(param); shift > max {
+				max = shift
+			}
+		}
+		if e.FuncType == function.TimeShift && len(e.Params) == 2 {
+			if duration, ok := e.Params[1].(*stmt.DurationLiteral); ok && duration.Val > 0 {
+				max += duration.Val.Int64()
+			}
+		}
+		return max
+	default:
+		return 0
+	}
+}
+
+// transform transforms the series of first param by the transform function.
+func (e *Expression) transform(expr *stmt.CallExpr) []*collections.FloatArray {
+	if len(expr.Params) == 0 {
+		return nil
+	}
+	shiftSlots := 0
+	if expr.FuncType == function.TimeShift {
+		if len(expr.Params) != 2 {
+			return nil
+		}
+		duration, ok := expr.Params[1].(*stmt.DurationLiteral)
+		if !ok || duration.Val < 0 {
+			return nil
+		}
+		shiftSlots = int(duration.Val.Int64() / e.interval)
+	}
+	// field in param uses default down sampling values,
+	// and the data points before query time range are visible for time shift.
+	e.shifting += shiftSlots
+	values := e.eval(nil, expr.Params[0])
+	e.shifting -= shiftSlots
+	if len(values) != 1 {
+		return nil
+	}
+	var result *collections.FloatArray
+	switch expr.FuncType {
+	case function.CumulativeSum:
+		result = function.CumulativeSumCall(values...)
+	case function.Diff:
+		result = function.DeltaCall(values...)
+	case function.MovingAvg, function.MovingSum:
+		window, ok := numberParam(expr)
+		if !ok {
+			return nil
+		}
+		if expr.FuncType == function.MovingAvg {
+			result = function.MovingAvgCall(int(window), values...)
+		} else {
+			result = function.MovingSumCall(int(window), values...)
+		}
+	case function.Ewma:
+		alpha, ok := numberParam(expr)
+		if !ok {
+			return nil
+		}
+		result = function.EwmaCall(alpha, values...)
+	case function.TimeShift:
+		result = function.TimeShiftCall(shiftSlots, values...)
+	}
+	if result == nil {
+		return nil
+	}
+	return []*collections.FloatArray{result}
+}
+
+// numberParam returns the number value of second param, e.g. moving_avg(f, 5).
+func numberParam(expr *stmt.CallExpr) (float64, bool) {
+	if len(expr.Params) != 2 {
+		return 0, false
+	}
+	number, ok := expr.Params[1].(*stmt.NumberLiteral)
+	if !ok {
+		return 0, false
+	}
+	return number.Val, true
+}
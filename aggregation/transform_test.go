@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestMaxTimeShift(t *testing.T) {
+	cases := []struct {
+		sql      string
+		interval int64
+		shift    int64
+	}{
+		{sql: "select f from cpu", interval: timeutil.OneMinute, shift: 0},
+		{sql: "select time_shift(f, 1h) from cpu", interval: 0, shift: 0},
+		{sql: "select f, time_shift(f, 1h) from cpu", interval: timeutil.OneMinute, shift: timeutil.OneHour},
+		{sql: "select f-time_shift(f, 1d), time_shift(f, 1h) from cpu", interval: timeutil.OneMinute, shift: timeutil.OneDay},
+		{sql: "select (time_shift(time_shift(f, 1h), 1h)) from cpu", interval: timeutil.OneMinute, shift: 2 * timeutil.OneHour},
+		{sql: "select time_shift(f, 90s) from cpu", interval: timeutil.OneMinute, shift: timeutil.OneMinute},
+	}
+	for _, tt := range cases {
+		q, err := sql.Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.shift, MaxTimeShift(q.(*stmt.Query).SelectItems, tt.interval), tt.sql)
+	}
+}
+
+func TestExpression_Transform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f1", field.SumField)
+	aggSpec.AddFunctionType(function.Sum)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 100)
+	// 19:08~19:12, query time range starts with 19:10
+	for slot := 8; slot <= 12; slot++ {
+		agg.AggregateBySlot(slot, float64(slot))
+	}
+	startTime, it := agg.ResultSet()
+	series1 := series.NewMockIterator(ctrl)
+	series1.EXPECT().FieldType().Return(field.SumField)
+	series1.EXPECT().FieldName().Return(field.Name("f1"))
+	series1.EXPECT().HasNext().Return(true)
+	series1.EXPECT().Next().Return(startTime, it)
+	series1.EXPECT().HasNext().Return(false)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select f1,time_shift(f1, 2m),moving_sum(f1, 2),cumulative_sum(f1),diff(f1),ewma(f1, 1),moving_avg(f1) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	// moving_avg without window returns nothing
+	assert.Equal(t, 6, len(resultSet))
+	assert.Equal(t, 3, resultSet["f1"].Size())
+	assert.Equal(t, 10.0, resultSet["f1"].GetValue(0))
+	assert.Equal(t, 61, resultSet["f1"].Capacity())
+
+	shifted := resultSet["time_shift(f1,2m)"]
+	assert.Equal(t, 5, shifted.Size())
+	assert.Equal(t, 8.0, shifted.GetValue(0))
+	assert.Equal(t, 12.0, shifted.GetValue(4))
+	assert.Equal(t, 10.0, resultSet["moving_sum(f1,2.00)"].GetValue(0))
+	assert.Equal(t, 21.0, resultSet["moving_sum(f1,2.00)"].GetValue(1))
+	// data points before query time range only used by time shift
+	assert.Equal(t, 10.0+11, resultSet["cumulative_sum(f1)"].GetValue(1))
+	assert.False(t, resultSet["diff(f1)"].HasValue(0))
+	assert.Equal(t, 1.0, resultSet["diff(f1)"].GetValue(1))
+	assert.Equal(t, 12.0, resultSet["ewma(f1,1.00)"].GetValue(2))
+}
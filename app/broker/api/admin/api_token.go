@@ -0,0 +1,136 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	APITokenPath = "/api-token"
+)
+
+// APITokenAPI represents api token admin rest api.
+type APITokenAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger *logger.Logger
+}
+
+// NewAPITokenAPI creates api token api instance.
+func NewAPITokenAPI(deps *depspkg.HTTPDeps) *APITokenAPI {
+	return &APITokenAPI{
+		deps:   deps,
+		logger: logger.GetLogger("broker", "APITokenAPI"),
+	}
+}
+
+// Register adds api token admin url route.
+func (api *APITokenAPI) Register(route gin.IRoutes) {
+	route.GET(APITokenPath, api.List)
+	route.POST(APITokenPath, api.Create)
+	route.DELETE(APITokenPath, api.Revoke)
+}
+
+// List returns all api tokens without secret.
+func (api *APITokenAPI) List(c *gin.Context) {
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	data, err := api.deps.Repo.List(ctx, constants.APITokenConfigPath)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	tokens := models.APITokens{}
+	for _, val := range data {
+		token := models.APIToken{}
+		if err := encoding.JSONUnmarshal(val.Value, &token); err != nil {
+			api.logger.Warn("unmarshal api token error",
+				logger.String("key", val.Key))
+			continue
+		}
+		token.Secret = ""
+		tokens = append(tokens, token)
+	}
+	http.OK(c, tokens)
+}
+
+// Create creates a new api token, responses the token value which only can be seen once.
+func (api *APITokenAPI) Create(c *gin.Context) {
+	var param struct {
+		Name       string `json:"name" binding:"required"`
+		Database   string `json:"database" binding:"required"`
+		Namespace  string `json:"namespace"`
+		Permission string `json:"permission" binding:"required"`
+	}
+	err := c.ShouldBind(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	permission, err := models.ParsePermission(param.Permission)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	token, value, err := models.NewAPIToken(param.Name, param.Database, param.Namespace, permission)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	api.logger.Info("create api token",
+		logger.String("id", token.ID),
+		logger.String("name", token.Name),
+		logger.String("database", token.Database))
+	if err := api.deps.Repo.Put(ctx, constants.GetAPITokenConfigPath(token.ID), encoding.JSONMarshal(token)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	http.OK(c, gin.H{"id": token.ID, "token": value})
+}
+
+// Revoke revokes the api token by id.
+func (api *APITokenAPI) Revoke(c *gin.Context) {
+	var param struct {
+		ID string `form:"id" binding:"required"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	api.logger.Info("revoke api token", logger.String("id", param.ID))
+	if err := api.deps.Repo.Delete(ctx, constants.GetAPITokenConfigPath(param.ID)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	http.NoContent(c)
+}
@@ -0,0 +1,112 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestAPITokenAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	api := NewAPITokenAPI(&deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+
+	// list failure
+	repo.EXPECT().List(gomock.Any(), constants.APITokenConfigPath).Return(nil, fmt.Errorf("err"))
+	resp := mock.DoRequest(t, r, http.MethodGet, APITokenPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// list ok, hide secret
+	repo.EXPECT().List(gomock.Any(), constants.APITokenConfigPath).Return([]state.KeyValue{
+		{Key: "id", Value: []byte(`{"id":"id","name":"agent","secret":"hash","createTime":1}`)},
+		{Key: "err", Value: []byte("err")},
+	}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, APITokenPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `[{"id":"id","name":"agent","database":"","permission":"","createTime":1}]`, resp.Body.String())
+
+	// create, param invalid
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create, permission invalid
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db","permission":"select"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create, admin permission not allowed
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db","permission":"admin"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create, persist failure
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db","permission":"write"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create ok
+	var saved []byte
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+			saved = data
+			return nil
+		})
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db","namespace":"ns","permission":"write"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	rs := map[string]string{}
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rs))
+	token := models.APIToken{}
+	assert.NoError(t, encoding.JSONUnmarshal(saved, &token))
+	assert.Equal(t, rs["id"], token.ID)
+	id, secret, err := models.ParseAPIToken(rs["token"])
+	assert.NoError(t, err)
+	assert.Equal(t, token.ID, id)
+	assert.True(t, token.CheckSecret(secret))
+	assert.Equal(t, models.Grant{Database: "db", Namespace: "ns", Permission: models.WritePermission}, token.Grant())
+
+	// revoke, param invalid
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// revoke failure
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAPITokenConfigPath("id")).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=id", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// revoke ok
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAPITokenConfigPath("id")).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=id", "")
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
@@ -95,6 +96,18 @@ func suggest(ctx context.Context, deps *depspkg.HTTPDeps,
 			Type:   request.Type.String(),
 			Values: resultFields,
 		}, nil
+	case stmtpkg.MetricCardinality, stmtpkg.TagValueCardinality:
+		// series of metric/tag value are distributed in different nodes, sum them
+		return mergeCardinality(request, values, func(a, b uint64) uint64 { return a + b })
+	case stmtpkg.TagKeyCardinality:
+		// tag value ids are generated by each node, cannot compute the distinct count across nodes,
+		// so use max count of all nodes as lower bound
+		return mergeCardinality(request, values, func(a, b uint64) uint64 {
+			if a > b {
+				return a
+			}
+			return b
+		})
 	default:
 		return &models.Metadata{
 			Type:   request.Type.String(),
@@ -102,3 +115,35 @@ func suggest(ctx context.Context, deps *depspkg.HTTPDeps,
 		}, nil
 	}
 }
+
+// mergeCardinality merges the cardinality items of all nodes by name, returns top-N items sorted by count.
+func mergeCardinality(request *stmtpkg.MetricMetadata, values []string,
+	merge func(a, b uint64) uint64) (interface{}, error) {
+	merged := make(map[string]uint64)
+	for _, value := range values {
+		var items []models.CardinalityItem
+		if err := encoding.JSONUnmarshal([]byte(value), &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			merged[item.Name] = merge(merged[item.Name], item.Count)
+		}
+	}
+	result := make([]models.CardinalityItem, 0, len(merged))
+	for name, count := range merged {
+		result = append(result, models.CardinalityItem{Name: name, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count == result[j].Count {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Count > result[j].Count
+	})
+	if request.Type != stmtpkg.TagKeyCardinality && len(result) > request.Limit {
+		result = result[:request.Limit]
+	}
+	return &models.Metadata{
+		Type:   request.Type.String(),
+		Values: result,
+	}, nil
+}
@@ -20,6 +20,7 @@ package command
 import (
 	"context"
 	"net/url"
+	"sort"
 	"strings"
 	"sync"
 
@@ -32,7 +33,7 @@ import (
 
 // StateCommand executes the state query.
 func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
-	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
 	stateStmt := stmt.(*stmtpkg.State)
 	switch stateStmt.Type {
 	case stmtpkg.Master:
@@ -43,6 +44,8 @@ func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
 		return deps.StateMgr.GetStorageList(), nil
 	case stmtpkg.Replication:
 		return getReplicaState(deps, stateStmt)
+	case stmtpkg.Limit:
+		return getLimitState(deps, param, stateStmt)
 	case stmtpkg.BrokerMetric:
 		liveNodes := deps.StateMgr.GetLiveNodes()
 		var nodes []models.Node
@@ -86,6 +89,98 @@ func getReplicaState(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{},
 	return fetchStateData(nodes, stmt)
 }
 
+// getLimitState returns the series usage against each per shard limit of database's shards,
+// database uses current database if not set in statement.
+func getLimitState(deps *depspkg.HTTPDeps, param *models.ExecuteParam, stmt *stmtpkg.State) (interface{}, error) {
+	database := strings.TrimSpace(stmt.Database)
+	if database == "" {
+		database = strings.TrimSpace(param.Database)
+	}
+	if database == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(database)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, nil
+	}
+	liveNodes := storage.LiveNodes
+	var nodes []models.Node
+	for id := range liveNodes {
+		n := liveNodes[id]
+		nodes = append(nodes, &n)
+	}
+	return fetchLimitData(nodes, database)
+}
+
+// fetchLimitData fetches the series limit usage from each live nodes,
+// merges the usage of replicas for same shard(keeps max usage).
+func fetchLimitData(nodes []models.Node, database string) (interface{}, error) {
+	size := len(nodes)
+	if size == 0 {
+		return nil, nil
+	}
+	result := make([]models.LimitUsages, size)
+	var wait sync.WaitGroup
+	wait.Add(size)
+	for idx := range nodes {
+		i := idx
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			address := node.HTTPAddress()
+			var state models.LimitUsages
+			_, err := NewRestyFn().R().SetQueryParams(map[string]string{"db": database}).
+				SetHeader("Accept", "application/json").
+				SetResult(&state).
+				Get(address + "/api/state/limit")
+			if err != nil {
+				log.Error("get series limit state from storage node", logger.String("url", address), logger.Error(err))
+				return
+			}
+			result[i] = state
+		}()
+	}
+	wait.Wait()
+	shards := make(map[models.ShardID]*models.ShardLimitUsage)
+	for _, state := range result {
+		for idx := range state {
+			shardState := state[idx]
+			shard, ok := shards[shardState.ShardID]
+			if !ok {
+				shards[shardState.ShardID] = &shardState
+				continue
+			}
+			for _, limit := range shardState.Limits {
+				merged := false
+				for j := range shard.Limits {
+					if shard.Limits[j].Scope == limit.Scope && shard.Limits[j].Name == limit.Name {
+						if limit.Usage > shard.Limits[j].Usage {
+							shard.Limits[j].Usage = limit.Usage
+						}
+						merged = true
+						break
+					}
+				}
+				if !merged {
+					shard.Limits = append(shard.Limits, limit)
+				}
+			}
+		}
+	}
+	rs := make(models.LimitUsages, 0, len(shards))
+	for _, shard := range shards {
+		rs = append(rs, *shard)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].ShardID < rs[j].ShardID
+	})
+	return rs, nil
+}
+
 // fetchStateData fetches the state metric from each live nodes.
 func fetchStateData(nodes []models.Node, stmt *stmtpkg.State) (interface{}, error) {
 	size := len(nodes)
@@ -0,0 +1,267 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// userCommandFn represents user command function define.
+type userCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error)
+
+// userCommands registers all user/role/grant related commands.
+var userCommands = map[stmtpkg.UserType]userCommandFn{
+	stmtpkg.CreateUserType: createUser,
+	stmtpkg.DropUserType:   dropUser,
+	stmtpkg.ShowUsersType:  listUsers,
+	stmtpkg.CreateRoleType: createRole,
+	stmtpkg.DropRoleType:   dropRole,
+	stmtpkg.ShowRolesType:  listRoles,
+	stmtpkg.GrantType:      grant,
+	stmtpkg.RevokeType:     revoke,
+}
+
+// UserCommand executes lin query language for user/role/grant related.
+func UserCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	userStmt := stmt.(*stmtpkg.User)
+	commandFn, ok := userCommands[userStmt.Type]
+	if ok {
+		return commandFn(ctx, deps, userStmt)
+	}
+	return nil, nil
+}
+
+// createUser creates a new user with password.
+func createUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if stmt.UserName == "" || stmt.Password == "" {
+		return nil, errors.New("user name/password cannot be empty")
+	}
+	if stmt.UserName == deps.BrokerCfg.BrokerBase.User.UserName {
+		return nil, fmt.Errorf("user[%s] is reserved", stmt.UserName)
+	}
+	if _, err := deps.Repo.Get(ctx, constants.GetUserConfigPath(stmt.UserName)); err == nil {
+		return nil, fmt.Errorf("user[%s] already exists", stmt.UserName)
+	} else if !errors.Is(err, state.ErrNotExist) {
+		return nil, err
+	}
+	user, err := models.NewUser(stmt.UserName, stmt.Password)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("create user", logger.String("name", stmt.UserName))
+	if err := saveUser(ctx, deps, user); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Create user[%s] ok", stmt.UserName)
+	return &rs, nil
+}
+
+// dropUser drops the user.
+func dropUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	log.Info("drop user", logger.String("name", stmt.UserName))
+	if err := deps.Repo.Delete(ctx, constants.GetUserConfigPath(stmt.UserName)); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Drop user[%s] ok", stmt.UserName)
+	return &rs, nil
+}
+
+// listUsers returns all users without password.
+func listUsers(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.User) (interface{}, error) {
+	data, err := deps.Repo.List(ctx, constants.UserConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	var users models.Users
+	for _, val := range data {
+		user := models.User{}
+		if err := encoding.JSONUnmarshal(val.Value, &user); err != nil {
+			log.Warn("unmarshal data error",
+				logger.String("key", val.Key))
+			continue
+		}
+		user.Password = ""
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// createRole creates a new role.
+func createRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if stmt.RoleName == "" {
+		return nil, errors.New("role name cannot be empty")
+	}
+	if _, err := deps.Repo.Get(ctx, constants.GetRoleConfigPath(stmt.RoleName)); err == nil {
+		return nil, fmt.Errorf("role[%s] already exists", stmt.RoleName)
+	} else if !errors.Is(err, state.ErrNotExist) {
+		return nil, err
+	}
+	log.Info("create role", logger.String("name", stmt.RoleName))
+	if err := saveRole(ctx, deps, &models.Role{Name: stmt.RoleName}); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Create role[%s] ok", stmt.RoleName)
+	return &rs, nil
+}
+
+// dropRole drops the role.
+func dropRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	log.Info("drop role", logger.String("name", stmt.RoleName))
+	if err := deps.Repo.Delete(ctx, constants.GetRoleConfigPath(stmt.RoleName)); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Drop role[%s] ok", stmt.RoleName)
+	return &rs, nil
+}
+
+// listRoles returns all roles.
+func listRoles(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.User) (interface{}, error) {
+	data, err := deps.Repo.List(ctx, constants.RoleConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	var roles models.Roles
+	for _, val := range data {
+		role := models.Role{}
+		if err := encoding.JSONUnmarshal(val.Value, &role); err != nil {
+			log.Warn("unmarshal data error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// grant grants permission to user/role, or grants role to user.
+func grant(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if err := modifyGrant(ctx, deps, stmt, true); err != nil {
+		return nil, err
+	}
+	rs := "Grant ok"
+	return &rs, nil
+}
+
+// revoke revokes permission from user/role, or revokes role from user.
+func revoke(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if err := modifyGrant(ctx, deps, stmt, false); err != nil {
+		return nil, err
+	}
+	rs := "Revoke ok"
+	return &rs, nil
+}
+
+// modifyGrant adds/removes the grant of user/role based on statement.
+func modifyGrant(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User, add bool) error {
+	log.Info("modify grant", logger.Any("stmt", stmt), logger.Any("add", add))
+	if stmt.Permission == "" {
+		// grant/revoke role to/from user
+		user, err := getUser(ctx, deps, stmt.UserName)
+		if err != nil {
+			return err
+		}
+		if add {
+			if _, err := getRole(ctx, deps, stmt.RoleName); err != nil {
+				return err
+			}
+			user.AddRole(stmt.RoleName)
+		} else {
+			user.RemoveRole(stmt.RoleName)
+		}
+		return saveUser(ctx, deps, user)
+	}
+	permission, err := models.ParsePermission(stmt.Permission)
+	if err != nil {
+		return err
+	}
+	g := models.Grant{Database: stmt.Database, Namespace: stmt.Namespace, Permission: permission}
+	modify := func(grants models.Grants) models.Grants {
+		if add {
+			return grants.Add(g)
+		}
+		return grants.Remove(g)
+	}
+	if stmt.UserName != "" {
+		// grant/revoke permission to/from user
+		user, err := getUser(ctx, deps, stmt.UserName)
+		if err != nil {
+			return err
+		}
+		user.Grants = modify(user.Grants)
+		return saveUser(ctx, deps, user)
+	}
+	// grant/revoke permission to/from role
+	role, err := getRole(ctx, deps, stmt.RoleName)
+	if err != nil {
+		return err
+	}
+	role.Grants = modify(role.Grants)
+	return saveRole(ctx, deps, role)
+}
+
+// getUser returns the user from state repo.
+func getUser(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.User, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetUserConfigPath(name))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", constants.ErrUserNotFound, name)
+		}
+		return nil, err
+	}
+	user := &models.User{}
+	if err := encoding.JSONUnmarshal(data, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// getRole returns the role from state repo.
+func getRole(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.Role, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetRoleConfigPath(name))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", constants.ErrRoleNotFound, name)
+		}
+		return nil, err
+	}
+	role := &models.Role{}
+	if err := encoding.JSONUnmarshal(data, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// saveUser saves the user into state repo.
+func saveUser(ctx context.Context, deps *depspkg.HTTPDeps, user *models.User) error {
+	return deps.Repo.Put(ctx, constants.GetUserConfigPath(user.Name), encoding.JSONMarshal(user))
+}
+
+// saveRole saves the role into state repo.
+func saveRole(ctx context.Context, deps *depspkg.HTTPDeps, role *models.Role) error {
+	return deps.Repo.Put(ctx, constants.GetRoleConfigPath(role.Name), encoding.JSONMarshal(role))
+}
@@ -26,8 +26,10 @@ import (
 
 	"github.com/lindb/lindb/app/broker/api/exec/command"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 	sqlpkg "github.com/lindb/lindb/sql"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -56,6 +58,9 @@ var (
 		stmtpkg.StateStatement:          command.StateCommand,
 		stmtpkg.MetricMetadataStatement: command.MetricMetadataCommand,
 		stmtpkg.QueryStatement:          command.QueryCommand,
+		stmtpkg.DeleteStatement:         command.DeleteCommand,
+		stmtpkg.UserStatement:           command.UserCommand,
+		stmtpkg.MetricSchemaStatement:   command.MetricSchemaCommand,
 	}
 )
 
@@ -88,6 +93,10 @@ func (e *ExecuteAPI) Execute(c *gin.Context) {
 	if err := e.deps.QueryLimiter.Do(func() error {
 		return e.execute(c)
 	}); err != nil {
+		if errors.Is(err, constants.ErrForbidden) {
+			httppkg.Forbidden(c, err)
+			return
+		}
 		httppkg.Error(c, err)
 	}
 }
@@ -111,6 +120,9 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	if !ok {
 		return errors.New("can't parse lin query language")
 	}
+	if err := authorize(c, &param, stmt); err != nil {
+		return err
+	}
 	result, err := commandFn(ctx, e.deps, &param, stmt)
 	if err != nil {
 		return err
@@ -122,3 +134,49 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 	return nil
 }
+
+// authorize checks if current user has the permission to execute the statement.
+func authorize(c *gin.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) error {
+	switch s := stmt.(type) {
+	case *stmtpkg.Query:
+		return middleware.Authorize(c, param.Database, getNamespace(s.Namespace), models.ReadPermission)
+	case *stmtpkg.MetricMetadata:
+		return middleware.Authorize(c, param.Database, getNamespace(s.Namespace), models.ReadPermission)
+	case *stmtpkg.Delete:
+		// delete series removes existing data, write permission is not enough
+		return middleware.Authorize(c, param.Database, getNamespace(s.Namespace), models.AdminPermission)
+	case *stmtpkg.MetricSchema:
+		return middleware.Authorize(c, param.Database, getNamespace(s.Namespace), models.AdminPermission)
+	case *stmtpkg.State:
+		if s.Type == stmtpkg.Limit {
+			// show limits of database only need read permission
+			database := s.Database
+			if database == "" {
+				database = param.Database
+			}
+			return middleware.Authorize(c, database, "", models.ReadPermission)
+		}
+		return middleware.Authorize(c, "", "", models.AdminPermission)
+	case *stmtpkg.Schema:
+		switch s.Type {
+		case stmtpkg.DropDatabaseSchemaType:
+			return middleware.Authorize(c, s.Value, "", models.AdminPermission)
+		case stmtpkg.CreateDatabaseSchemaType:
+			return middleware.Authorize(c, "", "", models.AdminPermission)
+		default:
+			// show databases only need authentication
+			return nil
+		}
+	default:
+		// storage/state/metadata/user statements are cluster level
+		return middleware.Authorize(c, "", "", models.AdminPermission)
+	}
+}
+
+// getNamespace returns namespace, if empty returns default namespace.
+func getNamespace(namespace string) string {
+	if namespace == "" {
+		return constants.DefaultNamespace
+	}
+	return namespace
+}
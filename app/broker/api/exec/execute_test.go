@@ -37,6 +37,7 @@ import (
 	"github.com/lindb/lindb/app/broker/api/exec/command"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
@@ -47,6 +48,7 @@ import (
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
@@ -79,6 +81,7 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		QueryFactory: queryFactory,
 		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
 			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			User: config.User{UserName: "admin"},
 		}},
 		QueryLimiter: concurrent.NewLimiter(
 			context.TODO(),
@@ -164,6 +167,81 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "database name cannot be empty when delete series",
+			reqBody: `{"sql":"delete from cpu where host='1.1.1.1'"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series failure",
+			reqBody: `{"sql":"delete from cpu where host='1.1.1.1'","db":"test"}`,
+			prepare: func() {
+				deleteQuery := brokerQuery.NewMockDeleteQuery(ctrl)
+				queryFactory.EXPECT().NewDeleteQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(deleteQuery)
+				deleteQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series successfully",
+			reqBody: `{"sql":"delete from cpu where host='1.1.1.1'","db":"test"}`,
+			prepare: func() {
+				deleteQuery := brokerQuery.NewMockDeleteQuery(ctrl)
+				queryFactory.EXPECT().NewDeleteQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(deleteQuery)
+				deleteQuery.EXPECT().WaitResponse().Return(&models.DeleteResult{DeletedSeries: 1}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "database name cannot be empty when drop metric",
+			reqBody: `{"sql":"drop metric cpu"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "alter field with unknown field type",
+			reqBody: `{"sql":"alter metric cpu alter field f type abc","db":"test"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop metric failure",
+			reqBody: `{"sql":"drop metric cpu","db":"test"}`,
+			prepare: func() {
+				schemaQuery := brokerQuery.NewMockSchemaQuery(ctrl)
+				queryFactory.EXPECT().NewSchemaQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(schemaQuery)
+				schemaQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "rename field successfully",
+			reqBody: `{"sql":"alter metric cpu on ns rename field f to f1","db":"test"}`,
+			prepare: func() {
+				schemaQuery := brokerQuery.NewMockSchemaQuery(ctrl)
+				queryFactory.EXPECT().NewSchemaQuery(gomock.Any(), "test", &stmtpkg.MetricSchema{
+					Type:         stmtpkg.RenameFieldType,
+					Namespace:    "ns",
+					MetricName:   "cpu",
+					FieldName:    "f",
+					NewFieldName: "f1",
+				}).Return(schemaQuery)
+				schemaQuery.EXPECT().WaitResponse().Return(&models.DeleteResult{}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{
 			name:    "get database list err",
 			reqBody: `{"sql":"show databases"}`,
@@ -379,6 +457,51 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "show cardinality, bad data",
+			reqBody: `{"sql":"show metric cardinality","db":"db"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return([]string{"ns"}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show metric cardinality successfully",
+			reqBody: `{"sql":"show metric cardinality limit 1","db":"db"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return([]string{
+					string(encoding.JSONMarshal([]models.CardinalityItem{{Name: "cpu", Count: 2}, {Name: "mem", Count: 3}})),
+					string(encoding.JSONMarshal([]models.CardinalityItem{{Name: "cpu", Count: 2}})),
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, `{"type":"metricCardinality","values":[{"name":"cpu","count":4}]}`, resp.Body.String())
+			},
+		},
+		{
+			name:    "show tag key cardinality successfully",
+			reqBody: `{"sql":"show tag key cardinality from cpu","db":"db"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return([]string{
+					string(encoding.JSONMarshal([]models.CardinalityItem{{Name: "host", Count: 2}, {Name: "zone", Count: 3}})),
+					string(encoding.JSONMarshal([]models.CardinalityItem{{Name: "host", Count: 5}})),
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, `{"type":"tagKeyCardinality","values":[{"name":"host","count":5},{"name":"zone","count":3}]}`,
+					resp.Body.String())
+			},
+		},
 		{
 			name:    "unknown storage op type",
 			reqBody: `{"sql":"show storages"}`,
@@ -632,6 +755,83 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "show limits, database name empty",
+			reqBody: `{"sql":"show limits"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show limits, database not found",
+			reqBody: `{"sql":"show limits","db":"test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show limits, storage not found",
+			reqBody: `{"sql":"show limits where database=test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(nil, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, resp.Code)
+			},
+		},
+		{
+			name:    "show limits, alive node empty",
+			reqBody: `{"sql":"show limits","db":"test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(&models.StorageState{}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, resp.Code)
+			},
+		},
+		{
+			name:    "show limits successfully",
+			reqBody: `{"sql":"show limits","db":"test"}`,
+			prepare: func() {
+				usage := 5
+				svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Add("content-type", "application/json")
+					_, _ = w.Write([]byte(fmt.Sprintf(`[{"shardId":2,"limits":[{"scope":"database","name":"test","usage":%d}]},`+
+						`{"shardId":1,"limits":[{"scope":"metric","name":"ns/cpu","limit":10,"usage":%d}]}]`, usage, usage)))
+					usage++
+				}))
+				u, err := url.Parse(svr.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+						StatelessNode: models.StatelessNode{
+							HostIP:   u.Hostname(),
+							HTTPPort: uint16(p),
+						},
+						ID: 1,
+					}, 2: {
+						StatelessNode: models.StatelessNode{
+							HostIP:   u.Hostname(),
+							HTTPPort: uint16(p),
+						},
+						ID: 2,
+					}}}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				// keep max usage of replicas, order by shard id
+				assert.Equal(t, `[{"shardId":1,"limits":[{"scope":"metric","name":"ns/cpu","limit":10,"usage":6}]},`+
+					`{"shardId":2,"limits":[{"scope":"database","name":"test","limit":0,"usage":6}]}]`, resp.Body.String())
+			},
+		},
 		{
 			name:    "show broker metric, no alive node",
 			reqBody: `{"sql":"show broker metric where metric in (a,b)"}`,
@@ -1022,6 +1222,355 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "create user, user name is reserved",
+			reqBody: `{"sql":"create user admin with password 'admin'"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, user already exists",
+			reqBody: `{"sql":"create user test with password 'test@123'"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return([]byte("{}"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, get user failure",
+			reqBody: `{"sql":"create user test with password 'test@123'"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, persist failure",
+			reqBody: `{"sql":"create user test with password 'test@123'"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user successfully",
+			reqBody: `{"sql":"create user test with password 'test@123'"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserConfigPath("test"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						user := models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, &user))
+						assert.True(t, user.CheckPassword("test@123"))
+						return nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "drop user failure",
+			reqBody: `{"sql":"drop user test"}`,
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop user successfully",
+			reqBody: `{"sql":"drop user test"}`,
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), constants.GetUserConfigPath("test")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show users failure",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show users successfully",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.UserConfigPath).Return([]state.KeyValue{
+					{Key: "test", Value: []byte(`{"name":"test","password":"hash"}`)},
+					{Key: "err", Value: []byte("err")},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, `[{"name":"test"}]`, resp.Body.String())
+			},
+		},
+		{
+			name:    "create role, role already exists",
+			reqBody: `{"sql":"create role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRoleConfigPath("dev")).Return([]byte("{}"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create role, get role failure",
+			reqBody: `{"sql":"create role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create role, persist failure",
+			reqBody: `{"sql":"create role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create role successfully",
+			reqBody: `{"sql":"create role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), constants.GetRoleConfigPath("dev"), []byte(`{"name":"dev"}`)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "drop role failure",
+			reqBody: `{"sql":"drop role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop role successfully",
+			reqBody: `{"sql":"drop role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), constants.GetRoleConfigPath("dev")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "show roles failure",
+			reqBody: `{"sql":"show roles"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show roles successfully",
+			reqBody: `{"sql":"show roles"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.RoleConfigPath).Return([]state.KeyValue{
+					{Key: "dev", Value: []byte(`{"name":"dev"}`)},
+					{Key: "err", Value: []byte("err")},
+				}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, `[{"name":"dev"}]`, resp.Body.String())
+			},
+		},
+		{
+			name:    "grant permission, user not exist",
+			reqBody: `{"sql":"grant read on db to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission, get user failure",
+			reqBody: `{"sql":"grant read on db to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission, unmarshal user failure",
+			reqBody: `{"sql":"grant read on db to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("err"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission to user successfully",
+			reqBody: `{"sql":"grant read on db namespace ns to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return([]byte(`{"name":"test"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserConfigPath("test"),
+					[]byte(`{"name":"test","grants":[{"database":"db","namespace":"ns","permission":"read"}]}`)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke permission from user successfully",
+			reqBody: `{"sql":"revoke read on db namespace ns from user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).
+					Return([]byte(`{"name":"test","grants":[{"database":"db","namespace":"ns","permission":"read"}]}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserConfigPath("test"), []byte(`{"name":"test"}`)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission, role not exist",
+			reqBody: `{"sql":"grant write on * to role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission, get role failure",
+			reqBody: `{"sql":"grant write on * to role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission, unmarshal role failure",
+			reqBody: `{"sql":"grant write on * to role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("err"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant permission to role successfully",
+			reqBody: `{"sql":"grant write on * to role dev"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRoleConfigPath("dev")).Return([]byte(`{"name":"dev"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetRoleConfigPath("dev"),
+					[]byte(`{"name":"dev","grants":[{"database":"*","permission":"write"}]}`)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "grant role to user, user not exist",
+			reqBody: `{"sql":"grant role dev to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant role to user, role not exist",
+			reqBody: `{"sql":"grant role dev to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return([]byte(`{"name":"test"}`), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRoleConfigPath("dev")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant role to user successfully",
+			reqBody: `{"sql":"grant role dev to user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return([]byte(`{"name":"test"}`), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRoleConfigPath("dev")).Return([]byte(`{"name":"dev"}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserConfigPath("test"),
+					[]byte(`{"name":"test","roles":["dev"]}`)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke role from user failure",
+			reqBody: `{"sql":"revoke role dev from user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return([]byte(`{"name":"test","roles":["dev"]}`), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "revoke role from user successfully",
+			reqBody: `{"sql":"revoke role dev from user test"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserConfigPath("test")).Return([]byte(`{"name":"test","roles":["dev"]}`), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetUserConfigPath("test"), []byte(`{"name":"test"}`)).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "unknown user statement",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
+					return &stmtpkg.User{}, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, resp.Code)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -1047,3 +1596,77 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteAPI_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	admin := config.User{UserName: "admin", Password: "admin123"}
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetUser("test").Return(models.User{Name: "test", Grants: models.Grants{
+		{Database: "db", Permission: models.ReadPermission},
+		{Database: "db2", Permission: models.AdminPermission},
+		{Database: "db4", Permission: models.WritePermission},
+	}}, true).AnyTimes()
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx:      context.Background(),
+		StateMgr: stateMgr,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			User: admin,
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	r.Use(middleware.NewAuthentication(admin, []byte("secret"), stateMgr).Validate())
+	api.Register(r)
+	token, err := middleware.CreateToken([]byte("secret"), "test")
+	assert.NoError(t, err)
+
+	defer func() {
+		sqlParseFn = sql.Parse
+	}()
+	cases := []struct {
+		name string
+		stmt stmtpkg.Statement
+		db   string
+	}{
+		{name: "query without permission", stmt: &stmtpkg.Query{}, db: "db3"},
+		{name: "metric metadata without permission", stmt: &stmtpkg.MetricMetadata{Namespace: "ns"}, db: "db3"},
+		{name: "delete series without write permission", stmt: &stmtpkg.Delete{}, db: "db"},
+		{name: "delete series without admin permission", stmt: &stmtpkg.Delete{}, db: "db4"},
+		{name: "drop metric without admin permission", stmt: &stmtpkg.MetricSchema{Type: stmtpkg.DropMetricType}, db: "db"},
+		{name: "drop database without permission", stmt: &stmtpkg.Schema{Type: stmtpkg.DropDatabaseSchemaType, Value: "db"}},
+		{name: "create database without permission", stmt: &stmtpkg.Schema{Type: stmtpkg.CreateDatabaseSchemaType}},
+		{name: "create user without permission", stmt: &stmtpkg.User{Type: stmtpkg.CreateUserType}},
+		{name: "show storages without permission", stmt: &stmtpkg.Storage{Type: stmtpkg.StorageOpShow}},
+		{name: "show replication without permission", stmt: &stmtpkg.State{Type: stmtpkg.Replication, Database: "db2"}},
+		{name: "show limits without permission", stmt: &stmtpkg.State{Type: stmtpkg.Limit}, db: "db3"},
+		{name: "show limits of other database without permission", stmt: &stmtpkg.State{Type: stmtpkg.Limit, Database: "db3"}, db: "db"},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+				return tt.stmt, nil
+			}
+			req, err := http.NewRequest(http.MethodPut, ExecutePath,
+				strings.NewReader(`{"sql":"select","db":"`+tt.db+`"}`))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			assert.Equal(t, http.StatusForbidden, resp.Code)
+		})
+	}
+
+	c, _ := gin.CreateTestContext(nil)
+	assert.NoError(t, authorize(c, &models.ExecuteParam{}, &stmtpkg.Schema{Type: stmtpkg.DatabaseSchemaType}))
+	assert.NoError(t, authorize(c, &models.ExecuteParam{}, &stmtpkg.User{}))
+}
@@ -0,0 +1,617 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/influxql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	influxqlParseFn = influxql.Parse
+)
+
+var (
+	// QueryPath represents influxdb query api's path.
+	QueryPath = "/influx/query"
+)
+
+// QueryParam represents influxdb query api's param.
+type QueryParam struct {
+	Database  string `form:"db"`
+	Namespace string `form:"ns"`
+	Query     string `form:"q" binding:"required"`
+	Epoch     string `form:"epoch"`
+}
+
+// QueryAPI represents influxdb compatible query api,
+// executes InfluxQL statements based on lin query execution pipeline.
+type QueryAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewQueryAPI creates influxdb compatible query api.
+func NewQueryAPI(deps *depspkg.HTTPDeps) *QueryAPI {
+	return &QueryAPI{
+		deps:   deps,
+		logger: logger.GetLogger("broker", "InfluxQueryAPI"),
+	}
+}
+
+// Register adds influxdb query api's path.
+func (api *QueryAPI) Register(route gin.IRoutes) {
+	route.GET(QueryPath, api.Query)
+	route.POST(QueryPath, api.Query)
+}
+
+// Query executes the InfluxQL statements, then responses results in influxdb format.
+func (api *QueryAPI) Query(c *gin.Context) {
+	param := &QueryParam{}
+	var err error
+	if c.Request.Method == http.MethodPost && c.ContentType() == binding.MIMEPOSTForm {
+		err = c.ShouldBindWith(param, binding.Form)
+	} else {
+		err = c.ShouldBindQuery(param)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &response{Err: err.Error()})
+		return
+	}
+	statements, err := influxqlParseFn(param.Query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &response{Err: fmt.Sprintf("error parsing query: %s", err)})
+		return
+	}
+	for _, statement := range statements {
+		if err := authorize(c, param, statement); err != nil {
+			c.JSON(http.StatusForbidden, &response{Err: err.Error()})
+			return
+		}
+	}
+	rs := &response{Results: make([]*result, 0, len(statements))}
+	err = api.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := api.deps.WithTimeout()
+		defer cancel()
+
+		for idx, statement := range statements {
+			series, err := api.execute(ctx, param, statement)
+			r := &result{StatementID: idx, Series: series}
+			if err != nil {
+				api.logger.Warn("execute influxql statement failure",
+					logger.String("query", param.Query), logger.Error(err))
+				r.Series = nil
+				r.Err = err.Error()
+			}
+			rs.Results = append(rs.Results, r)
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, &response{Err: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, rs)
+}
+
+// authorize checks if current user has the permission to execute the statement,
+// create database(handshake of influxdb agents) only needs write permission of the database.
+func authorize(c *gin.Context, param *QueryParam, statement influxql.Statement) error {
+	namespace := param.Namespace
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+	switch s := statement.(type) {
+	case *influxql.CreateDatabaseStatement:
+		database := s.Name
+		if database == "" {
+			database = param.Database
+		}
+		return middleware.Authorize(c, database, namespace, models.WritePermission)
+	case *influxql.ShowDatabasesStatement:
+		// show databases only need authentication
+		return nil
+	default:
+		return middleware.Authorize(c, param.Database, namespace, models.ReadPermission)
+	}
+}
+
+// execute executes the statement, returns the series of result.
+func (api *QueryAPI) execute(ctx context.Context, param *QueryParam, statement influxql.Statement) ([]*row, error) {
+	switch statement.(type) {
+	case *influxql.CreateDatabaseStatement:
+		// database is created by admin api, just answers influxdb agents.
+		return nil, nil
+	case *influxql.ShowDatabasesStatement:
+		return api.showDatabases(), nil
+	}
+	if strings.TrimSpace(param.Database) == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	namespace := param.Namespace
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+	switch s := statement.(type) {
+	case *influxql.SelectStatement:
+		return api.selectSeries(ctx, param, namespace, s)
+	case *influxql.ShowMeasurementsStatement:
+		return api.showMeasurements(ctx, param.Database, namespace, s)
+	case *influxql.ShowTagKeysStatement:
+		return api.showTagKeys(ctx, param.Database, namespace, s)
+	case *influxql.ShowTagValuesStatement:
+		return api.showTagValues(ctx, param.Database, namespace, s)
+	case *influxql.ShowFieldKeysStatement:
+		return api.showFieldKeys(ctx, param.Database, namespace, s)
+	default:
+		return nil, fmt.Errorf("statement not supported")
+	}
+}
+
+// showDatabases returns all database names.
+func (api *QueryAPI) showDatabases() []*row {
+	databases := api.deps.StateMgr.GetDatabases()
+	names := make([]string, 0, len(databases))
+	for idx := range databases {
+		names = append(names, databases[idx].Name)
+	}
+	sort.Strings(names)
+	return []*row{{Name: "databases", Columns: []string{"name"}, Values: stringValues(names)}}
+}
+
+// showMeasurements returns the measurement names which match WITH MEASUREMENT clause.
+func (api *QueryAPI) showMeasurements(ctx context.Context, database, namespace string,
+	s *influxql.ShowMeasurementsStatement,
+) ([]*row, error) {
+	matcher, err := s.Matcher()
+	if err != nil {
+		return nil, err
+	}
+	metricNames, err := api.metricNames(ctx, database, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, metricName := range metricNames {
+		if matcher(metricName) {
+			names = append(names, metricName)
+		}
+	}
+	values := paginate(stringValues(names), s.Limit, s.Offset)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return []*row{{Name: "measurements", Columns: []string{"name"}, Values: values}}, nil
+}
+
+// showTagKeys returns the tag keys of measurement, returns tag keys of all measurements if measurement not provided.
+func (api *QueryAPI) showTagKeys(ctx context.Context, database, namespace string,
+	s *influxql.ShowTagKeysStatement,
+) ([]*row, error) {
+	metricNames, err := api.measurements(ctx, database, namespace, s.Measurement)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*row
+	for _, metricName := range metricNames {
+		tagKeys, err := api.tagKeys(ctx, database, namespace, metricName)
+		if err != nil {
+			return nil, err
+		}
+		values := paginate(stringValues(tagKeys), s.Limit, s.Offset)
+		if len(values) == 0 {
+			continue
+		}
+		rows = append(rows, &row{Name: metricName, Columns: []string{"tagKey"}, Values: values})
+	}
+	return rows, nil
+}
+
+// showTagValues returns the tag values of tag keys which match WITH KEY clause.
+func (api *QueryAPI) showTagValues(ctx context.Context, database, namespace string,
+	s *influxql.ShowTagValuesStatement,
+) ([]*row, error) {
+	condition, err := influxql.TagCondition(s.Condition)
+	if err != nil {
+		return nil, err
+	}
+	metricNames, err := api.measurements(ctx, database, namespace, s.Measurement)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*row
+	for _, metricName := range metricNames {
+		tagKeys, err := api.matchedTagKeys(ctx, database, namespace, metricName, s)
+		if err != nil {
+			return nil, err
+		}
+		var values [][]interface{}
+		for _, tagKey := range tagKeys {
+			tagValues, err := api.deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+				Namespace:  namespace,
+				MetricName: metricName,
+				Type:       stmtpkg.TagValue,
+				TagKey:     tagKey,
+				Condition:  condition,
+				Limit:      constants.MaxSuggestions,
+			}).WaitResponse()
+			if err != nil {
+				return nil, err
+			}
+			sort.Strings(tagValues)
+			for _, tagValue := range tagValues {
+				values = append(values, []interface{}{tagKey, tagValue})
+			}
+		}
+		values = paginate(values, s.Limit, s.Offset)
+		if len(values) == 0 {
+			continue
+		}
+		rows = append(rows, &row{Name: metricName, Columns: []string{"key", "value"}, Values: values})
+	}
+	return rows, nil
+}
+
+// matchedTagKeys returns the tag keys of measurement which match WITH KEY clause.
+func (api *QueryAPI) matchedTagKeys(ctx context.Context, database, namespace, metricName string,
+	s *influxql.ShowTagValuesStatement,
+) ([]string, error) {
+	if keys, ok := s.ExactKeys(); ok {
+		return keys, nil
+	}
+	matcher, err := s.KeyMatcher()
+	if err != nil {
+		return nil, err
+	}
+	tagKeys, err := api.tagKeys(ctx, database, namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, tagKey := range tagKeys {
+		if matcher(tagKey) {
+			keys = append(keys, tagKey)
+		}
+	}
+	return keys, nil
+}
+
+// showFieldKeys returns the field keys of measurement, returns field keys of all measurements if measurement not provided.
+func (api *QueryAPI) showFieldKeys(ctx context.Context, database, namespace string,
+	s *influxql.ShowFieldKeysStatement,
+) ([]*row, error) {
+	metricNames, err := api.measurements(ctx, database, namespace, s.Measurement)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*row
+	for _, metricName := range metricNames {
+		fields, err := api.fields(ctx, database, namespace, metricName)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i].Name < fields[j].Name
+		})
+		var values [][]interface{}
+		for _, f := range fields {
+			// histogram field is internal field, not visible for user
+			if f.Type == field.HistogramField {
+				continue
+			}
+			// all fields are float values
+			values = append(values, []interface{}{string(f.Name), "float"})
+		}
+		values = paginate(values, s.Limit, s.Offset)
+		if len(values) == 0 {
+			continue
+		}
+		rows = append(rows, &row{Name: metricName, Columns: []string{"fieldKey", "fieldType"}, Values: values})
+	}
+	return rows, nil
+}
+
+// selectSeries executes the select statement, returns each result series as a row.
+func (api *QueryAPI) selectSeries(ctx context.Context, param *QueryParam, namespace string,
+	s *influxql.SelectStatement,
+) ([]*row, error) {
+	plan, err := influxql.NewQueryPlan(s, namespace, timeutil.Now())
+	if err != nil {
+		return nil, err
+	}
+	query := plan.Query
+	if len(plan.HistogramFields) > 0 {
+		if err := api.checkHistogramFields(ctx, param.Database, plan); err != nil {
+			return nil, err
+		}
+	}
+	if plan.ExpandGroupBy {
+		groupBy, err := api.expandGroupBy(ctx, param.Database, plan)
+		if err != nil {
+			return nil, err
+		}
+		query.GroupBy = groupBy
+	}
+	rs, err := api.deps.QueryFactory.NewMetricQuery(ctx, param.Database, query).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	if rs == nil || len(rs.Series) == 0 {
+		return nil, nil
+	}
+	seriesList := rs.Series
+	sort.Slice(seriesList, func(i, j int) bool {
+		return tagsKey(query.GroupBy, seriesList[i].Tags) < tagsKey(query.GroupBy, seriesList[j].Tags)
+	})
+	if s.SOffset >= len(seriesList) {
+		return nil, nil
+	}
+	seriesList = seriesList[s.SOffset:]
+	if s.SLimit > 0 && s.SLimit < len(seriesList) {
+		seriesList = seriesList[:s.SLimit]
+	}
+	columns := append([]string{"time"}, plan.Columns...)
+	var rows []*row
+	for _, series := range seriesList {
+		values := seriesValues(plan, rs, series, param.Epoch)
+		values = paginate(values, s.Limit, s.Offset)
+		if len(values) == 0 {
+			continue
+		}
+		r := &row{Name: s.Measurement, Columns: columns, Values: values}
+		if len(series.Tags) > 0 {
+			r.Tags = series.Tags
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// checkHistogramFields checks if the measurement has histogram buckets for the fields of percentile functions,
+// because percentile is computed from histogram buckets, not from the values of field.
+func (api *QueryAPI) checkHistogramFields(ctx context.Context, database string, plan *influxql.QueryPlan) error {
+	fields, err := api.fields(ctx, database, plan.Query.Namespace, plan.Query.MetricName)
+	if err != nil {
+		return err
+	}
+	hasBuckets := false
+	fieldNames := make(map[field.Name]struct{})
+	for _, f := range fields {
+		if f.Type == field.HistogramField {
+			hasBuckets = true
+		}
+		fieldNames[f.Name] = struct{}{}
+	}
+	for _, fieldName := range plan.HistogramFields {
+		if _, ok := fieldNames[field.Name(fieldName)]; !ok || !hasBuckets {
+			return fmt.Errorf("function percentile requires histogram buckets of field %s in measurement %s",
+				fieldName, plan.Query.MetricName)
+		}
+	}
+	return nil
+}
+
+// expandGroupBy returns the grouping tag keys for group by * or /regex/.
+func (api *QueryAPI) expandGroupBy(ctx context.Context, database string, plan *influxql.QueryPlan) ([]string, error) {
+	tagKeys, err := api.tagKeys(ctx, database, plan.Query.Namespace, plan.Query.MetricName)
+	if err != nil {
+		return nil, err
+	}
+	var groupByRegex *regexp.Regexp
+	if plan.Statement.GroupByRegex != "" {
+		// regex validated by query plan
+		groupByRegex = regexp.MustCompile(plan.Statement.GroupByRegex)
+	}
+	groupBy := make(map[string]struct{})
+	for _, tagKey := range plan.Query.GroupBy {
+		groupBy[tagKey] = struct{}{}
+	}
+	for _, tagKey := range tagKeys {
+		if plan.Statement.GroupByAll || groupByRegex.MatchString(tagKey) {
+			groupBy[tagKey] = struct{}{}
+		}
+	}
+	result := make([]string, 0, len(groupBy))
+	for tagKey := range groupBy {
+		result = append(result, tagKey)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// measurements returns the measurement if provided, else returns all measurements.
+func (api *QueryAPI) measurements(ctx context.Context, database, namespace, measurement string) ([]string, error) {
+	if measurement != "" {
+		return []string{measurement}, nil
+	}
+	return api.metricNames(ctx, database, namespace)
+}
+
+// metricNames returns the sorted metric names under namespace.
+func (api *QueryAPI) metricNames(ctx context.Context, database, namespace string) ([]string, error) {
+	metricNames, err := api.deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+		Namespace: namespace,
+		Type:      stmtpkg.Metric,
+		Limit:     constants.MaxSuggestions,
+	}).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(metricNames)
+	return metricNames, nil
+}
+
+// tagKeys returns the sorted tag keys of metric.
+func (api *QueryAPI) tagKeys(ctx context.Context, database, namespace, metricName string) ([]string, error) {
+	tagKeys, err := api.deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+		Namespace:  namespace,
+		MetricName: metricName,
+		Type:       stmtpkg.TagKey,
+		Limit:      constants.MaxSuggestions,
+	}).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(tagKeys)
+	return tagKeys, nil
+}
+
+// fields returns the field metas of metric.
+func (api *QueryAPI) fields(ctx context.Context, database, namespace, metricName string) (field.Metas, error) {
+	result, err := api.deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+		Namespace:  namespace,
+		MetricName: metricName,
+		Type:       stmtpkg.Field,
+		Limit:      constants.MaxSuggestions,
+	}).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	var fields field.Metas
+	for _, value := range result {
+		var metas field.Metas
+		if err := json.Unmarshal([]byte(value), &metas); err != nil {
+			return nil, err
+		}
+		fields = append(fields, metas...)
+	}
+	return fields, nil
+}
+
+// tagsKey returns the ordering key of series tags.
+func tagsKey(groupBy []string, tags map[string]string) string {
+	var sb strings.Builder
+	for _, tagKey := range groupBy {
+		sb.WriteString(tags[tagKey])
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// seriesValues returns the rows of series, each row contains time and values of all columns,
+// empty intervals are filled based on fill option if group by time interval.
+func seriesValues(plan *influxql.QueryPlan, rs *models.ResultSet, series *models.Series, epoch string) [][]interface{} {
+	s := plan.Statement
+	timestamps := make(map[int64]struct{})
+	for _, column := range plan.Columns {
+		for t := range series.Fields[column] {
+			timestamps[t] = struct{}{}
+		}
+	}
+	if s.GroupByInterval > 0 && s.Fill != influxql.FillNone && rs.Interval > 0 {
+		for t := rs.StartTime; t <= rs.EndTime; t += rs.Interval {
+			timestamps[t] = struct{}{}
+		}
+	}
+	times := make([]int64, 0, len(timestamps))
+	for t := range timestamps {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	values := make([][]interface{}, len(times))
+	for idx, t := range times {
+		values[idx] = make([]interface{}, len(plan.Columns)+1)
+		values[idx][0] = formatTime(t, epoch)
+	}
+	for col, column := range plan.Columns {
+		points := series.Fields[column]
+		for idx, t := range times {
+			if v, ok := points[t]; ok {
+				values[idx][col+1] = formatValue(v)
+			}
+		}
+		fill(s, times, values, col+1)
+	}
+	if s.Fill == influxql.FillNone {
+		// remove rows without any value
+		pos := 0
+		for _, value := range values {
+			for _, v := range value[1:] {
+				if v != nil {
+					values[pos] = value
+					pos++
+					break
+				}
+			}
+		}
+		values = values[:pos]
+	}
+	if s.Descending {
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+	return values
+}
+
+// fill fills the empty values of column based on fill option.
+func fill(s *influxql.SelectStatement, times []int64, values [][]interface{}, col int) {
+	switch s.Fill {
+	case influxql.FillValue:
+		for idx := range values {
+			if values[idx][col] == nil {
+				values[idx][col] = s.FillValue
+			}
+		}
+	case influxql.FillPrevious:
+		var prev interface{}
+		for idx := range values {
+			if values[idx][col] == nil {
+				values[idx][col] = prev
+			} else {
+				prev = values[idx][col]
+			}
+		}
+	case influxql.FillLinear:
+		prevIdx := -1
+		for idx := range values {
+			if values[idx][col] == nil {
+				continue
+			}
+			if prevIdx >= 0 && idx-prevIdx > 1 {
+				prev, next := values[prevIdx][col].(float64), values[idx][col].(float64)
+				slope := (next - prev) / float64(times[idx]-times[prevIdx])
+				for i := prevIdx + 1; i < idx; i++ {
+					values[i][col] = prev + slope*float64(times[i]-times[prevIdx])
+				}
+			}
+			prevIdx = idx
+		}
+	}
+}
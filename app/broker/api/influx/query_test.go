@@ -0,0 +1,600 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influx
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/ltoml"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestAPI(ctrl *gomock.Controller) (*gin.Engine, *brokerQuery.MockFactory, *broker.MockStateManager) {
+	queryFactory := brokerQuery.NewMockFactory(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	api := NewQueryAPI(&deps.HTTPDeps{
+		Ctx:          context.Background(),
+		QueryFactory: queryFactory,
+		StateMgr:     stateMgr,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("influx", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	api.Register(r)
+	return r, queryFactory, stateMgr
+}
+
+func queryPath(params, q string) string {
+	return QueryPath + "?" + params + "&q=" + url.QueryEscape(q)
+}
+
+func TestQueryAPI_Query(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, queryFactory, stateMgr := newTestAPI(ctrl)
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		code    int
+		body    string
+	}{
+		{
+			name: "query required",
+			path: QueryPath + "?db=test",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "parse failure",
+			path: queryPath("db=test", "drop database test"),
+			code: http.StatusBadRequest,
+			body: `{"error":"error parsing query: unexpected \"drop\" at position 0, expected SELECT, SHOW or CREATE"}`,
+		},
+		{
+			name: "create database",
+			path: queryPath("", "CREATE DATABASE test"),
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0}]}`,
+		},
+		{
+			name: "show databases",
+			path: queryPath("", "SHOW DATABASES"),
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return([]models.Database{{Name: "b"}, {Name: "a"}})
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["a"],["b"]]}]}]}`,
+		},
+		{
+			name: "database required",
+			path: queryPath("db=%20", "SHOW MEASUREMENTS"),
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"database name cannot be empty"}]}`,
+		},
+		{
+			name: "show measurements",
+			path: queryPath("db=test", "SHOW MEASUREMENTS WITH MEASUREMENT =~ /m|d/ LIMIT 1 OFFSET 1; SHOW MEASUREMENTS OFFSET 10"),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, metadata *stmt.MetricMetadata) brokerQuery.MetaDataQuery {
+						assert.Equal(t, stmt.Metric, metadata.Type)
+						assert.Equal(t, "default-ns", metadata.Namespace)
+						return metadataQuery
+					}).Times(2)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"mem", "cpu", "disk"}, nil).Times(2)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"measurements","columns":["name"],"values":[["mem"]]}]},` +
+				`{"statement_id":1}]}`,
+		},
+		{
+			name: "show measurements with bad regex",
+			path: queryPath("db=test", "SHOW MEASUREMENTS WITH MEASUREMENT =~ /[/"),
+			code: http.StatusOK,
+			body: "{\"results\":[{\"statement_id\":0,\"error\":\"bad regex: error parsing regexp: missing closing ]: `[`\"}]}",
+		},
+		{
+			name: "show measurements failure",
+			path: queryPath("db=test", "SHOW MEASUREMENTS"),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show tag keys",
+			path: queryPath("db=test&ns=ns", "SHOW TAG KEYS"),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery).Times(3)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"mem", "cpu"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"ip", "host"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["tagKey"],"values":[["host"],["ip"]]}]}]}`,
+		},
+		{
+			name: "show tag keys failure",
+			path: queryPath("db=test", "SHOW TAG KEYS FROM cpu"),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show tag keys, find measurements failure",
+			path: queryPath("db=test", "SHOW TAG KEYS"),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show tag values with exact keys",
+			path: queryPath("db=test", `SHOW TAG VALUES FROM cpu WITH KEY IN ("host", "ip") WHERE zone = 'a' LIMIT 2`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, metadata *stmt.MetricMetadata) brokerQuery.MetaDataQuery {
+						assert.Equal(t, stmt.TagValue, metadata.Type)
+						assert.Equal(t, "cpu", metadata.MetricName)
+						assert.Equal(t, "zone=a", metadata.Condition.Rewrite())
+						return metadataQuery
+					}).Times(2)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"b", "a"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"1"}, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["key","value"],` +
+				`"values":[["host","a"],["host","b"]]}]}]}`,
+		},
+		{
+			name: "show tag values with key regex",
+			path: queryPath("db=test", `SHOW TAG VALUES FROM cpu WITH KEY =~ /h.*/`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery).Times(2)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"ip", "host"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"a"}, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["key","value"],"values":[["host","a"]]}]}]}`,
+		},
+		{
+			name: "show tag values, key not matched",
+			path: queryPath("db=test", `SHOW TAG VALUES WITH KEY != host`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery).Times(2)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"cpu"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"host"}, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0}]}`,
+		},
+		{
+			name: "show tag values with bad condition",
+			path: queryPath("db=test", `SHOW TAG VALUES WITH KEY = host WHERE value > 1`),
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"condition of value is not supported, only tag condition is supported"}]}`,
+		},
+		{
+			name: "show tag values with bad key regex",
+			path: queryPath("db=test", `SHOW TAG VALUES FROM cpu WITH KEY =~ /[/`),
+			code: http.StatusOK,
+		},
+		{
+			name: "show tag values, find measurements failure",
+			path: queryPath("db=test", `SHOW TAG VALUES WITH KEY = host`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show tag values, find tag keys failure",
+			path: queryPath("db=test", `SHOW TAG VALUES FROM cpu WITH KEY =~ /h/`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show tag values, find tag values failure",
+			path: queryPath("db=test", `SHOW TAG VALUES FROM cpu WITH KEY = host`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show field keys",
+			path: queryPath("db=test", `SHOW FIELD KEYS FROM cpu; SHOW FIELD KEYS FROM mem`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, metadata *stmt.MetricMetadata) brokerQuery.MetaDataQuery {
+						assert.Equal(t, stmt.Field, metadata.Type)
+						return metadataQuery
+					}).Times(2)
+				metadataQuery.EXPECT().WaitResponse().
+					Return([]string{`[{"name":"usage","type":1},{"name":"idle","type":2},{"name":"__bucket_0","type":5}]`}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["fieldKey","fieldType"],` +
+				`"values":[["idle","float"],["usage","float"]]}]},{"statement_id":1}]}`,
+		},
+		{
+			name: "show field keys, bad field metas",
+			path: queryPath("db=test", `SHOW FIELD KEYS FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"abc"}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "show field keys failure",
+			path: queryPath("db=test", `SHOW FIELD KEYS FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "show field keys, find measurements failure",
+			path: queryPath("db=test", `SHOW FIELD KEYS`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "select, plan failure",
+			path: queryPath("db=test", `SELECT * FROM cpu`),
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"wildcard field is not supported"}]}`,
+		},
+		{
+			name: "select failure",
+			path: queryPath("db=test", `SELECT mean(value) FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "select empty result",
+			path: queryPath("db=test", `SELECT mean(value) FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0}]}`,
+		},
+		{
+			name: "select percentile, find fields failure",
+			path: queryPath("db=test", `SELECT percentile(value, 99) FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "select percentile without histogram buckets",
+			path: queryPath("db=test", `SELECT percentile(value, 99) FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{`[{"name":"value","type":2}]`}, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"function percentile requires histogram buckets of field value in measurement cpu"}]}`,
+		},
+		{
+			name: "select percentile, field not in histogram",
+			path: queryPath("db=test", `SELECT percentile(value, 99) FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{`[{"name":"__bucket_0","type":5}]`}, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"function percentile requires histogram buckets of field value in measurement cpu"}]}`,
+		},
+		{
+			name: "select percentile with histogram buckets",
+			path: queryPath("db=test", `SELECT percentile(HistogramSum, 99) FROM cpu`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().
+					Return([]string{`[{"name":"HistogramSum","type":1},{"name":"__bucket_0","type":5}]`}, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0}]}`,
+		},
+		{
+			name: "select, expand group by failure",
+			path: queryPath("db=test", `SELECT mean(value) FROM cpu GROUP BY *`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"error":"err"}]}`,
+		},
+		{
+			name: "select group by regex",
+			path: queryPath("db=test&epoch=s", `SELECT mean(value), max(value) FROM cpu `+
+				`WHERE time >= 0ms AND time <= 40s GROUP BY time(10s), zone, /^h/ fill(none) SLIMIT 1 SOFFSET 1`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"ip", "host"}, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, query *stmt.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, []string{"host", "zone"}, query.GroupBy)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet(), nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"b"},` +
+				`"columns":["time","mean","max"],"values":[[0,1,null],[30,4,null]]}]}]}`,
+		},
+		{
+			name: "select fill linear",
+			path: queryPath("db=test&epoch=ms", `SELECT mean(value), max(value) FROM cpu `+
+				`WHERE time >= 0ms AND time <= 40s GROUP BY time(10s), host fill(linear) ORDER BY time DESC LIMIT 3`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet(), nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[` +
+				`{"name":"cpu","tags":{"host":"a"},"columns":["time","mean","max"],"values":[[40000,null,null],[30000,null,null],[20000,null,null]]},` +
+				`{"name":"cpu","tags":{"host":"b"},"columns":["time","mean","max"],"values":[[40000,null,null],[30000,4,null],[20000,3,null]]}]}]}`,
+		},
+		{
+			name: "select fill previous",
+			path: queryPath("db=test", `SELECT mean(value) FROM cpu `+
+				`WHERE time >= 0ms AND time <= 40s GROUP BY time(10s), host fill(previous) OFFSET 1 SOFFSET 1`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet(), nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"b"},"columns":["time","mean"],` +
+				`"values":[["1970-01-01T00:00:10Z",1],["1970-01-01T00:00:20Z",1],["1970-01-01T00:00:30Z",4],["1970-01-01T00:00:40Z",4]]}]}]}`,
+		},
+		{
+			name: "select fill value",
+			path: queryPath("db=test&epoch=ms", `SELECT mean(value) FROM cpu `+
+				`WHERE time >= 0ms AND time <= 40s GROUP BY time(10s), host fill(0) SLIMIT 1`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet(), nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","mean"],` +
+				`"values":[[0,0],[10000,2],[20000,0],[30000,0],[40000,0]]}]}]}`,
+		},
+		{
+			name: "select, series offset out of range",
+			path: queryPath("db=test", `SELECT mean(value) FROM cpu GROUP BY host SOFFSET 5`),
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet(), nil)
+			},
+			code: http.StatusOK,
+			body: `{"results":[{"statement_id":0}]}`,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, resp.Body.String())
+			}
+		})
+	}
+}
+
+func TestQueryAPI_Query_authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	admin := config.User{UserName: "admin", Password: "admin123"}
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetUser("writer").Return(models.User{Name: "writer", Grants: models.Grants{
+		{Database: "telegraf", Permission: models.WritePermission},
+	}}, true).AnyTimes()
+	api := NewQueryAPI(&deps.HTTPDeps{
+		Ctx:      context.Background(),
+		StateMgr: stateMgr,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			User: admin,
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("influx", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	r.Use(middleware.NewAuthentication(admin, []byte("secret"), stateMgr).Validate())
+	api.Register(r)
+	token, err := middleware.CreateToken([]byte("secret"), "writer")
+	assert.NoError(t, err)
+
+	doRequest := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(http.MethodPost, path, nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp
+	}
+	// handshake of telegraf with write permission
+	resp := doRequest(queryPath("", `CREATE DATABASE "telegraf"`))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = doRequest(queryPath("db=telegraf", "CREATE DATABASE test"))
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// query needs read permission
+	resp = doRequest(queryPath("db=telegraf", "SHOW MEASUREMENTS"))
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = doRequest(queryPath("db=telegraf", `CREATE DATABASE "telegraf"; SHOW MEASUREMENTS`))
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// show databases only needs authentication
+	stateMgr.EXPECT().GetDatabases().Return(nil)
+	resp = doRequest(queryPath("", "SHOW DATABASES"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestQueryAPI_Query_form(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _, _ := newTestAPI(ctrl)
+	req := httptest.NewRequest(http.MethodPost, QueryPath, strings.NewReader("q=create+database+test"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"results":[{"statement_id":0}]}`, resp.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, QueryPath, strings.NewReader("%zz"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestQueryAPI_execute(t *testing.T) {
+	api := NewQueryAPI(&deps.HTTPDeps{})
+	series, err := api.execute(context.TODO(), &QueryParam{Database: "test"}, nil)
+	assert.Error(t, err)
+	assert.Nil(t, series)
+}
+
+func TestQueryAPI_Query_limit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	limiter := concurrent.NewLimiter(context.TODO(), 1, time.Millisecond*10,
+		metrics.NewLimitStatistics("influx_limit", linmetric.BrokerRegistry))
+	api := NewQueryAPI(&deps.HTTPDeps{
+		Ctx:          context.Background(),
+		QueryLimiter: limiter,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		}},
+	})
+	r := gin.New()
+	api.Register(r)
+	ch := make(chan struct{})
+	go func() {
+		_ = limiter.Do(func() error {
+			<-ch
+			return nil
+		})
+	}()
+	time.Sleep(time.Millisecond * 5)
+	resp := mock.DoRequest(t, r, http.MethodGet, queryPath("", "create database test"), "")
+	close(ch)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func newResultSet() *models.ResultSet {
+	rs := models.NewResultSet()
+	rs.MetricName = "cpu"
+	rs.StartTime = 0
+	rs.EndTime = 40000
+	rs.Interval = 10000
+	s := models.NewSeries(map[string]string{"host": "b"})
+	points := models.NewPoints()
+	points.AddPoint(0, 1)
+	points.AddPoint(20000, math.NaN())
+	points.AddPoint(30000, 4)
+	s.AddField("mean", points)
+	rs.AddSeries(s)
+	s = models.NewSeries(map[string]string{"host": "a"})
+	points = models.NewPoints()
+	points.AddPoint(10000, 2)
+	s.AddField("mean", points)
+	rs.AddSeries(s)
+	return rs
+}
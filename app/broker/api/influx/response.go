@@ -0,0 +1,94 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influx
+
+import (
+	"math"
+	"time"
+)
+
+// response represents the influxdb query response.
+type response struct {
+	Results []*result `json:"results,omitempty"`
+	Err     string    `json:"error,omitempty"`
+}
+
+// result represents the result of a statement.
+type result struct {
+	StatementID int    `json:"statement_id"`
+	Series      []*row `json:"series,omitempty"`
+	Err         string `json:"error,omitempty"`
+}
+
+// row represents a series of result with values in rows.
+type row struct {
+	Name    string            `json:"name,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Columns []string          `json:"columns"`
+	Values  [][]interface{}   `json:"values,omitempty"`
+}
+
+// paginate returns the values after offset and limit(0 means no limit).
+func paginate(values [][]interface{}, limit, offset int) [][]interface{} {
+	if offset >= len(values) {
+		return nil
+	}
+	values = values[offset:]
+	if limit > 0 && limit < len(values) {
+		values = values[:limit]
+	}
+	return values
+}
+
+// stringValues returns the rows with single column of string values.
+func stringValues(values []string) [][]interface{} {
+	rows := make([][]interface{}, 0, len(values))
+	for _, value := range values {
+		rows = append(rows, []interface{}{value})
+	}
+	return rows
+}
+
+// formatTime formats the timestamp in milliseconds with epoch precision,
+// returns the RFC3339 string if precision not specified.
+func formatTime(timestamp int64, epoch string) interface{} {
+	switch epoch {
+	case "ns", "n":
+		return timestamp * int64(time.Millisecond)
+	case "u", "µ":
+		return timestamp * int64(time.Millisecond/time.Microsecond)
+	case "ms":
+		return timestamp
+	case "s":
+		return timestamp / int64(time.Second/time.Millisecond)
+	case "m":
+		return timestamp / int64(time.Minute/time.Millisecond)
+	case "h":
+		return timestamp / int64(time.Hour/time.Millisecond)
+	default:
+		return time.Unix(0, timestamp*int64(time.Millisecond)).UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// formatValue returns the json value of float value, NaN/Inf is returned as null.
+func formatValue(value float64) interface{} {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil
+	}
+	return value
+}
@@ -22,12 +22,10 @@ import (
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/ingestion/influx"
-	"github.com/lindb/lindb/pkg/http"
 )
 
 var (
 	InfluxWritePath = "/influx/write"
-	InfluxQueryPath = "/influx/query"
 )
 
 // InfluxWriter processes Influxdb line protocol.
@@ -57,11 +55,4 @@ func (iw *InfluxWriter) Register(route gin.IRoutes) {
 		WithHistogram(ingestStatistics.Duration.WithTagValues(InfluxWritePath)),
 		iw.Write,
 	)
-
-	route.POST(InfluxQueryPath, iw.FakeQuery)
-}
-
-// FakeQuery answers influxdb agent such as telegraf
-func (iw *InfluxWriter) FakeQuery(c *gin.Context) {
-	http.OK(c, "ok")
 }
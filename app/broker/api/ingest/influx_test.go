@@ -61,11 +61,8 @@ func Test_Influx_Write(t *testing.T) {
 	r := gin.New()
 	api.Register(r)
 
-	resp := mock.DoRequest(t, r, http.MethodPost, InfluxQueryPath, "")
-	assert.Equal(t, http.StatusOK, resp.Code)
-
 	// missing db param
-	resp = mock.DoRequest(t, r, http.MethodPut, InfluxWritePath, "")
+	resp := mock.DoRequest(t, r, http.MethodPut, InfluxWritePath, "")
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 
 	// enrich_tag bad format
@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/ingestion/otlp"
+)
+
+var (
+	OTLPMetricsPath = "/otlp/v1/metrics"
+)
+
+// OTLPWriter processes OpenTelemetry OTLP/HTTP metrics.
+type OTLPWriter struct {
+	commonWriter
+}
+
+// NewOTLPWriter creates OpenTelemetry OTLP/HTTP metrics writer
+func NewOTLPWriter(deps *depspkg.HTTPDeps) *OTLPWriter {
+	return &OTLPWriter{
+		commonWriter: commonWriter{
+			deps:   deps,
+			parser: otlp.Parse,
+		},
+	}
+}
+
+// Register adds OTLP/HTTP metrics url route.
+func (ow *OTLPWriter) Register(route gin.IRoutes) {
+	route.POST(
+		OTLPMetricsPath,
+		WithHistogram(ingestStatistics.Duration.WithTagValues(OTLPMetricsPath)),
+		ow.Write,
+	)
+	route.PUT(
+		OTLPMetricsPath,
+		WithHistogram(ingestStatistics.Duration.WithTagValues(OTLPMetricsPath)),
+		ow.Write,
+	)
+}
@@ -0,0 +1,89 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/ingestion/prometheus"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/replica"
+)
+
+func Test_PrometheusWriter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewPrometheusWriter(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM: cm,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	// missing db param
+	resp := mock.DoRequest(t, r, http.MethodPost, PrometheusWritePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// bad format
+	resp = mock.DoRequest(t, r, http.MethodPost, PrometheusWritePath+"?db=test&ns=ns1", `xxxx`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	writeReq := &prometheus.WriteRequest{Timeseries: []*prometheus.TimeSeries{{
+		Labels:  []prometheus.Label{{Name: "__name__", Value: "http_requests_total"}, {Name: "host", Value: "a"}},
+		Samples: []prometheus.Sample{{Value: 10, Timestamp: time.Now().UnixNano() / int64(time.Millisecond)}},
+	}}}
+	data := string(snappy.Encode(nil, writeReq.Marshal()))
+
+	// no content
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, PrometheusWritePath+"?db=test&ns=ns2&enrich_tag=a=b", data)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	// write error
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPut, PrometheusWritePath+"?db=test&ns=ns2", data)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
@@ -33,16 +33,20 @@ var (
 
 // LoginAPI represents login param
 type LoginAPI struct {
-	user config.User
+	admin    config.User
+	secret   []byte
+	provider middleware.UserProvider
 
 	logger *logger.Logger
 }
 
-// NewLoginAPI creates login api instance
-func NewLoginAPI(user config.User) *LoginAPI {
+// NewLoginAPI creates login api instance, secret is the key which signs the login token.
+func NewLoginAPI(admin config.User, secret []byte, provider middleware.UserProvider) *LoginAPI {
 	return &LoginAPI{
-		user:   user,
-		logger: logger.GetLogger("broker", "LoginAPI"),
+		admin:    admin,
+		secret:   secret,
+		provider: provider,
+		logger:   logger.GetLogger("broker", "LoginAPI"),
 	}
 }
 
@@ -62,22 +66,40 @@ func (l *LoginAPI) Login(c *gin.Context) {
 		http.OK(c, "")
 		return
 	}
-	// user name is error
-	if l.user.UserName != user.UserName {
-		l.logger.Error("username is invalid")
+	if !l.checkUser(user) {
 		http.OK(c, "")
 		return
 	}
-	// password is error
-	if l.user.Password != user.Password {
-		l.logger.Error("password is invalid")
-		http.OK(c, "")
-		return
-	}
-	token, err := createTokenFn(user)
+	token, err := createTokenFn(l.secret, user.UserName)
 	if err != nil {
 		http.OK(c, "")
 		return
 	}
 	http.OK(c, token)
 }
+
+// checkUser checks if user name and password are valid,
+// user can be admin user(configured in broker) or user stored in state repo.
+func (l *LoginAPI) checkUser(user config.User) bool {
+	if l.admin.UserName == user.UserName {
+		// password is error
+		if l.admin.Password != user.Password {
+			l.logger.Error("password is invalid")
+			return false
+		}
+		return true
+	}
+	if l.provider != nil {
+		if u, ok := l.provider.GetUser(user.UserName); ok {
+			// password is error
+			if !u.CheckPassword(user.Password) {
+				l.logger.Error("password is invalid")
+				return false
+			}
+			return true
+		}
+	}
+	// user name is error
+	l.logger.Error("username is invalid")
+	return false
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http/middleware"
 )
 
@@ -39,7 +40,9 @@ func TestLogin(t *testing.T) {
 	}()
 
 	user := config.User{UserName: "admin", Password: "admin123"}
-	api := NewLoginAPI(user)
+	provider := httppkg.NewMockUserProvider(ctrl)
+	provider.EXPECT().GetUser(gomock.Any()).Return(models.User{}, false).AnyTimes()
+	api := NewLoginAPI(user, []byte("secret"), provider)
 	r := gin.New()
 	api.Register(r)
 
@@ -67,7 +70,7 @@ func TestLogin(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 
 	// token create fail
-	createTokenFn = func(user config.User) (string, error) {
+	createTokenFn = func(secret []byte, userName string) (string, error) {
 		return "", fmt.Errorf("err")
 	}
 	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
@@ -78,3 +81,25 @@ func TestLogin(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
+
+func TestLogin_RepoUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user, err := models.NewUser("test", "test123")
+	assert.NoError(t, err)
+	provider := httppkg.NewMockUserProvider(ctrl)
+	provider.EXPECT().GetUser("test").Return(*user, true).AnyTimes()
+	api := NewLoginAPI(config.User{UserName: "admin", Password: "admin123"}, []byte("secret"), provider)
+	r := gin.New()
+	api.Register(r)
+
+	// password is error
+	resp := mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "test", "password": "test"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `""`, resp.Body.String())
+	// login ok
+	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "test", "password": "test123"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotEqual(t, `""`, resp.Body.String())
+}
@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+// bucket represents the cumulative count of histogram bucket.
+type bucket struct {
+	upperBound float64
+	count      float64
+}
+
+// histogramQuantile calculates the φ-quantile from the bucket series which are grouped by le label,
+// bucket series with the same labels(excludes le) are merged into one result series.
+func histogramQuantile(q float64, seriesList []*models.Series) []*models.Series {
+	type histogram struct {
+		tags    map[string]string
+		buckets map[int64][]bucket // timestamp => buckets
+	}
+	var (
+		keys       []string
+		histograms = make(map[string]*histogram)
+	)
+	for _, s := range seriesList {
+		upperBound, err := strconv.ParseFloat(s.Tags[constants.PrometheusBucketLabel], 64)
+		if err != nil {
+			// series without valid le label is ignored
+			continue
+		}
+		tags := make(map[string]string, len(s.Tags))
+		tagPairs := make([]string, 0, len(s.Tags))
+		for k, v := range s.Tags {
+			if k != constants.PrometheusBucketLabel {
+				tags[k] = v
+				tagPairs = append(tagPairs, k+"="+v)
+			}
+		}
+		sort.Strings(tagPairs)
+		key := strings.Join(tagPairs, ",")
+		h, ok := histograms[key]
+		if !ok {
+			h = &histogram{tags: tags, buckets: make(map[int64][]bucket)}
+			histograms[key] = h
+			keys = append(keys, key)
+		}
+		for _, p := range seriesPoints(s) {
+			h.buckets[p.T] = append(h.buckets[p.T], bucket{upperBound: upperBound, count: p.V})
+		}
+	}
+	result := make([]*models.Series, 0, len(keys))
+	for _, key := range keys {
+		h := histograms[key]
+		points := make(map[int64]float64, len(h.buckets))
+		for t, buckets := range h.buckets {
+			points[t] = bucketQuantile(q, buckets)
+		}
+		s := models.NewSeries(h.tags)
+		s.Fields[constants.PrometheusValueField] = points
+		result = append(result, s)
+	}
+	return result
+}
+
+// bucketQuantile calculates the quantile from cumulative buckets like prometheus,
+// assumes the observations are distributed linearly in the bucket, returns NaN if buckets are invalid.
+func bucketQuantile(q float64, buckets []bucket) float64 {
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].upperBound < buckets[j].upperBound })
+	if len(buckets) < 2 || !math.IsInf(buckets[len(buckets)-1].upperBound, 1) {
+		return math.NaN()
+	}
+	// counts of buckets may be not monotonic because of precision or scrape timing
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i].count < buckets[i-1].count {
+			buckets[i].count = buckets[i-1].count
+		}
+	}
+	observations := buckets[len(buckets)-1].count
+	if observations == 0 {
+		return math.NaN()
+	}
+	rank := q * observations
+	b := sort.Search(len(buckets)-1, func(i int) bool { return buckets[i].count >= rank })
+	switch {
+	case b == len(buckets)-1:
+		return buckets[len(buckets)-2].upperBound
+	case b == 0 && buckets[0].upperBound <= 0:
+		return buckets[0].upperBound
+	}
+	var (
+		bucketStart float64
+		bucketEnd   = buckets[b].upperBound
+		count       = buckets[b].count
+	)
+	if b > 0 {
+		bucketStart = buckets[b-1].upperBound
+		count -= buckets[b-1].count
+		rank -= buckets[b-1].count
+	}
+	return bucketStart + (bucketEnd-bucketStart)*(rank/count)
+}
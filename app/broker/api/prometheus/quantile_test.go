@@ -0,0 +1,77 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestBucketQuantile(t *testing.T) {
+	inf := math.Inf(1)
+	cases := []struct {
+		name    string
+		q       float64
+		buckets []bucket
+		expect  float64
+	}{
+		{name: "no +Inf bucket", q: 0.5, buckets: []bucket{{1, 1}, {2, 2}}, expect: math.NaN()},
+		{name: "one bucket", q: 0.5, buckets: []bucket{{inf, 2}}, expect: math.NaN()},
+		{name: "no observation", q: 0.5, buckets: []bucket{{1, 0}, {inf, 0}}, expect: math.NaN()},
+		{name: "linear interpolation", q: 0.5, buckets: []bucket{{inf, 10}, {1, 2}, {2, 6}}, expect: 1.75},
+		{name: "first bucket", q: 0.1, buckets: []bucket{{1, 2}, {2, 6}, {inf, 10}}, expect: 0.5},
+		{name: "negative first bucket", q: 0.1, buckets: []bucket{{-1, 2}, {2, 6}, {inf, 10}}, expect: -1},
+		{name: "+Inf bucket", q: 0.9, buckets: []bucket{{1, 2}, {2, 6}, {inf, 10}}, expect: 2},
+		{name: "not monotonic", q: 0.5, buckets: []bucket{{1, 4}, {2, 3}, {inf, 8}}, expect: 1},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			v := bucketQuantile(tt.q, tt.buckets)
+			if math.IsNaN(tt.expect) {
+				assert.True(t, math.IsNaN(v))
+			} else {
+				assert.InDelta(t, tt.expect, v, 1e-9)
+			}
+		})
+	}
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	newBucket := func(host, le string, points map[int64]float64) *models.Series {
+		s := models.NewSeries(map[string]string{"host": host, "le": le})
+		s.Fields["value"] = points
+		return s
+	}
+	rs := histogramQuantile(0.5, []*models.Series{
+		newBucket("a", "1", map[int64]float64{10: 2, 20: 0}),
+		newBucket("a", "2", map[int64]float64{10: 6, 20: 4}),
+		newBucket("a", "+Inf", map[int64]float64{10: 10, 20: 4}),
+		newBucket("b", "+Inf", map[int64]float64{10: 1}),
+		newBucket("b", "bad", map[int64]float64{10: 1}),
+	})
+	assert.Len(t, rs, 2)
+	assert.Equal(t, map[string]string{"host": "a"}, rs[0].Tags)
+	assert.Equal(t, map[int64]float64{10: 1.75, 20: 1.5}, rs[0].Fields["value"])
+	assert.Equal(t, map[string]string{"host": "b"}, rs[1].Tags)
+	assert.True(t, math.IsNaN(rs[1].Fields["value"][10]))
+}
@@ -0,0 +1,452 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/sql/promql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	promqlParseFn = promql.Parse
+)
+
+var (
+	// QueryPath represents prometheus instant query's path.
+	QueryPath = "/api/v1/query"
+	// QueryRangePath represents prometheus range query's path.
+	QueryRangePath = "/api/v1/query_range"
+	// SeriesPath represents prometheus series finding's path.
+	SeriesPath = "/api/v1/series"
+	// LabelsPath represents prometheus label names finding's path.
+	LabelsPath = "/api/v1/labels"
+)
+
+// apiError represents the error with prometheus error type.
+type apiError struct {
+	typ errorType
+	err error
+}
+
+// Error returns the error message.
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+// badData returns the bad data error.
+func badData(err error) error {
+	return &apiError{typ: errorBadData, err: err}
+}
+
+// QueryParam represents prometheus http api's param.
+type QueryParam struct {
+	Database  string   `form:"db" binding:"required"`
+	Namespace string   `form:"ns"`
+	Query     string   `form:"query"`
+	Time      string   `form:"time"`
+	Start     string   `form:"start"`
+	End       string   `form:"end"`
+	Step      string   `form:"step"`
+	Matches   []string `form:"match[]"`
+}
+
+// QueryAPI represents prometheus compatible query api,
+// evaluates PromQL expression based on lin query execution pipeline.
+type QueryAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewQueryAPI creates prometheus compatible query api.
+func NewQueryAPI(deps *depspkg.HTTPDeps) *QueryAPI {
+	return &QueryAPI{
+		deps:   deps,
+		logger: logger.GetLogger("broker", "PrometheusQueryAPI"),
+	}
+}
+
+// Register adds prometheus query api's path.
+func (api *QueryAPI) Register(route gin.IRoutes) {
+	route.GET(QueryPath, api.Query)
+	route.POST(QueryPath, api.Query)
+	route.GET(QueryRangePath, api.QueryRange)
+	route.POST(QueryRangePath, api.QueryRange)
+	route.GET(SeriesPath, api.Series)
+	route.POST(SeriesPath, api.Series)
+	route.GET(LabelsPath, api.Labels)
+	route.POST(LabelsPath, api.Labels)
+}
+
+// Query evaluates an instant query at a single point in time.
+func (api *QueryAPI) Query(c *gin.Context) {
+	api.handle(c, api.query)
+}
+
+// QueryRange evaluates an expression query over a range of time.
+func (api *QueryAPI) QueryRange(c *gin.Context) {
+	api.handle(c, api.queryRange)
+}
+
+// Series finds series by label matchers.
+func (api *QueryAPI) Series(c *gin.Context) {
+	api.handle(c, api.series)
+}
+
+// Labels finds label names.
+func (api *QueryAPI) Labels(c *gin.Context) {
+	api.handle(c, api.labels)
+}
+
+// handle executes the query handler with limit, then responses result in prometheus format.
+func (api *QueryAPI) handle(c *gin.Context, handler func(ctx context.Context, param *QueryParam) (interface{}, error)) {
+	var result interface{}
+	err := api.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := api.deps.WithTimeout()
+		defer cancel()
+
+		param := &QueryParam{}
+		var err error
+		if c.Request.Method == http.MethodPost && c.ContentType() == binding.MIMEPOSTForm {
+			// url-encoded body is allowed for long query
+			err = c.ShouldBindWith(param, binding.Form)
+		} else {
+			err = c.ShouldBindQuery(param)
+		}
+		if err != nil {
+			return badData(err)
+		}
+		result, err = handler(ctx, param)
+		return err
+	})
+	if err != nil {
+		var e *apiError
+		switch {
+		case errors.As(err, &e):
+			fail(c, e.typ, e.err)
+		case errors.Is(err, brokerQuery.ErrTimeout):
+			fail(c, errorTimeout, err)
+		default:
+			fail(c, errorExecution, err)
+		}
+		return
+	}
+	ok(c, result)
+}
+
+// query evaluates an instant query.
+func (api *QueryAPI) query(ctx context.Context, param *QueryParam) (interface{}, error) {
+	ts, err := parseTime(param.Time, timeutil.Now())
+	if err != nil {
+		return nil, badData(fmt.Errorf("invalid parameter 'time': %w", err))
+	}
+	expr, err := promqlParseFn(param.Query)
+	if err != nil {
+		return nil, badData(err)
+	}
+	plan, err := promql.NewInstantQueryPlan(expr, param.Namespace, ts)
+	if err != nil {
+		return nil, badData(err)
+	}
+	if plan.IsScalar {
+		return &queryData{ResultType: promql.ValueTypeScalar, Result: point{T: ts, V: plan.Scalar}}, nil
+	}
+	rs, err := api.execute(ctx, param.Database, plan)
+	if err != nil {
+		return nil, err
+	}
+	vector := make([]*sample, 0)
+	for _, s := range rs.Series {
+		points := seriesPoints(s)
+		if len(points) == 0 {
+			continue
+		}
+		// take the latest point in look back window
+		vector = append(vector, &sample{
+			Metric: metricLabels(plan, rs.MetricName, s),
+			Value:  point{T: ts, V: points[len(points)-1].V},
+		})
+	}
+	return &queryData{ResultType: promql.ValueTypeVector, Result: vector}, nil
+}
+
+// queryRange evaluates a range query.
+func (api *QueryAPI) queryRange(ctx context.Context, param *QueryParam) (interface{}, error) {
+	start, err := parseTime(param.Start, 0)
+	if err != nil {
+		return nil, badData(fmt.Errorf("invalid parameter 'start': %w", err))
+	}
+	end, err := parseTime(param.End, 0)
+	if err != nil {
+		return nil, badData(fmt.Errorf("invalid parameter 'end': %w", err))
+	}
+	step, err := parseStep(param.Step)
+	if err != nil {
+		return nil, badData(fmt.Errorf("invalid parameter 'step': %w", err))
+	}
+	expr, err := promqlParseFn(param.Query)
+	if err != nil {
+		return nil, badData(err)
+	}
+	plan, err := promql.NewRangeQueryPlan(expr, param.Namespace, start, end, step)
+	if err != nil {
+		return nil, badData(err)
+	}
+	matrix := make([]*series, 0)
+	if plan.IsScalar {
+		s := &series{Metric: map[string]string{}}
+		for ts := start; ts <= end; ts += step {
+			s.Values = append(s.Values, point{T: ts, V: plan.Scalar})
+		}
+		matrix = append(matrix, s)
+		return &queryData{ResultType: promql.ValueTypeMatrix, Result: matrix}, nil
+	}
+	rs, err := api.execute(ctx, param.Database, plan)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range rs.Series {
+		points := seriesPoints(s)
+		if len(points) == 0 {
+			continue
+		}
+		for idx := range points {
+			points[idx].T += plan.Offset
+		}
+		matrix = append(matrix, &series{
+			Metric: metricLabels(plan, rs.MetricName, s),
+			Values: points,
+		})
+	}
+	return &queryData{ResultType: promql.ValueTypeMatrix, Result: matrix}, nil
+}
+
+// series finds the series which match the selectors in time range.
+func (api *QueryAPI) series(ctx context.Context, param *QueryParam) (interface{}, error) {
+	if len(param.Matches) == 0 {
+		return nil, badData(fmt.Errorf("no match[] parameter provided"))
+	}
+	end, err := parseTime(param.End, timeutil.Now())
+	if err != nil {
+		return nil, badData(fmt.Errorf("invalid parameter 'end': %w", err))
+	}
+	start, err := parseTime(param.Start, end-timeutil.OneHour)
+	if err != nil {
+		return nil, badData(fmt.Errorf("invalid parameter 'start': %w", err))
+	}
+	step := end - start
+	if step <= 0 {
+		step = timeutil.OneSecond
+	}
+	result := make([]map[string]string, 0)
+	for _, match := range param.Matches {
+		expr, err := promqlParseFn(match)
+		if err != nil {
+			return nil, badData(err)
+		}
+		if _, ok := expr.(*promql.VectorSelector); !ok {
+			return nil, badData(fmt.Errorf("invalid parameter 'match[]': %s is not a series selector", match))
+		}
+		plan, err := promql.NewRangeQueryPlan(expr, param.Namespace, start, end, step)
+		if err != nil {
+			return nil, badData(err)
+		}
+		rs, err := api.execute(ctx, param.Database, plan)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range rs.Series {
+			result = append(result, metricLabels(plan, rs.MetricName, s))
+		}
+	}
+	return result, nil
+}
+
+// labels finds the label names of the metrics which match the selectors,
+// if selector not provided, finds label names of all metrics.
+func (api *QueryAPI) labels(ctx context.Context, param *QueryParam) (interface{}, error) {
+	namespace := param.Namespace
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+	var metricNames []string
+	for _, match := range param.Matches {
+		expr, err := promqlParseFn(match)
+		if err != nil {
+			return nil, badData(err)
+		}
+		selector, ok := expr.(*promql.VectorSelector)
+		if !ok {
+			return nil, badData(fmt.Errorf("invalid parameter 'match[]': %s is not a series selector", match))
+		}
+		metricNames = append(metricNames, selector.Name)
+	}
+	if len(param.Matches) == 0 {
+		var err error
+		metricNames, err = api.deps.QueryFactory.NewMetadataQuery(ctx, param.Database, &stmtpkg.MetricMetadata{
+			Namespace: namespace,
+			Type:      stmtpkg.Metric,
+			Limit:     constants.MaxSuggestions,
+		}).WaitResponse()
+		if err != nil {
+			return nil, err
+		}
+	}
+	labelNames := map[string]struct{}{promql.MetricNameLabel: {}}
+	for _, metricName := range metricNames {
+		tagKeys, err := api.tagKeys(ctx, param.Database, namespace, metricName)
+		if err != nil {
+			return nil, err
+		}
+		for _, tagKey := range tagKeys {
+			labelNames[tagKey] = struct{}{}
+		}
+	}
+	result := make([]string, 0, len(labelNames))
+	for labelName := range labelNames {
+		result = append(result, labelName)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// execute executes the lowered metric query, expands the grouping tag keys if need keep all labels of series.
+func (api *QueryAPI) execute(ctx context.Context, database string, plan *promql.QueryPlan) (*models.ResultSet, error) {
+	if strings.TrimSpace(database) == "" {
+		return nil, badData(constants.ErrDatabaseNameRequired)
+	}
+	query := plan.Query
+	if plan.ExpandGroupBy {
+		tagKeys, err := api.tagKeys(ctx, database, query.Namespace, query.MetricName)
+		if err != nil {
+			return nil, err
+		}
+		excludeTagKeys := make(map[string]struct{})
+		for _, tagKey := range plan.ExcludeTagKeys {
+			excludeTagKeys[tagKey] = struct{}{}
+		}
+		query.GroupBy = nil
+		for _, tagKey := range tagKeys {
+			if _, ok := excludeTagKeys[tagKey]; !ok {
+				query.GroupBy = append(query.GroupBy, tagKey)
+			}
+		}
+		sort.Strings(query.GroupBy)
+	}
+	rs, err := api.deps.QueryFactory.NewMetricQuery(ctx, database, query).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	if rs == nil {
+		return models.NewResultSet(), nil
+	}
+	if plan.HistogramQuantile {
+		rs.Series = histogramQuantile(plan.Quantile, rs.Series)
+	}
+	return rs, nil
+}
+
+// tagKeys returns all tag keys of metric.
+func (api *QueryAPI) tagKeys(ctx context.Context, database, namespace, metricName string) ([]string, error) {
+	return api.deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+		Namespace:  namespace,
+		MetricName: metricName,
+		Type:       stmtpkg.TagKey,
+		Limit:      constants.MaxSuggestions,
+	}).WaitResponse()
+}
+
+// metricLabels returns the labels of result series.
+func metricLabels(plan *promql.QueryPlan, metricName string, s *models.Series) map[string]string {
+	labels := make(map[string]string, len(s.Tags)+1)
+	for k, v := range s.Tags {
+		labels[k] = v
+	}
+	if plan.KeepMetricName {
+		labels[promql.MetricNameLabel] = metricName
+	}
+	return labels
+}
+
+// seriesPoints returns the points sorted by timestamp of series.
+func seriesPoints(s *models.Series) []point {
+	var points []point
+	for _, fieldPoints := range s.Fields {
+		for t, v := range fieldPoints {
+			points = append(points, point{T: t, V: v})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].T < points[j].T
+	})
+	return points
+}
+
+// parseTime parses timestamp in milliseconds from unix timestamp in seconds or RFC3339 format.
+func parseTime(val string, defaultVal int64) (int64, error) {
+	if val == "" {
+		if defaultVal <= 0 {
+			return 0, fmt.Errorf("cannot be empty")
+		}
+		return defaultVal, nil
+	}
+	if t, err := strconv.ParseFloat(val, 64); err == nil {
+		if math.IsNaN(t) || math.IsInf(t, 0) {
+			return 0, fmt.Errorf("cannot parse %q to a valid timestamp", val)
+		}
+		return int64(math.Round(t * 1000)), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, val); err == nil {
+		return t.UnixNano() / int64(time.Millisecond), nil
+	}
+	return 0, fmt.Errorf("cannot parse %q to a valid timestamp", val)
+}
+
+// parseStep parses query resolution step in milliseconds from seconds or duration format.
+func parseStep(val string) (int64, error) {
+	if val == "" {
+		return 0, fmt.Errorf("cannot be empty")
+	}
+	if d, err := strconv.ParseFloat(val, 64); err == nil {
+		if math.IsNaN(d) || math.IsInf(d, 0) || d <= 0 {
+			return 0, fmt.Errorf("cannot parse %q to a valid duration", val)
+		}
+		return int64(math.Round(d * 1000)), nil
+	}
+	return promql.ParseDuration(val)
+}
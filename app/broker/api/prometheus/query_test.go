@@ -0,0 +1,511 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/sql/promql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestAPI(ctrl *gomock.Controller) (*gin.Engine, *brokerQuery.MockFactory) {
+	queryFactory := brokerQuery.NewMockFactory(ctrl)
+	api := NewQueryAPI(&deps.HTTPDeps{
+		Ctx:          context.Background(),
+		QueryFactory: queryFactory,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("prometheus", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	api.Register(r)
+	return r, queryFactory
+}
+
+func newResultSet(metricName string) *models.ResultSet {
+	rs := models.NewResultSet()
+	rs.MetricName = metricName
+	s := models.NewSeries(map[string]string{"host": "a"})
+	points := models.NewPoints()
+	points.AddPoint(20000, 2)
+	points.AddPoint(10000, 1.5)
+	s.AddField("value", points)
+	rs.AddSeries(s)
+	// empty series
+	rs.AddSeries(models.NewSeries(map[string]string{"host": "b"}))
+	return rs
+}
+
+func TestQueryAPI_Query(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, queryFactory := newTestAPI(ctrl)
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+
+	cases := []struct {
+		name    string
+		method  string
+		path    string
+		prepare func()
+		code    int
+		body    string
+	}{
+		{
+			name: "database required",
+			path: QueryPath + "?query=cpu",
+			code: http.StatusBadRequest,
+			body: `{"status":"error","errorType":"bad_data","error":"Key: 'QueryParam.Database' Error:` +
+				`Field validation for 'Database' failed on the 'required' tag"}`,
+		},
+		{
+			name: "database empty",
+			path: QueryPath + "?db=%20&query=cpu&time=30",
+			code: http.StatusBadRequest,
+			body: `{"status":"error","errorType":"bad_data","error":"database name cannot be empty"}`,
+		},
+		{
+			name: "bad time",
+			path: QueryPath + "?db=test&query=cpu&time=abc",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "parse failure",
+			path: QueryPath + "?db=test&query=cpu{",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "lowering failure",
+			path: QueryPath + "?db=test&query=topk(5,cpu)",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "scalar",
+			path: QueryPath + "?db=test&query=1%2B1&time=30.5",
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"scalar","result":[30.5,"2"]}}`,
+		},
+		{
+			name: "find tag keys failure",
+			path: QueryPath + "?db=test&query=cpu&time=30",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusUnprocessableEntity,
+			body: `{"status":"error","errorType":"execution","error":"err"}`,
+		},
+		{
+			name: "query timeout",
+			path: QueryPath + "?db=test&query=sum(cpu)&time=30",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, brokerQuery.ErrTimeout)
+			},
+			code: http.StatusServiceUnavailable,
+			body: `{"status":"error","errorType":"timeout","error":"exceed timeout"}`,
+		},
+		{
+			name: "empty result",
+			path: QueryPath + "?db=test&query=sum(cpu)&time=30",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		},
+		{
+			name:   "vector",
+			method: http.MethodPost,
+			path:   QueryPath + "?db=test&query=cpu{host=\"a\"}&time=30",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"le", "host"}, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, query *stmt.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, []string{"host", "le"}, query.GroupBy)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet("cpu"), nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{"__name__":"cpu","host":"a"},"value":[30,"2"]}]}}`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			resp := mock.DoRequest(t, r, method, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, resp.Body.String())
+			}
+		})
+	}
+}
+
+func TestQueryAPI_QueryRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, queryFactory := newTestAPI(ctrl)
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		code    int
+		body    string
+	}{
+		{
+			name: "bad start",
+			path: QueryRangePath + "?db=test&query=cpu&end=10&step=1",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "bad end",
+			path: QueryRangePath + "?db=test&query=cpu&start=10&end=abc&step=1",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "bad step",
+			path: QueryRangePath + "?db=test&query=cpu&start=10&end=20&step=-1",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "parse failure",
+			path: QueryRangePath + "?db=test&query=cpu{&start=10&end=20&step=1",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "end before start",
+			path: QueryRangePath + "?db=test&query=cpu&start=20&end=10&step=1",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "scalar",
+			path: QueryRangePath + "?db=test&query=2&start=10&end=20&step=5s",
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"matrix","result":[` +
+				`{"metric":{},"values":[[10,"2"],[15,"2"],[20,"2"]]}]}}`,
+		},
+		{
+			name: "query failure",
+			path: QueryRangePath + "?db=test&query=sum(cpu)&start=10&end=20&step=10",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "matrix",
+			path: QueryRangePath + "?db=test&ns=ns&query=rate(cpu[1m]+offset+10s)&start=10&end=20&step=10",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"host"}, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, query *stmt.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, "ns", query.Namespace)
+						assert.Equal(t, int64(0), query.TimeRange.Start)
+						assert.Equal(t, int64(10000), query.TimeRange.End)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet("cpu"), nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"matrix","result":[` +
+				`{"metric":{"host":"a"},"values":[[20,"1.5"],[30,"2"]]}]}}`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, resp.Body.String())
+			}
+		})
+	}
+}
+
+func TestQueryAPI_Series(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, queryFactory := newTestAPI(ctrl)
+	metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+	metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		code    int
+		body    string
+	}{
+		{
+			name: "match required",
+			path: SeriesPath + "?db=test",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "bad end",
+			path: SeriesPath + "?db=test&match[]=cpu&end=abc",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "bad start",
+			path: SeriesPath + "?db=test&match[]=cpu&start=abc",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "parse failure",
+			path: SeriesPath + "?db=test&match[]=cpu{",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "not selector",
+			path: SeriesPath + "?db=test&match[]=sum(cpu)",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "lowering failure",
+			path: SeriesPath + "?db=test&match[]=" + url.QueryEscape(`cpu{__name__=~"c"}`),
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "query failure",
+			path: SeriesPath + "?db=test&match[]=cpu&start=10&end=10",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"host"}, nil)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "series",
+			path: SeriesPath + "?db=test&match[]=cpu&match[]=mem",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery).Times(2)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"host"}, nil).Times(2)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), "test", gomock.Any()).Return(metricQuery).Times(2)
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet("cpu"), nil)
+				metricQuery.EXPECT().WaitResponse().Return(newResultSet("mem"), nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":[{"__name__":"cpu","host":"a"},{"__name__":"cpu","host":"b"},` +
+				`{"__name__":"mem","host":"a"},{"__name__":"mem","host":"b"}]}`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, resp.Body.String())
+			}
+		})
+	}
+}
+
+func TestQueryAPI_Labels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, queryFactory := newTestAPI(ctrl)
+	metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		code    int
+		body    string
+	}{
+		{
+			name: "parse failure",
+			path: LabelsPath + "?db=test&match[]=cpu{",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "not selector",
+			path: LabelsPath + "?db=test&match[]=sum(cpu)",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "find metric names failure",
+			path: LabelsPath + "?db=test",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "find tag keys failure",
+			path: LabelsPath + "?db=test&match[]=cpu",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "labels of all metrics",
+			path: LabelsPath + "?db=test",
+			prepare: func() {
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery).Times(3)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"cpu", "mem"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"host", "ip"}, nil)
+				metadataQuery.EXPECT().WaitResponse().Return([]string{"host", "disk"}, nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":["__name__","disk","host","ip"]}`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, resp.Body.String())
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	ts, err := parseTime("", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), ts)
+	_, err = parseTime("", 0)
+	assert.Error(t, err)
+	ts, err = parseTime("1.5", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), ts)
+	ts, err = parseTime("2021-09-01T00:00:00.5Z", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1630454400500), ts)
+	_, err = parseTime("NaN", 0)
+	assert.Error(t, err)
+	_, err = parseTime("abc", 0)
+	assert.Error(t, err)
+}
+
+func TestParseStep(t *testing.T) {
+	step, err := parseStep("15")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15000), step)
+	step, err = parseStep("1m")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60000), step)
+	_, err = parseStep("")
+	assert.Error(t, err)
+	_, err = parseStep("0")
+	assert.Error(t, err)
+	_, err = parseStep("abc")
+	assert.Error(t, err)
+}
+
+func TestQueryAPI_parse_failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		promqlParseFn = promql.Parse
+		ctrl.Finish()
+	}()
+
+	r, _ := newTestAPI(ctrl)
+	promqlParseFn = func(input string) (promql.Expr, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp := mock.DoRequest(t, r, http.MethodGet, QueryPath+"?db=test&query=cpu", "")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestQueryAPI_Query_form(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _ := newTestAPI(ctrl)
+	req := httptest.NewRequest(http.MethodPost, QueryPath, strings.NewReader("db=test&query=1&time=10"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"status":"success","data":{"resultType":"scalar","result":[10,"1"]}}`, resp.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, QueryPath, strings.NewReader("%zz"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
@@ -0,0 +1,113 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/sql/promql"
+)
+
+// errorType represents the error type of prometheus api response.
+type errorType string
+
+const (
+	statusSuccess = "success"
+	statusError   = "error"
+
+	errorBadData   errorType = "bad_data"
+	errorExecution errorType = "execution"
+	errorTimeout   errorType = "timeout"
+)
+
+// response represents the prometheus http api response envelope.
+type response struct {
+	Status    string      `json:"status"`
+	Data      interface{} `json:"data,omitempty"`
+	ErrorType errorType   `json:"errorType,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// queryData represents the data of query/query_range response.
+type queryData struct {
+	ResultType promql.ValueType `json:"resultType"`
+	Result     interface{}      `json:"result"`
+}
+
+// sample represents a series with single point of instant vector.
+type sample struct {
+	Metric map[string]string `json:"metric"`
+	Value  point             `json:"value"`
+}
+
+// series represents a series with points of range matrix.
+type series struct {
+	Metric map[string]string `json:"metric"`
+	Values []point           `json:"values"`
+}
+
+// point represents a data point, encoded as [<unix time in seconds>, "<value>"].
+type point struct {
+	T int64 // timestamp in milliseconds
+	V float64
+}
+
+// MarshalJSON returns json data of point.
+func (p point) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, 32)
+	buf = append(buf, '[')
+	buf = strconv.AppendFloat(buf, float64(p.T)/1000, 'f', -1, 64)
+	buf = append(buf, ',', '"')
+	buf = append(buf, formatValue(p.V)...)
+	buf = append(buf, '"', ']')
+	return buf, nil
+}
+
+// formatValue formats sample value as prometheus does.
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+}
+
+// ok responses success with data.
+func ok(c *gin.Context, data interface{}) {
+	c.JSON(http.StatusOK, &response{Status: statusSuccess, Data: data})
+}
+
+// fail responses error with error type.
+func fail(c *gin.Context, typ errorType, err error) {
+	_ = c.Error(err)
+	code := http.StatusBadRequest
+	switch typ {
+	case errorExecution:
+		code = http.StatusUnprocessableEntity
+	case errorTimeout:
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, &response{Status: statusError, ErrorType: typ, Error: err.Error()})
+}
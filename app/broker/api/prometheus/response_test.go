@@ -0,0 +1,35 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestPoint_MarshalJSON(t *testing.T) {
+	assert.Equal(t, `[1.5,"10"]`, string(encoding.JSONMarshal(point{T: 1500, V: 10})))
+	assert.Equal(t, `[10,"0.25"]`, string(encoding.JSONMarshal(point{T: 10000, V: 0.25})))
+	assert.Equal(t, `[0,"+Inf"]`, string(encoding.JSONMarshal(point{V: math.Inf(1)})))
+	assert.Equal(t, `[0,"-Inf"]`, string(encoding.JSONMarshal(point{V: math.Inf(-1)})))
+	assert.Equal(t, `[0,"NaN"]`, string(encoding.JSONMarshal(point{V: math.NaN()})))
+}
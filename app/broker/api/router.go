@@ -22,66 +22,113 @@ import (
 
 	"github.com/lindb/lindb/app/broker/api/admin"
 	"github.com/lindb/lindb/app/broker/api/exec"
+	"github.com/lindb/lindb/app/broker/api/influx"
 	"github.com/lindb/lindb/app/broker/api/ingest"
+	"github.com/lindb/lindb/app/broker/api/prometheus"
 	"github.com/lindb/lindb/app/broker/api/state"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
 )
 
 // API represents broker http api.
 type API struct {
-	execute *exec.ExecuteAPI
-
-	database           *admin.DatabaseAPI
-	flusher            *admin.DatabaseFlusherAPI
-	storage            *admin.StorageClusterAPI
-	brokerStateMachine *state.BrokerStateMachineAPI
-	metricExplore      *monitoring.ExploreAPI
-	log                *monitoring.LoggerAPI
-	config             *monitoring.ConfigAPI
-	influxIngestion    *ingest.InfluxWriter
-	protoIngestion     *ingest.ProtoWriter
-	flatIngestion      *ingest.FlatWriter
-	proxy              *ReverseProxy
+	enableAuth bool
+	auth       middleware.Authentication
+	login      *LoginAPI
+
+	execute         *exec.ExecuteAPI
+	prometheusQuery *prometheus.QueryAPI
+	influxQuery     *influx.QueryAPI
+
+	database            *admin.DatabaseAPI
+	flusher             *admin.DatabaseFlusherAPI
+	storage             *admin.StorageClusterAPI
+	apiToken            *admin.APITokenAPI
+	brokerStateMachine  *state.BrokerStateMachineAPI
+	metricExplore       *monitoring.ExploreAPI
+	log                 *monitoring.LoggerAPI
+	config              *monitoring.ConfigAPI
+	influxIngestion     *ingest.InfluxWriter
+	protoIngestion      *ingest.ProtoWriter
+	flatIngestion       *ingest.FlatWriter
+	prometheusIngestion *ingest.PrometheusWriter
+	otlpIngestion       *ingest.OTLPWriter
+	proxy               *ReverseProxy
 }
 
 // NewAPI creates broker http api.
 func NewAPI(deps *depspkg.HTTPDeps) *API {
 	return &API{
-		execute:            exec.NewExecuteAPI(deps),
-		database:           admin.NewDatabaseAPI(deps),
-		flusher:            admin.NewDatabaseFlusherAPI(deps),
-		storage:            admin.NewStorageClusterAPI(deps),
-		brokerStateMachine: state.NewBrokerStateMachineAPI(deps),
-		metricExplore:      monitoring.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
-		log:                monitoring.NewLoggerAPI(deps.BrokerCfg.Logging.Dir),
-		config:             monitoring.NewConfigAPI(deps.Node, deps.BrokerCfg),
-		influxIngestion:    ingest.NewInfluxWriter(deps),
-		protoIngestion:     ingest.NewProtoWriter(deps),
-		flatIngestion:      ingest.NewFlatWriter(deps),
-		proxy:              NewReverseProxy(),
+		enableAuth:          deps.BrokerCfg.BrokerBase.User.Enabled,
+		auth:                middleware.NewAuthentication(deps.BrokerCfg.BrokerBase.User, deps.TokenSecret, deps.StateMgr),
+		login:               NewLoginAPI(deps.BrokerCfg.BrokerBase.User, deps.TokenSecret, deps.StateMgr),
+		execute:             exec.NewExecuteAPI(deps),
+		prometheusQuery:     prometheus.NewQueryAPI(deps),
+		influxQuery:         influx.NewQueryAPI(deps),
+		database:            admin.NewDatabaseAPI(deps),
+		flusher:             admin.NewDatabaseFlusherAPI(deps),
+		storage:             admin.NewStorageClusterAPI(deps),
+		apiToken:            admin.NewAPITokenAPI(deps),
+		brokerStateMachine:  state.NewBrokerStateMachineAPI(deps),
+		metricExplore:       monitoring.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
+		log:                 monitoring.NewLoggerAPI(deps.BrokerCfg.Logging.Dir),
+		config:              monitoring.NewConfigAPI(deps.Node, deps.BrokerCfg),
+		influxIngestion:     ingest.NewInfluxWriter(deps),
+		protoIngestion:      ingest.NewProtoWriter(deps),
+		flatIngestion:       ingest.NewFlatWriter(deps),
+		prometheusIngestion: ingest.NewPrometheusWriter(deps),
+		otlpIngestion:       ingest.NewOTLPWriter(deps),
+		proxy:               NewReverseProxy(),
 	}
 }
 
 // RegisterRouter registers http api router.
 func (api *API) RegisterRouter(router *gin.RouterGroup) {
-	api.execute.Register(router)
+	api.login.Register(router)
+
+	// if authentication enabled, all apis(except login) need validate token,
+	// then check the permission of user.
+	var (
+		execRouter   = router
+		readRouter   = router
+		writeRouter  = router
+		clusterAdmin = router
+	)
+	if api.enableAuth {
+		execRouter = router.Group("", api.auth.Validate())
+		readRouter = execRouter.Group("", middleware.RequirePermission(models.ReadPermission))
+		writeRouter = execRouter.Group("", middleware.RequirePermission(models.WritePermission))
+		clusterAdmin = execRouter.Group("", middleware.RequireClusterAdmin())
+	}
+
+	// lin query language, checks permission based on statement
+	api.execute.Register(execRouter)
+	// influxql, checks permission based on statement, because influxdb agents(e.g. telegraf)
+	// send create database on startup with write permission only.
+	api.influxQuery.Register(execRouter)
+
+	api.prometheusQuery.Register(readRouter)
 
-	api.database.Register(router)
-	api.flusher.Register(router)
-	api.storage.Register(router)
+	api.database.Register(clusterAdmin)
+	api.flusher.Register(clusterAdmin)
+	api.storage.Register(clusterAdmin)
+	api.apiToken.Register(clusterAdmin)
 
-	api.brokerStateMachine.Register(router)
+	api.brokerStateMachine.Register(clusterAdmin)
 
-	api.influxIngestion.Register(router)
-	api.protoIngestion.Register(router)
-	api.flatIngestion.Register(router)
+	api.influxIngestion.Register(writeRouter)
+	api.protoIngestion.Register(writeRouter)
+	api.flatIngestion.Register(writeRouter)
+	api.prometheusIngestion.Register(writeRouter)
+	api.otlpIngestion.Register(writeRouter)
 
 	// monitoring
-	api.metricExplore.Register(router)
-	api.log.Register(router)
-	api.config.Register(router)
+	api.metricExplore.Register(clusterAdmin)
+	api.log.Register(clusterAdmin)
+	api.config.Register(clusterAdmin)
 
-	api.proxy.Register(router)
+	api.proxy.Register(clusterAdmin)
 }
@@ -18,15 +18,40 @@
 package api
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/api/exec"
+	"github.com/lindb/lindb/app/broker/api/influx"
+	"github.com/lindb/lindb/app/broker/api/ingest"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
 )
 
 func TestNewRouter(t *testing.T) {
 	r := NewAPI(&deps.HTTPDeps{BrokerCfg: &config.Broker{}})
 	r.RegisterRouter(gin.New().Group("/api"))
 }
+
+func TestNewRouter_Auth(t *testing.T) {
+	cfg := &config.Broker{}
+	cfg.BrokerBase.User = config.User{UserName: "admin", Password: "admin123", Enabled: true}
+	r := NewAPI(&deps.HTTPDeps{BrokerCfg: cfg})
+	engine := gin.New()
+	r.RegisterRouter(engine.Group("/api"))
+
+	// no token
+	resp := mock.DoRequest(t, engine, http.MethodPut, "/api"+ingest.InfluxWritePath+"?db=db", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	resp = mock.DoRequest(t, engine, http.MethodGet, "/api"+exec.ExecutePath+"?sql=show databases", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	resp = mock.DoRequest(t, engine, http.MethodPost, "/api"+influx.QueryPath+"?q=create%20database%20db", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	// login without token
+	resp = mock.DoRequest(t, engine, http.MethodPut, "/api"+LoginPath, `{"username": "admin", "password": "admin123"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
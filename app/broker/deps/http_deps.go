@@ -41,6 +41,7 @@ type HTTPDeps struct {
 	Repo        state.Repository
 	RepoFactory state.RepositoryFactory
 	StateMgr    broker.StateManager
+	TokenSecret []byte
 
 	CM            replica.ChannelManager
 	IngestLimiter *concurrent.Limiter
@@ -42,6 +42,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -103,6 +104,7 @@ type runtime struct {
 	registry            discovery.Registry
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            broker.StateManager
+	tokenSecret         []byte
 
 	grpcServer rpc.GRPCServer
 	rpcHandler *rpcHandler
@@ -173,6 +175,12 @@ func (r *runtime) Run() error {
 		r.state = server.Failed
 		return err
 	}
+	// load the secret key which signs login token, shared by all brokers
+	if err = r.loadTokenSecret(); err != nil {
+		r.log.Error("failed to load token secret", logger.Error(err))
+		r.state = server.Failed
+		return err
+	}
 	r.globalKeyValues = tag.Tags{
 		{Key: []byte("node"), Value: []byte(r.node.Indicator())},
 		{Key: []byte("role"), Value: []byte(constants.BrokerRole)},
@@ -343,7 +351,6 @@ func (r *runtime) Stop() {
 func (r *runtime) startHTTPServer() {
 	r.log.Info("starting HTTP server")
 	r.httpServer = httppkg.NewServer(r.config.BrokerBase.HTTP, true, linmetric.BrokerRegistry)
-	// TODO login api is not registered
 	httpAPI := api.NewAPI(&deps.HTTPDeps{
 		Ctx:         r.ctx,
 		Node:        r.node,
@@ -352,6 +359,7 @@ func (r *runtime) startHTTPServer() {
 		Repo:        r.repo,
 		RepoFactory: r.repoFactory,
 		StateMgr:    r.stateMgr,
+		TokenSecret: r.tokenSecret,
 		CM:          r.srv.channelManager,
 		IngestLimiter: concurrent.NewLimiter(
 			r.ctx,
@@ -392,6 +400,18 @@ func (r *runtime) startStateRepo() error {
 	return nil
 }
 
+// loadTokenSecret loads the secret key which signs login token from state repo.
+func (r *runtime) loadTokenSecret() error {
+	ctx, cancel := context.WithTimeout(r.ctx, r.config.Coordinator.Timeout.Duration())
+	defer cancel()
+	secret, err := middleware.LoadTokenSecret(ctx, r.repo)
+	if err != nil {
+		return fmt.Errorf("load token secret error:%s", err)
+	}
+	r.tokenSecret = secret
+	return nil
+}
+
 // buildServiceDependency builds broker service dependency
 func (r *runtime) buildServiceDependency() {
 	// create replica channel mgr.
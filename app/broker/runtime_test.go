@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	brokerpkg "github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
@@ -86,6 +87,7 @@ func TestBrokerRuntime_Run(t *testing.T) {
 
 	repoFct := state.NewMockRepositoryFactory(ctrl)
 	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return([]byte("secret"), nil).AnyTimes()
 	cases := []struct {
 		name    string
 		prepare func()
@@ -110,6 +112,15 @@ func TestBrokerRuntime_Run(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "load token secret failure",
+			prepare: func() {
+				failureRepo := state.NewMockRepository(ctrl)
+				failureRepo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, fmt.Errorf("err"))
+				repoFct.EXPECT().CreateBrokerRepo(gomock.Any()).Return(failureRepo, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "create master controller failure",
 			prepare: func() {
@@ -0,0 +1,85 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	LimitPath = "/state/limit"
+)
+
+// LimitAPI represents internal series limit state rest api.
+type LimitAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewLimitAPI creates series limit state api instance.
+func NewLimitAPI(engine tsdb.Engine) *LimitAPI {
+	return &LimitAPI{
+		engine: engine,
+		logger: logger.GetLogger("storage", "LimitAPI"),
+	}
+}
+
+// Register adds limit state url route.
+func (d *LimitAPI) Register(route gin.IRoutes) {
+	route.GET(LimitPath, d.GetLimitState)
+}
+
+// GetLimitState returns the series usage against each limit of database's shards by given database's name.
+func (d *LimitAPI) GetLimitState(c *gin.Context) {
+	var param struct {
+		DB string `form:"db" binding:"required"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db, ok := d.engine.GetDatabase(param.DB)
+	if !ok {
+		httppkg.NotFound(c)
+		return
+	}
+	var rs models.LimitUsages
+	for _, shard := range db.GetShards() {
+		limits, err := shard.IndexDatabase().GetLimitUsage()
+		if err != nil {
+			d.logger.Error("get series limit usage failure",
+				logger.String("database", param.DB),
+				logger.Any("shardID", shard.ShardID()), logger.Error(err))
+			httppkg.Error(c, fmt.Errorf("get series limit usage of shard[%d] failure: %w", shard.ShardID(), err))
+			return
+		}
+		rs = append(rs, models.ShardLimitUsage{
+			ShardID: shard.ShardID(),
+			Limits:  limits,
+		})
+	}
+	httppkg.OK(c, rs)
+}
@@ -0,0 +1,71 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/indexdb"
+)
+
+func TestLimitAPI_GetLimitState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	db.EXPECT().GetShards().Return([]tsdb.Shard{shard}).AnyTimes()
+	api := NewLimitAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, LimitPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, LimitPath+"?db=test", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// case 3: get limit usage failure
+	engine.EXPECT().GetDatabase("test").Return(db, true).AnyTimes()
+	indexDB.EXPECT().GetLimitUsage().Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, LimitPath+"?db=test", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: get limit usage ok
+	indexDB.EXPECT().GetLimitUsage().Return([]models.SeriesLimitUsage{
+		{Scope: models.DatabaseLimitScope, Name: "test", Limit: 10, Usage: 5},
+	}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, LimitPath+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t,
+		`[{"shardId":1,"limits":[{"scope":"database","name":"test","limit":10,"usage":5}]}]`,
+		resp.Body.String())
+}
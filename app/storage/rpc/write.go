@@ -76,6 +76,9 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		return status.Error(codes.Internal, err.Error())
 	}
 
+	// rows are written into shard asynchronously when replaying write ahead log,
+	// so reports the rejected rows of requests which are replayed since last response.
+	var pendingSequences []int64
 	// handle write request from stream
 	for {
 		req, err := server.Recv()
@@ -89,11 +92,14 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 
 		resp := &protoWriteV1.WriteResponse{}
 		// write wal log
-		err = p.WriteLog(req.Record)
+		sequence, err := p.WriteLog(req.Record)
 
 		if err != nil {
 			resp.Err = err.Error()
+		} else if sequence >= 0 {
+			pendingSequences = append(pendingSequences, sequence)
 		}
+		resp.RejectedRows, pendingSequences = takeRejectedRows(p, pendingSequences)
 
 		if err := server.Send(resp); err != nil {
 			return status.Error(codes.Internal, err.Error())
@@ -101,6 +107,20 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 	}
 }
 
+// takeRejectedRows returns the number of rejected rows of requests which are replayed into shard,
+// and the sequences of requests which are not replayed yet.
+func takeRejectedRows(p replica.Partition, sequences []int64) (rejectedRows int64, pending []int64) {
+	for idx, sequence := range sequences {
+		rows, replayed := p.RejectedRows(sequence)
+		if !replayed {
+			// write ahead log is replayed in order
+			return rejectedRows, sequences[idx:]
+		}
+		rejectedRows += rows
+	}
+	return rejectedRows, sequences[:0]
+}
+
 // getFamilyInfoFromCtx returns family state metadata from rpc context.
 func (r *WriteHandler) getFamilyInfoFromCtx(ctx context.Context) (familyState models.FamilyState, err error) {
 	familyStateDate, err := rpc.GetStringFromContext(ctx, constants.RPCMetaKeyFamilyState)
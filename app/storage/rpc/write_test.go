@@ -90,15 +90,35 @@ func TestWriteHandler_Write(t *testing.T) {
 	assert.NoError(t, err)
 	// case 9: write wal err
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(fmt.Errorf("err"))
+	p.EXPECT().WriteLog(gomock.Any()).Return(int64(-1), fmt.Errorf("err"))
 	replicaServer.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
 	// case 10: write wal ok
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	p.EXPECT().WriteLog(gomock.Any()).Return(int64(1), nil)
+	p.EXPECT().RejectedRows(int64(1)).Return(int64(0), true)
 	replicaServer.EXPECT().Send(gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
 	err = r.Write(replicaServer)
 	assert.NoError(t, err)
+	// case 11: report rejected rows of requests in stream
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil).Times(3)
+	gomock.InOrder(
+		p.EXPECT().WriteLog(gomock.Any()).Return(int64(10), nil),
+		p.EXPECT().RejectedRows(int64(10)).Return(int64(0), false),
+		p.EXPECT().WriteLog(gomock.Any()).Return(int64(12), nil),
+		p.EXPECT().RejectedRows(int64(10)).Return(int64(5), true),
+		p.EXPECT().RejectedRows(int64(12)).Return(int64(0), false),
+		p.EXPECT().WriteLog(gomock.Any()).Return(int64(-1), nil),
+		p.EXPECT().RejectedRows(int64(12)).Return(int64(3), true),
+	)
+	gomock.InOrder(
+		replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{}).Return(nil),
+		replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{RejectedRows: 5}).Return(nil),
+		replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{RejectedRows: 3}).Return(nil),
+	)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	err = r.Write(replicaServer)
+	assert.NoError(t, err)
 }
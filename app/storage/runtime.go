@@ -386,6 +386,8 @@ func (r *runtime) startHTTPServer() {
 	exploreAPI.Register(r.httpServer.GetAPIRouter())
 	replicaAPI := stateapi.NewReplicaAPI(r.walMgr)
 	replicaAPI.Register(r.httpServer.GetAPIRouter())
+	limitAPI := stateapi.NewLimitAPI(r.engine)
+	limitAPI.Register(r.httpServer.GetAPIRouter())
 	stateMachineAPI := stateapi.NewStorageStateMachineAPI(r.stateMgr)
 	stateMachineAPI.Register(r.httpServer.GetAPIRouter())
 	logAPI := monitoring.NewLoggerAPI(r.config.Logging.Dir)
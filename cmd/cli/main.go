@@ -68,6 +68,9 @@ var (
 		{Text: "databases"},
 		{Text: "group by"},
 		{Text: "select"},
+		{Text: "delete"},
+		{Text: "alter"},
+		{Text: "rename"},
 		{Text: "from"},
 		{Text: "where"},
 		{Text: "namespaces"},
@@ -81,6 +84,14 @@ var (
 		{Text: "key"},
 		{Text: "values"},
 		{Text: "and"},
+		{Text: "user"},
+		{Text: "users"},
+		{Text: "role"},
+		{Text: "roles"},
+		{Text: "grant"},
+		{Text: "revoke"},
+		{Text: "limits"},
+		{Text: "cardinality"},
 	}
 	spacesPattern = regexp.MustCompile(`\s+`)
 	inputC        = &inputCtx{}
@@ -143,6 +154,12 @@ func executor(in string) {
 					result = &models.Master{}
 				case stmtpkg.BrokerAlive:
 					result = &models.StatelessNodes{}
+				case stmtpkg.Limit:
+					result = &models.LimitUsages{}
+					if strings.TrimSpace(s.Database) == "" && strings.TrimSpace(inputC.db) == "" {
+						printErr(errors.New("please select database(use ...)"))
+						return
+					}
 				}
 			case *stmtpkg.Schema:
 				switch s.Type {
@@ -151,6 +168,13 @@ func executor(in string) {
 				case stmtpkg.DatabaseSchemaType:
 					result = &models.Databases{}
 				}
+			case *stmtpkg.User:
+				switch s.Type {
+				case stmtpkg.ShowUsersType:
+					result = &models.Users{}
+				case stmtpkg.ShowRolesType:
+					result = &models.Roles{}
+				}
 			case *stmtpkg.MetricMetadata:
 				result = &models.Metadata{}
 			case *stmtpkg.Query:
@@ -159,6 +183,18 @@ func executor(in string) {
 					printErr(errors.New("please select database(use ...)"))
 					return
 				}
+			case *stmtpkg.Delete:
+				result = &models.DeleteResult{}
+				if strings.TrimSpace(inputC.db) == "" {
+					printErr(errors.New("please select database(use ...)"))
+					return
+				}
+			case *stmtpkg.MetricSchema:
+				result = &models.DeleteResult{}
+				if strings.TrimSpace(inputC.db) == "" {
+					printErr(errors.New("please select database(use ...)"))
+					return
+				}
 			}
 			rs, err := cli.ExecuteAsResult(models.ExecuteParam{SQL: query, Database: inputC.db}, result)
 			if err != nil {
@@ -78,15 +78,21 @@ ingest-timeout = "%s"`,
 type User struct {
 	UserName string `toml:"username" json:"username" binding:"required"`
 	Password string `toml:"password" json:"password" binding:"required"`
+	Enabled  bool   `toml:"enabled" json:"-"`
 }
 
 func (u *User) TOML() string {
 	return fmt.Sprintf(`
 ## admin user setting
 username = "%s"
-password = "%s"`,
+password = "%s"
+## enable authentication/authorization for http api,
+## admin user has all permissions, other users/roles are managed by LinQL(create user/grant etc.)
+## Default: false
+enabled = %v`,
 		u.UserName,
-		u.Password)
+		u.Password,
+		u.Enabled)
 }
 
 // Write represents config for write replication in broker.
@@ -58,6 +58,14 @@ const (
 	StorageConfigPath = "/storage/config"
 	// StorageStatePath represents storage cluster's state.
 	StorageStatePath = "/storage/state"
+	// UserConfigPath represents user config path.
+	UserConfigPath = "/user/config"
+	// RoleConfigPath represents role config path.
+	RoleConfigPath = "/role/config"
+	// APITokenConfigPath represents api token config path.
+	APITokenConfigPath = "/api-token/config"
+	// TokenSecretPath represents the path of secret key which signs the login token.
+	TokenSecretPath = "/token/secret"
 )
 
 // GetStorageClusterConfigPath returns path which storing config of storage cluster
@@ -79,6 +87,21 @@ func GetDatabaseAssignPath(name string) string {
 	return fmt.Sprintf("%s/%s", ShardAssigmentPath, name)
 }
 
+// GetUserConfigPath returns path which storing config of user
+func GetUserConfigPath(name string) string {
+	return fmt.Sprintf("%s/%s", UserConfigPath, name)
+}
+
+// GetRoleConfigPath returns path which storing config of role
+func GetRoleConfigPath(name string) string {
+	return fmt.Sprintf("%s/%s", RoleConfigPath, name)
+}
+
+// GetAPITokenConfigPath returns path which storing config of api token
+func GetAPITokenConfigPath(id string) string {
+	return fmt.Sprintf("%s/%s", APITokenConfigPath, id)
+}
+
 // GetLiveNodePath returns live node register path.
 func GetLiveNodePath(node string) string {
 	return fmt.Sprintf("%s/%s", LiveNodesPath, node)
@@ -42,3 +42,15 @@ func TestGetStorageClusterConfigPath(t *testing.T) {
 func TestGetStorageStatePath(t *testing.T) {
 	assert.Equal(t, StorageStatePath+"/name", GetStorageStatePath("name"))
 }
+
+func TestGetUserConfigPath(t *testing.T) {
+	assert.Equal(t, UserConfigPath+"/name", GetUserConfigPath("name"))
+}
+
+func TestGetRoleConfigPath(t *testing.T) {
+	assert.Equal(t, RoleConfigPath+"/name", GetRoleConfigPath("name"))
+}
+
+func TestGetAPITokenConfigPath(t *testing.T) {
+	assert.Equal(t, APITokenConfigPath+"/id", GetAPITokenConfigPath("id"))
+}
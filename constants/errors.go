@@ -62,4 +62,12 @@ var (
 	ErrDatabaseNameRequired = errors.New("database name cannot be empty")
 	// ErrStorageNameRequired represents storage name not input.
 	ErrStorageNameRequired = errors.New("storage name cannot be empty")
+	// ErrUnauthorized represents the request without valid token.
+	ErrUnauthorized = errors.New("authorization token invalid")
+	// ErrForbidden represents the user has no permission to access the resource.
+	ErrForbidden = errors.New("permission denied")
+	// ErrUserNotFound represents user not exist.
+	ErrUserNotFound = fmt.Errorf("user %w", ErrNotFound)
+	// ErrRoleNotFound represents role not exist.
+	ErrRoleNotFound = fmt.Errorf("role %w", ErrNotFound)
 )
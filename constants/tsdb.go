@@ -38,4 +38,11 @@ const (
 
 	// DefaultNamespace represents default namespace if not set
 	DefaultNamespace = "default-ns"
+
+	// PrometheusValueField represents the field name which stores the sample value of prometheus series.
+	PrometheusValueField = "value"
+	// PrometheusBucketSuffix represents the metric name suffix of prometheus histogram buckets.
+	PrometheusBucketSuffix = "_bucket"
+	// PrometheusBucketLabel represents the label name of prometheus histogram bucket upper bound.
+	PrometheusBucketLabel = "le"
 )
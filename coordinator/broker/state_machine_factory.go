@@ -98,6 +98,27 @@ func (f *stateMachineFactory) Start() (err error) {
 	}
 	f.stateMachines = append(f.stateMachines, sm)
 
+	f.logger.Debug("starting UserConfigStateMachine")
+	sm, err = f.createUserCfgStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
+	f.logger.Debug("starting RoleConfigStateMachine")
+	sm, err = f.createRoleCfgStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
+	f.logger.Debug("starting APITokenConfigStateMachine")
+	sm, err = f.createAPITokenCfgStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
 	f.logger.Info("started BrokerStateMachines")
 	return nil
 }
@@ -151,6 +172,96 @@ func (f *stateMachineFactory) createStorageStatusStateMachine() (discovery.State
 	)
 }
 
+// createUserCfgStateMachine creates user config state machine.
+func (f *stateMachineFactory) createUserCfgStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.UserConfigStateMachine,
+		f.discoveryFactory,
+		constants.UserConfigPath,
+		true,
+		f.onUserConfigChanged,
+		f.onUserConfigDeletion,
+	)
+}
+
+// createRoleCfgStateMachine creates role config state machine.
+func (f *stateMachineFactory) createRoleCfgStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.RoleConfigStateMachine,
+		f.discoveryFactory,
+		constants.RoleConfigPath,
+		true,
+		f.onRoleConfigChanged,
+		f.onRoleConfigDeletion,
+	)
+}
+
+// createAPITokenCfgStateMachine creates api token config state machine.
+func (f *stateMachineFactory) createAPITokenCfgStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.APITokenConfigStateMachine,
+		f.discoveryFactory,
+		constants.APITokenConfigPath,
+		true,
+		f.onAPITokenConfigChanged,
+		f.onAPITokenConfigDeletion,
+	)
+}
+
+// onAPITokenConfigChanged triggers when api token config modified(create/update).
+func (f *stateMachineFactory) onAPITokenConfigChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenConfigChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onAPITokenConfigDeletion triggers when api token is deletion.
+func (f *stateMachineFactory) onAPITokenConfigDeletion(key string) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type: discovery.APITokenConfigDeletion,
+		Key:  key,
+	})
+}
+
+// onUserConfigChanged triggers when user config modified(create/update).
+func (f *stateMachineFactory) onUserConfigChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.UserConfigChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onUserConfigDeletion triggers when user is deletion.
+func (f *stateMachineFactory) onUserConfigDeletion(key string) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type: discovery.UserConfigDeletion,
+		Key:  key,
+	})
+}
+
+// onRoleConfigChanged triggers when role config modified(create/update).
+func (f *stateMachineFactory) onRoleConfigChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RoleConfigChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onRoleConfigDeletion triggers when role is deletion.
+func (f *stateMachineFactory) onRoleConfigDeletion(key string) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type: discovery.RoleConfigDeletion,
+		Key:  key,
+	})
+}
+
 // onDatabaseConfigChanged triggers when database config modified(create/update)
 func (f *stateMachineFactory) onDatabaseConfigChanged(key string, data []byte) {
 	f.stateMgr.EmitEvent(&discovery.Event{
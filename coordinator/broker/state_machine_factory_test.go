@@ -54,10 +54,23 @@ func TestStateMachineFactory_Start(t *testing.T) {
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
 	err = fct.Start()
 	assert.Error(t, err)
+	// user config sm err
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).Times(3)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// role config sm err
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).Times(4)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// api token config sm err
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).Times(5)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
 	// all state machines are ok
-	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil)
-	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil)
-	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).Times(6)
 	err = fct.Start()
 	assert.NoError(t, err)
 }
@@ -137,6 +150,57 @@ func TestStateMachineFactory_OnStorage(t *testing.T) {
 	fct1.onStorageStateChange("/key", []byte("value"))
 }
 
+func TestStateMachineFactory_OnUserAndRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	fct := NewStateMachineFactory(context.TODO(), nil, stateMgr)
+	fct1 := fct.(*stateMachineFactory)
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.UserConfigDeletion,
+		Key:  "/key",
+	})
+	fct1.onUserConfigDeletion("/key")
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.UserConfigChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onUserConfigChanged("/key", []byte("value"))
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.RoleConfigDeletion,
+		Key:  "/key",
+	})
+	fct1.onRoleConfigDeletion("/key")
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.RoleConfigChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onRoleConfigChanged("/key", []byte("value"))
+}
+
+func TestStateMachineFactory_OnAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	fct := NewStateMachineFactory(context.TODO(), nil, stateMgr)
+	fct1 := fct.(*stateMachineFactory)
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.APITokenConfigDeletion,
+		Key:  "/key",
+	})
+	fct1.onAPITokenConfigDeletion("/key")
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenConfigChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onAPITokenConfigChanged("/key", []byte("value"))
+}
+
 func TestStateMachineFactory_CreateState(t *testing.T) {
 	assert.NotNil(t, StateMachinePaths[constants.LiveNode].CreateState())
 	assert.NotNil(t, StateMachinePaths[constants.DatabaseConfig].CreateState())
@@ -50,10 +50,19 @@ type StateManager interface {
 	// and chooses the leader replica if the shard has multi-replica.
 	// returns storage node => shard id list
 	GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error)
+	// GetReplicas returns all live replicas of the online shards,
+	// returns storage node => shard id list
+	GetReplicas(databaseName string) (map[string][]models.ShardID, error)
 	// GetStorage returns storage state by name.
 	GetStorage(name string) (*models.StorageState, bool)
 	// GetStorageList returns all storage state list.
 	GetStorageList() (rs []*models.StorageState)
+	// GetUser returns the user by name.
+	GetUser(name string) (models.User, bool)
+	// GetRole returns the role by name.
+	GetRole(name string) (models.Role, bool)
+	// GetAPIToken returns the api token by id.
+	GetAPIToken(id string) (models.APIToken, bool)
 
 	WatchShardStateChangeEvent(fn func(databaseCfg models.Database,
 		shards map[models.ShardID]models.ShardState,
@@ -71,6 +80,9 @@ type stateManager struct {
 	storages    map[string]*models.StorageState // storage state
 	databases   map[string]models.Database      // database config
 	nodes       map[string]models.StatelessNode // broker live nodes
+	users       map[string]models.User          // user config
+	roles       map[string]models.Role          // role config
+	apiTokens   map[string]models.APIToken      // api token config
 
 	callbacks []func(databaseCfg models.Database,
 		shards map[models.ShardID]models.ShardState,
@@ -104,6 +116,9 @@ func NewStateManager(
 		storages:          make(map[string]*models.StorageState),
 		databases:         make(map[string]models.Database),
 		nodes:             make(map[string]models.StatelessNode),
+		users:             make(map[string]models.User),
+		roles:             make(map[string]models.Role),
+		apiTokens:         make(map[string]models.APIToken),
 		events:            make(chan *discovery.Event, 10),
 		statistics:        metrics.NewStateManagerStatistics(strings.ToLower(constants.BrokerRole)),
 		logger:            logger.GetLogger("broker", "StateManager"),
@@ -177,6 +192,18 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		err = m.onStorageStateChange(event.Key, event.Value)
 	case discovery.StorageStateDeletion:
 		m.onStorageDelete(event.Key)
+	case discovery.UserConfigChanged:
+		err = m.onUserCfgChange(event.Key, event.Value)
+	case discovery.UserConfigDeletion:
+		m.onUserCfgDelete(event.Key)
+	case discovery.RoleConfigChanged:
+		err = m.onRoleCfgChange(event.Key, event.Value)
+	case discovery.RoleConfigDeletion:
+		m.onRoleCfgDelete(event.Key)
+	case discovery.APITokenConfigChanged:
+		err = m.onAPITokenCfgChange(event.Key, event.Value)
+	case discovery.APITokenConfigDeletion:
+		m.onAPITokenCfgDelete(event.Key)
 	}
 	if err != nil {
 		m.statistics.HandleEventFailure.WithTagValues(eventType).Incr()
@@ -216,6 +243,85 @@ func (m *stateManager) onDatabaseCfgDelete(key string) {
 	delete(m.databases, databaseName)
 }
 
+// onUserCfgChange triggers when user create/modify.
+func (m *stateManager) onUserCfgChange(key string, data []byte) error {
+	// user config includes password hash, don't log the data
+	m.logger.Info("user config is modified", logger.String("key", key))
+
+	user := models.User{}
+	if err := encoding.JSONUnmarshal(data, &user); err != nil {
+		m.logger.Error("user config modified but unmarshal error", logger.Error(err))
+		return err
+	}
+	if user.Name == "" {
+		m.logger.Error("user name cannot be empty")
+		return constants.ErrNameEmpty
+	}
+	m.users[user.Name] = user
+	return nil
+}
+
+// onUserCfgDelete triggers when user is deletion.
+func (m *stateManager) onUserCfgDelete(key string) {
+	m.logger.Info("user config deleted", logger.String("key", key))
+
+	_, name := filepath.Split(key)
+	delete(m.users, name)
+}
+
+// onRoleCfgChange triggers when role create/modify.
+func (m *stateManager) onRoleCfgChange(key string, data []byte) error {
+	m.logger.Info("role config is modified",
+		logger.String("key", key),
+		logger.String("data", string(data)))
+
+	role := models.Role{}
+	if err := encoding.JSONUnmarshal(data, &role); err != nil {
+		m.logger.Error("role config modified but unmarshal error", logger.Error(err))
+		return err
+	}
+	if role.Name == "" {
+		m.logger.Error("role name cannot be empty")
+		return constants.ErrNameEmpty
+	}
+	m.roles[role.Name] = role
+	return nil
+}
+
+// onRoleCfgDelete triggers when role is deletion.
+func (m *stateManager) onRoleCfgDelete(key string) {
+	m.logger.Info("role config deleted", logger.String("key", key))
+
+	_, name := filepath.Split(key)
+	delete(m.roles, name)
+}
+
+// onAPITokenCfgChange triggers when api token create/modify.
+func (m *stateManager) onAPITokenCfgChange(key string, data []byte) error {
+	// api token config includes secret hash, don't log the data
+	m.logger.Info("api token config is modified", logger.String("key", key))
+
+	token := models.APIToken{}
+	if err := encoding.JSONUnmarshal(data, &token); err != nil {
+		m.logger.Error("api token config modified but unmarshal error", logger.Error(err))
+		return err
+	}
+	if token.ID == "" {
+		m.logger.Error("api token id cannot be empty")
+		return constants.ErrNameEmpty
+	}
+	m.apiTokens[token.ID] = token
+	return nil
+}
+
+// onAPITokenCfgDelete triggers when api token is deletion(revoked).
+func (m *stateManager) onAPITokenCfgDelete(key string) {
+	m.logger.Info("api token config deleted", logger.String("key", key))
+
+	_, id := filepath.Split(key)
+	delete(m.apiTokens, id)
+}
+
 // onNodeStartup triggers when broker node online.
 func (m *stateManager) onNodeStartup(key string, data []byte) error {
 	m.logger.Info("new broker node online",
@@ -377,16 +483,106 @@ func (m *stateManager) GetStorageList() (rs []*models.StorageState) {
 	return
 }
 
+// GetUser returns the user by name.
+func (m *stateManager) GetUser(name string) (models.User, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	user, ok := m.users[name]
+	return user, ok
+}
+
+// GetRole returns the role by name.
+func (m *stateManager) GetRole(name string) (models.Role, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	role, ok := m.roles[name]
+	return role, ok
+}
+
+// GetAPIToken returns the api token by id.
+func (m *stateManager) GetAPIToken(id string) (models.APIToken, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	token, ok := m.apiTokens[id]
+	return token, ok
+}
+
 // GetQueryableReplicas returns the queryable replicas, else return detail error msg.::x
 // returns storage node => shard id list
 func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error) {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
+	storage, liveNodes, shards, err := m.getShardStates(databaseName)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]models.ShardID)
+	for shardID, shardState := range shards {
+		if shardState.State == models.OnlineShard {
+			node := liveNodes[shardState.Leader]
+			nodeID := node.Indicator()
+			result[nodeID] = append(result[nodeID], shardID)
+		} else {
+			m.logger.Warn("shard is not online ignore it, maybe query data will be lost",
+				logger.String("storage", storage),
+				logger.String("database", databaseName),
+				logger.Any("shard", shardState.ID))
+		}
+	}
+	return result, nil
+}
+
+// GetReplicas returns all live replicas of the online shards, else return detail error msg.
+// returns storage node => shard id list
+func (m *stateManager) GetReplicas(databaseName string) (map[string][]models.ShardID, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	storage, liveNodes, shards, err := m.getShardStates(databaseName)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]models.ShardID)
+	for shardID, shardState := range shards {
+		if shardState.State != models.OnlineShard {
+			m.logger.Warn("shard is not online ignore it",
+				logger.String("storage", storage),
+				logger.String("database", databaseName),
+				logger.Any("shard", shardState.ID))
+			continue
+		}
+		for _, replica := range shardState.Replica.Replicas {
+			node, ok := liveNodes[replica]
+			if !ok {
+				m.logger.Warn("replica of shard is not alive ignore it",
+					logger.String("storage", storage),
+					logger.String("database", databaseName),
+					logger.Any("shard", shardState.ID),
+					logger.Any("replica", replica))
+				continue
+			}
+			nodeID := node.Indicator()
+			result[nodeID] = append(result[nodeID], shardID)
+		}
+	}
+	return result, nil
+}
+
+// getShardStates returns the storage name, live nodes and shard states of database, else return detail error msg.
+func (m *stateManager) getShardStates(databaseName string) (
+	storage string,
+	liveNodes map[models.NodeID]models.StatefulNode,
+	shards map[models.ShardID]models.ShardState,
+	err error,
+) {
 	// 1. check database if exist
 	database, ok := m.databases[databaseName]
 	if !ok {
-		return nil, constants.ErrDatabaseNotFound
+		return "", nil, nil, constants.ErrDatabaseNotFound
 	}
 
 	// 2. check shards if exist
@@ -395,38 +591,24 @@ func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]m
 		m.logger.Warn("database not run on any storage",
 			logger.String("storage", database.Storage),
 			logger.String("database", databaseName))
-		return nil, constants.ErrNoStorageCluster
+		return "", nil, nil, constants.ErrNoStorageCluster
 	}
 	// check if it has live nodes
-	liveNodes := storageState.LiveNodes
+	liveNodes = storageState.LiveNodes
 	if len(liveNodes) == 0 {
 		m.logger.Warn("there is no live node for this storage",
 			logger.String("storage", database.Storage),
 			logger.String("database", databaseName))
-		return nil, constants.ErrNoLiveNode
+		return "", nil, nil, constants.ErrNoLiveNode
 	}
-	shards := storageState.ShardStates[databaseName]
+	shards = storageState.ShardStates[databaseName]
 	if len(shards) == 0 {
 		m.logger.Warn("there is no shard for this database",
 			logger.String("storage", database.Storage),
 			logger.String("database", databaseName))
-		return nil, constants.ErrShardNotFound
-	}
-
-	result := make(map[string][]models.ShardID)
-	for shardID, shardState := range shards {
-		if shardState.State == models.OnlineShard {
-			node := liveNodes[shardState.Leader]
-			nodeID := node.Indicator()
-			result[nodeID] = append(result[nodeID], shardID)
-		} else {
-			m.logger.Warn("shard is not online ignore it, maybe query data will be lost",
-				logger.String("storage", database.Storage),
-				logger.String("database", databaseName),
-				logger.Any("shard", shardState.ID))
-		}
+		return "", nil, nil, constants.ErrShardNotFound
 	}
-	return result, nil
+	return database.Storage, liveNodes, shards, nil
 }
 
 // buildShardAssign builds the data write channel and related shard state.
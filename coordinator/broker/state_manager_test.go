@@ -19,6 +19,7 @@ package broker
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -296,3 +297,148 @@ func TestStateManager_ShardState(t *testing.T) {
 
 	assert.True(t, c > 0)
 }
+
+func TestStateManager_GetReplicas(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	defer mgr.Close()
+	mgr1 := mgr.(*stateManager)
+	mgr1.mutex.Lock()
+	mgr1.databases = map[string]models.Database{
+		"test": {Storage: "test_not_exist"},
+		"db":   {Storage: "test"}}
+	mgr1.storages = map[string]*models.StorageState{
+		"test": {
+			Name: "test",
+			ShardStates: map[string]map[models.ShardID]models.ShardState{
+				"db": {
+					1: models.ShardState{ID: 1, State: models.OnlineShard, Replica: models.Replica{Replicas: []models.NodeID{1, 2, 3}}},
+					2: models.ShardState{ID: 2, Replica: models.Replica{Replicas: []models.NodeID{1, 2}}},
+					3: models.ShardState{ID: 3, State: models.OnlineShard, Replica: models.Replica{Replicas: []models.NodeID{2}}},
+				},
+			},
+			LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+				StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000},
+			}, 2: {
+				StatelessNode: models.StatelessNode{HostIP: "2.2.2.2", GRPCPort: 9000},
+			}},
+		},
+	}
+	mgr1.mutex.Unlock()
+
+	// db not exist
+	replicas, err := mgr.GetReplicas("test_db")
+	assert.Equal(t, constants.ErrDatabaseNotFound, err)
+	assert.Empty(t, replicas)
+	// storage not exist
+	replicas, err = mgr.GetReplicas("test")
+	assert.Equal(t, constants.ErrNoStorageCluster, err)
+	assert.Empty(t, replicas)
+	// all live replicas of online shards
+	replicas, err = mgr.GetReplicas("db")
+	assert.NoError(t, err)
+	sort.Slice(replicas["2.2.2.2:9000"], func(i, j int) bool {
+		return replicas["2.2.2.2:9000"][i] < replicas["2.2.2.2:9000"][j]
+	})
+	assert.Equal(t, map[string][]models.ShardID{
+		"1.1.1.1:9000": {1},
+		"2.2.2.2:9000": {1, 3},
+	}, replicas)
+}
+
+func TestStateManager_UserAndRole(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	// case 1: unmarshal cfg err
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.UserConfigChanged,
+		Key:   "/user/config/test",
+		Value: []byte("221"),
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RoleConfigChanged,
+		Key:   "/role/config/dev",
+		Value: []byte("221"),
+	})
+	// case 2: name empty
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.UserConfigChanged,
+		Key:   "/user/config/test",
+		Value: []byte("{}"),
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RoleConfigChanged,
+		Key:   "/role/config/dev",
+		Value: []byte("{}"),
+	})
+	// case 3: cache user/role config
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.UserConfigChanged,
+		Key:   "/user/config/test",
+		Value: []byte(`{"name":"test","roles":["dev"]}`),
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RoleConfigChanged,
+		Key:   "/role/config/dev",
+		Value: []byte(`{"name":"dev"}`),
+	})
+	time.Sleep(time.Second) // wait
+	user, ok := mgr.GetUser("test")
+	assert.True(t, ok)
+	assert.Equal(t, models.User{Name: "test", Roles: []string{"dev"}}, user)
+	role, ok := mgr.GetRole("dev")
+	assert.True(t, ok)
+	assert.Equal(t, models.Role{Name: "dev"}, role)
+
+	// case 4: remove user/role config
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.UserConfigDeletion,
+		Key:  "/user/config/test",
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.RoleConfigDeletion,
+		Key:  "/role/config/dev",
+	})
+	time.Sleep(time.Second) // wait
+	_, ok = mgr.GetUser("test")
+	assert.False(t, ok)
+	_, ok = mgr.GetRole("dev")
+	assert.False(t, ok)
+
+	mgr.Close()
+}
+
+func TestStateManager_APIToken(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	// case 1: unmarshal cfg err
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenConfigChanged,
+		Key:   "/api-token/config/id",
+		Value: []byte("221"),
+	})
+	// case 2: id empty
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenConfigChanged,
+		Key:   "/api-token/config/id",
+		Value: []byte("{}"),
+	})
+	// case 3: cache api token config
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenConfigChanged,
+		Key:   "/api-token/config/id",
+		Value: []byte(`{"id":"id","name":"agent"}`),
+	})
+	time.Sleep(time.Second) // wait
+	token, ok := mgr.GetAPIToken("id")
+	assert.True(t, ok)
+	assert.Equal(t, models.APIToken{ID: "id", Name: "agent"}, token)
+
+	// case 4: remove api token config
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.APITokenConfigDeletion,
+		Key:  "/api-token/config/id",
+	})
+	time.Sleep(time.Second) // wait
+	_, ok = mgr.GetAPIToken("id")
+	assert.False(t, ok)
+
+	mgr.Close()
+}
@@ -31,6 +31,12 @@ const (
 	StorageStateDeletion
 	StorageConfigChanged
 	StorageConfigDeletion
+	UserConfigChanged
+	UserConfigDeletion
+	RoleConfigChanged
+	RoleConfigDeletion
+	APITokenConfigChanged
+	APITokenConfigDeletion
 )
 
 // String returns string value of EventType.
@@ -56,6 +62,18 @@ func (e EventType) String() string {
 		return "StorageConfigChanged"
 	case StorageConfigDeletion:
 		return "StorageConfigDeletion"
+	case UserConfigChanged:
+		return "UserConfigChanged"
+	case UserConfigDeletion:
+		return "UserConfigDeletion"
+	case RoleConfigChanged:
+		return "RoleConfigChanged"
+	case RoleConfigDeletion:
+		return "RoleConfigDeletion"
+	case APITokenConfigChanged:
+		return "APITokenConfigChanged"
+	case APITokenConfigDeletion:
+		return "APITokenConfigDeletion"
 	default:
 		return "unknown"
 	}
@@ -35,4 +35,10 @@ func TestEventType_String(t *testing.T) {
 	assert.Equal(t, "ShardAssignmentChanged", ShardAssignmentChanged.String())
 	assert.Equal(t, "StorageConfigDeletion", StorageConfigDeletion.String())
 	assert.Equal(t, "StorageConfigChanged", StorageConfigChanged.String())
+	assert.Equal(t, "UserConfigChanged", UserConfigChanged.String())
+	assert.Equal(t, "UserConfigDeletion", UserConfigDeletion.String())
+	assert.Equal(t, "RoleConfigChanged", RoleConfigChanged.String())
+	assert.Equal(t, "RoleConfigDeletion", RoleConfigDeletion.String())
+	assert.Equal(t, "APITokenConfigChanged", APITokenConfigChanged.String())
+	assert.Equal(t, "APITokenConfigDeletion", APITokenConfigDeletion.String())
 }
@@ -42,6 +42,9 @@ const (
 	StorageStatusStateMachine
 	StorageConfigStateMachine
 	StorageNodeStateMachine
+	UserConfigStateMachine
+	RoleConfigStateMachine
+	APITokenConfigStateMachine
 )
 
 // String returns state machine type desc.
@@ -59,6 +62,12 @@ func (st StateMachineType) String() string {
 		return "StorageConfigStateMachine"
 	case StorageNodeStateMachine:
 		return "StorageNodeStateMachine"
+	case UserConfigStateMachine:
+		return "UserConfigStateMachine"
+	case RoleConfigStateMachine:
+		return "RoleConfigStateMachine"
+	case APITokenConfigStateMachine:
+		return "APITokenConfigStateMachine"
 	default:
 		return "Unknown"
 	}
@@ -33,6 +33,9 @@ func TestStateMachineType_String(t *testing.T) {
 	assert.Equal(t, StorageStatusStateMachine.String(), "StorageStatusStateMachine")
 	assert.Equal(t, StorageConfigStateMachine.String(), "StorageConfigStateMachine")
 	assert.Equal(t, StorageNodeStateMachine.String(), "StorageNodeStateMachine")
+	assert.Equal(t, UserConfigStateMachine.String(), "UserConfigStateMachine")
+	assert.Equal(t, RoleConfigStateMachine.String(), "RoleConfigStateMachine")
+	assert.Equal(t, APITokenConfigStateMachine.String(), "APITokenConfigStateMachine")
 	assert.Equal(t, (StateMachineType(0)).String(), "Unknown")
 }
 
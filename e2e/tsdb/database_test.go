@@ -85,7 +85,7 @@ func TestDatabase_Write_And_Rollup(t *testing.T) {
 			}},
 		})
 
-		err = shard.LookupRowMetricMeta(rows)
+		_, err = shard.LookupRowMetricMeta(rows)
 		assert.NoError(t, err)
 
 		err = f.WriteRows(rows)
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/binary"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -80,6 +81,9 @@ type StorageExecuteContext struct {
 	// set value in plan stage when lookup group by tags.
 	GroupByTags      tag.Metas
 	GroupByTagKeyIDs []tag.KeyID
+	// tag value transforms for each group tag, nil if group by source tag key,
+	// grouping tag value ids of group tag which has transform are derived tag value ids.
+	GroupingTagTransforms []*TagValueTransform
 	// for group by query store tag value ids for each group tag key
 	GroupingTagValueIDs []*roaring.Bitmap
 
@@ -108,9 +112,21 @@ func (ctx *StorageExecuteContext) CalcSourceSlotRange(familyTime int64) timeutil
 	return ctx.Query.StorageInterval.CalcSlotRange(familyTime, ctx.Query.TimeRange)
 }
 
-// CalcTargetSlotRange returns slot range for aggregator by family time and query time range.
+// CalcTargetSlotRange returns slot range for aggregator by family time and query time range,
+// the time slots are aligned to the session time zone of query.
 func (ctx *StorageExecuteContext) CalcTargetSlotRange(familyTime int64) timeutil.SlotRange {
-	return ctx.Query.Interval.CalcSlotRange(familyTime, ctx.Query.TimeRange)
+	return ctx.Query.Interval.CalcSlotRangeInLocation(familyTime, ctx.Query.TimeRange, ctx.Query.Location())
+}
+
+// CalcAlignSlots returns the number of storage slots which shifts the source slots of family,
+// so that the source slots are down sampled into the time slots aligned to the session time zone of query.
+func (ctx *StorageExecuteContext) CalcAlignSlots(familyTime int64) uint16 {
+	storageInterval := ctx.Query.StorageInterval.Int64()
+	if storageInterval <= 0 {
+		return 0
+	}
+	offset := timeutil.CalcAlignOffset(familyTime, ctx.Query.Interval.Int64(), ctx.Query.Location())
+	return uint16(offset / storageInterval)
 }
 
 // HasGroupingTagValueIDs returns if it needs collect grouping tag value.
@@ -288,6 +304,15 @@ type DataLoadContext struct {
 
 	Decoder      *encoding.TSDDecoder
 	DownSampling func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter)
+	// DownSamplingRollup does down sampling for the field which has rollup aggregates(e.g. max/count of gauge),
+	// aggGetters are the materialized aggregates, their values are partial results of aggTypes.
+	DownSamplingRollup func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter,
+		aggTypes []field.AggType, aggGetters []encoding.TSDValueGetter)
+
+	Dictionary   field.Dictionary                 // dictionary of string fields loaded from storage
+	StringValues map[string]models.StringValueSet // grouping key => values of string fields and median/count distinct
+
+	adjacentAggregators []*aggregation.AdjacentAggregator // field index => adjacent aggregator, lazy init
 }
 
 // PrepareAggregatorWithoutGrouping prepares context for without grouping query.
@@ -336,6 +361,7 @@ func (ctx *DataLoadContext) newSeriesAggregators() []aggregation.SeriesAggregato
 			ctx.ShardExecuteCtx.StorageExecuteCtx.Query.Interval,
 			ctx.ShardExecuteCtx.StorageExecuteCtx.Query.IntervalRatio,
 			ctx.ShardExecuteCtx.StorageExecuteCtx.Query.TimeRange,
+			ctx.ShardExecuteCtx.StorageExecuteCtx.Query.Location(),
 			ctx.ShardExecuteCtx.StorageExecuteCtx.DownSamplingSpecs[fieldIdx])
 	}
 	return rs
@@ -347,9 +373,41 @@ func (ctx *DataLoadContext) newSeriesAggregator(fieldIdx int) aggregation.Series
 		ctx.ShardExecuteCtx.StorageExecuteCtx.Query.Interval,
 		ctx.ShardExecuteCtx.StorageExecuteCtx.Query.IntervalRatio,
 		ctx.ShardExecuteCtx.StorageExecuteCtx.Query.TimeRange,
+		ctx.ShardExecuteCtx.StorageExecuteCtx.Query.Location(),
 		ctx.ShardExecuteCtx.StorageExecuteCtx.DownSamplingSpecs[fieldIdx])
 }
 
+// GetAdjacentAggregator returns the adjacent aggregator of field, returns nil if field hasn't adjacent agg types.
+// adjacent aggregator keeps the last data point of each series across families, because families are loaded in order.
+func (ctx *DataLoadContext) GetAdjacentAggregator(fieldIdx int) *aggregation.AdjacentAggregator {
+	if ctx.adjacentAggregators == nil {
+		storageExecuteCtx := ctx.ShardExecuteCtx.StorageExecuteCtx
+		ctx.adjacentAggregators = make([]*aggregation.AdjacentAggregator, len(storageExecuteCtx.DownSamplingSpecs))
+		for idx, spec := range storageExecuteCtx.DownSamplingSpecs {
+			ctx.adjacentAggregators[idx] = aggregation.NewAdjacentAggregator(spec, storageExecuteCtx.Query.Interval.Int64())
+		}
+	}
+	return ctx.adjacentAggregators[fieldIdx]
+}
+
+// FlushAdjacentAggregators calculates the adjacent agg types of the series collected from all result sets of family,
+// then aggregates them into series aggregators, returns true if any series is flushed.
+func (ctx *DataLoadContext) FlushAdjacentAggregators(familyTime int64, timestamp func(pos int) int64) bool {
+	flushed := false
+	for idx := range ctx.adjacentAggregators {
+		adjacentAgg := ctx.adjacentAggregators[idx]
+		if adjacentAgg == nil || adjacentAgg.IsEmpty() {
+			continue
+		}
+		fieldIdx := idx
+		adjacentAgg.Flush(func(seriesIdx uint32) (aggregation.FieldAggregator, bool) {
+			return ctx.GetSeriesAggregator(uint16(seriesIdx), fieldIdx).GetAggregator(familyTime)
+		}, timestamp)
+		flushed = true
+	}
+	return flushed
+}
+
 // HasGroupingData returns if it is grouping data.
 func (ctx *DataLoadContext) HasGroupingData() bool {
 	if ctx.IsGrouping {
@@ -418,6 +476,73 @@ func (ctx *DataLoadContext) IterateLowSeriesIDs(lowSeriesIDsFromStorage roaring.
 	}
 }
 
+// IterateGroupingSeries iterates the series ids which query need with grouping key of series,
+// grouping key is empty if query without grouping.
+func (ctx *DataLoadContext) IterateGroupingSeries(fn func(seriesID uint32, groupingKey string)) {
+	highKey := uint32(ctx.SeriesIDHighKey) << 16
+	it := ctx.LowSeriesIDsContainer.PeekableIterator()
+	for it.HasNext() {
+		lowSeriesID := it.Next()
+		groupingKey := ""
+		if ctx.IsGrouping {
+			groupingSeriesIdx := ctx.GroupingSeriesAggRefs[lowSeriesID-ctx.MinSeriesID]
+			groupingKey = ctx.GroupingSeriesAgg[groupingSeriesIdx].Key
+		}
+		fn(encoding.ValueWithHighLowBits(highKey, lowSeriesID), groupingKey)
+	}
+}
+
+// AddDictionary adds the dictionary of string fields from storage, used for decoding string values.
+func (ctx *DataLoadContext) AddDictionary(dictionary field.Dictionary) {
+	if len(dictionary) == 0 {
+		return
+	}
+	if ctx.Dictionary == nil {
+		ctx.Dictionary = make(field.Dictionary)
+	}
+	ctx.Dictionary.Merge(dictionary)
+}
+
+// CollectStringValue collects the value of string field by dictionary code for counting distinct values.
+func (ctx *DataLoadContext) CollectStringValue(lowSeriesIdx uint16, fieldIdx int, timestamp int64, code float64) {
+	value, ok := ctx.Dictionary.Get(code)
+	if !ok {
+		return
+	}
+	ctx.collectValue(lowSeriesIdx, fieldIdx, timestamp, value)
+}
+
+// CollectNumericValue collects the value of numeric field for calculating median/count distinct,
+// which cannot be merged from the down sampling result of each family/shard.
+func (ctx *DataLoadContext) CollectNumericValue(lowSeriesIdx uint16, fieldIdx int, timestamp int64, value float64) {
+	ctx.collectValue(lowSeriesIdx, fieldIdx, timestamp, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// collectValue collects the value of field at timestamp under the grouping key of series.
+func (ctx *DataLoadContext) collectValue(lowSeriesIdx uint16, fieldIdx int, timestamp int64, value string) {
+	groupingKey := ""
+	if ctx.IsGrouping {
+		groupingKey = ctx.GroupingSeriesAgg[ctx.GroupingSeriesAggRefs[lowSeriesIdx]].Key
+	}
+	if ctx.StringValues == nil {
+		ctx.StringValues = make(map[string]models.StringValueSet)
+	}
+	values, ok := ctx.StringValues[groupingKey]
+	if !ok {
+		values = make(models.StringValueSet)
+		ctx.StringValues[groupingKey] = values
+	}
+	values.Add(string(ctx.ShardExecuteCtx.StorageExecuteCtx.Fields[fieldIdx].Name), timestamp, value)
+}
+
+// ReduceStringValues reduces the distinct values of string fields, then resets them.
+func (ctx *DataLoadContext) ReduceStringValues(reduceFn func(groupingKey string, values []*models.StringValues)) {
+	for groupingKey, values := range ctx.StringValues {
+		reduceFn(groupingKey, values.Values())
+	}
+	ctx.StringValues = nil
+}
+
 // Reduce reduces down sampling result.
 func (ctx *DataLoadContext) Reduce(reduceFn func(it series.GroupedIterator)) {
 	if ctx.IsGrouping {
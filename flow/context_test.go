@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
@@ -77,6 +78,33 @@ func TestStorageExecuteContext_CalcSlotRange(t *testing.T) {
 	}, slotRange)
 }
 
+func TestStorageExecuteContext_CalcAlignSlots(t *testing.T) {
+	defer func(local *time.Location) {
+		time.Local = local
+	}(time.Local)
+	time.Local = time.UTC
+
+	familyTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).UnixNano() / 1000000
+	ctx := &StorageExecuteContext{
+		Query: &stmt.Query{
+			Interval:        timeutil.Interval(timeutil.OneDay),
+			StorageInterval: timeutil.Interval(timeutil.OneHour),
+			TimeRange: timeutil.TimeRange{
+				Start: familyTime - 8*timeutil.OneHour,
+				End:   familyTime + 40*timeutil.OneHour,
+			},
+			TimeZone: "+08:00",
+		},
+	}
+	assert.Equal(t, uint16(8), ctx.CalcAlignSlots(familyTime))
+	assert.Equal(t, timeutil.SlotRange{Start: 0, End: 2}, ctx.CalcTargetSlotRange(familyTime))
+	ctx.Query.TimeZone = ""
+	assert.Equal(t, uint16(0), ctx.CalcAlignSlots(familyTime))
+	assert.Equal(t, timeutil.SlotRange{Start: 0, End: 1}, ctx.CalcTargetSlotRange(familyTime))
+	ctx.Query.StorageInterval = 0
+	assert.Equal(t, uint16(0), ctx.CalcAlignSlots(familyTime))
+}
+
 func TestStorageExecuteContext_HasGroupingTagValueIDs(t *testing.T) {
 	ctx := &StorageExecuteContext{
 		GroupingTagValueIDs: make([]*roaring.Bitmap, 2),
@@ -248,6 +276,27 @@ func TestDataLoadContext_NewSeriesAggregator(t *testing.T) {
 	assert.NotNil(t, ctx.GroupingSeriesAgg[0].Aggregators)
 }
 
+func TestDataLoadContext_GetAdjacentAggregator(t *testing.T) {
+	counterSpec := aggregation.NewAggregatorSpec("b", field.GaugeField)
+	counterSpec.AddFunctionType(function.Increase)
+	ctx := &DataLoadContext{
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				DownSamplingSpecs: aggregation.AggregatorSpecs{
+					aggregation.NewAggregatorSpec("a", field.SumField),
+					counterSpec,
+				},
+				Query: &stmt.Query{Interval: timeutil.Interval(10 * timeutil.OneSecond)},
+			},
+		},
+	}
+	assert.Nil(t, ctx.GetAdjacentAggregator(0))
+	adjacentAgg := ctx.GetAdjacentAggregator(1)
+	assert.NotNil(t, adjacentAgg)
+	// keeps same aggregator for all families
+	assert.Equal(t, adjacentAgg, ctx.GetAdjacentAggregator(1))
+}
+
 func TestDataLoadContext_HasGroupingData(t *testing.T) {
 	ctx := &DataLoadContext{
 		ShardExecuteCtx: &ShardExecuteContext{
@@ -293,6 +342,34 @@ func TestDataLoadContext_IterateLowSeriesIDs(t *testing.T) {
 	assert.Equal(t, querySeriesIDs, findSeriesIDs)
 }
 
+func TestDataLoadContext_IterateGroupingSeries(t *testing.T) {
+	seriesIDs := roaring.BitmapOf(65536+5, 65536+6)
+	ctx := &DataLoadContext{
+		LowSeriesIDsContainer: seriesIDs.GetContainer(1),
+		SeriesIDHighKey:       1,
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				Query: &stmt.Query{},
+			},
+		},
+	}
+	ctx.Grouping()
+	rs := make(map[uint32]string)
+	ctx.IterateGroupingSeries(func(seriesID uint32, groupingKey string) {
+		rs[seriesID] = groupingKey
+	})
+	assert.Equal(t, map[uint32]string{65536 + 5: "", 65536 + 6: ""}, rs)
+
+	ctx.IsGrouping = true
+	ctx.Grouping()
+	ctx.GroupingSeriesAggRefs = []uint16{1, 0}
+	ctx.GroupingSeriesAgg = []*GroupingSeriesAgg{{Key: "a"}, {Key: "b"}}
+	ctx.IterateGroupingSeries(func(seriesID uint32, groupingKey string) {
+		rs[seriesID] = groupingKey
+	})
+	assert.Equal(t, map[uint32]string{65536 + 5: "b", 65536 + 6: "a"}, rs)
+}
+
 func TestDataLoadContext_GetSeriesAggregator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -329,6 +406,45 @@ func TestDataLoadContext_GetSeriesAggregator(t *testing.T) {
 	ctx.Reduce(func(it series.GroupedIterator) {})
 }
 
+func TestDataLoadContext_StringValues(t *testing.T) {
+	dict1 := field.Dictionary{}
+	code1, _ := dict1.Add("v1")
+	dict2 := field.Dictionary{}
+	code2, _ := dict2.Add("v2")
+	ctx := &DataLoadContext{
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				Fields: field.Metas{{ID: 1, Name: "f", Type: field.StringField}},
+			},
+		},
+		IsGrouping:            true,
+		GroupingSeriesAggRefs: []uint16{0, 1},
+		GroupingSeriesAgg:     []*GroupingSeriesAgg{{Key: "a"}, {Key: "b"}},
+	}
+	ctx.AddDictionary(nil)
+	assert.Nil(t, ctx.Dictionary)
+	ctx.AddDictionary(dict1)
+	ctx.AddDictionary(dict2)
+	ctx.CollectStringValue(0, 0, 10, code1)
+	ctx.CollectStringValue(0, 0, 10, code2)
+	ctx.CollectStringValue(1, 0, 20, code2)
+	// code not found in dictionary
+	ctx.CollectStringValue(1, 0, 20, 1)
+	// numeric values are collected with occurrences
+	ctx.CollectNumericValue(1, 0, 30, 1.5)
+	ctx.CollectNumericValue(1, 0, 30, 1.5)
+
+	rs := make(map[string][]*models.StringValues)
+	ctx.ReduceStringValues(func(groupingKey string, values []*models.StringValues) {
+		rs[groupingKey] = values
+	})
+	assert.Equal(t, map[string][]*models.StringValues{
+		"a": {{Field: "f", Timestamps: []int64{10}, Values: [][]string{{"v1", "v2"}}, Counts: [][]uint32{{1, 1}}}},
+		"b": {{Field: "f", Timestamps: []int64{20, 30}, Values: [][]string{{"v2"}, {"1.5"}}, Counts: [][]uint32{{1}, {2}}}},
+	}, rs)
+	assert.Nil(t, ctx.StringValues)
+}
+
 func TestTimeSegmentContexts(t *testing.T) {
 	segments := TimeSegmentContexts{
 		{
@@ -167,7 +167,12 @@ func (g *groupingContext) scanGroupingTags(ctx *DataLoadContext,
 	fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32),
 ) {
 	seriesIDHighKey := ctx.SeriesIDHighKey
+	transforms := ctx.ShardExecuteCtx.StorageExecuteCtx.GroupingTagTransforms
 	for tagKeyIdx, tagKey := range g.tagKeys {
+		var transform *TagValueTransform
+		if tagKeyIdx < len(transforms) {
+			transform = transforms[tagKeyIdx]
+		}
 		scanners := g.scanners[tagKey]
 		for _, scanner := range scanners {
 			lowSeriesIDs, tagValueIDs := scanner.GetSeriesAndTagValue(seriesIDHighKey)
@@ -176,7 +181,12 @@ func (g *groupingContext) scanGroupingTags(ctx *DataLoadContext,
 				continue
 			}
 			ctx.IterateLowSeriesIDs(lowSeriesIDs, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
-				fn(seriesIdxFromQuery, tagKeyIdx, tagValueIDs[seriesIdxFromStorage])
+				tagValueID := tagValueIDs[seriesIdxFromStorage]
+				if transform != nil {
+					// group by derived tag value, series with same derived tag value are merged
+					tagValueID = transform.DerivedID(tagValueID)
+				}
+				fn(seriesIdxFromQuery, tagKeyIdx, tagValueID)
 			})
 		}
 	}
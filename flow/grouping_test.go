@@ -127,6 +127,45 @@ func TestGroupingContext_BuildMultiTag(t *testing.T) {
 	assert.Equal(t, uint16(0), dataLoadCtx.GroupingSeriesAggRefs[10-dataLoadCtx.MinSeriesID])
 }
 
+func TestGroupingContext_BuildWithTagTransform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		ctrl.Finish()
+	}()
+	scanner := NewMockGroupingScanner(ctrl)
+	ctx := NewGroupContext([]tag.KeyID{1}, map[tag.KeyID][]GroupingScanner{1: {scanner}})
+	storageSeriesIDs := roaring.BitmapOf(1, 2, 3, 10)
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(1)).
+		Return(storageSeriesIDs.GetContainerAtIndex(0), []uint32{10, 20, 30, 40})
+	transform, err := NewTagValueTransform(&stmt.TagTransform{Type: stmt.RegexExtract, Regex: "^(\\w+)-"})
+	assert.NoError(t, err)
+	transform.Resolve(roaring.BitmapOf(10, 20, 30, 40),
+		map[uint32]string{10: "sh-1", 20: "bj-1", 30: "bj-2", 40: "sh-2"})
+	// found series id 1,2,10, tag value id: 10,20,40 => derived tag value: sh,bj,sh
+	dataLoadCtx := &DataLoadContext{
+		SeriesIDHighKey:       1,
+		LowSeriesIDsContainer: roaring.BitmapOf(1, 2, 6, 10).GetContainerAtIndex(0),
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				DownSamplingSpecs:     aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+				GroupByTagKeyIDs:      []tag.KeyID{1},
+				GroupingTagValueIDs:   make([]*roaring.Bitmap, 1),
+				GroupingTagTransforms: []*TagValueTransform{transform},
+				Query:                 &stmt.Query{GroupBy: []string{"a"}},
+			},
+		},
+		IsGrouping: true,
+	}
+	dataLoadCtx.Grouping()
+	ctx.BuildGroup(dataLoadCtx)
+	assert.Len(t, dataLoadCtx.GroupingSeriesAgg, 2)
+	assert.Equal(t, uint16(0), dataLoadCtx.GroupingSeriesAggRefs[1-dataLoadCtx.MinSeriesID])
+	assert.Equal(t, uint16(1), dataLoadCtx.GroupingSeriesAggRefs[2-dataLoadCtx.MinSeriesID])
+	assert.Equal(t, uint16(0), dataLoadCtx.GroupingSeriesAggRefs[10-dataLoadCtx.MinSeriesID])
+	// collect derived tag value ids
+	assert.Equal(t, []uint32{0, 1}, dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.GroupingTagValueIDs[0].ToArray())
+}
+
 func TestGroupingContext_ScanTagValueIDs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
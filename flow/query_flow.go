@@ -18,6 +18,7 @@
 package flow
 
 import (
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/series"
 )
 
@@ -33,6 +34,10 @@ type StorageQueryFlow interface {
 	Reduce(it series.GroupedIterator)
 	// ReduceTagValues reduces the group by tag values.
 	ReduceTagValues(tagKeyIndex int, tagValues map[uint32]string)
+	// ReduceExemplars reduces the exemplars of grouped series.
+	ReduceExemplars(groupingKey string, exemplars []*models.Exemplar)
+	// ReduceStringValues reduces the distinct values of string fields of grouped series.
+	ReduceStringValues(groupingKey string, values []*models.StringValues)
 	// Complete completes the query flow with error.
 	Complete(err error)
 }
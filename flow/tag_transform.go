@@ -0,0 +1,115 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// TagValueTransform transforms the tag values of grouping tag key into derived tag values,
+// maps tag value id to derived tag value id, so that the series whose derived tag values are same
+// are merged into one group when building group.
+type TagValueTransform struct {
+	transform func(tagValue string) string
+
+	mapping map[uint32]uint32 // tag value id => derived tag value id
+	ids     map[string]uint32 // derived tag value => derived tag value id
+	values  []string          // derived tag value id => derived tag value
+
+	mutex sync.RWMutex
+}
+
+// NewTagValueTransform creates the tag value transform by tag transform of group by.
+func NewTagValueTransform(t *stmt.TagTransform) (*TagValueTransform, error) {
+	transform, err := t.Compile()
+	if err != nil {
+		return nil, err
+	}
+	return &TagValueTransform{
+		transform: transform,
+		mapping:   make(map[uint32]uint32),
+		ids:       make(map[string]uint32),
+	}, nil
+}
+
+// Unresolved returns the tag value ids which are not mapped to derived tag value id.
+func (t *TagValueTransform) Unresolved(tagValueIDs *roaring.Bitmap) *roaring.Bitmap {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	result := roaring.New()
+	it := tagValueIDs.Iterator()
+	for it.HasNext() {
+		tagValueID := it.Next()
+		if _, ok := t.mapping[tagValueID]; !ok {
+			result.Add(tagValueID)
+		}
+	}
+	return result
+}
+
+// Resolve transforms the tag values of tag value ids, then maps tag value id to derived tag value id,
+// empty tag value is transformed if tag value not found.
+func (t *TagValueTransform) Resolve(tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	it := tagValueIDs.Iterator()
+	for it.HasNext() {
+		tagValueID := it.Next()
+		if _, ok := t.mapping[tagValueID]; ok {
+			continue
+		}
+		derivedValue := t.transform(tagValues[tagValueID])
+		derivedID, ok := t.ids[derivedValue]
+		if !ok {
+			derivedID = uint32(len(t.values))
+			t.ids[derivedValue] = derivedID
+			t.values = append(t.values, derivedValue)
+		}
+		t.mapping[tagValueID] = derivedID
+	}
+}
+
+// DerivedID returns the derived tag value id of tag value id, need resolve tag value id before.
+func (t *TagValueTransform) DerivedID(tagValueID uint32) uint32 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.mapping[tagValueID]
+}
+
+// DerivedValues returns the derived tag values by derived tag value ids.
+func (t *TagValueTransform) DerivedValues(derivedIDs *roaring.Bitmap) map[uint32]string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	result := make(map[uint32]string)
+	it := derivedIDs.Iterator()
+	for it.HasNext() {
+		derivedID := it.Next()
+		if int(derivedID) < len(t.values) {
+			result[derivedID] = t.values[derivedID]
+		}
+	}
+	return result
+}
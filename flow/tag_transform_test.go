@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestTagValueTransform(t *testing.T) {
+	transform, err := NewTagValueTransform(&stmt.TagTransform{Type: stmt.RegexExtract, Regex: "("})
+	assert.Error(t, err)
+	assert.Nil(t, transform)
+
+	transform, err = NewTagValueTransform(&stmt.TagTransform{Type: stmt.RegexExtract, Regex: "^(\\w+)-"})
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(1, 2, 3), transform.Unresolved(roaring.BitmapOf(1, 2, 3)))
+	transform.Resolve(roaring.BitmapOf(1, 2), map[uint32]string{1: "sh-1", 2: "bj-1"})
+	assert.Equal(t, roaring.BitmapOf(3), transform.Unresolved(roaring.BitmapOf(1, 2, 3)))
+	// tag value not found
+	transform.Resolve(roaring.BitmapOf(2, 3, 4), map[uint32]string{3: "sh-2"})
+	assert.True(t, transform.Unresolved(roaring.BitmapOf(1, 2, 3, 4)).IsEmpty())
+
+	assert.Equal(t, uint32(0), transform.DerivedID(1))
+	assert.Equal(t, uint32(1), transform.DerivedID(2))
+	assert.Equal(t, uint32(0), transform.DerivedID(3))
+	assert.Equal(t, uint32(2), transform.DerivedID(4))
+	assert.Equal(t, map[uint32]string{0: "sh", 1: "bj", 2: ""}, transform.DerivedValues(roaring.BitmapOf(0, 1, 2, 10)))
+}
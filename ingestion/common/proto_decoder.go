@@ -0,0 +1,65 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ProtoFieldFunc handles a field of protobuf message,
+// the value of varint/fixed field is passed as val, the value of bytes field is passed as v.
+type ProtoFieldFunc func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error
+
+// DecodeProtoMessage iterates the fields of protobuf message, unknown wire types are skipped.
+func DecodeProtoMessage(data []byte, fn ProtoFieldFunc) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("bad field tag: %w", protowire.ParseError(n))
+		}
+		data = data[n:]
+		var (
+			val uint64
+			v   []byte
+		)
+		switch typ {
+		case protowire.VarintType:
+			val, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed64Type:
+			val, n = protowire.ConsumeFixed64(data)
+		case protowire.Fixed32Type:
+			var val32 uint32
+			val32, n = protowire.ConsumeFixed32(data)
+			val = uint64(val32)
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return fmt.Errorf("bad field value: %w", protowire.ParseError(n))
+		}
+		if err := fn(num, typ, val, v); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
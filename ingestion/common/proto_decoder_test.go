@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestDecodeProtoMessage(t *testing.T) {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 10)
+	b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 20)
+	b = protowire.AppendTag(b, 3, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 30)
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendString(b, "abc")
+	b = protowire.AppendTag(b, 5, protowire.StartGroupType)
+	b = protowire.AppendTag(b, 5, protowire.EndGroupType)
+
+	values := make(map[protowire.Number]uint64)
+	var str string
+	err := DecodeProtoMessage(b, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		values[num] = val
+		if typ == protowire.BytesType {
+			str = string(v)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[protowire.Number]uint64{1: 10, 2: 20, 3: 30, 4: 0, 5: 0}, values)
+	assert.Equal(t, "abc", str)
+
+	// handle field failure
+	err = DecodeProtoMessage(b, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		return fmt.Errorf("err")
+	})
+	assert.Error(t, err)
+	// bad tag
+	assert.Error(t, DecodeProtoMessage([]byte{0xff}, nil))
+	// bad value
+	assert.Error(t, DecodeProtoMessage(protowire.AppendTag(nil, 1, protowire.BytesType), nil))
+}
@@ -88,12 +88,16 @@ func parseInfluxLine(
 		return err
 	}
 	fields, err := parseFields(content, tagsEndAt+1, fieldsEndAt, escaped)
-	// return error only if fields are empty, just drop fields not supported in LinDB like string.
+	// return error only if fields are empty, just drop fields not supported in LinDB.
 	if err != nil && len(fields) == 0 {
 		return err
 	}
 	for idx := range fields {
-		err = builder.AddSimpleField(fields[idx].Name, fields[idx].Type, fields[idx].Value)
+		if fields[idx].Type == flatMetricsV1.SimpleFieldTypeString {
+			err = builder.AddStringField(fields[idx].Name, fields[idx].StringValue)
+		} else {
+			err = builder.AddSimpleField(fields[idx].Name, fields[idx].Type, fields[idx].Value)
+		}
 		if err != nil {
 			return err
 		}
@@ -234,9 +238,10 @@ func scanFieldLine(buf []byte, startAt int, isEscaped bool) (endAt int, err erro
 }
 
 type flatSimpleField struct {
-	Name  []byte
-	Type  flatMetricsV1.SimpleFieldType
-	Value float64
+	Name        []byte
+	Type        flatMetricsV1.SimpleFieldType
+	Value       float64
+	StringValue []byte
 }
 
 func parseFields(
@@ -294,6 +299,17 @@ func parseField(key, value []byte) ([]flatSimpleField, error) {
 		return nil, ErrBadFields
 	}
 	tail := value[len(value)-1]
+	// double-quoted value, low-cardinality string field
+	if value[0] == '"' {
+		if len(value) < 2 || tail != '"' {
+			return nil, ErrBadFields
+		}
+		return []flatSimpleField{{
+			Name:        unescapedKey,
+			Type:        flatMetricsV1.SimpleFieldTypeString,
+			StringValue: unescapeStringField(value[1 : len(value)-1]),
+		}}, nil
+	}
 	switch tail {
 	case 'i', 'I', 'u', 'U': // is int or unsigned
 		v, err := strconv.ParseInt(strutil.ByteSlice2String(value[0:len(value)-1]), 10, 64)
@@ -440,6 +456,13 @@ var (
 		{k: [1]byte{' '}, esc: [2]byte{'\\', ' '}},
 		{k: [1]byte{'='}, esc: [2]byte{'\\', '='}},
 	}
+
+	stringFieldEscapeCodes = [...]escapeSet{
+		{k: [1]byte{','}, esc: [2]byte{'\\', ','}},
+		{k: [1]byte{' '}, esc: [2]byte{'\\', ' '}},
+		{k: [1]byte{'"'}, esc: [2]byte{'\\', '"'}},
+		{k: [1]byte{'\\'}, esc: [2]byte{'\\', '\\'}},
+	}
 )
 
 func unescapeMetricName(in []byte) []byte {
@@ -468,3 +491,14 @@ func unescapeTag(in []byte) []byte {
 	}
 	return in
 }
+
+func unescapeStringField(in []byte) []byte {
+	if bytes.IndexByte(in, '\\') == -1 {
+		return in
+	}
+	for i := range stringFieldEscapeCodes {
+		c := &stringFieldEscapeCodes[i]
+		in = bytes.Replace(in, c.esc[:], c.k[:], -1)
+	}
+	return in
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/pkg/fasttime"
 	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
+	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
 )
 
@@ -394,6 +395,18 @@ func Test_parseUnescapedMetric(t *testing.T) {
 				{Name: []byte("value_gauge"), Type: flatMetricsV1.SimpleFieldTypeGauge, Value: 1},
 				{Name: []byte("bool"), Type: flatMetricsV1.SimpleFieldTypeGauge, Value: 0},
 			}},
+		// string field with escaped quote and space
+		{`cpu,regions=east status="a\"1\ i",value=1i`,
+			`cpu`,
+			map[string]string{"regions": "east"},
+			[]flatSimpleField{
+				{
+					Name: []byte("status"), Type: flatMetricsV1.SimpleFieldTypeString,
+					Value: field.StringCode(`a"1 i`), StringValue: []byte(`a"1 i`),
+				},
+				{Name: []byte("value_sum"), Type: flatMetricsV1.SimpleFieldTypeDeltaSum, Value: 1},
+				{Name: []byte("value_gauge"), Type: flatMetricsV1.SimpleFieldTypeGauge, Value: 1},
+			}},
 	}
 
 	builder, releaseFunc := metric.NewRowBuilder()
@@ -421,9 +434,10 @@ func Test_parseUnescapedMetric(t *testing.T) {
 		for i := 0; i < m.SimpleFieldsLength(); i++ {
 			m.SimpleFields(&sf, i)
 			realFields = append(realFields, flatSimpleField{
-				Name:  sf.Name(),
-				Type:  sf.Type(),
-				Value: sf.Value(),
+				Name:        sf.Name(),
+				Type:        sf.Type(),
+				Value:       sf.Value(),
+				StringValue: sf.StringValue(),
 			})
 		}
 
@@ -433,7 +447,7 @@ func Test_parseUnescapedMetric(t *testing.T) {
 
 func Test_parseBadFields(t *testing.T) {
 	lines := []string{
-		`cpu,regions=east value="a1i"`,
+		`cpu,regions=east value="a1i`,
 		`cpu,regions=east value=a1i`,
 		`cpu,regions=east value=`,
 		`cpu,regions=east  value=2`,
@@ -453,6 +467,22 @@ func Test_parseBadFields(t *testing.T) {
 	}
 }
 
+func Test_parseStringField(t *testing.T) {
+	builder, releaseFunc := metric.NewRowBuilder()
+	defer releaseFunc(builder)
+	// quoted value was dropped as unsupported field before, now it is ingested as string field
+	assert.NoError(t, parseInfluxLine(builder, []byte(`cpu,regions=east value="a1i"`), "ns", 1e6))
+	var row metric.BrokerRow
+	assert.NoError(t, builder.BuildTo(&row))
+	m := row.Metric()
+	assert.Equal(t, 1, m.SimpleFieldsLength())
+	var sf flatMetricsV1.SimpleField
+	m.SimpleFields(&sf, 0)
+	assert.Equal(t, "value", string(sf.Name()))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeString, sf.Type())
+	assert.Equal(t, "a1i", string(sf.StringValue()))
+}
+
 func Test_parseTimestamp(t *testing.T) {
 	timestamp := fasttime.UnixMilliseconds()
 	assert.Equal(t, timestamp, timestamp2MilliSeconds(timestamp))
@@ -0,0 +1,126 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// UnmarshalJSON decodes the json data of metrics export request,
+// the deprecated instrumentation library fields are converted into scope fields.
+func (m *ExportMetricsServiceRequest) UnmarshalJSON(data []byte) error {
+	type request ExportMetricsServiceRequest
+	if err := json.Unmarshal(data, (*request)(m)); err != nil {
+		return err
+	}
+	for _, rm := range m.ResourceMetrics {
+		for _, sm := range rm.InstrumentationLibraryMetrics {
+			sm.Scope = sm.InstrumentationLibrary
+			rm.ScopeMetrics = append(rm.ScopeMetrics, sm)
+		}
+		rm.InstrumentationLibraryMetrics = nil
+	}
+	return nil
+}
+
+// Uint64 represents the uint64 value which is encoded as string or number in OTLP json format.
+type Uint64 uint64
+
+// UnmarshalJSON decodes uint64 value from json string or number.
+func (v *Uint64) UnmarshalJSON(data []byte) error {
+	val, err := strconv.ParseUint(unquote(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad uint64 value: %s", data)
+	}
+	*v = Uint64(val)
+	return nil
+}
+
+// Int64 represents the int64 value which is encoded as string or number in OTLP json format.
+type Int64 int64
+
+// UnmarshalJSON decodes int64 value from json string or number.
+func (v *Int64) UnmarshalJSON(data []byte) error {
+	val, err := strconv.ParseInt(unquote(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad int64 value: %s", data)
+	}
+	*v = Int64(val)
+	return nil
+}
+
+// Float64 represents the double value which is encoded as number or
+// string("NaN", "Infinity", "-Infinity") in OTLP json format.
+type Float64 float64
+
+// UnmarshalJSON decodes double value from json string or number.
+func (v *Float64) UnmarshalJSON(data []byte) error {
+	str := unquote(data)
+	switch str {
+	case "NaN":
+		*v = Float64(math.NaN())
+	case "Infinity":
+		*v = Float64(math.Inf(1))
+	case "-Infinity":
+		*v = Float64(math.Inf(-1))
+	default:
+		val, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return fmt.Errorf("bad double value: %s", data)
+		}
+		*v = Float64(val)
+	}
+	return nil
+}
+
+// aggregationTemporalityNames represents the enum names of aggregation temporality.
+var aggregationTemporalityNames = map[string]AggregationTemporality{
+	"AGGREGATION_TEMPORALITY_UNSPECIFIED": AggregationTemporalityUnspecified,
+	"AGGREGATION_TEMPORALITY_DELTA":       AggregationTemporalityDelta,
+	"AGGREGATION_TEMPORALITY_CUMULATIVE":  AggregationTemporalityCumulative,
+}
+
+// UnmarshalJSON decodes aggregation temporality from json enum number or name.
+func (t *AggregationTemporality) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		temporality, ok := aggregationTemporalityNames[name]
+		if !ok {
+			return fmt.Errorf("bad aggregation temporality: %s", data)
+		}
+		*t = temporality
+		return nil
+	}
+	val, err := strconv.ParseInt(string(data), 10, 32)
+	if err != nil {
+		return fmt.Errorf("bad aggregation temporality: %s", data)
+	}
+	*t = AggregationTemporality(val)
+	return nil
+}
+
+// unquote returns the content of json string, or the raw data if it is not a string.
+func unquote(data []byte) string {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		return string(data[1 : len(data)-1])
+	}
+	return string(data)
+}
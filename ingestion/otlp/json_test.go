@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportMetricsServiceRequest_UnmarshalJSON(t *testing.T) {
+	data := `{"resourceMetrics":[{
+		"resource":{"attributes":[{"key":"service","value":{"stringValue":"order"}}]},
+		"scopeMetrics":[{"scope":{"name":"lib"},"metrics":[
+			{"name":"cpu","gauge":{"dataPoints":[{"timeUnixNano":"1000000","asDouble":"NaN"}]}},
+			{"name":"requests","sum":{"aggregationTemporality":1,"isMonotonic":true,
+				"dataPoints":[{"timeUnixNano":1000000,"asInt":"10"}]}},
+			{"name":"latency","histogram":{"aggregationTemporality":"AGGREGATION_TEMPORALITY_DELTA",
+				"dataPoints":[{"count":"3","sum":1.5,"bucketCounts":["1","2"],"explicitBounds":[1]}]}}
+		]}],
+		"instrumentationLibraryMetrics":[{"instrumentationLibrary":{"name":"legacy"},"metrics":[{"name":"disk"}]}]
+	}]}`
+	req := &ExportMetricsServiceRequest{}
+	assert.NoError(t, req.UnmarshalJSON([]byte(data)))
+	rm := req.ResourceMetrics[0]
+	assert.Nil(t, rm.InstrumentationLibraryMetrics)
+	assert.Len(t, rm.ScopeMetrics, 2)
+	assert.Equal(t, "lib", rm.ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, "legacy", rm.ScopeMetrics[1].Scope.Name)
+	assert.Equal(t, "disk", rm.ScopeMetrics[1].Metrics[0].Name)
+
+	metrics := rm.ScopeMetrics[0].Metrics
+	assert.Equal(t, Uint64(1000000), metrics[0].Gauge.DataPoints[0].TimeUnixNano)
+	assert.True(t, math.IsNaN(float64(*metrics[0].Gauge.DataPoints[0].AsDouble)))
+	assert.Equal(t, AggregationTemporalityDelta, metrics[1].Sum.AggregationTemporality)
+	assert.Equal(t, Uint64(1000000), metrics[1].Sum.DataPoints[0].TimeUnixNano)
+	assert.Equal(t, Int64(10), *metrics[1].Sum.DataPoints[0].AsInt)
+	assert.Equal(t, AggregationTemporalityDelta, metrics[2].Histogram.AggregationTemporality)
+	assert.Equal(t, []Uint64{1, 2}, metrics[2].Histogram.DataPoints[0].BucketCounts)
+	assert.Equal(t, []Float64{1}, metrics[2].Histogram.DataPoints[0].ExplicitBounds)
+
+	assert.Error(t, req.UnmarshalJSON([]byte("xxx")))
+}
+
+func TestJSONValue(t *testing.T) {
+	var f Float64
+	for str, expect := range map[string]float64{
+		`"Infinity"`: math.Inf(1), `"-Infinity"`: math.Inf(-1), `1.5`: 1.5, `"2.5"`: 2.5,
+	} {
+		assert.NoError(t, f.UnmarshalJSON([]byte(str)))
+		assert.Equal(t, expect, float64(f))
+	}
+	assert.Error(t, f.UnmarshalJSON([]byte(`"x"`)))
+
+	var i Int64
+	assert.NoError(t, i.UnmarshalJSON([]byte(`-1`)))
+	assert.Equal(t, Int64(-1), i)
+	assert.Error(t, i.UnmarshalJSON([]byte(`"x"`)))
+
+	var u Uint64
+	assert.NoError(t, u.UnmarshalJSON([]byte(`"1"`)))
+	assert.Equal(t, Uint64(1), u)
+	assert.Error(t, u.UnmarshalJSON([]byte(`-1`)))
+
+	var temporality AggregationTemporality
+	assert.NoError(t, temporality.UnmarshalJSON([]byte(`"AGGREGATION_TEMPORALITY_CUMULATIVE"`)))
+	assert.Equal(t, AggregationTemporalityCumulative, temporality)
+	assert.NoError(t, temporality.UnmarshalJSON([]byte(`1`)))
+	assert.Equal(t, AggregationTemporalityDelta, temporality)
+	assert.Error(t, temporality.UnmarshalJSON([]byte(`"x"`)))
+	assert.Error(t, temporality.UnmarshalJSON([]byte(`1.5`)))
+}
@@ -0,0 +1,574 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+)
+
+// AggregationTemporality represents the temporality of sum/histogram data points.
+type AggregationTemporality int32
+
+// Defines all aggregation temporalities of OTLP metrics.
+const (
+	AggregationTemporalityUnspecified AggregationTemporality = iota
+	AggregationTemporalityDelta
+	AggregationTemporalityCumulative
+)
+
+// dataPointFlagNoRecordedValue marks the data point as a staleness marker without value.
+const dataPointFlagNoRecordedValue = 1
+
+// ExportMetricsServiceRequest represents the OTLP metrics export request.
+type ExportMetricsServiceRequest struct {
+	ResourceMetrics []*ResourceMetrics `json:"resourceMetrics"`
+}
+
+// ResourceMetrics represents the metrics produced by a resource.
+type ResourceMetrics struct {
+	Resource     Resource        `json:"resource"`
+	ScopeMetrics []*ScopeMetrics `json:"scopeMetrics"`
+	// InstrumentationLibraryMetrics is the deprecated name of ScopeMetrics(before OTLP v0.15) in json format.
+	InstrumentationLibraryMetrics []*ScopeMetrics `json:"instrumentationLibraryMetrics"`
+}
+
+// Resource represents the entity producing metrics.
+type Resource struct {
+	Attributes []*KeyValue `json:"attributes"`
+}
+
+// ScopeMetrics represents the metrics produced by an instrumentation scope.
+type ScopeMetrics struct {
+	Scope   Scope     `json:"scope"`
+	Metrics []*Metric `json:"metrics"`
+	// InstrumentationLibrary is the deprecated name of Scope(before OTLP v0.15) in json format.
+	InstrumentationLibrary Scope `json:"instrumentationLibrary"`
+}
+
+// Scope represents the instrumentation scope.
+type Scope struct {
+	Name       string      `json:"name"`
+	Version    string      `json:"version"`
+	Attributes []*KeyValue `json:"attributes"`
+}
+
+// Metric represents a metric with one kind of data points.
+type Metric struct {
+	Name                 string                `json:"name"`
+	Description          string                `json:"description"`
+	Unit                 string                `json:"unit"`
+	Gauge                *Gauge                `json:"gauge"`
+	Sum                  *Sum                  `json:"sum"`
+	Histogram            *Histogram            `json:"histogram"`
+	ExponentialHistogram *ExponentialHistogram `json:"exponentialHistogram"`
+}
+
+// Gauge represents the gauge data points.
+type Gauge struct {
+	DataPoints []*NumberDataPoint `json:"dataPoints"`
+}
+
+// Sum represents the sum data points.
+type Sum struct {
+	DataPoints             []*NumberDataPoint     `json:"dataPoints"`
+	AggregationTemporality AggregationTemporality `json:"aggregationTemporality"`
+	IsMonotonic            bool                   `json:"isMonotonic"`
+}
+
+// Histogram represents the explicit bucket histogram data points.
+type Histogram struct {
+	DataPoints             []*HistogramDataPoint  `json:"dataPoints"`
+	AggregationTemporality AggregationTemporality `json:"aggregationTemporality"`
+}
+
+// ExponentialHistogram represents the exponential bucket histogram data points.
+type ExponentialHistogram struct {
+	DataPoints             []*ExponentialHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality AggregationTemporality           `json:"aggregationTemporality"`
+}
+
+// NumberDataPoint represents a data point of gauge/sum.
+type NumberDataPoint struct {
+	Attributes   []*KeyValue `json:"attributes"`
+	TimeUnixNano Uint64      `json:"timeUnixNano"`
+	AsDouble     *Float64    `json:"asDouble"`
+	AsInt        *Int64      `json:"asInt"`
+	Flags        uint32      `json:"flags"`
+}
+
+// HistogramDataPoint represents a data point of explicit bucket histogram.
+type HistogramDataPoint struct {
+	Attributes     []*KeyValue `json:"attributes"`
+	TimeUnixNano   Uint64      `json:"timeUnixNano"`
+	Count          Uint64      `json:"count"`
+	Sum            Float64     `json:"sum"`
+	BucketCounts   []Uint64    `json:"bucketCounts"`
+	ExplicitBounds []Float64   `json:"explicitBounds"`
+	Flags          uint32      `json:"flags"`
+}
+
+// ExponentialHistogramDataPoint represents a data point of exponential bucket histogram.
+type ExponentialHistogramDataPoint struct {
+	Attributes    []*KeyValue `json:"attributes"`
+	TimeUnixNano  Uint64      `json:"timeUnixNano"`
+	Count         Uint64      `json:"count"`
+	Sum           Float64     `json:"sum"`
+	Scale         int32       `json:"scale"`
+	ZeroCount     Uint64      `json:"zeroCount"`
+	Positive      Buckets     `json:"positive"`
+	Negative      Buckets     `json:"negative"`
+	Flags         uint32      `json:"flags"`
+	ZeroThreshold Float64     `json:"zeroThreshold"`
+}
+
+// Buckets represents the continuous buckets of exponential histogram.
+type Buckets struct {
+	Offset       int32    `json:"offset"`
+	BucketCounts []Uint64 `json:"bucketCounts"`
+}
+
+// KeyValue represents an attribute.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue represents the value of attribute.
+type AnyValue struct {
+	StringValue *string       `json:"stringValue"`
+	BoolValue   *bool         `json:"boolValue"`
+	IntValue    *Int64        `json:"intValue"`
+	DoubleValue *Float64      `json:"doubleValue"`
+	ArrayValue  *ArrayValue   `json:"arrayValue"`
+	KvlistValue *KeyValueList `json:"kvlistValue"`
+	BytesValue  []byte        `json:"bytesValue"`
+}
+
+// ArrayValue represents the array value of attribute.
+type ArrayValue struct {
+	Values []*AnyValue `json:"values"`
+}
+
+// KeyValueList represents the key/value list value of attribute.
+type KeyValueList struct {
+	Values []*KeyValue `json:"values"`
+}
+
+// String returns the string representation of attribute value which is used as tag value.
+func (m *AnyValue) String() string {
+	switch {
+	case m.StringValue != nil:
+		return *m.StringValue
+	case m.BoolValue != nil:
+		return strconv.FormatBool(*m.BoolValue)
+	case m.IntValue != nil:
+		return strconv.FormatInt(int64(*m.IntValue), 10)
+	case m.DoubleValue != nil:
+		return strconv.FormatFloat(float64(*m.DoubleValue), 'g', -1, 64)
+	case m.ArrayValue != nil:
+		values := make([]string, 0, len(m.ArrayValue.Values))
+		for _, value := range m.ArrayValue.Values {
+			values = append(values, value.String())
+		}
+		return "[" + strings.Join(values, ",") + "]"
+	case m.KvlistValue != nil:
+		kvs := make([]string, 0, len(m.KvlistValue.Values))
+		for _, kv := range m.KvlistValue.Values {
+			kvs = append(kvs, kv.Key+"="+kv.Value.String())
+		}
+		return "{" + strings.Join(kvs, ",") + "}"
+	case m.BytesValue != nil:
+		return base64.StdEncoding.EncodeToString(m.BytesValue)
+	default:
+		return ""
+	}
+}
+
+// Unmarshal decodes the protobuf data of metrics export request.
+func (m *ExportMetricsServiceRequest) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if num == 1 && typ == protowire.BytesType {
+			rm := &ResourceMetrics{}
+			if err := rm.Unmarshal(v); err != nil {
+				return err
+			}
+			m.ResourceMetrics = append(m.ResourceMetrics, rm)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of resource metrics.
+func (m *ResourceMetrics) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			return decodeAttributes(v, 1, &m.Resource.Attributes)
+		case 2, 1000: // 1000 is the deprecated instrumentation library metrics
+			sm := &ScopeMetrics{}
+			if err := sm.Unmarshal(v); err != nil {
+				return err
+			}
+			m.ScopeMetrics = append(m.ScopeMetrics, sm)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of scope metrics.
+func (m *ScopeMetrics) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			return m.Scope.Unmarshal(v)
+		case 2:
+			metric := &Metric{}
+			if err := metric.Unmarshal(v); err != nil {
+				return err
+			}
+			m.Metrics = append(m.Metrics, metric)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of instrumentation scope.
+func (m *Scope) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			m.Name = string(v)
+		case 2:
+			m.Version = string(v)
+		case 3:
+			return decodeAttribute(v, &m.Attributes)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of metric, deprecated int/double data types are ignored.
+func (m *Metric) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			m.Name = string(v)
+		case 2:
+			m.Description = string(v)
+		case 3:
+			m.Unit = string(v)
+		case 5:
+			m.Gauge = &Gauge{}
+			return m.Gauge.Unmarshal(v)
+		case 7:
+			m.Sum = &Sum{}
+			return m.Sum.Unmarshal(v)
+		case 9:
+			m.Histogram = &Histogram{}
+			return m.Histogram.Unmarshal(v)
+		case 10:
+			m.ExponentialHistogram = &ExponentialHistogram{}
+			return m.ExponentialHistogram.Unmarshal(v)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of gauge.
+func (m *Gauge) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if num == 1 && typ == protowire.BytesType {
+			dp := &NumberDataPoint{}
+			if err := dp.Unmarshal(v); err != nil {
+				return err
+			}
+			m.DataPoints = append(m.DataPoints, dp)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of sum.
+func (m *Sum) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			dp := &NumberDataPoint{}
+			if err := dp.Unmarshal(v); err != nil {
+				return err
+			}
+			m.DataPoints = append(m.DataPoints, dp)
+		case num == 2 && typ == protowire.VarintType:
+			m.AggregationTemporality = AggregationTemporality(val)
+		case num == 3 && typ == protowire.VarintType:
+			m.IsMonotonic = val != 0
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of histogram.
+func (m *Histogram) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			dp := &HistogramDataPoint{}
+			if err := dp.Unmarshal(v); err != nil {
+				return err
+			}
+			m.DataPoints = append(m.DataPoints, dp)
+		case num == 2 && typ == protowire.VarintType:
+			m.AggregationTemporality = AggregationTemporality(val)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of exponential histogram.
+func (m *ExponentialHistogram) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			dp := &ExponentialHistogramDataPoint{}
+			if err := dp.Unmarshal(v); err != nil {
+				return err
+			}
+			m.DataPoints = append(m.DataPoints, dp)
+		case num == 2 && typ == protowire.VarintType:
+			m.AggregationTemporality = AggregationTemporality(val)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of number data point.
+func (m *NumberDataPoint) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 7 && typ == protowire.BytesType:
+			return decodeAttribute(v, &m.Attributes)
+		case num == 3 && typ == protowire.Fixed64Type:
+			m.TimeUnixNano = Uint64(val)
+		case num == 4 && typ == protowire.Fixed64Type:
+			value := Float64(math.Float64frombits(val))
+			m.AsDouble = &value
+		case num == 6 && typ == protowire.Fixed64Type:
+			value := Int64(val)
+			m.AsInt = &value
+		case num == 8 && typ == protowire.VarintType:
+			m.Flags = uint32(val)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of histogram data point.
+func (m *HistogramDataPoint) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 9 && typ == protowire.BytesType:
+			return decodeAttribute(v, &m.Attributes)
+		case num == 3 && typ == protowire.Fixed64Type:
+			m.TimeUnixNano = Uint64(val)
+		case num == 4 && typ == protowire.Fixed64Type:
+			m.Count = Uint64(val)
+		case num == 5 && typ == protowire.Fixed64Type:
+			m.Sum = Float64(math.Float64frombits(val))
+		case num == 6:
+			return decodeRepeatedFixed64(typ, val, v, func(val uint64) {
+				m.BucketCounts = append(m.BucketCounts, Uint64(val))
+			})
+		case num == 7:
+			return decodeRepeatedFixed64(typ, val, v, func(val uint64) {
+				m.ExplicitBounds = append(m.ExplicitBounds, Float64(math.Float64frombits(val)))
+			})
+		case num == 10 && typ == protowire.VarintType:
+			m.Flags = uint32(val)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of exponential histogram data point.
+func (m *ExponentialHistogramDataPoint) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			return decodeAttribute(v, &m.Attributes)
+		case num == 3 && typ == protowire.Fixed64Type:
+			m.TimeUnixNano = Uint64(val)
+		case num == 4 && typ == protowire.Fixed64Type:
+			m.Count = Uint64(val)
+		case num == 5 && typ == protowire.Fixed64Type:
+			m.Sum = Float64(math.Float64frombits(val))
+		case num == 6 && typ == protowire.VarintType:
+			m.Scale = int32(protowire.DecodeZigZag(val))
+		case num == 7 && typ == protowire.Fixed64Type:
+			m.ZeroCount = Uint64(val)
+		case num == 8 && typ == protowire.BytesType:
+			return m.Positive.Unmarshal(v)
+		case num == 9 && typ == protowire.BytesType:
+			return m.Negative.Unmarshal(v)
+		case num == 10 && typ == protowire.VarintType:
+			m.Flags = uint32(val)
+		case num == 14 && typ == protowire.Fixed64Type:
+			m.ZeroThreshold = Float64(math.Float64frombits(val))
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of exponential histogram buckets.
+func (m *Buckets) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			m.Offset = int32(protowire.DecodeZigZag(val))
+		case num == 2 && typ == protowire.VarintType:
+			m.BucketCounts = append(m.BucketCounts, Uint64(val))
+		case num == 2 && typ == protowire.BytesType:
+			// packed repeated varint
+			for len(v) > 0 {
+				count, n := protowire.ConsumeVarint(v)
+				if n < 0 {
+					return fmt.Errorf("bad packed varint: %w", protowire.ParseError(n))
+				}
+				m.BucketCounts = append(m.BucketCounts, Uint64(count))
+				v = v[n:]
+			}
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of key value.
+func (m *KeyValue) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			m.Key = string(v)
+		case num == 2 && typ == protowire.BytesType:
+			return m.Value.Unmarshal(v)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the protobuf data of any value.
+func (m *AnyValue) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			value := string(v)
+			m.StringValue = &value
+		case num == 2 && typ == protowire.VarintType:
+			value := val != 0
+			m.BoolValue = &value
+		case num == 3 && typ == protowire.VarintType:
+			value := Int64(val)
+			m.IntValue = &value
+		case num == 4 && typ == protowire.Fixed64Type:
+			value := Float64(math.Float64frombits(val))
+			m.DoubleValue = &value
+		case num == 5 && typ == protowire.BytesType:
+			m.ArrayValue = &ArrayValue{}
+			return decodeMessages(v, func(item []byte) error {
+				value := &AnyValue{}
+				if err := value.Unmarshal(item); err != nil {
+					return err
+				}
+				m.ArrayValue.Values = append(m.ArrayValue.Values, value)
+				return nil
+			})
+		case num == 6 && typ == protowire.BytesType:
+			m.KvlistValue = &KeyValueList{}
+			return decodeAttributes(v, 1, &m.KvlistValue.Values)
+		case num == 7 && typ == protowire.BytesType:
+			m.BytesValue = append([]byte{}, v...)
+		}
+		return nil
+	})
+}
+
+// decodeAttribute decodes an attribute and appends it into attributes.
+func decodeAttribute(data []byte, attributes *[]*KeyValue) error {
+	kv := &KeyValue{}
+	if err := kv.Unmarshal(data); err != nil {
+		return err
+	}
+	*attributes = append(*attributes, kv)
+	return nil
+}
+
+// decodeAttributes decodes the attributes field(field number is num) of message.
+func decodeAttributes(data []byte, num protowire.Number, attributes *[]*KeyValue) error {
+	return ingestCommon.DecodeProtoMessage(data, func(n protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if n == num && typ == protowire.BytesType {
+			return decodeAttribute(v, attributes)
+		}
+		return nil
+	})
+}
+
+// decodeMessages decodes the repeated message field(field number is 1) of message.
+func decodeMessages(data []byte, fn func(item []byte) error) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		if num == 1 && typ == protowire.BytesType {
+			return fn(v)
+		}
+		return nil
+	})
+}
+
+// decodeRepeatedFixed64 decodes the repeated fixed64/double field which may be packed or not.
+func decodeRepeatedFixed64(typ protowire.Type, val uint64, v []byte, fn func(val uint64)) error {
+	switch typ {
+	case protowire.Fixed64Type:
+		fn(val)
+	case protowire.BytesType:
+		for len(v) > 0 {
+			item, n := protowire.ConsumeFixed64(v)
+			if n < 0 {
+				return fmt.Errorf("bad packed fixed64: %w", protowire.ParseError(n))
+			}
+			fn(item)
+			v = v[n:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// pb builds protobuf message for testing.
+type pb []byte
+
+func (b pb) msg(num protowire.Number, v pb) pb {
+	return protowire.AppendBytes(protowire.AppendTag(b, num, protowire.BytesType), v)
+}
+
+func (b pb) str(num protowire.Number, v string) pb {
+	return protowire.AppendString(protowire.AppendTag(b, num, protowire.BytesType), v)
+}
+
+func (b pb) varint(num protowire.Number, v uint64) pb {
+	return protowire.AppendVarint(protowire.AppendTag(b, num, protowire.VarintType), v)
+}
+
+func (b pb) fixed64(num protowire.Number, v uint64) pb {
+	return protowire.AppendFixed64(protowire.AppendTag(b, num, protowire.Fixed64Type), v)
+}
+
+func (b pb) double(num protowire.Number, v float64) pb {
+	return b.fixed64(num, math.Float64bits(v))
+}
+
+func attr(key string, value pb) pb {
+	return pb{}.str(1, key).msg(2, value)
+}
+
+func stringAttr(key, value string) pb {
+	return attr(key, pb{}.str(1, value))
+}
+
+func TestExportMetricsServiceRequest_Unmarshal(t *testing.T) {
+	gauge := pb{}.str(1, "cpu").str(2, "cpu usage").str(3, "1").msg(5, pb{}.msg(1, pb{}.
+		msg(7, stringAttr("host", "a")).
+		fixed64(3, 1e9).
+		double(4, 1.5).
+		varint(8, 0)))
+	sum := pb{}.str(1, "requests").msg(7, pb{}.
+		msg(1, pb{}.fixed64(3, 2e9).fixed64(6, uint64(10))).
+		varint(2, uint64(AggregationTemporalityDelta)).
+		varint(3, 1))
+	packedCounts := protowire.AppendFixed64(protowire.AppendFixed64(protowire.AppendFixed64(nil, 1), 2), 3)
+	packedBounds := protowire.AppendFixed64(protowire.AppendFixed64(nil, math.Float64bits(1)), math.Float64bits(2))
+	histogram := pb{}.str(1, "latency").msg(9, pb{}.
+		msg(1, pb{}.
+			msg(9, stringAttr("path", "/")).
+			fixed64(3, 3e9).
+			fixed64(4, 6).
+			double(5, 7.5).
+			msg(6, packedCounts).
+			msg(7, packedBounds).
+			varint(10, 1)).
+		msg(1, pb{}.
+			fixed64(6, 1).fixed64(6, 2).
+			double(7, 1)).
+		varint(2, uint64(AggregationTemporalityCumulative)))
+	packedVarint := protowire.AppendVarint(protowire.AppendVarint(nil, 4), 5)
+	expHistogram := pb{}.str(1, "size").msg(10, pb{}.
+		msg(1, pb{}.
+			msg(1, stringAttr("path", "/")).
+			fixed64(3, 4e9).
+			fixed64(4, 10).
+			double(5, 20).
+			varint(6, protowire.EncodeZigZag(-1)).
+			fixed64(7, 1).
+			msg(8, pb{}.varint(1, protowire.EncodeZigZag(2)).msg(2, packedVarint)).
+			msg(9, pb{}.varint(1, protowire.EncodeZigZag(-2)).varint(2, 6)).
+			varint(10, 0).
+			double(14, 0.5)).
+		varint(2, uint64(AggregationTemporalityDelta)))
+	values := pb{}.msg(1, pb{}.str(1, "x")).msg(1, pb{}.varint(3, 1)).str(10, "unknown")
+	kvs := pb{}.msg(1, stringAttr("k", "v"))
+	scope := pb{}.str(1, "lib").str(2, "1.0").
+		msg(3, attr("bool", pb{}.varint(2, 1))).
+		msg(3, attr("int", pb{}.varint(3, 10))).
+		msg(3, attr("double", pb{}.double(4, 1.5))).
+		msg(3, attr("array", pb{}.msg(5, values))).
+		msg(3, attr("kvs", pb{}.msg(6, kvs))).
+		msg(3, attr("bytes", pb{}.str(7, "ab"))).
+		varint(4, 1)
+	data := pb{}.msg(1, pb{}.
+		msg(1, pb{}.msg(1, stringAttr("service", "order")).varint(2, 0)).
+		msg(2, pb{}.
+			msg(1, scope).
+			msg(2, gauge).msg(2, sum).msg(2, histogram).msg(2, expHistogram).
+			msg(2, pb{}.str(1, "summary").msg(11, pb{}))).
+		msg(1000, pb{}.msg(2, pb{}.str(1, "legacy"))).
+		str(3, "schema"))
+
+	req := &ExportMetricsServiceRequest{}
+	assert.NoError(t, req.Unmarshal(data))
+	assert.Len(t, req.ResourceMetrics, 1)
+	rm := req.ResourceMetrics[0]
+	assert.Equal(t, "service", rm.Resource.Attributes[0].Key)
+	assert.Equal(t, "order", rm.Resource.Attributes[0].Value.String())
+	assert.Len(t, rm.ScopeMetrics, 2)
+	assert.Equal(t, "legacy", rm.ScopeMetrics[1].Metrics[0].Name)
+
+	sm := rm.ScopeMetrics[0]
+	assert.Equal(t, "lib", sm.Scope.Name)
+	assert.Equal(t, "1.0", sm.Scope.Version)
+	var attrs []string
+	for _, kv := range sm.Scope.Attributes {
+		attrs = append(attrs, kv.Key+"="+kv.Value.String())
+	}
+	assert.Equal(t, []string{"bool=true", "int=10", "double=1.5", "array=[x,1]", "kvs={k=v}", "bytes=YWI="}, attrs)
+	assert.Len(t, sm.Metrics, 5)
+
+	m := sm.Metrics[0]
+	assert.Equal(t, "cpu", m.Name)
+	assert.Equal(t, "cpu usage", m.Description)
+	assert.Equal(t, "1", m.Unit)
+	assert.Equal(t, Uint64(1e9), m.Gauge.DataPoints[0].TimeUnixNano)
+	assert.Equal(t, Float64(1.5), *m.Gauge.DataPoints[0].AsDouble)
+	assert.Equal(t, "a", m.Gauge.DataPoints[0].Attributes[0].Value.String())
+
+	m = sm.Metrics[1]
+	assert.Equal(t, AggregationTemporalityDelta, m.Sum.AggregationTemporality)
+	assert.True(t, m.Sum.IsMonotonic)
+	assert.Equal(t, Int64(10), *m.Sum.DataPoints[0].AsInt)
+
+	m = sm.Metrics[2]
+	assert.Equal(t, AggregationTemporalityCumulative, m.Histogram.AggregationTemporality)
+	dp := m.Histogram.DataPoints[0]
+	assert.Equal(t, "path", dp.Attributes[0].Key)
+	assert.Equal(t, Uint64(3e9), dp.TimeUnixNano)
+	assert.Equal(t, Uint64(6), dp.Count)
+	assert.Equal(t, Float64(7.5), dp.Sum)
+	assert.Equal(t, []Uint64{1, 2, 3}, dp.BucketCounts)
+	assert.Equal(t, []Float64{1, 2}, dp.ExplicitBounds)
+	assert.Equal(t, uint32(1), dp.Flags)
+	// not packed
+	assert.Equal(t, []Uint64{1, 2}, m.Histogram.DataPoints[1].BucketCounts)
+	assert.Equal(t, []Float64{1}, m.Histogram.DataPoints[1].ExplicitBounds)
+
+	m = sm.Metrics[3]
+	assert.Equal(t, AggregationTemporalityDelta, m.ExponentialHistogram.AggregationTemporality)
+	expDP := m.ExponentialHistogram.DataPoints[0]
+	assert.Equal(t, "path", expDP.Attributes[0].Key)
+	assert.Equal(t, Uint64(4e9), expDP.TimeUnixNano)
+	assert.Equal(t, Uint64(10), expDP.Count)
+	assert.Equal(t, Float64(20), expDP.Sum)
+	assert.Equal(t, int32(-1), expDP.Scale)
+	assert.Equal(t, Uint64(1), expDP.ZeroCount)
+	assert.Equal(t, Buckets{Offset: 2, BucketCounts: []Uint64{4, 5}}, expDP.Positive)
+	assert.Equal(t, Buckets{Offset: -2, BucketCounts: []Uint64{6}}, expDP.Negative)
+	assert.Equal(t, Float64(0.5), expDP.ZeroThreshold)
+
+	m = sm.Metrics[4]
+	assert.Equal(t, "summary", m.Name)
+	assert.Nil(t, m.Gauge)
+	assert.Nil(t, m.Sum)
+	assert.Nil(t, m.Histogram)
+	assert.Nil(t, m.ExponentialHistogram)
+}
+
+func TestExportMetricsServiceRequest_Unmarshal_fail(t *testing.T) {
+	bad := pb{0xff}
+	badPacked := pb{0x80}
+	cases := []pb{
+		bad,
+		pb{}.msg(1, bad),
+		pb{}.msg(1, pb{}.msg(1, bad)),
+		pb{}.msg(1, pb{}.msg(1, pb{}.msg(1, bad))),
+		pb{}.msg(1, pb{}.msg(2, bad)),
+		pb{}.msg(1, pb{}.msg(2, pb{}.msg(1, bad))),
+		pb{}.msg(1, pb{}.msg(2, pb{}.msg(1, pb{}.msg(3, bad)))),
+		pb{}.msg(1, pb{}.msg(2, pb{}.msg(2, bad))),
+	}
+	for _, data := range cases {
+		assert.Error(t, (&ExportMetricsServiceRequest{}).Unmarshal(data))
+	}
+	metrics := []pb{
+		pb{}.msg(5, bad),
+		pb{}.msg(5, pb{}.msg(1, bad)),
+		pb{}.msg(5, pb{}.msg(1, pb{}.msg(7, bad))),
+		pb{}.msg(7, bad),
+		pb{}.msg(7, pb{}.msg(1, bad)),
+		pb{}.msg(9, bad),
+		pb{}.msg(9, pb{}.msg(1, bad)),
+		pb{}.msg(9, pb{}.msg(1, pb{}.msg(9, bad))),
+		pb{}.msg(9, pb{}.msg(1, pb{}.msg(6, badPacked))),
+		pb{}.msg(9, pb{}.msg(1, pb{}.msg(7, badPacked))),
+		pb{}.msg(10, bad),
+		pb{}.msg(10, pb{}.msg(1, bad)),
+		pb{}.msg(10, pb{}.msg(1, pb{}.msg(1, bad))),
+		pb{}.msg(10, pb{}.msg(1, pb{}.msg(8, bad))),
+		pb{}.msg(10, pb{}.msg(1, pb{}.msg(9, bad))),
+		pb{}.msg(10, pb{}.msg(1, pb{}.msg(8, pb{}.msg(2, badPacked)))),
+	}
+	for _, data := range metrics {
+		assert.Error(t, (&Metric{}).Unmarshal(data))
+	}
+	values := []pb{
+		bad,
+		pb{}.msg(2, bad),
+		pb{}.msg(2, pb{}.msg(5, bad)),
+		pb{}.msg(2, pb{}.msg(5, pb{}.msg(1, bad))),
+		pb{}.msg(2, pb{}.msg(6, bad)),
+	}
+	for _, data := range values {
+		assert.Error(t, (&KeyValue{}).Unmarshal(data))
+	}
+}
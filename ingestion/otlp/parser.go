@@ -0,0 +1,301 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/strutil"
+	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+const (
+	valueField      = "value"
+	sumSuffix       = "_sum"
+	countSuffix     = "_count"
+	jsonContentType = "application/json"
+)
+
+var (
+	otlpIngestionStatistics = metrics.NewOTLPIngestionStatistics()
+)
+
+// Parse parses the OTLP/HTTP metrics export request,
+// the request body is json format if content type is application/json, else protobuf format.
+func Parse(req *http.Request, enrichedTags tag.Tags, namespace string) (*metric.BrokerBatchRows, error) {
+	var reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := ingestCommon.GetGzipReader(req.Body)
+		if err != nil {
+			otlpIngestionStatistics.CorruptedData.Incr()
+			return nil, fmt.Errorf("ingestion corrupted gzip data: %w", err)
+		}
+		defer ingestCommon.PutGzipReader(gzipReader)
+		reader = gzipReader
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	otlpIngestionStatistics.ReadBytes.Add(float64(len(data)))
+
+	var exportReq ExportMetricsServiceRequest
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType == jsonContentType {
+		err = exportReq.UnmarshalJSON(data)
+	} else {
+		err = exportReq.Unmarshal(data)
+	}
+	if err != nil {
+		otlpIngestionStatistics.CorruptedData.Incr()
+		return nil, fmt.Errorf("ingestion corrupted otlp metrics data: %w", err)
+	}
+	batch := parseExportRequest(&exportReq, enrichedTags, namespace)
+	if batch.Len() == 0 {
+		return nil, fmt.Errorf("empty metrics")
+	}
+	otlpIngestionStatistics.IngestedMetrics.Add(float64(batch.Len()))
+	return batch, nil
+}
+
+// parseExportRequest converts OTLP data points into metric rows,
+// resource/scope/data point attributes are merged as tags(data point attribute has the highest priority).
+//
+// Because LinDB stores sum/histogram as delta value:
+// 1. delta sum is written as sum field, cumulative(or unspecified) sum and gauge are written as gauge field;
+// 2. delta(or unspecified) histograms are written as histogram field, cumulative histograms are written as
+// gauge fields of prometheus style series(<name>_bucket with le tag, <name>_sum and <name>_count).
+func parseExportRequest(exportReq *ExportMetricsServiceRequest, enrichedTags tag.Tags, namespace string) *metric.BrokerBatchRows {
+	batch := metric.NewBrokerBatchRows()
+
+	converter, releaseFunc := metric.NewBrokerRowProtoConverter(strutil.String2ByteSlice(namespace), enrichedTags)
+	defer releaseFunc(converter)
+
+	appendMetric := func(m *protoMetricsV1.Metric) {
+		if err := batch.TryAppend(func(row *metric.BrokerRow) error {
+			return converter.ConvertTo(m, row)
+		}); err != nil {
+			otlpIngestionStatistics.DroppedMetrics.Incr()
+		}
+	}
+
+	for _, rm := range exportReq.ResourceMetrics {
+		for _, sm := range rm.ScopeMetrics {
+			var commonTags []*protoMetricsV1.KeyValue
+			commonTags = appendTags(commonTags, rm.Resource.Attributes)
+			commonTags = appendTags(commonTags, sm.Scope.Attributes)
+			for _, m := range sm.Metrics {
+				convertMetric(m, commonTags, appendMetric)
+			}
+		}
+	}
+	return batch
+}
+
+// convertMetric converts the data points of metric into LinDB metrics.
+func convertMetric(m *Metric, commonTags []*protoMetricsV1.KeyValue, appendMetric func(m *protoMetricsV1.Metric)) {
+	newMetric := func(name string, attributes []*KeyValue, timeUnixNano Uint64) *protoMetricsV1.Metric {
+		tags := make([]*protoMetricsV1.KeyValue, 0, len(commonTags)+len(attributes)+1)
+		tags = append(tags, commonTags...)
+		return &protoMetricsV1.Metric{
+			Name:      name,
+			Timestamp: int64(timeUnixNano) / int64(time.Millisecond),
+			Tags:      appendTags(tags, attributes),
+		}
+	}
+	convertNumber := func(dp *NumberDataPoint, fieldType protoMetricsV1.SimpleFieldType) {
+		value, ok := dp.value()
+		if !ok {
+			otlpIngestionStatistics.DroppedMetrics.Incr()
+			return
+		}
+		lm := newMetric(m.Name, dp.Attributes, dp.TimeUnixNano)
+		lm.SimpleFields = []*protoMetricsV1.SimpleField{{Name: valueField, Type: fieldType, Value: value}}
+		appendMetric(lm)
+	}
+	convertHistogram := func(attributes []*KeyValue, timeUnixNano Uint64, sum, count float64, bounds, values []float64) {
+		lm := newMetric(m.Name, attributes, timeUnixNano)
+		lm.CompoundField = &protoMetricsV1.CompoundField{
+			Sum:            sum,
+			Count:          count,
+			ExplicitBounds: bounds,
+			Values:         values,
+		}
+		appendMetric(lm)
+	}
+	// convertCumulativeHistogram converts cumulative histogram into gauge series like prometheus histogram,
+	// because histogram field stores delta bucket counts.
+	convertCumulativeHistogram := func(attributes []*KeyValue, timeUnixNano Uint64, sum, count float64, bounds, values []float64) {
+		appendGauge := func(name string, value float64, tags ...*protoMetricsV1.KeyValue) {
+			lm := newMetric(name, attributes, timeUnixNano)
+			lm.Tags = append(lm.Tags, tags...)
+			lm.SimpleFields = []*protoMetricsV1.SimpleField{{Name: valueField, Type: protoMetricsV1.SimpleFieldType_GAUGE, Value: value}}
+			appendMetric(lm)
+		}
+		var cumulative float64
+		for idx, upperBound := range bounds {
+			cumulative += values[idx]
+			appendGauge(m.Name+constants.PrometheusBucketSuffix, cumulative,
+				&protoMetricsV1.KeyValue{Key: constants.PrometheusBucketLabel, Value: formatBound(upperBound)})
+		}
+		appendGauge(m.Name+sumSuffix, sum)
+		appendGauge(m.Name+countSuffix, count)
+	}
+
+	switch {
+	case m.Gauge != nil:
+		for _, dp := range m.Gauge.DataPoints {
+			convertNumber(dp, protoMetricsV1.SimpleFieldType_GAUGE)
+		}
+	case m.Sum != nil:
+		fieldType := protoMetricsV1.SimpleFieldType_GAUGE
+		if m.Sum.AggregationTemporality == AggregationTemporalityDelta {
+			fieldType = protoMetricsV1.SimpleFieldType_DELTA_SUM
+		}
+		for _, dp := range m.Sum.DataPoints {
+			convertNumber(dp, fieldType)
+		}
+	case m.Histogram != nil:
+		convert := convertHistogram
+		if m.Histogram.AggregationTemporality == AggregationTemporalityCumulative {
+			convert = convertCumulativeHistogram
+		}
+		for _, dp := range m.Histogram.DataPoints {
+			bounds, values, ok := explicitBuckets(dp)
+			if !ok {
+				otlpIngestionStatistics.DroppedMetrics.Incr()
+				continue
+			}
+			convert(dp.Attributes, dp.TimeUnixNano, float64(dp.Sum), float64(dp.Count), bounds, values)
+		}
+	case m.ExponentialHistogram != nil:
+		convert := convertHistogram
+		if m.ExponentialHistogram.AggregationTemporality == AggregationTemporalityCumulative {
+			convert = convertCumulativeHistogram
+		}
+		for _, dp := range m.ExponentialHistogram.DataPoints {
+			if dp.Flags&dataPointFlagNoRecordedValue != 0 {
+				otlpIngestionStatistics.DroppedMetrics.Incr()
+				continue
+			}
+			bounds, values := exponentialBuckets(dp)
+			convert(dp.Attributes, dp.TimeUnixNano, float64(dp.Sum), float64(dp.Count), bounds, values)
+		}
+	default:
+		// summary and deprecated data types are not supported
+		otlpIngestionStatistics.DroppedMetrics.Incr()
+	}
+}
+
+// value returns the value of number data point, returns false if no value recorded or value is NaN/Inf.
+func (m *NumberDataPoint) value() (float64, bool) {
+	if m.Flags&dataPointFlagNoRecordedValue != 0 {
+		return 0, false
+	}
+	switch {
+	case m.AsDouble != nil:
+		value := float64(*m.AsDouble)
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			// ignore invalid value
+			return 0, false
+		}
+		return value, true
+	case m.AsInt != nil:
+		return float64(*m.AsInt), true
+	default:
+		return 0, false
+	}
+}
+
+// explicitBuckets returns the bounds and bucket counts of explicit bucket histogram,
+// OTLP histogram has len(bounds)+1 buckets, the upper bound of last bucket is +Inf.
+func explicitBuckets(dp *HistogramDataPoint) (bounds, values []float64, ok bool) {
+	if dp.Flags&dataPointFlagNoRecordedValue != 0 || len(dp.BucketCounts) != len(dp.ExplicitBounds)+1 {
+		return nil, nil, false
+	}
+	bounds = make([]float64, 0, len(dp.BucketCounts))
+	values = make([]float64, 0, len(dp.BucketCounts))
+	for idx, count := range dp.BucketCounts {
+		if idx < len(dp.ExplicitBounds) {
+			bounds = append(bounds, float64(dp.ExplicitBounds[idx]))
+		} else {
+			bounds = append(bounds, math.Inf(1))
+		}
+		values = append(values, float64(count))
+	}
+	return bounds, values, true
+}
+
+// exponentialBuckets converts exponential buckets into explicit buckets,
+// the positive bucket at index i covers (base^i, base^(i+1)] where base = 2^(2^-scale).
+// Because histogram bound of LinDB cannot be negative, negative buckets are merged into the zero bucket
+// whose upper bound is zero threshold.
+func exponentialBuckets(dp *ExponentialHistogramDataPoint) (bounds, values []float64) {
+	bucketCount := len(dp.Positive.BucketCounts) + 2
+	bounds = make([]float64, 0, bucketCount)
+	values = make([]float64, 0, bucketCount)
+
+	zeroCount := float64(dp.ZeroCount)
+	for _, count := range dp.Negative.BucketCounts {
+		zeroCount += float64(count)
+	}
+	bounds = append(bounds, float64(dp.ZeroThreshold))
+	values = append(values, zeroCount)
+
+	factor := math.Exp2(-float64(dp.Scale))
+	for idx, count := range dp.Positive.BucketCounts {
+		index := int64(dp.Positive.Offset) + int64(idx) + 1
+		bounds = append(bounds, math.Exp2(float64(index)*factor))
+		values = append(values, float64(count))
+	}
+	bounds = append(bounds, math.Inf(1))
+	values = append(values, 0)
+	return bounds, values
+}
+
+// formatBound formats the upper bound of bucket as prometheus le label value.
+func formatBound(upperBound float64) string {
+	if math.IsInf(upperBound, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(upperBound, 'g', -1, 64)
+}
+
+// appendTags appends the attributes into tags, attributes with empty value are ignored.
+func appendTags(tags []*protoMetricsV1.KeyValue, attributes []*KeyValue) []*protoMetricsV1.KeyValue {
+	for _, attr := range attributes {
+		value := attr.Value.String()
+		if attr.Key == "" || value == "" {
+			continue
+		}
+		tags = append(tags, &protoMetricsV1.KeyValue{Key: attr.Key, Value: value})
+	}
+	return tags
+}
@@ -0,0 +1,294 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func newRequest(data []byte, contentType string) *http.Request {
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", bytes.NewReader(data))
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+func newExportRequest(metrics ...*Metric) *ExportMetricsServiceRequest {
+	return &ExportMetricsServiceRequest{ResourceMetrics: []*ResourceMetrics{{
+		Resource: Resource{Attributes: []*KeyValue{stringKV("service", "order"), stringKV("host", "a")}},
+		ScopeMetrics: []*ScopeMetrics{{
+			Scope:   Scope{Name: "lib", Attributes: []*KeyValue{stringKV("empty", "")}},
+			Metrics: metrics,
+		}},
+	}}}
+}
+
+func stringKV(key, value string) *KeyValue {
+	return &KeyValue{Key: key, Value: AnyValue{StringValue: &value}}
+}
+
+func double(v float64) *Float64 {
+	f := Float64(v)
+	return &f
+}
+
+func tags(row *metric.BrokerRow) map[string]string {
+	m := row.Metric()
+	result := make(map[string]string)
+	var kv flatMetricsV1.KeyValue
+	for i := 0; i < m.KeyValuesLength(); i++ {
+		m.KeyValues(&kv, i)
+		result[string(kv.Key())] = string(kv.Value())
+	}
+	return result
+}
+
+func simpleField(row *metric.BrokerRow) *flatMetricsV1.SimpleField {
+	var sf flatMetricsV1.SimpleField
+	m := row.Metric()
+	m.SimpleFields(&sf, 0)
+	return &sf
+}
+
+func compoundField(row *metric.BrokerRow) (bounds, values []float64) {
+	m := row.Metric()
+	cf := m.CompoundField(nil)
+	for i := 0; i < cf.ValuesLength(); i++ {
+		bounds = append(bounds, cf.ExplicitBounds(i))
+		values = append(values, cf.Values(i))
+	}
+	return bounds, values
+}
+
+func Test_Parse(t *testing.T) {
+	data := []byte(`{"resourceMetrics":[{"scopeMetrics":[{"metrics":[
+		{"name":"cpu","gauge":{"dataPoints":[{"timeUnixNano":"2000000","asDouble":1.5}]}}]}]}]}`)
+	enrichedTags := []tag.Tag{tag.NewTag([]byte("region"), []byte("nj"))}
+	// json
+	batch, err := Parse(newRequest(data, "application/json; charset=utf-8"), enrichedTags, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+	m := batch.Rows()[0].Metric()
+	assert.Equal(t, "cpu", string(m.Name()))
+	assert.Equal(t, "ns", string(m.Namespace()))
+	assert.Equal(t, int64(2), m.Timestamp())
+	assert.Equal(t, map[string]string{"region": "nj"}, tags(&batch.Rows()[0]))
+	// gzip json
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	req := newRequest(buf.Bytes(), "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	batch, err = Parse(req, nil, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+	// protobuf
+	gauge := pb{}.str(1, "cpu").msg(5, pb{}.msg(1, pb{}.fixed64(3, 1e6).double(4, 1)))
+	body := pb{}.msg(1, pb{}.msg(2, pb{}.msg(2, gauge)))
+	batch, err = Parse(newRequest(body, "application/x-protobuf"), nil, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+}
+
+func Test_Parse_fail(t *testing.T) {
+	// bad gzip data
+	req := newRequest([]byte("bad-data"), "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	_, err := Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// read failure
+	req, _ = http.NewRequestWithContext(context.TODO(), http.MethodPost, "", io.NopCloser(&badReader{}))
+	_, err = Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// bad json
+	_, err = Parse(newRequest([]byte("bad-data"), "application/json"), nil, "ns")
+	assert.Error(t, err)
+	// bad protobuf
+	_, err = Parse(newRequest([]byte{0xff}, ""), nil, "ns")
+	assert.Error(t, err)
+	// empty metrics
+	_, err = Parse(newRequest(nil, ""), nil, "ns")
+	assert.Error(t, err)
+}
+
+func Test_parseExportRequest_number(t *testing.T) {
+	intValue := Int64(5)
+	batch := parseExportRequest(newExportRequest(
+		&Metric{Name: "cpu", Gauge: &Gauge{DataPoints: []*NumberDataPoint{
+			{Attributes: []*KeyValue{stringKV("host", "b")}, TimeUnixNano: 1e9, AsDouble: double(1.5)},
+			// no value
+			{TimeUnixNano: 1e9},
+			{TimeUnixNano: 1e9, AsDouble: double(1), Flags: dataPointFlagNoRecordedValue},
+			// bad value
+			{TimeUnixNano: 1e9, AsDouble: double(math.NaN())},
+			{TimeUnixNano: 1e9, AsDouble: double(math.Inf(1))},
+			{TimeUnixNano: 1e9, AsDouble: double(math.Inf(-1))},
+		}}},
+		&Metric{Name: "requests", Sum: &Sum{
+			AggregationTemporality: AggregationTemporalityDelta,
+			DataPoints: []*NumberDataPoint{
+				{TimeUnixNano: 1e9, AsInt: &intValue},
+				{TimeUnixNano: 1e9, AsDouble: double(math.Inf(1))},
+			},
+		}},
+		&Metric{Name: "memory", Sum: &Sum{
+			AggregationTemporality: AggregationTemporalityCumulative,
+			DataPoints:             []*NumberDataPoint{{TimeUnixNano: 1e9, AsDouble: double(3)}},
+		}},
+		// summary not support
+		&Metric{Name: "summary"},
+	), nil, "ns")
+	assert.Equal(t, 3, batch.Len())
+	rows := batch.Rows()
+
+	assert.Equal(t, map[string]string{"service": "order", "host": "b"}, tags(&rows[0]))
+	m := rows[0].Metric()
+	assert.Equal(t, int64(1000), m.Timestamp())
+	sf := simpleField(&rows[0])
+	assert.Equal(t, "value", string(sf.Name()))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeGauge, sf.Type())
+	assert.Equal(t, 1.5, sf.Value())
+
+	sf = simpleField(&rows[1])
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeDeltaSum, sf.Type())
+	assert.Equal(t, 5.0, sf.Value())
+
+	sf = simpleField(&rows[2])
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeGauge, sf.Type())
+	assert.Equal(t, 3.0, sf.Value())
+}
+
+func Test_parseExportRequest_histogram(t *testing.T) {
+	batch := parseExportRequest(newExportRequest(
+		&Metric{Name: "latency", Histogram: &Histogram{
+			AggregationTemporality: AggregationTemporalityDelta,
+			DataPoints: []*HistogramDataPoint{
+				{TimeUnixNano: 1e9, Count: 6, Sum: 10, BucketCounts: []Uint64{1, 2, 3}, ExplicitBounds: []Float64{1, 5}},
+				// bucket count not match
+				{TimeUnixNano: 1e9, Count: 6, Sum: 10, BucketCounts: []Uint64{1, 2}, ExplicitBounds: []Float64{1, 5}},
+				// no value
+				{TimeUnixNano: 1e9, Flags: dataPointFlagNoRecordedValue, BucketCounts: []Uint64{1}},
+			},
+		}},
+		// cumulative histogram
+		&Metric{Name: "latency", Histogram: &Histogram{
+			AggregationTemporality: AggregationTemporalityCumulative,
+			DataPoints: []*HistogramDataPoint{
+				{TimeUnixNano: 1e9, Count: 6, Sum: 10, BucketCounts: []Uint64{1, 2, 3}, ExplicitBounds: []Float64{1, 5}},
+				// bucket count not match
+				{TimeUnixNano: 1e9, BucketCounts: []Uint64{1}, ExplicitBounds: []Float64{1, 5}},
+			},
+		}},
+		&Metric{Name: "size", ExponentialHistogram: &ExponentialHistogram{
+			AggregationTemporality: AggregationTemporalityDelta,
+			DataPoints: []*ExponentialHistogramDataPoint{
+				{
+					TimeUnixNano: 1e9, Count: 10, Sum: 20, Scale: 1, ZeroCount: 1, ZeroThreshold: 0.5,
+					Positive: Buckets{Offset: 1, BucketCounts: []Uint64{2, 3}},
+					Negative: Buckets{Offset: 0, BucketCounts: []Uint64{4}},
+				},
+				{Flags: dataPointFlagNoRecordedValue},
+			},
+		}},
+		&Metric{Name: "size", ExponentialHistogram: &ExponentialHistogram{
+			AggregationTemporality: AggregationTemporalityCumulative,
+			DataPoints: []*ExponentialHistogramDataPoint{
+				{TimeUnixNano: 1e9, Count: 1, Sum: 1.5, Positive: Buckets{BucketCounts: []Uint64{1}}},
+				{Flags: dataPointFlagNoRecordedValue},
+			},
+		}},
+	), nil, "ns")
+	assert.Equal(t, 12, batch.Len())
+	rows := batch.Rows()
+	// gaugeSeries returns metric name, le tag and value of gauge series converted from cumulative histogram
+	gaugeSeries := func(row *metric.BrokerRow) (string, string, float64) {
+		sf := simpleField(row)
+		assert.Equal(t, flatMetricsV1.SimpleFieldTypeGauge, sf.Type())
+		assert.Equal(t, "value", string(sf.Name()))
+		m := row.Metric()
+		return string(m.Name()), tags(row)["le"], sf.Value()
+	}
+	type gauge struct {
+		name, le string
+		value    float64
+	}
+	assertGauges := func(rows []metric.BrokerRow, expect []gauge) {
+		for idx := range rows {
+			name, le, value := gaugeSeries(&rows[idx])
+			assert.Equal(t, expect[idx], gauge{name: name, le: le, value: value})
+		}
+	}
+
+	m := rows[0].Metric()
+	assert.Equal(t, "latency", string(m.Name()))
+	cf := m.CompoundField(nil)
+	assert.Equal(t, 10.0, cf.Sum())
+	assert.Equal(t, 6.0, cf.Count())
+	bounds, values := compoundField(&rows[0])
+	assert.Equal(t, []float64{1, 5, math.Inf(1)}, bounds)
+	assert.Equal(t, []float64{1, 2, 3}, values)
+
+	// cumulative histogram, bucket counts are cumulative like prometheus
+	assertGauges(rows[1:6], []gauge{
+		{name: "latency_bucket", le: "1", value: 1},
+		{name: "latency_bucket", le: "5", value: 3},
+		{name: "latency_bucket", le: "+Inf", value: 6},
+		{name: "latency_sum", value: 10},
+		{name: "latency_count", value: 6},
+	})
+
+	m = rows[6].Metric()
+	assert.Equal(t, "size", string(m.Name()))
+	bounds, values = compoundField(&rows[6])
+	assert.Equal(t, []float64{0.5, 2, math.Exp2(1.5), math.Inf(1)}, bounds)
+	assert.Equal(t, []float64{5, 2, 3, 0}, values)
+
+	assertGauges(rows[7:], []gauge{
+		{name: "size_bucket", le: "0", value: 0},
+		{name: "size_bucket", le: "2", value: 1},
+		{name: "size_bucket", le: "+Inf", value: 1},
+		{name: "size_sum", value: 1.5},
+		{name: "size_count", value: 1},
+	})
+}
+
+func Test_AnyValue_String(t *testing.T) {
+	boolValue := true
+	assert.Equal(t, "true", (&AnyValue{BoolValue: &boolValue}).String())
+	assert.Equal(t, "", (&AnyValue{}).String())
+	assert.True(t, strings.HasPrefix((&AnyValue{ArrayValue: &ArrayValue{}}).String(), "["))
+}
+
+type badReader struct{}
+
+func (r *badReader) Read(_ []byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
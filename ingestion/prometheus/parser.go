@@ -0,0 +1,124 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/golang/snappy"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/strutil"
+	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+const (
+	metricNameLabel = "__name__"
+)
+
+var (
+	prometheusIngestionStatistics = metrics.NewPrometheusIngestionStatistics()
+)
+
+// Parse parses the snappy compressed protobuf data of prometheus remote write request.
+func Parse(req *http.Request, enrichedTags tag.Tags, namespace string) (*metric.BrokerBatchRows, error) {
+	compressed, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	prometheusIngestionStatistics.ReadBytes.Add(float64(len(compressed)))
+
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		prometheusIngestionStatistics.CorruptedData.Incr()
+		return nil, fmt.Errorf("ingestion corrupted snappy data: %w", err)
+	}
+	var writeReq WriteRequest
+	if err := writeReq.Unmarshal(data); err != nil {
+		prometheusIngestionStatistics.CorruptedData.Incr()
+		return nil, fmt.Errorf("ingestion corrupted prometheus remote write data: %w", err)
+	}
+	batch := parseWriteRequest(&writeReq, enrichedTags, namespace)
+	if batch.Len() == 0 {
+		return nil, fmt.Errorf("empty metrics")
+	}
+	prometheusIngestionStatistics.IngestedMetrics.Add(float64(batch.Len()))
+	return batch, nil
+}
+
+// parseWriteRequest converts prometheus series into metric rows, all samples are written as gauge field,
+// because counters and histogram series(_bucket/_sum/_count) of prometheus are cumulative,
+// counter-aware functions(e.g. rate/increase) handle them when querying.
+func parseWriteRequest(writeReq *WriteRequest, enrichedTags tag.Tags, namespace string) *metric.BrokerBatchRows {
+	batch := metric.NewBrokerBatchRows()
+
+	converter, releaseFunc := metric.NewBrokerRowProtoConverter(strutil.String2ByteSlice(namespace), enrichedTags)
+	defer releaseFunc(converter)
+
+	for _, ts := range writeReq.Timeseries {
+		name, tags := seriesLabels(ts)
+		if name == "" {
+			prometheusIngestionStatistics.DroppedMetrics.Add(float64(len(ts.Samples)))
+			continue
+		}
+		for _, sample := range ts.Samples {
+			if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+				// ignore stale marker and invalid value
+				prometheusIngestionStatistics.DroppedMetrics.Incr()
+				continue
+			}
+			m := &protoMetricsV1.Metric{
+				Name:      name,
+				Timestamp: sample.Timestamp,
+				Tags:      tags,
+				SimpleFields: []*protoMetricsV1.SimpleField{{
+					Name:  constants.PrometheusValueField,
+					Type:  protoMetricsV1.SimpleFieldType_GAUGE,
+					Value: sample.Value,
+				}},
+			}
+			if err := batch.TryAppend(func(row *metric.BrokerRow) error {
+				return converter.ConvertTo(m, row)
+			}); err != nil {
+				prometheusIngestionStatistics.DroppedMetrics.Incr()
+			}
+		}
+	}
+	return batch
+}
+
+// seriesLabels returns metric name and tags of series.
+func seriesLabels(ts *TimeSeries) (name string, tags []*protoMetricsV1.KeyValue) {
+	for _, label := range ts.Labels {
+		switch {
+		case label.Name == metricNameLabel:
+			name = label.Value
+		case label.Value == "":
+			// empty label value is equivalent to label not exist
+		default:
+			tags = append(tags, &protoMetricsV1.KeyValue{Key: label.Name, Value: label.Value})
+		}
+	}
+	return name, tags
+}
@@ -0,0 +1,180 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func newSeries(name string, value float64, labels ...string) *TimeSeries {
+	ts := &TimeSeries{
+		Labels:  []Label{{Name: metricNameLabel, Value: name}},
+		Samples: []Sample{{Value: value, Timestamp: 1000}},
+	}
+	for i := 0; i+1 < len(labels); i += 2 {
+		ts.Labels = append(ts.Labels, Label{Name: labels[i], Value: labels[i+1]})
+	}
+	return ts
+}
+
+func newRequest(writeReq *WriteRequest) *http.Request {
+	data := snappy.Encode(nil, writeReq.Marshal())
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", bytes.NewReader(data))
+	return req
+}
+
+func simpleField(row *metric.BrokerRow) *flatMetricsV1.SimpleField {
+	var sf flatMetricsV1.SimpleField
+	m := row.Metric()
+	m.SimpleFields(&sf, 0)
+	return &sf
+}
+
+func Test_Parse(t *testing.T) {
+	enrichedTags := []tag.Tag{tag.NewTag([]byte("region"), []byte("nj"))}
+	batch, err := Parse(newRequest(&WriteRequest{Timeseries: []*TimeSeries{
+		newSeries("http_requests_total", 10, "host", "a", "empty", ""),
+	}}), enrichedTags, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+	m := batch.Rows()[0].Metric()
+	assert.Equal(t, "ns", string(m.Namespace()))
+	assert.Equal(t, "http_requests_total", string(m.Name()))
+	assert.Equal(t, int64(1000), m.Timestamp())
+	assert.Equal(t, 2, m.KeyValuesLength())
+	sf := simpleField(&batch.Rows()[0])
+	assert.Equal(t, "value", string(sf.Name()))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeGauge, sf.Type())
+	assert.Equal(t, 10.0, sf.Value())
+}
+
+func Test_Parse_fail(t *testing.T) {
+	// read failure
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", io.NopCloser(&badReader{}))
+	_, err := Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// bad snappy data
+	req, _ = http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader("bad-data"))
+	_, err = Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// bad proto data
+	req, _ = http.NewRequestWithContext(context.TODO(), http.MethodPost, "",
+		bytes.NewReader(snappy.Encode(nil, []byte{0xff})))
+	_, err = Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// empty metrics
+	_, err = Parse(newRequest(&WriteRequest{}), nil, "ns")
+	assert.Error(t, err)
+}
+
+func Test_parseWriteRequest_fieldType(t *testing.T) {
+	batch := parseWriteRequest(&WriteRequest{
+		Timeseries: []*TimeSeries{
+			newSeries("cpu", 1),
+			newSeries("requests", 2),
+			newSeries("rpc_sum", 5),
+			newSeries("rpc", 6, "quantile", "0.99"),
+			newSeries("memory_total", 7),
+		},
+		Metadata: []*MetricMetadata{
+			{Type: MetricTypeCounter, MetricFamilyName: "requests"},
+			{Type: MetricTypeSummary, MetricFamilyName: "rpc"},
+			{Type: MetricTypeGauge, MetricFamilyName: "memory_total"},
+		},
+	}, nil, "ns")
+	assert.Equal(t, 5, batch.Len())
+	// counters are cumulative, written as gauge like other series
+	rows := batch.Rows()
+	for idx := range rows {
+		m := rows[idx].Metric()
+		assert.Equal(t, flatMetricsV1.SimpleFieldTypeGauge, simpleField(&rows[idx]).Type(), string(m.Name()))
+	}
+}
+
+func Test_parseWriteRequest_histogram(t *testing.T) {
+	batch := parseWriteRequest(&WriteRequest{
+		Timeseries: []*TimeSeries{
+			newSeries("latency_bucket", 1, "host", "a", "le", "0.1"),
+			newSeries("latency_bucket", 6, "host", "a", "le", "+Inf"),
+			newSeries("latency_sum", 2.5, "host", "a"),
+			newSeries("latency_count", 6, "host", "a"),
+		},
+		Metadata: []*MetricMetadata{{Type: MetricTypeHistogram, MetricFamilyName: "latency"}},
+	}, nil, "ns")
+	// bucket series keep le label, all series are gauge
+	assert.Equal(t, 4, batch.Len())
+	expects := []struct {
+		name  string
+		le    string
+		value float64
+	}{
+		{name: "latency_bucket", le: "0.1", value: 1},
+		{name: "latency_bucket", le: "+Inf", value: 6},
+		{name: "latency_sum", value: 2.5},
+		{name: "latency_count", value: 6},
+	}
+	rows := batch.Rows()
+	for idx := range rows {
+		m := rows[idx].Metric()
+		assert.Equal(t, expects[idx].name, string(m.Name()))
+		le := ""
+		var kv flatMetricsV1.KeyValue
+		for i := 0; i < m.KeyValuesLength(); i++ {
+			m.KeyValues(&kv, i)
+			if string(kv.Key()) == "le" {
+				le = string(kv.Value())
+			}
+		}
+		assert.Equal(t, expects[idx].le, le)
+		sf := simpleField(&rows[idx])
+		assert.Equal(t, flatMetricsV1.SimpleFieldTypeGauge, sf.Type())
+		assert.Equal(t, expects[idx].value, sf.Value())
+	}
+}
+
+func Test_parseWriteRequest_drop(t *testing.T) {
+	batch := parseWriteRequest(&WriteRequest{
+		Timeseries: []*TimeSeries{
+			// no metric name
+			{Labels: []Label{{Name: "host", Value: "a"}}, Samples: []Sample{{Value: 1}}},
+			// stale marker
+			newSeries("cpu", math.NaN()),
+			newSeries("cpu", math.Inf(1)),
+		},
+	}, nil, "ns")
+	assert.Equal(t, 0, batch.Len())
+}
+
+type badReader struct{}
+
+func (r *badReader) Read(_ []byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
@@ -0,0 +1,220 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+)
+
+// MetricType represents the metric type of prometheus metric family.
+type MetricType int32
+
+// Defines all metric types of prometheus remote write protocol.
+const (
+	MetricTypeUnknown MetricType = iota
+	MetricTypeCounter
+	MetricTypeGauge
+	MetricTypeHistogram
+	MetricTypeGaugeHistogram
+	MetricTypeSummary
+	MetricTypeInfo
+	MetricTypeStateSet
+)
+
+// WriteRequest represents the remote write request of prometheus.
+type WriteRequest struct {
+	Timeseries []*TimeSeries
+	Metadata   []*MetricMetadata
+}
+
+// TimeSeries represents a series with labels and samples.
+type TimeSeries struct {
+	Labels  []Label
+	Samples []Sample
+}
+
+// Label represents a label pair of series.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample represents a data point of series.
+type Sample struct {
+	Value     float64
+	Timestamp int64 // timestamp in milliseconds
+}
+
+// MetricMetadata represents the metadata of metric family.
+type MetricMetadata struct {
+	Type             MetricType
+	MetricFamilyName string
+	Help             string
+	Unit             string
+}
+
+// Unmarshal decodes the protobuf data of remote write request.
+func (m *WriteRequest) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			ts := &TimeSeries{}
+			if err := ts.Unmarshal(v); err != nil {
+				return err
+			}
+			m.Timeseries = append(m.Timeseries, ts)
+		case num == 3 && typ == protowire.BytesType:
+			md := &MetricMetadata{}
+			if err := md.Unmarshal(v); err != nil {
+				return err
+			}
+			m.Metadata = append(m.Metadata, md)
+		}
+		return nil
+	})
+}
+
+// Marshal encodes the remote write request into protobuf data.
+func (m *WriteRequest) Marshal() []byte {
+	var b []byte
+	for _, ts := range m.Timeseries {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, ts.Marshal())
+	}
+	for _, md := range m.Metadata {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendBytes(b, md.Marshal())
+	}
+	return b
+}
+
+// Unmarshal decodes the protobuf data of time series.
+func (m *TimeSeries) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			label := Label{}
+			if err := label.Unmarshal(v); err != nil {
+				return err
+			}
+			m.Labels = append(m.Labels, label)
+		case num == 2 && typ == protowire.BytesType:
+			sample := Sample{}
+			if err := sample.Unmarshal(v); err != nil {
+				return err
+			}
+			m.Samples = append(m.Samples, sample)
+		}
+		return nil
+	})
+}
+
+// Marshal encodes the time series into protobuf data.
+func (m *TimeSeries) Marshal() []byte {
+	var b []byte
+	for _, label := range m.Labels {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, label.Marshal())
+	}
+	for _, sample := range m.Samples {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, sample.Marshal())
+	}
+	return b
+}
+
+// Unmarshal decodes the protobuf data of label.
+func (m *Label) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			m.Name = string(v)
+		case num == 2 && typ == protowire.BytesType:
+			m.Value = string(v)
+		}
+		return nil
+	})
+}
+
+// Marshal encodes the label into protobuf data.
+func (m *Label) Marshal() []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, m.Name)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, m.Value)
+	return b
+}
+
+// Unmarshal decodes the protobuf data of sample.
+func (m *Sample) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.Fixed64Type:
+			m.Value = math.Float64frombits(val)
+		case num == 2 && typ == protowire.VarintType:
+			m.Timestamp = int64(val)
+		}
+		return nil
+	})
+}
+
+// Marshal encodes the sample into protobuf data.
+func (m *Sample) Marshal() []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, math.Float64bits(m.Value))
+	b = protowire.AppendTag(b, 2, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(m.Timestamp))
+	return b
+}
+
+// Unmarshal decodes the protobuf data of metric metadata.
+func (m *MetricMetadata) Unmarshal(data []byte) error {
+	return ingestCommon.DecodeProtoMessage(data, func(num protowire.Number, typ protowire.Type, val uint64, v []byte) error {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			m.Type = MetricType(val)
+		case num == 2 && typ == protowire.BytesType:
+			m.MetricFamilyName = string(v)
+		case num == 4 && typ == protowire.BytesType:
+			m.Help = string(v)
+		case num == 5 && typ == protowire.BytesType:
+			m.Unit = string(v)
+		}
+		return nil
+	})
+}
+
+// Marshal encodes the metric metadata into protobuf data.
+func (m *MetricMetadata) Marshal() []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(m.Type))
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, m.MetricFamilyName)
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendString(b, m.Help)
+	b = protowire.AppendTag(b, 5, protowire.BytesType)
+	b = protowire.AppendString(b, m.Unit)
+	return b
+}
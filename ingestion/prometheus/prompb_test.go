@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestWriteRequest_Marshal(t *testing.T) {
+	writeReq := &WriteRequest{
+		Timeseries: []*TimeSeries{{
+			Labels:  []Label{{Name: "__name__", Value: "cpu"}, {Name: "host", Value: "a"}},
+			Samples: []Sample{{Value: 1.5, Timestamp: 1000}, {Value: math.Inf(1), Timestamp: -1}},
+		}},
+		Metadata: []*MetricMetadata{{Type: MetricTypeGauge, MetricFamilyName: "cpu", Help: "cpu usage", Unit: "percent"}},
+	}
+	data := writeReq.Marshal()
+	decoded := &WriteRequest{}
+	assert.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, writeReq, decoded)
+}
+
+func TestWriteRequest_Unmarshal_unknownField(t *testing.T) {
+	var b []byte
+	// unknown fields with all wire types are skipped
+	b = protowire.AppendTag(b, 10, protowire.VarintType)
+	b = protowire.AppendVarint(b, 10)
+	b = protowire.AppendTag(b, 11, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 10)
+	b = protowire.AppendTag(b, 12, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 10)
+	b = protowire.AppendTag(b, 13, protowire.BytesType)
+	b = protowire.AppendString(b, "abc")
+	writeReq := &WriteRequest{}
+	assert.NoError(t, writeReq.Unmarshal(b))
+	assert.Empty(t, writeReq.Timeseries)
+}
+
+func TestWriteRequest_Unmarshal_fail(t *testing.T) {
+	writeReq := &WriteRequest{}
+	// bad tag
+	assert.Error(t, writeReq.Unmarshal([]byte{0xff}))
+	// bad value
+	assert.Error(t, writeReq.Unmarshal(protowire.AppendTag(nil, 1, protowire.BytesType)))
+
+	// bad time series
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendBytes(b, []byte{0xff})
+	assert.Error(t, writeReq.Unmarshal(b))
+	// bad metadata
+	b = protowire.AppendTag(nil, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, []byte{0xff})
+	assert.Error(t, writeReq.Unmarshal(b))
+
+	// bad label
+	ts := protowire.AppendTag(nil, 1, protowire.BytesType)
+	ts = protowire.AppendBytes(ts, []byte{0xff})
+	assert.Error(t, (&TimeSeries{}).Unmarshal(ts))
+	// bad sample
+	ts = protowire.AppendTag(nil, 2, protowire.BytesType)
+	ts = protowire.AppendBytes(ts, []byte{0xff})
+	assert.Error(t, (&TimeSeries{}).Unmarshal(ts))
+	assert.Error(t, (&Label{}).Unmarshal([]byte{0xff}))
+	assert.Error(t, (&Sample{}).Unmarshal([]byte{0xff}))
+}
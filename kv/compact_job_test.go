@@ -136,6 +136,37 @@ func TestCompactJob_merge_compact_merge_fail(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestCompactJob_merge_context(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader := table.NewMockReader(ctrl)
+	gomock.InOrder(
+		reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+			1: []byte("value1"),
+		})),
+		reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{})),
+	)
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil).MaxTimes(2)
+	merge := NewMockMerger(ctrl)
+	merge.EXPECT().Init(map[string]interface{}{"key": "value"})
+	merge.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	family := NewMockFamily(ctrl)
+	family.EXPECT().getNewMerger().Return(func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().getMergeContext().Return(map[string]interface{}{"key": "value"})
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	f1 := version.NewFileMeta(1, 1, 10, 100)
+	f4 := version.NewFileMeta(4, 30, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, []*version.FileMeta{f4})
+	state := newCompactionState(1000, snapshot, compaction)
+	compactJob := newCompactJob(family, state, nil)
+	err := compactJob.Run()
+	assert.Error(t, err)
+}
+
 func TestCompactJob_merge_doMerge_fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -357,6 +388,7 @@ func TestCompactJob_merge_rollup(t *testing.T) {
 func generateMockFamily(ctrl *gomock.Controller, merger NewMerger) *MockFamily {
 	family := NewMockFamily(ctrl)
 	family.EXPECT().getNewMerger().Return(merger).AnyTimes()
+	family.EXPECT().getMergeContext().Return(nil).AnyTimes()
 	family.EXPECT().Name().Return("test-family").AnyTimes()
 	family.EXPECT().commitEditLog(gomock.Any()).Return(true).AnyTimes()
 	return family
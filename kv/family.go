@@ -47,6 +47,8 @@ type Family interface {
 	Name() string
 	// NewFlusher creates flusher for saving data to family.
 	NewFlusher() Flusher
+	// SetMergeContext sets the context param which passes to merger when does compaction job.
+	SetMergeContext(key string, value interface{})
 	// GetSnapshot returns current version's snapshot
 	GetSnapshot() version.Snapshot
 
@@ -68,6 +70,8 @@ type Family interface {
 	compact()
 	// getNewMerger returns new merger function, merger need implement Merger interface
 	getNewMerger() NewMerger
+	// getMergeContext returns the context params of merger.
+	getMergeContext() map[string]interface{}
 	// addPendingOutput add a file which current writing file number
 	addPendingOutput(fileNumber table.FileNumber)
 	// removePendingOutput removes pending output file after compact or flush
@@ -77,6 +81,8 @@ type Family interface {
 	rollup()
 	// deleteObsoleteFiles deletes obsolete files
 	deleteObsoleteFiles()
+	// moveToBackend copies the live table files into file backend, then removes the local files.
+	moveToBackend(backend table.FileBackend) error
 	// close family, need wait background job completed then releases resource.
 	close()
 }
@@ -92,11 +98,14 @@ type family struct {
 	maxFileSize   uint32
 
 	pendingOutputs    sync.Map // keep all pending output files, includes flush/compact/rollup.
+	mergeContext      sync.Map // context params of merger, pass to merger when do compact/rollup job.
 	newCompactJobFunc func(family Family, state *compactionState, rollup Rollup) CompactJob
 
 	rolluping      atomic.Bool
 	lastRollupTime *atomic.Int64
 	compacting     atomic.Bool
+	// movedToBackend represents some table files of family are moved into file backend.
+	movedToBackend atomic.Bool
 
 	condition sync.WaitGroup // compact/rollup job if it's doing
 }
@@ -105,7 +114,8 @@ type family struct {
 func newFamily(store Store, option FamilyOption) (Family, error) {
 	name := option.Name
 
-	familyPath := filepath.Join(store.Path(), name)
+	storePath := store.Path()
+	familyPath := filepath.Join(storePath, name)
 
 	if !fileutil.Exist(familyPath) {
 		if err := mkDirFunc(familyPath); err != nil {
@@ -132,6 +142,8 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 		familyVersion:     store.createFamilyVersion(name, version.FamilyID(option.ID)),
 		lastRollupTime:    atomic.NewInt64(timeutil.Now()),
 	}
+	// store is tiered, obsolete table files need be deleted from file backend
+	f.movedToBackend.Store(fileutil.Exist(filepath.Join(storePath, version.Tiered)))
 
 	kvLogger.Info("create new family successfully", logger.String("family", f.familyInfo()))
 	return f, nil
@@ -281,6 +293,21 @@ func (f *family) getNewMerger() NewMerger {
 	return f.merger
 }
 
+// SetMergeContext sets the context param which passes to merger when does compaction job.
+func (f *family) SetMergeContext(key string, value interface{}) {
+	f.mergeContext.Store(key, value)
+}
+
+// getMergeContext returns the context params of merger.
+func (f *family) getMergeContext() map[string]interface{} {
+	params := make(map[string]interface{})
+	f.mergeContext.Range(func(key, value interface{}) bool {
+		params[key.(string)] = value
+		return true
+	})
+	return params
+}
+
 // deleteObsoleteFiles deletes obsolete files
 func (f *family) deleteObsoleteFiles() {
 	sstFiles, err := listDirFunc(f.familyPath)
@@ -329,6 +356,77 @@ func (f *family) deleteObsoleteFiles() {
 			}
 		}
 	}
+	if f.movedToBackend.Load() {
+		if backend := f.store.Option().Backend; backend != nil {
+			f.deleteObsoleteBackendFiles(backend, liveFiles)
+		}
+	}
+}
+
+// deleteObsoleteBackendFiles deletes obsolete table files which are moved into file backend.
+func (f *family) deleteObsoleteBackendFiles(backend table.FileBackend, liveFiles map[table.FileNumber]string) {
+	fileNames, err := backend.List(f.name)
+	if err != nil {
+		kvLogger.Error("list backend file fail when delete obsolete files",
+			logger.String("family", f.familyInfo()), logger.Error(err))
+		return
+	}
+	for _, fileName := range fileNames {
+		fileDesc := version.ParseFileName(fileName)
+		if fileDesc == nil || fileDesc.FileType != version.TypeTable {
+			continue
+		}
+		fileNumber := fileDesc.FileNumber
+		if _, ok := liveFiles[fileNumber]; ok {
+			continue
+		}
+		f.store.evictFamilyFile(fileNumber)
+		if err := backend.Delete(f.name, fileName); err != nil {
+			kvLogger.Error("delete backend sst file fail",
+				logger.String("family", f.familyInfo()), logger.Any("fileNumber", fileNumber), logger.Error(err))
+		} else {
+			kvLogger.Info("delete backend sst file successfully",
+				logger.String("family", f.familyInfo()), logger.Any("fileNumber", fileNumber))
+		}
+	}
+}
+
+// moveToBackend copies the live table files into file backend, then removes the local files.
+func (f *family) moveToBackend(backend table.FileBackend) error {
+	// cannot move files when compaction job doing, because compaction maybe generate new files.
+	if !f.compacting.CAS(false, true) {
+		return fmt.Errorf("family[%s] is compacting, cannot move files into backend", f.familyInfo())
+	}
+	defer f.compacting.Store(false)
+
+	liveFiles := make(map[table.FileNumber]struct{})
+	allLiveSSTFiles := f.familyVersion.GetAllActiveFiles()
+	for idx := range allLiveSSTFiles {
+		liveFiles[allLiveSSTFiles[idx].GetFileNumber()] = struct{}{}
+	}
+	// rollup files need be read when target family does rollup job
+	for file := range f.familyVersion.GetLiveRollupFiles() {
+		liveFiles[file] = struct{}{}
+	}
+	for fileNumber := range liveFiles {
+		fileName := version.Table(fileNumber)
+		localPath := filepath.Join(f.familyPath, fileName)
+		if !fileutil.Exist(localPath) {
+			// file moved already
+			continue
+		}
+		if err := backend.Put(f.name, fileName, localPath); err != nil {
+			return fmt.Errorf("move file[%s] of family[%s] into backend error:%w", fileName, f.familyInfo(), err)
+		}
+		f.movedToBackend.Store(true)
+		// NOTE: opened reader is still valid after removing the local file(mmap), the reader will be evicted by ttl.
+		if err := removeFunc(localPath); err != nil {
+			return err
+		}
+		kvLogger.Info("move sst file into backend successfully",
+			logger.String("family", f.familyInfo()), logger.Any("fileNumber", fileNumber))
+	}
+	return nil
 }
 
 // close family, need wait background job completed then releases resource.
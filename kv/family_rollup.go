@@ -43,20 +43,24 @@ type Rollup interface {
 	CalcSlot(timestamp int64) uint16
 	// BaseSlot returns base slot by source family time/target interval.
 	BaseSlot() uint16
+	// Aggregates returns the extra aggregates which need be materialized in target family.
+	Aggregates() []string
 }
 
 // rollup implements Rollup interface.
 type rollup struct {
 	source, target           timeutil.Interval
 	sourceFTime, targetFTime int64
+	aggregates               []string
 }
 
-func newRollup(source, target timeutil.Interval, sourceFTime, targetFTime int64) Rollup {
+func newRollup(source, target timeutil.Interval, sourceFTime, targetFTime int64, aggregates []string) Rollup {
 	return &rollup{
 		source:      source,
 		target:      target,
 		sourceFTime: sourceFTime,
 		targetFTime: targetFTime,
+		aggregates:  aggregates,
 	}
 }
 
@@ -76,6 +80,10 @@ func (r *rollup) BaseSlot() uint16 {
 	return r.CalcSlot(r.sourceFTime)
 }
 
+func (r *rollup) Aggregates() []string {
+	return r.aggregates
+}
+
 // needRollup checks if it needs rollup source family data.
 func (f *family) needRollup() bool {
 	if f.rolluping.Load() {
@@ -150,7 +158,8 @@ func (f *family) rollup() {
 			}
 
 			editLog := version.NewEditLog(f.ID())
-			sourceInterval := f.store.Option().Source
+			storeOption := f.store.Option()
+			sourceInterval := storeOption.Source
 			calc := sourceInterval.Calculator()
 			storeName := f.store.Name()
 			_, segmentName := filepath.Split(storeName)
@@ -197,7 +206,8 @@ func (f *family) rollup() {
 						logger.Error(err))
 					continue
 				}
-				rollup := newRollup(sourceInterval, targetInterval, familyStartTime, fSTime)
+				rollup := newRollup(sourceInterval, targetInterval, familyStartTime, fSTime,
+					storeOption.GetRollupAggregates(targetInterval))
 				if err := targetFamily.doRollupWork(f, rollup, files); err != nil {
 					kvLogger.Error("do rollup work fail",
 						logger.String("family", f.familyInfo()),
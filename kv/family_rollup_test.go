@@ -35,8 +35,9 @@ func TestRollup(t *testing.T) {
 	t.Run("10s->5min", func(t *testing.T) {
 		sf, _ := timeutil.ParseTimestamp("2019-12-12 10:00:00")
 		tf, _ := timeutil.ParseTimestamp("2019-12-12 00:00:00")
-		in := newRollup(timeutil.Interval(10*1000), timeutil.Interval(5*60*1000), sf, tf)
+		in := newRollup(timeutil.Interval(10*1000), timeutil.Interval(5*60*1000), sf, tf, []string{"max"})
 		assert.Equal(t, uint16(30), in.IntervalRatio())
+		assert.Equal(t, []string{"max"}, in.Aggregates())
 		timestamp := in.GetTimestamp(20)
 		assert.Equal(t, sf+10*1000*20, timestamp)
 		assert.Equal(t, uint16(10*60/5), in.CalcSlot(timestamp))
@@ -45,7 +46,8 @@ func TestRollup(t *testing.T) {
 	t.Run("10s->1hour", func(t *testing.T) {
 		sf, _ := timeutil.ParseTimestamp("2019-12-12 10:00:00")
 		tf, _ := timeutil.ParseTimestamp("2019-12-12 00:00:00")
-		in := newRollup(timeutil.Interval(10*1000), timeutil.Interval(60*60*1000), sf, tf)
+		in := newRollup(timeutil.Interval(10*1000), timeutil.Interval(60*60*1000), sf, tf, nil)
+		assert.Empty(t, in.Aggregates())
 		assert.Equal(t, uint16(360), in.IntervalRatio())
 		timestamp := in.GetTimestamp(20)
 		assert.Equal(t, uint16(10), in.BaseSlot())
@@ -54,6 +56,14 @@ func TestRollup(t *testing.T) {
 	})
 }
 
+func TestStoreOption_GetRollupAggregates(t *testing.T) {
+	opt := StoreOption{RollupAggregates: []RollupAggregate{
+		{Interval: timeutil.Interval(timeutil.OneMinute), Aggregates: []string{"min", "max"}},
+	}}
+	assert.Equal(t, []string{"min", "max"}, opt.GetRollupAggregates(timeutil.Interval(timeutil.OneMinute)))
+	assert.Nil(t, opt.GetRollupAggregates(timeutil.Interval(timeutil.OneHour)))
+}
+
 func TestFamily_needRollup(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -19,6 +19,7 @@ package kv
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -73,6 +74,10 @@ func TestFamily_New(t *testing.T) {
 
 	assert.NotNil(t, f.getFamilyVersion())
 	assert.NotNil(t, f.getNewMerger())
+
+	assert.Empty(t, f.getMergeContext())
+	f.SetMergeContext("key", "value")
+	assert.Equal(t, map[string]interface{}{"key": "value"}, f.getMergeContext())
 }
 
 func TestFamily_Data_Write_Read(t *testing.T) {
@@ -277,6 +282,83 @@ func TestFamily_deleteObsoleteFiles(t *testing.T) {
 	f1.deleteObsoleteFiles()
 }
 
+func TestFamily_deleteObsoleteBackendFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storePath := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(storePath, version.Tiered), nil, 0644))
+	backend := table.NewMockFileBackend(ctrl)
+	storeOption := DefaultStoreOption()
+	storeOption.Backend = backend
+	store := NewMockStore(ctrl)
+	store.EXPECT().Option().Return(storeOption).AnyTimes()
+	store.EXPECT().Path().Return(storePath)
+	fv := version.NewMockFamilyVersion(ctrl)
+	fv.EXPECT().GetAllActiveFiles().Return([]*version.FileMeta{version.NewFileMeta(2, 0, 0, 0)}).AnyTimes()
+	fv.EXPECT().GetLiveRollupFiles().Return(nil).AnyTimes()
+	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(fv)
+	f, err := newFamily(store, FamilyOption{Merger: "mockMerger", Name: "f"})
+	assert.NoError(t, err)
+	f1 := f.(*family)
+	// store is tiered
+	assert.True(t, f1.movedToBackend.Load())
+	// case 1: list backend files err
+	backend.EXPECT().List("f").Return(nil, fmt.Errorf("err"))
+	f1.deleteObsoleteFiles()
+	// case 2: delete obsolete backend files
+	backend.EXPECT().List("f").Return([]string{"000001.sst", "000002.sst", "000003.sst", "abc"}, nil)
+	store.EXPECT().evictFamilyFile(table.FileNumber(1))
+	store.EXPECT().evictFamilyFile(table.FileNumber(3))
+	backend.EXPECT().Delete("f", "000001.sst").Return(fmt.Errorf("err"))
+	backend.EXPECT().Delete("f", "000003.sst").Return(nil)
+	f1.deleteObsoleteFiles()
+	// case 3: no files moved into backend
+	f1.movedToBackend.Store(false)
+	f1.deleteObsoleteFiles()
+}
+
+func TestFamily_moveToBackend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		removeFunc = os.Remove
+		ctrl.Finish()
+	}()
+	familyPath := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(familyPath, version.Table(2)), []byte("test"), 0644))
+	fv := version.NewMockFamilyVersion(ctrl)
+	fv.EXPECT().GetAllActiveFiles().
+		Return([]*version.FileMeta{version.NewFileMeta(1, 0, 0, 0), version.NewFileMeta(2, 0, 0, 0)}).AnyTimes()
+	fv.EXPECT().GetLiveRollupFiles().Return(map[table.FileNumber][]timeutil.Interval{2: {10}}).AnyTimes()
+	backend := table.NewMockFileBackend(ctrl)
+	f := &family{
+		name:          "f",
+		familyPath:    familyPath,
+		familyVersion: fv,
+	}
+	// case 1: compaction job doing
+	f.compacting.Store(true)
+	assert.Error(t, f.moveToBackend(backend))
+	f.compacting.Store(false)
+	// case 2: put file into backend err
+	backend.EXPECT().Put("f", version.Table(2), filepath.Join(familyPath, version.Table(2))).Return(fmt.Errorf("err"))
+	assert.Error(t, f.moveToBackend(backend))
+	assert.False(t, f.compacting.Load())
+	// case 3: remove local file err
+	backend.EXPECT().Put("f", version.Table(2), gomock.Any()).Return(nil).Times(2)
+	removeFunc = func(name string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, f.moveToBackend(backend))
+	removeFunc = os.Remove
+	// case 4: move successfully, file 1 not exist in local
+	assert.NoError(t, f.moveToBackend(backend))
+	assert.True(t, f.movedToBackend.Load())
+	assert.False(t, fileutil.Exist(filepath.Join(familyPath, version.Table(2))))
+	// case 5: file moved already
+	assert.NoError(t, f.moveToBackend(backend))
+}
+
 func TestFamily_close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
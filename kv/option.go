@@ -20,6 +20,7 @@ package kv
 import (
 	"time"
 
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -41,6 +42,26 @@ type StoreOption struct {
 
 	Source timeutil.Interval   `toml:"source"` // optional(source interval)
 	Rollup []timeutil.Interval `toml:"rollup"` // optional(target interval)
+	// optional(extra aggregates materialized when rolling up into target interval)
+	RollupAggregates []RollupAggregate `toml:"rollupAggregates"`
+	// optional(file backend which keeps the table files moved out of store path, e.g. tiered storage)
+	Backend table.FileBackend `toml:"-"`
+}
+
+// RollupAggregate represents the extra aggregates which are materialized when rolling up into target interval.
+type RollupAggregate struct {
+	Interval   timeutil.Interval `toml:"interval"`
+	Aggregates []string          `toml:"aggregates"`
+}
+
+// GetRollupAggregates returns the extra aggregates of target interval, return nil if not set.
+func (s StoreOption) GetRollupAggregates(target timeutil.Interval) []string {
+	for _, rollupAggregate := range s.RollupAggregates {
+		if rollupAggregate.Interval == target {
+			return rollupAggregate.Aggregates
+		}
+	}
+	return nil
 }
 
 // DefaultStoreOption builds default store option
@@ -46,6 +46,7 @@ var (
 	listDirFunc       = fileutil.ListDir
 	mkDirFunc         = fileutil.MkDir
 	removeFunc        = os.Remove
+	writeFileFunc     = os.WriteFile
 	newFileLockFunc   = lockers.NewFileLock
 	newStoreFunc      = newStore
 )
@@ -67,6 +68,9 @@ type Store interface {
 	Option() StoreOption
 	// ForceRollup does rollup job manual.
 	ForceRollup()
+	// MoveToBackend moves the table files of all families into file backend, then removes the local files,
+	// the moved files are read back lazily through file backend.
+	MoveToBackend() error
 
 	// compact the families under store.
 	compact()
@@ -157,7 +161,7 @@ func newStore(name, path string, option StoreOption) (s Store, err error) {
 	}()
 
 	// build store reader cache
-	store1.cache = table.NewCache(path, option.TTL.Duration())
+	store1.cache = table.NewCache(path, option.TTL.Duration(), option.Backend)
 	// init version set
 	store1.versions = newVersionSetFunc(path, store1.cache, store1.option.Levels)
 
@@ -271,6 +275,22 @@ func (s *store) ForceRollup() {
 	}
 }
 
+// MoveToBackend moves the table files of all families into file backend, then removes the local files,
+// the moved files are read back lazily through file backend.
+func (s *store) MoveToBackend() error {
+	if s.option.Backend == nil {
+		return fmt.Errorf("file backend not set for store[%s]", s.path)
+	}
+	families := s.getCurrentFamilies()
+	for _, f := range families {
+		if err := f.moveToBackend(s.option.Backend); err != nil {
+			return err
+		}
+	}
+	// write marker file, store is tiered
+	return writeFileFunc(filepath.Join(s.path, version.Tiered), nil, 0644)
+}
+
 // close the store, then release some resource
 func (s *store) close() error {
 	// close each family in kv store.
@@ -32,6 +32,7 @@ import (
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/lockers"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/objstore"
 )
 
 var mergerStr = "mockMergerAppend"
@@ -295,6 +296,69 @@ func TestStore_Rollup(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStore_MoveToBackend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		writeFileFunc = os.WriteFile
+		ctrl.Finish()
+	}()
+	path := filepath.Join(t.TempDir(), "test_data")
+	option := DefaultStoreOption()
+	// case 1: backend not set
+	kv, err := newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	assert.Error(t, kv.MoveToBackend())
+	assert.NoError(t, kv.close())
+
+	bucket, err := objstore.NewLocalBucket(t.TempDir())
+	assert.NoError(t, err)
+	option.Backend = table.NewBucketFileBackend(bucket, "test_kv")
+	kv, err = newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	f1, err := kv.CreateFamily("f", FamilyOption{Merger: mergerStr})
+	assert.NoError(t, err)
+	flusher := f1.NewFlusher()
+	_ = flusher.Add(1, []byte("test"))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+	// case 2: move family files err
+	family := NewMockFamily(ctrl)
+	kv1 := kv.(*store)
+	kv1.families["mock"] = family
+	family.EXPECT().moveToBackend(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, kv.MoveToBackend())
+	delete(kv1.families, "mock")
+	// case 3: write marker file err
+	writeFileFunc = func(name string, data []byte, perm os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, kv.MoveToBackend())
+	writeFileFunc = os.WriteFile
+	// case 4: move files successfully
+	assert.NoError(t, kv.MoveToBackend())
+	assert.True(t, fileutil.Exist(filepath.Join(path, version.Tiered)))
+	files, err := fileutil.ListDir(filepath.Join(path, "f"))
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	objects, err := bucket.List("test_kv/f")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+	assert.NoError(t, kv.close())
+
+	// case 5: reopen store, read files from backend
+	kv, err = newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	snapshot := kv.GetFamily("f").GetSnapshot()
+	readers, err := snapshot.FindReaders(1)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, err := readers[0].Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+	snapshot.Close()
+	assert.NoError(t, kv.close())
+}
+
 func TestStore_Close(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "test_data")
 	option := DefaultStoreOption()
@@ -0,0 +1,99 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"os"
+	"path"
+
+	"github.com/lindb/lindb/pkg/objstore"
+)
+
+//go:generate mockgen -source ./backend.go -destination=./backend_mock.go -package table
+
+// FileBackend represents the pluggable backend which keeps the table files moved out of local store directory,
+// e.g. the files of cold segment are moved into cheaper volume or object store, then read back lazily.
+type FileBackend interface {
+	// Put copies the local table file into backend.
+	Put(family, fileName, localPath string) error
+	// Get reads the whole table file from backend.
+	Get(family, fileName string) ([]byte, error)
+	// List returns the table file names of family in backend.
+	List(family string) ([]string, error)
+	// Delete deletes the table file from backend, it's ok if file not exist.
+	Delete(family, fileName string) error
+}
+
+// bucketFileBackend implements FileBackend interface based on object store bucket.
+type bucketFileBackend struct {
+	bucket objstore.Bucket
+	prefix string
+}
+
+// NewBucketFileBackend creates the file backend based on object store bucket,
+// the key of table file is prefix/family/file name.
+func NewBucketFileBackend(bucket objstore.Bucket, prefix string) FileBackend {
+	return &bucketFileBackend{
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+// Put copies the local table file into bucket.
+func (b *bucketFileBackend) Put(family, fileName, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return b.bucket.Put(b.key(family, fileName), f, stat.Size())
+}
+
+// Get reads the whole table file from bucket.
+func (b *bucketFileBackend) Get(family, fileName string) ([]byte, error) {
+	return b.bucket.Get(b.key(family, fileName))
+}
+
+// List returns the table file names of family in bucket.
+func (b *bucketFileBackend) List(family string) ([]string, error) {
+	keys, err := b.bucket.List(path.Join(b.prefix, family) + "/")
+	if err != nil {
+		return nil, err
+	}
+	fileNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		fileNames = append(fileNames, path.Base(key))
+	}
+	return fileNames, nil
+}
+
+// Delete deletes the table file from bucket.
+func (b *bucketFileBackend) Delete(family, fileName string) error {
+	return b.bucket.Delete(b.key(family, fileName))
+}
+
+// key returns the object key of table file.
+func (b *bucketFileBackend) key(family, fileName string) string {
+	return path.Join(b.prefix, family, fileName)
+}
@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/objstore"
+)
+
+func TestBucketFileBackend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storePath := t.TempDir()
+	bucket, err := objstore.NewLocalBucket(t.TempDir())
+	assert.NoError(t, err)
+	backend := NewBucketFileBackend(bucket, "db/shard/1")
+
+	// case 1: local file not exist
+	err = backend.Put("f", "000010.sst", filepath.Join(storePath, "f", "000010.sst"))
+	assert.Error(t, err)
+	// case 2: put into bucket err
+	mockBucket := objstore.NewMockBucket(ctrl)
+	mockBucket.EXPECT().Put("db/shard/1/f/000010.sst", gomock.Any(), int64(0)).Return(fmt.Errorf("err"))
+	assert.NoError(t, os.MkdirAll(filepath.Join(storePath, "f"), 0755))
+	emptyFile := filepath.Join(storePath, "f", "000010.sst")
+	assert.NoError(t, os.WriteFile(emptyFile, nil, 0644))
+	err = NewBucketFileBackend(mockBucket, "db/shard/1").Put("f", "000010.sst", emptyFile)
+	assert.Error(t, err)
+	assert.NoError(t, os.Remove(emptyFile))
+
+	// case 3: move table file into backend, then read it back lazily
+	builder, err := NewStoreBuilder(10, filepath.Join(storePath, "f", "000010.sst"))
+	assert.NoError(t, err)
+	_ = builder.Add(1, []byte("test"))
+	_ = builder.Add(10, []byte("test10"))
+	assert.NoError(t, builder.Close())
+	err = backend.Put("f", "000010.sst", filepath.Join(storePath, "f", "000010.sst"))
+	assert.NoError(t, err)
+	assert.NoError(t, os.Remove(filepath.Join(storePath, "f", "000010.sst")))
+
+	cache := NewCache(storePath, time.Hour, backend)
+	reader, err := cache.GetReader("f", "000010.sst")
+	assert.NoError(t, err)
+	value, err := reader.Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test10"), value)
+	assert.Equal(t, filepath.Join(storePath, "f", "000010.sst"), reader.Path())
+	cache.Evict("000010.sst")
+	fileNames, err := backend.List("f")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"000010.sst"}, fileNames)
+
+	// case 4: file not exist in backend
+	reader, err = cache.GetReader("f", "000011.sst")
+	assert.ErrorIs(t, err, objstore.ErrObjectNotFound)
+	assert.Nil(t, reader)
+
+	// case 5: delete file from backend
+	assert.NoError(t, backend.Delete("f", "000010.sst"))
+	fileNames, err = backend.List("f")
+	assert.NoError(t, err)
+	assert.Empty(t, fileNames)
+	mockBucket.EXPECT().List("db/shard/1/f/").Return(nil, fmt.Errorf("err"))
+	fileNames, err = NewBucketFileBackend(mockBucket, "db/shard/1").List("f")
+	assert.Error(t, err)
+	assert.Nil(t, fileNames)
+	assert.NoError(t, cache.Close())
+}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -34,7 +35,8 @@ import (
 
 // for test
 var (
-	newMMapStoreReaderFunc = newMMapStoreReader
+	newMMapStoreReaderFunc   = newMMapStoreReader
+	newMemoryStoreReaderFunc = newMemoryStoreReader
 )
 
 // Cache caches table readers.
@@ -55,17 +57,22 @@ type Cache interface {
 type storeCache struct {
 	ttl       time.Duration
 	storePath string
+	backend   FileBackend                    // optional, read table file from it if not exist in local
 	families  map[string]map[string]struct{} // family name => files
+	loading   map[string]*loadingCall        // file name => reader creating
 	cache     *LRUCache
 	mutex     sync.Mutex
 }
 
-// NewCache creates cache for store readers.
-func NewCache(storePath string, ttl time.Duration) Cache {
+// NewCache creates cache for store readers, if backend isn't nil,
+// the table file which not exist in local store path is read from backend lazily.
+func NewCache(storePath string, ttl time.Duration, backend FileBackend) Cache {
 	return &storeCache{
 		ttl:       ttl,
 		storePath: storePath,
+		backend:   backend,
 		families:  make(map[string]map[string]struct{}),
+		loading:   make(map[string]*loadingCall),
 		cache:     NewLRUCache(),
 	}
 }
@@ -97,43 +104,84 @@ func (c *storeCache) ReleaseReaders(readers []Reader) {
 }
 
 // GetReader returns store reader from cache, create new reader if not exist.
+// Reader is created outside the lock of cache, because reading table file from backend may be slow,
+// concurrent readers of the same file wait for the one which is creating it.
 func (c *storeCache) GetReader(family, fileName string) (Reader, error) {
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-
-	// find from cache
-	entry, ok := c.cache.Get(fileName)
-	if ok {
-		entry.retain()
-		metrics.TableCacheStatistics.Hit.Incr()
-		return entry.reader, nil
+	for {
+		// find from cache
+		entry, ok := c.cache.Get(fileName)
+		if ok {
+			entry.retain()
+			c.mutex.Unlock()
+			metrics.TableCacheStatistics.Hit.Incr()
+			return entry.reader, nil
+		}
+		call, ok := c.loading[fileName]
+		if !ok {
+			break
+		}
+		c.mutex.Unlock()
+		<-call.done
+		if call.err != nil {
+			return nil, call.err
+		}
+		// reader created by other goroutine, find it from cache again
+		c.mutex.Lock()
 	}
+	call := &loadingCall{done: make(chan struct{})}
+	c.loading[fileName] = call
+	c.mutex.Unlock()
 
 	metrics.TableCacheStatistics.Miss.Incr()
 	metrics.TableCacheStatistics.ActiveReaders.Incr()
 	// create new reader
 	path := filepath.Join(c.storePath, family, fileName)
-	newReader, err := newMMapStoreReaderFunc(path, fileName)
+	newReader, err := c.newReader(path, family, fileName)
+
+	c.mutex.Lock()
+	delete(c.loading, fileName)
+	if err == nil {
+		entry := &cacheEntry{
+			key:      fileName,
+			reader:   newReader,
+			family:   family,
+			fileName: fileName,
+		}
+		entry.retain()
+		c.cache.Add(fileName, entry)
+
+		files, ok := c.families[family]
+		if ok {
+			files[fileName] = struct{}{}
+		} else {
+			c.families[family] = map[string]struct{}{fileName: {}}
+		}
+	}
+	c.mutex.Unlock()
+
+	call.err = err
+	close(call.done)
 	if err != nil {
 		return nil, err
 	}
-	entry = &cacheEntry{
-		key:      fileName,
-		reader:   newReader,
-		family:   family,
-		fileName: fileName,
-	}
-	entry.retain()
-	c.cache.Add(fileName, entry)
+	return newReader, nil
+}
 
-	files, ok := c.families[family]
-	if ok {
-		files[fileName] = struct{}{}
-	} else {
-		c.families[family] = map[string]struct{}{fileName: {}}
+// newReader creates the reader of table file, reads the file from backend if it's moved out of local store path.
+// The whole file read from backend is kept in heap until the reader is evicted from cache,
+// so the memory cost of cold segment query is up to the total size of table files read(cleaned by ttl of cache).
+func (c *storeCache) newReader(path, family, fileName string) (Reader, error) {
+	if c.backend == nil || fileutil.Exist(path) {
+		return newMMapStoreReaderFunc(path, fileName)
 	}
-
-	return newReader, nil
+	data, err := c.backend.Get(family, fileName)
+	if err != nil {
+		metrics.TableReadStatistics.BackendReadFailures.Incr()
+		return nil, err
+	}
+	metrics.TableReadStatistics.BackendReads.Incr()
+	return newMemoryStoreReaderFunc(path, fileName, data)
 }
 
 // Cleanup cleans the expired reader from cache.
@@ -188,6 +236,12 @@ func (c *storeCache) evict(entry *cacheEntry) {
 	metrics.TableCacheStatistics.Evict.Incr()
 }
 
+// loadingCall represents the in-flight creating of file reader.
+type loadingCall struct {
+	done chan struct{}
+	err  error
+}
+
 // cacheEntry represents entry in lru cache.
 type cacheEntry struct {
 	key              string       // file name
@@ -19,6 +19,7 @@ package table
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -32,7 +33,7 @@ func TestMapCache_GetReader(t *testing.T) {
 		newMMapStoreReaderFunc = newMMapStoreReader
 		ctrl.Finish()
 	}()
-	cache := NewCache(t.TempDir(), time.Hour)
+	cache := NewCache(t.TempDir(), time.Hour, nil)
 	// case 1: get reader err
 	newMMapStoreReaderFunc = func(path, fileName string) (r Reader, err error) {
 		return nil, fmt.Errorf("err")
@@ -75,6 +76,81 @@ func TestMapCache_GetReader(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStoreCache_GetReader_Backend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newMMapStoreReaderFunc = newMMapStoreReader
+		newMemoryStoreReaderFunc = newMemoryStoreReader
+		ctrl.Finish()
+	}()
+	backend := NewMockFileBackend(ctrl)
+	cache := NewCache(t.TempDir(), time.Hour, backend)
+	// case 1: read from backend err
+	backend.EXPECT().Get("f", "100000.sst").Return(nil, fmt.Errorf("err"))
+	r, err := cache.GetReader("f", "100000.sst")
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	// case 2: data of backend is invalid
+	backend.EXPECT().Get("f", "100000.sst").Return([]byte{1, 2, 3}, nil)
+	r, err = cache.GetReader("f", "100000.sst")
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	// case 3: read from backend successfully
+	mockReader := NewMockReader(ctrl)
+	newMemoryStoreReaderFunc = func(path, fileName string, data []byte) (Reader, error) {
+		return mockReader, nil
+	}
+	backend.EXPECT().Get("f", "100000.sst").Return([]byte{1, 2, 3}, nil)
+	r, err = cache.GetReader("f", "100000.sst")
+	assert.NoError(t, err)
+	assert.Equal(t, mockReader, r)
+	mockReader.EXPECT().Close().Return(nil)
+	assert.NoError(t, cache.Close())
+}
+
+func TestStoreCache_GetReader_Backend_Concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newMMapStoreReaderFunc = newMMapStoreReader
+		newMemoryStoreReaderFunc = newMemoryStoreReader
+		ctrl.Finish()
+	}()
+	backend := NewMockFileBackend(ctrl)
+	cache := NewCache(t.TempDir(), time.Hour, backend)
+	mockReader := NewMockReader(ctrl)
+	newMemoryStoreReaderFunc = func(path, fileName string, data []byte) (Reader, error) {
+		return mockReader, nil
+	}
+	fetching := make(chan struct{})
+	fetched := make(chan struct{})
+	// backend is read once for the same file
+	backend.EXPECT().Get("f", "100000.sst").DoAndReturn(func(_, _ string) ([]byte, error) {
+		close(fetching)
+		<-fetched
+		return []byte{1, 2, 3}, nil
+	})
+	var wait sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			r, err := cache.GetReader("f", "100000.sst")
+			assert.NoError(t, err)
+			assert.Equal(t, mockReader, r)
+		}()
+	}
+	<-fetching
+	// other file isn't blocked by slow backend read
+	backend.EXPECT().Get("f", "200000.sst").Return(nil, fmt.Errorf("err"))
+	_, err := cache.GetReader("f", "200000.sst")
+	assert.Error(t, err)
+	close(fetched)
+	wait.Wait()
+
+	mockReader.EXPECT().Close().Return(nil)
+	assert.NoError(t, cache.Close())
+}
+
 func TestStoreCache_Cleanup(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -82,7 +158,7 @@ func TestStoreCache_Cleanup(t *testing.T) {
 		ctrl.Finish()
 	}()
 
-	cache := NewCache(t.TempDir(), time.Millisecond)
+	cache := NewCache(t.TempDir(), time.Millisecond, nil)
 	mockReader := NewMockReader(ctrl)
 	newMMapStoreReaderFunc = func(path, fileName string) (reader Reader, err error) {
 		return mockReader, nil
@@ -62,6 +62,7 @@ type Reader interface {
 type storeMMapReader struct {
 	path         string // path of sst-file
 	fileName     string
+	inMemory     bool                         // file content is read from file backend, not mmaped
 	fullBlock    []byte                       // mmaped file content
 	entriesBlock []byte                       // mmaped file content without footer
 	keys         *roaring.Bitmap              // bitmap of keys
@@ -107,6 +108,24 @@ func newMMapStoreReader(path, fileName string) (r Reader, err error) {
 	return reader, nil
 }
 
+// newMemoryStoreReader creates store file reader based on file content which is read from file backend.
+func newMemoryStoreReader(path, fileName string, data []byte) (Reader, error) {
+	if len(data) < sstFileFooterSize {
+		return nil, fmt.Errorf("length of sstfile:%s length is too short", path)
+	}
+	reader := &storeMMapReader{
+		path:      path,
+		fileName:  fileName,
+		inMemory:  true,
+		fullBlock: data,
+		keys:      roaring.New(),
+	}
+	if err := reader.initialize(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
 // initialize store reader, reads index block(keys,offset etc.), then caches it.
 func (r *storeMMapReader) initialize() error {
 	// decode footer
@@ -185,6 +204,10 @@ func (r *storeMMapReader) Iterator() Iterator {
 // Close store reader, release resource
 func (r *storeMMapReader) Close() error {
 	r.entriesBlock = nil
+	if r.inMemory {
+		r.fullBlock = nil
+		return nil
+	}
 	err := fileutil.Unmap(r.fullBlock)
 	if err == nil {
 		metrics.TableReadStatistics.UnMMapFailures.Incr()
@@ -154,7 +154,7 @@ func TestReader(t *testing.T) {
 	err = builder.Close()
 	assert.Nil(t, err)
 
-	cache := NewCache(testKVPath, time.Hour)
+	cache := NewCache(testKVPath, time.Hour, nil)
 
 	reader, err := cache.GetReader("", "000010.sst")
 	assert.NoError(t, err)
@@ -197,7 +197,7 @@ func TestStoreIterator(t *testing.T) {
 	err = builder.Close()
 	assert.Nil(t, err)
 
-	cache := NewCache(testKVPath, time.Hour)
+	cache := NewCache(testKVPath, time.Hour, nil)
 	reader, err := cache.GetReader("", "000010.sst")
 	assert.NoError(t, err)
 
@@ -33,6 +33,9 @@ const Lock = "LOCK"
 
 const Options = "OPTIONS"
 
+// Tiered is the marker file which represents the table files of store are moved into file backend.
+const Tiered = "TIERED"
+
 const ManifestPrefix = "MANIFEST-"
 
 // FileType represents a file type.
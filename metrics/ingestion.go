@@ -70,6 +70,28 @@ func NewNativeIngestionStatistics() *NativeIngestionStatistics {
 	}
 }
 
+// NewPrometheusIngestionStatistics creates a prometheus remote write ingestion statistics.
+func NewPrometheusIngestionStatistics() *NativeIngestionStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.prometheus")
+	return &NativeIngestionStatistics{
+		CorruptedData:   scope.NewCounter("data_corrupted"),
+		IngestedMetrics: scope.NewCounter("ingested_metrics"),
+		ReadBytes:       scope.NewCounter("read_bytes"),
+		DroppedMetrics:  scope.NewCounter("dropped_metrics"),
+	}
+}
+
+// NewOTLPIngestionStatistics creates an OpenTelemetry OTLP ingestion statistics.
+func NewOTLPIngestionStatistics() *NativeIngestionStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.otlp")
+	return &NativeIngestionStatistics{
+		CorruptedData:   scope.NewCounter("data_corrupted"),
+		IngestedMetrics: scope.NewCounter("ingested_metrics"),
+		ReadBytes:       scope.NewCounter("read_bytes"),
+		DroppedMetrics:  scope.NewCounter("dropped_metrics"),
+	}
+}
+
 // NewInfluxIngestionStatistics creates an influx ingestion statistics.
 func NewInfluxIngestionStatistics() *InfluxIngestionStatistics {
 	influxIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.influx")
@@ -57,21 +57,25 @@ var (
 	tableReadScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.read")
 	// TableReadStatistics represents table file read statistics.
 	TableReadStatistics = struct {
-		Gets           *linmetric.BoundCounter // get data by key success
-		GetFailures    *linmetric.BoundCounter // get data by key failure
-		ReadBytes      *linmetric.BoundCounter // bytes of read data
-		MMaps          *linmetric.BoundCounter // map file success
-		MMapFailures   *linmetric.BoundCounter // map file failure
-		UnMMaps        *linmetric.BoundCounter // unmap file success
-		UnMMapFailures *linmetric.BoundCounter // unmap file failures
+		Gets                *linmetric.BoundCounter // get data by key success
+		GetFailures         *linmetric.BoundCounter // get data by key failure
+		ReadBytes           *linmetric.BoundCounter // bytes of read data
+		MMaps               *linmetric.BoundCounter // map file success
+		MMapFailures        *linmetric.BoundCounter // map file failure
+		UnMMaps             *linmetric.BoundCounter // unmap file success
+		UnMMapFailures      *linmetric.BoundCounter // unmap file failures
+		BackendReads        *linmetric.BoundCounter // read file from file backend(tiered storage) success
+		BackendReadFailures *linmetric.BoundCounter // read file from file backend failure
 	}{
-		Gets:           tableReadScope.NewCounter("gets"),
-		GetFailures:    tableReadScope.NewCounter("get_failures"),
-		ReadBytes:      tableReadScope.NewCounter("read_bytes"),
-		MMaps:          tableReadScope.NewCounter("mmaps"),
-		MMapFailures:   tableReadScope.NewCounter("mmap_failures"),
-		UnMMaps:        tableReadScope.NewCounter("unmmaps"),
-		UnMMapFailures: tableReadScope.NewCounter("unmmap_failures"),
+		Gets:                tableReadScope.NewCounter("gets"),
+		GetFailures:         tableReadScope.NewCounter("get_failures"),
+		ReadBytes:           tableReadScope.NewCounter("read_bytes"),
+		MMaps:               tableReadScope.NewCounter("mmaps"),
+		MMapFailures:        tableReadScope.NewCounter("mmap_failures"),
+		UnMMaps:             tableReadScope.NewCounter("unmmaps"),
+		UnMMapFailures:      tableReadScope.NewCounter("unmmap_failures"),
+		BackendReads:        tableReadScope.NewCounter("backend_reads"),
+		BackendReadFailures: tableReadScope.NewCounter("backend_read_failures"),
 	}
 
 	// compact job
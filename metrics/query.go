@@ -38,6 +38,10 @@ type StorageQueryStatistics struct {
 	MetricQueryFailures *linmetric.BoundCounter // execute metric query failure
 	MetaQuery           *linmetric.BoundCounter // metadata query success
 	MetaQueryFailures   *linmetric.BoundCounter // metadata query failure
+	DeleteQuery         *linmetric.BoundCounter // delete series success
+	DeleteQueryFailures *linmetric.BoundCounter // delete series failure
+	SchemaQuery         *linmetric.BoundCounter // metric schema management success
+	SchemaQueryFailures *linmetric.BoundCounter // metric schema management failure
 	OmitRequest         *linmetric.BoundCounter // omit request(task no belong to current node, wrong stream etc.)
 }
 
@@ -65,6 +69,10 @@ func NewStorageQueryStatistics() *StorageQueryStatistics {
 		MetricQueryFailures: scope.NewCounter("metric_query_failures"),
 		MetaQuery:           scope.NewCounter("meta_queries"),
 		MetaQueryFailures:   scope.NewCounter("meta_query_failures"),
+		DeleteQuery:         scope.NewCounter("delete_queries"),
+		DeleteQueryFailures: scope.NewCounter("delete_query_failures"),
+		SchemaQuery:         scope.NewCounter("schema_queries"),
+		SchemaQueryFailures: scope.NewCounter("schema_query_failures"),
 		OmitRequest:         scope.NewCounter("omitted_requests"),
 	}
 }
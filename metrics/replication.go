@@ -23,6 +23,7 @@ import "github.com/lindb/lindb/internal/linmetric"
 type BrokerDatabaseWriteStatistics struct {
 	OutOfTimeRange *linmetric.BoundCounter // timestamp of metrics out of acceptable write time range
 	ShardNotFound  *linmetric.BoundCounter // shard not found count
+	RejectedRows   *linmetric.BoundCounter // rows rejected by series limits of storage
 }
 
 // BrokerFamilyWriteStatistics represents family channel write statistics.
@@ -102,6 +103,7 @@ func NewBrokerDatabaseWriteStatistics(database string) *BrokerDatabaseWriteStati
 	return &BrokerDatabaseWriteStatistics{
 		OutOfTimeRange: scope.NewCounterVec("out_of_time_range", "db").WithTagValues(database),
 		ShardNotFound:  scope.NewCounterVec("shard_not_found", "db").WithTagValues(database),
+		RejectedRows:   scope.NewCounterVec("rejected_rows", "db").WithTagValues(database),
 	}
 }
 
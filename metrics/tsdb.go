@@ -36,12 +36,14 @@ var (
 // IndexDBStatistics represents index database statistics.
 type IndexDBStatistics = struct {
 	BuildInvertedIndex *linmetric.BoundCounter // build inverted index count
+	DeleteSeries       *linmetric.BoundCounter // delete series count
 }
 
 // MemDBStatistics represents memory database statistics.
 type MemDBStatistics = struct {
 	AllocatedPages       *linmetric.BoundCounter // allocate temp memory page success
 	AllocatePageFailures *linmetric.BoundCounter // allocate temp memory page failures
+	DroppedStringValues  *linmetric.BoundCounter // drop string field value when dictionary of metric is full
 }
 
 // DatabaseStatistics represents database statistics.
@@ -71,6 +73,7 @@ type ShardStatistics struct {
 	LookupMetricMetaFailures *linmetric.BoundCounter   // lookup meta of metric failure
 	IndexDBFlushDuration     *linmetric.BoundHistogram // flush index database duration(include count)
 	IndexDBFlushFailures     *linmetric.BoundCounter   // flush index database failure
+	RejectedRows             *linmetric.BoundCounter   // rows rejected by series limits
 }
 
 // FamilyStatistics represents family statistics.
@@ -119,6 +122,8 @@ func NewShardStatistics(database, shard string) *ShardStatistics {
 			WithTagValues(database, shard),
 		IndexDBFlushDuration: shardScope.Scope("indexdb_flush_duration").NewHistogramVec("db", "shard").
 			WithTagValues(database, shard),
+		RejectedRows: shardScope.NewCounterVec("rejected_rows", "db", "shard").
+			WithTagValues(database, shard),
 	}
 }
 
@@ -157,6 +162,7 @@ func NewMemDBStatistics(database string) *MemDBStatistics {
 	return &MemDBStatistics{
 		AllocatedPages:       scope.NewCounterVec("allocated_pages", "db").WithTagValues(database),
 		AllocatePageFailures: scope.NewCounterVec("allocate_page_failures", "db").WithTagValues(database),
+		DroppedStringValues:  scope.NewCounterVec("dropped_string_values", "db").WithTagValues(database),
 	}
 }
 
@@ -165,5 +171,6 @@ func NewIndexDBStatistics(database string) *IndexDBStatistics {
 	scope := linmetric.StorageRegistry.NewScope("lindb.tsdb.indexdb")
 	return &IndexDBStatistics{
 		BuildInvertedIndex: scope.NewCounterVec("build_inverted_index", "db").WithTagValues(database),
+		DeleteSeries:       scope.NewCounterVec("delete_series", "db").WithTagValues(database),
 	}
 }
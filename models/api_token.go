@@ -0,0 +1,133 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// APITokenPrefix represents the prefix of api token, used to distinguish from jwt token.
+const APITokenPrefix = "lindb_"
+
+// for testing
+var (
+	randReadFn = rand.Read
+)
+
+// APIToken represents the long-lived token for ingestion/query clients,
+// which is scoped to a database(and namespace) with read or write permission.
+type APIToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name" binding:"required"`
+	Database   string     `json:"database" binding:"required"`
+	Namespace  string     `json:"namespace,omitempty"` // empty means all namespaces
+	Permission Permission `json:"permission" binding:"required"`
+	Secret     string     `json:"secret,omitempty"` // sha256 hash of token secret
+	CreateTime int64      `json:"createTime"`
+}
+
+// NewAPIToken creates an api token, returns the token value which only can be seen once.
+func NewAPIToken(name, database, namespace string, permission Permission) (*APIToken, string, error) {
+	if permission != ReadPermission && permission != WritePermission {
+		return nil, "", fmt.Errorf("api token only supports read/write permission, but: %s", permission)
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	token := &APIToken{
+		ID:         id,
+		Name:       name,
+		Database:   database,
+		Namespace:  namespace,
+		Permission: permission,
+		Secret:     hashSecret(secret),
+		CreateTime: timeutil.Now(),
+	}
+	return token, APITokenPrefix + id + "." + secret, nil
+}
+
+// ParseAPIToken parses the token value, returns token id and secret.
+func ParseAPIToken(value string) (id, secret string, err error) {
+	if !strings.HasPrefix(value, APITokenPrefix) {
+		return "", "", errors.New("invalid api token")
+	}
+	parts := strings.SplitN(strings.TrimPrefix(value, APITokenPrefix), ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("invalid api token")
+	}
+	return parts[0], parts[1], nil
+}
+
+// CheckSecret checks if the secret matches the secret hash of token.
+func (t *APIToken) CheckSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(t.Secret), []byte(hashSecret(secret))) == 1
+}
+
+// Grant returns the grant of token.
+func (t *APIToken) Grant() Grant {
+	return Grant{Database: t.Database, Namespace: t.Namespace, Permission: t.Permission}
+}
+
+// APITokens represents the api token list.
+type APITokens []APIToken
+
+// ToTable returns api token list as table if it has value, else return empty string.
+func (ts APITokens) ToTable() (rows int, tableStr string) {
+	if len(ts) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"ID", "Name", "Grant", "Create Time"})
+	for i := range ts {
+		t := ts[i]
+		g := t.Grant()
+		writer.AppendRow(table.Row{t.ID, t.Name, g.String(),
+			timeutil.FormatTimestamp(t.CreateTime, timeutil.DataTimeFormat2)})
+	}
+	return len(ts), writer.Render()
+}
+
+// hashSecret returns the sha256 hash of secret.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomHex returns a random hex string with n bytes.
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := randReadFn(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
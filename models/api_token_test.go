@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	defer func() {
+		randReadFn = rand.Read
+	}()
+	_, _, err := NewAPIToken("agent", "db", "", AdminPermission)
+	assert.Error(t, err)
+
+	token, value, err := NewAPIToken("agent", "db", "ns", WritePermission)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(value, APITokenPrefix))
+	id, secret, err := ParseAPIToken(value)
+	assert.NoError(t, err)
+	assert.Equal(t, token.ID, id)
+	assert.True(t, token.CheckSecret(secret))
+	assert.False(t, token.CheckSecret("secret"))
+	assert.NotContains(t, token.Secret, secret)
+	assert.Equal(t, Grant{Database: "db", Namespace: "ns", Permission: WritePermission}, token.Grant())
+
+	randReadFn = func(b []byte) (n int, err error) {
+		return 0, fmt.Errorf("err")
+	}
+	_, _, err = NewAPIToken("agent", "db", "ns", ReadPermission)
+	assert.Error(t, err)
+	count := 0
+	randReadFn = func(b []byte) (n int, err error) {
+		count++
+		if count > 1 {
+			return 0, fmt.Errorf("err")
+		}
+		return len(b), nil
+	}
+	_, _, err = NewAPIToken("agent", "db", "ns", ReadPermission)
+	assert.Error(t, err)
+}
+
+func TestParseAPIToken(t *testing.T) {
+	for _, value := range []string{"abc", "lindb_", "lindb_id", "lindb_.secret", "lindb_id."} {
+		_, _, err := ParseAPIToken(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestAPITokens_ToTable(t *testing.T) {
+	rows, rs := APITokens{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = APITokens{{ID: "id", Name: "agent", Database: "db", Permission: WritePermission}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, rs)
+}
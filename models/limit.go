@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// LimitScope represents the scope of series cardinality limit.
+type LimitScope string
+
+const (
+	// DatabaseLimitScope represents the limit of database.
+	DatabaseLimitScope LimitScope = "database"
+	// NamespaceLimitScope represents the limit of namespace.
+	NamespaceLimitScope LimitScope = "namespace"
+	// MetricLimitScope represents the limit of metric.
+	MetricLimitScope LimitScope = "metric"
+)
+
+// SeriesLimitUsage represents the current number of series in a shard against the per shard limit
+// of database/namespace/metric, limit 0 means no limit.
+type SeriesLimitUsage struct {
+	Scope LimitScope `json:"scope"`
+	Name  string     `json:"name"`
+	Limit uint32     `json:"limit"` // max series per shard
+	Usage uint32     `json:"usage"`
+}
+
+// ShardLimitUsage represents the series usage against each limit of a shard.
+type ShardLimitUsage struct {
+	ShardID ShardID            `json:"shardId"`
+	Limits  []SeriesLimitUsage `json:"limits,omitempty"`
+}
+
+// LimitUsages represents the series usage against each limit of database's shards.
+type LimitUsages []ShardLimitUsage
+
+// ToTable returns the series usage of each limit as table if it has value, else return empty string,
+// the usage and limit of each row are for one shard.
+func (ls LimitUsages) ToTable() (rows int, tableStr string) {
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Shard", "Scope", "Name", "Usage", "Limit(per shard)"})
+	for _, shard := range ls {
+		for _, limit := range shard.Limits {
+			maxSeries := "unlimited"
+			if limit.Limit > 0 {
+				maxSeries = fmt.Sprintf("%d", limit.Limit)
+			}
+			writer.AppendRow(table.Row{shard.ShardID, limit.Scope, limit.Name, limit.Usage, maxSeries})
+			rows++
+		}
+	}
+	if rows == 0 {
+		return 0, ""
+	}
+	return rows, writer.Render()
+}
@@ -43,6 +43,12 @@ func (m *Metadata) ToTable() (rows int, tableStr string) {
 		return m.toTableForStringValues(table.Row{"Tag Value"}, writer)
 	case stmt.Field.String():
 		return m.toTableForMapValues(table.Row{"Name", "Type"}, []string{"name", "type"}, writer)
+	case stmt.MetricCardinality.String():
+		return m.toTableForMapValues(table.Row{"Metric", "Series"}, []string{"name", "count"}, writer)
+	case stmt.TagKeyCardinality.String():
+		return m.toTableForMapValues(table.Row{"Tag Key", "Values"}, []string{"name", "count"}, writer)
+	case stmt.TagValueCardinality.String():
+		return m.toTableForMapValues(table.Row{"Tag Value", "Series"}, []string{"name", "count"}, writer)
 	default:
 		return 0, ""
 	}
@@ -78,3 +84,10 @@ type Field struct {
 	Name string `json:"name"`
 	Type string `json:"type"`
 }
+
+// CardinalityItem represents the cardinality of metric/tag key/tag value,
+// count is the number of series for metric/tag value, the number of distinct values for tag key.
+type CardinalityItem struct {
+	Name  string `json:"name"`
+	Count uint64 `json:"count"`
+}
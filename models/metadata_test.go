@@ -48,6 +48,14 @@ func TestMetadata_ToTable(t *testing.T) {
 	}).ToTable()
 	assert.Equal(t, rows, 1)
 	assert.NotEmpty(t, rs)
+	for _, metadataType := range []stmt.MetricMetadataType{stmt.MetricCardinality, stmt.TagKeyCardinality, stmt.TagValueCardinality} {
+		rows, rs = (&Metadata{
+			Type:   metadataType.String(),
+			Values: []interface{}{map[string]interface{}{"name": "n", "count": 10}},
+		}).ToTable()
+		assert.Equal(t, rows, 1)
+		assert.NotEmpty(t, rs)
+	}
 }
 
 func TestDatabaseNames_ToTable(t *testing.T) {
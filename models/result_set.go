@@ -32,6 +32,32 @@ type SuggestResult struct {
 	Values []string `json:"values"`
 }
 
+// ShardDeleteResult represents the number of deleted series in a shard.
+type ShardDeleteResult struct {
+	ShardID ShardID `json:"shardId"`
+	Series  int     `json:"series"`
+}
+
+// DeleteResult represents the result of delete series statement.
+type DeleteResult struct {
+	Shards        []ShardDeleteResult `json:"shards,omitempty"`
+	DeletedSeries int                 `json:"deletedSeries"`
+}
+
+// ToTable returns the deleted series of each shard as table if it has value, else return empty string.
+func (rs *DeleteResult) ToTable() (rows int, tableStr string) {
+	if len(rs.Shards) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Shard", "Deleted Series"})
+	for _, shard := range rs.Shards {
+		writer.AppendRow(table.Row{shard.ShardID, shard.Series})
+	}
+	writer.AppendFooter(table.Row{"Total", rs.DeletedSeries})
+	return len(rs.Shards), writer.Render()
+}
+
 // ResultSet represents the query result set
 type ResultSet struct {
 	MetricName string      `json:"metricName,omitempty"`
@@ -118,8 +144,28 @@ func (rs *ResultSet) ToTable() (rows int, tableStr string) {
 
 // Series represents one time series for metric
 type Series struct {
-	Tags   map[string]string            `json:"tags,omitempty"`
-	Fields map[string]map[int64]float64 `json:"fields,omitempty"`
+	Tags      map[string]string            `json:"tags,omitempty"`
+	Fields    map[string]map[int64]float64 `json:"fields,omitempty"`
+	Exemplars []*Exemplar                  `json:"exemplars,omitempty"`
+	Heatmap   *Heatmap                     `json:"heatmap,omitempty"`
+	// StringFields holds the values of selected string fields, field => timestamp => value.
+	StringFields map[string]map[int64]string `json:"stringFields,omitempty"`
+}
+
+// Heatmap represents the bucket matrix of histogram,
+// Values[i][j] is the count of observations in Buckets[i] at Timestamps[j].
+type Heatmap struct {
+	Buckets    []string    `json:"buckets"` // upper bounds of buckets, last one is +Inf
+	Timestamps []int64     `json:"timestamps"`
+	Values     [][]float64 `json:"values"`
+}
+
+// Exemplar represents a trace sample attached to the point of series.
+type Exemplar struct {
+	Timestamp int64  `json:"timestamp"`
+	TraceID   string `json:"traceId"`
+	SpanID    string `json:"spanId,omitempty"`
+	Duration  int64  `json:"duration,omitempty"`
 }
 
 // NewSeries creates a new series
@@ -139,6 +185,96 @@ func (s *Series) AddField(fieldName string, points *Points) {
 	}
 }
 
+// AddStringField adds the values of string field
+func (s *Series) AddStringField(fieldName string, values map[int64]string) {
+	if s.StringFields == nil {
+		s.StringFields = make(map[string]map[int64]string)
+	}
+	s.StringFields[fieldName] = values
+}
+
+// StringValues represents the distinct values of field with the number of occurrences in each time slot,
+// values of string field are decoded by dictionary, values of numeric field are formatted float for median/count distinct.
+type StringValues struct {
+	Field      string     `json:"field"`
+	Timestamps []int64    `json:"timestamps"`
+	Values     [][]string `json:"values"`
+	Counts     [][]uint32 `json:"counts,omitempty"` // occurrences of each value, 1 if not set
+}
+
+// StringValueSet collects the distinct values of fields with occurrences, field => timestamp => value => count.
+type StringValueSet map[string]map[int64]map[string]uint32
+
+// Add adds the value of field at timestamp.
+func (s StringValueSet) Add(fieldName string, timestamp int64, value string) {
+	s.add(fieldName, timestamp, value, 1)
+}
+
+// add adds the value of field at timestamp with the number of occurrences.
+func (s StringValueSet) add(fieldName string, timestamp int64, value string, count uint32) {
+	points, ok := s[fieldName]
+	if !ok {
+		points = make(map[int64]map[string]uint32)
+		s[fieldName] = points
+	}
+	values, ok := points[timestamp]
+	if !ok {
+		values = make(map[string]uint32)
+		points[timestamp] = values
+	}
+	values[value] += count
+}
+
+// AddAll adds the distinct values of fields with occurrences.
+func (s StringValueSet) AddAll(stringValues []*StringValues) {
+	for _, sv := range stringValues {
+		for idx, timestamp := range sv.Timestamps {
+			if idx >= len(sv.Values) {
+				break
+			}
+			for i, value := range sv.Values[idx] {
+				s.add(sv.Field, timestamp, value, sv.Count(idx, i))
+			}
+		}
+	}
+}
+
+// Values returns the distinct values of fields with occurrences, sorted by field/timestamp/value.
+func (s StringValueSet) Values() []*StringValues {
+	var rs []*StringValues
+	for fieldName, points := range s {
+		sv := &StringValues{Field: fieldName}
+		for timestamp := range points {
+			sv.Timestamps = append(sv.Timestamps, timestamp)
+		}
+		sort.Slice(sv.Timestamps, func(i, j int) bool { return sv.Timestamps[i] < sv.Timestamps[j] })
+		for _, timestamp := range sv.Timestamps {
+			values := make([]string, 0, len(points[timestamp]))
+			for value := range points[timestamp] {
+				values = append(values, value)
+			}
+			sort.Strings(values)
+			counts := make([]uint32, len(values))
+			for i, value := range values {
+				counts[i] = points[timestamp][value]
+			}
+			sv.Values = append(sv.Values, values)
+			sv.Counts = append(sv.Counts, counts)
+		}
+		rs = append(rs, sv)
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Field < rs[j].Field })
+	return rs
+}
+
+// Count returns the number of occurrences of the value at given timestamp index and value index.
+func (sv *StringValues) Count(timestampIdx, valueIdx int) uint32 {
+	if timestampIdx >= len(sv.Counts) || valueIdx >= len(sv.Counts[timestampIdx]) {
+		return 1
+	}
+	return sv.Counts[timestampIdx][valueIdx]
+}
+
 // Points represents the data points of the field
 type Points struct {
 	Points map[int64]float64 `json:"points,omitempty"`
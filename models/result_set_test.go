@@ -45,6 +45,32 @@ func TestResultSet(t *testing.T) {
 		s.Fields["f1"])
 }
 
+func TestSeries_AddStringField(t *testing.T) {
+	series := NewSeries(nil)
+	series.AddStringField("f", map[int64]string{10: "v1"})
+	assert.Equal(t, map[string]map[int64]string{"f": {10: "v1"}}, series.StringFields)
+}
+
+func TestStringValueSet(t *testing.T) {
+	valueSet := make(StringValueSet)
+	valueSet.Add("b", 20, "v2")
+	valueSet.AddAll([]*StringValues{
+		{Field: "b", Timestamps: []int64{20, 10}, Values: [][]string{{"v1"}, {"v3", "v1"}}},
+		{Field: "a", Timestamps: []int64{10, 20}, Values: [][]string{{"v1"}}},
+	})
+	assert.Equal(t, []*StringValues{
+		{Field: "a", Timestamps: []int64{10}, Values: [][]string{{"v1"}}, Counts: [][]uint32{{1}}},
+		{Field: "b", Timestamps: []int64{10, 20}, Values: [][]string{{"v1", "v3"}, {"v1", "v2"}},
+			Counts: [][]uint32{{1, 1}, {1, 1}}},
+	}, valueSet.Values())
+	// occurrences are merged
+	valueSet.AddAll([]*StringValues{
+		{Field: "a", Timestamps: []int64{10}, Values: [][]string{{"v1", "v2"}}, Counts: [][]uint32{{2, 3}}},
+	})
+	assert.Equal(t, &StringValues{Field: "a", Timestamps: []int64{10}, Values: [][]string{{"v1", "v2"}},
+		Counts: [][]uint32{{3, 3}}}, valueSet.Values()[0])
+}
+
 func TestResultSet_ToTable(t *testing.T) {
 	rows, rs := NewResultSet().ToTable()
 	assert.Zero(t, rows)
@@ -65,3 +91,15 @@ func TestResultSet_ToTable(t *testing.T) {
 	assert.Equal(t, rows, 2)
 	assert.NotEmpty(t, rs)
 }
+
+func TestDeleteResult_ToTable(t *testing.T) {
+	rows, rs := (&DeleteResult{}).ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = (&DeleteResult{
+		Shards:        []ShardDeleteResult{{ShardID: 1, Series: 2}, {ShardID: 2, Series: 3}},
+		DeletedSeries: 5,
+	}).ToTable()
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, rs)
+}
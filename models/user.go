@@ -0,0 +1,210 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// AllDatabases represents the grant applies to all databases.
+const AllDatabases = "*"
+
+// Permission represents the access permission of database.
+type Permission string
+
+// Defines all permissions, admin permission includes read and write permission.
+const (
+	ReadPermission  Permission = "read"
+	WritePermission Permission = "write"
+	AdminPermission Permission = "admin"
+)
+
+// ParsePermission returns the permission by given string value.
+func ParsePermission(permission string) (Permission, error) {
+	p := Permission(strings.ToLower(permission))
+	switch p {
+	case ReadPermission, WritePermission, AdminPermission:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown permission: %s", permission)
+	}
+}
+
+// Contains checks if the permission includes the required permission.
+func (p Permission) Contains(required Permission) bool {
+	return p == required || p == AdminPermission
+}
+
+// Grant represents the permission on database(and namespace).
+type Grant struct {
+	Database   string     `json:"database" validate:"required"` // database name, * means all databases
+	Namespace  string     `json:"namespace,omitempty"`          // empty means all namespaces
+	Permission Permission `json:"permission" validate:"required"`
+}
+
+// Allow checks if the grant allows the permission on database/namespace,
+// empty database means cluster level resource which only can be accessed by the grant of all databases.
+func (g *Grant) Allow(database, namespace string, permission Permission) bool {
+	if !g.Permission.Contains(permission) {
+		return false
+	}
+	if g.Database != AllDatabases && (database == "" || g.Database != database) {
+		return false
+	}
+	return g.Namespace == "" || g.Namespace == namespace
+}
+
+// String returns the string value of grant.
+func (g *Grant) String() string {
+	if g.Namespace == "" {
+		return fmt.Sprintf("%s on %s", g.Permission, g.Database)
+	}
+	return fmt.Sprintf("%s on %s(namespace:%s)", g.Permission, g.Database, g.Namespace)
+}
+
+// Grants represents the grant list.
+type Grants []Grant
+
+// Allow checks if any grant allows the permission on database/namespace.
+func (gs Grants) Allow(database, namespace string, permission Permission) bool {
+	for idx := range gs {
+		if gs[idx].Allow(database, namespace, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds the grant if not exist.
+func (gs Grants) Add(grant Grant) Grants {
+	for idx := range gs {
+		if gs[idx] == grant {
+			return gs
+		}
+	}
+	return append(gs, grant)
+}
+
+// Remove removes the grant.
+func (gs Grants) Remove(grant Grant) Grants {
+	var rs Grants
+	for idx := range gs {
+		if gs[idx] != grant {
+			rs = append(rs, gs[idx])
+		}
+	}
+	return rs
+}
+
+// String returns the string value of grant list.
+func (gs Grants) String() string {
+	var rs []string
+	for idx := range gs {
+		rs = append(rs, gs[idx].String())
+	}
+	return strings.Join(rs, ", ")
+}
+
+// Role represents a set of grants which can be assigned to users.
+type Role struct {
+	Name   string `json:"name" validate:"required"`
+	Grants Grants `json:"grants,omitempty"`
+}
+
+// Roles represents the role list.
+type Roles []Role
+
+// ToTable returns role list as table if it has value, else return empty string.
+func (rs Roles) ToTable() (rows int, tableStr string) {
+	if len(rs) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Grants"})
+	for i := range rs {
+		r := rs[i]
+		writer.AppendRow(table.Row{r.Name, r.Grants.String()})
+	}
+	return len(rs), writer.Render()
+}
+
+// User represents the user which can access broker api.
+type User struct {
+	Name     string   `json:"name" validate:"required"`
+	Password string   `json:"password,omitempty"` // bcrypt hash of password
+	Roles    []string `json:"roles,omitempty"`
+	Grants   Grants   `json:"grants,omitempty"`
+}
+
+// NewUser creates a user with the password hash.
+func NewUser(name, password string) (*User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	return &User{Name: name, Password: string(hash)}, nil
+}
+
+// CheckPassword checks if the password matches the password hash of user.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) == nil
+}
+
+// AddRole adds the role if not exist.
+func (u *User) AddRole(role string) {
+	for _, r := range u.Roles {
+		if r == role {
+			return
+		}
+	}
+	u.Roles = append(u.Roles, role)
+	sort.Strings(u.Roles)
+}
+
+// RemoveRole removes the role.
+func (u *User) RemoveRole(role string) {
+	var roles []string
+	for _, r := range u.Roles {
+		if r != role {
+			roles = append(roles, r)
+		}
+	}
+	u.Roles = roles
+}
+
+// Users represents the user list.
+type Users []User
+
+// ToTable returns user list as table if it has value, else return empty string.
+func (us Users) ToTable() (rows int, tableStr string) {
+	if len(us) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Roles", "Grants"})
+	for i := range us {
+		r := us[i]
+		writer.AppendRow(table.Row{r.Name, strings.Join(r.Roles, ", "), r.Grants.String()})
+	}
+	return len(us), writer.Render()
+}
@@ -0,0 +1,117 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePermission(t *testing.T) {
+	p, err := ParsePermission("READ")
+	assert.NoError(t, err)
+	assert.Equal(t, ReadPermission, p)
+	p, err = ParsePermission("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, AdminPermission, p)
+	_, err = ParsePermission("select")
+	assert.Error(t, err)
+
+	assert.True(t, AdminPermission.Contains(WritePermission))
+	assert.True(t, WritePermission.Contains(WritePermission))
+	assert.False(t, ReadPermission.Contains(WritePermission))
+}
+
+func TestGrant_Allow(t *testing.T) {
+	cases := []struct {
+		name   string
+		grant  Grant
+		db, ns string
+		perm   Permission
+		allow  bool
+	}{
+		{name: "same database", grant: Grant{Database: "db", Permission: ReadPermission},
+			db: "db", ns: "ns", perm: ReadPermission, allow: true},
+		{name: "permission not match", grant: Grant{Database: "db", Permission: ReadPermission},
+			db: "db", ns: "ns", perm: WritePermission},
+		{name: "database not match", grant: Grant{Database: "db", Permission: ReadPermission},
+			db: "db2", ns: "ns", perm: ReadPermission},
+		{name: "namespace not match", grant: Grant{Database: "db", Namespace: "ns", Permission: ReadPermission},
+			db: "db", ns: "ns2", perm: ReadPermission},
+		{name: "all databases", grant: Grant{Database: AllDatabases, Permission: AdminPermission},
+			db: "db", ns: "ns", perm: WritePermission, allow: true},
+		{name: "cluster level", grant: Grant{Database: AllDatabases, Permission: AdminPermission},
+			perm: AdminPermission, allow: true},
+		{name: "cluster level, but grant database", grant: Grant{Database: "db", Permission: AdminPermission},
+			perm: AdminPermission},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.allow, tt.grant.Allow(tt.db, tt.ns, tt.perm))
+			assert.Equal(t, tt.allow, Grants{tt.grant}.Allow(tt.db, tt.ns, tt.perm))
+		})
+	}
+}
+
+func TestGrants(t *testing.T) {
+	g1 := Grant{Database: "db", Permission: ReadPermission}
+	g2 := Grant{Database: "db", Namespace: "ns", Permission: WritePermission}
+	var grants Grants
+	grants = grants.Add(g1)
+	grants = grants.Add(g2)
+	grants = grants.Add(g1)
+	assert.Len(t, grants, 2)
+	assert.Equal(t, "read on db, write on db(namespace:ns)", grants.String())
+	grants = grants.Remove(g1)
+	assert.Equal(t, Grants{g2}, grants)
+}
+
+func TestUser(t *testing.T) {
+	user, err := NewUser("test", "test123")
+	assert.NoError(t, err)
+	assert.True(t, user.CheckPassword("test123"))
+	assert.False(t, user.CheckPassword("test"))
+
+	user.AddRole("ops")
+	user.AddRole("dev")
+	user.AddRole("ops")
+	assert.Equal(t, []string{"dev", "ops"}, user.Roles)
+	user.RemoveRole("ops")
+	assert.Equal(t, []string{"dev"}, user.Roles)
+}
+
+func TestUsers_ToTable(t *testing.T) {
+	rows, rs := Users{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = Users{{Name: "test", Roles: []string{"dev"},
+		Grants: Grants{{Database: "db", Permission: ReadPermission}}}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, rs)
+}
+
+func TestRoles_ToTable(t *testing.T) {
+	rows, rs := Roles{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = Roles{{Name: "dev", Grants: Grants{{Database: "db", Permission: ReadPermission}}}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, rs)
+}
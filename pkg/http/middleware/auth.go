@@ -18,39 +18,97 @@
 package middleware
 
 import (
-	"crypto/md5"
+	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
 
-	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+// tokenExpiration represents how long the login token is valid.
+const tokenExpiration = 24 * time.Hour
+
+// tokenSecretLength represents the length of secret key which signs the login token.
+const tokenSecretLength = 32
+
+// errTokenSecretExist represents the token secret has been stored by other broker.
+var errTokenSecretExist = errors.New("token secret exist")
+
+// for testing
+var (
+	randReadFn = rand.Read
 )
 
 // CustomClaims represents jwt custom claims param
-// need username and password and some standard claims
+// need username and some standard claims, password never be put into token.
 type CustomClaims struct {
 	jwt.StandardClaims
 	UserName string `json:"username"`
-	Password string `json:"password"`
 }
 
-// CreateToken returns token use jwt with custom claims
-func CreateToken(user config.User) (string, error) {
+// CreateToken returns token use jwt with custom claims,
+// token is signed by the secret key shared by brokers, and expires after tokenExpiration.
+func CreateToken(secret []byte, userName string) (string, error) {
+	now := time.Now()
 	claims := CustomClaims{
-		UserName: user.UserName,
-		Password: user.Password,
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(tokenExpiration).Unix(),
+		},
+		UserName: userName,
 	}
-	cid := Md5Encrypt(user)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
-	return token.SignedString([]byte(cid))
+	return token.SignedString(secret)
 }
 
-// Md5Encrypt returns secret key use Mk5 encryption with username and password
-func Md5Encrypt(user config.User) string {
-	/* #nosec */
-	md5Encrypt := md5.New()
-	key := fmt.Sprintf("%s/%s", user.UserName, user.Password)
-	_, _ = md5Encrypt.Write([]byte(key))
-	cipher := md5Encrypt.Sum(nil)
-	return string(cipher)
+// LoadTokenSecret returns the secret key which signs the login token from state repo,
+// if not exist, generates a random secret key and stores it into state repo.
+// all brokers share the same secret key, and the login tokens are still valid after broker restarting.
+func LoadTokenSecret(ctx context.Context, repo state.Repository) ([]byte, error) {
+	secret, err := repo.Get(ctx, constants.TokenSecretPath)
+	if err == nil && len(secret) > 0 {
+		return secret, nil
+	}
+	if err != nil && !errors.Is(err, state.ErrNotExist) {
+		return nil, err
+	}
+	secret, err = newTokenSecret()
+	if err != nil {
+		return nil, err
+	}
+	ok, err := repo.PutWithTX(ctx, constants.TokenSecretPath, secret, func(oldVal []byte) error {
+		if len(oldVal) > 0 {
+			return errTokenSecretExist
+		}
+		return nil
+	})
+	if err == nil && ok {
+		return secret, nil
+	}
+	if err != nil && !errors.Is(err, errTokenSecretExist) {
+		return nil, err
+	}
+	// secret key stored by other broker concurrently, use it
+	secret, err = repo.Get(ctx, constants.TokenSecretPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("token secret is empty")
+	}
+	return secret, nil
+}
+
+// newTokenSecret returns a random secret key for signing token.
+func newTokenSecret() ([]byte, error) {
+	secret := make([]byte, tokenSecretLength)
+	if _, err := randReadFn(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
 }
@@ -18,19 +18,107 @@
 package middleware
 
 import (
+	"context"
+	"crypto/rand"
+	"fmt"
 	"testing"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/state"
 )
 
-var tokenStr = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1c2VybmFtZSI6ImFkbWluIiwicGF" +
-	"zc3dvcmQiOiJhZG1pbjEyMyJ9.YbNGN0V-U5Y3xOIGNXcgbQkK2VV30UDDEZV19FN62hk"
+var testSecret = []byte("test-secret")
 
 func Test_CreateToken(t *testing.T) {
-	user := config.User{UserName: "admin", Password: "admin123"}
-	token, err := CreateToken(user)
-	assert.Equal(t, true, err == nil)
-	assert.Equal(t, tokenStr, token)
+	token, err := CreateToken(testSecret, "admin")
+	assert.NoError(t, err)
+	claims, err := parseToken(testSecret, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", claims.UserName)
+	assert.True(t, claims.ExpiresAt > time.Now().Unix())
+	assert.True(t, claims.ExpiresAt <= time.Now().Add(tokenExpiration).Unix())
+	// signed by other secret key
+	_, err = parseToken([]byte("other-secret"), token)
+	assert.Error(t, err)
+}
+
+func TestLoadTokenSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		randReadFn = rand.Read
+		ctrl.Finish()
+	}()
+
+	repo := state.NewMockRepository(ctrl)
+	ctx := context.TODO()
+	// get secret failure
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, fmt.Errorf("err"))
+	secret, err := LoadTokenSecret(ctx, repo)
+	assert.Error(t, err)
+	assert.Nil(t, secret)
+	// secret exist
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(testSecret, nil)
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.NoError(t, err)
+	assert.Equal(t, testSecret, secret)
+	// generate secret failure
+	randReadFn = func(b []byte) (n int, err error) {
+		return 0, fmt.Errorf("err")
+	}
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, state.ErrNotExist)
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.Error(t, err)
+	assert.Nil(t, secret)
+	randReadFn = rand.Read
+	// store secret failure
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, state.ErrNotExist)
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.TokenSecretPath, gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("err"))
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.Error(t, err)
+	assert.Nil(t, secret)
+	// store secret successfully
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, state.ErrNotExist)
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.TokenSecretPath, gomock.Any(), gomock.Any()).Return(true, nil)
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.NoError(t, err)
+	assert.Len(t, secret, tokenSecretLength)
+	// secret stored by other broker concurrently
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, state.ErrNotExist)
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.TokenSecretPath, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []byte, check func(oldVal []byte) error) (bool, error) {
+			return false, check(testSecret)
+		})
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(testSecret, nil)
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.NoError(t, err)
+	assert.Equal(t, testSecret, secret)
+	// get stored secret failure
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, state.ErrNotExist)
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.TokenSecretPath, gomock.Any(), gomock.Any()).Return(false, nil)
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, fmt.Errorf("err"))
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.Error(t, err)
+	assert.Nil(t, secret)
+	// stored secret is empty
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, state.ErrNotExist)
+	repo.EXPECT().PutWithTX(gomock.Any(), constants.TokenSecretPath, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []byte, check func(oldVal []byte) error) (bool, error) {
+			return false, check(nil)
+		})
+	repo.EXPECT().Get(gomock.Any(), constants.TokenSecretPath).Return(nil, nil)
+	secret, err = LoadTokenSecret(ctx, repo)
+	assert.Error(t, err)
+	assert.Nil(t, secret)
+}
+
+// signToken returns token with given claims signed by secret key.
+func signToken(t *testing.T, claims *CustomClaims, secret []byte) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+	assert.NoError(t, err)
+	return token
 }
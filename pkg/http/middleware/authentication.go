@@ -18,63 +18,143 @@
 package middleware
 
 import (
-	"errors"
 	"net/http"
+	"strings"
 
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
 
 	"github.com/lindb/lindb/config"
-	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 )
 
 //go:generate mockgen -source=./authentication.go -destination=./authentication_mock.go -package=middleware
 
+// principalKey represents the key of authenticated principal in gin context.
+const principalKey = "lindb.principal"
+
+// UserProvider represents the provider of users/roles/api tokens which stored in state repo.
+type UserProvider interface {
+	// GetUser returns the user by name.
+	GetUser(name string) (models.User, bool)
+	// GetRole returns the role by name.
+	GetRole(name string) (models.Role, bool)
+	// GetAPIToken returns the api token by id.
+	GetAPIToken(id string) (models.APIToken, bool)
+}
+
+// Authentication represents authentication param
 type Authentication interface {
-	// Validate validates the token
-	Validate(next http.Handler) http.Handler
+	// Validate validates the token, then puts the principal into context.
+	Validate() gin.HandlerFunc
 }
 
 // userAuthentication represents user authentication using jwt
 type userAuthentication struct {
-	user config.User
+	admin    config.User
+	secret   []byte
+	provider UserProvider
 }
 
-// NewAuthentication creates authentication api instance
-func NewAuthentication(user config.User) Authentication {
+// NewAuthentication creates authentication api instance, secret is the key which signs the login token.
+func NewAuthentication(admin config.User, secret []byte, provider UserProvider) Authentication {
 	return &userAuthentication{
-		user: user,
+		admin:    admin,
+		secret:   secret,
+		provider: provider,
 	}
 }
 
-// Validate creates middleware for user permissions validation by request header Authorization
-// if not authorization throw error
-// else perform the next action
-func (u *userAuthentication) Validate(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := r.Header.Get("Authorization")
+// Validate returns middleware which validates the token from request header,
+// token can be jwt token(login) or api token(ingestion/query clients) with Bearer/Token scheme.
+// if token is valid, puts the principal into context, else responses 401.
+func (u *userAuthentication) Validate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader("Authorization")
+		token = strings.TrimPrefix(token, "Bearer ")
+		token = strings.TrimSpace(strings.TrimPrefix(token, "Token "))
 		if len(token) > 0 {
-			claims := parseToken(token, u.user)
-			if claims.UserName == u.user.UserName && claims.Password == u.user.Password {
-				next.ServeHTTP(w, r)
+			if principal, ok := u.authenticate(token); ok {
+				c.Set(principalKey, principal)
+				c.Next()
 				return
 			}
 		}
-		err := errors.New("authorization token invalid")
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(http.StatusUnauthorized)
-		b := encoding.JSONMarshal(err.Error())
-		_, _ = w.Write(b)
-	})
+		c.AbortWithStatusJSON(http.StatusUnauthorized, constants.ErrUnauthorized.Error())
+	}
 }
 
-// ParseToken returns jwt claims by token
-// get secret key use Md5Encrypt method with username and password
-// then jwt parse token by secret key
-func parseToken(tokenString string, user config.User) *CustomClaims {
+// authenticate returns the principal if token is valid.
+func (u *userAuthentication) authenticate(token string) (*Principal, bool) {
+	if strings.HasPrefix(token, models.APITokenPrefix) {
+		return u.getAPITokenPrincipal(token)
+	}
+	claims, err := parseToken(u.secret, token)
+	if err != nil {
+		return nil, false
+	}
+	return u.getPrincipal(claims.UserName)
+}
+
+// getAPITokenPrincipal returns the principal of api token, which only has the grant of token.
+func (u *userAuthentication) getAPITokenPrincipal(value string) (*Principal, bool) {
+	if u.provider == nil {
+		return nil, false
+	}
+	id, secret, err := models.ParseAPIToken(value)
+	if err != nil {
+		return nil, false
+	}
+	token, ok := u.provider.GetAPIToken(id)
+	if !ok || !token.CheckSecret(secret) {
+		return nil, false
+	}
+	return &Principal{
+		Name:   "token:" + token.Name,
+		Grants: models.Grants{token.Grant()},
+	}, true
+}
+
+// getPrincipal returns the principal by user name, includes grants of user and user's roles.
+func (u *userAuthentication) getPrincipal(userName string) (*Principal, bool) {
+	if userName == "" {
+		return nil, false
+	}
+	if userName == u.admin.UserName {
+		return &Principal{Name: userName, SuperUser: true}, true
+	}
+	if u.provider == nil {
+		return nil, false
+	}
+	user, ok := u.provider.GetUser(userName)
+	if !ok {
+		return nil, false
+	}
+	principal := &Principal{Name: userName}
+	principal.Grants = append(principal.Grants, user.Grants...)
+	for _, roleName := range user.Roles {
+		if role, ok := u.provider.GetRole(roleName); ok {
+			principal.Grants = append(principal.Grants, role.Grants...)
+		}
+	}
+	return principal, true
+}
+
+// parseToken returns custom claims if token is valid, token without expiration is invalid.
+func parseToken(secret []byte, tokenString string) (*CustomClaims, error) {
 	claims := CustomClaims{}
-	cid := Md5Encrypt(user)
-	_, _ = jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
-		return cid, nil
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, constants.ErrUnauthorized
+		}
+		return secret, nil
 	})
-	return &claims
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid || claims.ExpiresAt == 0 {
+		return nil, constants.ErrUnauthorized
+	}
+	return &claims, nil
 }
@@ -18,54 +18,176 @@
 package middleware
 
 import (
-	"bytes"
-	"context"
-	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
 )
 
 func Test_ParseToken(t *testing.T) {
-	user := config.User{UserName: "admin", Password: "admin123"}
-	claim := parseToken(tokenStr, user)
-	assert.Equal(t, user.UserName, claim.UserName)
-	assert.Equal(t, user.Password, claim.Password)
+	tokenStr, err := CreateToken(testSecret, "admin")
+	assert.NoError(t, err)
+	claim, err := parseToken(testSecret, tokenStr)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", claim.UserName)
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	// token signed by other secret
+	_, err = parseToken(testSecret, signToken(t, &CustomClaims{
+		StandardClaims: jwt.StandardClaims{ExpiresAt: expiresAt},
+		UserName:       "admin",
+	}, []byte("admin")))
+	assert.Error(t, err)
+	// token expired
+	_, err = parseToken(testSecret, signToken(t, &CustomClaims{
+		StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(-time.Minute).Unix()},
+		UserName:       "admin",
+	}, testSecret))
+	assert.Error(t, err)
+	// token without expiration
+	_, err = parseToken(testSecret, signToken(t, &CustomClaims{UserName: "admin"}, testSecret))
+	assert.Error(t, err)
+	// bad token
+	_, err = parseToken(testSecret, "abc")
+	assert.Error(t, err)
 }
 
 func TestUserAuthentication_Validate(t *testing.T) {
-	req, err := http.NewRequestWithContext(context.TODO(), "GET", "/health-check", bytes.NewReader([]byte("test")))
-	if err != nil {
-		t.Fatal(err)
-	}
-	req.Header.Set("Authorization", "Bearer abc123")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	rr := httptest.NewRecorder()
-	user := config.User{UserName: "admin", Password: "admin123"}
-	auth := NewAuthentication(user)
+	admin := config.User{UserName: "admin", Password: "admin123"}
+	provider := NewMockUserProvider(ctrl)
+	auth := NewAuthentication(admin, testSecret, provider)
 
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Header().Set("Content-Type", "application/json")
-		_, _ = io.WriteString(w, "ok")
+	r := gin.New()
+	r.Use(auth.Validate())
+	r.GET("/health-check", func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		assert.True(t, ok)
+		c.JSON(http.StatusOK, principal)
 	})
-	authHandler := auth.Validate(handler)
+	doRequest := func(token string) int {
+		req, err := http.NewRequest(http.MethodGet, "/health-check", nil)
+		assert.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp.Code
+	}
+	// no token
+	assert.Equal(t, http.StatusUnauthorized, doRequest(""))
+	// bad token
+	assert.Equal(t, http.StatusUnauthorized, doRequest("Bearer abc123"))
+	// admin token
+	tokenStr, err := CreateToken(testSecret, "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, doRequest(tokenStr))
+	assert.Equal(t, http.StatusOK, doRequest("Bearer "+tokenStr))
+	// user not exist
+	token, err := CreateToken(testSecret, "test")
+	assert.NoError(t, err)
+	provider.EXPECT().GetUser("test").Return(models.User{}, false)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(token))
+	// user exist
+	provider.EXPECT().GetUser("test").Return(models.User{Name: "test", Roles: []string{"dev", "ops"}}, true)
+	provider.EXPECT().GetRole("dev").Return(models.Role{Name: "dev"}, true)
+	provider.EXPECT().GetRole("ops").Return(models.Role{}, false)
+	assert.Equal(t, http.StatusOK, doRequest(token))
+	// empty user name
+	token, err = CreateToken(testSecret, "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(token))
+	// no user provider
+	auth = NewAuthentication(admin, testSecret, nil)
+	r = gin.New()
+	r.Use(auth.Validate())
+	r.GET("/health-check", func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+	token, err = CreateToken(testSecret, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(token))
+}
+
+func TestUserAuthentication_getPrincipal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	provider := NewMockUserProvider(ctrl)
+	auth := NewAuthentication(config.User{UserName: "admin"}, testSecret, provider).(*userAuthentication)
+	provider.EXPECT().GetUser("test").Return(models.User{
+		Name:   "test",
+		Roles:  []string{"dev"},
+		Grants: models.Grants{{Database: "db1", Permission: models.WritePermission}},
+	}, true)
+	provider.EXPECT().GetRole("dev").Return(models.Role{
+		Name:   "dev",
+		Grants: models.Grants{{Database: "db2", Permission: models.ReadPermission}},
+	}, true)
+	principal, ok := auth.getPrincipal("test")
+	assert.True(t, ok)
+	assert.False(t, principal.SuperUser)
+	assert.True(t, principal.Allow("db1", "ns", models.WritePermission))
+	assert.True(t, principal.Allow("db2", "ns", models.ReadPermission))
+	assert.False(t, principal.Allow("db2", "ns", models.WritePermission))
+
+	principal, ok = auth.getPrincipal("admin")
+	assert.True(t, ok)
+	assert.True(t, principal.SuperUser)
+}
 
-	authHandler.ServeHTTP(rr, req)
+func TestUserAuthentication_APIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	admin := config.User{UserName: "admin", Password: "admin123"}
+	provider := NewMockUserProvider(ctrl)
+	auth := NewAuthentication(admin, testSecret, provider)
 
-	req, err = http.NewRequestWithContext(context.TODO(), "GET", "/health-check", bytes.NewReader([]byte("test")))
+	r := gin.New()
+	r.Use(auth.Validate())
+	r.PUT("/write", RequirePermission(models.WritePermission), func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+	doRequest := func(path, token string) int {
+		req, err := http.NewRequest(http.MethodPut, path, nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", token)
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp.Code
+	}
+	token, value, err := models.NewAPIToken("agent", "db", "", models.WritePermission)
 	assert.NoError(t, err)
-	req.Header.Set("Authorization", tokenStr)
-	rr = httptest.NewRecorder()
 
-	authHandler.ServeHTTP(rr, req)
+	// bad token format
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/write?db=db", "Token lindb_abc"))
+	// token not exist(revoked)
+	provider.EXPECT().GetAPIToken(token.ID).Return(models.APIToken{}, false)
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/write?db=db", "Token "+value))
+	// secret not match
+	provider.EXPECT().GetAPIToken(token.ID).Return(*token, true)
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/write?db=db", "Token "+value+"x"))
+
+	provider.EXPECT().GetAPIToken(token.ID).Return(*token, true).AnyTimes()
+	// write ok
+	assert.Equal(t, http.StatusOK, doRequest("/write?db=db", "Token "+value))
+	assert.Equal(t, http.StatusOK, doRequest("/write?db=db&ns=ns", "Bearer "+value))
+	// other database
+	assert.Equal(t, http.StatusForbidden, doRequest("/write?db=db2", "Token "+value))
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-	assert.Equal(t, "ok", rr.Body.String())
+	// no user provider
+	auth = NewAuthentication(admin, testSecret, nil)
+	_, ok := auth.(*userAuthentication).authenticate(value)
+	assert.False(t, ok)
 }
@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+// Principal represents the authenticated user with all grants.
+type Principal struct {
+	Name      string
+	SuperUser bool // admin user configured in broker, has all permissions
+	Grants    models.Grants
+}
+
+// Allow checks if principal has the permission on database/namespace.
+func (p *Principal) Allow(database, namespace string, permission models.Permission) bool {
+	return p.SuperUser || p.Grants.Allow(database, namespace, permission)
+}
+
+// GetPrincipal returns the authenticated principal from context.
+func GetPrincipal(c *gin.Context) (*Principal, bool) {
+	val, ok := c.Get(principalKey)
+	if !ok {
+		return nil, false
+	}
+	principal, ok := val.(*Principal)
+	return principal, ok
+}
+
+// Authorize checks if current principal has the permission on database/namespace,
+// empty database means cluster level resource.
+// If no principal in context(authentication disabled), allows all requests.
+func Authorize(c *gin.Context, database, namespace string, permission models.Permission) error {
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		return nil
+	}
+	if principal.Allow(database, namespace, permission) {
+		return nil
+	}
+	return constants.ErrForbidden
+}
+
+// RequirePermission returns middleware which checks the permission on database/namespace,
+// database/namespace get from request params(db/ns).
+func RequirePermission(permission models.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		database := c.Query("db")
+		if database == "" {
+			database = c.PostForm("db")
+		}
+		namespace := c.Query("ns")
+		if namespace == "" {
+			namespace = c.PostForm("ns")
+		}
+		if namespace == "" {
+			namespace = constants.DefaultNamespace
+		}
+		if err := Authorize(c, database, namespace, permission); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireClusterAdmin returns middleware which checks the admin permission of cluster level resource.
+func RequireClusterAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := Authorize(c, "", "", models.AdminPermission); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+)
+
+func TestAuthorize(t *testing.T) {
+	c, _ := gin.CreateTestContext(nil)
+	// authentication disabled
+	assert.NoError(t, Authorize(c, "db", "ns", models.AdminPermission))
+	// super user
+	c.Set(principalKey, &Principal{Name: "admin", SuperUser: true})
+	assert.NoError(t, Authorize(c, "", "", models.AdminPermission))
+	// normal user
+	c.Set(principalKey, &Principal{Name: "test", Grants: models.Grants{
+		{Database: "db", Namespace: "ns", Permission: models.WritePermission},
+	}})
+	assert.NoError(t, Authorize(c, "db", "ns", models.WritePermission))
+	assert.Equal(t, constants.ErrForbidden, Authorize(c, "db", "ns", models.ReadPermission))
+	assert.Equal(t, constants.ErrForbidden, Authorize(c, "db", "ns2", models.WritePermission))
+	assert.Equal(t, constants.ErrForbidden, Authorize(c, "", "", models.AdminPermission))
+}
+
+func TestRequirePermission(t *testing.T) {
+	principal := &Principal{Name: "test", Grants: models.Grants{
+		{Database: "db", Namespace: constants.DefaultNamespace, Permission: models.WritePermission},
+		{Database: "db2", Permission: models.AdminPermission},
+	}}
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(principalKey, principal)
+	})
+	ok := func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	}
+	r.PUT("/write", RequirePermission(models.WritePermission), ok)
+	r.GET("/admin", RequireClusterAdmin(), ok)
+
+	resp := mock.DoRequest(t, r, http.MethodPut, "/write?db=db", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, "/write?db=db&ns=ns", "")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, "/write?db=db2&ns=ns", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, "/write", "")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodGet, "/admin", "")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+
+	principal.SuperUser = true
+	resp = mock.DoRequest(t, r, http.MethodGet, "/admin", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -40,6 +40,12 @@ func NotFound(c *gin.Context) {
 	response(c, http.StatusNotFound, nil)
 }
 
+// Forbidden responses error message and set the http status code 403.
+func Forbidden(c *gin.Context, err error) {
+	_ = c.Error(err)
+	response(c, http.StatusForbidden, err.Error())
+}
+
 // Error responses error message and set the http status code 500.
 func Error(c *gin.Context, err error) {
 	_ = c.Error(err)
@@ -0,0 +1,131 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package objstore
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+// for testing
+var (
+	mkDirIfNotExistFunc = fileutil.MkDirIfNotExist
+	renameFunc          = os.Rename
+)
+
+const tmpSuffix = ".tmp"
+
+// localBucket implements Bucket interface, keeps objects as files under the directory,
+// the directory is always mounted by other cheaper volume.
+type localBucket struct {
+	dir string
+}
+
+// NewLocalBucket creates the bucket which keeps objects under given directory.
+func NewLocalBucket(dir string) (Bucket, error) {
+	if err := mkDirIfNotExistFunc(dir); err != nil {
+		return nil, err
+	}
+	return &localBucket{dir: dir}, nil
+}
+
+// Put writes the object into temp file, then renames it, so reader cannot see the partial object.
+func (b *localBucket) Put(key string, r io.Reader, _ int64) (err error) {
+	filePath := b.filePath(key)
+	if err = mkDirIfNotExistFunc(filepath.Dir(filePath)); err != nil {
+		return err
+	}
+	tmpPath := filePath + tmpSuffix
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return renameFunc(tmpPath, filePath)
+}
+
+// Get returns the whole content of object by key.
+func (b *localBucket) Get(key string) ([]byte, error) {
+	data, err := os.ReadFile(b.filePath(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	return data, err
+}
+
+// Delete deletes the object by key.
+func (b *localBucket) Delete(key string) error {
+	err := os.Remove(b.filePath(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// List returns the keys of objects which start with given prefix.
+func (b *localBucket) List(prefix string) ([]string, error) {
+	root := b.filePath(path.Dir(prefix))
+	var keys []string
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(filePath, tmpSuffix) {
+			return nil
+		}
+		rel, err := filepath.Rel(b.dir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// filePath returns the file path of object.
+func (b *localBucket) filePath(key string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(key))
+}
@@ -0,0 +1,98 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package objstore
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestNewBucket(t *testing.T) {
+	b, err := NewBucket(&option.Tiered{Backend: option.TieredBackendLocal, Dir: t.TempDir()})
+	assert.NoError(t, err)
+	assert.IsType(t, &localBucket{}, b)
+	b, err = NewBucket(&option.Tiered{Backend: option.TieredBackendS3, S3: option.S3{Endpoint: "http://s3", Bucket: "lindb"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &s3Bucket{}, b)
+	b, err = NewBucket(&option.Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay), Backend: "hdfs"})
+	assert.Error(t, err)
+	assert.Nil(t, b)
+}
+
+func TestLocalBucket(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewLocalBucket(dir)
+	assert.NoError(t, err)
+
+	assert.NoError(t, b.Put("db/1/000001.sst", bytes.NewReader([]byte("data1")), 5))
+	assert.NoError(t, b.Put("db/1/000002.sst", bytes.NewReader([]byte("data2")), 5))
+	assert.NoError(t, b.Put("db/2/000001.sst", bytes.NewReader([]byte("data3")), 5))
+	assert.False(t, fileutil.Exist(filepath.Join(dir, "db", "1", "000001.sst"+tmpSuffix)))
+
+	data, err := b.Get("db/1/000002.sst")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data2"), data)
+	_, err = b.Get("db/1/000003.sst")
+	assert.Equal(t, ErrObjectNotFound, err)
+
+	keys, err := b.List("db/1/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db/1/000001.sst", "db/1/000002.sst"}, keys)
+	keys, err = b.List("db/3/")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	assert.NoError(t, b.Delete("db/1/000001.sst"))
+	assert.NoError(t, b.Delete("db/1/000001.sst"))
+	keys, err = b.List("db/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db/1/000002.sst", "db/2/000001.sst"}, keys)
+}
+
+func TestLocalBucket_Failure(t *testing.T) {
+	defer func() {
+		mkDirIfNotExistFunc = fileutil.MkDirIfNotExist
+		renameFunc = os.Rename
+	}()
+	dir := t.TempDir()
+	mkDirIfNotExistFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	b, err := NewLocalBucket(dir)
+	assert.Error(t, err)
+	assert.Nil(t, b)
+	b = &localBucket{dir: dir}
+	assert.Error(t, b.Put("db/1/000001.sst", bytes.NewReader([]byte("data1")), 5))
+
+	mkDirIfNotExistFunc = fileutil.MkDirIfNotExist
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, b.Put("db/1/000001.sst", bytes.NewReader([]byte("data1")), 5))
+	// temp file removed after failure
+	assert.False(t, fileutil.Exist(filepath.Join(dir, "db", "1", "000001.sst"+tmpSuffix)))
+}
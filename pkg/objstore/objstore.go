@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package objstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lindb/lindb/pkg/option"
+)
+
+//go:generate mockgen -source=./objstore.go -destination=./objstore_mock.go -package=objstore
+
+// ErrObjectNotFound represents the object not exist in bucket.
+var ErrObjectNotFound = errors.New("object not found")
+
+// Bucket represents the object store which keeps the files of cold data(tiered storage).
+type Bucket interface {
+	// Put uploads the object with key, the content is read from reader.
+	Put(key string, r io.Reader, size int64) error
+	// Get returns the whole content of object by key, returns ErrObjectNotFound if not exist.
+	Get(key string) ([]byte, error)
+	// Delete deletes the object by key, it's ok if object not exist.
+	Delete(key string) error
+	// List returns the keys of objects which start with given prefix.
+	List(prefix string) ([]string, error)
+}
+
+// NewBucket creates the bucket based on backend of tiered storage policy.
+func NewBucket(tiered *option.Tiered) (Bucket, error) {
+	switch tiered.Backend {
+	case option.TieredBackendLocal:
+		return NewLocalBucket(tiered.Dir)
+	case option.TieredBackendS3:
+		return NewS3Bucket(tiered.S3), nil
+	default:
+		return nil, fmt.Errorf("tiered storage backend %s not support", tiered.Backend)
+	}
+}
@@ -0,0 +1,318 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package objstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lindb/lindb/pkg/option"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	s3Service       = "s3"
+	signAlgorithm   = "AWS4-HMAC-SHA256"
+	amzDateFormat   = "20060102T150405Z"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// sha256 of empty payload
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// defaultS3Timeout is the timeout of request if not set, avoids blocking by stalled request forever.
+	defaultS3Timeout = 30 * time.Second
+)
+
+// for testing
+var (
+	nowFunc = time.Now
+)
+
+// s3Bucket implements Bucket interface based on S3-compatible object store(e.g. AWS S3/MinIO),
+// uses path-style request and signs request with signature version 4.
+type s3Bucket struct {
+	cfg    option.S3
+	client *http.Client
+}
+
+// NewS3Bucket creates the bucket based on S3-compatible object store.
+func NewS3Bucket(cfg option.S3) Bucket {
+	if cfg.Region == "" {
+		cfg.Region = defaultS3Region
+	}
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+	timeout := defaultS3Timeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout.Int64()) * time.Millisecond
+	}
+	return &s3Bucket{
+		cfg:    cfg,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Put uploads the object with key, payload isn't signed for streaming upload.
+func (b *s3Bucket) Put(key string, r io.Reader, size int64) error {
+	req, err := http.NewRequest(http.MethodPut, b.objectURL(key), r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	resp, err := b.do(req, unsignedPayload)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return responseError(req, resp)
+	}
+	return nil
+}
+
+// Get returns the whole content of object by key.
+func (b *s3Bucket) Get(key string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, b.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrObjectNotFound
+	default:
+		return nil, responseError(req, resp)
+	}
+}
+
+// Delete deletes the object by key.
+func (b *s3Bucket) Delete(key string) error {
+	req, err := http.NewRequest(http.MethodDelete, b.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := b.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return responseError(req, resp)
+	}
+}
+
+// listBucketResult represents the response of list objects(v2).
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+}
+
+// List returns the keys of objects which start with given prefix, lists all pages.
+func (b *s3Bucket) List(prefix string) ([]string, error) {
+	var keys []string
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", b.objectKey(prefix))
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		req, err := http.NewRequest(http.MethodGet, b.cfg.Endpoint+"/"+b.cfg.Bucket+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		result, err := b.list(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, content := range result.Contents {
+			keys = append(keys, strings.TrimPrefix(content.Key, b.objectKey("")))
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+// list does one page of list objects request.
+func (b *s3Bucket) list(req *http.Request) (*listBucketResult, error) {
+	resp, err := b.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(req, resp)
+	}
+	result := &listBucketResult{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// do signs the request if credentials set, then sends it.
+func (b *s3Bucket) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	if b.cfg.AccessKeyID != "" {
+		signRequest(req, b.cfg.AccessKeyID, b.cfg.SecretAccessKey, b.cfg.Region, payloadHash, nowFunc().UTC())
+	}
+	return b.client.Do(req)
+}
+
+// objectKey returns the object key with key prefix of config.
+func (b *s3Bucket) objectKey(key string) string {
+	if b.cfg.Prefix == "" {
+		return key
+	}
+	return path.Join(b.cfg.Prefix, key) + suffixSlash(key)
+}
+
+// objectURL returns the path-style url of object.
+func (b *s3Bucket) objectURL(key string) string {
+	return b.cfg.Endpoint + "/" + b.cfg.Bucket + "/" + uriEncode(b.objectKey(key), false)
+}
+
+// suffixSlash keeps the trailing slash which is removed by path.Join.
+func suffixSlash(key string) string {
+	if key == "" || strings.HasSuffix(key, "/") {
+		return "/"
+	}
+	return ""
+}
+
+// signRequest signs the request with signature version 4, signs host and all headers of request.
+func signRequest(req *http.Request, accessKeyID, secretAccessKey, region, payloadHash string, now time.Time) {
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	// canonical headers
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{signAlgorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+	signingKey := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signAlgorithm, accessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the sorted, uri encoded query string.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode encodes the string except unreserved characters, slash is kept if encodeSlash is false.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9'),
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// responseError returns the error with status and body of failure response.
+func responseError(req *http.Request, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 request %s %s failure, status: %d, body: %s", req.Method, req.URL.Path, resp.StatusCode, body)
+}
+
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
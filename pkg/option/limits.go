@@ -0,0 +1,111 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package option
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lindb/lindb/constants"
+)
+
+// Limits represents the series cardinality limits of database, zero value means no limit.
+// NOTE: series are partitioned by shard, all limits are applied to each shard of database separately,
+// so the max series of whole database is about limit * number of shards.
+type Limits struct {
+	MaxSeriesPerShard uint32           `toml:"maxSeriesPerShard" json:"maxSeriesPerShard,omitempty"` // max series of database per shard
+	Namespaces        []NamespaceLimit `toml:"namespaces" json:"namespaces,omitempty"`               // max series of namespace per shard
+	Metrics           []MetricLimit    `toml:"metrics" json:"metrics,omitempty"`                     // max series of metric per shard
+}
+
+// NamespaceLimit represents the series cardinality limit of namespace in each shard.
+type NamespaceLimit struct {
+	Namespace         string `toml:"namespace" json:"namespace"`
+	MaxSeriesPerShard uint32 `toml:"maxSeriesPerShard" json:"maxSeriesPerShard"`
+}
+
+// MetricLimit represents the series cardinality limit of metric in each shard,
+// if namespace is empty, uses default namespace.
+type MetricLimit struct {
+	Namespace         string `toml:"namespace" json:"namespace,omitempty"`
+	Metric            string `toml:"metric" json:"metric"`
+	MaxSeriesPerShard uint32 `toml:"maxSeriesPerShard" json:"maxSeriesPerShard"`
+}
+
+// GetNamespaceLimit returns the max series of namespace per shard, returns 0 if not limit.
+func (l *Limits) GetNamespaceLimit(namespace string) uint32 {
+	if l == nil {
+		return 0
+	}
+	for _, limit := range l.Namespaces {
+		if limit.Namespace == namespace {
+			return limit.MaxSeriesPerShard
+		}
+	}
+	return 0
+}
+
+// GetMetricLimit returns the max series of metric per shard, returns 0 if not limit.
+func (l *Limits) GetMetricLimit(namespace, metricName string) uint32 {
+	if l == nil {
+		return 0
+	}
+	for _, limit := range l.Metrics {
+		if limit.GetNamespace() == namespace && limit.Metric == metricName {
+			return limit.MaxSeriesPerShard
+		}
+	}
+	return 0
+}
+
+// GetNamespace returns the namespace of metric limit, if empty returns default namespace.
+func (l MetricLimit) GetNamespace() string {
+	if l.Namespace == "" {
+		return constants.DefaultNamespace
+	}
+	return l.Namespace
+}
+
+// Validate checks if limits are valid.
+func (l *Limits) Validate() error {
+	if l == nil {
+		return nil
+	}
+	namespaces := make(map[string]struct{})
+	for _, limit := range l.Namespaces {
+		if limit.Namespace == "" {
+			return errors.New("namespace of limit cannot be empty")
+		}
+		if _, ok := namespaces[limit.Namespace]; ok {
+			return fmt.Errorf("duplicate limit for namespace: %s", limit.Namespace)
+		}
+		namespaces[limit.Namespace] = struct{}{}
+	}
+	metrics := make(map[string]struct{})
+	for _, limit := range l.Metrics {
+		if limit.Metric == "" {
+			return errors.New("metric name of limit cannot be empty")
+		}
+		key := limit.GetNamespace() + "/" + limit.Metric
+		if _, ok := metrics[key]; ok {
+			return fmt.Errorf("duplicate limit for metric: %s", key)
+		}
+		metrics[key] = struct{}{}
+	}
+	return nil
+}
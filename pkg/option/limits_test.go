@@ -0,0 +1,95 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+)
+
+func TestLimits_GetLimit(t *testing.T) {
+	var limits *Limits
+	assert.Zero(t, limits.GetNamespaceLimit("ns"))
+	assert.Zero(t, limits.GetMetricLimit("ns", "cpu"))
+
+	limits = &Limits{
+		MaxSeriesPerShard: 100,
+		Namespaces:        []NamespaceLimit{{Namespace: "ns", MaxSeriesPerShard: 10}},
+		Metrics: []MetricLimit{
+			{Namespace: "ns", Metric: "cpu", MaxSeriesPerShard: 5},
+			{Metric: "memory", MaxSeriesPerShard: 6},
+		},
+	}
+	assert.Equal(t, uint32(10), limits.GetNamespaceLimit("ns"))
+	assert.Zero(t, limits.GetNamespaceLimit("ns2"))
+	assert.Equal(t, uint32(5), limits.GetMetricLimit("ns", "cpu"))
+	assert.Equal(t, uint32(6), limits.GetMetricLimit(constants.DefaultNamespace, "memory"))
+	assert.Zero(t, limits.GetMetricLimit("ns", "memory"))
+}
+
+func TestLimits_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      *Limits
+		wantErr bool
+	}{
+		{
+			name: "nil limits",
+		},
+		{
+			name:    "empty namespace",
+			in:      &Limits{Namespaces: []NamespaceLimit{{MaxSeriesPerShard: 10}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate namespace",
+			in:      &Limits{Namespaces: []NamespaceLimit{{Namespace: "ns"}, {Namespace: "ns"}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty metric",
+			in:      &Limits{Metrics: []MetricLimit{{Namespace: "ns"}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate metric",
+			in:      &Limits{Metrics: []MetricLimit{{Metric: "cpu"}, {Namespace: constants.DefaultNamespace, Metric: "cpu"}}},
+			wantErr: true,
+		},
+		{
+			name: "validation pass",
+			in: &Limits{
+				MaxSeriesPerShard: 100,
+				Namespaces:        []NamespaceLimit{{Namespace: "ns", MaxSeriesPerShard: 10}},
+				Metrics:           []MetricLimit{{Metric: "cpu"}, {Namespace: "ns", Metric: "cpu"}},
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package option
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// Defines all backends of tiered storage.
+const (
+	// TieredBackendLocal moves cold segments into other directory(e.g. mounted by cheaper volume).
+	TieredBackendLocal = "local"
+	// TieredBackendS3 moves cold segments into S3-compatible object store.
+	TieredBackendS3 = "s3"
+)
+
+// Tiered represents the tiered storage policy of database,
+// the segments older than ColdAfter are moved to secondary storage, then read back lazily when querying.
+type Tiered struct {
+	ColdAfter timeutil.Interval `toml:"coldAfter" json:"coldAfter"` // segments older than it are cold
+	Backend   string            `toml:"backend" json:"backend"`     // local/s3
+	Dir       string            `toml:"dir" json:"dir,omitempty"`   // directory of local backend
+	S3        S3                `toml:"s3" json:"s3,omitempty"`     // config of s3 backend
+}
+
+// S3 represents the config of S3-compatible object store.
+type S3 struct {
+	Endpoint        string `toml:"endpoint" json:"endpoint"` // e.g. http://127.0.0.1:9000
+	Region          string `toml:"region" json:"region,omitempty"`
+	Bucket          string `toml:"bucket" json:"bucket"`
+	Prefix          string `toml:"prefix" json:"prefix,omitempty"` // key prefix of objects
+	AccessKeyID     string `toml:"accessKeyID" json:"accessKeyID,omitempty"`
+	SecretAccessKey string `toml:"secretAccessKey" json:"secretAccessKey,omitempty"`
+	// timeout of each request, includes reading response body(default 30s)
+	Timeout timeutil.Interval `toml:"timeout" json:"timeout,omitempty"`
+}
+
+// IsCold checks if the segment is cold, segment is cold if it ends before now - ColdAfter.
+func (t *Tiered) IsCold(interval timeutil.Interval, segmentTime, now int64) bool {
+	if t == nil {
+		return false
+	}
+	return interval.Calculator().CalcSegmentTime(now-t.ColdAfter.Int64()) > segmentTime
+}
+
+// Validate checks if tiered storage policy is valid.
+func (t *Tiered) Validate() error {
+	if t == nil {
+		return nil
+	}
+	if t.ColdAfter <= 0 {
+		return errors.New("cold after of tiered storage must be positive")
+	}
+	switch t.Backend {
+	case TieredBackendLocal:
+		if t.Dir == "" {
+			return errors.New("dir of local tiered storage cannot be empty")
+		}
+	case TieredBackendS3:
+		if t.S3.Endpoint == "" || t.S3.Bucket == "" {
+			return errors.New("endpoint/bucket of s3 tiered storage cannot be empty")
+		}
+	default:
+		return fmt.Errorf("tiered storage backend %s not support", t.Backend)
+	}
+	return nil
+}
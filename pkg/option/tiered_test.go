@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestTiered_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      *Tiered
+		wantErr bool
+	}{
+		{
+			name: "nil tiered",
+		},
+		{
+			name:    "cold after not set",
+			in:      &Tiered{Backend: TieredBackendLocal, Dir: "/cold"},
+			wantErr: true,
+		},
+		{
+			name:    "backend not support",
+			in:      &Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay), Backend: "hdfs"},
+			wantErr: true,
+		},
+		{
+			name:    "local dir not set",
+			in:      &Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay), Backend: TieredBackendLocal},
+			wantErr: true,
+		},
+		{
+			name:    "s3 bucket not set",
+			in:      &Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay), Backend: TieredBackendS3, S3: S3{Endpoint: "http://s3"}},
+			wantErr: true,
+		},
+		{
+			name: "local backend",
+			in:   &Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay), Backend: TieredBackendLocal, Dir: "/cold"},
+		},
+		{
+			name: "s3 backend",
+			in: &Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay), Backend: TieredBackendS3,
+				S3: S3{Endpoint: "http://s3", Bucket: "lindb"}},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.Validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTiered_IsCold(t *testing.T) {
+	var tiered *Tiered
+	assert.False(t, tiered.IsCold(timeutil.Interval(10*timeutil.OneSecond), 0, timeutil.Now()))
+
+	tiered = &Tiered{ColdAfter: timeutil.Interval(timeutil.OneDay)}
+	interval := timeutil.Interval(10 * timeutil.OneSecond) // day segment
+	segmentTime, _ := timeutil.ParseTimestamp("2021-07-03 00:00:00")
+	now, _ := timeutil.ParseTimestamp("2021-07-04 12:00:00")
+	// segment ends at 2021-07-04 00:00:00, but now - cold after is 2021-07-03 12:00:00
+	assert.False(t, tiered.IsCold(interval, segmentTime, now))
+	now, _ = timeutil.ParseTimestamp("2021-07-05 00:00:01")
+	assert.True(t, tiered.IsCold(interval, segmentTime, now))
+}
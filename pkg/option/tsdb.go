@@ -45,10 +45,21 @@ func (m Intervals) String() string {
 	return fmt.Sprintf("[%s]", strings.Join(rs, ","))
 }
 
+// rollupAggregates represents the aggregates which can be materialized at rollup time.
+var rollupAggregates = map[string]struct{}{
+	"min":   {},
+	"max":   {},
+	"sum":   {},
+	"count": {},
+	"avg":   {},
+}
+
 // Interval represents the database's interval option, include interval and data retention.
 type Interval struct {
 	Interval  timeutil.Interval `toml:"interval" json:"interval,omitempty" validate:"required"`
 	Retention timeutil.Interval `toml:"retention" json:"retention,omitempty" validate:"required"`
+	// extra aggregates(min/max/sum/count/avg) of gauge field which are materialized when rolling up into this interval
+	Aggregates []string `toml:"aggregates" json:"aggregates,omitempty"`
 }
 
 // String returns the string representation of the Interval.
@@ -77,6 +88,9 @@ type DatabaseOption struct {
 	Index FlusherOption `toml:"index" json:"index,omitempty"` // index flusher option
 	Data  FlusherOption `toml:"data" json:"data,omitempty"`   // data flusher data
 
+	Limits *Limits `toml:"limits" json:"limits,omitempty"` // series cardinality limits
+	Tiered *Tiered `toml:"tiered" json:"tiered,omitempty"` // tiered storage policy of cold segments
+
 	ahead, behind int64
 }
 
@@ -108,6 +122,9 @@ func (e *DatabaseOption) Validate() error {
 	if len(e.Intervals) == 0 {
 		return errors.New("intervals cannot be empty")
 	}
+	if err := e.validateAggregates(); err != nil {
+		return err
+	}
 	// TODO need remove
 	if err := validateInterval(e.Ahead, false); err != nil {
 		return err
@@ -115,6 +132,34 @@ func (e *DatabaseOption) Validate() error {
 	if err := validateInterval(e.Behind, false); err != nil {
 		return err
 	}
+	if err := e.Tiered.Validate(); err != nil {
+		return err
+	}
+	return e.Limits.Validate()
+}
+
+// validateAggregates checks the rollup aggregates of intervals if valid,
+// the smallest interval is written directly, so it cannot set rollup aggregates.
+func (e *DatabaseOption) validateAggregates() error {
+	writeInterval := e.Intervals[0].Interval
+	for _, interval := range e.Intervals {
+		if interval.Interval < writeInterval {
+			writeInterval = interval.Interval
+		}
+	}
+	for _, interval := range e.Intervals {
+		if len(interval.Aggregates) == 0 {
+			continue
+		}
+		if interval.Interval == writeInterval {
+			return fmt.Errorf("write interval %s cannot set rollup aggregates", interval.Interval)
+		}
+		for _, aggregate := range interval.Aggregates {
+			if _, ok := rollupAggregates[aggregate]; !ok {
+				return fmt.Errorf("rollup aggregate %s not support", aggregate)
+			}
+		}
+	}
 	return nil
 }
 
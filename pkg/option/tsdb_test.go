@@ -53,11 +53,45 @@ func TestDatabaseOption_Validate(t *testing.T) {
 			DatabaseOption{Intervals: Intervals{{}}, Behind: "0h"},
 			true,
 		},
+		{
+			"limits invalid",
+			DatabaseOption{Intervals: Intervals{{}}, Limits: &Limits{Namespaces: []NamespaceLimit{{}}}},
+			true,
+		},
+		{
+			"write interval with rollup aggregates",
+			DatabaseOption{Intervals: Intervals{
+				{Interval: timeutil.Interval(timeutil.OneMinute)},
+				{Interval: timeutil.Interval(timeutil.OneSecond * 10), Aggregates: []string{"max"}},
+			}},
+			true,
+		},
+		{
+			"rollup aggregate not support",
+			DatabaseOption{Intervals: Intervals{
+				{Interval: timeutil.Interval(timeutil.OneSecond * 10)},
+				{Interval: timeutil.Interval(timeutil.OneMinute), Aggregates: []string{"median"}},
+			}},
+			true,
+		},
+		{
+			"tiered storage invalid",
+			DatabaseOption{Intervals: Intervals{{}}, Tiered: &Tiered{Backend: TieredBackendLocal}},
+			true,
+		},
 		{
 			"validation pass",
 			DatabaseOption{Intervals: Intervals{{}}, Behind: "1h", Ahead: "1h"},
 			false,
 		},
+		{
+			"rollup aggregates pass",
+			DatabaseOption{Intervals: Intervals{
+				{Interval: timeutil.Interval(timeutil.OneSecond * 10)},
+				{Interval: timeutil.Interval(timeutil.OneMinute), Aggregates: []string{"min", "max", "avg", "count"}},
+			}},
+			false,
+		},
 	}
 
 	for _, tt := range cases {
@@ -133,15 +167,15 @@ func TestInterval_String(t *testing.T) {
 
 func TestIntervals_Sort(t *testing.T) {
 	intervals := Intervals{
-		{timeutil.Interval(timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
-		{timeutil.Interval(timeutil.OneHour), timeutil.Interval(timeutil.OneMonth)},
-		{timeutil.Interval(timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneMinute), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneHour), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneMonth)},
 	}
 	sort.Sort(intervals)
 	assert.Equal(t, Intervals{
-		{timeutil.Interval(timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)},
-		{timeutil.Interval(timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
-		{timeutil.Interval(timeutil.OneHour), timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneMinute), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneHour), Retention: timeutil.Interval(timeutil.OneMonth)},
 	}, intervals)
 
 	assert.Equal(t, "[1s->1M,1m->1M,1h->1M]", intervals.String())
@@ -149,9 +183,9 @@ func TestIntervals_Sort(t *testing.T) {
 
 func TestDatabaseOption_FindMatchSmallestInterval(t *testing.T) {
 	opt := DatabaseOption{Intervals: Intervals{
-		{timeutil.Interval(timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)},
-		{timeutil.Interval(timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
-		{timeutil.Interval(timeutil.OneHour), timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneMinute), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(timeutil.OneHour), Retention: timeutil.Interval(timeutil.OneMonth)},
 	}}
 	interval := opt.FindMatchSmallestInterval(timeutil.Interval(timeutil.OneMinute * 3))
 	assert.Equal(t, timeutil.Interval(timeutil.OneMinute), interval)
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -163,17 +164,32 @@ func (i Interval) Calculator() IntervalCalculator {
 
 // CalcSlotRange returns slot range by family time and time range.
 func (i Interval) CalcSlotRange(familyTime int64, timeRange TimeRange) SlotRange {
-	calc := i.Calculator()
+	return i.CalcSlotRangeInLocation(familyTime, timeRange, nil)
+}
+
+// CalcSlotRangeInLocation returns slot range by family time and time range,
+// the time slots are aligned to the zone of given location.
+func (i Interval) CalcSlotRangeInLocation(familyTime int64, timeRange TimeRange, loc *time.Location) SlotRange {
 	storageTimeRange := TimeRange{
 		Start: familyTime,
-		End:   calc.CalcFamilyEndTime(familyTime),
+		End:   i.Calculator().CalcFamilyEndTime(familyTime),
 	}
 	rs := timeRange.Intersect(storageTimeRange)
-	queryIntervalVal := i.Int64()
 	return SlotRange{
-		Start: uint16(calc.CalcSlot(rs.Start, familyTime, queryIntervalVal)),
-		End:   uint16(calc.CalcSlot(rs.End, familyTime, queryIntervalVal)),
+		Start: uint16(i.CalcSlotInLocation(rs.Start, familyTime, loc)),
+		End:   uint16(i.CalcSlotInLocation(rs.End, familyTime, loc)),
+	}
+}
+
+// CalcSlotInLocation calculates the time slot of timestamp based on family time,
+// the time slots are aligned to the zone of given location.
+func (i Interval) CalcSlotInLocation(timestamp, familyTime int64, loc *time.Location) int {
+	queryIntervalVal := i.Int64()
+	offset := CalcAlignOffset(familyTime, queryIntervalVal, loc)
+	if offset == 0 {
+		return i.Calculator().CalcSlot(timestamp, familyTime, queryIntervalVal)
 	}
+	return int((timestamp - familyTime + offset) / queryIntervalVal)
 }
 
 // CalcQueryInterval returns query interval based on query time range and interval.
@@ -225,6 +225,20 @@ func (y *year) CalcTimeWindows(start, end int64) int {
 	return int(t2.Sub(t1).Hours()/24/30) + 1
 }
 
+// CalcAlignOffset returns the offset(millisecond) which aligns the time slots of family to the zone of given location.
+// Time slots are aligned to the family start time of local zone, so offset is 0 if location is nil or local zone,
+// e.g. local zone is UTC, location is +08:00 and interval is 1d, the first slot starts at 8h before family start time.
+func CalcAlignOffset(familyTime, interval int64, loc *time.Location) int64 {
+	if loc == nil || loc == time.Local || interval <= 0 {
+		return 0
+	}
+	offset := (ZoneOffset(familyTime, loc) - ZoneOffset(familyTime, time.Local)) % interval
+	if offset < 0 {
+		offset += interval
+	}
+	return offset
+}
+
 // CalcTimestamp returns timestamp based on start time, slot and interval.
 func CalcTimestamp(startTime int64, slot int, interval Interval) int64 {
 	return interval.Int64()*int64(slot) + startTime
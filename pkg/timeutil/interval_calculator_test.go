@@ -148,6 +148,22 @@ func TestCalTimeWindow(t *testing.T) {
 	assert.Equal(t, 2, calc.CalcTimeWindows(2592000000, 2592000000*2))
 }
 
+func TestCalcAlignOffset(t *testing.T) {
+	defer func(local *time.Location) {
+		time.Local = local
+	}(time.Local)
+	time.Local = time.UTC
+
+	familyTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).UnixNano() / 1000000
+	assert.Equal(t, int64(0), CalcAlignOffset(familyTime, OneDay, nil))
+	assert.Equal(t, int64(0), CalcAlignOffset(familyTime, OneDay, time.Local))
+	assert.Equal(t, int64(0), CalcAlignOffset(familyTime, 0, time.FixedZone("+08:00", 8*3600)))
+	assert.Equal(t, 8*OneHour, CalcAlignOffset(familyTime, OneDay, time.FixedZone("+08:00", 8*3600)))
+	assert.Equal(t, 19*OneHour, CalcAlignOffset(familyTime, OneDay, time.FixedZone("-05:00", -5*3600)))
+	assert.Equal(t, 30*OneMinute, CalcAlignOffset(familyTime, OneHour, time.FixedZone("+05:30", 5*3600+1800)))
+	assert.Equal(t, int64(0), CalcAlignOffset(familyTime, OneHour, time.FixedZone("+08:00", 8*3600)))
+}
+
 func TestCalcTimestamp(t *testing.T) {
 	var i Interval
 
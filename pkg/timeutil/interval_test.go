@@ -19,6 +19,7 @@ package timeutil
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -374,3 +375,22 @@ func TestInterval_CalcQuerySlotRange(t *testing.T) {
 		End:   59,
 	}, slotRange)
 }
+
+func TestInterval_CalcSlotRangeInLocation(t *testing.T) {
+	defer func(local *time.Location) {
+		time.Local = local
+	}(time.Local)
+	time.Local = time.UTC
+
+	loc := time.FixedZone("+08:00", 8*3600)
+	familyTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).UnixNano() / 1000000
+	timeRange := TimeRange{
+		Start: time.Date(2026, 10, 1, 0, 0, 0, 0, loc).UnixNano() / 1000000,
+		End:   time.Date(2026, 10, 4, 0, 0, 0, 0, loc).UnixNano() / 1000000,
+	}
+	// 2026-10-01 00:00:00 UTC is in the bucket which starts at 2026-10-01 00:00:00+08:00
+	assert.Equal(t, SlotRange{Start: 0, End: 3}, Interval(OneDay).CalcSlotRangeInLocation(familyTime, timeRange, loc))
+	assert.Equal(t, SlotRange{Start: 0, End: 2}, Interval(OneDay).CalcSlotRangeInLocation(familyTime, timeRange, nil))
+	assert.Equal(t, 1, Interval(OneDay).CalcSlotInLocation(familyTime+16*OneHour, familyTime, loc))
+	assert.Equal(t, 0, Interval(OneDay).CalcSlotInLocation(familyTime+16*OneHour, familyTime, nil))
+}
@@ -18,12 +18,17 @@
 package timeutil
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 var (
 	parseTimeFunc = time.ParseInLocation
+	// locations caches the loaded locations, zone name => *time.Location
+	locations sync.Map
 )
 
 const (
@@ -46,6 +51,7 @@ const (
 	DataTimeFormat2 = "2006-01-02 15:04:05"
 	DataTimeFormat3 = "2006/01/02 15:04:05"
 	DataTimeFormat4 = "20060102150405"
+	DataTimeFormat5 = "2006-01-02T15:04:05"
 )
 
 // FormatTimestamp returns timestamp format based on layout
@@ -56,11 +62,22 @@ func FormatTimestamp(timestamp int64, layout string) string {
 
 // ParseTimestamp parses timestamp str value based on layout using local zone
 func ParseTimestamp(timestampStr string, layout ...string) (int64, error) {
+	return ParseTimestampInLocation(timestampStr, time.Local, layout...)
+}
+
+// ParseTimestampInLocation parses timestamp str value based on layout using given location,
+// RFC3339 value(e.g. 2006-01-02T15:04:05+08:00) is parsed with the zone offset it carries.
+func ParseTimestampInLocation(timestampStr string, loc *time.Location, layout ...string) (int64, error) {
 	var format string
 	if len(layout) > 0 {
 		format = layout[0]
 	} else {
 		switch {
+		case strings.Index(timestampStr, "T") > 0:
+			format = DataTimeFormat5
+			if hasZoneOffset(timestampStr) {
+				format = time.RFC3339
+			}
 		case strings.Index(timestampStr, "-") > 0:
 			format = DataTimeFormat2
 		case strings.Index(timestampStr, "/") > 0:
@@ -71,13 +88,63 @@ func ParseTimestamp(timestampStr string, layout ...string) (int64, error) {
 			format = DataTimeFormat4
 		}
 	}
-	tm, err := parseTimeFunc(format, timestampStr, time.Local)
+	tm, err := parseTimeFunc(format, timestampStr, loc)
 	if err != nil {
 		return 0, err
 	}
 	return tm.UnixNano() / 1000000, nil
 }
 
+// hasZoneOffset checks if ISO-8601 timestamp str value has zone offset, like Z/+08:00/-07:00.
+func hasZoneOffset(timestampStr string) bool {
+	clock := timestampStr[strings.Index(timestampStr, "T"):]
+	return strings.HasSuffix(clock, "Z") || strings.ContainsAny(clock, "+-")
+}
+
+// LoadLocation returns the location by zone name(e.g. Asia/Shanghai) or fixed zone offset(e.g. +08:00),
+// returns local zone if name is empty, the loaded location is cached for reusing.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := loadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// loadLocation loads the location by zone name or fixed zone offset.
+func loadLocation(name string) (*time.Location, error) {
+	if name[0] != '+' && name[0] != '-' {
+		return time.LoadLocation(name)
+	}
+	parts := strings.Split(name[1:], ":")
+	hours, err := strconv.Atoi(parts[0])
+	minutes := 0
+	if err == nil && len(parts) == 2 {
+		minutes, err = strconv.Atoi(parts[1])
+	}
+	if err != nil || len(parts) > 2 || hours > 14 || minutes >= 60 {
+		return nil, fmt.Errorf("invalid zone offset: %s", name)
+	}
+	offset := hours*3600 + minutes*60
+	if name[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(name, offset), nil
+}
+
+// ZoneOffset returns the zone offset(millisecond) of given location at the timestamp.
+func ZoneOffset(timestamp int64, loc *time.Location) int64 {
+	_, offset := time.Unix(timestamp/1000, 0).In(loc).Zone()
+	return int64(offset) * OneSecond
+}
+
 // Now returns t as a Unix time, the number of millisecond elapsed
 // since January 1, 1970 UTC. The result does not depend on the
 // location associated with t.
@@ -95,6 +162,13 @@ func Truncate(timestamp, interval int64) int64 {
 	return timestamp / interval * interval
 }
 
+// TruncateInLocation truncates timestamp based on interval which is aligned to the zone of given location,
+// e.g. truncates timestamp to the midnight of given location if interval is one day.
+func TruncateInLocation(timestamp, interval int64, loc *time.Location) int64 {
+	offset := ZoneOffset(timestamp, loc)
+	return Truncate(timestamp+offset, interval) - offset
+}
+
 // CalPointCount calculates point counts between start time and end time by interval
 func CalPointCount(startTime, endTime, interval int64) int {
 	diff := endTime - startTime
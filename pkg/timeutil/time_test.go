@@ -52,6 +52,55 @@ func Test_ParseTimestamp(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_ParseTimestampInLocation(t *testing.T) {
+	loc := time.FixedZone("+08:00", 8*3600)
+	expect := time.Date(2026, 10, 1, 0, 0, 0, 0, loc).UnixNano() / 1000000
+	cases := []string{
+		"2026-10-01T00:00:00+08:00",
+		"2026-09-30T16:00:00Z",
+		"2026-09-30T12:00:00-04:00",
+		"2026-10-01T00:00:00",
+		"2026-10-01 00:00:00",
+		"2026/10/01 00:00:00",
+		"20261001 00:00:00",
+		"20261001000000",
+	}
+	for _, str := range cases {
+		timestamp, err := ParseTimestampInLocation(str, loc)
+		assert.NoError(t, err, str)
+		assert.Equal(t, expect, timestamp, str)
+	}
+	_, err := ParseTimestampInLocation("2026-10-01T00:00:00+8", loc)
+	assert.Error(t, err)
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Local, loc)
+	loc, err = LoadLocation("UTC")
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+	loc2, err := LoadLocation("UTC")
+	assert.NoError(t, err)
+	assert.Equal(t, loc, loc2)
+
+	loc, err = LoadLocation("+08:00")
+	assert.NoError(t, err)
+	assert.Equal(t, 8*OneHour, ZoneOffset(Now(), loc))
+	loc, err = LoadLocation("-05:30")
+	assert.NoError(t, err)
+	assert.Equal(t, -5*OneHour-30*OneMinute, ZoneOffset(Now(), loc))
+	loc, err = LoadLocation("+9")
+	assert.NoError(t, err)
+	assert.Equal(t, 9*OneHour, ZoneOffset(Now(), loc))
+
+	for _, name := range []string{"Unknown/Zone", "+08:0a", "+aa", "+15:00", "+08:60", "+08:00:00"} {
+		_, err = LoadLocation(name)
+		assert.Error(t, err, name)
+	}
+}
+
 func TestCalPointCount(t *testing.T) {
 	time1, _ := ParseTimestamp(date)
 	assert.Equal(t, 1, CalPointCount(time1, time1, 10*OneSecond))
@@ -82,3 +131,14 @@ func TestTruncate(t *testing.T) {
 	t1, _ = ParseTimestamp("20190702 19:10:00", "20060102 15:04:05")
 	assert.Equal(t, t1, Truncate(now, 10*OneMinute))
 }
+
+func TestTruncateInLocation(t *testing.T) {
+	loc := time.FixedZone("+08:00", 8*3600)
+	now, _ := ParseTimestampInLocation("2026-10-01T05:10:48+08:00", loc)
+	t1, _ := ParseTimestampInLocation("2026-10-01T00:00:00+08:00", loc)
+	assert.Equal(t, t1, TruncateInLocation(now, OneDay, loc))
+	t1, _ = ParseTimestampInLocation("2026-10-01T05:10:40+08:00", loc)
+	assert.Equal(t, t1, TruncateInLocation(now, 10*OneSecond, loc))
+	t1, _ = ParseTimestampInLocation("2026-09-30T00:00:00Z", loc)
+	assert.Equal(t, t1, TruncateInLocation(now, OneDay, time.UTC))
+}
@@ -76,16 +76,22 @@ type RequestType int32
 const (
 	RequestType_Data     RequestType = 0
 	RequestType_Metadata RequestType = 1
+	RequestType_Delete   RequestType = 2
+	RequestType_Schema   RequestType = 3
 )
 
 var RequestType_name = map[int32]string{
 	0: "Data",
 	1: "Metadata",
+	2: "Delete",
+	3: "Schema",
 }
 
 var RequestType_value = map[string]int32{
 	"Data":     0,
 	"Metadata": 1,
+	"Delete":   2,
+	"Schema":   3,
 }
 
 func (x RequestType) String() string {
@@ -328,6 +334,8 @@ func (m *TimeSeriesList) GetFieldAggSpecs() []*AggregatorSpec {
 type TimeSeries struct {
 	Tags                 string            `protobuf:"bytes,1,opt,name=tags,proto3" json:"tags,omitempty"`
 	Fields               map[string][]byte `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Exemplars            []byte            `protobuf:"bytes,3,opt,name=exemplars,proto3" json:"exemplars,omitempty"`
+	StringValues         []byte            `protobuf:"bytes,4,opt,name=stringValues,proto3" json:"stringValues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -380,6 +388,20 @@ func (m *TimeSeries) GetFields() map[string][]byte {
 	return nil
 }
 
+func (m *TimeSeries) GetExemplars() []byte {
+	if m != nil {
+		return m.Exemplars
+	}
+	return nil
+}
+
+func (m *TimeSeries) GetStringValues() []byte {
+	if m != nil {
+		return m.StringValues
+	}
+	return nil
+}
+
 type AggregatorSpec struct {
 	FieldName            string   `protobuf:"bytes,1,opt,name=fieldName,proto3" json:"fieldName,omitempty"`
 	FieldType            uint32   `protobuf:"varint,2,opt,name=fieldType,proto3" json:"fieldType,omitempty"`
@@ -457,43 +479,45 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xc6, 0x69, 0x9a, 0x8e, 0x9d, 0xc8, 0x5a, 0x21, 0x30, 0x01, 0xa2, 0xc8, 0x52, 0x25,
-	0xab, 0x48, 0x11, 0xb4, 0x17, 0x40, 0x70, 0x28, 0x2d, 0x1f, 0x15, 0x6d, 0x40, 0xdb, 0x50, 0xce,
-	0x8b, 0x3d, 0x35, 0x56, 0xfd, 0x85, 0x77, 0x5b, 0xc9, 0xff, 0xa4, 0xe2, 0x17, 0x71, 0xe4, 0xc2,
-	0x85, 0x13, 0x2a, 0x7f, 0x04, 0xed, 0xda, 0x69, 0xe2, 0xa8, 0x1c, 0x38, 0x79, 0xe7, 0xcd, 0xbc,
-	0x37, 0xf3, 0xd6, 0xb3, 0x60, 0xf9, 0x59, 0x92, 0x64, 0xe9, 0x24, 0x2f, 0x32, 0x99, 0xd1, 0xbe,
-	0xfe, 0xec, 0x69, 0xe8, 0xe4, 0xb1, 0xfb, 0x8b, 0x80, 0x39, 0xe3, 0xe2, 0x8c, 0xe1, 0xd7, 0x73,
-	0x14, 0x92, 0xba, 0x60, 0xe5, 0xbc, 0xc0, 0x54, 0x2a, 0xf0, 0x60, 0xdf, 0x21, 0x63, 0xe2, 0x6d,
-	0xb0, 0x06, 0x46, 0x1f, 0x42, 0x47, 0x96, 0x39, 0x3a, 0xed, 0x31, 0xf1, 0x06, 0xdb, 0x77, 0x26,
-	0x0d, 0xc5, 0x89, 0x2a, 0x9a, 0x95, 0x39, 0x32, 0x5d, 0x44, 0x9f, 0x83, 0x59, 0x54, 0xda, 0x0a,
-	0x74, 0x0c, 0xcd, 0x19, 0xae, 0x70, 0xd8, 0xa2, 0x82, 0x2d, 0x97, 0xeb, 0x71, 0xbe, 0x94, 0x22,
-	0xf2, 0x79, 0xfc, 0x21, 0xe6, 0xa9, 0xd3, 0x19, 0x13, 0xcf, 0x62, 0x0d, 0x8c, 0x3a, 0xb0, 0x9e,
-	0xf3, 0x32, 0xce, 0x78, 0xe0, 0xac, 0xe9, 0xf4, 0x3c, 0x74, 0x7f, 0x12, 0xb0, 0x2a, 0x73, 0x22,
-	0xcf, 0x52, 0x81, 0xf4, 0x36, 0x74, 0xe5, 0xb2, 0xaf, 0x3a, 0xfa, 0x3f, 0x47, 0xf7, 0x61, 0xc3,
-	0xcf, 0x92, 0x3c, 0x46, 0x89, 0x81, 0xf6, 0xd3, 0x63, 0x0b, 0x40, 0xb5, 0xc0, 0xa2, 0x38, 0x12,
-	0xa1, 0x9e, 0x75, 0x83, 0xd5, 0x11, 0x1d, 0x42, 0x4f, 0x60, 0x1a, 0xcc, 0xa2, 0x04, 0xf5, 0x98,
-	0x06, 0xbb, 0x8e, 0x97, 0x1d, 0x74, 0x1b, 0x0e, 0xe8, 0x2d, 0x58, 0x13, 0x92, 0x4b, 0xe1, 0xac,
-	0x6b, 0xbc, 0x0a, 0xdc, 0x4b, 0x02, 0x03, 0x45, 0x3c, 0xc6, 0x22, 0x42, 0x71, 0x18, 0x09, 0x49,
-	0x77, 0x61, 0x20, 0x1b, 0x88, 0x43, 0xc6, 0x86, 0x67, 0x6e, 0xdf, 0x5d, 0xf5, 0x72, 0x5d, 0xc4,
-	0x56, 0x08, 0x74, 0x0f, 0xfa, 0xa7, 0x11, 0xc6, 0xc1, 0x6e, 0x18, 0x1e, 0xe7, 0xe8, 0x0b, 0xa7,
-	0xad, 0x15, 0x1e, 0xac, 0x28, 0xec, 0x86, 0x61, 0x81, 0x21, 0x97, 0x59, 0xa1, 0xaa, 0x58, 0x93,
-	0xe3, 0x7e, 0x23, 0x00, 0x8b, 0x1e, 0x94, 0x42, 0x47, 0xf2, 0x50, 0xd4, 0xd7, 0xad, 0xcf, 0xf4,
-	0x05, 0x74, 0x35, 0x67, 0xde, 0x60, 0xf3, 0x9f, 0x23, 0x4e, 0x5e, 0xeb, 0xba, 0x57, 0xa9, 0x2c,
-	0x4a, 0x56, 0x93, 0x86, 0x4f, 0xc1, 0x5c, 0x82, 0xa9, 0x0d, 0xc6, 0x19, 0x96, 0x75, 0x03, 0x75,
-	0x54, 0x77, 0x76, 0xc1, 0xe3, 0xf3, 0xea, 0x6f, 0x5a, 0xac, 0x0a, 0x9e, 0xb5, 0x9f, 0x10, 0x37,
-	0x87, 0x41, 0x73, 0x7a, 0xf5, 0x2f, 0xb5, 0xec, 0x94, 0x27, 0x58, 0x6b, 0x2c, 0x80, 0xeb, 0xec,
-	0x6c, 0xbe, 0x1b, 0x7d, 0xb6, 0x00, 0xd4, 0x6e, 0x9e, 0x9e, 0xa7, 0xbe, 0x3a, 0xeb, 0x0b, 0x37,
-	0xc6, 0x86, 0xd7, 0x67, 0x0d, 0x6c, 0x6b, 0x07, 0x7a, 0xf3, 0xed, 0xa1, 0x26, 0xac, 0x7f, 0x9c,
-	0xbe, 0x9b, 0xbe, 0xff, 0x34, 0xb5, 0x5b, 0xd4, 0x06, 0xeb, 0x20, 0x95, 0x58, 0x24, 0x18, 0x44,
-	0x5c, 0xa2, 0x4d, 0x68, 0x0f, 0x3a, 0x87, 0xc8, 0x4f, 0xed, 0xf6, 0xd6, 0x26, 0x98, 0x4b, 0x0f,
-	0x42, 0x25, 0xf6, 0xb9, 0xe4, 0x76, 0x8b, 0x5a, 0xd0, 0x3b, 0x42, 0xc9, 0x03, 0x15, 0x91, 0xed,
-	0x93, 0xea, 0xe5, 0x1e, 0x63, 0x71, 0x11, 0xf9, 0x48, 0xdf, 0x40, 0xf7, 0x2d, 0x4f, 0x83, 0x18,
-	0xe9, 0xf0, 0x86, 0xfd, 0xad, 0x05, 0x87, 0xf7, 0x6e, 0xcc, 0x55, 0xcf, 0xc3, 0x6d, 0x79, 0xe4,
-	0x11, 0x79, 0x69, 0x7f, 0xbf, 0x1a, 0x91, 0x1f, 0x57, 0x23, 0xf2, 0xfb, 0x6a, 0x44, 0x2e, 0xff,
-	0x8c, 0x5a, 0x9f, 0xbb, 0x9a, 0xb3, 0xf3, 0x37, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xb3, 0x4d, 0x15,
-	0x4a, 0x04, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0xad, 0x9b, 0x2e, 0xeb, 0x6e, 0xd3, 0x2a, 0xb2, 0x10, 0x84, 0x02, 0x55, 0x15, 0x09, 0xa9,
+	0x1a, 0x52, 0x05, 0xdb, 0x0b, 0x20, 0xf6, 0x30, 0x36, 0x3e, 0x26, 0xb6, 0x82, 0xdc, 0x32, 0x9e,
+	0x4d, 0x72, 0x97, 0x45, 0xcb, 0x17, 0xb6, 0x37, 0xd1, 0x7f, 0xb2, 0x9f, 0xc4, 0x23, 0x2f, 0xbc,
+	0x20, 0x1e, 0xd0, 0xf8, 0x23, 0xc8, 0x6e, 0xbb, 0x26, 0xd5, 0x78, 0xe1, 0xa9, 0xbe, 0xc7, 0xe7,
+	0x5c, 0x9f, 0xe3, 0x5e, 0x07, 0x9c, 0x20, 0x4f, 0xd3, 0x3c, 0x1b, 0x16, 0x22, 0x57, 0x39, 0x6d,
+	0x9b, 0x9f, 0x3d, 0x03, 0x1d, 0x3f, 0xf1, 0x7f, 0x12, 0x68, 0x4d, 0xb8, 0x3c, 0x63, 0xf8, 0xe5,
+	0x1c, 0xa5, 0xa2, 0x3e, 0x38, 0x05, 0x17, 0x98, 0x29, 0x0d, 0x1e, 0xec, 0x7b, 0xa4, 0x4f, 0x06,
+	0x1b, 0xac, 0x82, 0xd1, 0x47, 0xd0, 0x50, 0xd3, 0x02, 0xbd, 0x7a, 0x9f, 0x0c, 0x3a, 0x5b, 0x77,
+	0x86, 0x95, 0x8e, 0x43, 0x4d, 0x9a, 0x4c, 0x0b, 0x64, 0x86, 0x44, 0x5f, 0x40, 0x4b, 0xcc, 0x7a,
+	0x6b, 0xd0, 0xb3, 0x8c, 0xa6, 0xbb, 0xa2, 0x61, 0x4b, 0x06, 0x2b, 0xd3, 0x8d, 0x9d, 0xd3, 0xa9,
+	0x8c, 0x03, 0x9e, 0x7c, 0x48, 0x78, 0xe6, 0x35, 0xfa, 0x64, 0xe0, 0xb0, 0x0a, 0x46, 0x3d, 0x58,
+	0x2f, 0xf8, 0x34, 0xc9, 0x79, 0xe8, 0xad, 0x99, 0xed, 0x45, 0xe9, 0xff, 0x20, 0xe0, 0xcc, 0xc2,
+	0xc9, 0x22, 0xcf, 0x24, 0xd2, 0xdb, 0x60, 0xab, 0x72, 0x2e, 0x5b, 0xfd, 0x47, 0xa2, 0xfb, 0xb0,
+	0x11, 0xe4, 0x69, 0x91, 0xa0, 0xc2, 0xd0, 0xe4, 0x69, 0xb2, 0x25, 0xa0, 0x8f, 0x40, 0x21, 0x8e,
+	0x64, 0x64, 0xbc, 0x6e, 0xb0, 0x79, 0x45, 0xbb, 0xd0, 0x94, 0x98, 0x85, 0x93, 0x38, 0x45, 0x63,
+	0xd3, 0x62, 0xd7, 0x75, 0x39, 0x81, 0x5d, 0x49, 0x40, 0x6f, 0xc1, 0x9a, 0x54, 0x5c, 0x49, 0x6f,
+	0xdd, 0xe0, 0xb3, 0xc2, 0xbf, 0x24, 0xd0, 0xd1, 0xc2, 0x31, 0x8a, 0x18, 0xe5, 0x61, 0x2c, 0x15,
+	0xdd, 0x85, 0x8e, 0xaa, 0x20, 0x1e, 0xe9, 0x5b, 0x83, 0xd6, 0xd6, 0xdd, 0xd5, 0x2c, 0xd7, 0x24,
+	0xb6, 0x22, 0xa0, 0x7b, 0xd0, 0x3e, 0x89, 0x31, 0x09, 0x77, 0xa3, 0x68, 0x5c, 0x60, 0x20, 0xbd,
+	0xba, 0xe9, 0xf0, 0x60, 0xa5, 0xc3, 0x6e, 0x14, 0x09, 0x8c, 0xb8, 0xca, 0x85, 0x66, 0xb1, 0xaa,
+	0xc6, 0xff, 0x45, 0x00, 0x96, 0x67, 0x50, 0x0a, 0x0d, 0xc5, 0x23, 0x39, 0xbf, 0x6e, 0xb3, 0xa6,
+	0x3b, 0x60, 0x1b, 0xcd, 0xe2, 0x80, 0x87, 0xff, 0xb4, 0x38, 0x7c, 0x6d, 0x78, 0xaf, 0x32, 0x25,
+	0xa6, 0x6c, 0x2e, 0xd2, 0xd7, 0x8f, 0x5f, 0x31, 0x2d, 0x12, 0x2e, 0xa4, 0xb9, 0x7e, 0x87, 0x2d,
+	0x01, 0x3d, 0x30, 0x52, 0x89, 0x38, 0x8b, 0x8e, 0x79, 0x72, 0x8e, 0x72, 0x31, 0x30, 0x65, 0xac,
+	0xfb, 0x0c, 0x5a, 0xa5, 0xc6, 0xd4, 0x05, 0xeb, 0x0c, 0xa7, 0x73, 0x8b, 0x7a, 0xa9, 0x6f, 0xfd,
+	0x42, 0x53, 0xcd, 0x3c, 0x38, 0x6c, 0x56, 0x3c, 0xaf, 0x3f, 0x25, 0x7e, 0x01, 0x9d, 0x6a, 0x7e,
+	0x6d, 0xc7, 0x18, 0x1b, 0xf1, 0x14, 0xe7, 0x3d, 0x96, 0xc0, 0xf5, 0xee, 0x64, 0x31, 0x5d, 0x6d,
+	0xb6, 0x04, 0xb4, 0xd9, 0x93, 0xf3, 0x2c, 0xd0, 0x6b, 0xf3, 0x97, 0x59, 0x7d, 0x6b, 0xd0, 0x66,
+	0x15, 0x6c, 0x73, 0x1b, 0x9a, 0x8b, 0xf9, 0xa3, 0x2d, 0x58, 0xff, 0x38, 0x7a, 0x37, 0x7a, 0xff,
+	0x69, 0xe4, 0xd6, 0xa8, 0x0b, 0xce, 0x41, 0xa6, 0x50, 0xa4, 0x18, 0xc6, 0x5c, 0xa1, 0x4b, 0x68,
+	0x13, 0x1a, 0x87, 0xc8, 0x4f, 0xdc, 0xfa, 0xe6, 0x0e, 0xb4, 0x4a, 0x4f, 0x4a, 0x6f, 0xec, 0x73,
+	0xc5, 0xdd, 0x1a, 0x75, 0xa0, 0x79, 0x84, 0x8a, 0x87, 0xba, 0x22, 0x14, 0xc0, 0xde, 0x47, 0x3d,
+	0xb6, 0x6e, 0x5d, 0xaf, 0xc7, 0xc1, 0x29, 0xa6, 0xdc, 0xb5, 0xb6, 0x8e, 0x67, 0xdf, 0x84, 0x31,
+	0x8a, 0x8b, 0x38, 0x40, 0xfa, 0x06, 0xec, 0xb7, 0x3c, 0x0b, 0x13, 0xa4, 0xdd, 0x1b, 0x5e, 0xc6,
+	0xfc, 0xa0, 0xee, 0xbd, 0x1b, 0xf7, 0x66, 0x0f, 0xcf, 0xaf, 0x0d, 0xc8, 0x63, 0xf2, 0xd2, 0xfd,
+	0x76, 0xd5, 0x23, 0xdf, 0xaf, 0x7a, 0xe4, 0xf7, 0x55, 0x8f, 0x5c, 0xfe, 0xe9, 0xd5, 0x3e, 0xdb,
+	0x46, 0xb3, 0xfd, 0x77, 0x00, 0xeb, 0x33, 0x30, 0x9e, 0xa4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -820,6 +844,20 @@ func (m *TimeSeries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.StringValues) > 0 {
+		i -= len(m.StringValues)
+		copy(dAtA[i:], m.StringValues)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.StringValues)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Exemplars) > 0 {
+		i -= len(m.Exemplars)
+		copy(dAtA[i:], m.Exemplars)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Exemplars)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Fields) > 0 {
 		for k := range m.Fields {
 			v := m.Fields[k]
@@ -1032,6 +1070,14 @@ func (m *TimeSeries) Size() (n int) {
 			n += mapEntrySize + 1 + sovCommon(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.Exemplars)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.StringValues)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1817,6 +1863,74 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 			}
 			m.Fields[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemplars", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exemplars = append(m.Exemplars[:0], dAtA[iNdEx:postIndex]...)
+			if m.Exemplars == nil {
+				m.Exemplars = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValues", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StringValues = append(m.StringValues[:0], dAtA[iNdEx:postIndex]...)
+			if m.StringValues == nil {
+				m.StringValues = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -102,8 +102,16 @@ func (rcv *SimpleField) ExemplarsLength() int {
 	return 0
 }
 
+func (rcv *SimpleField) StringValue() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
 func SimpleFieldStart(builder *flatbuffers.Builder) {
-	builder.StartObject(4)
+	builder.StartObject(5)
 }
 func SimpleFieldAddName(builder *flatbuffers.Builder, name flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(name), 0)
@@ -120,6 +128,9 @@ func SimpleFieldAddExemplars(builder *flatbuffers.Builder, exemplars flatbuffers
 func SimpleFieldStartExemplarsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(4, numElems, 4)
 }
+func SimpleFieldAddStringValue(builder *flatbuffers.Builder, stringValue flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(4, flatbuffers.UOffsetT(stringValue), 0)
+}
 func SimpleFieldEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -29,6 +29,7 @@ const (
 	SimpleFieldTypeDeltaSum    SimpleFieldType = 2
 	SimpleFieldTypeMin         SimpleFieldType = 3
 	SimpleFieldTypeMax         SimpleFieldType = 4
+	SimpleFieldTypeString      SimpleFieldType = 5
 )
 
 var EnumNamesSimpleFieldType = map[SimpleFieldType]string{
@@ -37,6 +38,7 @@ var EnumNamesSimpleFieldType = map[SimpleFieldType]string{
 	SimpleFieldTypeDeltaSum:    "DeltaSum",
 	SimpleFieldTypeMin:         "Min",
 	SimpleFieldTypeMax:         "Max",
+	SimpleFieldTypeString:      "String",
 }
 
 var EnumValuesSimpleFieldType = map[string]SimpleFieldType{
@@ -45,6 +47,7 @@ var EnumValuesSimpleFieldType = map[string]SimpleFieldType{
 	"DeltaSum":    SimpleFieldTypeDeltaSum,
 	"Min":         SimpleFieldTypeMin,
 	"Max":         SimpleFieldTypeMax,
+	"String":      SimpleFieldTypeString,
 }
 
 func (v SimpleFieldType) String() string {
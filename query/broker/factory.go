@@ -44,6 +44,12 @@ func (qh *queryFactory) NewMetricQuery(
 	databaseName string,
 	sql *stmtpkg.Query,
 ) MetricQuery {
+	if sql.SubQuery != nil {
+		return newSubQueryMetricQuery(ctx, databaseName, sql, qh)
+	}
+	if sql.IsJoin() {
+		return newJoinMetricQuery(ctx, databaseName, sql, qh)
+	}
 	return newMetricQuery(ctx, databaseName, sql, qh)
 }
 
@@ -54,3 +60,19 @@ func (qh *queryFactory) NewMetadataQuery(
 ) MetaDataQuery {
 	return newMetadataQuery(ctx, database, stmt, qh)
 }
+
+func (qh *queryFactory) NewDeleteQuery(
+	ctx context.Context,
+	database string,
+	stmt *stmtpkg.Delete,
+) DeleteQuery {
+	return newDeleteQuery(ctx, database, stmt, qh)
+}
+
+func (qh *queryFactory) NewSchemaQuery(
+	ctx context.Context,
+	database string,
+	stmt *stmtpkg.MetricSchema,
+) SchemaQuery {
+	return newSchemaQuery(ctx, database, stmt, qh)
+}
@@ -40,4 +40,8 @@ func TestExecutorFactory_NewExecutor(t *testing.T) {
 		context.Background(),
 		"",
 		&stmt.MetricMetadata{}))
+	assert.NotNil(t, factory.NewSchemaQuery(
+		context.Background(),
+		"",
+		&stmt.MetricSchema{}))
 }
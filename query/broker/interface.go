@@ -79,6 +79,19 @@ type MetaDataQuery interface {
 	WaitResponse() ([]string, error)
 }
 
+// DeleteQuery represents the series delete executor,
+// sends delete request to all live replicas of the database's shards.
+type DeleteQuery interface {
+	WaitResponse() (*models.DeleteResult, error)
+}
+
+// SchemaQuery represents the metric schema management executor,
+// sends schema statement to all live replicas of the database's shards,
+// returns the dropped series if drop metric/namespace.
+type SchemaQuery interface {
+	WaitResponse() (*models.DeleteResult, error)
+}
+
 // Factory is the handler for executing querying tasks
 type Factory interface {
 	NewMetricQuery(
@@ -92,4 +105,16 @@ type Factory interface {
 		databaseName string,
 		stmt *stmt.MetricMetadata,
 	) MetaDataQuery
+
+	NewDeleteQuery(
+		ctx context.Context,
+		databaseName string,
+		stmt *stmt.Delete,
+	) DeleteQuery
+
+	NewSchemaQuery(
+		ctx context.Context,
+		databaseName string,
+		stmt *stmt.MetricSchema,
+	) SchemaQuery
 }
@@ -141,7 +141,7 @@ func (p *intermediateTaskProcessor) processIntermediateTask(
 		if event.Stats != nil {
 			event.Stats.WaitCost = time.Since(startTime).Nanoseconds()
 		}
-		taskResponse := p.makeTaskResponse(req, event)
+		taskResponse := p.makeTaskResponse(req, &stmtQuery, event)
 		return p.taskManager.SendResponse(intermediate.Parent, taskResponse)
 	case <-ctx.Ctx.Done():
 		// ignore timeout case, as the caller is already timed out
@@ -179,6 +179,7 @@ func (p *intermediateTaskProcessor) decodePhysicalPlan(
 
 func (p *intermediateTaskProcessor) makeTaskResponse(
 	req *protoCommonV1.TaskRequest,
+	stmtQuery *stmt.Query,
 	event *series.TimeSeriesEvent,
 ) *protoCommonV1.TaskResponse {
 	var stats []byte
@@ -198,13 +199,23 @@ func (p *intermediateTaskProcessor) makeTaskResponse(
 		}
 		if len(fields) > 0 {
 			// always have group by
-			timeSeriesList = append(timeSeriesList, &protoCommonV1.TimeSeries{
+			ts := &protoCommonV1.TimeSeries{
 				Tags:   itr.Tags(),
 				Fields: fields,
-			})
+			}
+			if exemplars := event.Exemplars[ts.Tags]; len(exemplars) > 0 {
+				ts.Exemplars = encoding.JSONMarshal(exemplars)
+			}
+			if stringValues := event.StringValues[ts.Tags]; len(stringValues) > 0 {
+				ts.StringValues = encoding.JSONMarshal(stringValues.Values())
+			}
+			timeSeriesList = append(timeSeriesList, ts)
 		}
 	}
 
+	// push down order by/limit, only sends top series to root node
+	timeSeriesList = selectTopSeries(stmtQuery, timeSeriesList)
+
 	var aggregatorSpecs []*protoCommonV1.AggregatorSpec
 	for _, spec := range event.AggregatorSpecs {
 		aggregatorSpecs = append(aggregatorSpecs, spec)
@@ -18,6 +18,7 @@
 package brokerquery
 
 import (
+	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	querypkg "github.com/lindb/lindb/query"
@@ -32,6 +33,9 @@ type brokerPlan struct {
 	brokerNodes       []models.StatelessNode
 	intermediateNodes []models.StatelessNode
 	databaseCfg       models.Database
+	// timeRange is the time range of query result,
+	// the time range of query maybe widened for reading the data points shifted by time_shift function.
+	timeRange timeutil.TimeRange
 
 	physicalPlan *models.PhysicalPlan
 }
@@ -87,8 +91,13 @@ func (p *brokerPlan) Plan() error {
 	p.query.StorageInterval = storageInterval
 	p.query.Interval = interval
 	p.query.IntervalRatio = intervalRatio
-	p.query.TimeRange.Start = timeutil.Truncate(p.query.TimeRange.Start, intervalVal)
-	p.query.TimeRange.End = timeutil.Truncate(p.query.TimeRange.End, intervalVal)
+	// truncate time range by interval which is aligned to session time zone, e.g. midnight for 1d interval
+	location := p.query.Location()
+	p.query.TimeRange.Start = timeutil.TruncateInLocation(p.query.TimeRange.Start, intervalVal, location)
+	p.query.TimeRange.End = timeutil.TruncateInLocation(p.query.TimeRange.End, intervalVal, location)
+	p.timeRange = p.query.TimeRange
+	// widen the time range for storage, so that the data points shifted by time_shift function can be read
+	p.query.TimeRange.Start -= aggregation.MaxTimeShift(p.query.SelectItems, intervalVal)
 
 	root := p.currentBrokerNode
 
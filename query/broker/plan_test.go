@@ -24,6 +24,7 @@ import (
 
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/sql"
 	"github.com/lindb/lindb/sql/stmt"
@@ -74,6 +75,38 @@ func TestBrokerPlan_No_GroupBy(t *testing.T) {
 	assert.Equal(t, 0, len(plan.physicalPlan.Intermediates))
 }
 
+func TestBrokerPlan_TimeShift(t *testing.T) {
+	storageNodes := map[string][]models.ShardID{"1.1.1.1:9000": {1, 2, 4}}
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	q, err := sql.Parse("select f,time_shift(f, 1h) from cpu where time>'20190729 11:00:00' and time<'20190729 12:00:00'")
+	assert.NoError(t, err)
+	plan := newBrokerPlan(q.(*stmt.Query),
+		models.Database{Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}},
+		storageNodes, currentNode, nil)
+	err = plan.Plan()
+	assert.NoError(t, err)
+	// storage reads the data points shifted into query time range
+	assert.Equal(t, plan.timeRange.Start-timeutil.OneHour, plan.query.TimeRange.Start)
+	assert.Equal(t, plan.timeRange.End, plan.query.TimeRange.End)
+}
+
+func TestBrokerPlan_TimeZone(t *testing.T) {
+	storageNodes := map[string][]models.ShardID{"1.1.1.1:9000": {1, 2, 4}}
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	q, err := sql.Parse("select f from cpu where time>='2026-10-01T05:00:00+08:00' and time<'2026-10-03T05:00:00+08:00' " +
+		"group by time(1d) tz('+08:00')")
+	assert.NoError(t, err)
+	plan := newBrokerPlan(q.(*stmt.Query),
+		models.Database{Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}},
+		storageNodes, currentNode, nil)
+	err = plan.Plan()
+	assert.NoError(t, err)
+	// time range is truncated to the midnight of session time zone
+	start, _ := timeutil.ParseTimestamp("2026-10-01T00:00:00+08:00")
+	end, _ := timeutil.ParseTimestamp("2026-10-03T00:00:00+08:00")
+	assert.Equal(t, timeutil.TimeRange{Start: start, End: end}, plan.query.TimeRange)
+}
+
 func TestBrokerPlan_GroupBy_oddCount(t *testing.T) {
 	// odd number
 	oddStorageNodes := map[string][]models.ShardID{
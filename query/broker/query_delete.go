@@ -0,0 +1,152 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// submitTaskFunc submits the task of physical plan to all leaf nodes.
+type submitTaskFunc func(ctx context.Context, physicalPlan *models.PhysicalPlan) (<-chan *protoCommonV1.TaskResponse, error)
+
+type deleteQuery struct {
+	runtime *queryFactory
+	ctx     context.Context
+
+	database string
+	submit   submitTaskFunc
+
+	shards map[models.ShardID]int // shard id => deleted series
+}
+
+// newDeleteQuery creates the execution which executes the job of series delete
+func newDeleteQuery(
+	ctx context.Context,
+	database string,
+	stmt *stmtpkg.Delete,
+	queryBuilder *queryFactory,
+) DeleteQuery {
+	return &deleteQuery{
+		database: database,
+		ctx:      ctx,
+		runtime:  queryBuilder,
+		submit: func(ctx context.Context, physicalPlan *models.PhysicalPlan) (<-chan *protoCommonV1.TaskResponse, error) {
+			return queryBuilder.taskManager.SubmitDeleteTask(ctx, physicalPlan, stmt)
+		},
+		shards: make(map[models.ShardID]int),
+	}
+}
+
+// WaitResponse sends delete request to all live replicas, waits all replicas' response.
+func (dq *deleteQuery) WaitResponse() (*models.DeleteResult, error) {
+	physicalPlan, err := dq.makePlan()
+	if err != nil {
+		return nil, err
+	}
+
+	resultCh, err := dq.submit(dq.ctx, physicalPlan)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		select {
+		case result, ok := <-resultCh:
+			// received all data, break for loop
+			if !ok {
+				return dq.buildResult(), nil
+			}
+			if result.ErrMsg != "" {
+				return nil, errors.New(result.ErrMsg)
+			}
+			if err := dq.handleTaskResponse(result); err != nil {
+				return nil, err
+			}
+		case <-dq.ctx.Done():
+			return nil, ErrTimeout
+		}
+	}
+}
+
+// makePlan builds physical execute plan, series need to be deleted in all replicas.
+func (dq *deleteQuery) makePlan() (*models.PhysicalPlan, error) {
+	storageNodes, err := dq.runtime.stateMgr.GetReplicas(dq.database)
+	if err != nil {
+		return nil, err
+	}
+	storageNodesLen := len(storageNodes)
+	if storageNodesLen == 0 {
+		return nil, constants.ErrReplicaNotFound
+	}
+	curBroker := dq.runtime.stateMgr.GetCurrentNode()
+	curBrokerIndicator := curBroker.Indicator()
+	physicalPlan := &models.PhysicalPlan{
+		Database: dq.database,
+		Root: models.Root{
+			Indicator: curBrokerIndicator,
+			NumOfTask: int32(storageNodesLen),
+		},
+	}
+	receivers := []models.StatelessNode{curBroker}
+	for storageNode, shardIDs := range storageNodes {
+		leaf := &models.Leaf{
+			BaseNode: models.BaseNode{
+				Parent:    curBrokerIndicator,
+				Indicator: storageNode,
+			},
+			ShardIDs:  shardIDs,
+			Receivers: receivers,
+		}
+		physicalPlan.AddLeaf(leaf)
+	}
+	return physicalPlan, nil
+}
+
+func (dq *deleteQuery) handleTaskResponse(resp *protoCommonV1.TaskResponse) error {
+	result := &models.DeleteResult{}
+	if err := encoding.JSONUnmarshal(resp.Payload, result); err != nil {
+		return err
+	}
+	for _, shard := range result.Shards {
+		// each replica returns deleted series of same shard, keep the max one
+		if shard.Series > dq.shards[shard.ShardID] {
+			dq.shards[shard.ShardID] = shard.Series
+		}
+	}
+	return nil
+}
+
+// buildResult builds the delete result based on all replicas' response.
+func (dq *deleteQuery) buildResult() *models.DeleteResult {
+	rs := &models.DeleteResult{}
+	for shardID, series := range dq.shards {
+		rs.Shards = append(rs.Shards, models.ShardDeleteResult{ShardID: shardID, Series: series})
+		rs.DeletedSeries += series
+	}
+	sort.Slice(rs.Shards, func(i, j int) bool {
+		return rs.Shards[i].ShardID < rs.Shards[j].ShardID
+	})
+	return rs
+}
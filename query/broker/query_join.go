@@ -0,0 +1,321 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// joinMetricQuery implements MetricQuery for the query which references multiple metrics,
+// like select a.count/b.count from a, b group by host.
+// 1) splits the query into sub query of each metric
+// 2) executes the sub queries in parallel
+// 3) joins the grouped series of sub queries by group tags, aligns the time slots by time,
+// then evaluates the select items of the query
+type joinMetricQuery struct {
+	queryFactory *queryFactory
+
+	ctx      context.Context
+	database string
+
+	stmtQuery *stmt.Query
+}
+
+// newJoinMetricQuery creates the execution which executes the cross-metric query.
+func newJoinMetricQuery(
+	ctx context.Context,
+	database string,
+	sql *stmt.Query,
+	queryFactory *queryFactory,
+) MetricQuery {
+	return &joinMetricQuery{
+		stmtQuery:    sql,
+		database:     database,
+		ctx:          ctx,
+		queryFactory: queryFactory,
+	}
+}
+
+// WaitResponse executes the sub queries, then joins the results of them.
+func (jq *joinMetricQuery) WaitResponse() (*models.ResultSet, error) {
+	startTime := time.Now()
+	subQueries, err := splitJoinQuery(jq.stmtQuery)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]*metricQuery, len(subQueries))
+	events := make([]*series.TimeSeriesEvent, len(subQueries))
+	errs := make([]error, len(subQueries))
+	var wait sync.WaitGroup
+	for idx := range subQueries {
+		queries[idx] = &metricQuery{
+			stmtQuery:    subQueries[idx],
+			database:     jq.database,
+			ctx:          jq.ctx,
+			queryFactory: jq.queryFactory,
+		}
+		wait.Add(1)
+		go func(idx int) {
+			defer wait.Done()
+			events[idx], errs[idx] = queries[idx].execute()
+		}(idx)
+	}
+	wait.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// query result is built based on the planned time range/interval of sub queries
+	planned := queries[0].stmtQuery
+	for _, q := range queries[1:] {
+		if q.stmtQuery.TimeRange != planned.TimeRange || q.stmtQuery.Interval != planned.Interval {
+			return nil, fmt.Errorf("time range or interval of metric: %s and %s are not aligned",
+				planned.MetricName, q.stmtQuery.MetricName)
+		}
+	}
+	stmtQuery := *jq.stmtQuery
+	stmtQuery.TimeRange = planned.TimeRange
+	stmtQuery.Interval = planned.Interval
+	stmtQuery.IntervalRatio = planned.IntervalRatio
+	stmtQuery.StorageInterval = planned.StorageInterval
+
+	rootQuery := &metricQuery{
+		stmtQuery:   &stmtQuery,
+		startTime:   startTime,
+		endPlanTime: startTime,
+		expression: aggregation.NewExpression(
+			stmtQuery.TimeRange,
+			stmtQuery.Interval.Int64(),
+			stmtQuery.SelectItems,
+		),
+	}
+	for _, q := range queries {
+		if q.endPlanTime.After(rootQuery.endPlanTime) {
+			rootQuery.endPlanTime = q.endPlanTime
+		}
+	}
+	resultSet := rootQuery.makeResultSet(joinSeries(jq.stmtQuery.MetricNames, events))
+	resultSet.MetricName = strings.Join(jq.stmtQuery.MetricNames, ",")
+	return resultSet, nil
+}
+
+// joinSeries joins the grouped series of each metric by group tags(inner join),
+// the field of joined series is renamed with metric name, like count of metric a => a.count.
+func joinSeries(metricNames []string, events []*series.TimeSeriesEvent) *series.TimeSeriesEvent {
+	result := &series.TimeSeriesEvent{}
+	groups := make(map[string][]series.GroupedIterator)
+	for idx, event := range events {
+		if event.Stats != nil {
+			if result.Stats == nil {
+				result.Stats = models.NewQueryStats()
+			}
+			result.Stats.MergeBrokerTaskStats(metricNames[idx], event.Stats)
+		}
+		for _, it := range event.SeriesList {
+			tags := it.Tags()
+			group := groups[tags]
+			if len(group) != idx {
+				// series not found in previous metric or duplicated
+				continue
+			}
+			groups[tags] = append(group, it)
+		}
+	}
+	var tagsList []string
+	for tags, group := range groups {
+		if len(group) == len(events) {
+			tagsList = append(tagsList, tags)
+		}
+	}
+	// keep the result series in stable order
+	sort.Strings(tagsList)
+	for _, tags := range tagsList {
+		result.SeriesList = append(result.SeriesList, &joinedGroupedIterator{
+			tags:        tags,
+			metricNames: metricNames,
+			its:         groups[tags],
+		})
+	}
+	return result
+}
+
+// splitJoinQuery splits the cross-metric query into sub query of each metric,
+// the sub query selects the fields of the metric with the aggregate function which wraps the field,
+// e.g. select sum(a.count)/b.count from a, b => select sum(count) from a; select count from b.
+// sub query is not filtered/sorted, having/order by/limit is applied after joining.
+func splitJoinQuery(query *stmt.Query) ([]*stmt.Query, error) {
+	splitter := &joinSplitter{
+		query:       query,
+		selectItems: make(map[string][]stmt.Expr),
+		fieldNames:  make(map[string][]string),
+		rewrites:    make(map[string]struct{}),
+	}
+	for _, selectItem := range query.SelectItems {
+		splitter.split(nil, nil, selectItem)
+	}
+	if splitter.err != nil {
+		return nil, splitter.err
+	}
+	var result []*stmt.Query
+	for _, metricName := range query.MetricNames {
+		selectItems := splitter.selectItems[metricName]
+		if len(selectItems) == 0 {
+			return nil, fmt.Errorf("metric: %s is not referenced by select fields", metricName)
+		}
+		fieldNames := splitter.fieldNames[metricName]
+		sort.Strings(fieldNames)
+		result = append(result, &stmt.Query{
+			Explain:           query.Explain,
+			Namespace:         query.Namespace,
+			MetricName:        metricName,
+			SelectItems:       selectItems,
+			FieldNames:        fieldNames,
+			Condition:         query.Condition,
+			TimeRange:         query.TimeRange,
+			Interval:          query.Interval,
+			TimeZone:          query.TimeZone,
+			GroupBy:           query.GroupBy,
+			GroupByTransforms: query.GroupByTransforms,
+		})
+	}
+	return result, nil
+}
+
+// joinSplitter collects the select items and fields of each metric from the cross-metric query.
+type joinSplitter struct {
+	query       *stmt.Query
+	selectItems map[string][]stmt.Expr // metric name => select items
+	fieldNames  map[string][]string    // metric name => field names
+	rewrites    map[string]struct{}    // metric name + select item, for removing duplicate select item
+
+	err error
+}
+
+// split walks the expression, shifts are the time shift functions which wrap the expression,
+// parent is the function which has the expression as param.
+func (s *joinSplitter) split(shifts []*stmt.CallExpr, parent *stmt.CallExpr, expr stmt.Expr) {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		s.split(shifts, nil, e.Expr)
+	case *stmt.ParenExpr:
+		s.split(shifts, nil, e.Expr)
+	case *stmt.BinaryExpr:
+		s.split(shifts, nil, e.Left)
+		s.split(shifts, nil, e.Right)
+	case *stmt.CallExpr:
+		if e.FuncType.IsHistogram() {
+			s.err = fmt.Errorf("function: %s is not supported in cross-metric query", e.FuncType.String())
+			return
+		}
+		if e.FuncType == function.TimeShift {
+			// copy shifts, because it is shared by sibling expressions
+			shifts = append(append([]*stmt.CallExpr{}, shifts...), e)
+		}
+		for _, param := range e.Params {
+			s.split(shifts, e, param)
+		}
+	case *stmt.FieldExpr:
+		metricName, fieldName, ok := s.query.SplitJoinField(e.Name)
+		if !ok {
+			s.err = fmt.Errorf("field: %s need be referenced with metric name in cross-metric query", e.Name)
+			return
+		}
+		var item stmt.Expr = &stmt.FieldExpr{Name: fieldName}
+		if parent != nil && !parent.FuncType.IsTransform() && !parent.FuncType.IsScalar() {
+			item = &stmt.CallExpr{FuncType: parent.FuncType, Params: []stmt.Expr{item}}
+		}
+		// keeps time shift, so that sub query reads the data of shifted time range
+		for i := len(shifts) - 1; i >= 0; i-- {
+			params := append([]stmt.Expr{item}, shifts[i].Params[1:]...)
+			item = &stmt.CallExpr{FuncType: function.TimeShift, Params: params}
+		}
+		item = &stmt.SelectItem{Expr: item}
+		key := metricName + ":" + item.Rewrite()
+		if _, ok := s.rewrites[key]; ok {
+			return
+		}
+		s.rewrites[key] = struct{}{}
+		s.selectItems[metricName] = append(s.selectItems[metricName], item)
+		fieldNames := s.fieldNames[metricName]
+		for _, name := range fieldNames {
+			if name == fieldName {
+				return
+			}
+		}
+		s.fieldNames[metricName] = append(fieldNames, fieldName)
+	}
+}
+
+// joinedGroupedIterator implements series.GroupedIterator,
+// iterates the fields of the grouped series which have same group tags of each metric.
+type joinedGroupedIterator struct {
+	tags        string
+	metricNames []string
+	its         []series.GroupedIterator
+	idx         int
+}
+
+// HasNext returns if the iteration has more field's iterator.
+func (g *joinedGroupedIterator) HasNext() bool {
+	for g.idx < len(g.its) {
+		if g.its[g.idx].HasNext() {
+			return true
+		}
+		g.idx++
+	}
+	return false
+}
+
+// Next returns the field's iterator, the field name is prefixed with metric name.
+func (g *joinedGroupedIterator) Next() series.Iterator {
+	it := g.its[g.idx].Next()
+	return &joinedIterator{
+		Iterator:  it,
+		fieldName: field.Name(g.metricNames[g.idx] + "." + string(it.FieldName())),
+	}
+}
+
+// Tags returns group tags, tags is tag values concat string.
+func (g *joinedGroupedIterator) Tags() string {
+	return g.tags
+}
+
+// joinedIterator represents the field's iterator of joined series, which renames the field.
+type joinedIterator struct {
+	series.Iterator
+	fieldName field.Name
+}
+
+// FieldName returns the field name with metric name.
+func (it *joinedIterator) FieldName() field.Name {
+	return it.fieldName
+}
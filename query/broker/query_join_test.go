@@ -0,0 +1,166 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func Test_splitJoinQuery(t *testing.T) {
+	cases := []struct {
+		name    string
+		sql     string
+		expect  map[string][]string // metric name => select items of sub query
+		wantErr bool
+	}{
+		{
+			name:   "field",
+			sql:    "select a.f/b.f from a, b",
+			expect: map[string][]string{"a": {"f"}, "b": {"f"}},
+		},
+		{
+			name:   "function and duplicate fields",
+			sql:    "select sum(a.f)/b.g, a.f+sum(a.f), moving_avg(b.g, 3) from a, b",
+			expect: map[string][]string{"a": {"sum(f)", "f"}, "b": {"g"}},
+		},
+		{
+			name:   "time shift",
+			sql:    "select a.f/time_shift(max(a.f), 1h) + time_shift(b.f, 1d) from a, b",
+			expect: map[string][]string{"a": {"f", "time_shift(max(f),1h)"}, "b": {"time_shift(f,1d)"}},
+		},
+		{
+			name:    "quantile not supported",
+			sql:     "select quantile(0.99)+a.f from a, b",
+			wantErr: true,
+		},
+		{
+			name:    "histogram function not supported",
+			sql:     "select histogram_avg(), a.f from a, b",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := sql.Parse(tt.sql)
+			if err != nil {
+				assert.True(t, tt.wantErr)
+				return
+			}
+			query := q.(*stmt.Query)
+			subQueries, err := splitJoinQuery(query)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, subQueries, len(tt.expect))
+			for _, subQuery := range subQueries {
+				var items []string
+				for _, item := range subQuery.SelectItems {
+					items = append(items, item.Rewrite())
+				}
+				assert.Equal(t, tt.expect[subQuery.MetricName], items)
+				assert.False(t, subQuery.IsJoin())
+				assert.Equal(t, query.TimeRange, subQuery.TimeRange)
+			}
+		})
+	}
+
+	// field not referenced with metric name
+	_, err := splitJoinQuery(&stmt.Query{
+		MetricNames: []string{"a", "b"},
+		SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}}},
+	})
+	assert.Error(t, err)
+	// metric not referenced
+	_, err = splitJoinQuery(&stmt.Query{
+		MetricNames: []string{"a", "b"},
+		SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "a.f"}}},
+	})
+	assert.Error(t, err)
+}
+
+func Test_JoinMetricQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	taskManager := NewMockTaskManager(ctrl)
+	queryFactory := &queryFactory{
+		stateMgr:    stateMgr,
+		taskManager: taskManager,
+	}
+	stateMgr.EXPECT().GetCurrentNode().Return(currentNode).AnyTimes()
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{currentNode}).AnyTimes()
+
+	q, err := sql.Parse("select a.f/b.f as ratio from a, b group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.IsType(t, &joinMetricQuery{}, queryFactory.NewMetricQuery(context.Background(), "test_db", query))
+
+	// case 1: database not found
+	stateMgr.EXPECT().GetDatabaseCfg("test_db").Return(models.Database{}, false).Times(2)
+	_, err = newJoinMetricQuery(context.Background(), "test_db", query, queryFactory).WaitResponse()
+	assert.Error(t, err)
+
+	// case 2: join series by group tags
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 60 * 1000}}}
+	stateMgr.EXPECT().GetDatabaseCfg("test_db").Return(models.Database{Option: opt}, true).AnyTimes()
+	stateMgr.EXPECT().GetQueryableReplicas("test_db").
+		Return(map[string][]models.ShardID{"1.1.1.1:9000": {1, 2}}, nil).AnyTimes()
+	values := map[string]map[string]float64{
+		"a": {"x": 1, "y": 6},
+		"b": {"y": 2, "z": 3},
+	}
+	taskManager.EXPECT().SubmitMetricTask(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *models.PhysicalPlan, q *stmt.Query) (<-chan *series.TimeSeriesEvent, error) {
+			event := &series.TimeSeriesEvent{}
+			for host, value := range values[q.MetricName] {
+				ts := mockBinaryTimeSeries(t, q.TimeRange, host, value)
+				fields := map[field.Name][]byte{"f": ts.Fields["f"]}
+				event.SeriesList = append(event.SeriesList, series.NewGroupedIterator(ts.Tags, fields))
+			}
+			ch := make(chan *series.TimeSeriesEvent, 1)
+			ch <- event
+			return ch, nil
+		}).Times(2)
+	rs, err := newJoinMetricQuery(context.Background(), "test_db", query, queryFactory).WaitResponse()
+	assert.NoError(t, err)
+	assert.Equal(t, "a,b", rs.MetricName)
+	assert.Equal(t, []string{"ratio"}, rs.Fields)
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, map[string]string{"host": "y"}, rs.Series[0].Tags)
+	assert.Len(t, rs.Series[0].Fields["ratio"], 1)
+	for _, value := range rs.Series[0].Fields["ratio"] {
+		assert.Equal(t, 3.0, value)
+	}
+}
@@ -70,6 +70,10 @@ func (mq *metadataQuery) WaitResponse() ([]string, error) {
 		case result, ok := <-resultCh:
 			// received all data, break for loop
 			if !ok {
+				if mq.metaStmtQuery.Type.IsCardinality() {
+					// cardinality result of each node need be merged, cannot dedup same result
+					return mq.results, nil
+				}
 				deduped := strutil.DeDupStringSlice(mq.results)
 				sort.Strings(deduped)
 				return deduped, nil
@@ -111,6 +111,21 @@ func Test_MetadataQuery(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, results, 1)
 
+	// cardinality data not dedup
+	cardinalityQuery := newMetadataQuery(ctx, "db", &stmt.MetricMetadata{Type: stmt.MetricCardinality},
+		&queryFactory{stateMgr: stateMgr, taskManager: thisTaskManager})
+	response5Ch := make(chan *protoCommonV1.TaskResponse)
+	time.AfterFunc(time.Millisecond*200, func() {
+		response5Ch <- &protoCommonV1.TaskResponse{Payload: data}
+		response5Ch <- &protoCommonV1.TaskResponse{Payload: data}
+		close(response5Ch)
+	})
+	thisTaskManager.EXPECT().SubmitMetaDataTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		response5Ch, nil)
+	results, err = cardinalityQuery.WaitResponse()
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+
 	// timeout
 	response4Ch := make(chan *protoCommonV1.TaskResponse)
 	time.AfterFunc(time.Millisecond*200, cancel)
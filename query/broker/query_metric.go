@@ -21,14 +21,20 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -96,17 +102,29 @@ func (mq *metricQuery) makePlan() error {
 
 	mq.startTime = startTime
 	mq.plan.physicalPlan.Database = mq.database
-	mq.stmtQuery = mq.plan.query
+	// query result is built based on the time range before widened for time shift
+	stmtQuery := *mq.plan.query
+	stmtQuery.TimeRange = mq.plan.timeRange
+	mq.stmtQuery = &stmtQuery
 	mq.expression = aggregation.NewExpression(
-		mq.plan.query.TimeRange,
-		mq.plan.query.Interval.Int64(),
-		mq.plan.query.SelectItems,
+		mq.stmtQuery.TimeRange,
+		mq.stmtQuery.Interval.Int64(),
+		mq.stmtQuery.SelectItems,
 	)
 	return nil
 }
 
 // WaitResponse builds the plan, the dispatch the task by task-manager
 func (mq *metricQuery) WaitResponse() (*models.ResultSet, error) {
+	event, err := mq.execute()
+	if err != nil {
+		return nil, err
+	}
+	return mq.makeResultSet(event), nil
+}
+
+// execute builds the plan, dispatches the task and waits the grouped time series of query.
+func (mq *metricQuery) execute() (*series.TimeSeriesEvent, error) {
 	if err := mq.makePlan(); err != nil {
 		return nil, err
 	}
@@ -136,8 +154,131 @@ func (mq *metricQuery) WaitResponse() (*models.ResultSet, error) {
 	case <-mq.ctx.Done():
 		return nil, ErrTimeout
 	}
+	return event, nil
+}
 
-	return mq.makeResultSet(event), nil
+// stringFields returns the select items which select the value of string field, result name => field name.
+func (mq *metricQuery) stringFields(aggregatorSpecs map[string]*protoCommonV1.AggregatorSpec) map[string]string {
+	var rs map[string]string
+	for _, item := range mq.stmtQuery.SelectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if !ok {
+			continue
+		}
+		var fieldExpr *stmt.FieldExpr
+		switch expr := selectItem.Expr.(type) {
+		case *stmt.FieldExpr:
+			fieldExpr = expr
+		case *stmt.CallExpr:
+			// string field only supports last value, distinct count is numeric
+			if expr.FuncType == function.LastValue && len(expr.Params) == 1 {
+				fieldExpr, _ = expr.Params[0].(*stmt.FieldExpr)
+			}
+		}
+		if fieldExpr == nil {
+			continue
+		}
+		spec, ok := aggregatorSpecs[fieldExpr.Name]
+		if !ok || field.Type(spec.FieldType) != field.StringField {
+			continue
+		}
+		if rs == nil {
+			rs = make(map[string]string)
+		}
+		if len(selectItem.Alias) > 0 {
+			rs[selectItem.Alias] = fieldExpr.Name
+		} else {
+			rs[selectItem.Rewrite()] = fieldExpr.Name
+		}
+	}
+	return rs
+}
+
+// makeStringField decodes the dictionary codes of string field by the distinct values returned with series.
+func (mq *metricQuery) makeStringField(
+	fieldName string,
+	values *collections.FloatArray,
+	stringValues []*models.StringValues,
+) map[int64]string {
+	dictionary := make(map[float64]string)
+	for _, sv := range stringValues {
+		if sv.Field != fieldName {
+			continue
+		}
+		for _, slotValues := range sv.Values {
+			for _, value := range slotValues {
+				dictionary[field.StringCode(value)] = value
+			}
+		}
+	}
+	rs := make(map[int64]string)
+	it := values.NewIterator()
+	for it.HasNext() {
+		slot, code := it.Next()
+		if value, ok := dictionary[code]; ok {
+			rs[timeutil.CalcTimestamp(mq.stmtQuery.TimeRange.Start, slot, mq.stmtQuery.Interval)] = value
+		}
+	}
+	return rs
+}
+
+// makeExemplars sorts the exemplars of series by timestamp, removes the duplicate exemplars returned by replicas.
+func (mq *metricQuery) makeExemplars(exemplars []*models.Exemplar) []*models.Exemplar {
+	if len(exemplars) == 0 {
+		return nil
+	}
+	sort.SliceStable(exemplars, func(i, j int) bool {
+		if exemplars[i].Timestamp != exemplars[j].Timestamp {
+			return exemplars[i].Timestamp < exemplars[j].Timestamp
+		}
+		return exemplars[i].Duration > exemplars[j].Duration
+	})
+	var rs []*models.Exemplar
+	for _, e := range exemplars {
+		if len(rs) > 0 && rs[len(rs)-1].TraceID == e.TraceID && rs[len(rs)-1].SpanID == e.SpanID {
+			continue
+		}
+		rs = append(rs, e)
+	}
+	return rs
+}
+
+// makeHeatmap builds the bucket matrix of histogram, the count of bucket without value is 0.
+func (mq *metricQuery) makeHeatmap(buckets []*aggregation.HistogramBucket) *models.Heatmap {
+	var slots []int
+	for _, b := range buckets {
+		it := b.Values.NewIterator()
+		for it.HasNext() {
+			slot, _ := it.Next()
+			slots = append(slots, slot)
+		}
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	sort.Ints(slots)
+	heatmap := &models.Heatmap{}
+	slotIndexes := make(map[int]int)
+	for _, slot := range slots {
+		if _, ok := slotIndexes[slot]; ok {
+			continue
+		}
+		slotIndexes[slot] = len(heatmap.Timestamps)
+		heatmap.Timestamps = append(heatmap.Timestamps, timeutil.CalcTimestamp(mq.stmtQuery.TimeRange.Start, slot, mq.stmtQuery.Interval))
+	}
+	for _, b := range buckets {
+		heatmap.Buckets = append(heatmap.Buckets, strconv.FormatFloat(b.UpperBound, 'f', -1, 64))
+		values := make([]float64, len(heatmap.Timestamps))
+		it := b.Values.NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			if !math.IsNaN(val) {
+				values[slotIndexes[slot]] = val
+			}
+		}
+		heatmap.Values = append(heatmap.Values, values)
+	}
+	return heatmap
 }
 
 func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *models.ResultSet) {
@@ -148,6 +289,8 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 	groupByKeys := mq.stmtQuery.GroupBy
 	groupByKeysLength := len(groupByKeys)
 	fieldsMap := make(map[string]struct{})
+	stringFields := mq.stringFields(event.AggregatorSpecs)
+	var seriesList []*resultSeries
 	for _, ts := range event.SeriesList {
 		var tags map[string]string
 		if groupByKeysLength > 0 {
@@ -162,14 +305,36 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 				tags[tagKey] = tagValues[idx]
 			}
 		}
+		var stringValues []*models.StringValues
+		if len(event.StringValues) > 0 {
+			stringValues = event.StringValues[ts.Tags()].Values()
+			mq.expression.PrepareStringValues(stringValues)
+		}
+		rs, ok := evalSeries(mq.expression, mq.stmtQuery, ts)
+		if !ok {
+			mq.expression.Reset()
+			continue
+		}
 		timeSeries := models.NewSeries(tags)
-		resultSet.AddSeries(timeSeries)
-		mq.expression.Eval(ts)
-		rs := mq.expression.ResultSet()
+		if len(event.Exemplars) > 0 {
+			timeSeries.Exemplars = mq.makeExemplars(event.Exemplars[ts.Tags()])
+		}
+		if buckets := mq.expression.Heatmap(); len(buckets) > 0 {
+			timeSeries.Heatmap = mq.makeHeatmap(buckets)
+		}
+		result := newResultSeries(mq.stmtQuery, rs)
+		fillSeries(mq.stmtQuery, rs)
+		result.series = timeSeries
+		seriesList = append(seriesList, result)
 		for fieldName, values := range rs {
 			if values == nil {
 				continue
 			}
+			if name, ok := stringFields[fieldName]; ok {
+				timeSeries.AddStringField(fieldName, mq.makeStringField(name, values, stringValues))
+				fieldsMap[fieldName] = struct{}{}
+				continue
+			}
 			points := models.NewPoints()
 			it := values.NewIterator()
 			for it.HasNext() {
@@ -185,6 +350,13 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 		}
 		mq.expression.Reset()
 	}
+	sortSeries(mq.stmtQuery.OrderBy, seriesList)
+	if limit := mq.stmtQuery.Limit; limit > 0 && len(seriesList) > limit {
+		seriesList = seriesList[:limit]
+	}
+	for _, s := range seriesList {
+		resultSet.AddSeries(s.series)
+	}
 
 	resultSet.MetricName = mq.stmtQuery.MetricName
 	resultSet.GroupBy = mq.stmtQuery.GroupBy
@@ -20,6 +20,7 @@ package brokerquery
 import (
 	"context"
 	"io"
+	"math"
 	"testing"
 	"time"
 
@@ -29,8 +30,10 @@ import (
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql"
@@ -196,3 +199,220 @@ func Test_MetricQuery_makeResultSet(t *testing.T) {
 		},
 	})
 }
+
+// mockGroupedSeries returns mock grouped series with sum field f, which has values in first two slots.
+func mockGroupedSeries(ctrl *gomock.Controller, startTime int64, tags string, values ...float64) series.GroupedIterator {
+	primitiveIt := series.NewMockPrimitiveIterator(ctrl)
+	primitiveIt.EXPECT().AggType().Return(field.Sum).AnyTimes()
+	var calls []*gomock.Call
+	for idx, value := range values {
+		calls = append(calls,
+			primitiveIt.EXPECT().HasNext().Return(true),
+			primitiveIt.EXPECT().Next().Return(idx, value))
+	}
+	calls = append(calls, primitiveIt.EXPECT().HasNext().Return(false))
+	gomock.InOrder(calls...)
+	fieldIt := series.NewMockFieldIterator(ctrl)
+	gomock.InOrder(
+		fieldIt.EXPECT().HasNext().Return(true),
+		fieldIt.EXPECT().Next().Return(primitiveIt),
+		fieldIt.EXPECT().HasNext().Return(false),
+	)
+	it := series.NewMockIterator(ctrl)
+	it.EXPECT().FieldType().Return(field.SumField).AnyTimes()
+	it.EXPECT().FieldName().Return(field.Name("f")).AnyTimes()
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Next().Return(startTime, fieldIt),
+		it.EXPECT().HasNext().Return(false),
+	)
+	groupedIt := series.NewMockGroupedIterator(ctrl)
+	groupedIt.EXPECT().Tags().Return(tags).AnyTimes()
+	gomock.InOrder(
+		groupedIt.EXPECT().HasNext().Return(true),
+		groupedIt.EXPECT().Next().Return(it),
+		groupedIt.EXPECT().HasNext().Return(false),
+	)
+	return groupedIt
+}
+
+func Test_MetricQuery_makeResultSet_HavingOrderByFill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now, _ := timeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	timeRange := timeutil.TimeRange{Start: now, End: now + 3*timeutil.OneMinute}
+	cases := []struct {
+		name   string
+		sql    string
+		hosts  []string
+		points int
+	}{
+		{
+			name:   "limit without order by",
+			sql:    "select f from cpu group by host limit 2",
+			hosts:  []string{"a", "b"},
+			points: 2,
+		},
+		{
+			name:   "having filter series",
+			sql:    "select f from cpu group by host having max(f) >= 3",
+			hosts:  []string{"b", "c"},
+			points: 2,
+		},
+		{
+			name:   "order by desc with limit",
+			sql:    "select f as v from cpu group by host order by max(v) desc limit 2",
+			hosts:  []string{"b", "c"},
+			points: 2,
+		},
+		{
+			name:   "order by asc",
+			sql:    "select f from cpu group by host order by sum(f), f",
+			hosts:  []string{"a", "c", "b"},
+			points: 2,
+		},
+		{
+			name:   "fill previous",
+			sql:    "select f from cpu group by host fill(previous) having f < 5",
+			hosts:  []string{"a"},
+			points: 4,
+		},
+		{
+			name:   "fill value",
+			sql:    "select f from cpu group by host fill(0) order by f desc limit 1",
+			hosts:  []string{"b"},
+			points: 4,
+		},
+		{
+			name:   "having evaluated before fill",
+			sql:    "select f from cpu group by host fill(0) having count(f) > 2",
+			hosts:  nil,
+			points: 0,
+		},
+		{
+			name:   "order by evaluated before fill",
+			sql:    "select f from cpu group by host fill(0) order by min(f)",
+			hosts:  []string{"a", "c", "b"},
+			points: 4,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := sql.Parse(tt.sql)
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			query.TimeRange = timeRange
+			query.Interval = timeutil.Interval(timeutil.OneMinute)
+			qry := &metricQuery{
+				expression: aggregation.NewExpression(timeRange, timeutil.OneMinute, query.SelectItems),
+				stmtQuery:  query,
+			}
+			rs := qry.makeResultSet(&series.TimeSeriesEvent{
+				SeriesList: []series.GroupedIterator{
+					mockGroupedSeries(ctrl, now, "a", 1, 1),
+					mockGroupedSeries(ctrl, now, "b", 5, 5),
+					mockGroupedSeries(ctrl, now, "c", 3, 3),
+				},
+			})
+			var hosts []string
+			for _, s := range rs.Series {
+				hosts = append(hosts, s.Tags["host"])
+				assert.Len(t, s.Fields[rs.Fields[0]], tt.points)
+			}
+			assert.Equal(t, tt.hosts, hosts)
+		})
+	}
+}
+
+func Test_MetricQuery_makeResultSet_Exemplars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	q, err := sql.Parse("select f from cpu group by host with exemplars")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	query.TimeRange = timeutil.TimeRange{Start: 0, End: timeutil.OneMinute * 10}
+	query.Interval = timeutil.Interval(timeutil.OneMinute)
+	qry := &metricQuery{
+		stmtQuery:  query,
+		expression: aggregation.NewExpression(query.TimeRange, query.Interval.Int64(), query.SelectItems),
+	}
+	rs := qry.makeResultSet(&series.TimeSeriesEvent{
+		SeriesList: []series.GroupedIterator{mockGroupedSeries(ctrl, 0, "1.1.1.1", 1)},
+		Exemplars: map[string][]*models.Exemplar{
+			"1.1.1.1": {
+				{Timestamp: 20, TraceID: "02", Duration: 5},
+				{Timestamp: 10, TraceID: "01"},
+				{Timestamp: 20, TraceID: "02", Duration: 5},
+			},
+		},
+	})
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, []*models.Exemplar{
+		{Timestamp: 10, TraceID: "01"},
+		{Timestamp: 20, TraceID: "02", Duration: 5},
+	}, rs.Series[0].Exemplars)
+}
+
+func Test_MetricQuery_makeResultSet_StringField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	q, err := sql.Parse("select f, count_distinct(f) as c from cpu group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	query.TimeRange = timeutil.TimeRange{Start: 0, End: timeutil.OneMinute * 10}
+	query.Interval = timeutil.Interval(timeutil.OneMinute)
+	qry := &metricQuery{
+		stmtQuery:  query,
+		expression: aggregation.NewExpression(query.TimeRange, query.Interval.Int64(), query.SelectItems),
+	}
+	valueSet := make(models.StringValueSet)
+	valueSet.Add("f", 0, "v1")
+	valueSet.Add("f", timeutil.OneMinute, "v1")
+	valueSet.Add("f", timeutil.OneMinute, "v2")
+	rs := qry.makeResultSet(&series.TimeSeriesEvent{
+		AggregatorSpecs: map[string]*protoCommonV1.AggregatorSpec{
+			"f": {FieldName: "f", FieldType: uint32(field.StringField)},
+		},
+		SeriesList: []series.GroupedIterator{
+			mockGroupedSeries(ctrl, 0, "1.1.1.1", field.StringCode("v1"), field.StringCode("v2")),
+		},
+		StringValues: map[string]models.StringValueSet{"1.1.1.1": valueSet},
+	})
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, map[string]map[int64]string{
+		"f": {0: "v1", timeutil.OneMinute: "v2"},
+	}, rs.Series[0].StringFields)
+	assert.Equal(t, map[string]map[int64]float64{
+		"c": {0: 1, timeutil.OneMinute: 2},
+	}, rs.Series[0].Fields)
+}
+
+func Test_MetricQuery_makeHeatmap(t *testing.T) {
+	qry := &metricQuery{
+		stmtQuery: &stmt.Query{
+			TimeRange: timeutil.TimeRange{Start: 0, End: timeutil.OneMinute * 10},
+			Interval:  timeutil.Interval(timeutil.OneMinute),
+		},
+	}
+	// no values
+	assert.Nil(t, qry.makeHeatmap([]*aggregation.HistogramBucket{{UpperBound: 1, Values: collections.NewFloatArray(10)}}))
+
+	bucket1 := collections.NewFloatArray(10)
+	bucket1.SetValue(3, 1)
+	bucketInf := collections.NewFloatArray(10)
+	bucketInf.SetValue(1, 2)
+	bucketInf.SetValue(3, math.NaN())
+	heatmap := qry.makeHeatmap([]*aggregation.HistogramBucket{
+		{UpperBound: 0.5, Values: bucket1},
+		{UpperBound: math.Inf(1), Values: bucketInf},
+	})
+	assert.Equal(t, &models.Heatmap{
+		Buckets:    []string{"0.5", "+Inf"},
+		Timestamps: []int64{timeutil.OneMinute, timeutil.OneMinute * 3},
+		Values:     [][]float64{{0, 1}, {2, 0}},
+	}, heatmap)
+}
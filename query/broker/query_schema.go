@@ -0,0 +1,45 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+
+	"github.com/lindb/lindb/models"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// newSchemaQuery creates the execution which executes the job of metric schema management,
+// schema statement need to be executed in all replicas like series delete.
+func newSchemaQuery(
+	ctx context.Context,
+	database string,
+	stmt *stmtpkg.MetricSchema,
+	queryBuilder *queryFactory,
+) SchemaQuery {
+	return &deleteQuery{
+		database: database,
+		ctx:      ctx,
+		runtime:  queryBuilder,
+		submit: func(ctx context.Context, physicalPlan *models.PhysicalPlan) (<-chan *protoCommonV1.TaskResponse, error) {
+			return queryBuilder.taskManager.SubmitSchemaTask(ctx, physicalPlan, stmt)
+		},
+		shards: make(map[models.ShardID]int),
+	}
+}
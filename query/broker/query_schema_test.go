@@ -0,0 +1,80 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func Test_SchemaQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	thisTaskManager := NewMockTaskManager(ctrl)
+
+	factory := &queryFactory{
+		stateMgr:    stateMgr,
+		taskManager: thisTaskManager,
+	}
+	schemaStmt := &stmt.MetricSchema{Type: stmt.DropMetricType, Namespace: "ns", MetricName: "cpu"}
+	schemaQuery := factory.NewSchemaQuery(context.TODO(), "db", schemaStmt)
+
+	stateMgr.EXPECT().GetReplicas("db").
+		Return(map[string][]models.ShardID{
+			"1.1.1.1:9000": {1, 2},
+			"1.1.1.2:9000": {1, 2},
+		}, nil).AnyTimes()
+	stateMgr.EXPECT().GetCurrentNode().Return(models.StatelessNode{
+		HostIP: "1.1.1.3", GRPCPort: 8000,
+	}).AnyTimes()
+
+	// submit error
+	thisTaskManager.EXPECT().SubmitSchemaTask(gomock.Any(), gomock.Any(), schemaStmt).Return(nil, io.ErrClosedPipe)
+	_, err := schemaQuery.WaitResponse()
+	assert.Error(t, err)
+
+	// ok data, replicas of same shard
+	responseCh := make(chan *protoCommonV1.TaskResponse)
+	time.AfterFunc(time.Millisecond*200, func() {
+		responseCh <- &protoCommonV1.TaskResponse{Payload: encoding.JSONMarshal(&models.DeleteResult{
+			Shards: []models.ShardDeleteResult{{ShardID: 1, Series: 2}},
+		})}
+		responseCh <- &protoCommonV1.TaskResponse{Payload: encoding.JSONMarshal(&models.DeleteResult{})}
+		close(responseCh)
+	})
+	thisTaskManager.EXPECT().SubmitSchemaTask(gomock.Any(), gomock.Any(), schemaStmt).Return(responseCh, nil)
+	result, err := schemaQuery.WaitResponse()
+	assert.NoError(t, err)
+	assert.Equal(t, &models.DeleteResult{
+		Shards:        []models.ShardDeleteResult{{ShardID: 1, Series: 2}},
+		DeletedSeries: 2,
+	}, result)
+}
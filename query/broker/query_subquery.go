@@ -0,0 +1,278 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// subQueryFunctions represents the functions which the outer query applies on the result series of subquery,
+// fields of subquery result are aggregated across the series of each group.
+var subQueryFunctions = []function.FuncType{
+	function.Sum, function.Count, function.Min, function.Max, function.Avg,
+	function.Stddev, function.FirstValue, function.LastValue,
+}
+
+// subQueryMetricQuery implements MetricQuery for the query which aggregates the result of subquery,
+// like select max(avg_cpu) from (select avg(usage) as avg_cpu from cpu group by host, node) group by node.
+// 1) executes the subquery
+// 2) groups the result series of subquery by group tags of outer query,
+// aggregates the field values of the series in same group by time slot
+// 3) evaluates the select items of outer query on the grouped series
+type subQueryMetricQuery struct {
+	stmtQuery *stmt.Query
+	subQuery  MetricQuery
+}
+
+// newSubQueryMetricQuery creates the execution which executes the query with subquery.
+func newSubQueryMetricQuery(
+	ctx context.Context,
+	database string,
+	sql *stmt.Query,
+	queryFactory *queryFactory,
+) MetricQuery {
+	return &subQueryMetricQuery{
+		stmtQuery: sql,
+		subQuery:  queryFactory.NewMetricQuery(ctx, database, sql.SubQuery),
+	}
+}
+
+// WaitResponse executes the subquery, then aggregates the result of subquery.
+func (sq *subQueryMetricQuery) WaitResponse() (*models.ResultSet, error) {
+	startTime := time.Now()
+	subResultSet, err := sq.subQuery.WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	endPlanTime := time.Now()
+
+	// outer query is evaluated in the time range/interval of subquery result
+	stmtQuery := *sq.stmtQuery
+	stmtQuery.TimeRange = timeutil.TimeRange{Start: subResultSet.StartTime, End: subResultSet.EndTime}
+	stmtQuery.Interval = timeutil.Interval(subResultSet.Interval)
+	if stmtQuery.Interval <= 0 {
+		return nil, fmt.Errorf("interval of subquery result is invalid")
+	}
+	if aggregation.MaxTimeShift(stmtQuery.SelectItems, stmtQuery.Interval.Int64()) > 0 {
+		return nil, fmt.Errorf("time_shift function is not supported by the query of subquery result")
+	}
+	var (
+		selectItems []stmt.Expr
+		quantiles   []*seriesQuantile
+	)
+	for _, item := range stmtQuery.SelectItems {
+		selectItem, err := rewriteQuantile(item, &quantiles)
+		if err != nil {
+			return nil, err
+		}
+		selectItems = append(selectItems, selectItem)
+	}
+
+	rootQuery := &metricQuery{
+		stmtQuery:   &stmtQuery,
+		startTime:   startTime,
+		endPlanTime: endPlanTime,
+		expression: aggregation.NewExpression(
+			stmtQuery.TimeRange,
+			stmtQuery.Interval.Int64(),
+			selectItems,
+		),
+	}
+	resultSet := rootQuery.makeResultSet(groupSubQueryResult(&stmtQuery, quantiles, subResultSet))
+	resultSet.MetricName = subResultSet.MetricName
+	return resultSet, nil
+}
+
+// seriesQuantile represents the quantile of field values across the series of each group,
+// like p99 of the average cpu usage of hosts.
+type seriesQuantile struct {
+	name      string // result field name, which is referenced by rewritten select item
+	fieldName string
+	quantile  float64
+}
+
+// rewriteQuantile replaces the quantile/median function of subquery result field with the field reference
+// of pre-calculated result, e.g. quantile(f, 0.99) => field 'quantile(f,0.99)', because quantile cannot be
+// calculated by the aggregates of field values.
+func rewriteQuantile(expr stmt.Expr, quantiles *[]*seriesQuantile) (stmt.Expr, error) {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		item, err := rewriteQuantile(e.Expr, quantiles)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.SelectItem{Expr: item, Alias: e.Alias}, nil
+	case *stmt.ParenExpr:
+		item, err := rewriteQuantile(e.Expr, quantiles)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.ParenExpr{Expr: item}, nil
+	case *stmt.BinaryExpr:
+		left, err := rewriteQuantile(e.Left, quantiles)
+		if err != nil {
+			return nil, err
+		}
+		right, err := rewriteQuantile(e.Right, quantiles)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.BinaryExpr{Left: left, Operator: e.Operator, Right: right}, nil
+	case *stmt.CallExpr:
+		switch e.FuncType {
+		case function.Quantile, function.Median:
+			return newSeriesQuantile(e, quantiles)
+		}
+		callExpr := &stmt.CallExpr{FuncType: e.FuncType}
+		for _, param := range e.Params {
+			item, err := rewriteQuantile(param, quantiles)
+			if err != nil {
+				return nil, err
+			}
+			callExpr.Params = append(callExpr.Params, item)
+		}
+		return callExpr, nil
+	default:
+		return expr, nil
+	}
+}
+
+// newSeriesQuantile creates the quantile of field values, returns the field reference of quantile result.
+func newSeriesQuantile(callExpr *stmt.CallExpr, quantiles *[]*seriesQuantile) (stmt.Expr, error) {
+	q := &seriesQuantile{name: callExpr.Rewrite(), quantile: 0.5}
+	for _, param := range callExpr.Params {
+		switch p := param.(type) {
+		case *stmt.FieldExpr:
+			q.fieldName = p.Name
+		case *stmt.NumberLiteral:
+			q.quantile = p.Val
+		}
+	}
+	if q.fieldName == "" {
+		return nil, fmt.Errorf("function: %s need field of subquery result", callExpr.Rewrite())
+	}
+	if q.quantile < 0 || q.quantile > 1 {
+		return nil, fmt.Errorf("quantile: %v is not in [0, 1]", q.quantile)
+	}
+	*quantiles = append(*quantiles, q)
+	return &stmt.FieldExpr{Name: q.name}, nil
+}
+
+// groupSubQueryResult groups the result series of subquery by group tags of outer query,
+// the field values of the series in same group are aggregated by time slot.
+func groupSubQueryResult(
+	query *stmt.Query,
+	quantiles []*seriesQuantile,
+	resultSet *models.ResultSet,
+) *series.TimeSeriesEvent {
+	groups := make(map[string][]*models.Series)
+	for _, s := range resultSet.Series {
+		tagValues := make([]string, len(query.GroupBy))
+		for idx, tagKey := range query.GroupBy {
+			tagValues[idx] = s.Tags[tagKey]
+		}
+		tags := tag.ConcatTagValues(tagValues)
+		groups[tags] = append(groups[tags], s)
+	}
+	var tagsList []string
+	for tags := range groups {
+		tagsList = append(tagsList, tags)
+	}
+	// keep the result series in stable order
+	sort.Strings(tagsList)
+
+	var aggSpecs aggregation.AggregatorSpecs
+	for _, fieldName := range query.FieldNames {
+		aggSpec := aggregation.NewAggregatorSpec(field.Name(fieldName), field.SumField)
+		for _, funcType := range subQueryFunctions {
+			aggSpec.AddFunctionType(funcType)
+		}
+		aggSpecs = append(aggSpecs, aggSpec)
+	}
+	for _, q := range quantiles {
+		aggSpec := aggregation.NewAggregatorSpec(field.Name(q.name), field.SumField)
+		aggSpec.AddFunctionType(function.Sum)
+		aggSpecs = append(aggSpecs, aggSpec)
+	}
+
+	event := &series.TimeSeriesEvent{Stats: resultSet.Stats}
+	for _, tags := range tagsList {
+		seriesList := groups[tags]
+		aggregates := aggregation.NewFieldAggregates(query.Interval, 1, query.TimeRange, query.Location(), aggSpecs)
+		for idx, fieldName := range query.FieldNames {
+			for _, s := range seriesList {
+				for timestamp, value := range s.Fields[fieldName] {
+					aggregateByTime(aggregates[idx], query, timestamp, value)
+				}
+			}
+		}
+		for idx, q := range quantiles {
+			values := make(map[int64][]float64)
+			for _, s := range seriesList {
+				for timestamp, value := range s.Fields[q.fieldName] {
+					values[timestamp] = append(values[timestamp], value)
+				}
+			}
+			for timestamp, points := range values {
+				aggregateByTime(aggregates[len(query.FieldNames)+idx], query, timestamp, quantileOf(points, q.quantile))
+			}
+		}
+		event.SeriesList = append(event.SeriesList, aggregates.ResultSet(tags))
+	}
+	return event
+}
+
+// aggregateByTime aggregates the value into the field aggregator of the family which the timestamp belongs to.
+func aggregateByTime(agg aggregation.SeriesAggregator, query *stmt.Query, timestamp int64, value float64) {
+	if math.IsNaN(value) || !query.TimeRange.Contains(timestamp) {
+		return
+	}
+	interval := query.Interval
+	calc := interval.Calculator()
+	segmentTime := calc.CalcSegmentTime(timestamp)
+	familyTime := calc.CalcFamilyStartTime(segmentTime, calc.CalcFamily(timestamp, segmentTime))
+	fieldAgg, ok := agg.GetAggregator(familyTime)
+	if !ok {
+		return
+	}
+	loc := query.Location()
+	slotRange := interval.CalcSlotRangeInLocation(familyTime, query.TimeRange, loc)
+	fieldAgg.AggregateBySlot(interval.CalcSlotInLocation(timestamp, familyTime, loc)-int(slotRange.Start), value)
+}
+
+// quantileOf returns the quantile of values, interpolates linearly between the closest ranks.
+func quantileOf(values []float64, quantile float64) float64 {
+	sort.Float64s(values)
+	pos := quantile * float64(len(values)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return values[lower] + (values[upper]-values[lower])*(pos-float64(lower))
+}
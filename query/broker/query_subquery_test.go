@@ -0,0 +1,202 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func Test_SubQueryMetricQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now, _ := timeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	newSeries := func(host, node string, values ...float64) *models.Series {
+		s := models.NewSeries(map[string]string{"host": host, "node": node})
+		points := models.NewPoints()
+		for idx, value := range values {
+			points.AddPoint(now+int64(idx)*timeutil.OneMinute, value)
+		}
+		s.AddField("avg_cpu", points)
+		return s
+	}
+	subResultSet := &models.ResultSet{
+		MetricName: "cpu",
+		GroupBy:    []string{"host", "node"},
+		Fields:     []string{"avg_cpu"},
+		StartTime:  now,
+		EndTime:    now + 2*timeutil.OneMinute,
+		Interval:   timeutil.OneMinute,
+		Series: []*models.Series{
+			newSeries("a", "n1", 1, 4),
+			newSeries("b", "n1", 3, 2),
+			newSeries("c", "n2", 5),
+			newSeries("d", "n1", 2, 6),
+		},
+	}
+	subSQL := "(select avg(usage) as avg_cpu from cpu group by host, node)"
+	cases := []struct {
+		name    string
+		sql     string
+		expect  map[string]map[int64]float64 // tags => values of field v
+		wantErr bool
+	}{
+		{
+			name: "max of group",
+			sql:  "select max(avg_cpu) as v from " + subSQL + " group by node",
+			expect: map[string]map[int64]float64{
+				"n1": {now: 3, now + timeutil.OneMinute: 6},
+				"n2": {now: 5},
+			},
+		},
+		{
+			name: "sum and avg without group by",
+			sql:  "select sum(avg_cpu)/avg(avg_cpu) as v from " + subSQL,
+			expect: map[string]map[int64]float64{
+				"": {now: 4, now + timeutil.OneMinute: 3},
+			},
+		},
+		{
+			name: "having filter",
+			sql:  "select count(avg_cpu) as v from " + subSQL + " group by node having max(v) > 1",
+			expect: map[string]map[int64]float64{
+				"n1": {now: 3, now + timeutil.OneMinute: 3},
+			},
+		},
+		{
+			name: "quantile of series",
+			sql:  "select quantile(avg_cpu, 0.5) as v from " + subSQL + " group by node",
+			expect: map[string]map[int64]float64{
+				"n1": {now: 2, now + timeutil.OneMinute: 4},
+				"n2": {now: 5},
+			},
+		},
+		{
+			name: "median of series",
+			sql:  "select median(avg_cpu) + 1 as v from " + subSQL + " group by node",
+			expect: map[string]map[int64]float64{
+				"n1": {now: 3, now + timeutil.OneMinute: 5},
+				"n2": {now: 6},
+			},
+		},
+		{
+			name:    "quantile out of range",
+			sql:     "select quantile(avg_cpu, 2) as v from " + subSQL,
+			wantErr: true,
+		},
+		{
+			name:    "quantile without field",
+			sql:     "select quantile(0.99) as v from " + subSQL,
+			wantErr: true,
+		},
+		{
+			name:    "time shift not supported",
+			sql:     "select time_shift(max(avg_cpu), 1h) as v from " + subSQL,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := sql.Parse(tt.sql)
+			assert.NoError(t, err)
+			subQuery := NewMockMetricQuery(ctrl)
+			subQuery.EXPECT().WaitResponse().Return(subResultSet, nil)
+			qry := &subQueryMetricQuery{stmtQuery: q.(*stmt.Query), subQuery: subQuery}
+			rs, err := qry.WaitResponse()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "cpu", rs.MetricName)
+			result := make(map[string]map[int64]float64)
+			for _, s := range rs.Series {
+				result[s.Tags["node"]] = s.Fields["v"]
+			}
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+
+	// subquery failure
+	q, err := sql.Parse("select max(avg_cpu) from " + subSQL)
+	assert.NoError(t, err)
+	subQuery := NewMockMetricQuery(ctrl)
+	subQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+	_, err = (&subQueryMetricQuery{stmtQuery: q.(*stmt.Query), subQuery: subQuery}).WaitResponse()
+	assert.Error(t, err)
+	// invalid interval
+	subQuery.EXPECT().WaitResponse().Return(&models.ResultSet{}, nil)
+	_, err = (&subQueryMetricQuery{stmtQuery: q.(*stmt.Query), subQuery: subQuery}).WaitResponse()
+	assert.Error(t, err)
+
+	assert.IsType(t, &subQueryMetricQuery{},
+		NewQueryFactory(nil, nil).NewMetricQuery(context.Background(), "test_db", q.(*stmt.Query)))
+}
+
+func Test_SubQueryMetricQuery_WithoutDefaultLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now, _ := timeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	q, err := sql.Parse("select max(f) as v, count(f) as c from (select f from cpu group by host)")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	innerQuery := query.SubQuery
+	innerQuery.TimeRange = timeutil.TimeRange{Start: now, End: now + 3*timeutil.OneMinute}
+	innerQuery.Interval = timeutil.Interval(timeutil.OneMinute)
+
+	// more series than default limit of query
+	var seriesList []series.GroupedIterator
+	for i := 1; i <= 25; i++ {
+		seriesList = append(seriesList, mockGroupedSeries(ctrl, now, fmt.Sprintf("host-%d", i), float64(i)))
+	}
+	inner := &metricQuery{
+		expression: aggregation.NewExpression(innerQuery.TimeRange, timeutil.OneMinute, innerQuery.SelectItems),
+		stmtQuery:  innerQuery,
+	}
+	subResultSet := inner.makeResultSet(&series.TimeSeriesEvent{SeriesList: seriesList})
+	assert.Len(t, subResultSet.Series, 25)
+
+	subQuery := NewMockMetricQuery(ctrl)
+	subQuery.EXPECT().WaitResponse().Return(subResultSet, nil)
+	rs, err := (&subQueryMetricQuery{stmtQuery: query, subQuery: subQuery}).WaitResponse()
+	assert.NoError(t, err)
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, map[int64]float64{now: 25}, rs.Series[0].Fields["v"])
+	assert.Equal(t, map[int64]float64{now: 25}, rs.Series[0].Fields["c"])
+}
+
+func Test_quantileOf(t *testing.T) {
+	assert.Equal(t, 5.0, quantileOf([]float64{5}, 0.99))
+	assert.Equal(t, 2.5, quantileOf([]float64{4, 1, 3, 2}, 0.5))
+	assert.Equal(t, 4.0, quantileOf([]float64{4, 1, 3, 2}, 1))
+	assert.Equal(t, 1.0, quantileOf([]float64{4, 1, 3, 2}, 0))
+}
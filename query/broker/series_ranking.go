@@ -0,0 +1,152 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"sort"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/encoding"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// resultSeries represents the series of result set with the values for sorting.
+type resultSeries struct {
+	series     *models.Series
+	pos        int // position of series in the grouped series list
+	sortValues []float64
+	sortable   []bool
+}
+
+// evalSeries evaluates the select items of grouped series,
+// returns false if the series is filtered out by having clause.
+// empty values are not filled, so that having/order by clause is evaluated by the real values.
+func evalSeries(
+	expression *aggregation.Expression,
+	query *stmt.Query,
+	ts series.GroupedIterator,
+) (rs map[string]*collections.FloatArray, ok bool) {
+	expression.Eval(ts)
+	rs = expression.ResultSet()
+	if query.Having != nil {
+		// filter grouped series by having clause
+		matched, ok := aggregation.EvalScalar(query.Having, query.SelectItems, rs)
+		if !ok || matched == 0 {
+			return nil, false
+		}
+	}
+	return rs, true
+}
+
+// fillSeries fills the empty values of result series by fill clause,
+// after the series is filtered by having clause and evaluated for order by clause.
+func fillSeries(query *stmt.Query, rs map[string]*collections.FloatArray) {
+	for _, values := range rs {
+		aggregation.Fill(values, query.Fill, query.FillValue)
+	}
+}
+
+// newResultSeries creates the series of result set, evaluates the values of order by clause for sorting.
+func newResultSeries(query *stmt.Query, rs map[string]*collections.FloatArray) *resultSeries {
+	result := &resultSeries{}
+	for _, item := range query.OrderBy {
+		orderBy, ok := item.(*stmt.OrderByExpr)
+		if !ok {
+			continue
+		}
+		value, ok := aggregation.EvalScalar(orderBy.Expr, query.SelectItems, rs)
+		result.sortValues = append(result.sortValues, value)
+		result.sortable = append(result.sortable, ok)
+	}
+	return result
+}
+
+// sortSeries sorts the series by the values of order by clause,
+// the series which cannot be evaluated is always put at the end.
+func sortSeries(orderBy []stmt.Expr, seriesList []*resultSeries) {
+	if len(orderBy) == 0 {
+		return
+	}
+	sort.SliceStable(seriesList, func(i, j int) bool {
+		left, right := seriesList[i], seriesList[j]
+		for idx, item := range orderBy {
+			if idx >= len(left.sortValues) || idx >= len(right.sortValues) {
+				return false
+			}
+			if left.sortable[idx] != right.sortable[idx] {
+				return left.sortable[idx]
+			}
+			if !left.sortable[idx] || left.sortValues[idx] == right.sortValues[idx] {
+				continue
+			}
+			if expr, ok := item.(*stmt.OrderByExpr); ok && expr.Desc {
+				return left.sortValues[idx] > right.sortValues[idx]
+			}
+			return left.sortValues[idx] < right.sortValues[idx]
+		}
+		return false
+	})
+}
+
+// selectTopSeries ranks the grouped series by order by clause, only keeps the top series under limit,
+// so that intermediate node only sends the winners to root node.
+// groups are partitioned by intermediate nodes, so top series of all groups must be in top series of each partition.
+func selectTopSeries(query *stmt.Query, timeSeriesList []*protoCommonV1.TimeSeries) []*protoCommonV1.TimeSeries {
+	limit := query.Limit
+	if len(query.OrderBy) == 0 || limit <= 0 || len(timeSeriesList) <= limit {
+		return timeSeriesList
+	}
+	// evaluates the series in the time range of query result(before widened for time shift)
+	timeRange := query.TimeRange
+	timeRange.Start += aggregation.MaxTimeShift(query.SelectItems, query.Interval.Int64())
+	expression := aggregation.NewExpression(timeRange, query.Interval.Int64(), query.SelectItems)
+
+	var seriesList []*resultSeries
+	for pos, ts := range timeSeriesList {
+		fields := make(map[field.Name][]byte)
+		for k, v := range ts.Fields {
+			fields[field.Name(k)] = v
+		}
+		if len(ts.StringValues) > 0 {
+			var stringValues []*models.StringValues
+			if err := encoding.JSONUnmarshal(ts.StringValues, &stringValues); err == nil {
+				expression.PrepareStringValues(stringValues)
+			}
+		}
+		if rs, ok := evalSeries(expression, query, series.NewGroupedIterator(ts.Tags, fields)); ok {
+			result := newResultSeries(query, rs)
+			result.pos = pos
+			seriesList = append(seriesList, result)
+		}
+		expression.Reset()
+	}
+	sortSeries(query.OrderBy, seriesList)
+	if len(seriesList) > limit {
+		seriesList = seriesList[:limit]
+	}
+	topSeriesList := make([]*protoCommonV1.TimeSeries, len(seriesList))
+	for idx, s := range seriesList {
+		topSeriesList[idx] = timeSeriesList[s.pos]
+	}
+	return topSeriesList
+}
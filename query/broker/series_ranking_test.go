@@ -0,0 +1,132 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// mockBinaryTimeSeries returns the time series with sum field f(sum/count), which has values from first slot of time range.
+func mockBinaryTimeSeries(t *testing.T, timeRange timeutil.TimeRange, tags string, values ...float64) *protoCommonV1.TimeSeries {
+	interval := timeutil.Interval(timeutil.OneMinute)
+	aggSpec := aggregation.NewAggregatorSpec("f", field.SumField)
+	aggSpec.AddFunctionType(function.Sum)
+	aggSpec.AddFunctionType(function.Avg)
+	seriesAgg := aggregation.NewSeriesAggregator(interval, 1, timeRange, time.Local, aggSpec)
+	calc := interval.Calculator()
+	segmentTime := calc.CalcSegmentTime(timeRange.Start)
+	familyTime := calc.CalcFamilyStartTime(segmentTime, calc.CalcFamily(timeRange.Start, segmentTime))
+	agg, ok := seriesAgg.GetAggregator(familyTime)
+	assert.True(t, ok)
+	for idx, value := range values {
+		agg.AggregateBySlot(idx, value)
+	}
+	data, err := seriesAgg.ResultSet().MarshalBinary()
+	assert.NoError(t, err)
+	return &protoCommonV1.TimeSeries{Tags: tags, Fields: map[string][]byte{"f": data}}
+}
+
+func Test_selectTopSeries(t *testing.T) {
+	now, _ := timeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	timeRange := timeutil.TimeRange{Start: now, End: now + 3*timeutil.OneMinute}
+	timeSeriesList := []*protoCommonV1.TimeSeries{
+		mockBinaryTimeSeries(t, timeRange, "a", 1),
+		mockBinaryTimeSeries(t, timeRange, "b", 2, 3),
+		mockBinaryTimeSeries(t, timeRange, "c", 3),
+		mockBinaryTimeSeries(t, timeRange, "d", 4),
+	}
+	cases := []struct {
+		name   string
+		sql    string
+		expect []string
+	}{
+		{
+			name:   "no order by",
+			sql:    "select f from cpu group by host limit 2",
+			expect: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:   "no limit",
+			sql:    "select f from cpu group by host order by f desc",
+			expect: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:   "limit more than series",
+			sql:    "select f from cpu group by host order by f desc limit 10",
+			expect: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:   "top 2",
+			sql:    "select f from cpu group by host order by f desc limit 2",
+			expect: []string{"b", "d"},
+		},
+		{
+			name:   "bottom 2",
+			sql:    "select f from cpu group by host order by max(f) limit 2",
+			expect: []string{"a", "b"},
+		},
+		{
+			name:   "having and top 2",
+			sql:    "select f from cpu group by host having f<5 order by f desc limit 2",
+			expect: []string{"d", "c"},
+		},
+		{
+			name:   "order by select item reduced by its function",
+			sql:    "select avg(f) from cpu group by host order by avg(f) desc limit 1",
+			expect: []string{"d"},
+		},
+		{
+			name:   "having select item reduced by its function",
+			sql:    "select avg(f) as v from cpu group by host having v>2.6 order by v limit 3",
+			expect: []string{"c", "d"},
+		},
+		{
+			name:   "time shift",
+			sql:    "select time_shift(f, 1m) as s from cpu group by host order by s desc limit 1",
+			expect: []string{"b"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := sql.Parse(tt.sql)
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			query.Interval = timeutil.Interval(timeutil.OneMinute)
+			query.TimeRange = timeRange
+			// query time range widened by broker plan
+			query.TimeRange.Start -= aggregation.MaxTimeShift(query.SelectItems, query.Interval.Int64())
+			var tags []string
+			for _, ts := range selectTopSeries(query, timeSeriesList) {
+				tags = append(tags, ts.Tags)
+			}
+			assert.Equal(t, tt.expect, tags)
+		})
+	}
+}
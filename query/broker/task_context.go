@@ -187,6 +187,10 @@ type metricTaskContext struct {
 	// field name -> aggregator spec
 	// we will use it during intermediate tasks
 	aggregatorSpecs map[string]*protoCommonV1.AggregatorSpec
+	// tags -> exemplars, if query with exemplars
+	exemplars map[string][]*models.Exemplar
+	// tags -> distinct values of string fields, if query with string fields
+	stringValues map[string]models.StringValueSet
 	// tolerantNotFounds keeps the number of how many not found errors can be returned
 	// if all nodes return not-found errors, it will be treated as a error
 	// other error will be returned immediately
@@ -277,6 +281,8 @@ func (c *metricTaskContext) WriteResponse(resp *protoCommonV1.TaskResponse, from
 	case c.eventCh <- &series.TimeSeriesEvent{
 		AggregatorSpecs: c.aggregatorSpecs,
 		SeriesList:      c.groupAgg.ResultSet(),
+		Exemplars:       c.exemplars,
+		StringValues:    c.stringValues,
 		Stats:           c.stats,
 	}:
 	default:
@@ -344,6 +350,7 @@ func (c *metricTaskContext) handleTaskResponse(resp *protoCommonV1.TaskResponse,
 			c.stmtQuery.Interval,
 			1,
 			c.stmtQuery.TimeRange,
+			c.stmtQuery.Location(),
 			AggregatorSpecs,
 		)
 	}
@@ -358,10 +365,47 @@ func (c *metricTaskContext) handleTaskResponse(resp *protoCommonV1.TaskResponse,
 			fields[field.Name(k)] = v
 		}
 		c.groupAgg.Aggregate(series.NewGroupedIterator(ts.Tags, fields))
+		c.handleExemplars(ts)
+		c.handleStringValues(ts)
 	}
 	return nil
 }
 
+// handleExemplars merges the exemplars of time series by tags.
+func (c *metricTaskContext) handleExemplars(ts *protoCommonV1.TimeSeries) {
+	if len(ts.Exemplars) == 0 {
+		return
+	}
+	var exemplars []*models.Exemplar
+	if err := encoding.JSONUnmarshal(ts.Exemplars, &exemplars); err != nil || len(exemplars) == 0 {
+		return
+	}
+	if c.exemplars == nil {
+		c.exemplars = make(map[string][]*models.Exemplar)
+	}
+	c.exemplars[ts.Tags] = append(c.exemplars[ts.Tags], exemplars...)
+}
+
+// handleStringValues merges the distinct values of string fields by tags.
+func (c *metricTaskContext) handleStringValues(ts *protoCommonV1.TimeSeries) {
+	if len(ts.StringValues) == 0 {
+		return
+	}
+	var values []*models.StringValues
+	if err := encoding.JSONUnmarshal(ts.StringValues, &values); err != nil || len(values) == 0 {
+		return
+	}
+	if c.stringValues == nil {
+		c.stringValues = make(map[string]models.StringValueSet)
+	}
+	valueSet, ok := c.stringValues[ts.Tags]
+	if !ok {
+		valueSet = make(models.StringValueSet)
+		c.stringValues[ts.Tags] = valueSet
+	}
+	valueSet.AddAll(values)
+}
+
 // metaDataTaskContext represents the task context for tacking task execution state
 type metaDataTaskContext struct {
 	baseTaskContext
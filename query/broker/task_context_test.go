@@ -162,3 +162,54 @@ func Test_TaskContext_metricTaskContext_notFound(t *testing.T) {
 		"1.1.1.1",
 	)
 }
+
+func Test_TaskContext_handleExemplars(t *testing.T) {
+	taskCtx := &metricTaskContext{}
+	// no exemplars
+	taskCtx.handleExemplars(&protoCommonV1.TimeSeries{Tags: "a"})
+	assert.Nil(t, taskCtx.exemplars)
+	// bad exemplars
+	taskCtx.handleExemplars(&protoCommonV1.TimeSeries{Tags: "a", Exemplars: []byte("bad")})
+	assert.Nil(t, taskCtx.exemplars)
+	// merge exemplars
+	taskCtx.handleExemplars(&protoCommonV1.TimeSeries{
+		Tags:      "a",
+		Exemplars: encoding.JSONMarshal([]*models.Exemplar{{Timestamp: 10, TraceID: "01"}}),
+	})
+	taskCtx.handleExemplars(&protoCommonV1.TimeSeries{
+		Tags:      "a",
+		Exemplars: encoding.JSONMarshal([]*models.Exemplar{{Timestamp: 20, TraceID: "02"}}),
+	})
+	assert.Equal(t, map[string][]*models.Exemplar{
+		"a": {{Timestamp: 10, TraceID: "01"}, {Timestamp: 20, TraceID: "02"}},
+	}, taskCtx.exemplars)
+}
+
+func Test_TaskContext_handleStringValues(t *testing.T) {
+	taskCtx := &metricTaskContext{}
+	// no string values
+	taskCtx.handleStringValues(&protoCommonV1.TimeSeries{Tags: "a"})
+	assert.Nil(t, taskCtx.stringValues)
+	// bad string values
+	taskCtx.handleStringValues(&protoCommonV1.TimeSeries{Tags: "a", StringValues: []byte("bad")})
+	assert.Nil(t, taskCtx.stringValues)
+	// merge string values
+	taskCtx.handleStringValues(&protoCommonV1.TimeSeries{
+		Tags: "a",
+		StringValues: encoding.JSONMarshal([]*models.StringValues{
+			{Field: "f", Timestamps: []int64{10}, Values: [][]string{{"v1"}}},
+		}),
+	})
+	taskCtx.handleStringValues(&protoCommonV1.TimeSeries{
+		Tags: "a",
+		StringValues: encoding.JSONMarshal([]*models.StringValues{
+			{Field: "f", Timestamps: []int64{10, 20}, Values: [][]string{{"v1", "v2"}, {"v3"}}},
+		}),
+	})
+	assert.Equal(t, []*models.StringValues{{
+		Field:      "f",
+		Timestamps: []int64{10, 20},
+		Values:     [][]string{{"v1", "v2"}, {"v3"}},
+		Counts:     [][]uint32{{2, 1}, {1}},
+	}}, taskCtx.stringValues["a"].Values())
+}
@@ -69,6 +69,18 @@ type TaskManager interface {
 		physicalPlan *models.PhysicalPlan,
 		suggest *stmt.MetricMetadata,
 	) (taskResponse <-chan *protoCommonV1.TaskResponse, err error)
+	// SubmitDeleteTask concurrently send delete series task to multi leafs.
+	SubmitDeleteTask(
+		ctx context.Context,
+		physicalPlan *models.PhysicalPlan,
+		deleteStmt *stmt.Delete,
+	) (taskResponse <-chan *protoCommonV1.TaskResponse, err error)
+	// SubmitSchemaTask concurrently send metric schema management task to multi leafs.
+	SubmitSchemaTask(
+		ctx context.Context,
+		physicalPlan *models.PhysicalPlan,
+		schemaStmt *stmt.MetricSchema,
+	) (taskResponse <-chan *protoCommonV1.TaskResponse, err error)
 
 	// SendRequest sends the task request to target node based on node's indicator
 	SendRequest(targetNodeID string, req *protoCommonV1.TaskRequest) error
@@ -304,15 +316,43 @@ func (t *taskManager) SubmitMetaDataTask(
 	ctx context.Context,
 	physicalPlan *models.PhysicalPlan,
 	suggest *stmt.MetricMetadata,
+) (taskResponse <-chan *protoCommonV1.TaskResponse, err error) {
+	suggestMarshalData, _ := suggest.MarshalJSON()
+	return t.submitLeafTask(ctx, physicalPlan, protoCommonV1.RequestType_Metadata, suggestMarshalData)
+}
+
+func (t *taskManager) SubmitDeleteTask(
+	ctx context.Context,
+	physicalPlan *models.PhysicalPlan,
+	deleteStmt *stmt.Delete,
+) (taskResponse <-chan *protoCommonV1.TaskResponse, err error) {
+	deleteMarshalData, _ := deleteStmt.MarshalJSON()
+	return t.submitLeafTask(ctx, physicalPlan, protoCommonV1.RequestType_Delete, deleteMarshalData)
+}
+
+func (t *taskManager) SubmitSchemaTask(
+	ctx context.Context,
+	physicalPlan *models.PhysicalPlan,
+	schemaStmt *stmt.MetricSchema,
+) (taskResponse <-chan *protoCommonV1.TaskResponse, err error) {
+	return t.submitLeafTask(ctx, physicalPlan, protoCommonV1.RequestType_Schema, encoding.JSONMarshal(schemaStmt))
+}
+
+// submitLeafTask concurrently sends the task request to all leaf nodes of physical plan,
+// returns the response channel which receives the responses of all leaf nodes.
+func (t *taskManager) submitLeafTask(
+	ctx context.Context,
+	physicalPlan *models.PhysicalPlan,
+	requestType protoCommonV1.RequestType,
+	payload []byte,
 ) (taskResponse <-chan *protoCommonV1.TaskResponse, err error) {
 	taskID := t.AllocTaskID()
 
-	suggestMarshalData, _ := suggest.MarshalJSON()
 	req := &protoCommonV1.TaskRequest{
-		RequestType:  protoCommonV1.RequestType_Metadata,
+		RequestType:  requestType,
 		ParentTaskID: taskID,
 		PhysicalPlan: encoding.JSONMarshal(physicalPlan),
-		Payload:      suggestMarshalData,
+		Payload:      payload,
 	}
 
 	responseCh := make(chan *protoCommonV1.TaskResponse)
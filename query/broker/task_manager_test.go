@@ -30,6 +30,7 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/sql/stmt"
@@ -186,6 +187,31 @@ func TestTaskManager_SubmitMetaDataTask(t *testing.T) {
 
 	// SubmitIntermediateMetricTask
 	_ = taskManager2.SubmitIntermediateMetricTask(context.TODO(), physicalPlan, &stmt.Query{}, "")
+
+	// submit delete task
+	client.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *protoCommonV1.TaskRequest) error {
+		assert.Equal(t, protoCommonV1.RequestType_Delete, req.RequestType)
+		return nil
+	})
+	taskClientFactory.EXPECT().GetTaskClient(gomock.Any()).
+		Return(client)
+	respCh, err := taskManager2.SubmitDeleteTask(context.TODO(), physicalPlan, &stmt.Delete{})
+	assert.NoError(t, err)
+	assert.NotNil(t, respCh)
+
+	// submit schema task
+	client.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *protoCommonV1.TaskRequest) error {
+		assert.Equal(t, protoCommonV1.RequestType_Schema, req.RequestType)
+		schemaStmt := &stmt.MetricSchema{}
+		assert.NoError(t, encoding.JSONUnmarshal(req.Payload, schemaStmt))
+		assert.Equal(t, stmt.DropMetricType, schemaStmt.Type)
+		return nil
+	})
+	taskClientFactory.EXPECT().GetTaskClient(gomock.Any()).
+		Return(client)
+	respCh, err = taskManager2.SubmitSchemaTask(context.TODO(), physicalPlan, &stmt.MetricSchema{Type: stmt.DropMetricType})
+	assert.NoError(t, err)
+	assert.NotNil(t, respCh)
 }
 
 func TestTaskManager_cleaner(t *testing.T) {
@@ -66,6 +66,11 @@ func (t *groupingExecuteTrack) collectGroupByTagValues() {
 			t.queryFlow.ReduceTagValues(tagIndex, nil)
 			continue
 		}
+		if transforms := t.ctx.storageExecuteCtx.GroupingTagTransforms; idx < len(transforms) && transforms[idx] != nil {
+			// grouping tag value ids are derived tag value ids, derived tag values are kept by transform
+			t.queryFlow.ReduceTagValues(tagIndex, transforms[idx].DerivedValues(tagValueIDs))
+			continue
+		}
 		t.queryFlow.Submit(flow.ScannerStage, func() {
 			tagValues := make(map[uint32]string) // tag value id => tag value
 			task := newCollectTagValuesTaskFunc(t.ctx, t.ctx.getMetadata(), tagKey, tagValueIDs, tagValues)
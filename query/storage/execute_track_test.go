@@ -26,6 +26,7 @@ import (
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
@@ -90,6 +91,16 @@ func TestGroupingExecuteTrack_submitTask(t *testing.T) {
 				qf.EXPECT().ReduceTagValues(0, map[uint32]string{1: "1.1.1.1"})
 			},
 		},
+		{
+			name: "derived tag values of tag transform",
+			prepare: func() {
+				transform, _ := flow.NewTagValueTransform(&stmt.TagTransform{Type: stmt.RegexExtract, Regex: "^(\\d+)\\."})
+				transform.Resolve(roaring.BitmapOf(1, 2), map[uint32]string{1: "1.1.1.1", 2: "2.1.1.1"})
+				ctx.storageExecuteCtx.GroupingTagTransforms = []*flow.TagValueTransform{transform}
+				ctx.storageExecuteCtx.GroupingTagValueIDs = []*roaring.Bitmap{roaring.BitmapOf(0, 1)}
+				qf.EXPECT().ReduceTagValues(0, map[uint32]string{0: "1", 1: "2"})
+			},
+		},
 	}
 
 	for _, tt := range cases {
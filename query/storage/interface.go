@@ -17,6 +17,8 @@
 
 package storagequery
 
+import "github.com/lindb/lindb/models"
+
 //go:generate mockgen -source=./interface.go -destination=./interface_mock.go -package=storagequery
 
 // storageMetricQuery represents the metric data query interface in storage side.
@@ -30,3 +32,15 @@ type storageMetadataQuery interface {
 	// Execute executes metric metadata query.
 	Execute() (result []string, err error)
 }
+
+// storageDeleteQuery represents the series delete interface in storage side.
+type storageDeleteQuery interface {
+	// Execute executes series delete, returns the number of deleted series for each shard.
+	Execute() (result []models.ShardDeleteResult, err error)
+}
+
+// storageSchemaQuery represents the metric schema management interface in storage side.
+type storageSchemaQuery interface {
+	// Execute executes metric schema management, returns the number of dropped series for each shard.
+	Execute() (result []models.ShardDeleteResult, err error)
+}
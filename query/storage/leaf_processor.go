@@ -38,6 +38,8 @@ import (
 // for testing
 var (
 	newStorageMetadataQueryFn = newStorageMetadataQuery
+	newStorageDeleteQueryFn   = newStorageDeleteQuery
+	newStorageSchemaQueryFn   = newStorageSchemaQuery
 )
 
 // leafTaskProcessor represents the leaf node's task, the leaf node is always storage node
@@ -140,6 +142,18 @@ func (p *leafTaskProcessor) process(
 			return err
 		}
 		p.statistics.MetaQuery.Incr()
+	case protoCommonV1.RequestType_Delete:
+		if err := p.processDelete(ctx, db, curLeaf.ShardIDs, req, stream); err != nil {
+			p.statistics.DeleteQueryFailures.Incr()
+			return err
+		}
+		p.statistics.DeleteQuery.Incr()
+	case protoCommonV1.RequestType_Schema:
+		if err := p.processSchema(ctx, db, curLeaf.ShardIDs, req, stream); err != nil {
+			p.statistics.SchemaQueryFailures.Incr()
+			return err
+		}
+		p.statistics.SchemaQuery.Incr()
 	default:
 		p.statistics.OmitRequest.Incr()
 		return nil
@@ -176,6 +190,64 @@ func (p *leafTaskProcessor) processMetadataSuggest(
 	return nil
 }
 
+func (p *leafTaskProcessor) processDelete(
+	ctx *flow.TaskContext,
+	db tsdb.Database,
+	shardIDs []models.ShardID,
+	req *protoCommonV1.TaskRequest,
+	stream protoCommonV1.TaskService_HandleServer,
+) error {
+	defer ctx.Release()
+	var deleteStmt = &stmt.Delete{}
+	if err := deleteStmt.UnmarshalJSON(req.Payload); err != nil {
+		return query.ErrUnmarshalDelete
+	}
+	exec := newStorageDeleteQueryFn(db, shardIDs, deleteStmt)
+	result, err := exec.Execute()
+	if err != nil {
+		return err
+	}
+	// send result to upstream
+	if err := stream.Send(&protoCommonV1.TaskResponse{
+		Type:      protoCommonV1.TaskType_Leaf,
+		TaskID:    req.ParentTaskID,
+		Completed: true,
+		Payload:   encoding.JSONMarshal(&models.DeleteResult{Shards: result}),
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *leafTaskProcessor) processSchema(
+	ctx *flow.TaskContext,
+	db tsdb.Database,
+	shardIDs []models.ShardID,
+	req *protoCommonV1.TaskRequest,
+	stream protoCommonV1.TaskService_HandleServer,
+) error {
+	defer ctx.Release()
+	var schemaStmt = &stmt.MetricSchema{}
+	if err := encoding.JSONUnmarshal(req.Payload, schemaStmt); err != nil {
+		return query.ErrUnmarshalSchema
+	}
+	exec := newStorageSchemaQueryFn(db, shardIDs, schemaStmt)
+	result, err := exec.Execute()
+	if err != nil {
+		return err
+	}
+	// send result to upstream
+	if err := stream.Send(&protoCommonV1.TaskResponse{
+		Type:      protoCommonV1.TaskType_Leaf,
+		TaskID:    req.ParentTaskID,
+		Completed: true,
+		Payload:   encoding.JSONMarshal(&models.DeleteResult{Shards: result}),
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (p *leafTaskProcessor) processDataSearch(
 	ctx *flow.TaskContext,
 	db tsdb.Database,
@@ -310,3 +310,222 @@ func TestLeafTask_Suggest_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestLeafTask_Delete_Process(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskServerFactory := rpc.NewMockTaskServerFactory(ctrl)
+	engine := tsdb.NewMockEngine(ctrl)
+
+	currentNode := models.StatelessNode{HostIP: "1.1.1.3", GRPCPort: 8000}
+	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
+	processor := processorI.(*leafTaskProcessor)
+	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	plan := encoding.JSONMarshal(&models.PhysicalPlan{
+		Database: "test_db",
+		Leaves:   []*models.Leaf{{BaseNode: models.BaseNode{Indicator: "1.1.1.3:8000"}}},
+	})
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(mockDatabase, true).AnyTimes()
+	serverStream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
+	taskServerFactory.EXPECT().GetStream(gomock.Any()).Return(serverStream).AnyTimes()
+
+	cases := []struct {
+		name    string
+		payload []byte
+		prepare func()
+		assert  func(err error)
+	}{
+		{
+			name:    "unmarshal err",
+			payload: []byte{1, 2, 3},
+			assert: func(err error) {
+				assert.Equal(t, query.ErrUnmarshalDelete, err)
+			},
+		},
+		{
+			name:    "delete failure",
+			payload: encoding.JSONMarshal(&stmt.Delete{}),
+			prepare: func() {
+				q := NewMockstorageDeleteQuery(ctrl)
+				q.EXPECT().Execute().Return(nil, fmt.Errorf("err"))
+				newStorageDeleteQueryFn = func(database tsdb.Database, shardIDs []models.ShardID,
+					request *stmt.Delete) storageDeleteQuery {
+					return q
+				}
+			},
+			assert: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "stream err",
+			payload: encoding.JSONMarshal(&stmt.Delete{}),
+			prepare: func() {
+				q := NewMockstorageDeleteQuery(ctrl)
+				q.EXPECT().Execute().Return([]models.ShardDeleteResult{{ShardID: 1, Series: 10}}, nil)
+				newStorageDeleteQueryFn = func(database tsdb.Database, shardIDs []models.ShardID,
+					request *stmt.Delete) storageDeleteQuery {
+					return q
+				}
+				serverStream.EXPECT().Send(gomock.Any()).Return(io.ErrClosedPipe)
+			},
+			assert: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "delete successfully",
+			payload: encoding.JSONMarshal(&stmt.Delete{}),
+			prepare: func() {
+				q := NewMockstorageDeleteQuery(ctrl)
+				q.EXPECT().Execute().Return([]models.ShardDeleteResult{{ShardID: 1, Series: 10}}, nil)
+				newStorageDeleteQueryFn = func(database tsdb.Database, shardIDs []models.ShardID,
+					request *stmt.Delete) storageDeleteQuery {
+					return q
+				}
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					rs := &models.DeleteResult{}
+					err := encoding.JSONUnmarshal(resp.Payload, rs)
+					assert.NoError(t, err)
+					assert.Equal(t, []models.ShardDeleteResult{{ShardID: 1, Series: 10}}, rs.Shards)
+					return nil
+				})
+			},
+			assert: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newStorageDeleteQueryFn = newStorageDeleteQuery
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := processor.process(flow.NewTaskContextWithTimeout(context.Background(), time.Second),
+				&protoCommonV1.TaskRequest{
+					PhysicalPlan: plan,
+					RequestType:  protoCommonV1.RequestType_Delete,
+					Payload:      tt.payload})
+
+			if tt.assert != nil {
+				tt.assert(err)
+			}
+		})
+	}
+}
+
+func TestLeafTask_Schema_Process(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskServerFactory := rpc.NewMockTaskServerFactory(ctrl)
+	engine := tsdb.NewMockEngine(ctrl)
+
+	currentNode := models.StatelessNode{HostIP: "1.1.1.3", GRPCPort: 8000}
+	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
+	processor := processorI.(*leafTaskProcessor)
+	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	plan := encoding.JSONMarshal(&models.PhysicalPlan{
+		Database: "test_db",
+		Leaves:   []*models.Leaf{{BaseNode: models.BaseNode{Indicator: "1.1.1.3:8000"}}},
+	})
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(mockDatabase, true).AnyTimes()
+	serverStream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
+	taskServerFactory.EXPECT().GetStream(gomock.Any()).Return(serverStream).AnyTimes()
+
+	cases := []struct {
+		name    string
+		payload []byte
+		prepare func()
+		assert  func(err error)
+	}{
+		{
+			name:    "unmarshal err",
+			payload: []byte{1, 2, 3},
+			assert: func(err error) {
+				assert.Equal(t, query.ErrUnmarshalSchema, err)
+			},
+		},
+		{
+			name:    "execute failure",
+			payload: encoding.JSONMarshal(&stmt.MetricSchema{Type: stmt.DropMetricType}),
+			prepare: func() {
+				q := NewMockstorageSchemaQuery(ctrl)
+				q.EXPECT().Execute().Return(nil, fmt.Errorf("err"))
+				newStorageSchemaQueryFn = func(database tsdb.Database, shardIDs []models.ShardID,
+					request *stmt.MetricSchema) storageSchemaQuery {
+					return q
+				}
+			},
+			assert: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "stream err",
+			payload: encoding.JSONMarshal(&stmt.MetricSchema{Type: stmt.DropMetricType}),
+			prepare: func() {
+				q := NewMockstorageSchemaQuery(ctrl)
+				q.EXPECT().Execute().Return(nil, nil)
+				newStorageSchemaQueryFn = func(database tsdb.Database, shardIDs []models.ShardID,
+					request *stmt.MetricSchema) storageSchemaQuery {
+					return q
+				}
+				serverStream.EXPECT().Send(gomock.Any()).Return(io.ErrClosedPipe)
+			},
+			assert: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:    "execute successfully",
+			payload: encoding.JSONMarshal(&stmt.MetricSchema{Type: stmt.DropMetricType}),
+			prepare: func() {
+				q := NewMockstorageSchemaQuery(ctrl)
+				q.EXPECT().Execute().Return([]models.ShardDeleteResult{{ShardID: 1, Series: 10}}, nil)
+				newStorageSchemaQueryFn = func(database tsdb.Database, shardIDs []models.ShardID,
+					request *stmt.MetricSchema) storageSchemaQuery {
+					assert.Equal(t, stmt.DropMetricType, request.Type)
+					return q
+				}
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					rs := &models.DeleteResult{}
+					err := encoding.JSONUnmarshal(resp.Payload, rs)
+					assert.NoError(t, err)
+					assert.Equal(t, []models.ShardDeleteResult{{ShardID: 1, Series: 10}}, rs.Shards)
+					return nil
+				})
+			},
+			assert: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newStorageSchemaQueryFn = newStorageSchemaQuery
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := processor.process(flow.NewTaskContextWithTimeout(context.Background(), time.Second),
+				&protoCommonV1.TaskRequest{
+					PhysicalPlan: plan,
+					RequestType:  protoCommonV1.RequestType_Schema,
+					Payload:      tt.payload})
+
+			if tt.assert != nil {
+				tt.assert(err)
+			}
+		})
+	}
+}
@@ -26,7 +26,9 @@ import (
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -86,13 +88,25 @@ func (p *storageExecutePlan) groupBy() error {
 	p.ctx.storageExecuteCtx.GroupByTags = make(tag.Metas, lengthOfGroupByTagKeys)
 	p.ctx.storageExecuteCtx.GroupByTagKeyIDs = make([]tag.KeyID, lengthOfGroupByTagKeys)
 	queryStmt := p.ctx.storageExecuteCtx.Query
-	for idx, tagKey := range groupBy {
+	for idx, groupTag := range groupBy {
+		// lookup source tag key if group by tag transform
+		tagKey := queryStmt.GroupByTagKey(groupTag)
 		tagKeyID, err := p.ctx.getMetadata().MetadataDatabase().GetTagKeyID(queryStmt.Namespace, queryStmt.MetricName, tagKey)
 		if err != nil {
 			return err
 		}
 		p.ctx.storageExecuteCtx.GroupByTags[idx] = tag.Meta{Key: tagKey, ID: tagKeyID}
 		p.ctx.storageExecuteCtx.GroupByTagKeyIDs[idx] = tagKeyID
+		if transform := queryStmt.GetTagTransform(groupTag); transform != nil {
+			tagValueTransform, err := flow.NewTagValueTransform(transform)
+			if err != nil {
+				return err
+			}
+			if p.ctx.storageExecuteCtx.GroupingTagTransforms == nil {
+				p.ctx.storageExecuteCtx.GroupingTagTransforms = make([]*flow.TagValueTransform, lengthOfGroupByTagKeys)
+			}
+			p.ctx.storageExecuteCtx.GroupingTagTransforms[idx] = tagValueTransform
+		}
 	}
 
 	// need cache found grouping tag value id
@@ -153,8 +167,45 @@ func (p *storageExecutePlan) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 	case *stmt.SelectItem:
 		p.field(nil, e.Expr)
 	case *stmt.CallExpr:
-		if e.FuncType == function.Quantile {
-			p.planHistogramFields(e)
+		switch e.FuncType {
+		case function.Quantile:
+			p.planQuantile(e)
+			return
+		case function.Median:
+			if len(e.Params) == 0 {
+				// median of histogram is the 0.5 quantile of bucket fields, else calculated by the values of field
+				p.planHistogramFields()
+				return
+			}
+		case function.Heatmap:
+			p.planHistogramFields()
+			return
+		case function.HistogramCount:
+			p.planHistogramField(metric.HistogramCount)
+			return
+		case function.HistogramSum:
+			p.planHistogramField(metric.HistogramSum)
+			return
+		case function.HistogramAvg:
+			p.planHistogramField(metric.HistogramSum)
+			p.planHistogramField(metric.HistogramCount)
+			return
+		case function.HistogramFraction:
+			p.planHistogramFraction(e)
+			return
+		}
+		if e.FuncType.IsTransform() {
+			// transform function only uses the field of first param with default down sampling function
+			if len(e.Params) > 0 {
+				p.field(nil, e.Params[0])
+			}
+			return
+		}
+		if e.FuncType.IsScalar() {
+			// scalar function is applied on each data point, fields in params use default down sampling function
+			for _, param := range e.Params {
+				p.field(nil, param)
+			}
 			return
 		}
 		for _, param := range e.Params {
@@ -208,7 +259,7 @@ func (p *storageExecutePlan) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 	}
 }
 
-func (p *storageExecutePlan) planHistogramFields(e *stmt.CallExpr) {
+func (p *storageExecutePlan) planQuantile(e *stmt.CallExpr) {
 	if len(e.Params) != 1 {
 		p.err = fmt.Errorf("qunantile params more than one")
 		return
@@ -220,6 +271,29 @@ func (p *storageExecutePlan) planHistogramFields(e *stmt.CallExpr) {
 		p.err = fmt.Errorf("quantile param: %f is illegal", v)
 		return
 	}
+	p.planHistogramFields()
+}
+
+// planHistogramFraction plans the histogram bucket fields for fraction calculation, param is upper bound.
+func (p *storageExecutePlan) planHistogramFraction(e *stmt.CallExpr) {
+	if len(e.Params) != 1 {
+		p.err = fmt.Errorf("histogram_fraction params not equals one")
+		return
+	}
+	if _, err := strconv.ParseFloat(e.Params[0].Rewrite(), 64); err != nil {
+		p.err = fmt.Errorf("histogram_fraction param: %s is not float", e.Params[0].Rewrite())
+		return
+	}
+	p.planHistogramFields()
+}
+
+// planHistogramField plans the reserved field of histogram(sum/count) with default down sampling function.
+func (p *storageExecutePlan) planHistogramField(fieldName field.Name) {
+	p.field(nil, &stmt.FieldExpr{Name: string(fieldName)})
+}
+
+// planHistogramFields plans all histogram bucket fields of the metric for quantile calculation.
+func (p *storageExecutePlan) planHistogramFields() {
 	queryStmt := p.ctx.storageExecuteCtx.Query
 	fieldMetas, err := p.ctx.getMetadata().MetadataDatabase().GetAllHistogramFields(queryStmt.Namespace, queryStmt.MetricName)
 	if err != nil {
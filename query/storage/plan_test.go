@@ -126,14 +126,61 @@ func TestStoragePlan_SelectList(t *testing.T) {
 	assert.Equal(t, downSampling, storagePlan.fields[field.ID(10)].DownSampling)
 	assert.Equal(t, field.Metas{{Name: "f", ID: 10, Type: field.SumField}}, ctx.storageExecuteCtx.Fields)
 
-	// function not support
+	// stddev
 	q, _ = sql.Parse("select stddev(f) from cpu")
 	query = q.(*stmt.Query)
 	ctx.storageExecuteCtx.Query = query
 	storagePlan = newStorageExecutePlan(ctx)
 	err = storagePlan.Plan()
+	assert.NoError(t, err)
+	downSampling = aggregation.NewAggregatorSpec("f", field.SumField)
+	downSampling.AddFunctionType(function.Stddev)
+	assert.Equal(t, downSampling, storagePlan.fields[field.ID(10)].DownSampling)
+
+	// transform function uses default down sampling func of field
+	q, _ = sql.Parse("select moving_avg(b, 3),time_shift(b, 1d) from cpu")
+	query = q.(*stmt.Query)
+	ctx.storageExecuteCtx.Query = query
+	storagePlan = newStorageExecutePlan(ctx)
+	err = storagePlan.Plan()
+	assert.NoError(t, err)
+	downSampling = aggregation.NewAggregatorSpec("b", field.MaxField)
+	downSampling.AddFunctionType(function.Max)
+	assert.Equal(t, downSampling, storagePlan.fields[field.ID(12)].DownSampling)
+
+	// scalar function uses default down sampling func of field, includes fields of condition
+	q, _ = sql.Parse("select abs(b), if(b > 10, pow(b, 2), 0) from cpu")
+	query = q.(*stmt.Query)
+	ctx.storageExecuteCtx.Query = query
+	storagePlan = newStorageExecutePlan(ctx)
+	err = storagePlan.Plan()
+	assert.NoError(t, err)
+	assert.Equal(t, downSampling, storagePlan.fields[field.ID(12)].DownSampling)
+
+	// function not support
+	q, _ = sql.Parse("select stddev(b) from cpu")
+	query = q.(*stmt.Query)
+	ctx.storageExecuteCtx.Query = query
+	storagePlan = newStorageExecutePlan(ctx)
+	err = storagePlan.Plan()
 	assert.Error(t, err)
 
+	// median/count distinct of field are calculated by the values of field
+	q, _ = sql.Parse("select median(f), count_distinct(f) from cpu")
+	ctx.storageExecuteCtx.Query = q.(*stmt.Query)
+	storagePlan = newStorageExecutePlan(ctx)
+	assert.NoError(t, storagePlan.Plan())
+	downSampling = aggregation.NewAggregatorSpec("f", field.SumField)
+	downSampling.AddFunctionType(function.Median)
+	downSampling.AddFunctionType(function.CountDistinct)
+	assert.Equal(t, downSampling, storagePlan.fields[field.ID(10)].DownSampling)
+	assert.True(t, isValueCollected(downSampling))
+	// count distinct not support max field
+	q, _ = sql.Parse("select count_distinct(b) from cpu")
+	ctx.storageExecuteCtx.Query = q.(*stmt.Query)
+	storagePlan = newStorageExecutePlan(ctx)
+	assert.Error(t, storagePlan.Plan())
+
 	q, _ = sql.Parse("select a,b as d from cpu")
 	query = q.(*stmt.Query)
 	ctx.storageExecuteCtx.Query = query
@@ -227,6 +274,52 @@ func TestStorageExecutePlan_groupBy(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestStorageExecutePlan_groupByTagTransform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+
+	gomock.InOrder(
+		metadataDB.EXPECT().GetMetricID(gomock.Any(), "disk").Return(metric.ID(10), nil),
+		metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "host").Return(tag.KeyID(10), nil),
+		metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "path").Return(tag.KeyID(11), nil),
+		metadataDB.EXPECT().GetField(gomock.Any(), gomock.Any(), field.Name("f")).
+			Return(field.Meta{ID: 12, Type: field.SumField}, nil),
+	)
+	q, _ := sql.Parse("select f from disk group by regex_extract(host, '^(\\w+)-') as dc,path")
+	ctx := &executeContext{
+		database: db,
+		storageExecuteCtx: &flow.StorageExecuteContext{
+			Query: q.(*stmt.Query),
+		},
+	}
+	err := newStorageExecutePlan(ctx).Plan()
+	assert.NoError(t, err)
+	assert.Equal(t, tag.Metas{{ID: 10, Key: "host"}, {ID: 11, Key: "path"}}, ctx.storageExecuteCtx.GroupByTags)
+	assert.Len(t, ctx.storageExecuteCtx.GroupingTagTransforms, 2)
+	assert.NotNil(t, ctx.storageExecuteCtx.GroupingTagTransforms[0])
+	assert.Nil(t, ctx.storageExecuteCtx.GroupingTagTransforms[1])
+
+	// invalid regex
+	gomock.InOrder(
+		metadataDB.EXPECT().GetMetricID(gomock.Any(), "disk").Return(metric.ID(10), nil),
+		metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "host").Return(tag.KeyID(10), nil),
+	)
+	ctx.storageExecuteCtx = &flow.StorageExecuteContext{
+		Query: &stmt.Query{
+			MetricName:        "disk",
+			GroupBy:           []string{"host"},
+			GroupByTransforms: []*stmt.TagTransform{{Type: stmt.RegexExtract, Name: "host", TagKey: "host", Regex: "("}},
+		},
+	}
+	err = newStorageExecutePlan(ctx).Plan()
+	assert.Error(t, err)
+}
+
 func TestStorageExecutePlan_empty_select_item(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -282,17 +375,23 @@ func TestStorageExecutePlan_field_expr_fail(t *testing.T) {
 		metadataDB.EXPECT().GetAllHistogramFields(gomock.Any(), gomock.Any()).
 			Return(histogramFieldMetas, nil).AnyTimes(),
 	)
+	// bad params are rejected by parser, so replaces the params of call expr directly
+	parseWithParams := func(sqlStr string, params ...stmt.Expr) *stmt.Query {
+		q, _ := sql.Parse(sqlStr)
+		query := q.(*stmt.Query)
+		query.SelectItems[0].(*stmt.SelectItem).Expr.(*stmt.CallExpr).Params = params
+		return query
+	}
 	// params more than one
-	q, _ = sql.Parse("select quantile(0.99,1.0) from disk")
-	query = q.(*stmt.Query)
+	query = parseWithParams("select quantile(0.99) from disk",
+		&stmt.NumberLiteral{Val: 0.99}, &stmt.NumberLiteral{Val: 1.0})
 	ctx.storageExecuteCtx.Query = query
 	plan = newStorageExecutePlan(ctx)
 	err = plan.Plan()
 	assert.Error(t, err)
 
 	// quantile param not float
-	q, _ = sql.Parse("select quantile(xxxx) from disk")
-	query = q.(*stmt.Query)
+	query = parseWithParams("select quantile(0.99) from disk", &stmt.FieldExpr{Name: "xxxx"})
 	ctx.storageExecuteCtx.Query = query
 	plan = newStorageExecutePlan(ctx)
 	err = plan.Plan()
@@ -305,6 +404,82 @@ func TestStorageExecutePlan_field_expr_fail(t *testing.T) {
 	plan = newStorageExecutePlan(ctx)
 	err = plan.Plan()
 	assert.Error(t, err)
+
+	// median uses histogram fields
+	q, _ = sql.Parse("select median() from disk")
+	query = q.(*stmt.Query)
+	ctx.storageExecuteCtx.Query = query
+	plan = newStorageExecutePlan(ctx)
+	err = plan.Plan()
+	assert.NoError(t, err)
+	assert.Len(t, plan.fields, len(histogramFieldMetas))
+
+	metadataDB.EXPECT().GetMetricID(gomock.Any(), "disk").Return(metric.ID(10), nil).AnyTimes()
+	// heatmap uses histogram fields
+	q, _ = sql.Parse("select heatmap() from disk")
+	ctx.storageExecuteCtx.Query = q.(*stmt.Query)
+	plan = newStorageExecutePlan(ctx)
+	assert.NoError(t, plan.Plan())
+	assert.Len(t, plan.fields, len(histogramFieldMetas))
+
+	// histogram fraction uses histogram fields
+	q, _ = sql.Parse("select histogram_fraction(0.5) from disk")
+	ctx.storageExecuteCtx.Query = q.(*stmt.Query)
+	plan = newStorageExecutePlan(ctx)
+	assert.NoError(t, plan.Plan())
+	assert.Len(t, plan.fields, len(histogramFieldMetas))
+
+	// histogram fraction params not equals one
+	ctx.storageExecuteCtx.Query = parseWithParams("select histogram_fraction(0.5) from disk")
+	plan = newStorageExecutePlan(ctx)
+	assert.Error(t, plan.Plan())
+
+	// histogram fraction param not float
+	ctx.storageExecuteCtx.Query = parseWithParams("select histogram_fraction(0.5) from disk", &stmt.FieldExpr{Name: "xxxx"})
+	plan = newStorageExecutePlan(ctx)
+	assert.Error(t, plan.Plan())
+}
+
+func TestStorageExecutePlan_histogramSumCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metadataDB.EXPECT().GetMetricID(gomock.Any(), "disk").Return(metric.ID(10), nil).AnyTimes()
+	metadataDB.EXPECT().GetField(gomock.Any(), gomock.Any(), metric.HistogramSum).
+		Return(field.Meta{ID: 11, Type: field.SumField}, nil).AnyTimes()
+	metadataDB.EXPECT().GetField(gomock.Any(), gomock.Any(), metric.HistogramCount).
+		Return(field.Meta{ID: 12, Type: field.SumField}, nil).AnyTimes()
+
+	cases := []struct {
+		sql    string
+		fields []field.ID
+	}{
+		{sql: "select histogram_count() from disk", fields: []field.ID{12}},
+		{sql: "select histogram_sum() from disk", fields: []field.ID{11}},
+		{sql: "select histogram_avg() from disk", fields: []field.ID{11, 12}},
+	}
+	for _, tt := range cases {
+		q, err := sql.Parse(tt.sql)
+		assert.NoError(t, err)
+		ctx := &executeContext{
+			database: db,
+			storageExecuteCtx: &flow.StorageExecuteContext{
+				Query: q.(*stmt.Query),
+			},
+		}
+		plan := newStorageExecutePlan(ctx)
+		assert.NoError(t, plan.Plan())
+		assert.Len(t, plan.fields, len(tt.fields))
+		for _, fieldID := range tt.fields {
+			aggregator, ok := plan.fields[fieldID]
+			assert.True(t, ok)
+			assert.Equal(t, map[function.FuncType]function.FuncType{function.Sum: function.Sum}, aggregator.Aggregator.Functions())
+		}
+	}
 }
 
 var (
@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagequery
+
+import (
+	"errors"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// deleteStorageExecutor represents the executor which executes series delete in storage side
+type deleteStorageExecutor struct {
+	database tsdb.Database
+	request  *stmt.Delete
+	shardIDs []models.ShardID
+}
+
+// newStorageDeleteQuery creates a series delete executor in storage side
+func newStorageDeleteQuery(
+	database tsdb.Database,
+	shardIDs []models.ShardID,
+	request *stmt.Delete,
+) storageDeleteQuery {
+	return &deleteStorageExecutor{
+		database: database,
+		request:  request,
+		shardIDs: shardIDs,
+	}
+}
+
+// Execute finds the series which match the tag filter condition, then marks those series deleted
+// within the time range of delete statement for each shard.
+func (e *deleteStorageExecutor) Execute() (result []models.ShardDeleteResult, err error) {
+	req := e.request
+	metricID, err := e.database.Metadata().MetadataDatabase().GetMetricID(req.Namespace, req.MetricName)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			// metric not exist, nothing to delete
+			return nil, nil
+		}
+		return nil, err
+	}
+	ctx := &executeContext{
+		database: e.database,
+		storageExecuteCtx: &flow.StorageExecuteContext{
+			Query: &stmt.Query{
+				Namespace:  req.Namespace,
+				MetricName: req.MetricName,
+				Condition:  req.Condition,
+				TimeRange:  req.TimeRange,
+			},
+			MetricID: metricID,
+		},
+	}
+	if req.Condition != nil {
+		// do tag filter
+		tagSearch := newTagSearchFunc(ctx)
+		if err := tagSearch.Filter(); err != nil {
+			return nil, err
+		}
+		if len(ctx.storageExecuteCtx.TagFilterResult) == 0 {
+			// filter not match, nothing to delete
+			return nil, nil
+		}
+	}
+	for _, shardID := range e.shardIDs {
+		shard, ok := e.database.GetShard(shardID)
+		if !ok {
+			continue
+		}
+		seriesIDs, err := e.searchSeriesIDs(ctx, shard)
+		if err != nil {
+			return nil, err
+		}
+		if seriesIDs == nil || seriesIDs.IsEmpty() {
+			continue
+		}
+		if err := shard.IndexDatabase().DeleteSeries(req.Namespace, metricID, seriesIDs, req.TimeRange); err != nil {
+			return nil, err
+		}
+		result = append(result, models.ShardDeleteResult{
+			ShardID: shardID,
+			Series:  int(seriesIDs.GetCardinality()),
+		})
+	}
+	return result, nil
+}
+
+// searchSeriesIDs returns the series ids which need to delete in given shard.
+func (e *deleteStorageExecutor) searchSeriesIDs(ctx *executeContext, shard tsdb.Shard) (*roaring.Bitmap, error) {
+	req := e.request
+	if req.Condition != nil {
+		seriesSearch := newSeriesSearchFunc(shard.IndexDatabase(), ctx.storageExecuteCtx.TagFilterResult, req.Condition)
+		return seriesSearch.Search()
+	}
+	// no tag filter condition, delete all series of metric, include the series without tags
+	seriesIDs, err := shard.IndexDatabase().GetSeriesIDsForMetric(req.Namespace, req.MetricName)
+	if err != nil {
+		return nil, err
+	}
+	seriesIDs.Add(series.IDWithoutTags)
+	return seriesIDs, nil
+}
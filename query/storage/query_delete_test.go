@@ -0,0 +1,216 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagequery
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestDeleteStorageQuery_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newTagSearchFunc = newTagSearch
+		newSeriesSearchFunc = newSeriesSearch
+
+		ctrl.Finish()
+	}()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadataIndex := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataIndex).AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	tagSearch := NewMockTagSearch(ctrl)
+	seriesSearch := NewMockSeriesSearch(ctrl)
+	newSeriesSearchFunc = func(filter series.Filter, filterResult map[string]*flow.TagFilterResult, condition stmt.Expr) SeriesSearch {
+		return seriesSearch
+	}
+	timeRange := timeutil.TimeRange{Start: 10, End: 100}
+
+	cases := []struct {
+		name      string
+		condition stmt.Expr
+		prepare   func()
+		wantErr   bool
+		want      []models.ShardDeleteResult
+	}{
+		{
+			name: "get metric id failure",
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric not found",
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(0), constants.ErrNotFound)
+			},
+		},
+		{
+			name:      "tag search failure",
+			condition: &stmt.EqualsExpr{},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				newTagSearchFunc = func(ctx *executeContext) TagSearch {
+					return tagSearch
+				}
+				tagSearch.EXPECT().Filter().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "tag filter not found",
+			condition: &stmt.EqualsExpr{},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				newTagSearchFunc = func(ctx *executeContext) TagSearch {
+					return tagSearch
+				}
+				tagSearch.EXPECT().Filter().Return(nil)
+			},
+		},
+		{
+			name:      "series search failure",
+			condition: &stmt.EqualsExpr{},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				newTagSearchFunc = func(ctx *executeContext) TagSearch {
+					ctx.storageExecuteCtx.TagFilterResult = map[string]*flow.TagFilterResult{"key": {}}
+					return tagSearch
+				}
+				tagSearch.EXPECT().Filter().Return(nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				seriesSearch.EXPECT().Search().Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "shard not found or series not found",
+			condition: &stmt.EqualsExpr{},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				newTagSearchFunc = func(ctx *executeContext) TagSearch {
+					ctx.storageExecuteCtx.TagFilterResult = map[string]*flow.TagFilterResult{"key": {}}
+					return tagSearch
+				}
+				tagSearch.EXPECT().Filter().Return(nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				db.EXPECT().GetShard(models.ShardID(2)).Return(nil, false)
+				seriesSearch.EXPECT().Search().Return(roaring.New(), nil)
+			},
+		},
+		{
+			name:      "delete series failure",
+			condition: &stmt.EqualsExpr{},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				newTagSearchFunc = func(ctx *executeContext) TagSearch {
+					ctx.storageExecuteCtx.TagFilterResult = map[string]*flow.TagFilterResult{"key": {}}
+					return tagSearch
+				}
+				tagSearch.EXPECT().Filter().Return(nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				seriesSearch.EXPECT().Search().Return(roaring.BitmapOf(1, 2), nil)
+				indexDB.EXPECT().DeleteSeries("ns", metric.ID(1), roaring.BitmapOf(1, 2), timeRange).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "delete series by tag filter",
+			condition: &stmt.EqualsExpr{},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				newTagSearchFunc = func(ctx *executeContext) TagSearch {
+					ctx.storageExecuteCtx.TagFilterResult = map[string]*flow.TagFilterResult{"key": {}}
+					return tagSearch
+				}
+				tagSearch.EXPECT().Filter().Return(nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				db.EXPECT().GetShard(models.ShardID(2)).Return(shard, true)
+				seriesSearch.EXPECT().Search().Return(roaring.BitmapOf(1, 2), nil).Times(2)
+				indexDB.EXPECT().DeleteSeries("ns", metric.ID(1), roaring.BitmapOf(1, 2), timeRange).Return(nil).Times(2)
+			},
+			want: []models.ShardDeleteResult{{ShardID: 1, Series: 2}, {ShardID: 2, Series: 2}},
+		},
+		{
+			name: "get metric series failure",
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete all series of metric",
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				db.EXPECT().GetShard(models.ShardID(2)).Return(nil, false)
+				indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexDB.EXPECT().DeleteSeries("ns", metric.ID(1), roaring.BitmapOf(series.IDWithoutTags, 1), timeRange).Return(nil)
+			},
+			want: []models.ShardDeleteResult{{ShardID: 1, Series: 2}},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newTagSearchFunc = newTagSearch
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			exec := newStorageDeleteQuery(db, []models.ShardID{1, 2}, &stmt.Delete{
+				Namespace:  "ns",
+				MetricName: "cpu",
+				Condition:  tt.condition,
+				TimeRange:  timeRange,
+			})
+			result, err := exec.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sort"
 	"sync"
 
 	xxhash "github.com/cespare/xxhash/v2"
@@ -67,6 +68,8 @@ type storageQueryFlow struct {
 	tagValuesMap               []map[uint32]string // tag value id=> tag value for each group by tag key
 	tagValues                  []string
 	waitingForCollectTagValues atomic.Int32
+	exemplars                  map[string][]*models.Exemplar    // grouping key => exemplars
+	stringValues               map[string]models.StringValueSet // grouping key => distinct values of string fields
 	signal                     chan struct{}
 
 	mux       sync.Mutex
@@ -96,7 +99,8 @@ func NewStorageQueryFlow(
 func (qf *storageQueryFlow) Prepare() {
 	aggregatorSpecs := qf.storageExecuteCtx.AggregatorSpecs
 	qf.reduceAgg = aggregation.NewGroupingAggregator(qf.storageExecuteCtx.Query.Interval,
-		qf.storageExecuteCtx.Query.IntervalRatio, qf.storageExecuteCtx.Query.TimeRange, aggregatorSpecs)
+		qf.storageExecuteCtx.Query.IntervalRatio, qf.storageExecuteCtx.Query.TimeRange,
+		qf.storageExecuteCtx.Query.Location(), aggregatorSpecs)
 	qf.aggregatorSpecs = make([]*protoCommonV1.AggregatorSpec, len(aggregatorSpecs))
 	for idx, spec := range aggregatorSpecs {
 		qf.aggregatorSpecs[idx] = &protoCommonV1.AggregatorSpec{
@@ -149,6 +153,56 @@ func (qf *storageQueryFlow) ReduceTagValues(tagKeyIndex int, tagValues map[uint3
 	}
 }
 
+// ReduceExemplars reduces the exemplars of grouped series
+func (qf *storageQueryFlow) ReduceExemplars(groupingKey string, exemplars []*models.Exemplar) {
+	qf.mux.Lock()
+	defer qf.mux.Unlock()
+	if qf.exemplars == nil {
+		qf.exemplars = make(map[string][]*models.Exemplar)
+	}
+	qf.exemplars[groupingKey] = append(qf.exemplars[groupingKey], exemplars...)
+}
+
+// getExemplars returns the encoding exemplars of grouped series
+func (qf *storageQueryFlow) getExemplars(groupingKey string) []byte {
+	qf.mux.Lock()
+	defer qf.mux.Unlock()
+	exemplars, ok := qf.exemplars[groupingKey]
+	if !ok {
+		return nil
+	}
+	sort.Slice(exemplars, func(i, j int) bool {
+		return exemplars[i].Timestamp < exemplars[j].Timestamp
+	})
+	return encoding.JSONMarshal(exemplars)
+}
+
+// ReduceStringValues reduces the distinct values of string fields of grouped series
+func (qf *storageQueryFlow) ReduceStringValues(groupingKey string, values []*models.StringValues) {
+	qf.mux.Lock()
+	defer qf.mux.Unlock()
+	if qf.stringValues == nil {
+		qf.stringValues = make(map[string]models.StringValueSet)
+	}
+	valueSet, ok := qf.stringValues[groupingKey]
+	if !ok {
+		valueSet = make(models.StringValueSet)
+		qf.stringValues[groupingKey] = valueSet
+	}
+	valueSet.AddAll(values)
+}
+
+// getStringValues returns the encoding distinct values of string fields of grouped series
+func (qf *storageQueryFlow) getStringValues(groupingKey string) []byte {
+	qf.mux.Lock()
+	defer qf.mux.Unlock()
+	valueSet, ok := qf.stringValues[groupingKey]
+	if !ok {
+		return nil
+	}
+	return encoding.JSONMarshal(valueSet.Values())
+}
+
 func (qf *storageQueryFlow) getTagValues(tags string) string {
 	qf.mux.Lock()
 	defer qf.mux.Unlock()
@@ -292,13 +346,16 @@ func (qf *storageQueryFlow) makeTimeSeriesList() []*protoCommonV1.TimeSeries {
 		}
 
 		if len(fields) > 0 {
+			groupingKey := groupedSeriesItr.Tags()
 			tags := ""
 			if hasGroupBy {
-				tags = qf.getTagValues(groupedSeriesItr.Tags())
+				tags = qf.getTagValues(groupingKey)
 			}
 			timeSeriesList = append(timeSeriesList, &protoCommonV1.TimeSeries{
-				Tags:   tags,
-				Fields: fields,
+				Tags:         tags,
+				Fields:       fields,
+				Exemplars:    qf.getExemplars(groupingKey),
+				StringValues: qf.getStringValues(groupingKey),
 			})
 		}
 	}
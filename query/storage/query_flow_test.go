@@ -38,6 +38,7 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/rpc"
@@ -440,6 +441,68 @@ func TestStorageQueryFlow_Reduce(t *testing.T) {
 	qf.Reduce(nil)
 }
 
+func TestStorageQueryFlow_ReduceExemplars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reduceAgg := aggregation.NewMockGroupingAggregator(ctrl)
+	qf := &storageQueryFlow{
+		reduceAgg:         reduceAgg,
+		storageExecuteCtx: &flow.StorageExecuteContext{Query: &stmt.Query{}},
+	}
+	qf.ReduceExemplars("", []*models.Exemplar{{Timestamp: 20, TraceID: "02"}})
+	qf.ReduceExemplars("", []*models.Exemplar{{Timestamp: 10, TraceID: "01"}})
+
+	groupIt := series.NewMockGroupedIterator(ctrl)
+	it := series.NewMockIterator(ctrl)
+	groupIt.EXPECT().HasNext().Return(true)
+	groupIt.EXPECT().Next().Return(it)
+	groupIt.EXPECT().Tags().Return("")
+	it.EXPECT().MarshalBinary().Return([]byte{1, 2, 3}, nil)
+	it.EXPECT().FieldName().Return(field.Name("f1"))
+	groupIt.EXPECT().HasNext().Return(false)
+	reduceAgg.EXPECT().ResultSet().Return([]series.GroupedIterator{groupIt})
+	timeSeriesList := qf.makeTimeSeriesList()
+	assert.Len(t, timeSeriesList, 1)
+	var exemplars []*models.Exemplar
+	assert.NoError(t, encoding.JSONUnmarshal(timeSeriesList[0].Exemplars, &exemplars))
+	assert.Equal(t, []*models.Exemplar{{Timestamp: 10, TraceID: "01"}, {Timestamp: 20, TraceID: "02"}}, exemplars)
+}
+
+func TestStorageQueryFlow_ReduceStringValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reduceAgg := aggregation.NewMockGroupingAggregator(ctrl)
+	qf := &storageQueryFlow{
+		reduceAgg:         reduceAgg,
+		storageExecuteCtx: &flow.StorageExecuteContext{Query: &stmt.Query{}},
+	}
+	qf.ReduceStringValues("", []*models.StringValues{{Field: "f1", Timestamps: []int64{10}, Values: [][]string{{"v2"}}}})
+	qf.ReduceStringValues("", []*models.StringValues{{Field: "f1", Timestamps: []int64{10}, Values: [][]string{{"v1"}}}})
+	assert.Nil(t, qf.getStringValues("a"))
+
+	groupIt := series.NewMockGroupedIterator(ctrl)
+	it := series.NewMockIterator(ctrl)
+	groupIt.EXPECT().HasNext().Return(true)
+	groupIt.EXPECT().Next().Return(it)
+	groupIt.EXPECT().Tags().Return("")
+	it.EXPECT().MarshalBinary().Return([]byte{1, 2, 3}, nil)
+	it.EXPECT().FieldName().Return(field.Name("f1"))
+	groupIt.EXPECT().HasNext().Return(false)
+	reduceAgg.EXPECT().ResultSet().Return([]series.GroupedIterator{groupIt})
+	timeSeriesList := qf.makeTimeSeriesList()
+	assert.Len(t, timeSeriesList, 1)
+	var values []*models.StringValues
+	assert.NoError(t, encoding.JSONUnmarshal(timeSeriesList[0].StringValues, &values))
+	assert.Equal(t, []*models.StringValues{{
+		Field:      "f1",
+		Timestamps: []int64{10},
+		Values:     [][]string{{"v1", "v2"}},
+		Counts:     [][]uint32{{1, 1}},
+	}}, values)
+}
+
 func TestStorageQueryFlow_isCompleted(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.TODO())
 	qf := &storageQueryFlow{ctx: ctx, leafNode: &models.Leaf{}}
@@ -18,7 +18,12 @@
 package storagequery
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+
+	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
@@ -79,6 +84,12 @@ func (e *metadataStorageExecutor) Execute() (result []string, err error) {
 		}
 		result = append(result, string(encoding.JSONMarshal(fields)))
 		return result, nil
+	case stmt.MetricCardinality:
+		return e.metricCardinality(limit)
+	case stmt.TagKeyCardinality:
+		return e.tagKeyCardinality()
+	case stmt.TagValueCardinality:
+		return e.tagValueCardinality(limit)
 	case stmt.TagValue:
 		tagKeyID, err := e.database.Metadata().MetadataDatabase().GetTagKeyID(req.Namespace, req.MetricName, req.TagKey)
 		if err != nil {
@@ -155,3 +166,113 @@ func (e *metadataStorageExecutor) Execute() (result []string, err error) {
 	}
 	return result, nil
 }
+
+// metricCardinality returns the number of series for each metric under namespace in current node.
+func (e *metadataStorageExecutor) metricCardinality(limit int) ([]string, error) {
+	req := e.request
+	metricNames, err := e.database.Metadata().MetadataDatabase().SuggestMetrics(req.Namespace, req.Prefix, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	shards := e.getShards()
+	items := make([]models.CardinalityItem, 0, len(metricNames))
+	for _, metricName := range metricNames {
+		var count uint64
+		for _, shard := range shards {
+			seriesIDs, err := shard.IndexDatabase().GetSeriesIDsForMetric(req.Namespace, metricName)
+			if err != nil {
+				if errors.Is(err, constants.ErrNotFound) {
+					continue
+				}
+				return nil, err
+			}
+			count += seriesIDs.GetCardinality()
+		}
+		items = append(items, models.CardinalityItem{Name: metricName, Count: count})
+	}
+	return marshalCardinality(items, limit), nil
+}
+
+// tagKeyCardinality returns the number of distinct tag values for each tag key of metric in current node.
+func (e *metadataStorageExecutor) tagKeyCardinality() ([]string, error) {
+	req := e.request
+	tagKeys, err := e.database.Metadata().MetadataDatabase().GetAllTagKeys(req.Namespace, req.MetricName)
+	if err != nil {
+		return nil, err
+	}
+	shards := e.getShards()
+	items := make([]models.CardinalityItem, 0, len(tagKeys))
+	for _, tagKey := range tagKeys {
+		// tag value ids are shared by all shards in current node, union them for distinct count
+		tagValueIDs := roaring.New()
+		for _, shard := range shards {
+			counts, err := shard.IndexDatabase().GetSeriesCountByTagValue(tagKey.ID)
+			if err != nil {
+				return nil, err
+			}
+			for tagValueID := range counts {
+				tagValueIDs.Add(tagValueID)
+			}
+		}
+		items = append(items, models.CardinalityItem{Name: tagKey.Key, Count: tagValueIDs.GetCardinality()})
+	}
+	// returns all tag keys, because the number of tag keys is limited
+	return marshalCardinality(items, len(items)), nil
+}
+
+// tagValueCardinality returns the top-N tag values by the number of series for spec tag key in current node.
+func (e *metadataStorageExecutor) tagValueCardinality(limit int) ([]string, error) {
+	req := e.request
+	tagKeyID, err := e.database.Metadata().MetadataDatabase().GetTagKeyID(req.Namespace, req.MetricName, req.TagKey)
+	if err != nil {
+		return nil, err
+	}
+	seriesCounts := make(map[uint32]uint64)
+	tagValueIDs := roaring.New()
+	for _, shard := range e.getShards() {
+		counts, err := shard.IndexDatabase().GetSeriesCountByTagValue(tagKeyID)
+		if err != nil {
+			return nil, err
+		}
+		for tagValueID, count := range counts {
+			seriesCounts[tagValueID] += uint64(count)
+			tagValueIDs.Add(tagValueID)
+		}
+	}
+	tagValues := make(map[uint32]string)
+	if !tagValueIDs.IsEmpty() {
+		if err := e.database.Metadata().TagMetadata().CollectTagValues(tagKeyID, tagValueIDs, tagValues); err != nil {
+			return nil, err
+		}
+	}
+	items := make([]models.CardinalityItem, 0, len(tagValues))
+	for tagValueID, tagValue := range tagValues {
+		items = append(items, models.CardinalityItem{Name: tagValue, Count: seriesCounts[tagValueID]})
+	}
+	return marshalCardinality(items, limit), nil
+}
+
+// getShards returns the shards of current node by given query shard id list.
+func (e *metadataStorageExecutor) getShards() (shards []tsdb.Shard) {
+	for _, shardID := range e.shardIDs {
+		shard, ok := e.database.GetShard(shardID)
+		if ok {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+// marshalCardinality sorts cardinality items by count desc, keeps top-N items then marshals them as json string.
+func marshalCardinality(items []models.CardinalityItem, limit int) []string {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count == items[j].Count {
+			return items[i].Name < items[j].Name
+		}
+		return items[i].Count > items[j].Count
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return []string{string(encoding.JSONMarshal(items))}
+}
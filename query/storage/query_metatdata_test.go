@@ -25,6 +25,7 @@ import (
 	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -224,3 +225,102 @@ func TestMetadataStorageQuery_Execute_With_Tag_Condition(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 }
+
+func TestMetadataStorageQuery_Execute_Cardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadataIndex := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataIndex).AnyTimes()
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	metadata.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true).AnyTimes()
+	db.EXPECT().GetShard(models.ShardID(2)).Return(nil, false).AnyTimes()
+	shardIDs := []models.ShardID{1, 2}
+
+	// case 1: suggest metrics err
+	exec := newStorageMetadataQuery(db, shardIDs, &stmt.MetricMetadata{Type: stmt.MetricCardinality, Limit: 1})
+	metadataIndex.EXPECT().SuggestMetrics(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	result, err := exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 2: get series ids err
+	metadataIndex.EXPECT().SuggestMetrics(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"a", "b", "c"}, nil)
+	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), "a").Return(nil, fmt.Errorf("err"))
+	result, err = exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 3: metric cardinality, keep top-N
+	metadataIndex.EXPECT().SuggestMetrics(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"a", "b", "c"}, nil)
+	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), "a").Return(roaring.BitmapOf(1, 2), nil)
+	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), "b").Return(roaring.BitmapOf(1, 2, 3), nil)
+	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), "c").Return(nil, constants.ErrNotFound)
+	result, err = exec.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{string(encoding.JSONMarshal([]models.CardinalityItem{{Name: "b", Count: 3}}))}, result)
+
+	// case 4: get tag keys err
+	exec = newStorageMetadataQuery(db, shardIDs, &stmt.MetricMetadata{Type: stmt.TagKeyCardinality})
+	metadataIndex.EXPECT().GetAllTagKeys(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	result, err = exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 5: get series count by tag value err
+	metadataIndex.EXPECT().GetAllTagKeys(gomock.Any(), gomock.Any()).
+		Return(tag.Metas{{Key: "host", ID: 1}, {Key: "zone", ID: 2}}, nil).Times(2)
+	indexDB.EXPECT().GetSeriesCountByTagValue(tag.KeyID(1)).Return(nil, fmt.Errorf("err"))
+	result, err = exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 6: tag key cardinality
+	indexDB.EXPECT().GetSeriesCountByTagValue(tag.KeyID(1)).Return(map[uint32]int{1: 2, 2: 1, 3: 1}, nil)
+	indexDB.EXPECT().GetSeriesCountByTagValue(tag.KeyID(2)).Return(map[uint32]int{1: 4}, nil)
+	result, err = exec.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{string(encoding.JSONMarshal([]models.CardinalityItem{
+		{Name: "host", Count: 3}, {Name: "zone", Count: 1},
+	}))}, result)
+
+	// case 7: get tag key id err
+	exec = newStorageMetadataQuery(db, shardIDs, &stmt.MetricMetadata{Type: stmt.TagValueCardinality, TagKey: "host", Limit: 2})
+	metadataIndex.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "host").Return(tag.EmptyTagKeyID, fmt.Errorf("err"))
+	result, err = exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 8: get series count by tag value err
+	metadataIndex.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "host").Return(tag.KeyID(1), nil).AnyTimes()
+	indexDB.EXPECT().GetSeriesCountByTagValue(tag.KeyID(1)).Return(nil, fmt.Errorf("err"))
+	result, err = exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 9: collect tag values err
+	indexDB.EXPECT().GetSeriesCountByTagValue(tag.KeyID(1)).Return(map[uint32]int{1: 2, 2: 1, 3: 3}, nil).Times(2)
+	tagMeta.EXPECT().CollectTagValues(tag.KeyID(1), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	result, err = exec.Execute()
+	assert.Error(t, err)
+	assert.Empty(t, result)
+	// case 10: tag value cardinality, keep top-N
+	tagMeta.EXPECT().CollectTagValues(tag.KeyID(1), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ tag.KeyID, _ *roaring.Bitmap, tagValues map[uint32]string) error {
+			tagValues[1] = "a"
+			tagValues[2] = "b"
+			tagValues[3] = "c"
+			return nil
+		})
+	result, err = exec.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{string(encoding.JSONMarshal([]models.CardinalityItem{
+		{Name: "c", Count: 3}, {Name: "a", Count: 2},
+	}))}, result)
+	// case 11: tag value not found
+	indexDB.EXPECT().GetSeriesCountByTagValue(tag.KeyID(1)).Return(map[uint32]int{}, nil)
+	result, err = exec.Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[]"}, result)
+}
@@ -38,6 +38,7 @@ var (
 	newFamilyFilterTaskFunc        = newFamilyFilterTask
 	newGroupingContextFindTaskFunc = newGroupingContextFindTask
 	newCollectTagValuesTaskFunc    = newCollectTagValuesTask
+	newExemplarLoadTaskFunc        = newExemplarLoadTask
 )
 
 var (
@@ -215,6 +216,15 @@ func (e *storageExecutor) executeGroupBy(shardExecuteContext *flow.ShardExecuteC
 					}
 				}
 			})
+			if e.ctx.storageExecuteCtx.Query.WithExemplars {
+				e.queryFlow.Submit(flow.ScannerStage, func() {
+					// 4.load exemplars of grouped series
+					t := newExemplarLoadTaskFunc(shard, e.queryFlow, dataLoadCtx)
+					if err := t.Run(); err != nil {
+						e.queryFlow.Complete(err)
+					}
+				})
+			}
 		})
 	}
 }
@@ -52,6 +52,12 @@ func (m *mockQueryFlow) Submit(_ flow.Stage, task func()) {
 func (m *mockQueryFlow) ReduceTagValues(_ int, _ map[uint32]string) {
 }
 
+func (m *mockQueryFlow) ReduceExemplars(_ string, _ []*models.Exemplar) {
+}
+
+func (m *mockQueryFlow) ReduceStringValues(_ string, _ []*models.StringValues) {
+}
+
 func (m *mockQueryFlow) Prepare() {
 }
 
@@ -392,6 +398,34 @@ func TestStorageExecute_Execute(t *testing.T) {
 				assert.Equal(t, io.ErrClosedPipe, queryFlow.err)
 			},
 		},
+		{
+			name: "exemplar load failure",
+			sql: "select f from cpu where host='1.1.1.1' and time>'20190729 11:00:00' and time<'20190729 12:00:00'" +
+				" group by node with exemplars",
+			prepare: func() {
+				mockGroupData(ctrl)
+				task := flow.NewMockQueryTask(ctrl)
+				task.EXPECT().Run().Return(nil).AnyTimes()
+				newBuildGroupTaskFunc = func(shard tsdb.Shard,
+					loadCtx *flow.DataLoadContext) flow.QueryTask {
+					loadCtx.GroupingSeriesAgg = []*flow.GroupingSeriesAgg{nil}
+					return task
+				}
+				newDataLoadTaskFunc = func(shard tsdb.Shard, queryFlow flow.StorageQueryFlow,
+					dataLoadCtx *flow.DataLoadContext, segmentIdx int, segmentCtx *flow.TimeSegmentResultSet) flow.QueryTask {
+					return task
+				}
+				exemplarTask := flow.NewMockQueryTask(ctrl)
+				exemplarTask.EXPECT().Run().Return(io.ErrClosedPipe)
+				newExemplarLoadTaskFunc = func(shard tsdb.Shard, queryFlow flow.StorageQueryFlow,
+					dataLoadCtx *flow.DataLoadContext) flow.QueryTask {
+					return exemplarTask
+				}
+			},
+			assert: func() {
+				assert.Equal(t, io.ErrClosedPipe, queryFlow.err)
+			},
+		},
 		{
 			name: "no group data",
 			sql:  "select f from cpu where host='1.1.1.1' and time>'20190729 11:00:00' and time<'20190729 12:00:00' group by node",
@@ -431,6 +465,7 @@ func TestStorageExecute_Execute(t *testing.T) {
 				newGroupingContextFindTaskFunc = newGroupingContextFindTask
 				newBuildGroupTaskFunc = newBuildGroupTask
 				newDataLoadTaskFunc = newDataLoadTask
+				newExemplarLoadTaskFunc = newExemplarLoadTask
 				queryFlow.err = nil
 				ctx.shards = nil
 			}()
@@ -0,0 +1,142 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagequery
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// schemaStorageExecutor represents the executor which executes metric schema management in storage side.
+type schemaStorageExecutor struct {
+	database tsdb.Database
+	request  *stmt.MetricSchema
+	shardIDs []models.ShardID
+}
+
+// newStorageSchemaQuery creates a metric schema management executor in storage side.
+func newStorageSchemaQuery(
+	database tsdb.Database,
+	shardIDs []models.ShardID,
+	request *stmt.MetricSchema,
+) storageSchemaQuery {
+	return &schemaStorageExecutor{
+		database: database,
+		request:  request,
+		shardIDs: shardIDs,
+	}
+}
+
+// Execute executes metric schema management statement, returns the number of dropped series for each shard
+// if drop metric/namespace.
+func (e *schemaStorageExecutor) Execute() (result []models.ShardDeleteResult, err error) {
+	req := e.request
+	metadata := e.database.Metadata().MetadataDatabase()
+	switch req.Type {
+	case stmt.RenameFieldType:
+		err = metadata.RenameField(req.Namespace, req.MetricName, field.Name(req.FieldName), field.Name(req.NewFieldName))
+	case stmt.DropFieldType:
+		err = metadata.DropField(req.Namespace, req.MetricName, field.Name(req.FieldName))
+	case stmt.AlterFieldType:
+		fieldType := field.ParseType(req.FieldType)
+		if fieldType == field.Unknown {
+			return nil, series.ErrFieldTypeUnspecified
+		}
+		_, err = metadata.ChangeFieldType(req.Namespace, req.MetricName, field.Name(req.FieldName), fieldType)
+	case stmt.DropMetricType:
+		result, err = e.dropMetric(req.Namespace, req.MetricName, result)
+		if err == nil {
+			err = metadata.DropMetric(req.Namespace, req.MetricName)
+		}
+	case stmt.DropNamespaceType:
+		var metricNames []string
+		metricNames, err = metadata.SuggestMetrics(req.Namespace, "", math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		for _, metricName := range metricNames {
+			result, err = e.dropMetric(req.Namespace, metricName, result)
+			if err != nil && !errors.Is(err, constants.ErrNotFound) {
+				return nil, err
+			}
+		}
+		err = metadata.DropNamespace(req.Namespace)
+	default:
+		return nil, fmt.Errorf("unknown metric schema statement type: %d", req.Type)
+	}
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			// metric/field maybe not exist in current node, nothing to do
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// dropMetric drops all series of metric for each shard, merges the number of dropped series into result.
+func (e *schemaStorageExecutor) dropMetric(
+	namespace, metricName string,
+	result []models.ShardDeleteResult,
+) ([]models.ShardDeleteResult, error) {
+	metadata := e.database.Metadata().MetadataDatabase()
+	metricID, err := metadata.GetMetricID(namespace, metricName)
+	if err != nil {
+		return result, err
+	}
+	tagKeys, err := metadata.GetAllTagKeys(namespace, metricName)
+	if err != nil && !errors.Is(err, constants.ErrNotFound) {
+		return result, err
+	}
+	tagKeyIDs := make([]tag.KeyID, len(tagKeys))
+	for idx, tagKey := range tagKeys {
+		tagKeyIDs[idx] = tagKey.ID
+	}
+	for _, shardID := range e.shardIDs {
+		shard, ok := e.database.GetShard(shardID)
+		if !ok {
+			continue
+		}
+		dropped, err := shard.IndexDatabase().DropMetric(namespace, metricID, tagKeyIDs)
+		if err != nil {
+			return result, err
+		}
+		result = addShardDeleteResult(result, shardID, dropped)
+	}
+	return result, nil
+}
+
+// addShardDeleteResult accumulates the number of dropped series for given shard.
+func addShardDeleteResult(result []models.ShardDeleteResult, shardID models.ShardID, count int) []models.ShardDeleteResult {
+	for idx := range result {
+		if result[idx].ShardID == shardID {
+			result[idx].Series += count
+			return result
+		}
+	}
+	return append(result, models.ShardDeleteResult{ShardID: shardID, Series: count})
+}
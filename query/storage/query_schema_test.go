@@ -0,0 +1,196 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storagequery
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestSchemaStorageQuery_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadataIndex := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataIndex).AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+
+	cases := []struct {
+		name    string
+		request *stmt.MetricSchema
+		prepare func()
+		wantErr bool
+		want    []models.ShardDeleteResult
+	}{
+		{
+			name:    "unknown schema statement type",
+			request: &stmt.MetricSchema{},
+			wantErr: true,
+		},
+		{
+			name:    "rename field",
+			request: &stmt.MetricSchema{Type: stmt.RenameFieldType, Namespace: "ns", MetricName: "cpu", FieldName: "f", NewFieldName: "f1"},
+			prepare: func() {
+				metadataIndex.EXPECT().RenameField("ns", "cpu", field.Name("f"), field.Name("f1")).Return(nil)
+			},
+		},
+		{
+			name:    "rename field failure",
+			request: &stmt.MetricSchema{Type: stmt.RenameFieldType, Namespace: "ns", MetricName: "cpu", FieldName: "f", NewFieldName: "f1"},
+			prepare: func() {
+				metadataIndex.EXPECT().RenameField(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(series.ErrFieldExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "drop field, field not found",
+			request: &stmt.MetricSchema{Type: stmt.DropFieldType, Namespace: "ns", MetricName: "cpu", FieldName: "f"},
+			prepare: func() {
+				metadataIndex.EXPECT().DropField("ns", "cpu", field.Name("f")).Return(constants.ErrNotFound)
+			},
+		},
+		{
+			name:    "alter field type, unknown type",
+			request: &stmt.MetricSchema{Type: stmt.AlterFieldType, Namespace: "ns", MetricName: "cpu", FieldName: "f", FieldType: "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "alter field type",
+			request: &stmt.MetricSchema{Type: stmt.AlterFieldType, Namespace: "ns", MetricName: "cpu", FieldName: "f", FieldType: "sum"},
+			prepare: func() {
+				metadataIndex.EXPECT().ChangeFieldType("ns", "cpu", field.Name("f"), field.SumField).Return(field.ID(2), nil)
+			},
+		},
+		{
+			name:    "drop metric, get metric id failure",
+			request: &stmt.MetricSchema{Type: stmt.DropMetricType, Namespace: "ns", MetricName: "cpu"},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "drop metric, metric not found",
+			request: &stmt.MetricSchema{Type: stmt.DropMetricType, Namespace: "ns", MetricName: "cpu"},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), constants.ErrNotFound)
+			},
+		},
+		{
+			name:    "drop metric, get tag keys failure",
+			request: &stmt.MetricSchema{Type: stmt.DropMetricType, Namespace: "ns", MetricName: "cpu"},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metadataIndex.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "drop metric, drop series failure",
+			request: &stmt.MetricSchema{Type: stmt.DropMetricType, Namespace: "ns", MetricName: "cpu"},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metadataIndex.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				indexDB.EXPECT().DropMetric("ns", metric.ID(10), []tag.KeyID{}).Return(0, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "drop metric successfully",
+			request: &stmt.MetricSchema{Type: stmt.DropMetricType, Namespace: "ns", MetricName: "cpu"},
+			prepare: func() {
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metadataIndex.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{Key: "host", ID: 3}}, nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				db.EXPECT().GetShard(models.ShardID(2)).Return(nil, false)
+				indexDB.EXPECT().DropMetric("ns", metric.ID(10), []tag.KeyID{3}).Return(5, nil)
+				metadataIndex.EXPECT().DropMetric("ns", "cpu").Return(nil)
+			},
+			want: []models.ShardDeleteResult{{ShardID: 1, Series: 5}},
+		},
+		{
+			name:    "drop namespace, suggest metrics failure",
+			request: &stmt.MetricSchema{Type: stmt.DropNamespaceType, Namespace: "ns"},
+			prepare: func() {
+				metadataIndex.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "drop namespace, drop metric failure",
+			request: &stmt.MetricSchema{Type: stmt.DropNamespaceType, Namespace: "ns"},
+			prepare: func() {
+				metadataIndex.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu"}, nil)
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "drop namespace successfully",
+			request: &stmt.MetricSchema{Type: stmt.DropNamespaceType, Namespace: "ns"},
+			prepare: func() {
+				metadataIndex.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu", "mem", "disk"}, nil)
+				metadataIndex.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metadataIndex.EXPECT().GetMetricID("ns", "mem").Return(metric.ID(11), nil)
+				metadataIndex.EXPECT().GetMetricID("ns", "disk").Return(metric.ID(0), constants.ErrNotFound)
+				metadataIndex.EXPECT().GetAllTagKeys("ns", gomock.Any()).Return(nil, constants.ErrNotFound).Times(2)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true).Times(2)
+				db.EXPECT().GetShard(models.ShardID(2)).Return(nil, false).Times(2)
+				indexDB.EXPECT().DropMetric("ns", metric.ID(10), gomock.Any()).Return(5, nil)
+				indexDB.EXPECT().DropMetric("ns", metric.ID(11), gomock.Any()).Return(3, nil)
+				metadataIndex.EXPECT().DropNamespace("ns").Return(nil)
+			},
+			want: []models.ShardDeleteResult{{ShardID: 1, Series: 8}},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			exec := newStorageSchemaQuery(db, []models.ShardID{1, 2}, tt.request)
+			rs, err := exec.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			assert.Equal(t, tt.want, rs)
+		})
+	}
+}
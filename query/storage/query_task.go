@@ -18,6 +18,7 @@
 package storagequery
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -26,11 +27,14 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/tsdb"
 	"github.com/lindb/lindb/tsdb/metadb"
@@ -191,10 +195,19 @@ func (t *seriesIDsSearchTask) Run() (err error) {
 			seriesIDs.Add(series.IDWithoutTags)
 		}
 	}
-	if err == nil && seriesIDs != nil {
-		t.shardExecuteContext.SeriesIDsAfterFiltering.Or(seriesIDs)
+	if err != nil || seriesIDs == nil {
+		return
 	}
-	return
+	// filter out the series which are deleted within whole query time range
+	tombstones, err := t.shard.IndexDatabase().GetTombstones(t.shardExecuteContext.StorageExecuteCtx.MetricID)
+	if err != nil {
+		return err
+	}
+	if len(tombstones) > 0 {
+		seriesIDs = roaring.AndNot(seriesIDs, tombstones.GetDeletedSeriesIDs(queryStmt.TimeRange))
+	}
+	t.shardExecuteContext.SeriesIDsAfterFiltering.Or(seriesIDs)
+	return nil
 }
 
 // AfterRun invokes after series ids search, collects the series ids search stats
@@ -375,9 +388,24 @@ func (t *dataLoadTask) Run() error {
 	if explain {
 		t.costs = make([]time.Duration, len(t.segmentCtx.FilterRS))
 	}
-	queryIntervalRatio := t.dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.Query.IntervalRatio
+	// get deleted time slots of the series which are deleted partially in current family
+	deletedSlots, err := t.getDeletedSlots()
+	if err != nil {
+		return err
+	}
+	storageExecuteCtx := t.dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx
+	queryIntervalRatio := storageExecuteCtx.Query.IntervalRatio
 	seriesIDs := t.dataLoadCtx.ShardExecuteCtx.SeriesIDsAfterFiltering // after group result
-	targetSlotRange := t.dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.CalcTargetSlotRange(t.segmentCtx.FamilyTime)
+	targetSlotRange := storageExecuteCtx.CalcTargetSlotRange(t.segmentCtx.FamilyTime)
+	alignSlots := storageExecuteCtx.CalcAlignSlots(t.segmentCtx.FamilyTime)
+	timestamp := func(pos int) int64 {
+		return t.calcTimestamp(targetSlotRange, pos)
+	}
+	// median/count distinct of numeric field are calculated by the values of all data points
+	collectNumeric := make([]bool, len(storageExecuteCtx.Fields))
+	for fieldIdx, spec := range storageExecuteCtx.DownSamplingSpecs {
+		collectNumeric[fieldIdx] = storageExecuteCtx.Fields[fieldIdx].Type != field.StringField && isValueCollected(spec)
+	}
 
 	for idx, rs := range t.segmentCtx.FilterRS {
 		// double filtering, maybe some series ids be filtered out when do grouping.
@@ -396,22 +424,105 @@ func (t *dataLoadTask) Run() error {
 			continue
 		}
 
+		// prepareGetter filters the deleted values and aligns the time slots to session time zone.
+		prepareGetter := func(slotRange timeutil.SlotRange, lowSeriesIdx uint16,
+			getter encoding.TSDValueGetter) (timeutil.SlotRange, encoding.TSDValueGetter) {
+			if len(deletedSlots) > 0 {
+				getter = t.filterDeletedValues(lowSeriesIdx, deletedSlots, getter)
+			}
+			if alignSlots > 0 {
+				// shift source slots, down sampling aggregates them into the time slots aligned to session time zone
+				slotRange = timeutil.SlotRange{Start: slotRange.Start + alignSlots, End: slotRange.End + alignSlots}
+				getter = &alignedValueGetter{getter: getter, alignSlots: alignSlots}
+			}
+			return slotRange, getter
+		}
+		// prepareCollector wraps emit function, which collects the values of field for the functions
+		// calculated by the values of all data points(e.g. count distinct, median).
+		prepareCollector := func(lowSeriesIdx uint16, fieldIdx int,
+			emitValue func(pos int, value float64)) func(pos int, value float64) {
+			switch {
+			case storageExecuteCtx.Fields[fieldIdx].Type == field.StringField:
+				// collects the distinct values of string field for each time slot
+				return func(pos int, value float64) {
+					emitValue(pos, value)
+					t.dataLoadCtx.CollectStringValue(lowSeriesIdx, fieldIdx, timestamp(pos), value)
+				}
+			case collectNumeric[fieldIdx]:
+				return func(pos int, value float64) {
+					emitValue(pos, value)
+					t.dataLoadCtx.CollectNumericValue(lowSeriesIdx, fieldIdx, timestamp(pos), value)
+				}
+			default:
+				return emitValue
+			}
+		}
+		// prepareAdjacent wraps emit function, which keeps the down sampling values of series
+		// for calculating the agg types by adjacent data points(e.g. increase of counter).
+		prepareAdjacent := func(fieldIdx int,
+			emitValue func(pos int, value float64)) (*aggregation.AdjacentAggregator, func(pos int, value float64)) {
+			adjacentAgg := t.dataLoadCtx.GetAdjacentAggregator(fieldIdx)
+			if adjacentAgg == nil {
+				return nil, emitValue
+			}
+			adjacentAgg.Prepare(int(targetSlotRange.End-targetSlotRange.Start) + 1)
+			return adjacentAgg, func(pos int, value float64) {
+				emitValue(pos, value)
+				adjacentAgg.AggregateBySlot(pos, value)
+			}
+		}
 		// load field series data by series ids
 		t.dataLoadCtx.Decoder = encoding.GetTSDDecoder()
 		t.dataLoadCtx.DownSampling = func(slotRange timeutil.SlotRange, lowSeriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
-			var agg aggregation.FieldAggregator
 			seriesAggregator := t.dataLoadCtx.GetSeriesAggregator(lowSeriesIdx, fieldIdx)
-
-			var ok bool
-			agg, ok = seriesAggregator.GetAggregator(t.segmentCtx.FamilyTime)
+			agg, ok := seriesAggregator.GetAggregator(t.segmentCtx.FamilyTime)
 			if !ok {
 				return
 			}
+			slotRange, getter = prepareGetter(slotRange, lowSeriesIdx, getter)
+			emitValue := prepareCollector(lowSeriesIdx, fieldIdx, agg.AggregateBySlot)
+			adjacentAgg, emitValue := prepareAdjacent(fieldIdx, emitValue)
 			aggregation.DownSampling(
 				slotRange, targetSlotRange, uint16(queryIntervalRatio), 0, // same family, base slot = 0
 				getter,
-				agg.AggregateBySlot,
+				emitValue,
+			)
+			if adjacentAgg != nil {
+				// collects values of series, handles counter resets after all result sets of family loaded
+				adjacentAgg.Collect(uint32(lowSeriesIdx))
+			}
+		}
+		t.dataLoadCtx.DownSamplingRollup = func(slotRange timeutil.SlotRange, lowSeriesIdx uint16, fieldIdx int,
+			getter encoding.TSDValueGetter, aggTypes []field.AggType, aggGetters []encoding.TSDValueGetter) {
+			seriesAggregator := t.dataLoadCtx.GetSeriesAggregator(lowSeriesIdx, fieldIdx)
+			agg, ok := seriesAggregator.GetAggregator(t.segmentCtx.FamilyTime)
+			if !ok {
+				return
+			}
+			sourceSlotRange, getter := prepareGetter(slotRange, lowSeriesIdx, getter)
+			// field data only aggregates the agg types which are not materialized
+			adjacentAgg, emitValue := prepareAdjacent(fieldIdx, prepareCollector(lowSeriesIdx, fieldIdx, func(pos int, value float64) {
+				agg.AggregateRollupBySlot(pos, value, aggTypes)
+			}))
+			aggregation.DownSampling(
+				sourceSlotRange, targetSlotRange, uint16(queryIntervalRatio), 0,
+				getter,
+				emitValue,
 			)
+			if adjacentAgg != nil {
+				adjacentAgg.Collect(uint32(lowSeriesIdx))
+			}
+			for idx := range aggGetters {
+				aggType := aggTypes[idx]
+				_, aggGetter := prepareGetter(slotRange, lowSeriesIdx, aggGetters[idx])
+				aggregation.DownSampling(
+					sourceSlotRange, targetSlotRange, uint16(queryIntervalRatio), 0,
+					aggGetter,
+					func(pos int, value float64) {
+						agg.AggregatePartialBySlot(aggType, pos, value)
+					},
+				)
+			}
 		}
 
 		// loads the metric data by given series id from load result.
@@ -421,15 +532,71 @@ func (t *dataLoadTask) Run() error {
 		encoding.ReleaseTSDDecoder(t.dataLoadCtx.Decoder)
 		// after load, need to reduce the aggregator's result to query flow.
 		t.dataLoadCtx.Reduce(t.queryFlow.Reduce)
+		t.dataLoadCtx.ReduceStringValues(t.queryFlow.ReduceStringValues)
 
 		if explain {
 			t.costs[idx] = time.Since(start)
 			start = time.Now()
 		}
 	}
+	// data points of one series may be stored in memory database and files of family,
+	// so handles counter resets of each series after all result sets loaded in time order,
+	// then reduces the adjacent agg types before merging into grouping aggregator.
+	if t.dataLoadCtx.FlushAdjacentAggregators(t.segmentCtx.FamilyTime, timestamp) {
+		t.dataLoadCtx.Reduce(t.queryFlow.Reduce)
+	}
 	return nil
 }
 
+// calcTimestamp returns the timestamp of target slot in current family, the slot is aligned to session time zone.
+func (t *dataLoadTask) calcTimestamp(targetSlotRange timeutil.SlotRange, pos int) int64 {
+	query := t.dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.Query
+	familyTime := t.segmentCtx.FamilyTime
+	offset := timeutil.CalcAlignOffset(familyTime, query.Interval.Int64(), query.Location())
+	return timeutil.CalcTimestamp(familyTime, int(targetSlotRange.Start)+pos, query.Interval) - offset
+}
+
+// getDeletedSlots returns the deleted time slots of the series which are deleted partially in current family.
+func (t *dataLoadTask) getDeletedSlots() ([]deletedSlots, error) {
+	tombstones, err := t.shard.IndexDatabase().GetTombstones(t.dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.MetricID)
+	if err != nil || len(tombstones) == 0 {
+		return nil, err
+	}
+	familyTime := t.segmentCtx.FamilyTime
+	interval := t.segmentCtx.Interval
+	familyTimeRange := timeutil.TimeRange{
+		Start: familyTime,
+		End:   interval.Calculator().CalcFamilyEndTime(familyTime),
+	}
+	var rs []deletedSlots
+	for _, tombstone := range tombstones.Overlap(familyTimeRange) {
+		rs = append(rs, deletedSlots{
+			seriesIDs: tombstone.SeriesIDs,
+			slotRange: interval.CalcSlotRange(familyTime, tombstone.TimeRange),
+		})
+	}
+	return rs, nil
+}
+
+// filterDeletedValues wraps the value getter if series has deleted time slots.
+func (t *dataLoadTask) filterDeletedValues(
+	lowSeriesIdx uint16,
+	deleted []deletedSlots,
+	getter encoding.TSDValueGetter,
+) encoding.TSDValueGetter {
+	seriesID := encoding.ValueWithHighLowBits(uint32(t.dataLoadCtx.SeriesIDHighKey)<<16, t.dataLoadCtx.LowSeriesIDs[lowSeriesIdx])
+	var slots []timeutil.SlotRange
+	for idx := range deleted {
+		if deleted[idx].seriesIDs.Contains(seriesID) {
+			slots = append(slots, deleted[idx].slotRange)
+		}
+	}
+	if len(slots) == 0 {
+		return getter
+	}
+	return &deletedValueFilter{getter: getter, slots: slots}
+}
+
 // AfterRun invokes after data load, collects the data load stats
 func (t *dataLoadTask) AfterRun() {
 	t.baseQueryTask.AfterRun()
@@ -451,6 +618,39 @@ func (t *dataLoadTask) AfterRun() {
 	}
 }
 
+// deletedSlots represents the time slots of series which are deleted.
+type deletedSlots struct {
+	seriesIDs *roaring.Bitmap
+	slotRange timeutil.SlotRange
+}
+
+// deletedValueFilter filters out the values in deleted time slots.
+type deletedValueFilter struct {
+	getter encoding.TSDValueGetter
+	slots  []timeutil.SlotRange
+}
+
+// GetValue returns value by time slot, if it hasn't or it is deleted, return false.
+func (f *deletedValueFilter) GetValue(slot uint16) (float64, bool) {
+	for idx := range f.slots {
+		if f.slots[idx].Contains(slot) {
+			return 0, false
+		}
+	}
+	return f.getter.GetValue(slot)
+}
+
+// alignedValueGetter gets the value by the source slot which is shifted for aligning time slots.
+type alignedValueGetter struct {
+	getter     encoding.TSDValueGetter
+	alignSlots uint16
+}
+
+// GetValue returns value by shifted time slot, if it hasn't, return false.
+func (g *alignedValueGetter) GetValue(slot uint16) (float64, bool) {
+	return g.getter.GetValue(slot - g.alignSlots)
+}
+
 // collectTagValuesTask represents collect tag values by tag value ids
 type collectTagValuesTask struct {
 	baseQueryTask
@@ -490,3 +690,70 @@ func (t *collectTagValuesTask) AfterRun() {
 	t.baseQueryTask.AfterRun()
 	t.ctx.storageExecuteCtx.Stats.SetCollectTagValuesStats(t.tagKey.Key, t.cost)
 }
+
+// exemplarLoadTask represents load exemplars of grouped series task
+type exemplarLoadTask struct {
+	baseQueryTask
+	dataLoadCtx *flow.DataLoadContext
+	shard       tsdb.Shard
+	queryFlow   flow.StorageQueryFlow
+}
+
+// newExemplarLoadTask creates the exemplar load task
+func newExemplarLoadTask(
+	shard tsdb.Shard,
+	queryFlow flow.StorageQueryFlow,
+	dataLoadCtx *flow.DataLoadContext,
+) flow.QueryTask {
+	return &exemplarLoadTask{
+		shard:       shard,
+		queryFlow:   queryFlow,
+		dataLoadCtx: dataLoadCtx,
+	}
+}
+
+// Run finds the exemplars of grouped series, then reduces them by grouping key
+func (t *exemplarLoadTask) Run() error {
+	seriesIDs := roaring.New()
+	groupingKeys := make(map[uint32]string)
+	t.dataLoadCtx.IterateGroupingSeries(func(seriesID uint32, groupingKey string) {
+		seriesIDs.Add(seriesID)
+		groupingKeys[seriesID] = groupingKey
+	})
+	storageExecuteCtx := t.dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx
+	exemplars, err := t.shard.ExemplarStore().Find(storageExecuteCtx.MetricID, seriesIDs, storageExecuteCtx.Query.TimeRange)
+	if err != nil {
+		return err
+	}
+	if len(exemplars) == 0 {
+		return nil
+	}
+	var groupingKeyList []string
+	result := make(map[string][]*models.Exemplar)
+	for idx := range exemplars {
+		e := &exemplars[idx]
+		groupingKey := groupingKeys[e.SeriesID]
+		if _, ok := result[groupingKey]; !ok {
+			groupingKeyList = append(groupingKeyList, groupingKey)
+		}
+		result[groupingKey] = append(result[groupingKey], &models.Exemplar{
+			Timestamp: e.Timestamp,
+			TraceID:   hex.EncodeToString(e.TraceID),
+			SpanID:    hex.EncodeToString(e.SpanID),
+			Duration:  e.Duration,
+		})
+	}
+	for _, groupingKey := range groupingKeyList {
+		t.queryFlow.ReduceExemplars(groupingKey, result[groupingKey])
+	}
+	return nil
+}
+
+// isValueCollected returns if the values of all data points need be collected for the functions of field,
+// because median/count distinct cannot be merged from the down sampling results of families/shards.
+func isValueCollected(spec aggregation.AggregatorSpec) bool {
+	functions := spec.Functions()
+	_, median := functions[function.Median]
+	_, countDistinct := functions[function.CountDistinct]
+	return median || countDistinct
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
@@ -40,6 +41,7 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/tblstore/exemplar"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
 
@@ -137,9 +139,26 @@ func TestSeriesIDsSearchTask_Run(t *testing.T) {
 		SeriesIDsAfterFiltering: roaring.New(),
 	}
 	task := newSeriesIDsSearchTask(ctx, shard)
+	// get tombstones err
+	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.New(), nil)
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	err := task.Run()
+	assert.Error(t, err)
+	assert.True(t, ctx.SeriesIDsAfterFiltering.IsEmpty())
+	// filter deleted series by tombstones
+	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(indexdb.Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 0, End: 100}, SeriesIDs: roaring.BitmapOf(1, 2)},
+	}, nil)
+	ctx.StorageExecuteCtx.Query = &stmt.Query{GroupBy: []string{"host"}, TimeRange: timeutil.TimeRange{Start: 10, End: 20}}
+	err = task.Run()
+	assert.NoError(t, err)
+	assert.True(t, ctx.SeriesIDsAfterFiltering.IsEmpty())
+	ctx.StorageExecuteCtx.Query = &stmt.Query{}
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
 	// case 1: search err
 	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
-	err := task.Run()
+	err = task.Run()
 	assert.Error(t, err)
 	// case 2: no group by add series ids without tags
 	indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.New(), nil)
@@ -316,6 +335,9 @@ func TestDataLoadTask_Run(t *testing.T) {
 	defer ctrl.Finish()
 
 	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
 	qf := flow.NewMockStorageQueryFlow(ctrl)
 	qf.EXPECT().Reduce(gomock.Any())
 	rs := flow.NewMockFilterResultSet(ctrl)
@@ -329,6 +351,7 @@ func TestDataLoadTask_Run(t *testing.T) {
 					IntervalRatio: 1.0,
 				},
 				Stats:             models.NewStorageStats(),
+				Fields:            field.Metas{{ID: 1, Name: "f", Type: field.SumField}},
 				DownSamplingSpecs: aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
 			},
 			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
@@ -367,6 +390,257 @@ func TestDataLoadTask_Run(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDataLoadTask_DownSamplingRollup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
+	qf := flow.NewMockStorageQueryFlow(ctrl)
+	qf.EXPECT().Reduce(gomock.Any())
+	rs := flow.NewMockFilterResultSet(ctrl)
+	ctx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{
+					Interval:      timeutil.Interval(timeutil.OneMinute),
+					IntervalRatio: 1.0,
+					TimeRange:     timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+				},
+				Stats:             models.NewStorageStats(),
+				Fields:            field.Metas{{ID: 1, Name: "f", Type: field.GaugeField}},
+				DownSamplingSpecs: aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.GaugeField)},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
+		},
+	}
+	ctx.PrepareAggregatorWithoutGrouping()
+	agg := aggregation.NewMockSeriesAggregator(ctrl)
+	ctx.WithoutGroupingSeriesAgg.Aggregator = agg
+	segment := &flow.TimeSegmentResultSet{FilterRS: []flow.FilterResultSet{rs}}
+	task := newDataLoadTask(shard, qf, ctx, 0, segment)
+	loader := flow.NewMockDataLoader(ctrl)
+	rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2))
+	rs.EXPECT().Load(gomock.Any()).Return(loader)
+	fAgg := aggregation.NewMockFieldAggregator(ctrl)
+	agg.EXPECT().GetAggregator(gomock.Any()).Return(nil, false)
+	agg.EXPECT().GetAggregator(gomock.Any()).Return(fAgg, true)
+	agg.EXPECT().Reset()
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(gomock.Any()).Return(5.0, true).AnyTimes()
+	aggGetter := encoding.NewMockTSDValueGetter(ctrl)
+	aggGetter.EXPECT().GetValue(gomock.Any()).Return(9.0, true).AnyTimes()
+	// last value only aggregates the agg types which are not materialized, max uses materialized value
+	fAgg.EXPECT().AggregateRollupBySlot(5, 5.0, []field.AggType{field.Max})
+	fAgg.EXPECT().AggregatePartialBySlot(field.Max, 5, 9.0)
+	loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+		for i := 0; i < 2; i++ {
+			ctx.DownSamplingRollup(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter,
+				[]field.AggType{field.Max}, []encoding.TSDValueGetter{aggGetter})
+		}
+	})
+	err := task.Run()
+	assert.NoError(t, err)
+}
+
+func TestDataLoadTask_CollectNumericValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
+	qf := flow.NewMockStorageQueryFlow(ctrl)
+	qf.EXPECT().Reduce(gomock.Any())
+	rs := flow.NewMockFilterResultSet(ctrl)
+	spec := aggregation.NewAggregatorSpec("f", field.GaugeField)
+	spec.AddFunctionType(function.Median)
+	ctx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{
+					Interval:      timeutil.Interval(timeutil.OneMinute),
+					IntervalRatio: 1.0,
+					TimeRange:     timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+				},
+				Stats:             models.NewStorageStats(),
+				Fields:            field.Metas{{ID: 1, Name: "f", Type: field.GaugeField}},
+				DownSamplingSpecs: aggregation.AggregatorSpecs{spec},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
+		},
+	}
+	ctx.PrepareAggregatorWithoutGrouping()
+	agg := aggregation.NewMockSeriesAggregator(ctrl)
+	ctx.WithoutGroupingSeriesAgg.Aggregator = agg
+	segment := &flow.TimeSegmentResultSet{FilterRS: []flow.FilterResultSet{rs}}
+	task := newDataLoadTask(shard, qf, ctx, 0, segment)
+	loader := flow.NewMockDataLoader(ctrl)
+	rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2))
+	rs.EXPECT().Load(gomock.Any()).Return(loader)
+	fAgg := aggregation.NewMockFieldAggregator(ctrl)
+	agg.EXPECT().GetAggregator(gomock.Any()).Return(fAgg, true).Times(2)
+	agg.EXPECT().Reset()
+	fAgg.EXPECT().AggregateBySlot(5, 5.0).Times(2)
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(gomock.Any()).Return(5.0, true).AnyTimes()
+	loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter)
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 1, 0, getter)
+	})
+	// values of all data points are collected for median
+	qf.EXPECT().ReduceStringValues("", []*models.StringValues{{
+		Field:      "f",
+		Timestamps: []int64{5 * timeutil.OneMinute},
+		Values:     [][]string{{"5"}},
+		Counts:     [][]uint32{{2}},
+	}})
+	assert.NoError(t, task.Run())
+}
+
+func TestDataLoadTask_AdjacentAcrossResultSets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
+	qf := flow.NewMockStorageQueryFlow(ctrl)
+	qf.EXPECT().Reduce(gomock.Any()).AnyTimes()
+	aggSpec := aggregation.NewAggregatorSpec("f", field.GaugeField)
+	aggSpec.AddFunctionType(function.Increase)
+	ctx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{
+					Interval:      timeutil.Interval(timeutil.OneMinute),
+					IntervalRatio: 1.0,
+					TimeRange:     timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+				},
+				Stats:             models.NewStorageStats(),
+				Fields:            field.Metas{{ID: 1, Name: "f", Type: field.GaugeField}},
+				DownSamplingSpecs: aggregation.AggregatorSpecs{aggSpec},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1),
+		},
+	}
+	ctx.PrepareAggregatorWithoutGrouping()
+	agg := aggregation.NewMockSeriesAggregator(ctrl)
+	ctx.WithoutGroupingSeriesAgg.Aggregator = agg
+	fAgg := aggregation.NewFieldAggregator(aggSpec, 0, 0, 60)
+	agg.EXPECT().GetAggregator(gomock.Any()).Return(fAgg, true).AnyTimes()
+	agg.EXPECT().Reset().AnyTimes()
+
+	newResultSet := func(slotRange timeutil.SlotRange, values map[uint16]float64) flow.FilterResultSet {
+		rs := flow.NewMockFilterResultSet(ctrl)
+		loader := flow.NewMockDataLoader(ctrl)
+		getter := encoding.NewMockTSDValueGetter(ctrl)
+		getter.EXPECT().GetValue(gomock.Any()).DoAndReturn(func(slot uint16) (float64, bool) {
+			v, ok := values[slot]
+			return v, ok
+		}).AnyTimes()
+		rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1))
+		rs.EXPECT().Load(gomock.Any()).Return(loader)
+		loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+			ctx.DownSampling(slotRange, 1, 0, getter)
+		})
+		return rs
+	}
+	// memory database(newer data points) is loaded before file of same family
+	segment := &flow.TimeSegmentResultSet{FilterRS: []flow.FilterResultSet{
+		newResultSet(timeutil.SlotRange{Start: 8, End: 9}, map[uint16]float64{8: 5, 9: 15}), // counter reset
+		newResultSet(timeutil.SlotRange{Start: 5, End: 7}, map[uint16]float64{5: 10, 6: 20, 7: 30}),
+	}}
+	task := newDataLoadTask(shard, qf, ctx, 0, segment)
+	assert.NoError(t, task.Run())
+
+	_, it := fAgg.ResultSet()
+	assert.True(t, it.HasNext())
+	pIt := it.Next()
+	assert.Equal(t, field.Increase, pIt.AggType())
+	values := make(map[int]float64)
+	for pIt.HasNext() {
+		slot, value := pIt.Next()
+		values[slot] = value
+	}
+	// boundary between file and memory database is calculated once
+	assert.Equal(t, map[int]float64{6: 10, 7: 10, 8: 5, 9: 10}, values)
+}
+
+func TestDataLoadTask_DeletedSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	qf := flow.NewMockStorageQueryFlow(ctrl)
+	rs := flow.NewMockFilterResultSet(ctrl)
+	rs.EXPECT().Identifier().Return("memory").AnyTimes()
+	ctx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{},
+				Stats: models.NewStorageStats(),
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
+		},
+		SeriesIDHighKey: 0,
+		LowSeriesIDs:    []uint16{1, 2, 3},
+	}
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	familyTime, _ := timeutil.ParseTimestamp("20210703 10:00:00", "20060102 15:04:05")
+	segment := &flow.TimeSegmentResultSet{FilterRS: []flow.FilterResultSet{rs}, FamilyTime: familyTime, Interval: interval}
+	task := newDataLoadTask(shard, qf, ctx, 0, segment).(*dataLoadTask)
+	// case 1: get tombstones failure
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	err := task.Run()
+	assert.Error(t, err)
+	// case 2: tombstones cover whole family or not overlap with family
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(indexdb.Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 0, End: familyTime + timeutil.OneDay}, SeriesIDs: roaring.BitmapOf(1)},
+		{TimeRange: timeutil.TimeRange{Start: 0, End: familyTime - timeutil.OneHour}, SeriesIDs: roaring.BitmapOf(2)},
+	}, nil)
+	slots, err := task.getDeletedSlots()
+	assert.NoError(t, err)
+	assert.Empty(t, slots)
+	// case 3: mask deleted slots
+	indexDB.EXPECT().GetTombstones(gomock.Any()).Return(indexdb.Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 0, End: familyTime + 10*timeutil.OneSecond}, SeriesIDs: roaring.BitmapOf(1)},
+	}, nil)
+	slots, err = task.getDeletedSlots()
+	assert.NoError(t, err)
+	assert.Equal(t, []deletedSlots{{seriesIDs: roaring.BitmapOf(1), slotRange: timeutil.SlotRange{Start: 0, End: 1}}}, slots)
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(gomock.Any()).Return(5.0, true).AnyTimes()
+	// series 2 not deleted
+	assert.Equal(t, getter, task.filterDeletedValues(1, slots, getter))
+	// series 1 deleted in slot 0~1
+	filter := task.filterDeletedValues(0, slots, getter)
+	v, ok := filter.GetValue(1)
+	assert.False(t, ok)
+	assert.Zero(t, v)
+	v, ok = filter.GetValue(2)
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, v)
+}
+
+func TestAlignedValueGetter_GetValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(uint16(2)).Return(5.0, true)
+	aligned := &alignedValueGetter{getter: getter, alignSlots: 8}
+	v, ok := aligned.GetValue(10)
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, v)
+}
+
 func TestCollectTagValuesTask_Run(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -396,3 +670,49 @@ func TestCollectTagValuesTask_Run(t *testing.T) {
 	err = task.Run()
 	assert.NoError(t, err)
 }
+
+func TestExemplarLoadTask_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	exemplarStore := tsdb.NewMockExemplarStore(ctrl)
+	shard.EXPECT().ExemplarStore().Return(exemplarStore).AnyTimes()
+	qf := flow.NewMockStorageQueryFlow(ctrl)
+	seriesIDs := roaring.BitmapOf(1, 2, 3)
+	ctx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				MetricID: 10,
+				Query: &stmt.Query{
+					TimeRange: timeutil.TimeRange{Start: 10, End: 100},
+				},
+			},
+		},
+		LowSeriesIDsContainer: seriesIDs.GetContainerAtIndex(0),
+		IsGrouping:            true,
+		GroupingSeriesAgg:     []*flow.GroupingSeriesAgg{{Key: "a"}, {Key: "b"}},
+	}
+	ctx.Grouping()
+	ctx.GroupingSeriesAggRefs = []uint16{0, 1, 0}
+	task := newExemplarLoadTask(shard, qf, ctx)
+	// case 1: find err
+	exemplarStore.EXPECT().Find(metric.ID(10), seriesIDs, timeutil.TimeRange{Start: 10, End: 100}).
+		Return(nil, fmt.Errorf("err"))
+	assert.Error(t, task.Run())
+	// case 2: exemplars not found
+	exemplarStore.EXPECT().Find(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	assert.NoError(t, task.Run())
+	// case 3: reduce exemplars by grouping key
+	exemplarStore.EXPECT().Find(gomock.Any(), gomock.Any(), gomock.Any()).Return([]exemplar.Exemplar{
+		{SeriesID: 1, Timestamp: 20, TraceID: []byte{0x01, 0xab}, SpanID: []byte{0x02}, Duration: 10},
+		{SeriesID: 2, Timestamp: 30, TraceID: []byte{0x03}},
+		{SeriesID: 3, Timestamp: 40, TraceID: []byte{0x04}},
+	}, nil)
+	qf.EXPECT().ReduceExemplars("a", []*models.Exemplar{
+		{Timestamp: 20, TraceID: "01ab", SpanID: "02", Duration: 10},
+		{Timestamp: 40, TraceID: "04"},
+	})
+	qf.EXPECT().ReduceExemplars("b", []*models.Exemplar{{Timestamp: 30, TraceID: "03"}})
+	assert.NoError(t, task.Run())
+}
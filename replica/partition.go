@@ -53,9 +53,13 @@ type Partition interface {
 	// return appended index, if success.
 	ReplicaLog(replicaIdx int64, msg []byte) (int64, error)
 	// WriteLog writes msg that leader handle client writeTask request.
-	WriteLog(msg []byte) error
+	// return the sequence of msg, if success.
+	WriteLog(msg []byte) (int64, error)
 	// ReplicaAckIndex returns the index which replica appended index.
 	ReplicaAckIndex() int64
+	// RejectedRows returns the number of rows rejected by series limits of shard for msg of sequence,
+	// if msg isn't written into shard yet, replayed is false.
+	RejectedRows(sequence int64) (rows int64, replayed bool)
 	// ResetReplicaIndex resets replica index.
 	ResetReplicaIndex(idx int64)
 	// IsExpire returns partition if it is expired.
@@ -80,11 +84,13 @@ type partition struct {
 	shard         tsdb.Shard
 	family        tsdb.DataFamily
 
-	peers    map[models.NodeID]ReplicatorPeer
-	cliFct   rpc.ClientStreamFactory
-	stateMgr storage.StateManager
+	peers        map[models.NodeID]ReplicatorPeer
+	cliFct       rpc.ClientStreamFactory
+	stateMgr     storage.StateManager
+	rejectedRows *rejectedRows
 
-	mutex sync.Mutex
+	mutex      sync.Mutex
+	writeMutex sync.Mutex // make sure the sequence of msg is consistent when writing log concurrently
 
 	statistics *metrics.StorageWriteAheadLogStatistics
 
@@ -112,6 +118,7 @@ func NewPartition(
 		cliFct:        cliFct,
 		stateMgr:      stateMgr,
 		peers:         make(map[models.NodeID]ReplicatorPeer),
+		rejectedRows:  newRejectedRows(),
 		statistics:    metrics.NewStorageWriteAheadLogStatistics(shard.Database().Name(), shard.ShardID().String()),
 		logger:        logger.GetLogger("replica", "Partition"),
 	}
@@ -138,6 +145,12 @@ func (p *partition) ReplicaAckIndex() int64 {
 	return p.log.HeadSeq() - 1
 }
 
+// RejectedRows returns the number of rows rejected by series limits of shard for msg of sequence,
+// if msg isn't written into shard yet, replayed is false.
+func (p *partition) RejectedRows(sequence int64) (rows int64, replayed bool) {
+	return p.rejectedRows.take(sequence)
+}
+
 // ResetReplicaIndex resets replica index.
 func (p *partition) ResetReplicaIndex(idx int64) {
 	p.log.SetAppendSeq(idx)
@@ -169,17 +182,22 @@ func (p *partition) IsExpire() bool {
 }
 
 // WriteLog writes msg that leader sends replica msg.
-func (p *partition) WriteLog(msg []byte) error {
+// return the sequence of msg, if success, if msg is empty return -1.
+func (p *partition) WriteLog(msg []byte) (int64, error) {
 	if len(msg) == 0 {
-		return nil
+		return -1, nil
 	}
 	p.statistics.ReceiveWriteSize.Add(float64(len(msg)))
+	p.writeMutex.Lock()
+	defer p.writeMutex.Unlock()
+
+	sequence := p.log.HeadSeq()
 	if err := p.log.Put(msg); err != nil {
 		p.statistics.WriteWALFailures.Incr()
-		return err
+		return -1, err
 	}
 	p.statistics.WriteWAL.Incr()
-	return nil
+	return sequence, nil
 }
 
 // BuildReplicaForLeader builds replica relation when handle writeTask connection.
@@ -294,7 +312,7 @@ func (p *partition) buildReplica(leader, replica models.NodeID) error {
 	}
 	if replica == p.currentNodeID {
 		// local replicator
-		replicator = newLocalReplicatorFn(&channel, p.shard, p.family)
+		replicator = newLocalReplicatorFn(&channel, p.shard, p.family, p.rejectedRows)
 	} else {
 		// build remote replicator
 		replicator = newRemoteReplicatorFn(p.ctx, &channel, p.stateMgr, p.cliFct)
@@ -52,7 +52,7 @@ func TestPartition_BuildReplicaRelation(t *testing.T) {
 	r.EXPECT().String().Return("TestPartition_BuildReplicaRelation").AnyTimes()
 	r.EXPECT().State().Return(&models.ReplicaState{}).AnyTimes()
 	r.EXPECT().Pending().Return(int64(10)).AnyTimes()
-	newLocalReplicatorFn = func(_ *ReplicatorChannel, _ tsdb.Shard, _ tsdb.DataFamily) Replicator {
+	newLocalReplicatorFn = func(_ *ReplicatorChannel, _ tsdb.Shard, _ tsdb.DataFamily, _ *rejectedRows) Replicator {
 		return r
 	}
 	newRemoteReplicatorFn = func(_ context.Context, _ *ReplicatorChannel,
@@ -84,6 +84,10 @@ func TestPartition_BuildReplicaRelation(t *testing.T) {
 	p.ResetReplicaIndex(100)
 	log.EXPECT().Path().Return("path")
 	assert.Equal(t, "path", p.Path())
+	p1.rejectedRows.replayed(10, 5)
+	rows, replayed := p.RejectedRows(10)
+	assert.True(t, replayed)
+	assert.Equal(t, int64(5), rows)
 
 	// create fanout failure
 	p = NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
@@ -110,7 +114,7 @@ func TestPartition_BuildReplicaForFollower(t *testing.T) {
 	r.EXPECT().String().Return("TestPartition_BuildReplicaForFollower").AnyTimes()
 	r.EXPECT().State().Return(&models.ReplicaState{}).AnyTimes()
 	r.EXPECT().Pending().Return(int64(10)).AnyTimes()
-	newLocalReplicatorFn = func(_ *ReplicatorChannel, _ tsdb.Shard, _ tsdb.DataFamily) Replicator {
+	newLocalReplicatorFn = func(_ *ReplicatorChannel, _ tsdb.Shard, _ tsdb.DataFamily, _ *rejectedRows) Replicator {
 		return r
 	}
 	newRemoteReplicatorFn = func(_ context.Context, _ *ReplicatorChannel,
@@ -157,7 +161,7 @@ func TestPartition_Close(t *testing.T) {
 	l.EXPECT().GetOrCreateFanOut(gomock.Any()).Return(nil, nil).AnyTimes()
 	r.EXPECT().String().Return("TestPartition_Close").AnyTimes()
 	r.EXPECT().Pending().Return(int64(10)).AnyTimes()
-	newLocalReplicatorFn = func(_ *ReplicatorChannel, _ tsdb.Shard, _ tsdb.DataFamily) Replicator {
+	newLocalReplicatorFn = func(_ *ReplicatorChannel, _ tsdb.Shard, _ tsdb.DataFamily, _ *rejectedRows) Replicator {
 		return r
 	}
 	newRemoteReplicatorFn = func(_ context.Context, _ *ReplicatorChannel,
@@ -192,15 +196,19 @@ func TestPartition_WriteLog(t *testing.T) {
 	family := tsdb.NewMockDataFamily(ctrl)
 	family.EXPECT().FamilyTime().Return(timeutil.Now()).AnyTimes()
 	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	l.EXPECT().HeadSeq().Return(int64(10)).Times(2)
 	l.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
-	err := p.WriteLog([]byte{1})
+	sequence, err := p.WriteLog([]byte{1})
 	assert.Error(t, err)
+	assert.Equal(t, int64(-1), sequence)
 	// msg is empty
-	err = p.WriteLog(nil)
+	sequence, err = p.WriteLog(nil)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), sequence)
 	l.EXPECT().Put(gomock.Any()).Return(nil)
-	err = p.WriteLog([]byte{1})
+	sequence, err = p.WriteLog([]byte{1})
 	assert.NoError(t, err)
+	assert.Equal(t, int64(10), sequence)
 }
 
 func TestPartition_ReplicaLog(t *testing.T) {
@@ -328,7 +336,7 @@ func TestPartition_recovery(t *testing.T) {
 		q := queue.NewMockFanOut(ctrl)
 		log.EXPECT().GetOrCreateFanOut(gomock.Any()).Return(q, nil)
 		family.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: timeutil.Now()})
-		newLocalReplicatorFn = func(channel *ReplicatorChannel, shard tsdb.Shard, family tsdb.DataFamily) Replicator {
+		newLocalReplicatorFn = func(channel *ReplicatorChannel, shard tsdb.Shard, family tsdb.DataFamily, _ *rejectedRows) Replicator {
 			return nil
 		}
 		peer := NewMockReplicatorPeer(ctrl)
@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import "sync"
+
+// maxTrackedSequences is the max number of sequences which keep the rejected rows,
+// rejected rows of older sequences are dropped if they are not taken by write stream(stream closed).
+const maxTrackedSequences = 16 * 1024
+
+// rejectedRows tracks the number of rows rejected by series limits of shard for each sequence of write ahead log,
+// because rows are written into shard asynchronously when replaying write ahead log.
+type rejectedRows struct {
+	rows        map[int64]int64 // key: sequence of write ahead log, value: number of rejected rows
+	replayedSeq int64           // the last sequence replayed into shard
+
+	mutex sync.Mutex
+}
+
+// newRejectedRows creates the rejected rows tracker.
+func newRejectedRows() *rejectedRows {
+	return &rejectedRows{
+		rows:        make(map[int64]int64),
+		replayedSeq: -1,
+	}
+}
+
+// replayed records the number of rejected rows after msg of sequence is replayed into shard.
+func (r *rejectedRows) replayed(sequence int64, rows int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.replayedSeq = sequence
+	if rows <= 0 {
+		return
+	}
+	r.rows[sequence] = int64(rows)
+	if len(r.rows) > maxTrackedSequences {
+		for seq := range r.rows {
+			if seq <= sequence-maxTrackedSequences {
+				delete(r.rows, seq)
+			}
+		}
+	}
+}
+
+// take returns and removes the number of rejected rows of sequence,
+// if msg of sequence isn't replayed into shard yet, replayed is false.
+func (r *rejectedRows) take(sequence int64) (rows int64, replayed bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if sequence > r.replayedSeq {
+		return 0, false
+	}
+	rows = r.rows[sequence]
+	delete(r.rows, sequence)
+	return rows, true
+}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectedRows(t *testing.T) {
+	r := newRejectedRows()
+	rows, replayed := r.take(0)
+	assert.False(t, replayed)
+	assert.Zero(t, rows)
+
+	r.replayed(0, 0)
+	r.replayed(1, 10)
+	rows, replayed = r.take(0)
+	assert.True(t, replayed)
+	assert.Zero(t, rows)
+	rows, replayed = r.take(1)
+	assert.True(t, replayed)
+	assert.Equal(t, int64(10), rows)
+	// rejected rows is removed after taken
+	rows, replayed = r.take(1)
+	assert.True(t, replayed)
+	assert.Zero(t, rows)
+	_, replayed = r.take(2)
+	assert.False(t, replayed)
+
+	// drop rejected rows of old sequences which are not taken
+	for seq := int64(2); seq < maxTrackedSequences+10; seq++ {
+		r.replayed(seq, 1)
+	}
+	assert.LessOrEqual(t, len(r.rows), maxTrackedSequences+1)
+	rows, _ = r.take(2)
+	assert.Zero(t, rows)
+	rows, _ = r.take(maxTrackedSequences + 9)
+	assert.Equal(t, int64(1), rows)
+}
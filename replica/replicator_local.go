@@ -30,29 +30,36 @@ import (
 type localReplicator struct {
 	replicator
 
-	leader    int32
-	shard     tsdb.Shard
-	family    tsdb.DataFamily
-	logger    *logger.Logger
-	batchRows *metric.StorageBatchRows
+	leader       int32
+	shard        tsdb.Shard
+	family       tsdb.DataFamily
+	rejectedRows *rejectedRows
+	logger       *logger.Logger
+	batchRows    *metric.StorageBatchRows
 
 	block []byte
 
 	statistics *metrics.StorageLocalReplicatorStatistics
 }
 
-func NewLocalReplicator(channel *ReplicatorChannel, shard tsdb.Shard, family tsdb.DataFamily) Replicator {
+func NewLocalReplicator(
+	channel *ReplicatorChannel,
+	shard tsdb.Shard,
+	family tsdb.DataFamily,
+	rejectedRows *rejectedRows,
+) Replicator {
 	lr := &localReplicator{
 		leader: int32(channel.State.Leader),
 		replicator: replicator{
 			channel: channel,
 		},
-		shard:      shard,
-		family:     family,
-		batchRows:  metric.NewStorageBatchRows(),
-		statistics: metrics.NewStorageLocalReplicatorStatistics(channel.State.Database, channel.State.ShardID.String()),
-		logger:     logger.GetLogger("replica", "LocalReplicator"),
-		block:      make([]byte, 256*1024),
+		shard:        shard,
+		family:       family,
+		rejectedRows: rejectedRows,
+		batchRows:    metric.NewStorageBatchRows(),
+		statistics:   metrics.NewStorageLocalReplicatorStatistics(channel.State.Database, channel.State.ShardID.String()),
+		logger:       logger.GetLogger("replica", "LocalReplicator"),
+		block:        make([]byte, 256*1024),
 	}
 
 	// add ack sequence callback
@@ -74,7 +81,12 @@ func NewLocalReplicator(channel *ReplicatorChannel, shard tsdb.Shard, family tsd
 // 3. lookup metadata
 // 4. write metric data
 // 5. commit sequence in data family
+// 6. record the number of rows rejected by series limits
 func (r *localReplicator) Replica(sequence int64, msg []byte) {
+	rejectedRows := 0
+	defer func() {
+		r.rejectedRows.replayed(sequence, rejectedRows)
+	}()
 	if !r.family.ValidateSequence(r.leader, sequence) {
 		r.statistics.InvalidSequence.Incr()
 		return
@@ -105,7 +117,8 @@ func (r *localReplicator) Replica(sequence int64, msg []byte) {
 	rows := r.batchRows.Rows()
 
 	// lookup metric metadata
-	if err := r.shard.LookupRowMetricMeta(rows); err != nil {
+	rejectedRows, err = r.shard.LookupRowMetricMeta(rows)
+	if err != nil {
 		r.statistics.ReplicaFailures.Incr()
 		r.logger.Error("failed writing family rows",
 			logger.Int("rows", r.batchRows.Len()),
@@ -52,7 +52,7 @@ func TestLocalReplicator_New(t *testing.T) {
 	})
 	q := queue.NewMockFanOut(ctrl)
 	q.EXPECT().Ack(int64(10))
-	replicator := NewLocalReplicator(&ReplicatorChannel{State: &models.ReplicaState{Leader: 1}, Queue: q}, shard, family)
+	replicator := NewLocalReplicator(&ReplicatorChannel{State: &models.ReplicaState{Leader: 1}, Queue: q}, shard, family, newRejectedRows())
 	assert.NotNil(t, replicator)
 }
 
@@ -75,11 +75,12 @@ func TestLocalReplicator_Replica(t *testing.T) {
 	q := queue.NewMockFanOut(ctrl)
 	q.EXPECT().Pending().Return(int64(10)).AnyTimes()
 
+	rejectedRows := newRejectedRows()
 	replicator := NewLocalReplicator(
 		&ReplicatorChannel{
 			State: &models.ReplicaState{Leader: 1},
 			Queue: q,
-		}, shard, family)
+		}, shard, family, rejectedRows)
 	assert.True(t, replicator.IsReady())
 	// bad sequence
 	family.EXPECT().ValidateSequence(gomock.Any(), gomock.Any()).Return(false)
@@ -105,17 +106,26 @@ func TestLocalReplicator_Replica(t *testing.T) {
 	_, _ = row.WriteTo(buf)
 	var dst []byte
 	dst = snappy.Encode(dst, buf.Bytes())
-	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(fmt.Errorf("err"))
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(0, fmt.Errorf("err"))
 	replicator.Replica(1, dst)
 
 	// write failure
-	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(nil)
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(0, nil)
 	family.EXPECT().WriteRows(gomock.Any()).Return(fmt.Errorf("err"))
 	replicator.Replica(1, dst)
 	// write success
-	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(nil)
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(0, nil)
 	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
 	replicator.Replica(1, dst)
+	// rows rejected by series limits
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(1, nil)
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	replicator.Replica(2, dst)
+	rows, replayed := rejectedRows.take(2)
+	assert.True(t, replayed)
+	assert.Equal(t, int64(1), rows)
+	_, replayed = rejectedRows.take(3)
+	assert.False(t, replayed)
 	// bad data
 	dst = snappy.Encode(dst, []byte("bad-data"))
 	assert.Panics(t, func() {
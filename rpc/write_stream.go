@@ -24,6 +24,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
@@ -54,7 +55,8 @@ type writeStream struct {
 	cli    protoWriteV1.WriteService_WriteClient
 	closed *atomic.Bool
 
-	logger *logger.Logger
+	statistics *metrics.BrokerDatabaseWriteStatistics
+	logger     *logger.Logger
 }
 
 // NewWriteStream creates a WriteStream instance, initialize grpc connection(stream) and receive response task.
@@ -74,6 +76,7 @@ func NewWriteStream(
 		familyTime: familyTime,
 		fct:        fct,
 		closed:     atomic.NewBool(false),
+		statistics: metrics.NewBrokerDatabaseWriteStatistics(database),
 		logger:     logger.GetLogger("rpc", "WriteStream"),
 	}
 
@@ -177,6 +180,15 @@ func (s *writeStream) recvLoop() {
 					logger.String("target", s.target.Indicator()),
 					logger.String("err", resp.Err))
 			}
+			if resp.RejectedRows > 0 {
+				// rows rejected by series limits of storage
+				s.statistics.RejectedRows.Add(float64(resp.RejectedRows))
+				s.logger.Warn("rows rejected by series limits",
+					logger.String("database", s.database),
+					logger.Any("shard", s.shardState.ID),
+					logger.String("target", s.target.Indicator()),
+					logger.Int64("rejectedRows", resp.RejectedRows))
+			}
 		}
 	}
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
@@ -108,14 +109,19 @@ func TestWriteStream_Recv(t *testing.T) {
 	assert.True(t, stream.closed.Load())
 	// case 3: recv err
 	stream = &writeStream{
-		cli:    cli,
-		closed: atomic.NewBool(false),
-		target: &models.StatefulNode{},
-		logger: logger.GetLogger("rpc", "WriteStream"),
+		cli:        cli,
+		closed:     atomic.NewBool(false),
+		target:     &models.StatefulNode{},
+		shardState: &models.ShardState{ID: 1},
+		statistics: metrics.NewBrokerDatabaseWriteStatistics("db"),
+		logger:     logger.GetLogger("rpc", "WriteStream"),
 	}
 	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
 	cli.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
 	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{Err: "err"}, nil)
+	// case 4: rows rejected by series limits
+	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{RejectedRows: 10}, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF)
 	stream.recvLoop()
+	assert.Equal(t, float64(10), stream.statistics.RejectedRows.Get())
 }
@@ -32,3 +32,11 @@ var ErrTooManyFields = errors.New("too many fields")
 var ErrWrongFieldType = errors.New("field type is wrong")
 
 var ErrFieldTypeUnspecified = errors.New("field type is unknown")
+
+// ErrFieldExist is the error returned by tsdb when
+// rename field to a name which is used by other field.
+var ErrFieldExist = errors.New("field already exist")
+
+// ErrTooManySeries is the error returned by tsdb when
+// the number of series exceeds the limits of database/namespace/metric.
+var ErrTooManySeries = errors.New("too many series")
@@ -30,6 +30,8 @@ type Meta struct {
 	ID   ID   `json:"id"`   // query not use id, don't get id in query phase
 	Type Type `json:"type"` // query not use type
 	Name Name `json:"name"`
+	// AggType is the rollup aggregate materialized when rolling up, only used in metric data block
+	AggType AggType `json:"aggType,omitempty"`
 }
 
 func (m *Meta) MarshalBinary() (data []byte, err error) {
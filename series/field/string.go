@@ -0,0 +1,106 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package field
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+// MaxStringValues represents the max number of distinct string values of a metric in one family,
+// string field is designed for low-cardinality values like build version or status text.
+const MaxStringValues = 1024
+
+// ErrTooManyStringValues represents the distinct string values of metric exceed the limit.
+var ErrTooManyStringValues = errors.New("too many distinct values of string field")
+
+// StringCode returns the dictionary code of string value, the code is the high 52 bits of xxhash,
+// so that it is exact in float64 and stable across families, data can be merged without re-encoding.
+func StringCode(value string) float64 {
+	return float64(xxhash.Sum64String(value) >> 12)
+}
+
+// Dictionary represents the dictionary of string field values, dictionary code => string value.
+type Dictionary map[float64]string
+
+// Add adds the string value into dictionary then returns its code,
+// returns ErrTooManyStringValues if the dictionary is full.
+func (d Dictionary) Add(value string) (float64, error) {
+	code := StringCode(value)
+	if _, ok := d[code]; ok {
+		return code, nil
+	}
+	if len(d) >= MaxStringValues {
+		return 0, ErrTooManyStringValues
+	}
+	d[code] = value
+	return code, nil
+}
+
+// Get returns the string value by dictionary code.
+func (d Dictionary) Get(code float64) (string, bool) {
+	value, ok := d[code]
+	return value, ok
+}
+
+// Merge merges the values of other dictionary into current dictionary.
+func (d Dictionary) Merge(other Dictionary) {
+	for code, value := range other {
+		d[code] = value
+	}
+}
+
+// MarshalBinary marshals the dictionary ordered by code.
+func (d Dictionary) MarshalBinary() ([]byte, error) {
+	codes := make([]float64, 0, len(d))
+	for code := range d {
+		codes = append(codes, code)
+	}
+	sort.Float64s(codes)
+	writer := stream.NewBufferWriter(nil)
+	writer.PutUvarint64(uint64(len(codes)))
+	for _, code := range codes {
+		value := d[code]
+		writer.PutUint64(math.Float64bits(code))
+		writer.PutUvarint64(uint64(len(value)))
+		writer.PutBytes([]byte(value))
+	}
+	return writer.Bytes()
+}
+
+// UnmarshalDictionary unmarshals the dictionary from binary data.
+func UnmarshalDictionary(data []byte) (Dictionary, error) {
+	reader := stream.NewReader(data)
+	count := int(reader.ReadUvarint64())
+	d := make(Dictionary)
+	for i := 0; i < count && reader.Error() == nil; i++ {
+		code := math.Float64frombits(reader.ReadUint64())
+		length := reader.ReadUvarint64()
+		value := reader.ReadBytes(int(length))
+		d[code] = string(value)
+	}
+	if err := reader.Error(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
@@ -0,0 +1,99 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package field
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringCode(t *testing.T) {
+	code := StringCode("v1.0.0")
+	assert.Equal(t, code, StringCode("v1.0.0"))
+	assert.NotEqual(t, code, StringCode("v1.0.1"))
+	// code is exact in float64
+	assert.Equal(t, code, float64(uint64(code)))
+	assert.Less(t, code, float64(uint64(1)<<52))
+}
+
+func TestDictionary_Add(t *testing.T) {
+	d := make(Dictionary)
+	code, err := d.Add("running")
+	assert.NoError(t, err)
+	assert.Equal(t, StringCode("running"), code)
+	// add again
+	code2, err := d.Add("running")
+	assert.NoError(t, err)
+	assert.Equal(t, code, code2)
+	value, ok := d.Get(code)
+	assert.True(t, ok)
+	assert.Equal(t, "running", value)
+	_, ok = d.Get(StringCode("stopped"))
+	assert.False(t, ok)
+
+	for i := len(d); i < MaxStringValues; i++ {
+		_, err = d.Add(fmt.Sprintf("value-%d", i))
+		assert.NoError(t, err)
+	}
+	_, err = d.Add("stopped")
+	assert.Equal(t, ErrTooManyStringValues, err)
+	// exist value can be added when dictionary is full
+	_, err = d.Add("running")
+	assert.NoError(t, err)
+}
+
+func TestDictionary_Merge(t *testing.T) {
+	d1 := make(Dictionary)
+	_, _ = d1.Add("a")
+	d2 := make(Dictionary)
+	_, _ = d2.Add("a")
+	_, _ = d2.Add("b")
+	d1.Merge(d2)
+	assert.Len(t, d1, 2)
+	value, ok := d1.Get(StringCode("b"))
+	assert.True(t, ok)
+	assert.Equal(t, "b", value)
+}
+
+func TestDictionary_Marshal(t *testing.T) {
+	d := make(Dictionary)
+	_, _ = d.Add("a")
+	_, _ = d.Add("")
+	_, _ = d.Add("build-20220101")
+	data, err := d.MarshalBinary()
+	assert.NoError(t, err)
+	d2, err := UnmarshalDictionary(data)
+	assert.NoError(t, err)
+	assert.Equal(t, d, d2)
+
+	// empty dictionary
+	data, err = Dictionary{}.MarshalBinary()
+	assert.NoError(t, err)
+	d2, err = UnmarshalDictionary(data)
+	assert.NoError(t, err)
+	assert.Empty(t, d2)
+
+	// corrupted data
+	_, err = UnmarshalDictionary(data[:0])
+	assert.Error(t, err)
+	data, _ = d.MarshalBinary()
+	_, err = UnmarshalDictionary(data[:len(data)-2])
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ package field
 
 import (
 	"math"
+	"sort"
 
 	"github.com/lindb/lindb/aggregation/function"
 )
@@ -46,15 +47,24 @@ const (
 	Min
 	Max
 	LastValue
+	FirstValue
+	SumOfSquares
+	// agg types calculated by two adjacent data points of each series, the results of series are summed.
+	Increase
+	IRate
+	Delta
+	Derivative
 )
 
 // Aggregate aggregates two float64 values into one
 func (t AggType) Aggregate(a, b float64) float64 {
 	switch t {
-	case Sum, Count:
+	case Sum, Count, SumOfSquares, Increase, IRate, Delta, Derivative:
 		return a + b
 	case LastValue:
 		return b
+	case FirstValue:
+		return a
 	case Min:
 		return math.Min(a, b)
 	case Max:
@@ -64,6 +74,86 @@ func (t AggType) Aggregate(a, b float64) float64 {
 	}
 }
 
+// PointValue returns the partial value which one raw data point contributes to the agg type,
+// e.g. a point counts as 1 for count, and as the square of its value for sum of squares.
+func (t AggType) PointValue(value float64) float64 {
+	switch t {
+	case Count:
+		return 1
+	case SumOfSquares:
+		return value * value
+	default:
+		return value
+	}
+}
+
+// AdjacentFunc returns the function which calculates the agg type by two adjacent data points of one series,
+// returns function.Unknown if agg type is calculated by each data point.
+func (t AggType) AdjacentFunc() function.FuncType {
+	switch t {
+	case Increase:
+		return function.Increase
+	case IRate:
+		return function.IRate
+	case Delta:
+		return function.Delta
+	case Derivative:
+		return function.Derivative
+	default:
+		return function.Unknown
+	}
+}
+
+// IsAdjacent returns if the agg type is calculated by two adjacent data points of one series,
+// counter resets are handled for each series before the series are merged.
+func (t AggType) IsAdjacent() bool {
+	return t.AdjacentFunc() != function.Unknown
+}
+
+// RollupFieldType returns the field type of the rollup aggregate which is materialized for this agg type,
+// the field type decides how the aggregate is merged when doing compaction or rollup again.
+func (t AggType) RollupFieldType() Type {
+	switch t {
+	case Min:
+		return MinField
+	case Max:
+		return MaxField
+	default:
+		return SumField
+	}
+}
+
+// RollupAggTypes returns the sorted agg types which need be materialized for given rollup aggregates,
+// avg is materialized as sum and count.
+func RollupAggTypes(aggregates []string) []AggType {
+	var aggTypes []AggType
+	add := func(aggType AggType) {
+		for _, t := range aggTypes {
+			if t == aggType {
+				return
+			}
+		}
+		aggTypes = append(aggTypes, aggType)
+	}
+	for _, aggregate := range aggregates {
+		switch aggregate {
+		case "min":
+			add(Min)
+		case "max":
+			add(Max)
+		case "sum":
+			add(Sum)
+		case "count":
+			add(Count)
+		case "avg":
+			add(Sum)
+			add(Count)
+		}
+	}
+	sort.Slice(aggTypes, func(i, j int) bool { return aggTypes[i] < aggTypes[j] })
+	return aggTypes
+}
+
 // Type represents field type for LinDB support
 type Type uint8
 
@@ -75,6 +165,7 @@ const (
 	MaxField
 	GaugeField
 	HistogramField // alias for sumField, only visible for tsdb
+	StringField    // low-cardinality string value, stored as dictionary code
 )
 
 // String returns the field type's string value
@@ -90,11 +181,33 @@ func (t Type) String() string {
 		return "gauge"
 	case HistogramField:
 		return "histogram"
+	case StringField:
+		return "string"
 	default:
 		return "unknown"
 	}
 }
 
+// ParseType returns the field type by type's string value, if not match returns Unknown.
+func ParseType(name string) Type {
+	switch name {
+	case "sum":
+		return SumField
+	case "min":
+		return MinField
+	case "max":
+		return MaxField
+	case "gauge":
+		return GaugeField
+	case "histogram":
+		return HistogramField
+	case "string":
+		return StringField
+	default:
+		return Unknown
+	}
+}
+
 // AggType returns the aggregate function
 func (t Type) AggType() AggType {
 	switch t {
@@ -104,7 +217,7 @@ func (t Type) AggType() AggType {
 		return Min
 	case MaxField:
 		return Max
-	case GaugeField:
+	case GaugeField, StringField:
 		return LastValue
 	default:
 		panic("need impl")
@@ -119,7 +232,7 @@ func (t Type) DownSamplingFunc() function.FuncType {
 		return function.Min
 	case MaxField:
 		return function.Max
-	case GaugeField:
+	case GaugeField, StringField:
 		return function.LastValue
 	case HistogramField:
 		return function.Sum
@@ -132,7 +245,9 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 	switch t {
 	case SumField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.Rate:
+		case function.Sum, function.Min, function.Max, function.Rate,
+			function.Count, function.Avg, function.Stddev, function.Variance,
+			function.FirstValue, function.LastValue, function.Median, function.CountDistinct:
 			return true
 		default:
 			return false
@@ -153,7 +268,10 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 		}
 	case GaugeField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.LastValue:
+		case function.Sum, function.Min, function.Max, function.LastValue,
+			function.Count, function.Avg, function.Stddev, function.Variance, function.FirstValue,
+			function.Median, function.CountDistinct,
+			function.IRate, function.Increase, function.Derivative, function.Delta:
 			return true
 		default:
 			return false
@@ -165,6 +283,13 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 		default:
 			return false
 		}
+	case StringField:
+		switch funcType {
+		case function.LastValue, function.CountDistinct:
+			return true
+		default:
+			return false
+		}
 	default:
 		return false
 	}
@@ -184,6 +309,9 @@ func (t Type) GetFuncFieldParams(funcType function.FuncType) []AggType {
 	case HistogramField:
 		// Histogram field only supports sum
 		return []AggType{Sum}
+	case StringField:
+		// string field keeps the dictionary code of last value, distinct values are counted by dictionary
+		return []AggType{LastValue}
 	}
 	return nil
 }
@@ -195,7 +323,7 @@ func (t Type) GetDefaultFuncFieldParams() []AggType {
 		return []AggType{Sum}
 	case MinField:
 		return []AggType{Min}
-	case GaugeField:
+	case GaugeField, StringField:
 		return []AggType{LastValue}
 	case MaxField:
 		return []AggType{Max}
@@ -206,12 +334,10 @@ func (t Type) GetDefaultFuncFieldParams() []AggType {
 }
 
 func getFieldParamsForSumField(funcType function.FuncType) []AggType {
-	switch funcType {
-	case function.Max:
-		return []AggType{Max}
-	default:
-		return []AggType{Sum}
+	if aggTypes := getFieldParamsForStatFunc(funcType); len(aggTypes) > 0 {
+		return aggTypes
 	}
+	return []AggType{Sum}
 }
 
 func getFieldParamsForMinField(funcType function.FuncType) []AggType {
@@ -225,9 +351,42 @@ func getFieldParamsForMinField(funcType function.FuncType) []AggType {
 
 func getFieldParamsForGaugeField(funcType function.FuncType) []AggType {
 	switch funcType {
+	case function.Sum:
+		return []AggType{LastValue}
+	case function.Increase:
+		return []AggType{Increase}
+	case function.IRate:
+		return []AggType{IRate}
+	case function.Delta:
+		return []AggType{Delta}
+	case function.Derivative:
+		return []AggType{Derivative}
+	}
+	if aggTypes := getFieldParamsForStatFunc(funcType); len(aggTypes) > 0 {
+		return aggTypes
+	}
+	return []AggType{LastValue}
+}
+
+// getFieldParamsForStatFunc returns agg types for the functions which are calculated by raw data points,
+// stddev/variance need sum, count and sum of squares so that partial results can be combined.
+func getFieldParamsForStatFunc(funcType function.FuncType) []AggType {
+	switch funcType {
+	case function.Min:
+		return []AggType{Min}
 	case function.Max:
 		return []AggType{Max}
-	default:
+	case function.Count:
+		return []AggType{Count}
+	case function.Avg:
+		return []AggType{Sum, Count}
+	case function.Stddev, function.Variance:
+		return []AggType{Sum, Count, SumOfSquares}
+	case function.FirstValue:
+		return []AggType{FirstValue}
+	case function.LastValue:
 		return []AggType{LastValue}
+	default:
+		return nil
 	}
 }
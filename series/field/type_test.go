@@ -31,6 +31,7 @@ func TestDownSamplingFunc(t *testing.T) {
 	assert.Equal(t, function.Min, MinField.DownSamplingFunc())
 	assert.Equal(t, function.Max, MaxField.DownSamplingFunc())
 	assert.Equal(t, function.LastValue, GaugeField.DownSamplingFunc())
+	assert.Equal(t, function.LastValue, StringField.DownSamplingFunc())
 	assert.Equal(t, function.Unknown, Unknown.DownSamplingFunc())
 }
 
@@ -40,9 +41,18 @@ func TestType_String(t *testing.T) {
 	assert.Equal(t, "min", MinField.String())
 	assert.Equal(t, "gauge", GaugeField.String())
 	assert.Equal(t, "histogram", HistogramField.String())
+	assert.Equal(t, "string", StringField.String())
 	assert.Equal(t, "unknown", Unknown.String())
 }
 
+func TestParseType(t *testing.T) {
+	for _, fType := range []Type{SumField, MaxField, MinField, GaugeField, HistogramField, StringField} {
+		assert.Equal(t, fType, ParseType(fType.String()))
+	}
+	assert.Equal(t, Unknown, ParseType("unknown"))
+	assert.Equal(t, Unknown, ParseType("avg"))
+}
+
 func TestIsSupportFunc(t *testing.T) {
 	assert.True(t, HistogramField.IsFuncSupported(function.Sum))
 	assert.False(t, HistogramField.IsFuncSupported(function.LastValue))
@@ -61,7 +71,81 @@ func TestIsSupportFunc(t *testing.T) {
 	assert.True(t, MinField.IsFuncSupported(function.Min))
 	assert.False(t, MinField.IsFuncSupported(function.Quantile))
 
+	assert.True(t, StringField.IsFuncSupported(function.LastValue))
+	assert.True(t, StringField.IsFuncSupported(function.CountDistinct))
+	assert.False(t, StringField.IsFuncSupported(function.Sum))
+
 	assert.False(t, Unknown.IsFuncSupported(function.Quantile))
+
+	for _, funcType := range []function.FuncType{function.Count, function.Avg, function.Stddev,
+		function.Variance, function.FirstValue, function.Median, function.CountDistinct} {
+		assert.True(t, SumField.IsFuncSupported(funcType))
+		assert.True(t, GaugeField.IsFuncSupported(funcType))
+		assert.False(t, MaxField.IsFuncSupported(funcType))
+	}
+	// counter functions only for gauge which may be imported from cumulative sources
+	for _, funcType := range []function.FuncType{function.IRate, function.Increase,
+		function.Derivative, function.Delta} {
+		assert.True(t, GaugeField.IsFuncSupported(funcType))
+		assert.False(t, SumField.IsFuncSupported(funcType))
+	}
+}
+
+func TestGetFuncFieldParams(t *testing.T) {
+	assert.Equal(t, []AggType{Sum}, SumField.GetFuncFieldParams(function.Sum))
+	assert.Equal(t, []AggType{Min}, SumField.GetFuncFieldParams(function.Min))
+	assert.Equal(t, []AggType{Count}, SumField.GetFuncFieldParams(function.Count))
+	assert.Equal(t, []AggType{Sum, Count}, SumField.GetFuncFieldParams(function.Avg))
+	assert.Equal(t, []AggType{Sum, Count, SumOfSquares}, SumField.GetFuncFieldParams(function.Stddev))
+	assert.Equal(t, []AggType{Sum, Count, SumOfSquares}, GaugeField.GetFuncFieldParams(function.Variance))
+	assert.Equal(t, []AggType{FirstValue}, GaugeField.GetFuncFieldParams(function.FirstValue))
+	assert.Equal(t, []AggType{LastValue}, GaugeField.GetFuncFieldParams(function.Sum))
+	assert.Equal(t, []AggType{LastValue}, GaugeField.GetFuncFieldParams(function.Rate))
+	assert.Equal(t, []AggType{Increase}, GaugeField.GetFuncFieldParams(function.Increase))
+	assert.Equal(t, []AggType{IRate}, GaugeField.GetFuncFieldParams(function.IRate))
+	assert.Equal(t, []AggType{Delta}, GaugeField.GetFuncFieldParams(function.Delta))
+	assert.Equal(t, []AggType{Derivative}, GaugeField.GetFuncFieldParams(function.Derivative))
+	assert.Equal(t, []AggType{Max}, MinField.GetFuncFieldParams(function.Max))
+	assert.Equal(t, []AggType{Sum}, HistogramField.GetFuncFieldParams(function.Sum))
+	assert.Equal(t, []AggType{LastValue}, StringField.GetFuncFieldParams(function.CountDistinct))
+	// median/count distinct are calculated by the values collected with series
+	assert.Equal(t, []AggType{Sum}, SumField.GetFuncFieldParams(function.Median))
+	assert.Equal(t, []AggType{LastValue}, GaugeField.GetFuncFieldParams(function.CountDistinct))
+	assert.Equal(t, []AggType{LastValue}, StringField.GetDefaultFuncFieldParams())
+	assert.Equal(t, LastValue, StringField.AggType())
+}
+
+func TestAggType_PointValue(t *testing.T) {
+	assert.Equal(t, 1.0, Count.PointValue(10))
+	assert.Equal(t, 100.0, SumOfSquares.PointValue(10))
+	assert.Equal(t, 10.0, Sum.PointValue(10))
+	assert.Equal(t, 10.0, FirstValue.PointValue(10))
+}
+
+func TestAggType_Adjacent(t *testing.T) {
+	assert.Equal(t, function.Increase, Increase.AdjacentFunc())
+	assert.Equal(t, function.IRate, IRate.AdjacentFunc())
+	assert.Equal(t, function.Delta, Delta.AdjacentFunc())
+	assert.Equal(t, function.Derivative, Derivative.AdjacentFunc())
+	assert.Equal(t, function.Unknown, Sum.AdjacentFunc())
+	assert.True(t, Increase.IsAdjacent())
+	assert.False(t, LastValue.IsAdjacent())
+	// results of series are summed
+	assert.Equal(t, 3.0, Increase.Aggregate(1, 2))
+	assert.Equal(t, -1.0, Delta.Aggregate(1, -2))
+}
+
+func TestAggType_RollupFieldType(t *testing.T) {
+	assert.Equal(t, MinField, Min.RollupFieldType())
+	assert.Equal(t, MaxField, Max.RollupFieldType())
+	assert.Equal(t, SumField, Sum.RollupFieldType())
+	assert.Equal(t, SumField, Count.RollupFieldType())
+}
+
+func TestRollupAggTypes(t *testing.T) {
+	assert.Empty(t, RollupAggTypes(nil))
+	assert.Equal(t, []AggType{Sum, Count, Max}, RollupAggTypes([]string{"max", "avg", "sum"}))
+	assert.Equal(t, []AggType{Min}, RollupAggTypes([]string{"min", "unknown"}))
 }
 
 func TestSumAgg(t *testing.T) {
@@ -79,6 +163,11 @@ func TestMinAgg(t *testing.T) {
 	assert.Equal(t, 1.0, MinField.AggType().Aggregate(99.0, 1))
 }
 
+func TestFirstValueAgg(t *testing.T) {
+	assert.Equal(t, 1.0, FirstValue.Aggregate(1, 99.0))
+	assert.Equal(t, 100.0, SumOfSquares.Aggregate(1, 99.0))
+}
+
 func TestMaxAgg(t *testing.T) {
 	assert.Equal(t, 99.0, MaxField.AggType().Aggregate(1, 99.0))
 	assert.Equal(t, 99.0, MaxField.AggType().Aggregate(99.0, 1))
@@ -31,6 +31,8 @@ import (
 type TimeSeriesEvent struct {
 	SeriesList      GroupedIterators
 	AggregatorSpecs map[string]*protoCommonV1.AggregatorSpec
+	Exemplars       map[string][]*models.Exemplar    // tags => exemplars
+	StringValues    map[string]models.StringValueSet // tags => distinct values of string fields
 	Stats           *models.QueryStats
 	Err             error
 }
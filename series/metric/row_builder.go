@@ -31,6 +31,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fasttime"
 	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
+	"github.com/lindb/lindb/series/field"
 )
 
 type rowKV struct {
@@ -53,9 +54,18 @@ func (items rowKVs) Less(i, j int) bool {
 }
 
 type rowSimpleField struct {
-	name  []byte
-	fType flatMetricsV1.SimpleFieldType
-	value float64
+	name      []byte
+	fType     flatMetricsV1.SimpleFieldType
+	value     float64
+	exemplars []rowExemplar
+	// raw value of string field, value is the dictionary code of it
+	stringValue []byte
+}
+
+type rowExemplar struct {
+	traceID  []byte
+	spanID   []byte
+	duration int64
 }
 
 // RowBuilder builds a flat metric in order.
@@ -77,14 +87,17 @@ type RowBuilder struct {
 	compoundFieldMax            float64
 	compoundFieldSum            float64
 	compoundFieldCount          float64
+	compoundFieldExemplars      []rowExemplar
 
 	// context for building flat metrics
-	flatBuilder *flatbuffers.Builder
-	keys        []flatbuffers.UOffsetT
-	values      []flatbuffers.UOffsetT
-	kvs         []flatbuffers.UOffsetT
-	fieldNames  []flatbuffers.UOffsetT
-	fields      []flatbuffers.UOffsetT
+	flatBuilder  *flatbuffers.Builder
+	keys         []flatbuffers.UOffsetT
+	values       []flatbuffers.UOffsetT
+	kvs          []flatbuffers.UOffsetT
+	fieldNames   []flatbuffers.UOffsetT
+	stringValues []flatbuffers.UOffsetT
+	fields       []flatbuffers.UOffsetT
+	exemplars    []flatbuffers.UOffsetT
 }
 
 var rowBuilderPool sync.Pool
@@ -159,6 +172,32 @@ func (rb *RowBuilder) AddSimpleField(fieldName []byte, fieldType flatMetricsV1.S
 	// copy field type, field value
 	rb.simpleFields[sfIdx].fType = fieldType
 	rb.simpleFields[sfIdx].value = fieldValue
+	rb.simpleFields[sfIdx].exemplars = rb.simpleFields[sfIdx].exemplars[:0]
+	rb.simpleFields[sfIdx].stringValue = rb.simpleFields[sfIdx].stringValue[:0]
+	return nil
+}
+
+// AddStringField appends a low-cardinality string field,
+// the value of field is the dictionary code of string value.
+func (rb *RowBuilder) AddStringField(fieldName, fieldValue []byte) error {
+	if err := rb.AddSimpleField(fieldName, flatMetricsV1.SimpleFieldTypeString, field.StringCode(string(fieldValue))); err != nil {
+		return err
+	}
+	sf := &rb.simpleFields[rb.simpleFieldCount-1]
+	sf.stringValue = append(sf.stringValue[:0], fieldValue...)
+	return nil
+}
+
+// AddSimpleFieldExemplar appends an exemplar to the last added simple field.
+func (rb *RowBuilder) AddSimpleFieldExemplar(traceID, spanID []byte, duration int64) error {
+	if rb.simpleFieldCount == 0 {
+		return fmt.Errorf("simple field is empty")
+	}
+	if len(traceID) == 0 {
+		return fmt.Errorf("exemplar trace id is empty")
+	}
+	sf := &rb.simpleFields[rb.simpleFieldCount-1]
+	sf.exemplars = appendExemplar(sf.exemplars, traceID, spanID, duration)
 	return nil
 }
 
@@ -215,6 +254,29 @@ func (rb *RowBuilder) AddCompoundFieldMMSC(min, max, sum, count float64) error {
 	return nil
 }
 
+// AddCompoundFieldExemplar appends an exemplar to the compound field.
+func (rb *RowBuilder) AddCompoundFieldExemplar(traceID, spanID []byte, duration int64) error {
+	if len(traceID) == 0 {
+		return fmt.Errorf("exemplar trace id is empty")
+	}
+	rb.compoundFieldExemplars = appendExemplar(rb.compoundFieldExemplars, traceID, spanID, duration)
+	return nil
+}
+
+// appendExemplar appends an exemplar, reusing the buffers of slice.
+func appendExemplar(exemplars []rowExemplar, traceID, spanID []byte, duration int64) []rowExemplar {
+	if len(exemplars) < cap(exemplars) {
+		exemplars = exemplars[:len(exemplars)+1]
+	} else {
+		exemplars = append(exemplars, rowExemplar{})
+	}
+	e := &exemplars[len(exemplars)-1]
+	e.traceID = append(e.traceID[:0], traceID...)
+	e.spanID = append(e.spanID[:0], spanID...)
+	e.duration = duration
+	return exemplars
+}
+
 func (rb *RowBuilder) AddMetricName(metricName []byte) {
 	if ShouldSanitizeNamespaceOrMetricName(metricName) {
 		metricName = SanitizeNamespaceOrMetricName(metricName)
@@ -250,6 +312,7 @@ func (rb *RowBuilder) Reset() {
 	rb.compoundFieldMax = 0
 	rb.compoundFieldSum = 0
 	rb.compoundFieldCount = 0
+	rb.compoundFieldExemplars = rb.compoundFieldExemplars[:0]
 
 	// reset flat builder context
 	rb.flatBuilder.Reset()
@@ -257,7 +320,9 @@ func (rb *RowBuilder) Reset() {
 	rb.values = rb.values[:0]
 	rb.kvs = rb.kvs[:0]
 	rb.fieldNames = rb.fieldNames[:0]
+	rb.stringValues = rb.stringValues[:0]
 	rb.fields = rb.fields[:0]
+	rb.exemplars = rb.exemplars[:0]
 }
 
 var (
@@ -341,13 +406,32 @@ func (rb *RowBuilder) Build() ([]byte, error) {
 	for i := 0; i < rb.simpleFieldCount; i++ {
 		rb.fieldNames = append(rb.fieldNames, rb.flatBuilder.CreateByteString(rb.simpleFields[i].name))
 	}
+	// building field exemplars, reuse fields slice for holding exemplars vector offsets
+	for i := 0; i < rb.simpleFieldCount; i++ {
+		rb.fields = append(rb.fields, rb.buildExemplars(rb.simpleFields[i].exemplars))
+	}
+	// building raw values of string fields
+	for i := 0; i < rb.simpleFieldCount; i++ {
+		var stringValue flatbuffers.UOffsetT
+		if rb.simpleFields[i].fType == flatMetricsV1.SimpleFieldTypeString {
+			stringValue = rb.flatBuilder.CreateByteString(rb.simpleFields[i].stringValue)
+		}
+		rb.stringValues = append(rb.stringValues, stringValue)
+	}
 
 	for i := 0; i < rb.simpleFieldCount; i++ {
+		exemplars := rb.fields[i]
 		flatMetricsV1.SimpleFieldStart(rb.flatBuilder)
 		flatMetricsV1.SimpleFieldAddName(rb.flatBuilder, rb.fieldNames[i])
 		flatMetricsV1.SimpleFieldAddType(rb.flatBuilder, rb.simpleFields[i].fType)
 		flatMetricsV1.SimpleFieldAddValue(rb.flatBuilder, rb.simpleFields[i].value)
-		rb.fields = append(rb.fields, flatMetricsV1.SimpleFieldEnd(rb.flatBuilder))
+		if exemplars != 0 {
+			flatMetricsV1.SimpleFieldAddExemplars(rb.flatBuilder, exemplars)
+		}
+		if rb.stringValues[i] != 0 {
+			flatMetricsV1.SimpleFieldAddStringValue(rb.flatBuilder, rb.stringValues[i])
+		}
+		rb.fields[i] = flatMetricsV1.SimpleFieldEnd(rb.flatBuilder)
 	}
 	flatMetricsV1.MetricStartKeyValuesVector(rb.flatBuilder, rb.rowKVs.kvCount)
 	for i := rb.rowKVs.kvCount - 1; i >= 0; i-- {
@@ -365,6 +449,7 @@ func (rb *RowBuilder) Build() ([]byte, error) {
 		compoundFieldBounds flatbuffers.UOffsetT
 		compoundFieldValues flatbuffers.UOffsetT
 		compoundField       flatbuffers.UOffsetT
+		compoundExemplars   flatbuffers.UOffsetT
 	)
 	if len(rb.compoundFieldValues) == 0 {
 		goto Serialize
@@ -382,6 +467,7 @@ func (rb *RowBuilder) Build() ([]byte, error) {
 		rb.flatBuilder.PrependFloat64(rb.compoundFieldExplicitValues[i])
 	}
 	compoundFieldBounds = rb.flatBuilder.EndVector(len(rb.compoundFieldExplicitValues))
+	compoundExemplars = rb.buildExemplars(rb.compoundFieldExemplars)
 	// add count sum min max
 	flatMetricsV1.CompoundFieldStart(rb.flatBuilder)
 	flatMetricsV1.CompoundFieldAddCount(rb.flatBuilder, rb.compoundFieldCount)
@@ -390,6 +476,9 @@ func (rb *RowBuilder) Build() ([]byte, error) {
 	flatMetricsV1.CompoundFieldAddMax(rb.flatBuilder, rb.compoundFieldMax)
 	flatMetricsV1.CompoundFieldAddValues(rb.flatBuilder, compoundFieldValues)
 	flatMetricsV1.CompoundFieldAddExplicitBounds(rb.flatBuilder, compoundFieldBounds)
+	if compoundExemplars != 0 {
+		flatMetricsV1.CompoundFieldAddExemplars(rb.flatBuilder, compoundExemplars)
+	}
 	compoundField = flatMetricsV1.CompoundFieldEnd(rb.flatBuilder)
 
 Serialize:
@@ -428,3 +517,37 @@ func (rb *RowBuilder) BuildTo(row *BrokerRow) error {
 }
 
 func (rb *RowBuilder) SimpleFieldsLen() int { return rb.simpleFieldCount }
+
+// buildExemplars serializes the exemplars of field, returns 0 if no exemplars.
+func (rb *RowBuilder) buildExemplars(exemplars []rowExemplar) flatbuffers.UOffsetT {
+	rb.exemplars = rb.exemplars[:0]
+	for i := range exemplars {
+		rb.exemplars = append(rb.exemplars,
+			createFlatExemplar(rb.flatBuilder, exemplars[i].traceID, exemplars[i].spanID, exemplars[i].duration))
+	}
+	return createFlatExemplarsVector(rb.flatBuilder, rb.exemplars)
+}
+
+// createFlatExemplar serializes an exemplar.
+func createFlatExemplar(builder *flatbuffers.Builder, traceID, spanID []byte, duration int64) flatbuffers.UOffsetT {
+	traceIDOffset := builder.CreateByteVector(traceID)
+	spanIDOffset := builder.CreateByteVector(spanID)
+	flatMetricsV1.ExemplarStart(builder)
+	flatMetricsV1.ExemplarAddSpanID(builder, spanIDOffset)
+	flatMetricsV1.ExemplarAddTraceID(builder, traceIDOffset)
+	flatMetricsV1.ExemplarAddDuration(builder, duration)
+	return flatMetricsV1.ExemplarEnd(builder)
+}
+
+// createFlatExemplarsVector serializes the exemplars vector, returns 0 if exemplars is empty.
+func createFlatExemplarsVector(builder *flatbuffers.Builder, exemplars []flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	if len(exemplars) == 0 {
+		return 0
+	}
+	// simple field and compound field share the same vector layout
+	flatMetricsV1.SimpleFieldStartExemplarsVector(builder, len(exemplars))
+	for i := len(exemplars) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(exemplars[i])
+	}
+	return builder.EndVector(len(exemplars))
+}
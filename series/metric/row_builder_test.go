@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
+	"github.com/lindb/lindb/series/field"
 )
 
 func Test_NewRowBuilder(t *testing.T) {
@@ -58,6 +59,14 @@ func Test_RowBuilder_ErrorCases(t *testing.T) {
 	assert.Error(t, rb.AddSimpleField([]byte("f1"), flatMetricsV1.SimpleFieldTypeDeltaSum, math.NaN()))
 	assert.Zero(t, rb.SimpleFieldsLen())
 
+	// exemplar validation
+	assert.Error(t, rb.AddSimpleFieldExemplar([]byte("trace"), nil, 1))
+	assert.NoError(t, rb.AddSimpleField([]byte("f1"), flatMetricsV1.SimpleFieldTypeDeltaSum, 1))
+	assert.Error(t, rb.AddSimpleFieldExemplar(nil, []byte("span"), 1))
+	assert.NoError(t, rb.AddSimpleFieldExemplar([]byte("trace"), []byte("span"), 1))
+	assert.Error(t, rb.AddCompoundFieldExemplar(nil, []byte("span"), 1))
+	rb.Reset()
+
 	// compound field validation
 	assert.Error(t, rb.AddCompoundFieldData([]float64{1, 2}, []float64{1}))
 	assert.Error(t, rb.AddCompoundFieldData([]float64{1}, []float64{1}))
@@ -137,6 +146,24 @@ func Test_RowBuilder_OneSimpleField(t *testing.T) {
 	assert.Equal(t, "cpu", string(row.m.Name()))
 }
 
+func Test_RowBuilder_StringField(t *testing.T) {
+	rb := newRowBuilder()
+	rb.AddMetricName([]byte("app"))
+	assert.NoError(t, rb.AddStringField([]byte("version"), []byte("v1.0.0")))
+	assert.NoError(t, rb.AddSimpleField([]byte("idle"), flatMetricsV1.SimpleFieldTypeGauge, 1))
+	var row BrokerRow
+	assert.NoError(t, rb.BuildTo(&row))
+	assert.Equal(t, 2, row.m.SimpleFieldsLength())
+
+	var sf flatMetricsV1.SimpleField
+	row.m.SimpleFields(&sf, 0)
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeString, sf.Type())
+	assert.Equal(t, "v1.0.0", string(sf.StringValue()))
+	assert.Equal(t, field.StringCode("v1.0.0"), sf.Value())
+	row.m.SimpleFields(&sf, 1)
+	assert.Nil(t, sf.StringValue())
+}
+
 func Test_RowBuilder_BuildTo(t *testing.T) {
 	rb := newRowBuilder()
 	assert.NoError(t, rb.AddTag([]byte("ip"), []byte("1.1.1.1")))
@@ -24,6 +24,7 @@ import (
 
 	flatbuffers "github.com/google/flatbuffers/go"
 
+	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
 	"github.com/lindb/lindb/series/tag"
 )
 
@@ -141,13 +142,20 @@ func (itr *BrokerRowFlatDecoder) rebuild() error {
 
 	simpleFieldItr := itr.originRow.NewSimpleFieldIterator()
 	for simpleFieldItr.HasNext() {
-		if err := itr.rowBuilder.AddSimpleField(
-			simpleFieldItr.NextRawName(),
-			simpleFieldItr.NextRawType(),
-			simpleFieldItr.NextValue(),
-		); err != nil {
+		var err error
+		if simpleFieldItr.NextRawType() == flatMetricsV1.SimpleFieldTypeString {
+			err = itr.rowBuilder.AddStringField(simpleFieldItr.NextRawName(), simpleFieldItr.NextStringValue())
+		} else {
+			err = itr.rowBuilder.AddSimpleField(
+				simpleFieldItr.NextRawName(),
+				simpleFieldItr.NextRawType(),
+				simpleFieldItr.NextValue(),
+			)
+		}
+		if err != nil {
 			return err
 		}
+		simpleFieldItr.NextExemplars(itr.addSimpleFieldExemplar)
 	}
 	compoundFieldItr, ok := itr.originRow.NewCompoundFieldIterator()
 	if !ok {
@@ -168,6 +176,7 @@ func (itr *BrokerRowFlatDecoder) rebuild() error {
 	); err != nil {
 		return err
 	}
+	compoundFieldItr.Exemplars(itr.addCompoundFieldExemplar)
 
 End:
 	itr.rowBuilder.AddMetricName(itr.originRow.Name())
@@ -179,3 +188,13 @@ End:
 	}
 	return nil
 }
+
+// addSimpleFieldExemplar keeps the exemplar of simple field, ignores invalid exemplar.
+func (itr *BrokerRowFlatDecoder) addSimpleFieldExemplar(traceID, spanID []byte, duration int64) {
+	_ = itr.rowBuilder.AddSimpleFieldExemplar(traceID, spanID, duration)
+}
+
+// addCompoundFieldExemplar keeps the exemplar of compound field, ignores invalid exemplar.
+func (itr *BrokerRowFlatDecoder) addCompoundFieldExemplar(traceID, spanID []byte, duration int64) {
+	_ = itr.rowBuilder.AddCompoundFieldExemplar(traceID, spanID, duration)
+}
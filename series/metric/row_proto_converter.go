@@ -40,6 +40,9 @@ type BrokerRowProtoConverter struct {
 	kvs        []flatbuffers.UOffsetT
 	fieldNames []flatbuffers.UOffsetT
 	fields     []flatbuffers.UOffsetT
+	// exemplars vector offset of each simple field
+	fieldExemplars []flatbuffers.UOffsetT
+	exemplars      []flatbuffers.UOffsetT
 
 	// ingestion meta info
 	namespace    []byte
@@ -60,6 +63,8 @@ func (rc *BrokerRowProtoConverter) resetForNextConverter() {
 	rc.fieldNames = rc.fieldNames[:0]
 	rc.kvs = rc.kvs[:0]
 	rc.fields = rc.fields[:0]
+	rc.fieldExemplars = rc.fieldExemplars[:0]
+	rc.exemplars = rc.exemplars[:0]
 }
 
 func (rc *BrokerRowProtoConverter) validateMetric(m *protoMetricsV1.Metric) error {
@@ -209,6 +214,7 @@ func (rc *BrokerRowProtoConverter) MarshalProtoMetricV1(m *protoMetricsV1.Metric
 
 	for i := 0; i < len(m.SimpleFields); i++ {
 		rc.fieldNames = append(rc.fieldNames, rc.flatBuilder.CreateString(m.SimpleFields[i].Name))
+		rc.fieldExemplars = append(rc.fieldExemplars, rc.buildExemplars(m.SimpleFields[i].Exemplars))
 	}
 
 	// building field names
@@ -227,6 +233,9 @@ func (rc *BrokerRowProtoConverter) MarshalProtoMetricV1(m *protoMetricsV1.Metric
 			flatMetricsV1.SimpleFieldAddType(rc.flatBuilder, flatMetricsV1.SimpleFieldTypeMin)
 		}
 		flatMetricsV1.SimpleFieldAddValue(rc.flatBuilder, sf.Value)
+		if rc.fieldExemplars[i] != 0 {
+			flatMetricsV1.SimpleFieldAddExemplars(rc.flatBuilder, rc.fieldExemplars[i])
+		}
 		rc.fields = append(rc.fields, flatMetricsV1.SimpleFieldEnd(rc.flatBuilder))
 	}
 
@@ -248,6 +257,7 @@ func (rc *BrokerRowProtoConverter) MarshalProtoMetricV1(m *protoMetricsV1.Metric
 		compoundFieldBounds flatbuffers.UOffsetT
 		compoundFieldValues flatbuffers.UOffsetT
 		compoundField       flatbuffers.UOffsetT
+		compoundExemplars   flatbuffers.UOffsetT
 	)
 
 	if m.CompoundField == nil {
@@ -266,6 +276,7 @@ func (rc *BrokerRowProtoConverter) MarshalProtoMetricV1(m *protoMetricsV1.Metric
 		rc.flatBuilder.PrependFloat64(m.CompoundField.ExplicitBounds[i])
 	}
 	compoundFieldBounds = rc.flatBuilder.EndVector(len(m.CompoundField.ExplicitBounds))
+	compoundExemplars = rc.buildExemplars(m.CompoundField.Exemplars)
 
 	// add count sum min max
 	flatMetricsV1.CompoundFieldStart(rc.flatBuilder)
@@ -275,6 +286,9 @@ func (rc *BrokerRowProtoConverter) MarshalProtoMetricV1(m *protoMetricsV1.Metric
 	flatMetricsV1.CompoundFieldAddMax(rc.flatBuilder, m.CompoundField.Max)
 	flatMetricsV1.CompoundFieldAddValues(rc.flatBuilder, compoundFieldValues)
 	flatMetricsV1.CompoundFieldAddExplicitBounds(rc.flatBuilder, compoundFieldBounds)
+	if compoundExemplars != 0 {
+		flatMetricsV1.CompoundFieldAddExemplars(rc.flatBuilder, compoundExemplars)
+	}
 	compoundField = flatMetricsV1.CompoundFieldEnd(rc.flatBuilder)
 
 Serialize:
@@ -300,6 +314,19 @@ Serialize:
 	return rc.flatBuilder.FinishedBytes(), nil
 }
 
+// buildExemplars serializes the exemplars of field, returns 0 if no exemplars.
+func (rc *BrokerRowProtoConverter) buildExemplars(exemplars []*protoMetricsV1.Exemplar) flatbuffers.UOffsetT {
+	rc.exemplars = rc.exemplars[:0]
+	for _, e := range exemplars {
+		// skip exemplar without trace
+		if e == nil || len(e.TraceId) == 0 {
+			continue
+		}
+		rc.exemplars = append(rc.exemplars, createFlatExemplar(rc.flatBuilder, e.TraceId, e.SpanId, e.Duration))
+	}
+	return createFlatExemplarsVector(rc.flatBuilder, rc.exemplars)
+}
+
 func (rc *BrokerRowProtoConverter) MarshalProtoMetricV1To(m *protoMetricsV1.Metric, writer io.Writer) (n int, err error) {
 	block, err := rc.MarshalProtoMetricV1(m)
 	if err != nil {
@@ -316,3 +316,75 @@ func Test_BrokerRowProtoConverter_deDupTags(t *testing.T) {
 		{Key: "a", Value: "1"},
 	}, m2.Tags)
 }
+
+func Test_BrokerRowProtoConverter_Exemplars(t *testing.T) {
+	m := &protoMetricsV1.Metric{
+		Name: "test-metric",
+		Tags: []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{
+				Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1,
+				Exemplars: []*protoMetricsV1.Exemplar{
+					{TraceId: []byte("trace-1"), SpanId: []byte("span-1"), Duration: 10},
+					{SpanId: []byte("no-trace")},
+					nil,
+				},
+			},
+			{Name: "f2", Type: protoMetricsV1.SimpleFieldType_GAUGE, Value: 1},
+		},
+		CompoundField: &protoMetricsV1.CompoundField{
+			Min: 1, Max: 1, Sum: 1, Count: 1,
+			ExplicitBounds: []float64{1, 2, math.Inf(1)},
+			Values:         []float64{0, 1, 0},
+			Exemplars: []*protoMetricsV1.Exemplar{
+				{TraceId: []byte("trace-2"), Duration: 20},
+			},
+		},
+	}
+	converter := NewProtoConverter()
+	var buf bytes.Buffer
+	_, err := converter.MarshalProtoMetricV1To(m, &buf)
+	assert.NoError(t, err)
+
+	type exemplar struct {
+		traceID, spanID string
+		duration        int64
+	}
+	collect := func(rs *[]exemplar) ExemplarFn {
+		return func(traceID, spanID []byte, duration int64) {
+			*rs = append(*rs, exemplar{traceID: string(traceID), spanID: string(spanID), duration: duration})
+		}
+	}
+	assertRow := func(block []byte) {
+		rows := NewStorageBatchRows()
+		rows.UnmarshalRows(block)
+		assert.Equal(t, 1, rows.Len())
+		row := rows.Rows()[0]
+		var simple [][]exemplar
+		itr := row.NewSimpleFieldIterator()
+		for itr.HasNext() {
+			var rs []exemplar
+			itr.NextExemplars(collect(&rs))
+			simple = append(simple, rs)
+		}
+		assert.Equal(t, [][]exemplar{{{traceID: "trace-1", spanID: "span-1", duration: 10}}, nil}, simple)
+		compoundItr, ok := row.NewCompoundFieldIterator()
+		assert.True(t, ok)
+		var compound []exemplar
+		compoundItr.Exemplars(collect(&compound))
+		assert.Equal(t, []exemplar{{traceID: "trace-2", duration: 20}}, compound)
+	}
+	assertRow(buf.Bytes())
+
+	// rebuild by flat decoder with enriched tags
+	decoder, releaseFunc := NewBrokerRowFlatDecoder(bytes.NewReader(buf.Bytes()), nil,
+		tag.Tags{tag.NewTag([]byte("a"), []byte("b"))})
+	defer releaseFunc(decoder)
+	var row BrokerRow
+	assert.True(t, decoder.HasNext())
+	assert.NoError(t, decoder.DecodeTo(&row))
+	var rebuilt bytes.Buffer
+	_, err = row.WriteTo(&rebuilt)
+	assert.NoError(t, err)
+	assertRow(rebuilt.Bytes())
+}
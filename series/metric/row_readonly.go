@@ -23,6 +23,8 @@ import (
 	"strconv"
 	"strings"
 
+	flatbuffers "github.com/google/flatbuffers/go"
+
 	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
 	"github.com/lindb/lindb/series/field"
 )
@@ -49,6 +51,18 @@ func (mr *readOnlyRow) TagsLen() int { return mr.m.KeyValuesLength() }
 
 func (mr *readOnlyRow) SimpleFieldsLen() int { return mr.m.SimpleFieldsLength() }
 
+// HasExemplars returns if any field of row has exemplars.
+func (mr *readOnlyRow) HasExemplars() bool {
+	var f flatMetricsV1.SimpleField
+	for idx := 0; idx < mr.m.SimpleFieldsLength(); idx++ {
+		if mr.m.SimpleFields(&f, idx) && f.ExemplarsLength() > 0 {
+			return true
+		}
+	}
+	var compound flatMetricsV1.CompoundField
+	return mr.m.CompoundField(&compound) != nil && compound.ExemplarsLength() > 0
+}
+
 func (mr *readOnlyRow) NewKeyValueIterator() *KeyValueIterator {
 	mr.keyValueIterator.idx = -1
 	mr.keyValueIterator.m = &mr.m
@@ -126,6 +140,9 @@ func (itr *SimpleFieldIterator) NextRawName() []byte { return itr.f.Name() }
 
 func (itr *SimpleFieldIterator) NextValue() float64 { return itr.f.Value() }
 
+// NextStringValue returns the raw value of string field, value of string field is the dictionary code of it.
+func (itr *SimpleFieldIterator) NextStringValue() []byte { return itr.f.StringValue() }
+
 func (itr *SimpleFieldIterator) NextRawType() flatMetricsV1.SimpleFieldType { return itr.f.Type() }
 
 func (itr *SimpleFieldIterator) NextType() field.Type {
@@ -139,11 +156,18 @@ func (itr *SimpleFieldIterator) NextType() field.Type {
 		return field.MaxField
 	case flatMetricsV1.SimpleFieldTypeMin:
 		return field.MinField
+	case flatMetricsV1.SimpleFieldTypeString:
+		return field.StringField
 	default:
 		return field.Unknown
 	}
 }
 
+// NextExemplars visits the exemplars of current simple field.
+func (itr *SimpleFieldIterator) NextExemplars(fn ExemplarFn) {
+	visitExemplars(itr.f.ExemplarsLength(), itr.f.Exemplars, fn)
+}
+
 type CompoundFieldIterator struct {
 	m   *flatMetricsV1.Metric
 	f   flatMetricsV1.CompoundField
@@ -174,24 +198,30 @@ func (itr *CompoundFieldIterator) Sum() float64 { return itr.f.Sum() }
 
 func (itr *CompoundFieldIterator) Count() float64 { return itr.f.Count() }
 
+// Exemplars visits the exemplars of compound field.
+func (itr *CompoundFieldIterator) Exemplars(fn ExemplarFn) {
+	visitExemplars(itr.f.ExemplarsLength(), itr.f.Exemplars, fn)
+}
+
 func (itr *CompoundFieldIterator) BucketName() field.Name {
 	return field.Name(BucketNameOfHistogramExplicitBound(itr.NextExplicitBound()))
 }
 
+// reserved field names of histogram, stored with explicit bucket fields of histogram.
 const (
-	histogramSum   = field.Name("HistogramSum")
-	histogramCount = field.Name("HistogramCount")
-	histogramMax   = field.Name("HistogramMax")
-	histogramMin   = field.Name("HistogramMin")
+	HistogramSum   = field.Name("HistogramSum")
+	HistogramCount = field.Name("HistogramCount")
+	HistogramMax   = field.Name("HistogramMax")
+	HistogramMin   = field.Name("HistogramMin")
 )
 
-func (itr *CompoundFieldIterator) HistogramSumFieldName() field.Name { return histogramSum }
+func (itr *CompoundFieldIterator) HistogramSumFieldName() field.Name { return HistogramSum }
 
-func (itr *CompoundFieldIterator) HistogramCountFieldName() field.Name { return histogramCount }
+func (itr *CompoundFieldIterator) HistogramCountFieldName() field.Name { return HistogramCount }
 
-func (itr *CompoundFieldIterator) HistogramMaxFieldName() field.Name { return histogramMax }
+func (itr *CompoundFieldIterator) HistogramMaxFieldName() field.Name { return HistogramMax }
 
-func (itr *CompoundFieldIterator) HistogramMinFieldName() field.Name { return histogramMin }
+func (itr *CompoundFieldIterator) HistogramMinFieldName() field.Name { return HistogramMin }
 
 // BucketNameOfHistogramExplicitBound converts reserved field-name for histogram buckets.
 func BucketNameOfHistogramExplicitBound(upperBound float64) string {
@@ -210,3 +240,28 @@ func UpperBound(bucketName string) (float64, error) {
 	raw := bucketName[len("__bucket_"):]
 	return strconv.ParseFloat(raw, 64)
 }
+
+// ExemplarFn represents the callback of visiting field's exemplar,
+// trace id and span id reference the underlying row buffer, copy them if need keep.
+type ExemplarFn func(traceID, spanID []byte, duration int64)
+
+// visitExemplars visits the exemplars of field.
+func visitExemplars(length int, get func(obj *flatMetricsV1.Exemplar, j int) bool, fn ExemplarFn) {
+	var e flatMetricsV1.Exemplar
+	for j := 0; j < length; j++ {
+		if !get(&e, j) {
+			continue
+		}
+		tab := e.Table()
+		fn(exemplarBytes(&tab, 6), exemplarBytes(&tab, 4), e.Duration())
+	}
+}
+
+// exemplarBytes returns the byte vector of exemplar's slot without copy.
+func exemplarBytes(tab *flatbuffers.Table, slot flatbuffers.VOffsetT) []byte {
+	o := flatbuffers.UOffsetT(tab.Offset(slot))
+	if o == 0 {
+		return nil
+	}
+	return tab.ByteVector(o + tab.Pos)
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// deleteStmtParser represents delete statement parser,
+// reuses query statement parser for visiting from/where clause.
+type deleteStmtParser struct {
+	*queryStmtParser
+}
+
+// newDeleteStmtParse creates a delete statement parser.
+func newDeleteStmtParse() *deleteStmtParser {
+	return &deleteStmtParser{
+		queryStmtParser: newQueryStmtParse(false),
+	}
+}
+
+// build returns the delete statement.
+func (d *deleteStmtParser) build() (stmt.Statement, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	if d.metricName == "" {
+		return nil, fmt.Errorf("metric name cannot be empty")
+	}
+	if err := d.parseTimeStr(); err != nil {
+		return nil, err
+	}
+	deleteStmt := &stmt.Delete{
+		Namespace:  d.namespace,
+		MetricName: d.metricName,
+		Condition:  d.condition,
+		// if start time not set, delete all history data before end time
+		TimeRange: timeutil.TimeRange{Start: d.startTime, End: d.endTime},
+	}
+	if deleteStmt.TimeRange.Start < 0 {
+		deleteStmt.TimeRange.Start = 0
+	}
+	if deleteStmt.TimeRange.End <= 0 {
+		deleteStmt.TimeRange.End = timeutil.Now()
+	}
+	if deleteStmt.TimeRange.End < deleteStmt.TimeRange.Start {
+		return nil, fmt.Errorf("start time cannot be larger than end time")
+	}
+	return deleteStmt, nil
+}
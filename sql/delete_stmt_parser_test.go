@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteStatement(t *testing.T) {
+	q, err := Parse("delete from cpu on 'system' where host='1.1.1.1' and region in ('sh','bj')")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.Delete)
+	assert.Equal(t, "system", deleteStmt.Namespace)
+	assert.Equal(t, "cpu", deleteStmt.MetricName)
+	assert.Equal(t, &stmt.BinaryExpr{
+		Left:     &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"},
+		Operator: stmt.AND,
+		Right:    &stmt.InExpr{Key: "region", Values: []string{"sh", "bj"}},
+	}, deleteStmt.Condition)
+	assert.Equal(t, int64(0), deleteStmt.TimeRange.Start)
+	assert.True(t, deleteStmt.TimeRange.End > 0)
+	assert.Equal(t, stmt.DeleteStatement, deleteStmt.StatementType())
+
+	q, err = Parse("delete from cpu where host='1.1.1.1' and time>'20190410 00:00:00' and time<'20190410 10:00:00'")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Equal(t, constants.DefaultNamespace, deleteStmt.Namespace)
+	startTime, _ := timeutil.ParseTimestamp("20190410 00:00:00")
+	endTime, _ := timeutil.ParseTimestamp("20190410 10:00:00")
+	assert.Equal(t, timeutil.TimeRange{Start: startTime, End: endTime}, deleteStmt.TimeRange)
+	assert.Equal(t, &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"}, deleteStmt.Condition)
+
+	// only time range
+	q, err = Parse("delete from cpu where time>'20190410 00:00:00'")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Nil(t, deleteStmt.Condition)
+	assert.Equal(t, startTime, deleteStmt.TimeRange.Start)
+}
+
+func TestDeleteStatement_Fail(t *testing.T) {
+	// where clause is required
+	_, err := Parse("delete from cpu")
+	assert.Error(t, err)
+	// start > end
+	_, err = Parse("delete from cpu where time>'20190410 11:00:00' and time<'20190410 10:00:00'")
+	assert.Error(t, err)
+	// bad time
+	_, err = Parse("delete from cpu where time>'abc'")
+	assert.Error(t, err)
+	_, err = newDeleteStmtParse().build()
+	assert.Error(t, err)
+}
@@ -15,6 +15,7 @@ statementList           : showMasterStmt
                         | showStorageMetricStmt
                         | createStorageStmt
                         | showReplicationStmt
+                        | showLimitsStmt
                         | showSchemasStmt
                         | showDatabaseStmt
                         | useStmt
@@ -23,9 +24,24 @@ statementList           : showMasterStmt
                         | showFieldsStmt
                         | showTagKeysStmt
                         | showTagValuesStmt
+                        | showMetricCardinalityStmt
+                        | showTagKeyCardinalityStmt
+                        | showTagValueCardinalityStmt
                         | queryStmt
+                        | deleteStmt
+                        | alterMetricStmt
+                        | dropMetricStmt
+                        | dropNamespaceStmt
                         | createDatabaseStmt
                         | dropDatabaseStmt
+                        | createUserStmt
+                        | dropUserStmt
+                        | showUsersStmt
+                        | createRoleStmt
+                        | dropRoleStmt
+                        | showRolesStmt
+                        | grantStmt
+                        | revokeStmt
                         ;
 
 useStmt              : T_USE ident ;
@@ -38,6 +54,7 @@ showMasterMetaStmt   : T_SHOW T_MASTER T_METADATA T_FROM source T_WHERE typeFilt
 showStorageMetaStmt  : T_SHOW T_STORAGE T_METADATA T_FROM source T_WHERE (storageFilter|typeFilter) T_AND (storageFilter|typeFilter);
 showAliveStmt        : T_SHOW (T_BROKER | T_STORAGE) T_ALIVE;
 showReplicationStmt  : T_SHOW T_REPLICATION T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
+showLimitsStmt       : T_SHOW T_LIMITS (T_WHERE databaseFilter)? ;
 showBrokerMetricStmt : T_SHOW T_BROKER T_METRIC T_WHERE metricListFilter ;
 showStorageMetricStmt: T_SHOW T_STORAGE T_METRIC T_WHERE (storageFilter|metricListFilter) T_AND (storageFilter|metricListFilter) ;
 createStorageStmt    : T_CREATE T_STORAGE json;
@@ -50,15 +67,55 @@ showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQ
 showFieldsStmt       : T_SHOW T_FIELDS fromClause;
 showTagKeysStmt      : T_SHOW T_TAG T_KEYS fromClause;
 showTagValuesStmt    : T_SHOW T_TAG T_VALUES fromClause T_WITH T_KEY T_EQUAL withTagKey whereClause? limitClause?;
+//cardinality analysis statement
+showMetricCardinalityStmt   : T_SHOW T_METRIC T_CARDINALITY (T_ON namespace)? (T_WHERE T_METRIC T_EQUAL prefix)? limitClause?;
+showTagKeyCardinalityStmt   : T_SHOW T_TAG T_KEY T_CARDINALITY fromClause;
+showTagValueCardinalityStmt : T_SHOW T_TAG T_VALUE T_CARDINALITY fromClause T_WITH T_KEY T_EQUAL withTagKey limitClause?;
 prefix               : ident ;
 withTagKey           : ident ;
 namespace            : ident ;
 databaseName         : ident ;
 source               : (T_STATE_MACHINE|T_STATE_REPO) ;
 
+//user/role/grant statement
+createUserStmt       : T_CREATE T_USER userName T_WITH T_PASSWORD password ;
+dropUserStmt         : T_DROP T_USER userName ;
+showUsersStmt        : T_SHOW T_USERS ;
+createRoleStmt       : T_CREATE T_ROLE roleName ;
+dropRoleStmt         : T_DROP T_ROLE roleName ;
+showRolesStmt        : T_SHOW T_ROLES ;
+grantStmt            : T_GRANT privilege T_ON grantDatabase (T_NAMESPACE grantNamespace)? T_TO grantee
+                     | T_GRANT T_ROLE roleName T_TO T_USER userName
+                     ;
+revokeStmt           : T_REVOKE privilege T_ON grantDatabase (T_NAMESPACE grantNamespace)? T_FROM grantee
+                     | T_REVOKE T_ROLE roleName T_FROM T_USER userName
+                     ;
+privilege            : T_READ | T_WRITE | T_ADMIN ;
+grantDatabase        : ident | T_MUL ;
+grantNamespace       : ident ;
+grantee              : T_USER userName | T_ROLE roleName ;
+userName             : ident ;
+roleName             : ident ;
+password             : ident ;
+
 //data query plan
-queryStmt               : T_EXPLAIN? selectExpr fromClause whereClause? groupByClause? orderByClause? limitClause? T_WITH_VALUE?;
+queryStmt               : T_EXPLAIN? selectExpr queryFromClause whereClause? groupByClause? orderByClause? limitClause? timeZoneClause? T_WITH_VALUE? withExemplarsClause?;
 selectExpr              : T_SELECT fields;
+
+//data delete statement
+deleteStmt              : T_DELETE fromClause whereClause ;
+
+//metric schema management statement
+alterMetricStmt         : T_ALTER T_METRIC metricName (T_ON namespace)? alterFieldAction ;
+alterFieldAction        : T_RENAME T_FIELD fieldName T_TO targetFieldName
+                        | T_DROP T_FIELD fieldName
+                        | T_ALTER T_FIELD fieldName T_TYPE fieldType
+                        ;
+dropMetricStmt          : T_DROP T_METRIC metricName (T_ON namespace)? ;
+dropNamespaceStmt       : T_DROP T_NAMESPACE namespace ;
+fieldName               : ident ;
+targetFieldName         : ident ;
+fieldType               : ident ;
 //select fields
 fields                  : field ( T_COMMA field )* ;
 field                   : fieldExpr alias? ;
@@ -69,6 +126,11 @@ typeFilter              : T_TYPE T_EQUAL ident  ;
 
 //from clause
 fromClause              : T_FROM metricName (T_ON namespace)? ;
+//query may reference multiple metrics, like select a.f/b.f from a, b
+//or aggregate the result of subquery, like select max(f) from (select avg(f) as f from a group by host)
+queryFromClause         : T_FROM metricName (T_COMMA metricName)* (T_ON namespace)?
+                        | T_FROM T_OPEN_P queryStmt T_CLOSE_P
+                        ;
 
 //where clause
 whereClause             : T_WHERE conditionExpr;
@@ -86,7 +148,7 @@ tagValueList           : tagValue (T_COMMA tagValue)*;
 metricListFilter       : T_METRIC T_IN (T_OPEN_P metricList T_CLOSE_P) ;
 metricList             : ident (T_COMMA ident)*;
 timeRangeExpr          : timeExpr (T_AND timeExpr)? ;
-timeExpr               : T_TIME binaryOperator (nowExpr | ident) ;
+timeExpr               : T_TIME binaryOperator (nowExpr | ident | intNumber) ;
 
 nowExpr                 : nowFunc  durationLit? ;
 
@@ -95,7 +157,10 @@ nowFunc                 : T_NOW T_OPEN_P exprFuncParams? T_CLOSE_P ;
 //group by
 groupByClause          : T_GROUP T_BY groupByKeys (T_FILL T_OPEN_P fillOption T_CLOSE_P)? havingClause? ;
 groupByKeys            : groupByKey (T_COMMA groupByKey)* ;
-groupByKey             : ident | T_TIME T_OPEN_P durationLit T_CLOSE_P ;
+groupByKey             : ident | T_TIME T_OPEN_P durationLit T_CLOSE_P | tagTransformExpr alias? ;
+tagTransformExpr       : T_REGEX_EXTRACT T_OPEN_P tagKey T_COMMA ident T_CLOSE_P
+                       | T_LABEL_REPLACE T_OPEN_P tagKey T_COMMA ident T_COMMA ident T_CLOSE_P
+                       ;
 fillOption             : T_NULL | T_PREVIOUS | L_INT | L_DEC ;
 
 orderByClause          : T_ORDER T_BY sortFields ;
@@ -143,8 +208,16 @@ intervalItem            :
                          | T_MONTH
                          | T_YEAR
                          ;
-exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P ;
-funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_STDDEV | T_QUANTILE | T_RATE;
+exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P
+                        | T_IF T_OPEN_P boolExpr T_COMMA fieldExpr T_COMMA fieldExpr T_CLOSE_P
+                        ;
+funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_STDDEV | T_QUANTILE | T_RATE
+                        | T_VARIANCE | T_FIRST_VALUE | T_LAST_VALUE | T_MEDIAN
+                        | T_IRATE | T_INCREASE | T_DERIVATIVE | T_DELTA
+                        | T_MOVING_AVG | T_MOVING_SUM | T_CUMULATIVE_SUM | T_EWMA | T_TIME_SHIFT | T_DIFF
+                        | T_ABS | T_CEIL | T_FLOOR | T_ROUND | T_LOG | T_POW | T_SQRT | T_CLAMP_MIN | T_CLAMP_MAX
+                        | T_HISTOGRAM_COUNT | T_HISTOGRAM_SUM | T_HISTOGRAM_AVG | T_HISTOGRAM_FRACTION | T_HEATMAP
+                        | T_LAST | T_COUNT_DISTINCT;
 exprFuncParams          : funcParam (T_COMMA funcParam)* ;
 funcParam               :
                            fieldExpr
@@ -182,7 +255,7 @@ value
    | arr
    | 'true'
    | 'false'
-   | 'null'
+   | T_NULL
    ;
 
 // Integer (positive or negative)
@@ -190,6 +263,10 @@ intNumber               : ('-' | '+')? L_INT ;
 // Decimal number (positive or negative)
 decNumber               : ('-' | '+')? L_DEC ;
 limitClause             : T_LIMIT L_INT ;
+
+timeZoneClause          : T_TZ T_OPEN_P ident T_CLOSE_P ;
+
+withExemplarsClause     : T_WITH T_EXEMPLARS ;
 metricName              : ident ;
 tagKey                  : ident ;
 tagValue                : ident ;
@@ -266,6 +343,40 @@ nonReservedWords      :
                         | T_STDDEV
                         | T_QUANTILE
                         | T_RATE
+                        | T_VARIANCE
+                        | T_FIRST_VALUE
+                        | T_LAST_VALUE
+                        | T_MEDIAN
+                        | T_IRATE
+                        | T_INCREASE
+                        | T_DERIVATIVE
+                        | T_DELTA
+                        | T_MOVING_AVG
+                        | T_MOVING_SUM
+                        | T_CUMULATIVE_SUM
+                        | T_EWMA
+                        | T_TIME_SHIFT
+                        | T_DIFF
+                        | T_ABS
+                        | T_CEIL
+                        | T_FLOOR
+                        | T_ROUND
+                        | T_POW
+                        | T_SQRT
+                        | T_CLAMP_MIN
+                        | T_CLAMP_MAX
+                        | T_IF
+                        | T_TZ
+                        | T_REGEX_EXTRACT
+                        | T_LABEL_REPLACE
+                        | T_EXEMPLARS
+                        | T_HISTOGRAM_COUNT
+                        | T_HISTOGRAM_SUM
+                        | T_HISTOGRAM_AVG
+                        | T_HISTOGRAM_FRACTION
+                        | T_HEATMAP
+                        | T_LAST
+                        | T_COUNT_DISTINCT
                         | T_SECOND
                         | T_MINUTE
                         | T_HOUR
@@ -285,6 +396,22 @@ nonReservedWords      :
                         | T_SCHEMAS
                         | T_STATE_REPO
                         | T_STATE_MACHINE
+                        | T_USER
+                        | T_USERS
+                        | T_ROLE
+                        | T_ROLES
+                        | T_LIMITS
+                        | T_CARDINALITY
+                        | T_PASSWORD
+                        | T_GRANT
+                        | T_REVOKE
+                        | T_TO
+                        | T_READ
+                        | T_WRITE
+                        | T_ADMIN
+                        | T_DELETE
+                        | T_ALTER
+                        | T_RENAME
                         ;
 
 STRING
@@ -343,6 +470,22 @@ T_STORAGE            : S T O R A G E                    ;
 T_BROKER             : B R O K E R                      ;
 T_ALIVE              : A L I V E                        ;
 T_SCHEMAS            : S C H E M A S                    ;
+T_USER               : U S E R                          ;
+T_USERS              : U S E R S                        ;
+T_ROLE               : R O L E                          ;
+T_ROLES              : R O L E S                        ;
+T_LIMITS             : L I M I T S                      ;
+T_CARDINALITY        : C A R D I N A L I T Y            ;
+T_PASSWORD           : P A S S W O R D                  ;
+T_GRANT              : G R A N T                        ;
+T_REVOKE             : R E V O K E                      ;
+T_TO                 : T O                              ;
+T_READ               : R E A D                          ;
+T_WRITE              : W R I T E                        ;
+T_ADMIN              : A D M I N                        ;
+T_DELETE             : D E L E T E                      ;
+T_ALTER              : A L T E R                        ;
+T_RENAME             : R E N A M E                      ;
 T_DATASBAE           : D A T A B A S E                  ;
 T_DATASBAES          : D A T A B A S E S                ;
 T_NAMESPACE          : N A M E S P A C E                ;
@@ -400,6 +543,40 @@ T_AVG                : A V G                            ;
 T_STDDEV             : S T D D E V                      ;
 T_QUANTILE           : Q U A N T I L E                  ;
 T_RATE               : R A T E                          ;
+T_VARIANCE           : V A R I A N C E                  ;
+T_FIRST_VALUE        : F I R S T T_UNDERLINE V A L U E  ;
+T_LAST_VALUE         : L A S T T_UNDERLINE V A L U E    ;
+T_MEDIAN             : M E D I A N                      ;
+T_IRATE              : I R A T E                        ;
+T_INCREASE           : I N C R E A S E                  ;
+T_DERIVATIVE         : D E R I V A T I V E              ;
+T_DELTA              : D E L T A                        ;
+T_MOVING_AVG         : M O V I N G T_UNDERLINE A V G    ;
+T_MOVING_SUM         : M O V I N G T_UNDERLINE S U M    ;
+T_CUMULATIVE_SUM     : C U M U L A T I V E T_UNDERLINE S U M;
+T_EWMA               : E W M A                          ;
+T_TIME_SHIFT         : T I M E T_UNDERLINE S H I F T    ;
+T_DIFF               : D I F F                          ;
+T_ABS                : A B S                            ;
+T_CEIL               : C E I L                          ;
+T_FLOOR              : F L O O R                        ;
+T_ROUND              : R O U N D                        ;
+T_POW                : P O W                            ;
+T_SQRT               : S Q R T                          ;
+T_CLAMP_MIN          : C L A M P T_UNDERLINE M I N      ;
+T_CLAMP_MAX          : C L A M P T_UNDERLINE M A X      ;
+T_IF                 : I F                              ;
+T_TZ                 : T Z                              ;
+T_REGEX_EXTRACT      : R E G E X T_UNDERLINE E X T R A C T;
+T_LABEL_REPLACE      : L A B E L T_UNDERLINE R E P L A C E;
+T_EXEMPLARS          : E X E M P L A R S                ;
+T_HISTOGRAM_COUNT    : H I S T O G R A M T_UNDERLINE C O U N T;
+T_HISTOGRAM_SUM      : H I S T O G R A M T_UNDERLINE S U M;
+T_HISTOGRAM_AVG      : H I S T O G R A M T_UNDERLINE A V G;
+T_HISTOGRAM_FRACTION : H I S T O G R A M T_UNDERLINE F R A C T I O N;
+T_HEATMAP            : H E A T M A P                    ;
+T_LAST               : L A S T                          ;
+T_COUNT_DISTINCT     : C O U N T T_UNDERLINE D I S T I N C T;
 
 //time unit
 T_SECOND             : S                                ;
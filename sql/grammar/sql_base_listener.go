@@ -103,6 +103,12 @@ func (s *BaseSQLListener) EnterShowReplicationStmt(ctx *ShowReplicationStmtConte
 // ExitShowReplicationStmt is called when production showReplicationStmt is exited.
 func (s *BaseSQLListener) ExitShowReplicationStmt(ctx *ShowReplicationStmtContext) {}
 
+// EnterShowLimitsStmt is called when production showLimitsStmt is entered.
+func (s *BaseSQLListener) EnterShowLimitsStmt(ctx *ShowLimitsStmtContext) {}
+
+// ExitShowLimitsStmt is called when production showLimitsStmt is exited.
+func (s *BaseSQLListener) ExitShowLimitsStmt(ctx *ShowLimitsStmtContext) {}
+
 // EnterShowBrokerMetricStmt is called when production showBrokerMetricStmt is entered.
 func (s *BaseSQLListener) EnterShowBrokerMetricStmt(ctx *ShowBrokerMetricStmtContext) {}
 
@@ -175,6 +181,24 @@ func (s *BaseSQLListener) EnterShowTagValuesStmt(ctx *ShowTagValuesStmtContext)
 // ExitShowTagValuesStmt is called when production showTagValuesStmt is exited.
 func (s *BaseSQLListener) ExitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) {}
 
+// EnterShowMetricCardinalityStmt is called when production showMetricCardinalityStmt is entered.
+func (s *BaseSQLListener) EnterShowMetricCardinalityStmt(ctx *ShowMetricCardinalityStmtContext) {}
+
+// ExitShowMetricCardinalityStmt is called when production showMetricCardinalityStmt is exited.
+func (s *BaseSQLListener) ExitShowMetricCardinalityStmt(ctx *ShowMetricCardinalityStmtContext) {}
+
+// EnterShowTagKeyCardinalityStmt is called when production showTagKeyCardinalityStmt is entered.
+func (s *BaseSQLListener) EnterShowTagKeyCardinalityStmt(ctx *ShowTagKeyCardinalityStmtContext) {}
+
+// ExitShowTagKeyCardinalityStmt is called when production showTagKeyCardinalityStmt is exited.
+func (s *BaseSQLListener) ExitShowTagKeyCardinalityStmt(ctx *ShowTagKeyCardinalityStmtContext) {}
+
+// EnterShowTagValueCardinalityStmt is called when production showTagValueCardinalityStmt is entered.
+func (s *BaseSQLListener) EnterShowTagValueCardinalityStmt(ctx *ShowTagValueCardinalityStmtContext) {}
+
+// ExitShowTagValueCardinalityStmt is called when production showTagValueCardinalityStmt is exited.
+func (s *BaseSQLListener) ExitShowTagValueCardinalityStmt(ctx *ShowTagValueCardinalityStmtContext) {}
+
 // EnterPrefix is called when production prefix is entered.
 func (s *BaseSQLListener) EnterPrefix(ctx *PrefixContext) {}
 
@@ -205,6 +229,96 @@ func (s *BaseSQLListener) EnterSource(ctx *SourceContext) {}
 // ExitSource is called when production source is exited.
 func (s *BaseSQLListener) ExitSource(ctx *SourceContext) {}
 
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (s *BaseSQLListener) EnterCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// ExitCreateUserStmt is called when production createUserStmt is exited.
+func (s *BaseSQLListener) ExitCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (s *BaseSQLListener) EnterDropUserStmt(ctx *DropUserStmtContext) {}
+
+// ExitDropUserStmt is called when production dropUserStmt is exited.
+func (s *BaseSQLListener) ExitDropUserStmt(ctx *DropUserStmtContext) {}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (s *BaseSQLListener) EnterShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// ExitShowUsersStmt is called when production showUsersStmt is exited.
+func (s *BaseSQLListener) ExitShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// EnterCreateRoleStmt is called when production createRoleStmt is entered.
+func (s *BaseSQLListener) EnterCreateRoleStmt(ctx *CreateRoleStmtContext) {}
+
+// ExitCreateRoleStmt is called when production createRoleStmt is exited.
+func (s *BaseSQLListener) ExitCreateRoleStmt(ctx *CreateRoleStmtContext) {}
+
+// EnterDropRoleStmt is called when production dropRoleStmt is entered.
+func (s *BaseSQLListener) EnterDropRoleStmt(ctx *DropRoleStmtContext) {}
+
+// ExitDropRoleStmt is called when production dropRoleStmt is exited.
+func (s *BaseSQLListener) ExitDropRoleStmt(ctx *DropRoleStmtContext) {}
+
+// EnterShowRolesStmt is called when production showRolesStmt is entered.
+func (s *BaseSQLListener) EnterShowRolesStmt(ctx *ShowRolesStmtContext) {}
+
+// ExitShowRolesStmt is called when production showRolesStmt is exited.
+func (s *BaseSQLListener) ExitShowRolesStmt(ctx *ShowRolesStmtContext) {}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (s *BaseSQLListener) EnterGrantStmt(ctx *GrantStmtContext) {}
+
+// ExitGrantStmt is called when production grantStmt is exited.
+func (s *BaseSQLListener) ExitGrantStmt(ctx *GrantStmtContext) {}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (s *BaseSQLListener) EnterRevokeStmt(ctx *RevokeStmtContext) {}
+
+// ExitRevokeStmt is called when production revokeStmt is exited.
+func (s *BaseSQLListener) ExitRevokeStmt(ctx *RevokeStmtContext) {}
+
+// EnterPrivilege is called when production privilege is entered.
+func (s *BaseSQLListener) EnterPrivilege(ctx *PrivilegeContext) {}
+
+// ExitPrivilege is called when production privilege is exited.
+func (s *BaseSQLListener) ExitPrivilege(ctx *PrivilegeContext) {}
+
+// EnterGrantDatabase is called when production grantDatabase is entered.
+func (s *BaseSQLListener) EnterGrantDatabase(ctx *GrantDatabaseContext) {}
+
+// ExitGrantDatabase is called when production grantDatabase is exited.
+func (s *BaseSQLListener) ExitGrantDatabase(ctx *GrantDatabaseContext) {}
+
+// EnterGrantNamespace is called when production grantNamespace is entered.
+func (s *BaseSQLListener) EnterGrantNamespace(ctx *GrantNamespaceContext) {}
+
+// ExitGrantNamespace is called when production grantNamespace is exited.
+func (s *BaseSQLListener) ExitGrantNamespace(ctx *GrantNamespaceContext) {}
+
+// EnterGrantee is called when production grantee is entered.
+func (s *BaseSQLListener) EnterGrantee(ctx *GranteeContext) {}
+
+// ExitGrantee is called when production grantee is exited.
+func (s *BaseSQLListener) ExitGrantee(ctx *GranteeContext) {}
+
+// EnterUserName is called when production userName is entered.
+func (s *BaseSQLListener) EnterUserName(ctx *UserNameContext) {}
+
+// ExitUserName is called when production userName is exited.
+func (s *BaseSQLListener) ExitUserName(ctx *UserNameContext) {}
+
+// EnterRoleName is called when production roleName is entered.
+func (s *BaseSQLListener) EnterRoleName(ctx *RoleNameContext) {}
+
+// ExitRoleName is called when production roleName is exited.
+func (s *BaseSQLListener) ExitRoleName(ctx *RoleNameContext) {}
+
+// EnterPassword is called when production password is entered.
+func (s *BaseSQLListener) EnterPassword(ctx *PasswordContext) {}
+
+// ExitPassword is called when production password is exited.
+func (s *BaseSQLListener) ExitPassword(ctx *PasswordContext) {}
+
 // EnterQueryStmt is called when production queryStmt is entered.
 func (s *BaseSQLListener) EnterQueryStmt(ctx *QueryStmtContext) {}
 
@@ -217,6 +331,54 @@ func (s *BaseSQLListener) EnterSelectExpr(ctx *SelectExprContext) {}
 // ExitSelectExpr is called when production selectExpr is exited.
 func (s *BaseSQLListener) ExitSelectExpr(ctx *SelectExprContext) {}
 
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (s *BaseSQLListener) EnterDeleteStmt(ctx *DeleteStmtContext) {}
+
+// ExitDeleteStmt is called when production deleteStmt is exited.
+func (s *BaseSQLListener) ExitDeleteStmt(ctx *DeleteStmtContext) {}
+
+// EnterAlterMetricStmt is called when production alterMetricStmt is entered.
+func (s *BaseSQLListener) EnterAlterMetricStmt(ctx *AlterMetricStmtContext) {}
+
+// ExitAlterMetricStmt is called when production alterMetricStmt is exited.
+func (s *BaseSQLListener) ExitAlterMetricStmt(ctx *AlterMetricStmtContext) {}
+
+// EnterAlterFieldAction is called when production alterFieldAction is entered.
+func (s *BaseSQLListener) EnterAlterFieldAction(ctx *AlterFieldActionContext) {}
+
+// ExitAlterFieldAction is called when production alterFieldAction is exited.
+func (s *BaseSQLListener) ExitAlterFieldAction(ctx *AlterFieldActionContext) {}
+
+// EnterDropMetricStmt is called when production dropMetricStmt is entered.
+func (s *BaseSQLListener) EnterDropMetricStmt(ctx *DropMetricStmtContext) {}
+
+// ExitDropMetricStmt is called when production dropMetricStmt is exited.
+func (s *BaseSQLListener) ExitDropMetricStmt(ctx *DropMetricStmtContext) {}
+
+// EnterDropNamespaceStmt is called when production dropNamespaceStmt is entered.
+func (s *BaseSQLListener) EnterDropNamespaceStmt(ctx *DropNamespaceStmtContext) {}
+
+// ExitDropNamespaceStmt is called when production dropNamespaceStmt is exited.
+func (s *BaseSQLListener) ExitDropNamespaceStmt(ctx *DropNamespaceStmtContext) {}
+
+// EnterFieldName is called when production fieldName is entered.
+func (s *BaseSQLListener) EnterFieldName(ctx *FieldNameContext) {}
+
+// ExitFieldName is called when production fieldName is exited.
+func (s *BaseSQLListener) ExitFieldName(ctx *FieldNameContext) {}
+
+// EnterTargetFieldName is called when production targetFieldName is entered.
+func (s *BaseSQLListener) EnterTargetFieldName(ctx *TargetFieldNameContext) {}
+
+// ExitTargetFieldName is called when production targetFieldName is exited.
+func (s *BaseSQLListener) ExitTargetFieldName(ctx *TargetFieldNameContext) {}
+
+// EnterFieldType is called when production fieldType is entered.
+func (s *BaseSQLListener) EnterFieldType(ctx *FieldTypeContext) {}
+
+// ExitFieldType is called when production fieldType is exited.
+func (s *BaseSQLListener) ExitFieldType(ctx *FieldTypeContext) {}
+
 // EnterFields is called when production fields is entered.
 func (s *BaseSQLListener) EnterFields(ctx *FieldsContext) {}
 
@@ -259,6 +421,12 @@ func (s *BaseSQLListener) EnterFromClause(ctx *FromClauseContext) {}
 // ExitFromClause is called when production fromClause is exited.
 func (s *BaseSQLListener) ExitFromClause(ctx *FromClauseContext) {}
 
+// EnterQueryFromClause is called when production queryFromClause is entered.
+func (s *BaseSQLListener) EnterQueryFromClause(ctx *QueryFromClauseContext) {}
+
+// ExitQueryFromClause is called when production queryFromClause is exited.
+func (s *BaseSQLListener) ExitQueryFromClause(ctx *QueryFromClauseContext) {}
+
 // EnterWhereClause is called when production whereClause is entered.
 func (s *BaseSQLListener) EnterWhereClause(ctx *WhereClauseContext) {}
 
@@ -337,6 +505,12 @@ func (s *BaseSQLListener) EnterGroupByKey(ctx *GroupByKeyContext) {}
 // ExitGroupByKey is called when production groupByKey is exited.
 func (s *BaseSQLListener) ExitGroupByKey(ctx *GroupByKeyContext) {}
 
+// EnterTagTransformExpr is called when production tagTransformExpr is entered.
+func (s *BaseSQLListener) EnterTagTransformExpr(ctx *TagTransformExprContext) {}
+
+// ExitTagTransformExpr is called when production tagTransformExpr is exited.
+func (s *BaseSQLListener) ExitTagTransformExpr(ctx *TagTransformExprContext) {}
+
 // EnterFillOption is called when production fillOption is entered.
 func (s *BaseSQLListener) EnterFillOption(ctx *FillOptionContext) {}
 
@@ -499,6 +673,18 @@ func (s *BaseSQLListener) EnterLimitClause(ctx *LimitClauseContext) {}
 // ExitLimitClause is called when production limitClause is exited.
 func (s *BaseSQLListener) ExitLimitClause(ctx *LimitClauseContext) {}
 
+// EnterTimeZoneClause is called when production timeZoneClause is entered.
+func (s *BaseSQLListener) EnterTimeZoneClause(ctx *TimeZoneClauseContext) {}
+
+// ExitTimeZoneClause is called when production timeZoneClause is exited.
+func (s *BaseSQLListener) ExitTimeZoneClause(ctx *TimeZoneClauseContext) {}
+
+// EnterWithExemplarsClause is called when production withExemplarsClause is entered.
+func (s *BaseSQLListener) EnterWithExemplarsClause(ctx *WithExemplarsClauseContext) {}
+
+// ExitWithExemplarsClause is called when production withExemplarsClause is exited.
+func (s *BaseSQLListener) ExitWithExemplarsClause(ctx *WithExemplarsClauseContext) {}
+
 // EnterMetricName is called when production metricName is entered.
 func (s *BaseSQLListener) EnterMetricName(ctx *MetricNameContext) {}
 
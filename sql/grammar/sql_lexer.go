@@ -32,7 +32,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 123, 1085,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 172, 1595,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -64,467 +64,717 @@ var serializedLexerAtn = []uint16{
 	9, 142, 4, 143, 9, 143, 4, 144, 9, 144, 4, 145, 9, 145, 4, 146, 9, 146,
 	4, 147, 9, 147, 4, 148, 9, 148, 4, 149, 9, 149, 4, 150, 9, 150, 4, 151,
 	9, 151, 4, 152, 9, 152, 4, 153, 9, 153, 4, 154, 9, 154, 4, 155, 9, 155,
-	4, 156, 9, 156, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 7, 5, 333, 10,
-	5, 12, 5, 14, 5, 336, 11, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 5, 6, 343, 10,
-	6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3,
-	10, 5, 10, 357, 10, 10, 3, 10, 3, 10, 3, 11, 6, 11, 362, 10, 11, 13, 11,
-	14, 11, 363, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3,
-	12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14,
-	3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3,
-	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 18,
-	3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3,
-	19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20,
-	3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3,
-	22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23,
-	3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3,
-	25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27,
-	3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3,
-	28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29,
-	3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3,
-	30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31,
-	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3,
-	33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35,
-	3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3,
-	36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38,
-	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3,
-	39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40,
-	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3,
-	41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42,
-	3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3,
-	44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45,
-	3, 45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3,
-	47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48,
+	4, 156, 9, 156, 4, 157, 9, 157, 4, 158, 9, 158, 4, 159, 9, 159, 4, 160,
+	9, 160, 4, 161, 9, 161, 4, 162, 9, 162, 4, 163, 9, 163, 4, 164, 9, 164,
+	4, 165, 9, 165, 4, 166, 9, 166, 4, 167, 9, 167, 4, 168, 9, 168, 4, 169,
+	9, 169, 4, 170, 9, 170, 4, 171, 9, 171, 4, 172, 9, 172, 4, 173, 9, 173,
+	4, 174, 9, 174, 4, 175, 9, 175, 4, 176, 9, 176, 4, 177, 9, 177, 4, 178,
+	9, 178, 4, 179, 9, 179, 4, 180, 9, 180, 4, 181, 9, 181, 4, 182, 9, 182,
+	4, 183, 9, 183, 4, 184, 9, 184, 4, 185, 9, 185, 4, 186, 9, 186, 4, 187,
+	9, 187, 4, 188, 9, 188, 4, 189, 9, 189, 4, 190, 9, 190, 4, 191, 9, 191,
+	4, 192, 9, 192, 4, 193, 9, 193, 4, 194, 9, 194, 4, 195, 9, 195, 4, 196,
+	9, 196, 4, 197, 9, 197, 4, 198, 9, 198, 4, 199, 9, 199, 4, 200, 9, 200,
+	4, 201, 9, 201, 4, 202, 9, 202, 4, 203, 9, 203, 4, 204, 9, 204, 4, 205,
+	9, 205, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 4, 3, 4, 3, 4, 7, 4, 426, 10, 4, 12, 4, 14, 4, 429, 11, 4, 3, 4,
+	3, 4, 3, 5, 3, 5, 3, 5, 5, 5, 436, 10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6,
+	3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 5, 9, 450, 10, 9, 3, 9, 3, 9,
+	3, 10, 6, 10, 455, 10, 10, 13, 10, 14, 10, 456, 3, 10, 3, 10, 3, 11, 3,
+	11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12,
+	3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3,
+	14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16,
+	3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3,
+	18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18,
+	3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3,
+	20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21,
+	3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3,
+	23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25,
+	3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3,
+	27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28,
+	3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3,
+	29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30,
+	3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3,
+	31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33,
+	3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3,
+	34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39,
+	3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3,
+	41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43,
+	3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3,
+	43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45,
+	3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3,
+	46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49,
 	3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3,
-	51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53,
-	3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3,
-	54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56,
-	3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3,
-	58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59,
-	3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3,
-	61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62,
-	3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67,
-	3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3,
-	68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70,
-	3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3,
-	72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74,
-	3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3,
-	76, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78,
-	3, 79, 3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3,
-	81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83,
-	3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3,
-	85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87,
-	3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3,
-	90, 3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91,
-	3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 93, 3,
-	93, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 95, 3, 95, 3, 96, 3, 96, 3, 97,
-	3, 97, 3, 98, 3, 98, 3, 99, 3, 99, 3, 100, 3, 100, 3, 101, 3, 101, 3, 102,
-	3, 102, 3, 103, 3, 103, 3, 104, 3, 104, 3, 104, 3, 105, 3, 105, 3, 105,
-	3, 106, 3, 106, 3, 107, 3, 107, 3, 107, 3, 108, 3, 108, 3, 109, 3, 109,
-	3, 109, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111, 3, 111, 3, 112, 3, 112,
-	3, 113, 3, 113, 3, 114, 3, 114, 3, 115, 3, 115, 3, 116, 3, 116, 3, 117,
-	3, 117, 3, 118, 3, 118, 3, 119, 3, 119, 3, 120, 3, 120, 3, 121, 3, 121,
-	3, 122, 3, 122, 3, 123, 3, 123, 3, 124, 3, 124, 3, 125, 3, 125, 3, 126,
-	6, 126, 953, 10, 126, 13, 126, 14, 126, 954, 3, 127, 6, 127, 958, 10, 127,
-	13, 127, 14, 127, 959, 3, 127, 3, 127, 3, 127, 7, 127, 965, 10, 127, 12,
-	127, 14, 127, 968, 11, 127, 3, 127, 3, 127, 6, 127, 972, 10, 127, 13, 127,
-	14, 127, 973, 5, 127, 976, 10, 127, 3, 128, 3, 128, 3, 129, 3, 129, 3,
-	130, 3, 130, 3, 130, 3, 130, 7, 130, 986, 10, 130, 12, 130, 14, 130, 989,
-	11, 130, 3, 130, 3, 130, 3, 130, 7, 130, 994, 10, 130, 12, 130, 14, 130,
-	997, 11, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 6, 130, 1004, 10,
-	130, 13, 130, 14, 130, 1005, 3, 130, 3, 130, 7, 130, 1010, 10, 130, 12,
-	130, 14, 130, 1013, 11, 130, 3, 130, 3, 130, 3, 130, 7, 130, 1018, 10,
-	130, 12, 130, 14, 130, 1021, 11, 130, 3, 130, 3, 130, 3, 130, 7, 130, 1026,
-	10, 130, 12, 130, 14, 130, 1029, 11, 130, 3, 130, 5, 130, 1032, 10, 130,
-	3, 131, 3, 131, 3, 132, 3, 132, 3, 133, 3, 133, 3, 134, 3, 134, 3, 135,
-	3, 135, 3, 136, 3, 136, 3, 137, 3, 137, 3, 138, 3, 138, 3, 139, 3, 139,
-	3, 140, 3, 140, 3, 141, 3, 141, 3, 142, 3, 142, 3, 143, 3, 143, 3, 144,
-	3, 144, 3, 145, 3, 145, 3, 146, 3, 146, 3, 147, 3, 147, 3, 148, 3, 148,
-	3, 149, 3, 149, 3, 150, 3, 150, 3, 151, 3, 151, 3, 152, 3, 152, 3, 153,
-	3, 153, 3, 154, 3, 154, 3, 155, 3, 155, 3, 156, 3, 156, 6, 995, 1011, 1019,
-	1027, 2, 157, 3, 3, 5, 4, 7, 5, 9, 6, 11, 2, 13, 2, 15, 2, 17, 2, 19, 2,
-	21, 7, 23, 8, 25, 9, 27, 10, 29, 11, 31, 12, 33, 13, 35, 14, 37, 15, 39,
-	16, 41, 17, 43, 18, 45, 19, 47, 20, 49, 21, 51, 22, 53, 23, 55, 24, 57,
-	25, 59, 26, 61, 27, 63, 28, 65, 29, 67, 30, 69, 31, 71, 32, 73, 33, 75,
-	34, 77, 35, 79, 36, 81, 37, 83, 38, 85, 39, 87, 40, 89, 41, 91, 42, 93,
-	43, 95, 44, 97, 45, 99, 46, 101, 47, 103, 48, 105, 49, 107, 50, 109, 51,
-	111, 52, 113, 53, 115, 54, 117, 55, 119, 56, 121, 57, 123, 58, 125, 59,
-	127, 60, 129, 61, 131, 62, 133, 63, 135, 64, 137, 65, 139, 66, 141, 67,
-	143, 68, 145, 69, 147, 70, 149, 71, 151, 72, 153, 73, 155, 74, 157, 75,
-	159, 76, 161, 77, 163, 78, 165, 79, 167, 80, 169, 81, 171, 82, 173, 83,
-	175, 84, 177, 85, 179, 86, 181, 87, 183, 88, 185, 89, 187, 90, 189, 91,
-	191, 92, 193, 93, 195, 94, 197, 95, 199, 96, 201, 97, 203, 98, 205, 99,
-	207, 100, 209, 101, 211, 102, 213, 103, 215, 104, 217, 105, 219, 106, 221,
-	107, 223, 108, 225, 109, 227, 110, 229, 111, 231, 112, 233, 113, 235, 114,
-	237, 115, 239, 116, 241, 117, 243, 118, 245, 119, 247, 120, 249, 121, 251,
-	122, 253, 123, 255, 2, 257, 2, 259, 2, 261, 2, 263, 2, 265, 2, 267, 2,
-	269, 2, 271, 2, 273, 2, 275, 2, 277, 2, 279, 2, 281, 2, 283, 2, 285, 2,
-	287, 2, 289, 2, 291, 2, 293, 2, 295, 2, 297, 2, 299, 2, 301, 2, 303, 2,
-	305, 2, 307, 2, 309, 2, 311, 2, 3, 2, 39, 10, 2, 36, 36, 49, 49, 94, 94,
-	100, 100, 104, 104, 112, 112, 116, 116, 118, 118, 5, 2, 50, 59, 67, 72,
-	99, 104, 5, 2, 2, 33, 36, 36, 94, 94, 4, 2, 71, 71, 103, 103, 4, 2, 45,
-	45, 47, 47, 5, 2, 11, 12, 15, 15, 34, 34, 3, 2, 48, 48, 3, 2, 50, 59, 4,
-	2, 67, 92, 99, 124, 4, 2, 48, 48, 97, 97, 5, 2, 37, 38, 66, 66, 97, 97,
-	6, 2, 37, 38, 60, 60, 66, 66, 97, 97, 4, 2, 67, 67, 99, 99, 4, 2, 68, 68,
-	100, 100, 4, 2, 69, 69, 101, 101, 4, 2, 70, 70, 102, 102, 4, 2, 72, 72,
-	104, 104, 4, 2, 73, 73, 105, 105, 4, 2, 74, 74, 106, 106, 4, 2, 75, 75,
-	107, 107, 4, 2, 76, 76, 108, 108, 4, 2, 77, 77, 109, 109, 4, 2, 78, 78,
-	110, 110, 4, 2, 79, 79, 111, 111, 4, 2, 80, 80, 112, 112, 4, 2, 81, 81,
-	113, 113, 4, 2, 82, 82, 114, 114, 4, 2, 83, 83, 115, 115, 4, 2, 84, 84,
-	116, 116, 4, 2, 85, 85, 117, 117, 4, 2, 86, 86, 118, 118, 4, 2, 87, 87,
-	119, 119, 4, 2, 88, 88, 120, 120, 4, 2, 89, 89, 121, 121, 4, 2, 90, 90,
-	122, 122, 4, 2, 91, 91, 123, 123, 4, 2, 92, 92, 124, 124, 2, 1075, 2, 3,
-	3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 21,
-	3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2,
-	29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2,
-	2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2,
-	2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2,
-	2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3,
-	2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67,
-	3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2,
-	75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2,
-	2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2,
-	2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2,
-	2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105,
-	3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2,
-	2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3,
-	2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2,
-	127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3, 2,
-	2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141,
-	3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2, 2, 2,
-	2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2, 2, 2, 2, 155, 3,
-	2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2, 2,
-	163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2, 2, 169, 3, 2,
-	2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3, 2, 2, 2, 2, 177,
-	3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183, 3, 2, 2, 2,
-	2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2, 2, 2, 2, 191, 3,
-	2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 197, 3, 2, 2, 2, 2,
-	199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2, 2, 203, 3, 2, 2, 2, 2, 205, 3, 2,
-	2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3, 2, 2, 2, 2, 213,
-	3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2, 219, 3, 2, 2, 2,
-	2, 221, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3, 2, 2, 2, 2, 227, 3,
-	2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231, 3, 2, 2, 2, 2, 233, 3, 2, 2, 2, 2,
-	235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2, 2, 239, 3, 2, 2, 2, 2, 241, 3, 2,
-	2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3, 2, 2, 2, 2, 247, 3, 2, 2, 2, 2, 249,
-	3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2, 253, 3, 2, 2, 2, 3, 313, 3, 2, 2, 2,
-	5, 318, 3, 2, 2, 2, 7, 324, 3, 2, 2, 2, 9, 329, 3, 2, 2, 2, 11, 339, 3,
-	2, 2, 2, 13, 344, 3, 2, 2, 2, 15, 350, 3, 2, 2, 2, 17, 352, 3, 2, 2, 2,
-	19, 354, 3, 2, 2, 2, 21, 361, 3, 2, 2, 2, 23, 367, 3, 2, 2, 2, 25, 374,
-	3, 2, 2, 2, 27, 381, 3, 2, 2, 2, 29, 385, 3, 2, 2, 2, 31, 390, 3, 2, 2,
-	2, 33, 399, 3, 2, 2, 2, 35, 404, 3, 2, 2, 2, 37, 410, 3, 2, 2, 2, 39, 422,
-	3, 2, 2, 2, 41, 426, 3, 2, 2, 2, 43, 434, 3, 2, 2, 2, 45, 442, 3, 2, 2,
-	2, 47, 452, 3, 2, 2, 2, 49, 457, 3, 2, 2, 2, 51, 460, 3, 2, 2, 2, 53, 465,
-	3, 2, 2, 2, 55, 469, 3, 2, 2, 2, 57, 480, 3, 2, 2, 2, 59, 494, 3, 2, 2,
-	2, 61, 501, 3, 2, 2, 2, 63, 510, 3, 2, 2, 2, 65, 516, 3, 2, 2, 2, 67, 521,
-	3, 2, 2, 2, 69, 530, 3, 2, 2, 2, 71, 538, 3, 2, 2, 2, 73, 545, 3, 2, 2,
-	2, 75, 551, 3, 2, 2, 2, 77, 559, 3, 2, 2, 2, 79, 568, 3, 2, 2, 2, 81, 578,
-	3, 2, 2, 2, 83, 588, 3, 2, 2, 2, 85, 599, 3, 2, 2, 2, 87, 604, 3, 2, 2,
-	2, 89, 612, 3, 2, 2, 2, 91, 619, 3, 2, 2, 2, 93, 625, 3, 2, 2, 2, 95, 632,
-	3, 2, 2, 2, 97, 636, 3, 2, 2, 2, 99, 641, 3, 2, 2, 2, 101, 646, 3, 2, 2,
-	2, 103, 650, 3, 2, 2, 2, 105, 655, 3, 2, 2, 2, 107, 662, 3, 2, 2, 2, 109,
-	668, 3, 2, 2, 2, 111, 673, 3, 2, 2, 2, 113, 679, 3, 2, 2, 2, 115, 685,
-	3, 2, 2, 2, 117, 693, 3, 2, 2, 2, 119, 699, 3, 2, 2, 2, 121, 707, 3, 2,
-	2, 2, 123, 717, 3, 2, 2, 2, 125, 724, 3, 2, 2, 2, 127, 727, 3, 2, 2, 2,
-	129, 731, 3, 2, 2, 2, 131, 734, 3, 2, 2, 2, 133, 739, 3, 2, 2, 2, 135,
-	744, 3, 2, 2, 2, 137, 753, 3, 2, 2, 2, 139, 759, 3, 2, 2, 2, 141, 763,
-	3, 2, 2, 2, 143, 768, 3, 2, 2, 2, 145, 773, 3, 2, 2, 2, 147, 777, 3, 2,
-	2, 2, 149, 785, 3, 2, 2, 2, 151, 788, 3, 2, 2, 2, 153, 794, 3, 2, 2, 2,
-	155, 801, 3, 2, 2, 2, 157, 804, 3, 2, 2, 2, 159, 808, 3, 2, 2, 2, 161,
-	814, 3, 2, 2, 2, 163, 819, 3, 2, 2, 2, 165, 823, 3, 2, 2, 2, 167, 826,
-	3, 2, 2, 2, 169, 830, 3, 2, 2, 2, 171, 838, 3, 2, 2, 2, 173, 842, 3, 2,
-	2, 2, 175, 846, 3, 2, 2, 2, 177, 850, 3, 2, 2, 2, 179, 856, 3, 2, 2, 2,
-	181, 860, 3, 2, 2, 2, 183, 867, 3, 2, 2, 2, 185, 876, 3, 2, 2, 2, 187,
-	881, 3, 2, 2, 2, 189, 883, 3, 2, 2, 2, 191, 885, 3, 2, 2, 2, 193, 887,
-	3, 2, 2, 2, 195, 889, 3, 2, 2, 2, 197, 891, 3, 2, 2, 2, 199, 893, 3, 2,
-	2, 2, 201, 895, 3, 2, 2, 2, 203, 897, 3, 2, 2, 2, 205, 899, 3, 2, 2, 2,
-	207, 901, 3, 2, 2, 2, 209, 904, 3, 2, 2, 2, 211, 907, 3, 2, 2, 2, 213,
-	909, 3, 2, 2, 2, 215, 912, 3, 2, 2, 2, 217, 914, 3, 2, 2, 2, 219, 917,
-	3, 2, 2, 2, 221, 920, 3, 2, 2, 2, 223, 923, 3, 2, 2, 2, 225, 925, 3, 2,
-	2, 2, 227, 927, 3, 2, 2, 2, 229, 929, 3, 2, 2, 2, 231, 931, 3, 2, 2, 2,
-	233, 933, 3, 2, 2, 2, 235, 935, 3, 2, 2, 2, 237, 937, 3, 2, 2, 2, 239,
-	939, 3, 2, 2, 2, 241, 941, 3, 2, 2, 2, 243, 943, 3, 2, 2, 2, 245, 945,
-	3, 2, 2, 2, 247, 947, 3, 2, 2, 2, 249, 949, 3, 2, 2, 2, 251, 952, 3, 2,
-	2, 2, 253, 975, 3, 2, 2, 2, 255, 977, 3, 2, 2, 2, 257, 979, 3, 2, 2, 2,
-	259, 1031, 3, 2, 2, 2, 261, 1033, 3, 2, 2, 2, 263, 1035, 3, 2, 2, 2, 265,
-	1037, 3, 2, 2, 2, 267, 1039, 3, 2, 2, 2, 269, 1041, 3, 2, 2, 2, 271, 1043,
-	3, 2, 2, 2, 273, 1045, 3, 2, 2, 2, 275, 1047, 3, 2, 2, 2, 277, 1049, 3,
-	2, 2, 2, 279, 1051, 3, 2, 2, 2, 281, 1053, 3, 2, 2, 2, 283, 1055, 3, 2,
-	2, 2, 285, 1057, 3, 2, 2, 2, 287, 1059, 3, 2, 2, 2, 289, 1061, 3, 2, 2,
-	2, 291, 1063, 3, 2, 2, 2, 293, 1065, 3, 2, 2, 2, 295, 1067, 3, 2, 2, 2,
-	297, 1069, 3, 2, 2, 2, 299, 1071, 3, 2, 2, 2, 301, 1073, 3, 2, 2, 2, 303,
-	1075, 3, 2, 2, 2, 305, 1077, 3, 2, 2, 2, 307, 1079, 3, 2, 2, 2, 309, 1081,
-	3, 2, 2, 2, 311, 1083, 3, 2, 2, 2, 313, 314, 7, 118, 2, 2, 314, 315, 7,
-	116, 2, 2, 315, 316, 7, 119, 2, 2, 316, 317, 7, 103, 2, 2, 317, 4, 3, 2,
-	2, 2, 318, 319, 7, 104, 2, 2, 319, 320, 7, 99, 2, 2, 320, 321, 7, 110,
-	2, 2, 321, 322, 7, 117, 2, 2, 322, 323, 7, 103, 2, 2, 323, 6, 3, 2, 2,
-	2, 324, 325, 7, 112, 2, 2, 325, 326, 7, 119, 2, 2, 326, 327, 7, 110, 2,
-	2, 327, 328, 7, 110, 2, 2, 328, 8, 3, 2, 2, 2, 329, 334, 7, 36, 2, 2, 330,
-	333, 5, 11, 6, 2, 331, 333, 5, 17, 9, 2, 332, 330, 3, 2, 2, 2, 332, 331,
-	3, 2, 2, 2, 333, 336, 3, 2, 2, 2, 334, 332, 3, 2, 2, 2, 334, 335, 3, 2,
-	2, 2, 335, 337, 3, 2, 2, 2, 336, 334, 3, 2, 2, 2, 337, 338, 7, 36, 2, 2,
-	338, 10, 3, 2, 2, 2, 339, 342, 7, 94, 2, 2, 340, 343, 9, 2, 2, 2, 341,
-	343, 5, 13, 7, 2, 342, 340, 3, 2, 2, 2, 342, 341, 3, 2, 2, 2, 343, 12,
-	3, 2, 2, 2, 344, 345, 7, 119, 2, 2, 345, 346, 5, 15, 8, 2, 346, 347, 5,
-	15, 8, 2, 347, 348, 5, 15, 8, 2, 348, 349, 5, 15, 8, 2, 349, 14, 3, 2,
-	2, 2, 350, 351, 9, 3, 2, 2, 351, 16, 3, 2, 2, 2, 352, 353, 10, 4, 2, 2,
-	353, 18, 3, 2, 2, 2, 354, 356, 9, 5, 2, 2, 355, 357, 9, 6, 2, 2, 356, 355,
-	3, 2, 2, 2, 356, 357, 3, 2, 2, 2, 357, 358, 3, 2, 2, 2, 358, 359, 5, 251,
-	126, 2, 359, 20, 3, 2, 2, 2, 360, 362, 9, 7, 2, 2, 361, 360, 3, 2, 2, 2,
-	362, 363, 3, 2, 2, 2, 363, 361, 3, 2, 2, 2, 363, 364, 3, 2, 2, 2, 364,
-	365, 3, 2, 2, 2, 365, 366, 8, 11, 2, 2, 366, 22, 3, 2, 2, 2, 367, 368,
-	5, 265, 133, 2, 368, 369, 5, 295, 148, 2, 369, 370, 5, 269, 135, 2, 370,
-	371, 5, 261, 131, 2, 371, 372, 5, 299, 150, 2, 372, 373, 5, 269, 135, 2,
-	373, 24, 3, 2, 2, 2, 374, 375, 5, 301, 151, 2, 375, 376, 5, 291, 146, 2,
-	376, 377, 5, 267, 134, 2, 377, 378, 5, 261, 131, 2, 378, 379, 5, 299, 150,
-	2, 379, 380, 5, 269, 135, 2, 380, 26, 3, 2, 2, 2, 381, 382, 5, 297, 149,
-	2, 382, 383, 5, 269, 135, 2, 383, 384, 5, 299, 150, 2, 384, 28, 3, 2, 2,
-	2, 385, 386, 5, 267, 134, 2, 386, 387, 5, 295, 148, 2, 387, 388, 5, 289,
-	145, 2, 388, 389, 5, 291, 146, 2, 389, 30, 3, 2, 2, 2, 390, 391, 5, 277,
-	139, 2, 391, 392, 5, 287, 144, 2, 392, 393, 5, 299, 150, 2, 393, 394, 5,
-	269, 135, 2, 394, 395, 5, 295, 148, 2, 395, 396, 5, 303, 152, 2, 396, 397,
-	5, 261, 131, 2, 397, 398, 5, 283, 142, 2, 398, 32, 3, 2, 2, 2, 399, 400,
-	5, 287, 144, 2, 400, 401, 5, 261, 131, 2, 401, 402, 5, 285, 143, 2, 402,
-	403, 5, 269, 135, 2, 403, 34, 3, 2, 2, 2, 404, 405, 5, 297, 149, 2, 405,
-	406, 5, 275, 138, 2, 406, 407, 5, 261, 131, 2, 407, 408, 5, 295, 148, 2,
-	408, 409, 5, 267, 134, 2, 409, 36, 3, 2, 2, 2, 410, 411, 5, 295, 148, 2,
-	411, 412, 5, 269, 135, 2, 412, 413, 5, 291, 146, 2, 413, 414, 5, 283, 142,
-	2, 414, 415, 5, 277, 139, 2, 415, 416, 5, 265, 133, 2, 416, 417, 5, 261,
-	131, 2, 417, 418, 5, 299, 150, 2, 418, 419, 5, 277, 139, 2, 419, 420, 5,
-	289, 145, 2, 420, 421, 5, 287, 144, 2, 421, 38, 3, 2, 2, 2, 422, 423, 5,
-	299, 150, 2, 423, 424, 5, 299, 150, 2, 424, 425, 5, 283, 142, 2, 425, 40,
-	3, 2, 2, 2, 426, 427, 5, 285, 143, 2, 427, 428, 5, 269, 135, 2, 428, 429,
-	5, 299, 150, 2, 429, 430, 5, 261, 131, 2, 430, 431, 5, 299, 150, 2, 431,
-	432, 5, 299, 150, 2, 432, 433, 5, 283, 142, 2, 433, 42, 3, 2, 2, 2, 434,
-	435, 5, 291, 146, 2, 435, 436, 5, 261, 131, 2, 436, 437, 5, 297, 149, 2,
-	437, 438, 5, 299, 150, 2, 438, 439, 5, 299, 150, 2, 439, 440, 5, 299, 150,
-	2, 440, 441, 5, 283, 142, 2, 441, 44, 3, 2, 2, 2, 442, 443, 5, 271, 136,
-	2, 443, 444, 5, 301, 151, 2, 444, 445, 5, 299, 150, 2, 445, 446, 5, 301,
-	151, 2, 446, 447, 5, 295, 148, 2, 447, 448, 5, 269, 135, 2, 448, 449, 5,
-	299, 150, 2, 449, 450, 5, 299, 150, 2, 450, 451, 5, 283, 142, 2, 451, 46,
-	3, 2, 2, 2, 452, 453, 5, 281, 141, 2, 453, 454, 5, 277, 139, 2, 454, 455,
-	5, 283, 142, 2, 455, 456, 5, 283, 142, 2, 456, 48, 3, 2, 2, 2, 457, 458,
-	5, 289, 145, 2, 458, 459, 5, 287, 144, 2, 459, 50, 3, 2, 2, 2, 460, 461,
-	5, 297, 149, 2, 461, 462, 5, 275, 138, 2, 462, 463, 5, 289, 145, 2, 463,
-	464, 5, 305, 153, 2, 464, 52, 3, 2, 2, 2, 465, 466, 5, 301, 151, 2, 466,
-	467, 5, 297, 149, 2, 467, 468, 5, 269, 135, 2, 468, 54, 3, 2, 2, 2, 469,
-	470, 5, 297, 149, 2, 470, 471, 5, 299, 150, 2, 471, 472, 5, 261, 131, 2,
-	472, 473, 5, 299, 150, 2, 473, 474, 5, 269, 135, 2, 474, 475, 5, 247, 124,
-	2, 475, 476, 5, 295, 148, 2, 476, 477, 5, 269, 135, 2, 477, 478, 5, 291,
-	146, 2, 478, 479, 5, 289, 145, 2, 479, 56, 3, 2, 2, 2, 480, 481, 5, 297,
-	149, 2, 481, 482, 5, 299, 150, 2, 482, 483, 5, 261, 131, 2, 483, 484, 5,
-	299, 150, 2, 484, 485, 5, 269, 135, 2, 485, 486, 5, 247, 124, 2, 486, 487,
-	5, 285, 143, 2, 487, 488, 5, 261, 131, 2, 488, 489, 5, 265, 133, 2, 489,
-	490, 5, 275, 138, 2, 490, 491, 5, 277, 139, 2, 491, 492, 5, 287, 144, 2,
-	492, 493, 5, 269, 135, 2, 493, 58, 3, 2, 2, 2, 494, 495, 5, 285, 143, 2,
-	495, 496, 5, 261, 131, 2, 496, 497, 5, 297, 149, 2, 497, 498, 5, 299, 150,
-	2, 498, 499, 5, 269, 135, 2, 499, 500, 5, 295, 148, 2, 500, 60, 3, 2, 2,
-	2, 501, 502, 5, 285, 143, 2, 502, 503, 5, 269, 135, 2, 503, 504, 5, 299,
-	150, 2, 504, 505, 5, 261, 131, 2, 505, 506, 5, 267, 134, 2, 506, 507, 5,
-	261, 131, 2, 507, 508, 5, 299, 150, 2, 508, 509, 5, 261, 131, 2, 509, 62,
-	3, 2, 2, 2, 510, 511, 5, 299, 150, 2, 511, 512, 5, 309, 155, 2, 512, 513,
-	5, 291, 146, 2, 513, 514, 5, 269, 135, 2, 514, 515, 5, 297, 149, 2, 515,
-	64, 3, 2, 2, 2, 516, 517, 5, 299, 150, 2, 517, 518, 5, 309, 155, 2, 518,
-	519, 5, 291, 146, 2, 519, 520, 5, 269, 135, 2, 520, 66, 3, 2, 2, 2, 521,
-	522, 5, 297, 149, 2, 522, 523, 5, 299, 150, 2, 523, 524, 5, 289, 145, 2,
-	524, 525, 5, 295, 148, 2, 525, 526, 5, 261, 131, 2, 526, 527, 5, 273, 137,
-	2, 527, 528, 5, 269, 135, 2, 528, 529, 5, 297, 149, 2, 529, 68, 3, 2, 2,
-	2, 530, 531, 5, 297, 149, 2, 531, 532, 5, 299, 150, 2, 532, 533, 5, 289,
-	145, 2, 533, 534, 5, 295, 148, 2, 534, 535, 5, 261, 131, 2, 535, 536, 5,
-	273, 137, 2, 536, 537, 5, 269, 135, 2, 537, 70, 3, 2, 2, 2, 538, 539, 5,
-	263, 132, 2, 539, 540, 5, 295, 148, 2, 540, 541, 5, 289, 145, 2, 541, 542,
-	5, 281, 141, 2, 542, 543, 5, 269, 135, 2, 543, 544, 5, 295, 148, 2, 544,
-	72, 3, 2, 2, 2, 545, 546, 5, 261, 131, 2, 546, 547, 5, 283, 142, 2, 547,
-	548, 5, 277, 139, 2, 548, 549, 5, 303, 152, 2, 549, 550, 5, 269, 135, 2,
-	550, 74, 3, 2, 2, 2, 551, 552, 5, 297, 149, 2, 552, 553, 5, 265, 133, 2,
-	553, 554, 5, 275, 138, 2, 554, 555, 5, 269, 135, 2, 555, 556, 5, 285, 143,
-	2, 556, 557, 5, 261, 131, 2, 557, 558, 5, 297, 149, 2, 558, 76, 3, 2, 2,
-	2, 559, 560, 5, 267, 134, 2, 560, 561, 5, 261, 131, 2, 561, 562, 5, 299,
-	150, 2, 562, 563, 5, 261, 131, 2, 563, 564, 5, 263, 132, 2, 564, 565, 5,
-	261, 131, 2, 565, 566, 5, 297, 149, 2, 566, 567, 5, 269, 135, 2, 567, 78,
-	3, 2, 2, 2, 568, 569, 5, 267, 134, 2, 569, 570, 5, 261, 131, 2, 570, 571,
-	5, 299, 150, 2, 571, 572, 5, 261, 131, 2, 572, 573, 5, 263, 132, 2, 573,
-	574, 5, 261, 131, 2, 574, 575, 5, 297, 149, 2, 575, 576, 5, 269, 135, 2,
-	576, 577, 5, 297, 149, 2, 577, 80, 3, 2, 2, 2, 578, 579, 5, 287, 144, 2,
-	579, 580, 5, 261, 131, 2, 580, 581, 5, 285, 143, 2, 581, 582, 5, 269, 135,
-	2, 582, 583, 5, 297, 149, 2, 583, 584, 5, 291, 146, 2, 584, 585, 5, 261,
-	131, 2, 585, 586, 5, 265, 133, 2, 586, 587, 5, 269, 135, 2, 587, 82, 3,
-	2, 2, 2, 588, 589, 5, 287, 144, 2, 589, 590, 5, 261, 131, 2, 590, 591,
-	5, 285, 143, 2, 591, 592, 5, 269, 135, 2, 592, 593, 5, 297, 149, 2, 593,
-	594, 5, 291, 146, 2, 594, 595, 5, 261, 131, 2, 595, 596, 5, 265, 133, 2,
-	596, 597, 5, 269, 135, 2, 597, 598, 5, 297, 149, 2, 598, 84, 3, 2, 2, 2,
-	599, 600, 5, 287, 144, 2, 600, 601, 5, 289, 145, 2, 601, 602, 5, 267, 134,
-	2, 602, 603, 5, 269, 135, 2, 603, 86, 3, 2, 2, 2, 604, 605, 5, 285, 143,
-	2, 605, 606, 5, 269, 135, 2, 606, 607, 5, 299, 150, 2, 607, 608, 5, 295,
-	148, 2, 608, 609, 5, 277, 139, 2, 609, 610, 5, 265, 133, 2, 610, 611, 5,
-	297, 149, 2, 611, 88, 3, 2, 2, 2, 612, 613, 5, 285, 143, 2, 613, 614, 5,
-	269, 135, 2, 614, 615, 5, 299, 150, 2, 615, 616, 5, 295, 148, 2, 616, 617,
-	5, 277, 139, 2, 617, 618, 5, 265, 133, 2, 618, 90, 3, 2, 2, 2, 619, 620,
-	5, 271, 136, 2, 620, 621, 5, 277, 139, 2, 621, 622, 5, 269, 135, 2, 622,
-	623, 5, 283, 142, 2, 623, 624, 5, 267, 134, 2, 624, 92, 3, 2, 2, 2, 625,
-	626, 5, 271, 136, 2, 626, 627, 5, 277, 139, 2, 627, 628, 5, 269, 135, 2,
-	628, 629, 5, 283, 142, 2, 629, 630, 5, 267, 134, 2, 630, 631, 5, 297, 149,
-	2, 631, 94, 3, 2, 2, 2, 632, 633, 5, 299, 150, 2, 633, 634, 5, 261, 131,
-	2, 634, 635, 5, 273, 137, 2, 635, 96, 3, 2, 2, 2, 636, 637, 5, 277, 139,
-	2, 637, 638, 5, 287, 144, 2, 638, 639, 5, 271, 136, 2, 639, 640, 5, 289,
-	145, 2, 640, 98, 3, 2, 2, 2, 641, 642, 5, 281, 141, 2, 642, 643, 5, 269,
-	135, 2, 643, 644, 5, 309, 155, 2, 644, 645, 5, 297, 149, 2, 645, 100, 3,
-	2, 2, 2, 646, 647, 5, 281, 141, 2, 647, 648, 5, 269, 135, 2, 648, 649,
-	5, 309, 155, 2, 649, 102, 3, 2, 2, 2, 650, 651, 5, 305, 153, 2, 651, 652,
-	5, 277, 139, 2, 652, 653, 5, 299, 150, 2, 653, 654, 5, 275, 138, 2, 654,
-	104, 3, 2, 2, 2, 655, 656, 5, 303, 152, 2, 656, 657, 5, 261, 131, 2, 657,
-	658, 5, 283, 142, 2, 658, 659, 5, 301, 151, 2, 659, 660, 5, 269, 135, 2,
-	660, 661, 5, 297, 149, 2, 661, 106, 3, 2, 2, 2, 662, 663, 5, 303, 152,
-	2, 663, 664, 5, 261, 131, 2, 664, 665, 5, 283, 142, 2, 665, 666, 5, 301,
-	151, 2, 666, 667, 5, 269, 135, 2, 667, 108, 3, 2, 2, 2, 668, 669, 5, 271,
-	136, 2, 669, 670, 5, 295, 148, 2, 670, 671, 5, 289, 145, 2, 671, 672, 5,
-	285, 143, 2, 672, 110, 3, 2, 2, 2, 673, 674, 5, 305, 153, 2, 674, 675,
-	5, 275, 138, 2, 675, 676, 5, 269, 135, 2, 676, 677, 5, 295, 148, 2, 677,
-	678, 5, 269, 135, 2, 678, 112, 3, 2, 2, 2, 679, 680, 5, 283, 142, 2, 680,
-	681, 5, 277, 139, 2, 681, 682, 5, 285, 143, 2, 682, 683, 5, 277, 139, 2,
-	683, 684, 5, 299, 150, 2, 684, 114, 3, 2, 2, 2, 685, 686, 5, 293, 147,
-	2, 686, 687, 5, 301, 151, 2, 687, 688, 5, 269, 135, 2, 688, 689, 5, 295,
-	148, 2, 689, 690, 5, 277, 139, 2, 690, 691, 5, 269, 135, 2, 691, 692, 5,
-	297, 149, 2, 692, 116, 3, 2, 2, 2, 693, 694, 5, 293, 147, 2, 694, 695,
-	5, 301, 151, 2, 695, 696, 5, 269, 135, 2, 696, 697, 5, 295, 148, 2, 697,
-	698, 5, 309, 155, 2, 698, 118, 3, 2, 2, 2, 699, 700, 5, 269, 135, 2, 700,
-	701, 5, 307, 154, 2, 701, 702, 5, 291, 146, 2, 702, 703, 5, 283, 142, 2,
-	703, 704, 5, 261, 131, 2, 704, 705, 5, 277, 139, 2, 705, 706, 5, 287, 144,
-	2, 706, 120, 3, 2, 2, 2, 707, 708, 5, 305, 153, 2, 708, 709, 5, 277, 139,
-	2, 709, 710, 5, 299, 150, 2, 710, 711, 5, 275, 138, 2, 711, 712, 5, 303,
-	152, 2, 712, 713, 5, 261, 131, 2, 713, 714, 5, 283, 142, 2, 714, 715, 5,
-	301, 151, 2, 715, 716, 5, 269, 135, 2, 716, 122, 3, 2, 2, 2, 717, 718,
-	5, 297, 149, 2, 718, 719, 5, 269, 135, 2, 719, 720, 5, 283, 142, 2, 720,
-	721, 5, 269, 135, 2, 721, 722, 5, 265, 133, 2, 722, 723, 5, 299, 150, 2,
-	723, 124, 3, 2, 2, 2, 724, 725, 5, 261, 131, 2, 725, 726, 5, 297, 149,
-	2, 726, 126, 3, 2, 2, 2, 727, 728, 5, 261, 131, 2, 728, 729, 5, 287, 144,
-	2, 729, 730, 5, 267, 134, 2, 730, 128, 3, 2, 2, 2, 731, 732, 5, 289, 145,
-	2, 732, 733, 5, 295, 148, 2, 733, 130, 3, 2, 2, 2, 734, 735, 5, 271, 136,
-	2, 735, 736, 5, 277, 139, 2, 736, 737, 5, 283, 142, 2, 737, 738, 5, 283,
-	142, 2, 738, 132, 3, 2, 2, 2, 739, 740, 5, 287, 144, 2, 740, 741, 5, 301,
-	151, 2, 741, 742, 5, 283, 142, 2, 742, 743, 5, 283, 142, 2, 743, 134, 3,
-	2, 2, 2, 744, 745, 5, 291, 146, 2, 745, 746, 5, 295, 148, 2, 746, 747,
-	5, 269, 135, 2, 747, 748, 5, 303, 152, 2, 748, 749, 5, 277, 139, 2, 749,
-	750, 5, 289, 145, 2, 750, 751, 5, 301, 151, 2, 751, 752, 5, 297, 149, 2,
-	752, 136, 3, 2, 2, 2, 753, 754, 5, 289, 145, 2, 754, 755, 5, 295, 148,
-	2, 755, 756, 5, 267, 134, 2, 756, 757, 5, 269, 135, 2, 757, 758, 5, 295,
-	148, 2, 758, 138, 3, 2, 2, 2, 759, 760, 5, 261, 131, 2, 760, 761, 5, 297,
-	149, 2, 761, 762, 5, 265, 133, 2, 762, 140, 3, 2, 2, 2, 763, 764, 5, 267,
-	134, 2, 764, 765, 5, 269, 135, 2, 765, 766, 5, 297, 149, 2, 766, 767, 5,
-	265, 133, 2, 767, 142, 3, 2, 2, 2, 768, 769, 5, 283, 142, 2, 769, 770,
-	5, 277, 139, 2, 770, 771, 5, 281, 141, 2, 771, 772, 5, 269, 135, 2, 772,
-	144, 3, 2, 2, 2, 773, 774, 5, 287, 144, 2, 774, 775, 5, 289, 145, 2, 775,
-	776, 5, 299, 150, 2, 776, 146, 3, 2, 2, 2, 777, 778, 5, 263, 132, 2, 778,
-	779, 5, 269, 135, 2, 779, 780, 5, 299, 150, 2, 780, 781, 5, 305, 153, 2,
-	781, 782, 5, 269, 135, 2, 782, 783, 5, 269, 135, 2, 783, 784, 5, 287, 144,
-	2, 784, 148, 3, 2, 2, 2, 785, 786, 5, 277, 139, 2, 786, 787, 5, 297, 149,
-	2, 787, 150, 3, 2, 2, 2, 788, 789, 5, 273, 137, 2, 789, 790, 5, 295, 148,
-	2, 790, 791, 5, 289, 145, 2, 791, 792, 5, 301, 151, 2, 792, 793, 5, 291,
-	146, 2, 793, 152, 3, 2, 2, 2, 794, 795, 5, 275, 138, 2, 795, 796, 5, 261,
-	131, 2, 796, 797, 5, 303, 152, 2, 797, 798, 5, 277, 139, 2, 798, 799, 5,
-	287, 144, 2, 799, 800, 5, 273, 137, 2, 800, 154, 3, 2, 2, 2, 801, 802,
-	5, 263, 132, 2, 802, 803, 5, 309, 155, 2, 803, 156, 3, 2, 2, 2, 804, 805,
-	5, 271, 136, 2, 805, 806, 5, 289, 145, 2, 806, 807, 5, 295, 148, 2, 807,
-	158, 3, 2, 2, 2, 808, 809, 5, 297, 149, 2, 809, 810, 5, 299, 150, 2, 810,
-	811, 5, 261, 131, 2, 811, 812, 5, 299, 150, 2, 812, 813, 5, 297, 149, 2,
-	813, 160, 3, 2, 2, 2, 814, 815, 5, 299, 150, 2, 815, 816, 5, 277, 139,
-	2, 816, 817, 5, 285, 143, 2, 817, 818, 5, 269, 135, 2, 818, 162, 3, 2,
-	2, 2, 819, 820, 5, 287, 144, 2, 820, 821, 5, 289, 145, 2, 821, 822, 5,
-	305, 153, 2, 822, 164, 3, 2, 2, 2, 823, 824, 5, 277, 139, 2, 824, 825,
-	5, 287, 144, 2, 825, 166, 3, 2, 2, 2, 826, 827, 5, 283, 142, 2, 827, 828,
-	5, 289, 145, 2, 828, 829, 5, 273, 137, 2, 829, 168, 3, 2, 2, 2, 830, 831,
-	5, 291, 146, 2, 831, 832, 5, 295, 148, 2, 832, 833, 5, 289, 145, 2, 833,
-	834, 5, 271, 136, 2, 834, 835, 5, 277, 139, 2, 835, 836, 5, 283, 142, 2,
-	836, 837, 5, 269, 135, 2, 837, 170, 3, 2, 2, 2, 838, 839, 5, 297, 149,
-	2, 839, 840, 5, 301, 151, 2, 840, 841, 5, 285, 143, 2, 841, 172, 3, 2,
-	2, 2, 842, 843, 5, 285, 143, 2, 843, 844, 5, 277, 139, 2, 844, 845, 5,
-	287, 144, 2, 845, 174, 3, 2, 2, 2, 846, 847, 5, 285, 143, 2, 847, 848,
-	5, 261, 131, 2, 848, 849, 5, 307, 154, 2, 849, 176, 3, 2, 2, 2, 850, 851,
-	5, 265, 133, 2, 851, 852, 5, 289, 145, 2, 852, 853, 5, 301, 151, 2, 853,
-	854, 5, 287, 144, 2, 854, 855, 5, 299, 150, 2, 855, 178, 3, 2, 2, 2, 856,
-	857, 5, 261, 131, 2, 857, 858, 5, 303, 152, 2, 858, 859, 5, 273, 137, 2,
-	859, 180, 3, 2, 2, 2, 860, 861, 5, 297, 149, 2, 861, 862, 5, 299, 150,
-	2, 862, 863, 5, 267, 134, 2, 863, 864, 5, 267, 134, 2, 864, 865, 5, 269,
-	135, 2, 865, 866, 5, 303, 152, 2, 866, 182, 3, 2, 2, 2, 867, 868, 5, 293,
-	147, 2, 868, 869, 5, 301, 151, 2, 869, 870, 5, 261, 131, 2, 870, 871, 5,
-	287, 144, 2, 871, 872, 5, 299, 150, 2, 872, 873, 5, 277, 139, 2, 873, 874,
-	5, 283, 142, 2, 874, 875, 5, 269, 135, 2, 875, 184, 3, 2, 2, 2, 876, 877,
-	5, 295, 148, 2, 877, 878, 5, 261, 131, 2, 878, 879, 5, 299, 150, 2, 879,
-	880, 5, 269, 135, 2, 880, 186, 3, 2, 2, 2, 881, 882, 5, 297, 149, 2, 882,
-	188, 3, 2, 2, 2, 883, 884, 7, 111, 2, 2, 884, 190, 3, 2, 2, 2, 885, 886,
-	5, 275, 138, 2, 886, 192, 3, 2, 2, 2, 887, 888, 5, 267, 134, 2, 888, 194,
-	3, 2, 2, 2, 889, 890, 5, 305, 153, 2, 890, 196, 3, 2, 2, 2, 891, 892, 7,
-	79, 2, 2, 892, 198, 3, 2, 2, 2, 893, 894, 5, 309, 155, 2, 894, 200, 3,
-	2, 2, 2, 895, 896, 7, 48, 2, 2, 896, 202, 3, 2, 2, 2, 897, 898, 7, 60,
-	2, 2, 898, 204, 3, 2, 2, 2, 899, 900, 7, 63, 2, 2, 900, 206, 3, 2, 2, 2,
-	901, 902, 7, 62, 2, 2, 902, 903, 7, 64, 2, 2, 903, 208, 3, 2, 2, 2, 904,
-	905, 7, 35, 2, 2, 905, 906, 7, 63, 2, 2, 906, 210, 3, 2, 2, 2, 907, 908,
-	7, 64, 2, 2, 908, 212, 3, 2, 2, 2, 909, 910, 7, 64, 2, 2, 910, 911, 7,
-	63, 2, 2, 911, 214, 3, 2, 2, 2, 912, 913, 7, 62, 2, 2, 913, 216, 3, 2,
-	2, 2, 914, 915, 7, 62, 2, 2, 915, 916, 7, 63, 2, 2, 916, 218, 3, 2, 2,
-	2, 917, 918, 7, 63, 2, 2, 918, 919, 7, 128, 2, 2, 919, 220, 3, 2, 2, 2,
-	920, 921, 7, 35, 2, 2, 921, 922, 7, 128, 2, 2, 922, 222, 3, 2, 2, 2, 923,
-	924, 7, 46, 2, 2, 924, 224, 3, 2, 2, 2, 925, 926, 7, 125, 2, 2, 926, 226,
-	3, 2, 2, 2, 927, 928, 7, 127, 2, 2, 928, 228, 3, 2, 2, 2, 929, 930, 7,
-	93, 2, 2, 930, 230, 3, 2, 2, 2, 931, 932, 7, 95, 2, 2, 932, 232, 3, 2,
-	2, 2, 933, 934, 7, 42, 2, 2, 934, 234, 3, 2, 2, 2, 935, 936, 7, 43, 2,
-	2, 936, 236, 3, 2, 2, 2, 937, 938, 7, 45, 2, 2, 938, 238, 3, 2, 2, 2, 939,
-	940, 7, 47, 2, 2, 940, 240, 3, 2, 2, 2, 941, 942, 7, 49, 2, 2, 942, 242,
-	3, 2, 2, 2, 943, 944, 7, 44, 2, 2, 944, 244, 3, 2, 2, 2, 945, 946, 7, 39,
-	2, 2, 946, 246, 3, 2, 2, 2, 947, 948, 7, 97, 2, 2, 948, 248, 3, 2, 2, 2,
-	949, 950, 5, 259, 130, 2, 950, 250, 3, 2, 2, 2, 951, 953, 5, 257, 129,
-	2, 952, 951, 3, 2, 2, 2, 953, 954, 3, 2, 2, 2, 954, 952, 3, 2, 2, 2, 954,
-	955, 3, 2, 2, 2, 955, 252, 3, 2, 2, 2, 956, 958, 5, 257, 129, 2, 957, 956,
-	3, 2, 2, 2, 958, 959, 3, 2, 2, 2, 959, 957, 3, 2, 2, 2, 959, 960, 3, 2,
-	2, 2, 960, 961, 3, 2, 2, 2, 961, 962, 7, 48, 2, 2, 962, 966, 10, 8, 2,
-	2, 963, 965, 5, 257, 129, 2, 964, 963, 3, 2, 2, 2, 965, 968, 3, 2, 2, 2,
-	966, 964, 3, 2, 2, 2, 966, 967, 3, 2, 2, 2, 967, 976, 3, 2, 2, 2, 968,
-	966, 3, 2, 2, 2, 969, 971, 7, 48, 2, 2, 970, 972, 5, 257, 129, 2, 971,
-	970, 3, 2, 2, 2, 972, 973, 3, 2, 2, 2, 973, 971, 3, 2, 2, 2, 973, 974,
-	3, 2, 2, 2, 974, 976, 3, 2, 2, 2, 975, 957, 3, 2, 2, 2, 975, 969, 3, 2,
-	2, 2, 976, 254, 3, 2, 2, 2, 977, 978, 9, 7, 2, 2, 978, 256, 3, 2, 2, 2,
-	979, 980, 9, 9, 2, 2, 980, 258, 3, 2, 2, 2, 981, 987, 9, 10, 2, 2, 982,
-	986, 9, 10, 2, 2, 983, 986, 5, 257, 129, 2, 984, 986, 9, 11, 2, 2, 985,
-	982, 3, 2, 2, 2, 985, 983, 3, 2, 2, 2, 985, 984, 3, 2, 2, 2, 986, 989,
-	3, 2, 2, 2, 987, 985, 3, 2, 2, 2, 987, 988, 3, 2, 2, 2, 988, 1032, 3, 2,
-	2, 2, 989, 987, 3, 2, 2, 2, 990, 991, 7, 38, 2, 2, 991, 995, 7, 125, 2,
-	2, 992, 994, 11, 2, 2, 2, 993, 992, 3, 2, 2, 2, 994, 997, 3, 2, 2, 2, 995,
-	996, 3, 2, 2, 2, 995, 993, 3, 2, 2, 2, 996, 998, 3, 2, 2, 2, 997, 995,
-	3, 2, 2, 2, 998, 1032, 7, 127, 2, 2, 999, 1003, 9, 12, 2, 2, 1000, 1004,
-	9, 10, 2, 2, 1001, 1004, 5, 257, 129, 2, 1002, 1004, 9, 13, 2, 2, 1003,
-	1000, 3, 2, 2, 2, 1003, 1001, 3, 2, 2, 2, 1003, 1002, 3, 2, 2, 2, 1004,
-	1005, 3, 2, 2, 2, 1005, 1003, 3, 2, 2, 2, 1005, 1006, 3, 2, 2, 2, 1006,
-	1032, 3, 2, 2, 2, 1007, 1011, 7, 36, 2, 2, 1008, 1010, 11, 2, 2, 2, 1009,
-	1008, 3, 2, 2, 2, 1010, 1013, 3, 2, 2, 2, 1011, 1012, 3, 2, 2, 2, 1011,
-	1009, 3, 2, 2, 2, 1012, 1014, 3, 2, 2, 2, 1013, 1011, 3, 2, 2, 2, 1014,
-	1032, 7, 36, 2, 2, 1015, 1019, 7, 98, 2, 2, 1016, 1018, 11, 2, 2, 2, 1017,
-	1016, 3, 2, 2, 2, 1018, 1021, 3, 2, 2, 2, 1019, 1020, 3, 2, 2, 2, 1019,
-	1017, 3, 2, 2, 2, 1020, 1022, 3, 2, 2, 2, 1021, 1019, 3, 2, 2, 2, 1022,
-	1032, 7, 98, 2, 2, 1023, 1027, 7, 41, 2, 2, 1024, 1026, 11, 2, 2, 2, 1025,
-	1024, 3, 2, 2, 2, 1026, 1029, 3, 2, 2, 2, 1027, 1028, 3, 2, 2, 2, 1027,
-	1025, 3, 2, 2, 2, 1028, 1030, 3, 2, 2, 2, 1029, 1027, 3, 2, 2, 2, 1030,
-	1032, 7, 41, 2, 2, 1031, 981, 3, 2, 2, 2, 1031, 990, 3, 2, 2, 2, 1031,
-	999, 3, 2, 2, 2, 1031, 1007, 3, 2, 2, 2, 1031, 1015, 3, 2, 2, 2, 1031,
-	1023, 3, 2, 2, 2, 1032, 260, 3, 2, 2, 2, 1033, 1034, 9, 14, 2, 2, 1034,
-	262, 3, 2, 2, 2, 1035, 1036, 9, 15, 2, 2, 1036, 264, 3, 2, 2, 2, 1037,
-	1038, 9, 16, 2, 2, 1038, 266, 3, 2, 2, 2, 1039, 1040, 9, 17, 2, 2, 1040,
-	268, 3, 2, 2, 2, 1041, 1042, 9, 5, 2, 2, 1042, 270, 3, 2, 2, 2, 1043, 1044,
-	9, 18, 2, 2, 1044, 272, 3, 2, 2, 2, 1045, 1046, 9, 19, 2, 2, 1046, 274,
-	3, 2, 2, 2, 1047, 1048, 9, 20, 2, 2, 1048, 276, 3, 2, 2, 2, 1049, 1050,
-	9, 21, 2, 2, 1050, 278, 3, 2, 2, 2, 1051, 1052, 9, 22, 2, 2, 1052, 280,
-	3, 2, 2, 2, 1053, 1054, 9, 23, 2, 2, 1054, 282, 3, 2, 2, 2, 1055, 1056,
-	9, 24, 2, 2, 1056, 284, 3, 2, 2, 2, 1057, 1058, 9, 25, 2, 2, 1058, 286,
-	3, 2, 2, 2, 1059, 1060, 9, 26, 2, 2, 1060, 288, 3, 2, 2, 2, 1061, 1062,
-	9, 27, 2, 2, 1062, 290, 3, 2, 2, 2, 1063, 1064, 9, 28, 2, 2, 1064, 292,
-	3, 2, 2, 2, 1065, 1066, 9, 29, 2, 2, 1066, 294, 3, 2, 2, 2, 1067, 1068,
-	9, 30, 2, 2, 1068, 296, 3, 2, 2, 2, 1069, 1070, 9, 31, 2, 2, 1070, 298,
-	3, 2, 2, 2, 1071, 1072, 9, 32, 2, 2, 1072, 300, 3, 2, 2, 2, 1073, 1074,
-	9, 33, 2, 2, 1074, 302, 3, 2, 2, 2, 1075, 1076, 9, 34, 2, 2, 1076, 304,
-	3, 2, 2, 2, 1077, 1078, 9, 35, 2, 2, 1078, 306, 3, 2, 2, 2, 1079, 1080,
-	9, 36, 2, 2, 1080, 308, 3, 2, 2, 2, 1081, 1082, 9, 37, 2, 2, 1082, 310,
-	3, 2, 2, 2, 1083, 1084, 9, 38, 2, 2, 1084, 312, 3, 2, 2, 2, 22, 2, 332,
-	334, 342, 356, 363, 954, 959, 966, 973, 975, 985, 987, 995, 1003, 1005,
-	1011, 1019, 1027, 1031, 3, 8, 2, 2,
+	50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3,
+	54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55,
+	3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3,
+	56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3,
+	58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59,
+	3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3,
+	61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63,
+	3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3,
+	65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67,
+	3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3,
+	69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71,
+	3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3,
+	73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74,
+	3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76,
+	3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3,
+	79, 3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 81,
+	3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84,
+	3, 85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3,
+	87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89,
+	3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3,
+	91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92,
+	3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 95, 3,
+	95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 97, 3, 97, 3, 97,
+	3, 97, 3, 98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99, 3, 99, 3, 100, 3, 100,
+	3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 101, 3, 101, 3, 101,
+	3, 101, 3, 102, 3, 102, 3, 102, 3, 102, 3, 103, 3, 103, 3, 103, 3, 103,
+	3, 104, 3, 104, 3, 104, 3, 104, 3, 104, 3, 104, 3, 105, 3, 105, 3, 105,
+	3, 105, 3, 106, 3, 106, 3, 106, 3, 106, 3, 106, 3, 106, 3, 106, 3, 107,
+	3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 108,
+	3, 108, 3, 108, 3, 108, 3, 108, 3, 109, 3, 109, 3, 109, 3, 109, 3, 109,
+	3, 109, 3, 109, 3, 109, 3, 109, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110,
+	3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111,
+	3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111,
+	3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 113, 3, 113,
+	3, 113, 3, 113, 3, 113, 3, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114,
+	3, 114, 3, 114, 3, 114, 3, 114, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115,
+	3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 116, 3, 116, 3, 116,
+	3, 116, 3, 116, 3, 116, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117,
+	3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 118, 3, 118, 3, 118, 3, 118,
+	3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 119, 3, 119,
+	3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119,
+	3, 119, 3, 119, 3, 119, 3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120,
+	3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121,
+	3, 121, 3, 121, 3, 122, 3, 122, 3, 122, 3, 122, 3, 122, 3, 123, 3, 123,
+	3, 123, 3, 123, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 125, 3, 125,
+	3, 125, 3, 125, 3, 125, 3, 125, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126,
+	3, 126, 3, 127, 3, 127, 3, 127, 3, 127, 3, 128, 3, 128, 3, 128, 3, 128,
+	3, 128, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129,
+	3, 129, 3, 129, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130,
+	3, 130, 3, 130, 3, 130, 3, 131, 3, 131, 3, 131, 3, 132, 3, 132, 3, 132,
+	3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133,
+	3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 134, 3, 134, 3, 134, 3, 134,
+	3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134,
+	3, 134, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135,
+	3, 135, 3, 135, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136,
+	3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136,
+	3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137,
+	3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 138, 3, 138, 3, 138, 3, 138,
+	3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138,
+	3, 138, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139,
+	3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139,
+	3, 139, 3, 139, 3, 140, 3, 140, 3, 140, 3, 140, 3, 140, 3, 140, 3, 140,
+	3, 140, 3, 141, 3, 141, 3, 141, 3, 141, 3, 141, 3, 142, 3, 142, 3, 142,
+	3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142,
+	3, 142, 3, 142, 3, 142, 3, 143, 3, 143, 3, 144, 3, 144, 3, 145, 3, 145,
+	3, 146, 3, 146, 3, 147, 3, 147, 3, 148, 3, 148, 3, 149, 3, 149, 3, 150,
+	3, 150, 3, 151, 3, 151, 3, 152, 3, 152, 3, 153, 3, 153, 3, 153, 3, 154,
+	3, 154, 3, 154, 3, 155, 3, 155, 3, 156, 3, 156, 3, 156, 3, 157, 3, 157,
+	3, 158, 3, 158, 3, 158, 3, 159, 3, 159, 3, 159, 3, 160, 3, 160, 3, 160,
+	3, 161, 3, 161, 3, 162, 3, 162, 3, 163, 3, 163, 3, 164, 3, 164, 3, 165,
+	3, 165, 3, 166, 3, 166, 3, 167, 3, 167, 3, 168, 3, 168, 3, 169, 3, 169,
+	3, 170, 3, 170, 3, 171, 3, 171, 3, 172, 3, 172, 3, 173, 3, 173, 3, 174,
+	3, 174, 3, 175, 6, 175, 1463, 10, 175, 13, 175, 14, 175, 1464, 3, 176,
+	6, 176, 1468, 10, 176, 13, 176, 14, 176, 1469, 3, 176, 3, 176, 3, 176,
+	7, 176, 1475, 10, 176, 12, 176, 14, 176, 1478, 11, 176, 3, 176, 3, 176,
+	6, 176, 1482, 10, 176, 13, 176, 14, 176, 1483, 5, 176, 1486, 10, 176, 3,
+	177, 3, 177, 3, 178, 3, 178, 3, 179, 3, 179, 3, 179, 3, 179, 7, 179, 1496,
+	10, 179, 12, 179, 14, 179, 1499, 11, 179, 3, 179, 3, 179, 3, 179, 7, 179,
+	1504, 10, 179, 12, 179, 14, 179, 1507, 11, 179, 3, 179, 3, 179, 3, 179,
+	3, 179, 3, 179, 6, 179, 1514, 10, 179, 13, 179, 14, 179, 1515, 3, 179,
+	3, 179, 7, 179, 1520, 10, 179, 12, 179, 14, 179, 1523, 11, 179, 3, 179,
+	3, 179, 3, 179, 7, 179, 1528, 10, 179, 12, 179, 14, 179, 1531, 11, 179,
+	3, 179, 3, 179, 3, 179, 7, 179, 1536, 10, 179, 12, 179, 14, 179, 1539,
+	11, 179, 3, 179, 5, 179, 1542, 10, 179, 3, 180, 3, 180, 3, 181, 3, 181,
+	3, 182, 3, 182, 3, 183, 3, 183, 3, 184, 3, 184, 3, 185, 3, 185, 3, 186,
+	3, 186, 3, 187, 3, 187, 3, 188, 3, 188, 3, 189, 3, 189, 3, 190, 3, 190,
+	3, 191, 3, 191, 3, 192, 3, 192, 3, 193, 3, 193, 3, 194, 3, 194, 3, 195,
+	3, 195, 3, 196, 3, 196, 3, 197, 3, 197, 3, 198, 3, 198, 3, 199, 3, 199,
+	3, 200, 3, 200, 3, 201, 3, 201, 3, 202, 3, 202, 3, 203, 3, 203, 3, 204,
+	3, 204, 3, 205, 3, 205, 6, 1505, 1521, 1529, 1537, 2, 206, 3, 3, 5, 4,
+	7, 5, 9, 2, 11, 2, 13, 2, 15, 2, 17, 2, 19, 6, 21, 7, 23, 8, 25, 9, 27,
+	10, 29, 11, 31, 12, 33, 13, 35, 14, 37, 15, 39, 16, 41, 17, 43, 18, 45,
+	19, 47, 20, 49, 21, 51, 22, 53, 23, 55, 24, 57, 25, 59, 26, 61, 27, 63,
+	28, 65, 29, 67, 30, 69, 31, 71, 32, 73, 33, 75, 34, 77, 35, 79, 36, 81,
+	37, 83, 38, 85, 39, 87, 40, 89, 41, 91, 42, 93, 43, 95, 44, 97, 45, 99,
+	46, 101, 47, 103, 48, 105, 49, 107, 50, 109, 51, 111, 52, 113, 53, 115,
+	54, 117, 55, 119, 56, 121, 57, 123, 58, 125, 59, 127, 60, 129, 61, 131,
+	62, 133, 63, 135, 64, 137, 65, 139, 66, 141, 67, 143, 68, 145, 69, 147,
+	70, 149, 71, 151, 72, 153, 73, 155, 74, 157, 75, 159, 76, 161, 77, 163,
+	78, 165, 79, 167, 80, 169, 81, 171, 82, 173, 83, 175, 84, 177, 85, 179,
+	86, 181, 87, 183, 88, 185, 89, 187, 90, 189, 91, 191, 92, 193, 93, 195,
+	94, 197, 95, 199, 96, 201, 97, 203, 98, 205, 99, 207, 100, 209, 101, 211,
+	102, 213, 103, 215, 104, 217, 105, 219, 106, 221, 107, 223, 108, 225, 109,
+	227, 110, 229, 111, 231, 112, 233, 113, 235, 114, 237, 115, 239, 116, 241,
+	117, 243, 118, 245, 119, 247, 120, 249, 121, 251, 122, 253, 123, 255, 124,
+	257, 125, 259, 126, 261, 127, 263, 128, 265, 129, 267, 130, 269, 131, 271,
+	132, 273, 133, 275, 134, 277, 135, 279, 136, 281, 137, 283, 138, 285, 139,
+	287, 140, 289, 141, 291, 142, 293, 143, 295, 144, 297, 145, 299, 146, 301,
+	147, 303, 148, 305, 149, 307, 150, 309, 151, 311, 152, 313, 153, 315, 154,
+	317, 155, 319, 156, 321, 157, 323, 158, 325, 159, 327, 160, 329, 161, 331,
+	162, 333, 163, 335, 164, 337, 165, 339, 166, 341, 167, 343, 168, 345, 169,
+	347, 170, 349, 171, 351, 172, 353, 2, 355, 2, 357, 2, 359, 2, 361, 2, 363,
+	2, 365, 2, 367, 2, 369, 2, 371, 2, 373, 2, 375, 2, 377, 2, 379, 2, 381,
+	2, 383, 2, 385, 2, 387, 2, 389, 2, 391, 2, 393, 2, 395, 2, 397, 2, 399,
+	2, 401, 2, 403, 2, 405, 2, 407, 2, 409, 2, 3, 2, 39, 10, 2, 36, 36, 49,
+	49, 94, 94, 100, 100, 104, 104, 112, 112, 116, 116, 118, 118, 5, 2, 50,
+	59, 67, 72, 99, 104, 5, 2, 2, 33, 36, 36, 94, 94, 4, 2, 71, 71, 103, 103,
+	4, 2, 45, 45, 47, 47, 5, 2, 11, 12, 15, 15, 34, 34, 3, 2, 48, 48, 3, 2,
+	50, 59, 4, 2, 67, 92, 99, 124, 4, 2, 48, 48, 97, 97, 5, 2, 37, 38, 66,
+	66, 97, 97, 6, 2, 37, 38, 60, 60, 66, 66, 97, 97, 4, 2, 67, 67, 99, 99,
+	4, 2, 68, 68, 100, 100, 4, 2, 69, 69, 101, 101, 4, 2, 70, 70, 102, 102,
+	4, 2, 72, 72, 104, 104, 4, 2, 73, 73, 105, 105, 4, 2, 74, 74, 106, 106,
+	4, 2, 75, 75, 107, 107, 4, 2, 76, 76, 108, 108, 4, 2, 77, 77, 109, 109,
+	4, 2, 78, 78, 110, 110, 4, 2, 79, 79, 111, 111, 4, 2, 80, 80, 112, 112,
+	4, 2, 81, 81, 113, 113, 4, 2, 82, 82, 114, 114, 4, 2, 83, 83, 115, 115,
+	4, 2, 84, 84, 116, 116, 4, 2, 85, 85, 117, 117, 4, 2, 86, 86, 118, 118,
+	4, 2, 87, 87, 119, 119, 4, 2, 88, 88, 120, 120, 4, 2, 89, 89, 121, 121,
+	4, 2, 90, 90, 122, 122, 4, 2, 91, 91, 123, 123, 4, 2, 92, 92, 124, 124,
+	2, 1585, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 19, 3,
+	2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27,
+	3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2,
+	35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2,
+	2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2,
+	2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2,
+	2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3,
+	2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73,
+	3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2,
+	81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2,
+	2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2,
+	2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3,
+	2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2,
+	111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2,
+	2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125,
+	3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2,
+	2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3,
+	2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2,
+	147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2,
+	2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161,
+	3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2,
+	2, 169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3,
+	2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2,
+	183, 3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2,
+	2, 2, 2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 197,
+	3, 2, 2, 2, 2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2, 2, 203, 3, 2, 2, 2,
+	2, 205, 3, 2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3,
+	2, 2, 2, 2, 213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2,
+	219, 3, 2, 2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3, 2,
+	2, 2, 2, 227, 3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231, 3, 2, 2, 2, 2, 233,
+	3, 2, 2, 2, 2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2, 2, 239, 3, 2, 2, 2,
+	2, 241, 3, 2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3, 2, 2, 2, 2, 247, 3,
+	2, 2, 2, 2, 249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2, 253, 3, 2, 2, 2, 2,
+	255, 3, 2, 2, 2, 2, 257, 3, 2, 2, 2, 2, 259, 3, 2, 2, 2, 2, 261, 3, 2,
+	2, 2, 2, 263, 3, 2, 2, 2, 2, 265, 3, 2, 2, 2, 2, 267, 3, 2, 2, 2, 2, 269,
+	3, 2, 2, 2, 2, 271, 3, 2, 2, 2, 2, 273, 3, 2, 2, 2, 2, 275, 3, 2, 2, 2,
+	2, 277, 3, 2, 2, 2, 2, 279, 3, 2, 2, 2, 2, 281, 3, 2, 2, 2, 2, 283, 3,
+	2, 2, 2, 2, 285, 3, 2, 2, 2, 2, 287, 3, 2, 2, 2, 2, 289, 3, 2, 2, 2, 2,
+	291, 3, 2, 2, 2, 2, 293, 3, 2, 2, 2, 2, 295, 3, 2, 2, 2, 2, 297, 3, 2,
+	2, 2, 2, 299, 3, 2, 2, 2, 2, 301, 3, 2, 2, 2, 2, 303, 3, 2, 2, 2, 2, 305,
+	3, 2, 2, 2, 2, 307, 3, 2, 2, 2, 2, 309, 3, 2, 2, 2, 2, 311, 3, 2, 2, 2,
+	2, 313, 3, 2, 2, 2, 2, 315, 3, 2, 2, 2, 2, 317, 3, 2, 2, 2, 2, 319, 3,
+	2, 2, 2, 2, 321, 3, 2, 2, 2, 2, 323, 3, 2, 2, 2, 2, 325, 3, 2, 2, 2, 2,
+	327, 3, 2, 2, 2, 2, 329, 3, 2, 2, 2, 2, 331, 3, 2, 2, 2, 2, 333, 3, 2,
+	2, 2, 2, 335, 3, 2, 2, 2, 2, 337, 3, 2, 2, 2, 2, 339, 3, 2, 2, 2, 2, 341,
+	3, 2, 2, 2, 2, 343, 3, 2, 2, 2, 2, 345, 3, 2, 2, 2, 2, 347, 3, 2, 2, 2,
+	2, 349, 3, 2, 2, 2, 2, 351, 3, 2, 2, 2, 3, 411, 3, 2, 2, 2, 5, 416, 3,
+	2, 2, 2, 7, 422, 3, 2, 2, 2, 9, 432, 3, 2, 2, 2, 11, 437, 3, 2, 2, 2, 13,
+	443, 3, 2, 2, 2, 15, 445, 3, 2, 2, 2, 17, 447, 3, 2, 2, 2, 19, 454, 3,
+	2, 2, 2, 21, 460, 3, 2, 2, 2, 23, 467, 3, 2, 2, 2, 25, 474, 3, 2, 2, 2,
+	27, 478, 3, 2, 2, 2, 29, 483, 3, 2, 2, 2, 31, 492, 3, 2, 2, 2, 33, 497,
+	3, 2, 2, 2, 35, 503, 3, 2, 2, 2, 37, 515, 3, 2, 2, 2, 39, 519, 3, 2, 2,
+	2, 41, 527, 3, 2, 2, 2, 43, 535, 3, 2, 2, 2, 45, 545, 3, 2, 2, 2, 47, 550,
+	3, 2, 2, 2, 49, 553, 3, 2, 2, 2, 51, 558, 3, 2, 2, 2, 53, 562, 3, 2, 2,
+	2, 55, 573, 3, 2, 2, 2, 57, 587, 3, 2, 2, 2, 59, 594, 3, 2, 2, 2, 61, 603,
+	3, 2, 2, 2, 63, 609, 3, 2, 2, 2, 65, 614, 3, 2, 2, 2, 67, 623, 3, 2, 2,
+	2, 69, 631, 3, 2, 2, 2, 71, 638, 3, 2, 2, 2, 73, 644, 3, 2, 2, 2, 75, 652,
+	3, 2, 2, 2, 77, 657, 3, 2, 2, 2, 79, 663, 3, 2, 2, 2, 81, 668, 3, 2, 2,
+	2, 83, 674, 3, 2, 2, 2, 85, 681, 3, 2, 2, 2, 87, 693, 3, 2, 2, 2, 89, 702,
+	3, 2, 2, 2, 91, 708, 3, 2, 2, 2, 93, 715, 3, 2, 2, 2, 95, 718, 3, 2, 2,
+	2, 97, 723, 3, 2, 2, 2, 99, 729, 3, 2, 2, 2, 101, 735, 3, 2, 2, 2, 103,
+	742, 3, 2, 2, 2, 105, 748, 3, 2, 2, 2, 107, 755, 3, 2, 2, 2, 109, 764,
+	3, 2, 2, 2, 111, 774, 3, 2, 2, 2, 113, 784, 3, 2, 2, 2, 115, 795, 3, 2,
+	2, 2, 117, 800, 3, 2, 2, 2, 119, 808, 3, 2, 2, 2, 121, 815, 3, 2, 2, 2,
+	123, 821, 3, 2, 2, 2, 125, 828, 3, 2, 2, 2, 127, 832, 3, 2, 2, 2, 129,
+	837, 3, 2, 2, 2, 131, 842, 3, 2, 2, 2, 133, 846, 3, 2, 2, 2, 135, 851,
+	3, 2, 2, 2, 137, 858, 3, 2, 2, 2, 139, 864, 3, 2, 2, 2, 141, 869, 3, 2,
+	2, 2, 143, 875, 3, 2, 2, 2, 145, 881, 3, 2, 2, 2, 147, 889, 3, 2, 2, 2,
+	149, 895, 3, 2, 2, 2, 151, 903, 3, 2, 2, 2, 153, 913, 3, 2, 2, 2, 155,
+	920, 3, 2, 2, 2, 157, 923, 3, 2, 2, 2, 159, 927, 3, 2, 2, 2, 161, 930,
+	3, 2, 2, 2, 163, 935, 3, 2, 2, 2, 165, 940, 3, 2, 2, 2, 167, 949, 3, 2,
+	2, 2, 169, 955, 3, 2, 2, 2, 171, 959, 3, 2, 2, 2, 173, 964, 3, 2, 2, 2,
+	175, 969, 3, 2, 2, 2, 177, 973, 3, 2, 2, 2, 179, 981, 3, 2, 2, 2, 181,
+	984, 3, 2, 2, 2, 183, 990, 3, 2, 2, 2, 185, 997, 3, 2, 2, 2, 187, 1000,
+	3, 2, 2, 2, 189, 1004, 3, 2, 2, 2, 191, 1010, 3, 2, 2, 2, 193, 1015, 3,
+	2, 2, 2, 195, 1019, 3, 2, 2, 2, 197, 1022, 3, 2, 2, 2, 199, 1026, 3, 2,
+	2, 2, 201, 1034, 3, 2, 2, 2, 203, 1038, 3, 2, 2, 2, 205, 1042, 3, 2, 2,
+	2, 207, 1046, 3, 2, 2, 2, 209, 1052, 3, 2, 2, 2, 211, 1056, 3, 2, 2, 2,
+	213, 1063, 3, 2, 2, 2, 215, 1072, 3, 2, 2, 2, 217, 1077, 3, 2, 2, 2, 219,
+	1086, 3, 2, 2, 2, 221, 1098, 3, 2, 2, 2, 223, 1109, 3, 2, 2, 2, 225, 1116,
+	3, 2, 2, 2, 227, 1122, 3, 2, 2, 2, 229, 1131, 3, 2, 2, 2, 231, 1142, 3,
+	2, 2, 2, 233, 1148, 3, 2, 2, 2, 235, 1159, 3, 2, 2, 2, 237, 1170, 3, 2,
+	2, 2, 239, 1185, 3, 2, 2, 2, 241, 1190, 3, 2, 2, 2, 243, 1201, 3, 2, 2,
+	2, 245, 1206, 3, 2, 2, 2, 247, 1210, 3, 2, 2, 2, 249, 1215, 3, 2, 2, 2,
+	251, 1221, 3, 2, 2, 2, 253, 1227, 3, 2, 2, 2, 255, 1231, 3, 2, 2, 2, 257,
+	1236, 3, 2, 2, 2, 259, 1246, 3, 2, 2, 2, 261, 1256, 3, 2, 2, 2, 263, 1259,
+	3, 2, 2, 2, 265, 1262, 3, 2, 2, 2, 267, 1276, 3, 2, 2, 2, 269, 1290, 3,
+	2, 2, 2, 271, 1300, 3, 2, 2, 2, 273, 1316, 3, 2, 2, 2, 275, 1330, 3, 2,
+	2, 2, 277, 1344, 3, 2, 2, 2, 279, 1363, 3, 2, 2, 2, 281, 1371, 3, 2, 2,
+	2, 283, 1376, 3, 2, 2, 2, 285, 1391, 3, 2, 2, 2, 287, 1393, 3, 2, 2, 2,
+	289, 1395, 3, 2, 2, 2, 291, 1397, 3, 2, 2, 2, 293, 1399, 3, 2, 2, 2, 295,
+	1401, 3, 2, 2, 2, 297, 1403, 3, 2, 2, 2, 299, 1405, 3, 2, 2, 2, 301, 1407,
+	3, 2, 2, 2, 303, 1409, 3, 2, 2, 2, 305, 1411, 3, 2, 2, 2, 307, 1414, 3,
+	2, 2, 2, 309, 1417, 3, 2, 2, 2, 311, 1419, 3, 2, 2, 2, 313, 1422, 3, 2,
+	2, 2, 315, 1424, 3, 2, 2, 2, 317, 1427, 3, 2, 2, 2, 319, 1430, 3, 2, 2,
+	2, 321, 1433, 3, 2, 2, 2, 323, 1435, 3, 2, 2, 2, 325, 1437, 3, 2, 2, 2,
+	327, 1439, 3, 2, 2, 2, 329, 1441, 3, 2, 2, 2, 331, 1443, 3, 2, 2, 2, 333,
+	1445, 3, 2, 2, 2, 335, 1447, 3, 2, 2, 2, 337, 1449, 3, 2, 2, 2, 339, 1451,
+	3, 2, 2, 2, 341, 1453, 3, 2, 2, 2, 343, 1455, 3, 2, 2, 2, 345, 1457, 3,
+	2, 2, 2, 347, 1459, 3, 2, 2, 2, 349, 1462, 3, 2, 2, 2, 351, 1485, 3, 2,
+	2, 2, 353, 1487, 3, 2, 2, 2, 355, 1489, 3, 2, 2, 2, 357, 1541, 3, 2, 2,
+	2, 359, 1543, 3, 2, 2, 2, 361, 1545, 3, 2, 2, 2, 363, 1547, 3, 2, 2, 2,
+	365, 1549, 3, 2, 2, 2, 367, 1551, 3, 2, 2, 2, 369, 1553, 3, 2, 2, 2, 371,
+	1555, 3, 2, 2, 2, 373, 1557, 3, 2, 2, 2, 375, 1559, 3, 2, 2, 2, 377, 1561,
+	3, 2, 2, 2, 379, 1563, 3, 2, 2, 2, 381, 1565, 3, 2, 2, 2, 383, 1567, 3,
+	2, 2, 2, 385, 1569, 3, 2, 2, 2, 387, 1571, 3, 2, 2, 2, 389, 1573, 3, 2,
+	2, 2, 391, 1575, 3, 2, 2, 2, 393, 1577, 3, 2, 2, 2, 395, 1579, 3, 2, 2,
+	2, 397, 1581, 3, 2, 2, 2, 399, 1583, 3, 2, 2, 2, 401, 1585, 3, 2, 2, 2,
+	403, 1587, 3, 2, 2, 2, 405, 1589, 3, 2, 2, 2, 407, 1591, 3, 2, 2, 2, 409,
+	1593, 3, 2, 2, 2, 411, 412, 7, 118, 2, 2, 412, 413, 7, 116, 2, 2, 413,
+	414, 7, 119, 2, 2, 414, 415, 7, 103, 2, 2, 415, 4, 3, 2, 2, 2, 416, 417,
+	7, 104, 2, 2, 417, 418, 7, 99, 2, 2, 418, 419, 7, 110, 2, 2, 419, 420,
+	7, 117, 2, 2, 420, 421, 7, 103, 2, 2, 421, 6, 3, 2, 2, 2, 422, 427, 7,
+	36, 2, 2, 423, 426, 5, 9, 5, 2, 424, 426, 5, 15, 8, 2, 425, 423, 3, 2,
+	2, 2, 425, 424, 3, 2, 2, 2, 426, 429, 3, 2, 2, 2, 427, 425, 3, 2, 2, 2,
+	427, 428, 3, 2, 2, 2, 428, 430, 3, 2, 2, 2, 429, 427, 3, 2, 2, 2, 430,
+	431, 7, 36, 2, 2, 431, 8, 3, 2, 2, 2, 432, 435, 7, 94, 2, 2, 433, 436,
+	9, 2, 2, 2, 434, 436, 5, 11, 6, 2, 435, 433, 3, 2, 2, 2, 435, 434, 3, 2,
+	2, 2, 436, 10, 3, 2, 2, 2, 437, 438, 7, 119, 2, 2, 438, 439, 5, 13, 7,
+	2, 439, 440, 5, 13, 7, 2, 440, 441, 5, 13, 7, 2, 441, 442, 5, 13, 7, 2,
+	442, 12, 3, 2, 2, 2, 443, 444, 9, 3, 2, 2, 444, 14, 3, 2, 2, 2, 445, 446,
+	10, 4, 2, 2, 446, 16, 3, 2, 2, 2, 447, 449, 9, 5, 2, 2, 448, 450, 9, 6,
+	2, 2, 449, 448, 3, 2, 2, 2, 449, 450, 3, 2, 2, 2, 450, 451, 3, 2, 2, 2,
+	451, 452, 5, 349, 175, 2, 452, 18, 3, 2, 2, 2, 453, 455, 9, 7, 2, 2, 454,
+	453, 3, 2, 2, 2, 455, 456, 3, 2, 2, 2, 456, 454, 3, 2, 2, 2, 456, 457,
+	3, 2, 2, 2, 457, 458, 3, 2, 2, 2, 458, 459, 8, 10, 2, 2, 459, 20, 3, 2,
+	2, 2, 460, 461, 5, 363, 182, 2, 461, 462, 5, 393, 197, 2, 462, 463, 5,
+	367, 184, 2, 463, 464, 5, 359, 180, 2, 464, 465, 5, 397, 199, 2, 465, 466,
+	5, 367, 184, 2, 466, 22, 3, 2, 2, 2, 467, 468, 5, 399, 200, 2, 468, 469,
+	5, 389, 195, 2, 469, 470, 5, 365, 183, 2, 470, 471, 5, 359, 180, 2, 471,
+	472, 5, 397, 199, 2, 472, 473, 5, 367, 184, 2, 473, 24, 3, 2, 2, 2, 474,
+	475, 5, 395, 198, 2, 475, 476, 5, 367, 184, 2, 476, 477, 5, 397, 199, 2,
+	477, 26, 3, 2, 2, 2, 478, 479, 5, 365, 183, 2, 479, 480, 5, 393, 197, 2,
+	480, 481, 5, 387, 194, 2, 481, 482, 5, 389, 195, 2, 482, 28, 3, 2, 2, 2,
+	483, 484, 5, 375, 188, 2, 484, 485, 5, 385, 193, 2, 485, 486, 5, 397, 199,
+	2, 486, 487, 5, 367, 184, 2, 487, 488, 5, 393, 197, 2, 488, 489, 5, 401,
+	201, 2, 489, 490, 5, 359, 180, 2, 490, 491, 5, 381, 191, 2, 491, 30, 3,
+	2, 2, 2, 492, 493, 5, 385, 193, 2, 493, 494, 5, 359, 180, 2, 494, 495,
+	5, 383, 192, 2, 495, 496, 5, 367, 184, 2, 496, 32, 3, 2, 2, 2, 497, 498,
+	5, 395, 198, 2, 498, 499, 5, 373, 187, 2, 499, 500, 5, 359, 180, 2, 500,
+	501, 5, 393, 197, 2, 501, 502, 5, 365, 183, 2, 502, 34, 3, 2, 2, 2, 503,
+	504, 5, 393, 197, 2, 504, 505, 5, 367, 184, 2, 505, 506, 5, 389, 195, 2,
+	506, 507, 5, 381, 191, 2, 507, 508, 5, 375, 188, 2, 508, 509, 5, 363, 182,
+	2, 509, 510, 5, 359, 180, 2, 510, 511, 5, 397, 199, 2, 511, 512, 5, 375,
+	188, 2, 512, 513, 5, 387, 194, 2, 513, 514, 5, 385, 193, 2, 514, 36, 3,
+	2, 2, 2, 515, 516, 5, 397, 199, 2, 516, 517, 5, 397, 199, 2, 517, 518,
+	5, 381, 191, 2, 518, 38, 3, 2, 2, 2, 519, 520, 5, 383, 192, 2, 520, 521,
+	5, 367, 184, 2, 521, 522, 5, 397, 199, 2, 522, 523, 5, 359, 180, 2, 523,
+	524, 5, 397, 199, 2, 524, 525, 5, 397, 199, 2, 525, 526, 5, 381, 191, 2,
+	526, 40, 3, 2, 2, 2, 527, 528, 5, 389, 195, 2, 528, 529, 5, 359, 180, 2,
+	529, 530, 5, 395, 198, 2, 530, 531, 5, 397, 199, 2, 531, 532, 5, 397, 199,
+	2, 532, 533, 5, 397, 199, 2, 533, 534, 5, 381, 191, 2, 534, 42, 3, 2, 2,
+	2, 535, 536, 5, 369, 185, 2, 536, 537, 5, 399, 200, 2, 537, 538, 5, 397,
+	199, 2, 538, 539, 5, 399, 200, 2, 539, 540, 5, 393, 197, 2, 540, 541, 5,
+	367, 184, 2, 541, 542, 5, 397, 199, 2, 542, 543, 5, 397, 199, 2, 543, 544,
+	5, 381, 191, 2, 544, 44, 3, 2, 2, 2, 545, 546, 5, 379, 190, 2, 546, 547,
+	5, 375, 188, 2, 547, 548, 5, 381, 191, 2, 548, 549, 5, 381, 191, 2, 549,
+	46, 3, 2, 2, 2, 550, 551, 5, 387, 194, 2, 551, 552, 5, 385, 193, 2, 552,
+	48, 3, 2, 2, 2, 553, 554, 5, 395, 198, 2, 554, 555, 5, 373, 187, 2, 555,
+	556, 5, 387, 194, 2, 556, 557, 5, 403, 202, 2, 557, 50, 3, 2, 2, 2, 558,
+	559, 5, 399, 200, 2, 559, 560, 5, 395, 198, 2, 560, 561, 5, 367, 184, 2,
+	561, 52, 3, 2, 2, 2, 562, 563, 5, 395, 198, 2, 563, 564, 5, 397, 199, 2,
+	564, 565, 5, 359, 180, 2, 565, 566, 5, 397, 199, 2, 566, 567, 5, 367, 184,
+	2, 567, 568, 5, 345, 173, 2, 568, 569, 5, 393, 197, 2, 569, 570, 5, 367,
+	184, 2, 570, 571, 5, 389, 195, 2, 571, 572, 5, 387, 194, 2, 572, 54, 3,
+	2, 2, 2, 573, 574, 5, 395, 198, 2, 574, 575, 5, 397, 199, 2, 575, 576,
+	5, 359, 180, 2, 576, 577, 5, 397, 199, 2, 577, 578, 5, 367, 184, 2, 578,
+	579, 5, 345, 173, 2, 579, 580, 5, 383, 192, 2, 580, 581, 5, 359, 180, 2,
+	581, 582, 5, 363, 182, 2, 582, 583, 5, 373, 187, 2, 583, 584, 5, 375, 188,
+	2, 584, 585, 5, 385, 193, 2, 585, 586, 5, 367, 184, 2, 586, 56, 3, 2, 2,
+	2, 587, 588, 5, 383, 192, 2, 588, 589, 5, 359, 180, 2, 589, 590, 5, 395,
+	198, 2, 590, 591, 5, 397, 199, 2, 591, 592, 5, 367, 184, 2, 592, 593, 5,
+	393, 197, 2, 593, 58, 3, 2, 2, 2, 594, 595, 5, 383, 192, 2, 595, 596, 5,
+	367, 184, 2, 596, 597, 5, 397, 199, 2, 597, 598, 5, 359, 180, 2, 598, 599,
+	5, 365, 183, 2, 599, 600, 5, 359, 180, 2, 600, 601, 5, 397, 199, 2, 601,
+	602, 5, 359, 180, 2, 602, 60, 3, 2, 2, 2, 603, 604, 5, 397, 199, 2, 604,
+	605, 5, 407, 204, 2, 605, 606, 5, 389, 195, 2, 606, 607, 5, 367, 184, 2,
+	607, 608, 5, 395, 198, 2, 608, 62, 3, 2, 2, 2, 609, 610, 5, 397, 199, 2,
+	610, 611, 5, 407, 204, 2, 611, 612, 5, 389, 195, 2, 612, 613, 5, 367, 184,
+	2, 613, 64, 3, 2, 2, 2, 614, 615, 5, 395, 198, 2, 615, 616, 5, 397, 199,
+	2, 616, 617, 5, 387, 194, 2, 617, 618, 5, 393, 197, 2, 618, 619, 5, 359,
+	180, 2, 619, 620, 5, 371, 186, 2, 620, 621, 5, 367, 184, 2, 621, 622, 5,
+	395, 198, 2, 622, 66, 3, 2, 2, 2, 623, 624, 5, 395, 198, 2, 624, 625, 5,
+	397, 199, 2, 625, 626, 5, 387, 194, 2, 626, 627, 5, 393, 197, 2, 627, 628,
+	5, 359, 180, 2, 628, 629, 5, 371, 186, 2, 629, 630, 5, 367, 184, 2, 630,
+	68, 3, 2, 2, 2, 631, 632, 5, 361, 181, 2, 632, 633, 5, 393, 197, 2, 633,
+	634, 5, 387, 194, 2, 634, 635, 5, 379, 190, 2, 635, 636, 5, 367, 184, 2,
+	636, 637, 5, 393, 197, 2, 637, 70, 3, 2, 2, 2, 638, 639, 5, 359, 180, 2,
+	639, 640, 5, 381, 191, 2, 640, 641, 5, 375, 188, 2, 641, 642, 5, 401, 201,
+	2, 642, 643, 5, 367, 184, 2, 643, 72, 3, 2, 2, 2, 644, 645, 5, 395, 198,
+	2, 645, 646, 5, 363, 182, 2, 646, 647, 5, 373, 187, 2, 647, 648, 5, 367,
+	184, 2, 648, 649, 5, 383, 192, 2, 649, 650, 5, 359, 180, 2, 650, 651, 5,
+	395, 198, 2, 651, 74, 3, 2, 2, 2, 652, 653, 5, 399, 200, 2, 653, 654, 5,
+	395, 198, 2, 654, 655, 5, 367, 184, 2, 655, 656, 5, 393, 197, 2, 656, 76,
+	3, 2, 2, 2, 657, 658, 5, 399, 200, 2, 658, 659, 5, 395, 198, 2, 659, 660,
+	5, 367, 184, 2, 660, 661, 5, 393, 197, 2, 661, 662, 5, 395, 198, 2, 662,
+	78, 3, 2, 2, 2, 663, 664, 5, 393, 197, 2, 664, 665, 5, 387, 194, 2, 665,
+	666, 5, 381, 191, 2, 666, 667, 5, 367, 184, 2, 667, 80, 3, 2, 2, 2, 668,
+	669, 5, 393, 197, 2, 669, 670, 5, 387, 194, 2, 670, 671, 5, 381, 191, 2,
+	671, 672, 5, 367, 184, 2, 672, 673, 5, 395, 198, 2, 673, 82, 3, 2, 2, 2,
+	674, 675, 5, 381, 191, 2, 675, 676, 5, 375, 188, 2, 676, 677, 5, 383, 192,
+	2, 677, 678, 5, 375, 188, 2, 678, 679, 5, 397, 199, 2, 679, 680, 5, 395,
+	198, 2, 680, 84, 3, 2, 2, 2, 681, 682, 5, 363, 182, 2, 682, 683, 5, 359,
+	180, 2, 683, 684, 5, 393, 197, 2, 684, 685, 5, 365, 183, 2, 685, 686, 5,
+	375, 188, 2, 686, 687, 5, 385, 193, 2, 687, 688, 5, 359, 180, 2, 688, 689,
+	5, 381, 191, 2, 689, 690, 5, 375, 188, 2, 690, 691, 5, 397, 199, 2, 691,
+	692, 5, 407, 204, 2, 692, 86, 3, 2, 2, 2, 693, 694, 5, 389, 195, 2, 694,
+	695, 5, 359, 180, 2, 695, 696, 5, 395, 198, 2, 696, 697, 5, 395, 198, 2,
+	697, 698, 5, 403, 202, 2, 698, 699, 5, 387, 194, 2, 699, 700, 5, 393, 197,
+	2, 700, 701, 5, 365, 183, 2, 701, 88, 3, 2, 2, 2, 702, 703, 5, 371, 186,
+	2, 703, 704, 5, 393, 197, 2, 704, 705, 5, 359, 180, 2, 705, 706, 5, 385,
+	193, 2, 706, 707, 5, 397, 199, 2, 707, 90, 3, 2, 2, 2, 708, 709, 5, 393,
+	197, 2, 709, 710, 5, 367, 184, 2, 710, 711, 5, 401, 201, 2, 711, 712, 5,
+	387, 194, 2, 712, 713, 5, 379, 190, 2, 713, 714, 5, 367, 184, 2, 714, 92,
+	3, 2, 2, 2, 715, 716, 5, 397, 199, 2, 716, 717, 5, 387, 194, 2, 717, 94,
+	3, 2, 2, 2, 718, 719, 5, 393, 197, 2, 719, 720, 5, 367, 184, 2, 720, 721,
+	5, 359, 180, 2, 721, 722, 5, 365, 183, 2, 722, 96, 3, 2, 2, 2, 723, 724,
+	5, 403, 202, 2, 724, 725, 5, 393, 197, 2, 725, 726, 5, 375, 188, 2, 726,
+	727, 5, 397, 199, 2, 727, 728, 5, 367, 184, 2, 728, 98, 3, 2, 2, 2, 729,
+	730, 5, 359, 180, 2, 730, 731, 5, 365, 183, 2, 731, 732, 5, 383, 192, 2,
+	732, 733, 5, 375, 188, 2, 733, 734, 5, 385, 193, 2, 734, 100, 3, 2, 2,
+	2, 735, 736, 5, 365, 183, 2, 736, 737, 5, 367, 184, 2, 737, 738, 5, 381,
+	191, 2, 738, 739, 5, 367, 184, 2, 739, 740, 5, 397, 199, 2, 740, 741, 5,
+	367, 184, 2, 741, 102, 3, 2, 2, 2, 742, 743, 5, 359, 180, 2, 743, 744,
+	5, 381, 191, 2, 744, 745, 5, 397, 199, 2, 745, 746, 5, 367, 184, 2, 746,
+	747, 5, 393, 197, 2, 747, 104, 3, 2, 2, 2, 748, 749, 5, 393, 197, 2, 749,
+	750, 5, 367, 184, 2, 750, 751, 5, 385, 193, 2, 751, 752, 5, 359, 180, 2,
+	752, 753, 5, 383, 192, 2, 753, 754, 5, 367, 184, 2, 754, 106, 3, 2, 2,
+	2, 755, 756, 5, 365, 183, 2, 756, 757, 5, 359, 180, 2, 757, 758, 5, 397,
+	199, 2, 758, 759, 5, 359, 180, 2, 759, 760, 5, 361, 181, 2, 760, 761, 5,
+	359, 180, 2, 761, 762, 5, 395, 198, 2, 762, 763, 5, 367, 184, 2, 763, 108,
+	3, 2, 2, 2, 764, 765, 5, 365, 183, 2, 765, 766, 5, 359, 180, 2, 766, 767,
+	5, 397, 199, 2, 767, 768, 5, 359, 180, 2, 768, 769, 5, 361, 181, 2, 769,
+	770, 5, 359, 180, 2, 770, 771, 5, 395, 198, 2, 771, 772, 5, 367, 184, 2,
+	772, 773, 5, 395, 198, 2, 773, 110, 3, 2, 2, 2, 774, 775, 5, 385, 193,
+	2, 775, 776, 5, 359, 180, 2, 776, 777, 5, 383, 192, 2, 777, 778, 5, 367,
+	184, 2, 778, 779, 5, 395, 198, 2, 779, 780, 5, 389, 195, 2, 780, 781, 5,
+	359, 180, 2, 781, 782, 5, 363, 182, 2, 782, 783, 5, 367, 184, 2, 783, 112,
+	3, 2, 2, 2, 784, 785, 5, 385, 193, 2, 785, 786, 5, 359, 180, 2, 786, 787,
+	5, 383, 192, 2, 787, 788, 5, 367, 184, 2, 788, 789, 5, 395, 198, 2, 789,
+	790, 5, 389, 195, 2, 790, 791, 5, 359, 180, 2, 791, 792, 5, 363, 182, 2,
+	792, 793, 5, 367, 184, 2, 793, 794, 5, 395, 198, 2, 794, 114, 3, 2, 2,
+	2, 795, 796, 5, 385, 193, 2, 796, 797, 5, 387, 194, 2, 797, 798, 5, 365,
+	183, 2, 798, 799, 5, 367, 184, 2, 799, 116, 3, 2, 2, 2, 800, 801, 5, 383,
+	192, 2, 801, 802, 5, 367, 184, 2, 802, 803, 5, 397, 199, 2, 803, 804, 5,
+	393, 197, 2, 804, 805, 5, 375, 188, 2, 805, 806, 5, 363, 182, 2, 806, 807,
+	5, 395, 198, 2, 807, 118, 3, 2, 2, 2, 808, 809, 5, 383, 192, 2, 809, 810,
+	5, 367, 184, 2, 810, 811, 5, 397, 199, 2, 811, 812, 5, 393, 197, 2, 812,
+	813, 5, 375, 188, 2, 813, 814, 5, 363, 182, 2, 814, 120, 3, 2, 2, 2, 815,
+	816, 5, 369, 185, 2, 816, 817, 5, 375, 188, 2, 817, 818, 5, 367, 184, 2,
+	818, 819, 5, 381, 191, 2, 819, 820, 5, 365, 183, 2, 820, 122, 3, 2, 2,
+	2, 821, 822, 5, 369, 185, 2, 822, 823, 5, 375, 188, 2, 823, 824, 5, 367,
+	184, 2, 824, 825, 5, 381, 191, 2, 825, 826, 5, 365, 183, 2, 826, 827, 5,
+	395, 198, 2, 827, 124, 3, 2, 2, 2, 828, 829, 5, 397, 199, 2, 829, 830,
+	5, 359, 180, 2, 830, 831, 5, 371, 186, 2, 831, 126, 3, 2, 2, 2, 832, 833,
+	5, 375, 188, 2, 833, 834, 5, 385, 193, 2, 834, 835, 5, 369, 185, 2, 835,
+	836, 5, 387, 194, 2, 836, 128, 3, 2, 2, 2, 837, 838, 5, 379, 190, 2, 838,
+	839, 5, 367, 184, 2, 839, 840, 5, 407, 204, 2, 840, 841, 5, 395, 198, 2,
+	841, 130, 3, 2, 2, 2, 842, 843, 5, 379, 190, 2, 843, 844, 5, 367, 184,
+	2, 844, 845, 5, 407, 204, 2, 845, 132, 3, 2, 2, 2, 846, 847, 5, 403, 202,
+	2, 847, 848, 5, 375, 188, 2, 848, 849, 5, 397, 199, 2, 849, 850, 5, 373,
+	187, 2, 850, 134, 3, 2, 2, 2, 851, 852, 5, 401, 201, 2, 852, 853, 5, 359,
+	180, 2, 853, 854, 5, 381, 191, 2, 854, 855, 5, 399, 200, 2, 855, 856, 5,
+	367, 184, 2, 856, 857, 5, 395, 198, 2, 857, 136, 3, 2, 2, 2, 858, 859,
+	5, 401, 201, 2, 859, 860, 5, 359, 180, 2, 860, 861, 5, 381, 191, 2, 861,
+	862, 5, 399, 200, 2, 862, 863, 5, 367, 184, 2, 863, 138, 3, 2, 2, 2, 864,
+	865, 5, 369, 185, 2, 865, 866, 5, 393, 197, 2, 866, 867, 5, 387, 194, 2,
+	867, 868, 5, 383, 192, 2, 868, 140, 3, 2, 2, 2, 869, 870, 5, 403, 202,
+	2, 870, 871, 5, 373, 187, 2, 871, 872, 5, 367, 184, 2, 872, 873, 5, 393,
+	197, 2, 873, 874, 5, 367, 184, 2, 874, 142, 3, 2, 2, 2, 875, 876, 5, 381,
+	191, 2, 876, 877, 5, 375, 188, 2, 877, 878, 5, 383, 192, 2, 878, 879, 5,
+	375, 188, 2, 879, 880, 5, 397, 199, 2, 880, 144, 3, 2, 2, 2, 881, 882,
+	5, 391, 196, 2, 882, 883, 5, 399, 200, 2, 883, 884, 5, 367, 184, 2, 884,
+	885, 5, 393, 197, 2, 885, 886, 5, 375, 188, 2, 886, 887, 5, 367, 184, 2,
+	887, 888, 5, 395, 198, 2, 888, 146, 3, 2, 2, 2, 889, 890, 5, 391, 196,
+	2, 890, 891, 5, 399, 200, 2, 891, 892, 5, 367, 184, 2, 892, 893, 5, 393,
+	197, 2, 893, 894, 5, 407, 204, 2, 894, 148, 3, 2, 2, 2, 895, 896, 5, 367,
+	184, 2, 896, 897, 5, 405, 203, 2, 897, 898, 5, 389, 195, 2, 898, 899, 5,
+	381, 191, 2, 899, 900, 5, 359, 180, 2, 900, 901, 5, 375, 188, 2, 901, 902,
+	5, 385, 193, 2, 902, 150, 3, 2, 2, 2, 903, 904, 5, 403, 202, 2, 904, 905,
+	5, 375, 188, 2, 905, 906, 5, 397, 199, 2, 906, 907, 5, 373, 187, 2, 907,
+	908, 5, 401, 201, 2, 908, 909, 5, 359, 180, 2, 909, 910, 5, 381, 191, 2,
+	910, 911, 5, 399, 200, 2, 911, 912, 5, 367, 184, 2, 912, 152, 3, 2, 2,
+	2, 913, 914, 5, 395, 198, 2, 914, 915, 5, 367, 184, 2, 915, 916, 5, 381,
+	191, 2, 916, 917, 5, 367, 184, 2, 917, 918, 5, 363, 182, 2, 918, 919, 5,
+	397, 199, 2, 919, 154, 3, 2, 2, 2, 920, 921, 5, 359, 180, 2, 921, 922,
+	5, 395, 198, 2, 922, 156, 3, 2, 2, 2, 923, 924, 5, 359, 180, 2, 924, 925,
+	5, 385, 193, 2, 925, 926, 5, 365, 183, 2, 926, 158, 3, 2, 2, 2, 927, 928,
+	5, 387, 194, 2, 928, 929, 5, 393, 197, 2, 929, 160, 3, 2, 2, 2, 930, 931,
+	5, 369, 185, 2, 931, 932, 5, 375, 188, 2, 932, 933, 5, 381, 191, 2, 933,
+	934, 5, 381, 191, 2, 934, 162, 3, 2, 2, 2, 935, 936, 5, 385, 193, 2, 936,
+	937, 5, 399, 200, 2, 937, 938, 5, 381, 191, 2, 938, 939, 5, 381, 191, 2,
+	939, 164, 3, 2, 2, 2, 940, 941, 5, 389, 195, 2, 941, 942, 5, 393, 197,
+	2, 942, 943, 5, 367, 184, 2, 943, 944, 5, 401, 201, 2, 944, 945, 5, 375,
+	188, 2, 945, 946, 5, 387, 194, 2, 946, 947, 5, 399, 200, 2, 947, 948, 5,
+	395, 198, 2, 948, 166, 3, 2, 2, 2, 949, 950, 5, 387, 194, 2, 950, 951,
+	5, 393, 197, 2, 951, 952, 5, 365, 183, 2, 952, 953, 5, 367, 184, 2, 953,
+	954, 5, 393, 197, 2, 954, 168, 3, 2, 2, 2, 955, 956, 5, 359, 180, 2, 956,
+	957, 5, 395, 198, 2, 957, 958, 5, 363, 182, 2, 958, 170, 3, 2, 2, 2, 959,
+	960, 5, 365, 183, 2, 960, 961, 5, 367, 184, 2, 961, 962, 5, 395, 198, 2,
+	962, 963, 5, 363, 182, 2, 963, 172, 3, 2, 2, 2, 964, 965, 5, 381, 191,
+	2, 965, 966, 5, 375, 188, 2, 966, 967, 5, 379, 190, 2, 967, 968, 5, 367,
+	184, 2, 968, 174, 3, 2, 2, 2, 969, 970, 5, 385, 193, 2, 970, 971, 5, 387,
+	194, 2, 971, 972, 5, 397, 199, 2, 972, 176, 3, 2, 2, 2, 973, 974, 5, 361,
+	181, 2, 974, 975, 5, 367, 184, 2, 975, 976, 5, 397, 199, 2, 976, 977, 5,
+	403, 202, 2, 977, 978, 5, 367, 184, 2, 978, 979, 5, 367, 184, 2, 979, 980,
+	5, 385, 193, 2, 980, 178, 3, 2, 2, 2, 981, 982, 5, 375, 188, 2, 982, 983,
+	5, 395, 198, 2, 983, 180, 3, 2, 2, 2, 984, 985, 5, 371, 186, 2, 985, 986,
+	5, 393, 197, 2, 986, 987, 5, 387, 194, 2, 987, 988, 5, 399, 200, 2, 988,
+	989, 5, 389, 195, 2, 989, 182, 3, 2, 2, 2, 990, 991, 5, 373, 187, 2, 991,
+	992, 5, 359, 180, 2, 992, 993, 5, 401, 201, 2, 993, 994, 5, 375, 188, 2,
+	994, 995, 5, 385, 193, 2, 995, 996, 5, 371, 186, 2, 996, 184, 3, 2, 2,
+	2, 997, 998, 5, 361, 181, 2, 998, 999, 5, 407, 204, 2, 999, 186, 3, 2,
+	2, 2, 1000, 1001, 5, 369, 185, 2, 1001, 1002, 5, 387, 194, 2, 1002, 1003,
+	5, 393, 197, 2, 1003, 188, 3, 2, 2, 2, 1004, 1005, 5, 395, 198, 2, 1005,
+	1006, 5, 397, 199, 2, 1006, 1007, 5, 359, 180, 2, 1007, 1008, 5, 397, 199,
+	2, 1008, 1009, 5, 395, 198, 2, 1009, 190, 3, 2, 2, 2, 1010, 1011, 5, 397,
+	199, 2, 1011, 1012, 5, 375, 188, 2, 1012, 1013, 5, 383, 192, 2, 1013, 1014,
+	5, 367, 184, 2, 1014, 192, 3, 2, 2, 2, 1015, 1016, 5, 385, 193, 2, 1016,
+	1017, 5, 387, 194, 2, 1017, 1018, 5, 403, 202, 2, 1018, 194, 3, 2, 2, 2,
+	1019, 1020, 5, 375, 188, 2, 1020, 1021, 5, 385, 193, 2, 1021, 196, 3, 2,
+	2, 2, 1022, 1023, 5, 381, 191, 2, 1023, 1024, 5, 387, 194, 2, 1024, 1025,
+	5, 371, 186, 2, 1025, 198, 3, 2, 2, 2, 1026, 1027, 5, 389, 195, 2, 1027,
+	1028, 5, 393, 197, 2, 1028, 1029, 5, 387, 194, 2, 1029, 1030, 5, 369, 185,
+	2, 1030, 1031, 5, 375, 188, 2, 1031, 1032, 5, 381, 191, 2, 1032, 1033,
+	5, 367, 184, 2, 1033, 200, 3, 2, 2, 2, 1034, 1035, 5, 395, 198, 2, 1035,
+	1036, 5, 399, 200, 2, 1036, 1037, 5, 383, 192, 2, 1037, 202, 3, 2, 2, 2,
+	1038, 1039, 5, 383, 192, 2, 1039, 1040, 5, 375, 188, 2, 1040, 1041, 5,
+	385, 193, 2, 1041, 204, 3, 2, 2, 2, 1042, 1043, 5, 383, 192, 2, 1043, 1044,
+	5, 359, 180, 2, 1044, 1045, 5, 405, 203, 2, 1045, 206, 3, 2, 2, 2, 1046,
+	1047, 5, 363, 182, 2, 1047, 1048, 5, 387, 194, 2, 1048, 1049, 5, 399, 200,
+	2, 1049, 1050, 5, 385, 193, 2, 1050, 1051, 5, 397, 199, 2, 1051, 208, 3,
+	2, 2, 2, 1052, 1053, 5, 359, 180, 2, 1053, 1054, 5, 401, 201, 2, 1054,
+	1055, 5, 371, 186, 2, 1055, 210, 3, 2, 2, 2, 1056, 1057, 5, 395, 198, 2,
+	1057, 1058, 5, 397, 199, 2, 1058, 1059, 5, 365, 183, 2, 1059, 1060, 5,
+	365, 183, 2, 1060, 1061, 5, 367, 184, 2, 1061, 1062, 5, 401, 201, 2, 1062,
+	212, 3, 2, 2, 2, 1063, 1064, 5, 391, 196, 2, 1064, 1065, 5, 399, 200, 2,
+	1065, 1066, 5, 359, 180, 2, 1066, 1067, 5, 385, 193, 2, 1067, 1068, 5,
+	397, 199, 2, 1068, 1069, 5, 375, 188, 2, 1069, 1070, 5, 381, 191, 2, 1070,
+	1071, 5, 367, 184, 2, 1071, 214, 3, 2, 2, 2, 1072, 1073, 5, 393, 197, 2,
+	1073, 1074, 5, 359, 180, 2, 1074, 1075, 5, 397, 199, 2, 1075, 1076, 5,
+	367, 184, 2, 1076, 216, 3, 2, 2, 2, 1077, 1078, 5, 401, 201, 2, 1078, 1079,
+	5, 359, 180, 2, 1079, 1080, 5, 393, 197, 2, 1080, 1081, 5, 375, 188, 2,
+	1081, 1082, 5, 359, 180, 2, 1082, 1083, 5, 385, 193, 2, 1083, 1084, 5,
+	363, 182, 2, 1084, 1085, 5, 367, 184, 2, 1085, 218, 3, 2, 2, 2, 1086, 1087,
+	5, 369, 185, 2, 1087, 1088, 5, 375, 188, 2, 1088, 1089, 5, 393, 197, 2,
+	1089, 1090, 5, 395, 198, 2, 1090, 1091, 5, 397, 199, 2, 1091, 1092, 5,
+	345, 173, 2, 1092, 1093, 5, 401, 201, 2, 1093, 1094, 5, 359, 180, 2, 1094,
+	1095, 5, 381, 191, 2, 1095, 1096, 5, 399, 200, 2, 1096, 1097, 5, 367, 184,
+	2, 1097, 220, 3, 2, 2, 2, 1098, 1099, 5, 381, 191, 2, 1099, 1100, 5, 359,
+	180, 2, 1100, 1101, 5, 395, 198, 2, 1101, 1102, 5, 397, 199, 2, 1102, 1103,
+	5, 345, 173, 2, 1103, 1104, 5, 401, 201, 2, 1104, 1105, 5, 359, 180, 2,
+	1105, 1106, 5, 381, 191, 2, 1106, 1107, 5, 399, 200, 2, 1107, 1108, 5,
+	367, 184, 2, 1108, 222, 3, 2, 2, 2, 1109, 1110, 5, 383, 192, 2, 1110, 1111,
+	5, 367, 184, 2, 1111, 1112, 5, 365, 183, 2, 1112, 1113, 5, 375, 188, 2,
+	1113, 1114, 5, 359, 180, 2, 1114, 1115, 5, 385, 193, 2, 1115, 224, 3, 2,
+	2, 2, 1116, 1117, 5, 375, 188, 2, 1117, 1118, 5, 393, 197, 2, 1118, 1119,
+	5, 359, 180, 2, 1119, 1120, 5, 397, 199, 2, 1120, 1121, 5, 367, 184, 2,
+	1121, 226, 3, 2, 2, 2, 1122, 1123, 5, 375, 188, 2, 1123, 1124, 5, 385,
+	193, 2, 1124, 1125, 5, 363, 182, 2, 1125, 1126, 5, 393, 197, 2, 1126, 1127,
+	5, 367, 184, 2, 1127, 1128, 5, 359, 180, 2, 1128, 1129, 5, 395, 198, 2,
+	1129, 1130, 5, 367, 184, 2, 1130, 228, 3, 2, 2, 2, 1131, 1132, 5, 365,
+	183, 2, 1132, 1133, 5, 367, 184, 2, 1133, 1134, 5, 393, 197, 2, 1134, 1135,
+	5, 375, 188, 2, 1135, 1136, 5, 401, 201, 2, 1136, 1137, 5, 359, 180, 2,
+	1137, 1138, 5, 397, 199, 2, 1138, 1139, 5, 375, 188, 2, 1139, 1140, 5,
+	401, 201, 2, 1140, 1141, 5, 367, 184, 2, 1141, 230, 3, 2, 2, 2, 1142, 1143,
+	5, 365, 183, 2, 1143, 1144, 5, 367, 184, 2, 1144, 1145, 5, 381, 191, 2,
+	1145, 1146, 5, 397, 199, 2, 1146, 1147, 5, 359, 180, 2, 1147, 232, 3, 2,
+	2, 2, 1148, 1149, 5, 383, 192, 2, 1149, 1150, 5, 387, 194, 2, 1150, 1151,
+	5, 401, 201, 2, 1151, 1152, 5, 375, 188, 2, 1152, 1153, 5, 385, 193, 2,
+	1153, 1154, 5, 371, 186, 2, 1154, 1155, 5, 345, 173, 2, 1155, 1156, 5,
+	359, 180, 2, 1156, 1157, 5, 401, 201, 2, 1157, 1158, 5, 371, 186, 2, 1158,
+	234, 3, 2, 2, 2, 1159, 1160, 5, 383, 192, 2, 1160, 1161, 5, 387, 194, 2,
+	1161, 1162, 5, 401, 201, 2, 1162, 1163, 5, 375, 188, 2, 1163, 1164, 5,
+	385, 193, 2, 1164, 1165, 5, 371, 186, 2, 1165, 1166, 5, 345, 173, 2, 1166,
+	1167, 5, 395, 198, 2, 1167, 1168, 5, 399, 200, 2, 1168, 1169, 5, 383, 192,
+	2, 1169, 236, 3, 2, 2, 2, 1170, 1171, 5, 363, 182, 2, 1171, 1172, 5, 399,
+	200, 2, 1172, 1173, 5, 383, 192, 2, 1173, 1174, 5, 399, 200, 2, 1174, 1175,
+	5, 381, 191, 2, 1175, 1176, 5, 359, 180, 2, 1176, 1177, 5, 397, 199, 2,
+	1177, 1178, 5, 375, 188, 2, 1178, 1179, 5, 401, 201, 2, 1179, 1180, 5,
+	367, 184, 2, 1180, 1181, 5, 345, 173, 2, 1181, 1182, 5, 395, 198, 2, 1182,
+	1183, 5, 399, 200, 2, 1183, 1184, 5, 383, 192, 2, 1184, 238, 3, 2, 2, 2,
+	1185, 1186, 5, 367, 184, 2, 1186, 1187, 5, 403, 202, 2, 1187, 1188, 5,
+	383, 192, 2, 1188, 1189, 5, 359, 180, 2, 1189, 240, 3, 2, 2, 2, 1190, 1191,
+	5, 397, 199, 2, 1191, 1192, 5, 375, 188, 2, 1192, 1193, 5, 383, 192, 2,
+	1193, 1194, 5, 367, 184, 2, 1194, 1195, 5, 345, 173, 2, 1195, 1196, 5,
+	395, 198, 2, 1196, 1197, 5, 373, 187, 2, 1197, 1198, 5, 375, 188, 2, 1198,
+	1199, 5, 369, 185, 2, 1199, 1200, 5, 397, 199, 2, 1200, 242, 3, 2, 2, 2,
+	1201, 1202, 5, 365, 183, 2, 1202, 1203, 5, 375, 188, 2, 1203, 1204, 5,
+	369, 185, 2, 1204, 1205, 5, 369, 185, 2, 1205, 244, 3, 2, 2, 2, 1206, 1207,
+	5, 359, 180, 2, 1207, 1208, 5, 361, 181, 2, 1208, 1209, 5, 395, 198, 2,
+	1209, 246, 3, 2, 2, 2, 1210, 1211, 5, 363, 182, 2, 1211, 1212, 5, 367,
+	184, 2, 1212, 1213, 5, 375, 188, 2, 1213, 1214, 5, 381, 191, 2, 1214, 248,
+	3, 2, 2, 2, 1215, 1216, 5, 369, 185, 2, 1216, 1217, 5, 381, 191, 2, 1217,
+	1218, 5, 387, 194, 2, 1218, 1219, 5, 387, 194, 2, 1219, 1220, 5, 393, 197,
+	2, 1220, 250, 3, 2, 2, 2, 1221, 1222, 5, 393, 197, 2, 1222, 1223, 5, 387,
+	194, 2, 1223, 1224, 5, 399, 200, 2, 1224, 1225, 5, 385, 193, 2, 1225, 1226,
+	5, 365, 183, 2, 1226, 252, 3, 2, 2, 2, 1227, 1228, 5, 389, 195, 2, 1228,
+	1229, 5, 387, 194, 2, 1229, 1230, 5, 403, 202, 2, 1230, 254, 3, 2, 2, 2,
+	1231, 1232, 5, 395, 198, 2, 1232, 1233, 5, 391, 196, 2, 1233, 1234, 5,
+	393, 197, 2, 1234, 1235, 5, 397, 199, 2, 1235, 256, 3, 2, 2, 2, 1236, 1237,
+	5, 363, 182, 2, 1237, 1238, 5, 381, 191, 2, 1238, 1239, 5, 359, 180, 2,
+	1239, 1240, 5, 383, 192, 2, 1240, 1241, 5, 389, 195, 2, 1241, 1242, 5,
+	345, 173, 2, 1242, 1243, 5, 383, 192, 2, 1243, 1244, 5, 375, 188, 2, 1244,
+	1245, 5, 385, 193, 2, 1245, 258, 3, 2, 2, 2, 1246, 1247, 5, 363, 182, 2,
+	1247, 1248, 5, 381, 191, 2, 1248, 1249, 5, 359, 180, 2, 1249, 1250, 5,
+	383, 192, 2, 1250, 1251, 5, 389, 195, 2, 1251, 1252, 5, 345, 173, 2, 1252,
+	1253, 5, 383, 192, 2, 1253, 1254, 5, 359, 180, 2, 1254, 1255, 5, 405, 203,
+	2, 1255, 260, 3, 2, 2, 2, 1256, 1257, 5, 375, 188, 2, 1257, 1258, 5, 369,
+	185, 2, 1258, 262, 3, 2, 2, 2, 1259, 1260, 5, 397, 199, 2, 1260, 1261,
+	5, 409, 205, 2, 1261, 264, 3, 2, 2, 2, 1262, 1263, 5, 393, 197, 2, 1263,
+	1264, 5, 367, 184, 2, 1264, 1265, 5, 371, 186, 2, 1265, 1266, 5, 367, 184,
+	2, 1266, 1267, 5, 405, 203, 2, 1267, 1268, 5, 345, 173, 2, 1268, 1269,
+	5, 367, 184, 2, 1269, 1270, 5, 405, 203, 2, 1270, 1271, 5, 397, 199, 2,
+	1271, 1272, 5, 393, 197, 2, 1272, 1273, 5, 359, 180, 2, 1273, 1274, 5,
+	363, 182, 2, 1274, 1275, 5, 397, 199, 2, 1275, 266, 3, 2, 2, 2, 1276, 1277,
+	5, 381, 191, 2, 1277, 1278, 5, 359, 180, 2, 1278, 1279, 5, 361, 181, 2,
+	1279, 1280, 5, 367, 184, 2, 1280, 1281, 5, 381, 191, 2, 1281, 1282, 5,
+	345, 173, 2, 1282, 1283, 5, 393, 197, 2, 1283, 1284, 5, 367, 184, 2, 1284,
+	1285, 5, 389, 195, 2, 1285, 1286, 5, 381, 191, 2, 1286, 1287, 5, 359, 180,
+	2, 1287, 1288, 5, 363, 182, 2, 1288, 1289, 5, 367, 184, 2, 1289, 268, 3,
+	2, 2, 2, 1290, 1291, 5, 367, 184, 2, 1291, 1292, 5, 405, 203, 2, 1292,
+	1293, 5, 367, 184, 2, 1293, 1294, 5, 383, 192, 2, 1294, 1295, 5, 389, 195,
+	2, 1295, 1296, 5, 381, 191, 2, 1296, 1297, 5, 359, 180, 2, 1297, 1298,
+	5, 393, 197, 2, 1298, 1299, 5, 395, 198, 2, 1299, 270, 3, 2, 2, 2, 1300,
+	1301, 5, 373, 187, 2, 1301, 1302, 5, 375, 188, 2, 1302, 1303, 5, 395, 198,
+	2, 1303, 1304, 5, 397, 199, 2, 1304, 1305, 5, 387, 194, 2, 1305, 1306,
+	5, 371, 186, 2, 1306, 1307, 5, 393, 197, 2, 1307, 1308, 5, 359, 180, 2,
+	1308, 1309, 5, 383, 192, 2, 1309, 1310, 5, 345, 173, 2, 1310, 1311, 5,
+	363, 182, 2, 1311, 1312, 5, 387, 194, 2, 1312, 1313, 5, 399, 200, 2, 1313,
+	1314, 5, 385, 193, 2, 1314, 1315, 5, 397, 199, 2, 1315, 272, 3, 2, 2, 2,
+	1316, 1317, 5, 373, 187, 2, 1317, 1318, 5, 375, 188, 2, 1318, 1319, 5,
+	395, 198, 2, 1319, 1320, 5, 397, 199, 2, 1320, 1321, 5, 387, 194, 2, 1321,
+	1322, 5, 371, 186, 2, 1322, 1323, 5, 393, 197, 2, 1323, 1324, 5, 359, 180,
+	2, 1324, 1325, 5, 383, 192, 2, 1325, 1326, 5, 345, 173, 2, 1326, 1327,
+	5, 395, 198, 2, 1327, 1328, 5, 399, 200, 2, 1328, 1329, 5, 383, 192, 2,
+	1329, 274, 3, 2, 2, 2, 1330, 1331, 5, 373, 187, 2, 1331, 1332, 5, 375,
+	188, 2, 1332, 1333, 5, 395, 198, 2, 1333, 1334, 5, 397, 199, 2, 1334, 1335,
+	5, 387, 194, 2, 1335, 1336, 5, 371, 186, 2, 1336, 1337, 5, 393, 197, 2,
+	1337, 1338, 5, 359, 180, 2, 1338, 1339, 5, 383, 192, 2, 1339, 1340, 5,
+	345, 173, 2, 1340, 1341, 5, 359, 180, 2, 1341, 1342, 5, 401, 201, 2, 1342,
+	1343, 5, 371, 186, 2, 1343, 276, 3, 2, 2, 2, 1344, 1345, 5, 373, 187, 2,
+	1345, 1346, 5, 375, 188, 2, 1346, 1347, 5, 395, 198, 2, 1347, 1348, 5,
+	397, 199, 2, 1348, 1349, 5, 387, 194, 2, 1349, 1350, 5, 371, 186, 2, 1350,
+	1351, 5, 393, 197, 2, 1351, 1352, 5, 359, 180, 2, 1352, 1353, 5, 383, 192,
+	2, 1353, 1354, 5, 345, 173, 2, 1354, 1355, 5, 369, 185, 2, 1355, 1356,
+	5, 393, 197, 2, 1356, 1357, 5, 359, 180, 2, 1357, 1358, 5, 363, 182, 2,
+	1358, 1359, 5, 397, 199, 2, 1359, 1360, 5, 375, 188, 2, 1360, 1361, 5,
+	387, 194, 2, 1361, 1362, 5, 385, 193, 2, 1362, 278, 3, 2, 2, 2, 1363, 1364,
+	5, 373, 187, 2, 1364, 1365, 5, 367, 184, 2, 1365, 1366, 5, 359, 180, 2,
+	1366, 1367, 5, 397, 199, 2, 1367, 1368, 5, 383, 192, 2, 1368, 1369, 5,
+	359, 180, 2, 1369, 1370, 5, 389, 195, 2, 1370, 280, 3, 2, 2, 2, 1371, 1372,
+	5, 381, 191, 2, 1372, 1373, 5, 359, 180, 2, 1373, 1374, 5, 395, 198, 2,
+	1374, 1375, 5, 397, 199, 2, 1375, 282, 3, 2, 2, 2, 1376, 1377, 5, 363,
+	182, 2, 1377, 1378, 5, 387, 194, 2, 1378, 1379, 5, 399, 200, 2, 1379, 1380,
+	5, 385, 193, 2, 1380, 1381, 5, 397, 199, 2, 1381, 1382, 5, 345, 173, 2,
+	1382, 1383, 5, 365, 183, 2, 1383, 1384, 5, 375, 188, 2, 1384, 1385, 5,
+	395, 198, 2, 1385, 1386, 5, 397, 199, 2, 1386, 1387, 5, 375, 188, 2, 1387,
+	1388, 5, 385, 193, 2, 1388, 1389, 5, 363, 182, 2, 1389, 1390, 5, 397, 199,
+	2, 1390, 284, 3, 2, 2, 2, 1391, 1392, 5, 395, 198, 2, 1392, 286, 3, 2,
+	2, 2, 1393, 1394, 7, 111, 2, 2, 1394, 288, 3, 2, 2, 2, 1395, 1396, 5, 373,
+	187, 2, 1396, 290, 3, 2, 2, 2, 1397, 1398, 5, 365, 183, 2, 1398, 292, 3,
+	2, 2, 2, 1399, 1400, 5, 403, 202, 2, 1400, 294, 3, 2, 2, 2, 1401, 1402,
+	7, 79, 2, 2, 1402, 296, 3, 2, 2, 2, 1403, 1404, 5, 407, 204, 2, 1404, 298,
+	3, 2, 2, 2, 1405, 1406, 7, 48, 2, 2, 1406, 300, 3, 2, 2, 2, 1407, 1408,
+	7, 60, 2, 2, 1408, 302, 3, 2, 2, 2, 1409, 1410, 7, 63, 2, 2, 1410, 304,
+	3, 2, 2, 2, 1411, 1412, 7, 62, 2, 2, 1412, 1413, 7, 64, 2, 2, 1413, 306,
+	3, 2, 2, 2, 1414, 1415, 7, 35, 2, 2, 1415, 1416, 7, 63, 2, 2, 1416, 308,
+	3, 2, 2, 2, 1417, 1418, 7, 64, 2, 2, 1418, 310, 3, 2, 2, 2, 1419, 1420,
+	7, 64, 2, 2, 1420, 1421, 7, 63, 2, 2, 1421, 312, 3, 2, 2, 2, 1422, 1423,
+	7, 62, 2, 2, 1423, 314, 3, 2, 2, 2, 1424, 1425, 7, 62, 2, 2, 1425, 1426,
+	7, 63, 2, 2, 1426, 316, 3, 2, 2, 2, 1427, 1428, 7, 63, 2, 2, 1428, 1429,
+	7, 128, 2, 2, 1429, 318, 3, 2, 2, 2, 1430, 1431, 7, 35, 2, 2, 1431, 1432,
+	7, 128, 2, 2, 1432, 320, 3, 2, 2, 2, 1433, 1434, 7, 46, 2, 2, 1434, 322,
+	3, 2, 2, 2, 1435, 1436, 7, 125, 2, 2, 1436, 324, 3, 2, 2, 2, 1437, 1438,
+	7, 127, 2, 2, 1438, 326, 3, 2, 2, 2, 1439, 1440, 7, 93, 2, 2, 1440, 328,
+	3, 2, 2, 2, 1441, 1442, 7, 95, 2, 2, 1442, 330, 3, 2, 2, 2, 1443, 1444,
+	7, 42, 2, 2, 1444, 332, 3, 2, 2, 2, 1445, 1446, 7, 43, 2, 2, 1446, 334,
+	3, 2, 2, 2, 1447, 1448, 7, 45, 2, 2, 1448, 336, 3, 2, 2, 2, 1449, 1450,
+	7, 47, 2, 2, 1450, 338, 3, 2, 2, 2, 1451, 1452, 7, 49, 2, 2, 1452, 340,
+	3, 2, 2, 2, 1453, 1454, 7, 44, 2, 2, 1454, 342, 3, 2, 2, 2, 1455, 1456,
+	7, 39, 2, 2, 1456, 344, 3, 2, 2, 2, 1457, 1458, 7, 97, 2, 2, 1458, 346,
+	3, 2, 2, 2, 1459, 1460, 5, 357, 179, 2, 1460, 348, 3, 2, 2, 2, 1461, 1463,
+	5, 355, 178, 2, 1462, 1461, 3, 2, 2, 2, 1463, 1464, 3, 2, 2, 2, 1464, 1462,
+	3, 2, 2, 2, 1464, 1465, 3, 2, 2, 2, 1465, 350, 3, 2, 2, 2, 1466, 1468,
+	5, 355, 178, 2, 1467, 1466, 3, 2, 2, 2, 1468, 1469, 3, 2, 2, 2, 1469, 1467,
+	3, 2, 2, 2, 1469, 1470, 3, 2, 2, 2, 1470, 1471, 3, 2, 2, 2, 1471, 1472,
+	7, 48, 2, 2, 1472, 1476, 10, 8, 2, 2, 1473, 1475, 5, 355, 178, 2, 1474,
+	1473, 3, 2, 2, 2, 1475, 1478, 3, 2, 2, 2, 1476, 1474, 3, 2, 2, 2, 1476,
+	1477, 3, 2, 2, 2, 1477, 1486, 3, 2, 2, 2, 1478, 1476, 3, 2, 2, 2, 1479,
+	1481, 7, 48, 2, 2, 1480, 1482, 5, 355, 178, 2, 1481, 1480, 3, 2, 2, 2,
+	1482, 1483, 3, 2, 2, 2, 1483, 1481, 3, 2, 2, 2, 1483, 1484, 3, 2, 2, 2,
+	1484, 1486, 3, 2, 2, 2, 1485, 1467, 3, 2, 2, 2, 1485, 1479, 3, 2, 2, 2,
+	1486, 352, 3, 2, 2, 2, 1487, 1488, 9, 7, 2, 2, 1488, 354, 3, 2, 2, 2, 1489,
+	1490, 9, 9, 2, 2, 1490, 356, 3, 2, 2, 2, 1491, 1497, 9, 10, 2, 2, 1492,
+	1496, 9, 10, 2, 2, 1493, 1496, 5, 355, 178, 2, 1494, 1496, 9, 11, 2, 2,
+	1495, 1492, 3, 2, 2, 2, 1495, 1493, 3, 2, 2, 2, 1495, 1494, 3, 2, 2, 2,
+	1496, 1499, 3, 2, 2, 2, 1497, 1495, 3, 2, 2, 2, 1497, 1498, 3, 2, 2, 2,
+	1498, 1542, 3, 2, 2, 2, 1499, 1497, 3, 2, 2, 2, 1500, 1501, 7, 38, 2, 2,
+	1501, 1505, 7, 125, 2, 2, 1502, 1504, 11, 2, 2, 2, 1503, 1502, 3, 2, 2,
+	2, 1504, 1507, 3, 2, 2, 2, 1505, 1506, 3, 2, 2, 2, 1505, 1503, 3, 2, 2,
+	2, 1506, 1508, 3, 2, 2, 2, 1507, 1505, 3, 2, 2, 2, 1508, 1542, 7, 127,
+	2, 2, 1509, 1513, 9, 12, 2, 2, 1510, 1514, 9, 10, 2, 2, 1511, 1514, 5,
+	355, 178, 2, 1512, 1514, 9, 13, 2, 2, 1513, 1510, 3, 2, 2, 2, 1513, 1511,
+	3, 2, 2, 2, 1513, 1512, 3, 2, 2, 2, 1514, 1515, 3, 2, 2, 2, 1515, 1513,
+	3, 2, 2, 2, 1515, 1516, 3, 2, 2, 2, 1516, 1542, 3, 2, 2, 2, 1517, 1521,
+	7, 36, 2, 2, 1518, 1520, 11, 2, 2, 2, 1519, 1518, 3, 2, 2, 2, 1520, 1523,
+	3, 2, 2, 2, 1521, 1522, 3, 2, 2, 2, 1521, 1519, 3, 2, 2, 2, 1522, 1524,
+	3, 2, 2, 2, 1523, 1521, 3, 2, 2, 2, 1524, 1542, 7, 36, 2, 2, 1525, 1529,
+	7, 98, 2, 2, 1526, 1528, 11, 2, 2, 2, 1527, 1526, 3, 2, 2, 2, 1528, 1531,
+	3, 2, 2, 2, 1529, 1530, 3, 2, 2, 2, 1529, 1527, 3, 2, 2, 2, 1530, 1532,
+	3, 2, 2, 2, 1531, 1529, 3, 2, 2, 2, 1532, 1542, 7, 98, 2, 2, 1533, 1537,
+	7, 41, 2, 2, 1534, 1536, 11, 2, 2, 2, 1535, 1534, 3, 2, 2, 2, 1536, 1539,
+	3, 2, 2, 2, 1537, 1538, 3, 2, 2, 2, 1537, 1535, 3, 2, 2, 2, 1538, 1540,
+	3, 2, 2, 2, 1539, 1537, 3, 2, 2, 2, 1540, 1542, 7, 41, 2, 2, 1541, 1491,
+	3, 2, 2, 2, 1541, 1500, 3, 2, 2, 2, 1541, 1509, 3, 2, 2, 2, 1541, 1517,
+	3, 2, 2, 2, 1541, 1525, 3, 2, 2, 2, 1541, 1533, 3, 2, 2, 2, 1542, 358,
+	3, 2, 2, 2, 1543, 1544, 9, 14, 2, 2, 1544, 360, 3, 2, 2, 2, 1545, 1546,
+	9, 15, 2, 2, 1546, 362, 3, 2, 2, 2, 1547, 1548, 9, 16, 2, 2, 1548, 364,
+	3, 2, 2, 2, 1549, 1550, 9, 17, 2, 2, 1550, 366, 3, 2, 2, 2, 1551, 1552,
+	9, 5, 2, 2, 1552, 368, 3, 2, 2, 2, 1553, 1554, 9, 18, 2, 2, 1554, 370,
+	3, 2, 2, 2, 1555, 1556, 9, 19, 2, 2, 1556, 372, 3, 2, 2, 2, 1557, 1558,
+	9, 20, 2, 2, 1558, 374, 3, 2, 2, 2, 1559, 1560, 9, 21, 2, 2, 1560, 376,
+	3, 2, 2, 2, 1561, 1562, 9, 22, 2, 2, 1562, 378, 3, 2, 2, 2, 1563, 1564,
+	9, 23, 2, 2, 1564, 380, 3, 2, 2, 2, 1565, 1566, 9, 24, 2, 2, 1566, 382,
+	3, 2, 2, 2, 1567, 1568, 9, 25, 2, 2, 1568, 384, 3, 2, 2, 2, 1569, 1570,
+	9, 26, 2, 2, 1570, 386, 3, 2, 2, 2, 1571, 1572, 9, 27, 2, 2, 1572, 388,
+	3, 2, 2, 2, 1573, 1574, 9, 28, 2, 2, 1574, 390, 3, 2, 2, 2, 1575, 1576,
+	9, 29, 2, 2, 1576, 392, 3, 2, 2, 2, 1577, 1578, 9, 30, 2, 2, 1578, 394,
+	3, 2, 2, 2, 1579, 1580, 9, 31, 2, 2, 1580, 396, 3, 2, 2, 2, 1581, 1582,
+	9, 32, 2, 2, 1582, 398, 3, 2, 2, 2, 1583, 1584, 9, 33, 2, 2, 1584, 400,
+	3, 2, 2, 2, 1585, 1586, 9, 34, 2, 2, 1586, 402, 3, 2, 2, 2, 1587, 1588,
+	9, 35, 2, 2, 1588, 404, 3, 2, 2, 2, 1589, 1590, 9, 36, 2, 2, 1590, 406,
+	3, 2, 2, 2, 1591, 1592, 9, 37, 2, 2, 1592, 408, 3, 2, 2, 2, 1593, 1594,
+	9, 38, 2, 2, 1594, 410, 3, 2, 2, 2, 22, 2, 425, 427, 435, 449, 456, 1464,
+	1469, 1476, 1483, 1485, 1495, 1497, 1505, 1513, 1515, 1521, 1529, 1537,
+	1541, 3, 8, 2, 2,
 }
 
 var lexerChannelNames = []string{
@@ -536,59 +786,78 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'true'", "'false'", "'null'", "", "", "", "", "", "", "", "", "",
+	"", "'true'", "'false'", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "'m'", "", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'",
-	"'!='", "'>'", "'>='", "'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'",
-	"'['", "']'", "'('", "')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'m'", "",
+	"", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='",
+	"'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('",
+	"')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
+	"", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
 	"T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_TTL", "T_META_TTL",
 	"T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", "T_SHOW", "T_USE", "T_STATE_REPO",
 	"T_STATE_MACHINE", "T_MASTER", "T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES",
-	"T_STORAGE", "T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES",
-	"T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD",
-	"T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH", "T_VALUES",
-	"T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN",
-	"T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL",
-	"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
-	"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW",
-	"T_IN", "T_LOG", "T_PROFILE", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_AVG",
-	"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY",
-	"T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL",
-	"T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL",
+	"T_STORAGE", "T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_USER", "T_USERS", "T_ROLE",
+	"T_ROLES", "T_LIMITS", "T_CARDINALITY", "T_PASSWORD", "T_GRANT", "T_REVOKE",
+	"T_TO", "T_READ", "T_WRITE", "T_ADMIN", "T_DELETE", "T_ALTER", "T_RENAME",
+	"T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS",
+	"T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY",
+	"T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES",
+	"T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR",
+	"T_FILL", "T_NULL", "T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE",
+	"T_NOT", "T_BETWEEN", "T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS",
+	"T_TIME", "T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_SUM", "T_MIN", "T_MAX",
+	"T_COUNT", "T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", "T_VARIANCE", "T_FIRST_VALUE",
+	"T_LAST_VALUE", "T_MEDIAN", "T_IRATE", "T_INCREASE", "T_DERIVATIVE", "T_DELTA",
+	"T_MOVING_AVG", "T_MOVING_SUM", "T_CUMULATIVE_SUM", "T_EWMA", "T_TIME_SHIFT",
+	"T_DIFF", "T_ABS", "T_CEIL", "T_FLOOR", "T_ROUND", "T_POW", "T_SQRT", "T_CLAMP_MIN",
+	"T_CLAMP_MAX", "T_IF", "T_TZ", "T_REGEX_EXTRACT", "T_LABEL_REPLACE", "T_EXEMPLARS",
+	"T_HISTOGRAM_COUNT", "T_HISTOGRAM_SUM", "T_HISTOGRAM_AVG", "T_HISTOGRAM_FRACTION",
+	"T_HEATMAP", "T_LAST", "T_COUNT_DISTINCT", "T_SECOND", "T_MINUTE", "T_HOUR",
+	"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
+	"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL",
 	"T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB",
 	"T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL",
 	"T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
 }
 
 var lexerRuleNames = []string{
-	"T__0", "T__1", "T__2", "STRING", "ESC", "UNICODE", "HEX", "SAFECODEPOINT",
-	"EXP", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", "T_INTERVAL", "T_INTERVAL_NAME",
+	"T__0", "T__1", "STRING", "ESC", "UNICODE", "HEX", "SAFECODEPOINT", "EXP",
+	"WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", "T_INTERVAL", "T_INTERVAL_NAME",
 	"T_SHARD", "T_REPLICATION", "T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL",
 	"T_KILL", "T_ON", "T_SHOW", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE",
 	"T_MASTER", "T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES", "T_STORAGE",
-	"T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES", "T_NAMESPACE",
-	"T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD", "T_FIELDS",
-	"T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH", "T_VALUES", "T_VALUE",
-	"T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE",
-	"T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS",
-	"T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", "T_GROUP",
-	"T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", "T_IN", "T_LOG",
-	"T_PROFILE", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_AVG", "T_STDDEV",
-	"T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK",
-	"T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2",
-	"T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP",
-	"T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P",
-	"T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE",
-	"L_ID", "L_INT", "L_DEC", "BLANK", "L_DIGIT", "L_ID_PART", "A", "B", "C",
-	"D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R",
-	"S", "T", "U", "V", "W", "X", "Y", "Z",
+	"T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_USER", "T_USERS", "T_ROLE", "T_ROLES",
+	"T_LIMITS", "T_CARDINALITY", "T_PASSWORD", "T_GRANT", "T_REVOKE", "T_TO",
+	"T_READ", "T_WRITE", "T_ADMIN", "T_DELETE", "T_ALTER", "T_RENAME", "T_DATASBAE",
+	"T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC",
+	"T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH",
+	"T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY",
+	"T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL",
+	"T_NULL", "T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT",
+	"T_BETWEEN", "T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS",
+	"T_TIME", "T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_SUM", "T_MIN", "T_MAX",
+	"T_COUNT", "T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", "T_VARIANCE", "T_FIRST_VALUE",
+	"T_LAST_VALUE", "T_MEDIAN", "T_IRATE", "T_INCREASE", "T_DERIVATIVE", "T_DELTA",
+	"T_MOVING_AVG", "T_MOVING_SUM", "T_CUMULATIVE_SUM", "T_EWMA", "T_TIME_SHIFT",
+	"T_DIFF", "T_ABS", "T_CEIL", "T_FLOOR", "T_ROUND", "T_POW", "T_SQRT", "T_CLAMP_MIN",
+	"T_CLAMP_MAX", "T_IF", "T_TZ", "T_REGEX_EXTRACT", "T_LABEL_REPLACE", "T_EXEMPLARS",
+	"T_HISTOGRAM_COUNT", "T_HISTOGRAM_SUM", "T_HISTOGRAM_AVG", "T_HISTOGRAM_FRACTION",
+	"T_HEATMAP", "T_LAST", "T_COUNT_DISTINCT", "T_SECOND", "T_MINUTE", "T_HOUR",
+	"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
+	"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL",
+	"T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB",
+	"T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL",
+	"T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC", "BLANK", "L_DIGIT", "L_ID_PART",
+	"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O",
+	"P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
 }
 
 type SQLLexer struct {
@@ -628,125 +897,174 @@ func NewSQLLexer(input antlr.CharStream) *SQLLexer {
 
 // SQLLexer tokens.
 const (
-	SQLLexerT__0            = 1
-	SQLLexerT__1            = 2
-	SQLLexerT__2            = 3
-	SQLLexerSTRING          = 4
-	SQLLexerWS              = 5
-	SQLLexerT_CREATE        = 6
-	SQLLexerT_UPDATE        = 7
-	SQLLexerT_SET           = 8
-	SQLLexerT_DROP          = 9
-	SQLLexerT_INTERVAL      = 10
-	SQLLexerT_INTERVAL_NAME = 11
-	SQLLexerT_SHARD         = 12
-	SQLLexerT_REPLICATION   = 13
-	SQLLexerT_TTL           = 14
-	SQLLexerT_META_TTL      = 15
-	SQLLexerT_PAST_TTL      = 16
-	SQLLexerT_FUTURE_TTL    = 17
-	SQLLexerT_KILL          = 18
-	SQLLexerT_ON            = 19
-	SQLLexerT_SHOW          = 20
-	SQLLexerT_USE           = 21
-	SQLLexerT_STATE_REPO    = 22
-	SQLLexerT_STATE_MACHINE = 23
-	SQLLexerT_MASTER        = 24
-	SQLLexerT_METADATA      = 25
-	SQLLexerT_TYPES         = 26
-	SQLLexerT_TYPE          = 27
-	SQLLexerT_STORAGES      = 28
-	SQLLexerT_STORAGE       = 29
-	SQLLexerT_BROKER        = 30
-	SQLLexerT_ALIVE         = 31
-	SQLLexerT_SCHEMAS       = 32
-	SQLLexerT_DATASBAE      = 33
-	SQLLexerT_DATASBAES     = 34
-	SQLLexerT_NAMESPACE     = 35
-	SQLLexerT_NAMESPACES    = 36
-	SQLLexerT_NODE          = 37
-	SQLLexerT_METRICS       = 38
-	SQLLexerT_METRIC        = 39
-	SQLLexerT_FIELD         = 40
-	SQLLexerT_FIELDS        = 41
-	SQLLexerT_TAG           = 42
-	SQLLexerT_INFO          = 43
-	SQLLexerT_KEYS          = 44
-	SQLLexerT_KEY           = 45
-	SQLLexerT_WITH          = 46
-	SQLLexerT_VALUES        = 47
-	SQLLexerT_VALUE         = 48
-	SQLLexerT_FROM          = 49
-	SQLLexerT_WHERE         = 50
-	SQLLexerT_LIMIT         = 51
-	SQLLexerT_QUERIES       = 52
-	SQLLexerT_QUERY         = 53
-	SQLLexerT_EXPLAIN       = 54
-	SQLLexerT_WITH_VALUE    = 55
-	SQLLexerT_SELECT        = 56
-	SQLLexerT_AS            = 57
-	SQLLexerT_AND           = 58
-	SQLLexerT_OR            = 59
-	SQLLexerT_FILL          = 60
-	SQLLexerT_NULL          = 61
-	SQLLexerT_PREVIOUS      = 62
-	SQLLexerT_ORDER         = 63
-	SQLLexerT_ASC           = 64
-	SQLLexerT_DESC          = 65
-	SQLLexerT_LIKE          = 66
-	SQLLexerT_NOT           = 67
-	SQLLexerT_BETWEEN       = 68
-	SQLLexerT_IS            = 69
-	SQLLexerT_GROUP         = 70
-	SQLLexerT_HAVING        = 71
-	SQLLexerT_BY            = 72
-	SQLLexerT_FOR           = 73
-	SQLLexerT_STATS         = 74
-	SQLLexerT_TIME          = 75
-	SQLLexerT_NOW           = 76
-	SQLLexerT_IN            = 77
-	SQLLexerT_LOG           = 78
-	SQLLexerT_PROFILE       = 79
-	SQLLexerT_SUM           = 80
-	SQLLexerT_MIN           = 81
-	SQLLexerT_MAX           = 82
-	SQLLexerT_COUNT         = 83
-	SQLLexerT_AVG           = 84
-	SQLLexerT_STDDEV        = 85
-	SQLLexerT_QUANTILE      = 86
-	SQLLexerT_RATE          = 87
-	SQLLexerT_SECOND        = 88
-	SQLLexerT_MINUTE        = 89
-	SQLLexerT_HOUR          = 90
-	SQLLexerT_DAY           = 91
-	SQLLexerT_WEEK          = 92
-	SQLLexerT_MONTH         = 93
-	SQLLexerT_YEAR          = 94
-	SQLLexerT_DOT           = 95
-	SQLLexerT_COLON         = 96
-	SQLLexerT_EQUAL         = 97
-	SQLLexerT_NOTEQUAL      = 98
-	SQLLexerT_NOTEQUAL2     = 99
-	SQLLexerT_GREATER       = 100
-	SQLLexerT_GREATEREQUAL  = 101
-	SQLLexerT_LESS          = 102
-	SQLLexerT_LESSEQUAL     = 103
-	SQLLexerT_REGEXP        = 104
-	SQLLexerT_NEQREGEXP     = 105
-	SQLLexerT_COMMA         = 106
-	SQLLexerT_OPEN_B        = 107
-	SQLLexerT_CLOSE_B       = 108
-	SQLLexerT_OPEN_SB       = 109
-	SQLLexerT_CLOSE_SB      = 110
-	SQLLexerT_OPEN_P        = 111
-	SQLLexerT_CLOSE_P       = 112
-	SQLLexerT_ADD           = 113
-	SQLLexerT_SUB           = 114
-	SQLLexerT_DIV           = 115
-	SQLLexerT_MUL           = 116
-	SQLLexerT_MOD           = 117
-	SQLLexerT_UNDERLINE     = 118
-	SQLLexerL_ID            = 119
-	SQLLexerL_INT           = 120
-	SQLLexerL_DEC           = 121
+	SQLLexerT__0                 = 1
+	SQLLexerT__1                 = 2
+	SQLLexerSTRING               = 3
+	SQLLexerWS                   = 4
+	SQLLexerT_CREATE             = 5
+	SQLLexerT_UPDATE             = 6
+	SQLLexerT_SET                = 7
+	SQLLexerT_DROP               = 8
+	SQLLexerT_INTERVAL           = 9
+	SQLLexerT_INTERVAL_NAME      = 10
+	SQLLexerT_SHARD              = 11
+	SQLLexerT_REPLICATION        = 12
+	SQLLexerT_TTL                = 13
+	SQLLexerT_META_TTL           = 14
+	SQLLexerT_PAST_TTL           = 15
+	SQLLexerT_FUTURE_TTL         = 16
+	SQLLexerT_KILL               = 17
+	SQLLexerT_ON                 = 18
+	SQLLexerT_SHOW               = 19
+	SQLLexerT_USE                = 20
+	SQLLexerT_STATE_REPO         = 21
+	SQLLexerT_STATE_MACHINE      = 22
+	SQLLexerT_MASTER             = 23
+	SQLLexerT_METADATA           = 24
+	SQLLexerT_TYPES              = 25
+	SQLLexerT_TYPE               = 26
+	SQLLexerT_STORAGES           = 27
+	SQLLexerT_STORAGE            = 28
+	SQLLexerT_BROKER             = 29
+	SQLLexerT_ALIVE              = 30
+	SQLLexerT_SCHEMAS            = 31
+	SQLLexerT_USER               = 32
+	SQLLexerT_USERS              = 33
+	SQLLexerT_ROLE               = 34
+	SQLLexerT_ROLES              = 35
+	SQLLexerT_LIMITS             = 36
+	SQLLexerT_CARDINALITY        = 37
+	SQLLexerT_PASSWORD           = 38
+	SQLLexerT_GRANT              = 39
+	SQLLexerT_REVOKE             = 40
+	SQLLexerT_TO                 = 41
+	SQLLexerT_READ               = 42
+	SQLLexerT_WRITE              = 43
+	SQLLexerT_ADMIN              = 44
+	SQLLexerT_DELETE             = 45
+	SQLLexerT_ALTER              = 46
+	SQLLexerT_RENAME             = 47
+	SQLLexerT_DATASBAE           = 48
+	SQLLexerT_DATASBAES          = 49
+	SQLLexerT_NAMESPACE          = 50
+	SQLLexerT_NAMESPACES         = 51
+	SQLLexerT_NODE               = 52
+	SQLLexerT_METRICS            = 53
+	SQLLexerT_METRIC             = 54
+	SQLLexerT_FIELD              = 55
+	SQLLexerT_FIELDS             = 56
+	SQLLexerT_TAG                = 57
+	SQLLexerT_INFO               = 58
+	SQLLexerT_KEYS               = 59
+	SQLLexerT_KEY                = 60
+	SQLLexerT_WITH               = 61
+	SQLLexerT_VALUES             = 62
+	SQLLexerT_VALUE              = 63
+	SQLLexerT_FROM               = 64
+	SQLLexerT_WHERE              = 65
+	SQLLexerT_LIMIT              = 66
+	SQLLexerT_QUERIES            = 67
+	SQLLexerT_QUERY              = 68
+	SQLLexerT_EXPLAIN            = 69
+	SQLLexerT_WITH_VALUE         = 70
+	SQLLexerT_SELECT             = 71
+	SQLLexerT_AS                 = 72
+	SQLLexerT_AND                = 73
+	SQLLexerT_OR                 = 74
+	SQLLexerT_FILL               = 75
+	SQLLexerT_NULL               = 76
+	SQLLexerT_PREVIOUS           = 77
+	SQLLexerT_ORDER              = 78
+	SQLLexerT_ASC                = 79
+	SQLLexerT_DESC               = 80
+	SQLLexerT_LIKE               = 81
+	SQLLexerT_NOT                = 82
+	SQLLexerT_BETWEEN            = 83
+	SQLLexerT_IS                 = 84
+	SQLLexerT_GROUP              = 85
+	SQLLexerT_HAVING             = 86
+	SQLLexerT_BY                 = 87
+	SQLLexerT_FOR                = 88
+	SQLLexerT_STATS              = 89
+	SQLLexerT_TIME               = 90
+	SQLLexerT_NOW                = 91
+	SQLLexerT_IN                 = 92
+	SQLLexerT_LOG                = 93
+	SQLLexerT_PROFILE            = 94
+	SQLLexerT_SUM                = 95
+	SQLLexerT_MIN                = 96
+	SQLLexerT_MAX                = 97
+	SQLLexerT_COUNT              = 98
+	SQLLexerT_AVG                = 99
+	SQLLexerT_STDDEV             = 100
+	SQLLexerT_QUANTILE           = 101
+	SQLLexerT_RATE               = 102
+	SQLLexerT_VARIANCE           = 103
+	SQLLexerT_FIRST_VALUE        = 104
+	SQLLexerT_LAST_VALUE         = 105
+	SQLLexerT_MEDIAN             = 106
+	SQLLexerT_IRATE              = 107
+	SQLLexerT_INCREASE           = 108
+	SQLLexerT_DERIVATIVE         = 109
+	SQLLexerT_DELTA              = 110
+	SQLLexerT_MOVING_AVG         = 111
+	SQLLexerT_MOVING_SUM         = 112
+	SQLLexerT_CUMULATIVE_SUM     = 113
+	SQLLexerT_EWMA               = 114
+	SQLLexerT_TIME_SHIFT         = 115
+	SQLLexerT_DIFF               = 116
+	SQLLexerT_ABS                = 117
+	SQLLexerT_CEIL               = 118
+	SQLLexerT_FLOOR              = 119
+	SQLLexerT_ROUND              = 120
+	SQLLexerT_POW                = 121
+	SQLLexerT_SQRT               = 122
+	SQLLexerT_CLAMP_MIN          = 123
+	SQLLexerT_CLAMP_MAX          = 124
+	SQLLexerT_IF                 = 125
+	SQLLexerT_TZ                 = 126
+	SQLLexerT_REGEX_EXTRACT      = 127
+	SQLLexerT_LABEL_REPLACE      = 128
+	SQLLexerT_EXEMPLARS          = 129
+	SQLLexerT_HISTOGRAM_COUNT    = 130
+	SQLLexerT_HISTOGRAM_SUM      = 131
+	SQLLexerT_HISTOGRAM_AVG      = 132
+	SQLLexerT_HISTOGRAM_FRACTION = 133
+	SQLLexerT_HEATMAP            = 134
+	SQLLexerT_LAST               = 135
+	SQLLexerT_COUNT_DISTINCT     = 136
+	SQLLexerT_SECOND             = 137
+	SQLLexerT_MINUTE             = 138
+	SQLLexerT_HOUR               = 139
+	SQLLexerT_DAY                = 140
+	SQLLexerT_WEEK               = 141
+	SQLLexerT_MONTH              = 142
+	SQLLexerT_YEAR               = 143
+	SQLLexerT_DOT                = 144
+	SQLLexerT_COLON              = 145
+	SQLLexerT_EQUAL              = 146
+	SQLLexerT_NOTEQUAL           = 147
+	SQLLexerT_NOTEQUAL2          = 148
+	SQLLexerT_GREATER            = 149
+	SQLLexerT_GREATEREQUAL       = 150
+	SQLLexerT_LESS               = 151
+	SQLLexerT_LESSEQUAL          = 152
+	SQLLexerT_REGEXP             = 153
+	SQLLexerT_NEQREGEXP          = 154
+	SQLLexerT_COMMA              = 155
+	SQLLexerT_OPEN_B             = 156
+	SQLLexerT_CLOSE_B            = 157
+	SQLLexerT_OPEN_SB            = 158
+	SQLLexerT_CLOSE_SB           = 159
+	SQLLexerT_OPEN_P             = 160
+	SQLLexerT_CLOSE_P            = 161
+	SQLLexerT_ADD                = 162
+	SQLLexerT_SUB                = 163
+	SQLLexerT_DIV                = 164
+	SQLLexerT_MUL                = 165
+	SQLLexerT_MOD                = 166
+	SQLLexerT_UNDERLINE          = 167
+	SQLLexerL_ID                 = 168
+	SQLLexerL_INT                = 169
+	SQLLexerL_DEC                = 170
 )
@@ -57,6 +57,9 @@ type SQLListener interface {
 	// EnterShowReplicationStmt is called when entering the showReplicationStmt production.
 	EnterShowReplicationStmt(c *ShowReplicationStmtContext)
 
+	// EnterShowLimitsStmt is called when entering the showLimitsStmt production.
+	EnterShowLimitsStmt(c *ShowLimitsStmtContext)
+
 	// EnterShowBrokerMetricStmt is called when entering the showBrokerMetricStmt production.
 	EnterShowBrokerMetricStmt(c *ShowBrokerMetricStmtContext)
 
@@ -93,6 +96,15 @@ type SQLListener interface {
 	// EnterShowTagValuesStmt is called when entering the showTagValuesStmt production.
 	EnterShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
+	// EnterShowMetricCardinalityStmt is called when entering the showMetricCardinalityStmt production.
+	EnterShowMetricCardinalityStmt(c *ShowMetricCardinalityStmtContext)
+
+	// EnterShowTagKeyCardinalityStmt is called when entering the showTagKeyCardinalityStmt production.
+	EnterShowTagKeyCardinalityStmt(c *ShowTagKeyCardinalityStmtContext)
+
+	// EnterShowTagValueCardinalityStmt is called when entering the showTagValueCardinalityStmt production.
+	EnterShowTagValueCardinalityStmt(c *ShowTagValueCardinalityStmtContext)
+
 	// EnterPrefix is called when entering the prefix production.
 	EnterPrefix(c *PrefixContext)
 
@@ -108,12 +120,81 @@ type SQLListener interface {
 	// EnterSource is called when entering the source production.
 	EnterSource(c *SourceContext)
 
+	// EnterCreateUserStmt is called when entering the createUserStmt production.
+	EnterCreateUserStmt(c *CreateUserStmtContext)
+
+	// EnterDropUserStmt is called when entering the dropUserStmt production.
+	EnterDropUserStmt(c *DropUserStmtContext)
+
+	// EnterShowUsersStmt is called when entering the showUsersStmt production.
+	EnterShowUsersStmt(c *ShowUsersStmtContext)
+
+	// EnterCreateRoleStmt is called when entering the createRoleStmt production.
+	EnterCreateRoleStmt(c *CreateRoleStmtContext)
+
+	// EnterDropRoleStmt is called when entering the dropRoleStmt production.
+	EnterDropRoleStmt(c *DropRoleStmtContext)
+
+	// EnterShowRolesStmt is called when entering the showRolesStmt production.
+	EnterShowRolesStmt(c *ShowRolesStmtContext)
+
+	// EnterGrantStmt is called when entering the grantStmt production.
+	EnterGrantStmt(c *GrantStmtContext)
+
+	// EnterRevokeStmt is called when entering the revokeStmt production.
+	EnterRevokeStmt(c *RevokeStmtContext)
+
+	// EnterPrivilege is called when entering the privilege production.
+	EnterPrivilege(c *PrivilegeContext)
+
+	// EnterGrantDatabase is called when entering the grantDatabase production.
+	EnterGrantDatabase(c *GrantDatabaseContext)
+
+	// EnterGrantNamespace is called when entering the grantNamespace production.
+	EnterGrantNamespace(c *GrantNamespaceContext)
+
+	// EnterGrantee is called when entering the grantee production.
+	EnterGrantee(c *GranteeContext)
+
+	// EnterUserName is called when entering the userName production.
+	EnterUserName(c *UserNameContext)
+
+	// EnterRoleName is called when entering the roleName production.
+	EnterRoleName(c *RoleNameContext)
+
+	// EnterPassword is called when entering the password production.
+	EnterPassword(c *PasswordContext)
+
 	// EnterQueryStmt is called when entering the queryStmt production.
 	EnterQueryStmt(c *QueryStmtContext)
 
 	// EnterSelectExpr is called when entering the selectExpr production.
 	EnterSelectExpr(c *SelectExprContext)
 
+	// EnterDeleteStmt is called when entering the deleteStmt production.
+	EnterDeleteStmt(c *DeleteStmtContext)
+
+	// EnterAlterMetricStmt is called when entering the alterMetricStmt production.
+	EnterAlterMetricStmt(c *AlterMetricStmtContext)
+
+	// EnterAlterFieldAction is called when entering the alterFieldAction production.
+	EnterAlterFieldAction(c *AlterFieldActionContext)
+
+	// EnterDropMetricStmt is called when entering the dropMetricStmt production.
+	EnterDropMetricStmt(c *DropMetricStmtContext)
+
+	// EnterDropNamespaceStmt is called when entering the dropNamespaceStmt production.
+	EnterDropNamespaceStmt(c *DropNamespaceStmtContext)
+
+	// EnterFieldName is called when entering the fieldName production.
+	EnterFieldName(c *FieldNameContext)
+
+	// EnterTargetFieldName is called when entering the targetFieldName production.
+	EnterTargetFieldName(c *TargetFieldNameContext)
+
+	// EnterFieldType is called when entering the fieldType production.
+	EnterFieldType(c *FieldTypeContext)
+
 	// EnterFields is called when entering the fields production.
 	EnterFields(c *FieldsContext)
 
@@ -135,6 +216,9 @@ type SQLListener interface {
 	// EnterFromClause is called when entering the fromClause production.
 	EnterFromClause(c *FromClauseContext)
 
+	// EnterQueryFromClause is called when entering the queryFromClause production.
+	EnterQueryFromClause(c *QueryFromClauseContext)
+
 	// EnterWhereClause is called when entering the whereClause production.
 	EnterWhereClause(c *WhereClauseContext)
 
@@ -174,6 +258,9 @@ type SQLListener interface {
 	// EnterGroupByKey is called when entering the groupByKey production.
 	EnterGroupByKey(c *GroupByKeyContext)
 
+	// EnterTagTransformExpr is called when entering the tagTransformExpr production.
+	EnterTagTransformExpr(c *TagTransformExprContext)
+
 	// EnterFillOption is called when entering the fillOption production.
 	EnterFillOption(c *FillOptionContext)
 
@@ -255,6 +342,12 @@ type SQLListener interface {
 	// EnterLimitClause is called when entering the limitClause production.
 	EnterLimitClause(c *LimitClauseContext)
 
+	// EnterTimeZoneClause is called when entering the timeZoneClause production.
+	EnterTimeZoneClause(c *TimeZoneClauseContext)
+
+	// EnterWithExemplarsClause is called when entering the withExemplarsClause production.
+	EnterWithExemplarsClause(c *WithExemplarsClauseContext)
+
 	// EnterMetricName is called when entering the metricName production.
 	EnterMetricName(c *MetricNameContext)
 
@@ -303,6 +396,9 @@ type SQLListener interface {
 	// ExitShowReplicationStmt is called when exiting the showReplicationStmt production.
 	ExitShowReplicationStmt(c *ShowReplicationStmtContext)
 
+	// ExitShowLimitsStmt is called when exiting the showLimitsStmt production.
+	ExitShowLimitsStmt(c *ShowLimitsStmtContext)
+
 	// ExitShowBrokerMetricStmt is called when exiting the showBrokerMetricStmt production.
 	ExitShowBrokerMetricStmt(c *ShowBrokerMetricStmtContext)
 
@@ -339,6 +435,15 @@ type SQLListener interface {
 	// ExitShowTagValuesStmt is called when exiting the showTagValuesStmt production.
 	ExitShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
+	// ExitShowMetricCardinalityStmt is called when exiting the showMetricCardinalityStmt production.
+	ExitShowMetricCardinalityStmt(c *ShowMetricCardinalityStmtContext)
+
+	// ExitShowTagKeyCardinalityStmt is called when exiting the showTagKeyCardinalityStmt production.
+	ExitShowTagKeyCardinalityStmt(c *ShowTagKeyCardinalityStmtContext)
+
+	// ExitShowTagValueCardinalityStmt is called when exiting the showTagValueCardinalityStmt production.
+	ExitShowTagValueCardinalityStmt(c *ShowTagValueCardinalityStmtContext)
+
 	// ExitPrefix is called when exiting the prefix production.
 	ExitPrefix(c *PrefixContext)
 
@@ -354,12 +459,81 @@ type SQLListener interface {
 	// ExitSource is called when exiting the source production.
 	ExitSource(c *SourceContext)
 
+	// ExitCreateUserStmt is called when exiting the createUserStmt production.
+	ExitCreateUserStmt(c *CreateUserStmtContext)
+
+	// ExitDropUserStmt is called when exiting the dropUserStmt production.
+	ExitDropUserStmt(c *DropUserStmtContext)
+
+	// ExitShowUsersStmt is called when exiting the showUsersStmt production.
+	ExitShowUsersStmt(c *ShowUsersStmtContext)
+
+	// ExitCreateRoleStmt is called when exiting the createRoleStmt production.
+	ExitCreateRoleStmt(c *CreateRoleStmtContext)
+
+	// ExitDropRoleStmt is called when exiting the dropRoleStmt production.
+	ExitDropRoleStmt(c *DropRoleStmtContext)
+
+	// ExitShowRolesStmt is called when exiting the showRolesStmt production.
+	ExitShowRolesStmt(c *ShowRolesStmtContext)
+
+	// ExitGrantStmt is called when exiting the grantStmt production.
+	ExitGrantStmt(c *GrantStmtContext)
+
+	// ExitRevokeStmt is called when exiting the revokeStmt production.
+	ExitRevokeStmt(c *RevokeStmtContext)
+
+	// ExitPrivilege is called when exiting the privilege production.
+	ExitPrivilege(c *PrivilegeContext)
+
+	// ExitGrantDatabase is called when exiting the grantDatabase production.
+	ExitGrantDatabase(c *GrantDatabaseContext)
+
+	// ExitGrantNamespace is called when exiting the grantNamespace production.
+	ExitGrantNamespace(c *GrantNamespaceContext)
+
+	// ExitGrantee is called when exiting the grantee production.
+	ExitGrantee(c *GranteeContext)
+
+	// ExitUserName is called when exiting the userName production.
+	ExitUserName(c *UserNameContext)
+
+	// ExitRoleName is called when exiting the roleName production.
+	ExitRoleName(c *RoleNameContext)
+
+	// ExitPassword is called when exiting the password production.
+	ExitPassword(c *PasswordContext)
+
 	// ExitQueryStmt is called when exiting the queryStmt production.
 	ExitQueryStmt(c *QueryStmtContext)
 
 	// ExitSelectExpr is called when exiting the selectExpr production.
 	ExitSelectExpr(c *SelectExprContext)
 
+	// ExitDeleteStmt is called when exiting the deleteStmt production.
+	ExitDeleteStmt(c *DeleteStmtContext)
+
+	// ExitAlterMetricStmt is called when exiting the alterMetricStmt production.
+	ExitAlterMetricStmt(c *AlterMetricStmtContext)
+
+	// ExitAlterFieldAction is called when exiting the alterFieldAction production.
+	ExitAlterFieldAction(c *AlterFieldActionContext)
+
+	// ExitDropMetricStmt is called when exiting the dropMetricStmt production.
+	ExitDropMetricStmt(c *DropMetricStmtContext)
+
+	// ExitDropNamespaceStmt is called when exiting the dropNamespaceStmt production.
+	ExitDropNamespaceStmt(c *DropNamespaceStmtContext)
+
+	// ExitFieldName is called when exiting the fieldName production.
+	ExitFieldName(c *FieldNameContext)
+
+	// ExitTargetFieldName is called when exiting the targetFieldName production.
+	ExitTargetFieldName(c *TargetFieldNameContext)
+
+	// ExitFieldType is called when exiting the fieldType production.
+	ExitFieldType(c *FieldTypeContext)
+
 	// ExitFields is called when exiting the fields production.
 	ExitFields(c *FieldsContext)
 
@@ -381,6 +555,9 @@ type SQLListener interface {
 	// ExitFromClause is called when exiting the fromClause production.
 	ExitFromClause(c *FromClauseContext)
 
+	// ExitQueryFromClause is called when exiting the queryFromClause production.
+	ExitQueryFromClause(c *QueryFromClauseContext)
+
 	// ExitWhereClause is called when exiting the whereClause production.
 	ExitWhereClause(c *WhereClauseContext)
 
@@ -420,6 +597,9 @@ type SQLListener interface {
 	// ExitGroupByKey is called when exiting the groupByKey production.
 	ExitGroupByKey(c *GroupByKeyContext)
 
+	// ExitTagTransformExpr is called when exiting the tagTransformExpr production.
+	ExitTagTransformExpr(c *TagTransformExprContext)
+
 	// ExitFillOption is called when exiting the fillOption production.
 	ExitFillOption(c *FillOptionContext)
 
@@ -501,6 +681,12 @@ type SQLListener interface {
 	// ExitLimitClause is called when exiting the limitClause production.
 	ExitLimitClause(c *LimitClauseContext)
 
+	// ExitTimeZoneClause is called when exiting the timeZoneClause production.
+	ExitTimeZoneClause(c *TimeZoneClauseContext)
+
+	// ExitWithExemplarsClause is called when exiting the withExemplarsClause production.
+	ExitWithExemplarsClause(c *WithExemplarsClauseContext)
+
 	// ExitMetricName is called when exiting the metricName production.
 	ExitMetricName(c *MetricNameContext)
 
@@ -34,7 +34,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 123, 737,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 172, 1055,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -50,346 +50,502 @@ var parserATN = []uint16{
 	4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9, 70, 4,
 	71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75, 4, 76,
 	9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 4, 81, 9,
-	81, 4, 82, 9, 82, 4, 83, 9, 83, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3,
+	81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9, 84, 4, 85, 9, 85, 4, 86, 9, 86,
+	4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89, 4, 90, 9, 90, 4, 91, 9, 91, 4,
+	92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96, 4, 97,
+	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101, 4, 102,
+	9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 4, 105, 9, 105, 4, 106, 9, 106,
+	4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 4, 110, 9, 110, 4, 111,
+	9, 111, 4, 112, 9, 112, 4, 113, 9, 113, 4, 114, 9, 114, 3, 2, 3, 2, 3,
+	2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 192, 10, 3, 3, 4, 3, 4, 3,
-	4, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3,
-	8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3,
-	9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10,
-	5, 10, 231, 10, 10, 3, 10, 3, 10, 3, 10, 5, 10, 236, 10, 10, 3, 11, 3,
-	11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 247, 10, 12,
-	3, 12, 3, 12, 3, 12, 5, 12, 252, 10, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3,
-	13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14, 266, 10, 14,
-	3, 14, 3, 14, 3, 14, 5, 14, 271, 10, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3,
-	16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18,
-	3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 5, 20, 297,
-	10, 20, 3, 20, 5, 20, 300, 10, 20, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 306,
-	10, 21, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 312, 10, 21, 3, 21, 5, 21, 315,
-	10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23,
-	3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 5, 24, 335,
-	10, 24, 3, 24, 5, 24, 338, 10, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3,
-	27, 3, 28, 3, 28, 3, 29, 3, 29, 3, 30, 5, 30, 351, 10, 30, 3, 30, 3, 30,
-	3, 30, 5, 30, 356, 10, 30, 3, 30, 5, 30, 359, 10, 30, 3, 30, 5, 30, 362,
-	10, 30, 3, 30, 5, 30, 365, 10, 30, 3, 30, 5, 30, 368, 10, 30, 3, 31, 3,
-	31, 3, 31, 3, 32, 3, 32, 3, 32, 7, 32, 376, 10, 32, 12, 32, 14, 32, 379,
-	11, 32, 3, 33, 3, 33, 5, 33, 383, 10, 33, 3, 34, 3, 34, 3, 34, 3, 35, 3,
-	35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37,
-	3, 38, 3, 38, 3, 38, 3, 38, 5, 38, 404, 10, 38, 3, 39, 3, 39, 3, 39, 3,
-	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 417, 10, 40,
-	5, 40, 419, 10, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3,
-	41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41, 435, 10, 41, 3, 41,
-	3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41, 443, 10, 41, 3, 41, 3, 41, 3,
-	41, 3, 41, 5, 41, 449, 10, 41, 3, 41, 3, 41, 3, 41, 7, 41, 454, 10, 41,
-	12, 41, 14, 41, 457, 11, 41, 3, 42, 3, 42, 3, 42, 7, 42, 462, 10, 42, 12,
-	42, 14, 42, 465, 11, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44,
-	3, 44, 3, 44, 7, 44, 476, 10, 44, 12, 44, 14, 44, 479, 11, 44, 3, 45, 3,
-	45, 3, 45, 5, 45, 484, 10, 45, 3, 46, 3, 46, 3, 46, 3, 46, 5, 46, 490,
-	10, 46, 3, 47, 3, 47, 5, 47, 494, 10, 47, 3, 48, 3, 48, 3, 48, 5, 48, 499,
-	10, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 49, 5, 49, 511, 10, 49, 3, 49, 5, 49, 514, 10, 49, 3, 50, 3, 50, 3,
-	50, 7, 50, 519, 10, 50, 12, 50, 14, 50, 522, 11, 50, 3, 51, 3, 51, 3, 51,
-	3, 51, 3, 51, 3, 51, 5, 51, 530, 10, 51, 3, 52, 3, 52, 3, 53, 3, 53, 3,
-	53, 3, 53, 3, 54, 3, 54, 7, 54, 540, 10, 54, 12, 54, 14, 54, 543, 11, 54,
-	3, 55, 3, 55, 3, 55, 7, 55, 548, 10, 55, 12, 55, 14, 55, 551, 11, 55, 3,
-	56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 5, 57, 562,
-	10, 57, 3, 57, 3, 57, 3, 57, 3, 57, 7, 57, 568, 10, 57, 12, 57, 14, 57,
-	571, 11, 57, 3, 58, 3, 58, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3,
-	61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 5, 61, 589, 10, 61,
-	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5, 62, 599, 10,
-	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
-	3, 62, 3, 62, 7, 62, 613, 10, 62, 12, 62, 14, 62, 616, 11, 62, 3, 63, 3,
-	63, 3, 63, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 5, 65, 626, 10, 65, 3, 65,
-	3, 65, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 7, 67, 635, 10, 67, 12, 67, 14,
-	67, 638, 11, 67, 3, 68, 3, 68, 5, 68, 642, 10, 68, 3, 69, 3, 69, 5, 69,
-	646, 10, 69, 3, 69, 3, 69, 5, 69, 650, 10, 69, 3, 70, 3, 70, 3, 70, 3,
-	70, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 7, 72, 662, 10, 72, 12, 72,
-	14, 72, 665, 11, 72, 3, 72, 3, 72, 3, 72, 3, 72, 5, 72, 671, 10, 72, 3,
-	73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 7, 74, 681, 10, 74,
-	12, 74, 14, 74, 684, 11, 74, 3, 74, 3, 74, 3, 74, 3, 74, 5, 74, 690, 10,
-	74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 5, 75, 700,
-	10, 75, 3, 76, 5, 76, 703, 10, 76, 3, 76, 3, 76, 3, 77, 5, 77, 708, 10,
-	77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 81,
-	3, 81, 3, 82, 3, 82, 5, 82, 723, 10, 82, 3, 82, 3, 82, 3, 82, 5, 82, 728,
-	10, 82, 7, 82, 730, 10, 82, 12, 82, 14, 82, 733, 11, 82, 3, 83, 3, 83,
-	3, 83, 2, 5, 80, 112, 122, 84, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
-	24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58,
-	60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94,
-	96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124,
-	126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154,
-	156, 158, 160, 162, 164, 2, 12, 3, 2, 31, 32, 3, 2, 24, 25, 3, 2, 60, 61,
-	4, 2, 63, 64, 122, 123, 3, 2, 66, 67, 4, 2, 68, 68, 106, 106, 3, 2, 90,
-	96, 3, 2, 82, 89, 3, 2, 115, 116, 3, 2, 8, 96, 2, 760, 2, 166, 3, 2, 2,
-	2, 4, 191, 3, 2, 2, 2, 6, 193, 3, 2, 2, 2, 8, 196, 3, 2, 2, 2, 10, 199,
-	3, 2, 2, 2, 12, 202, 3, 2, 2, 2, 14, 206, 3, 2, 2, 2, 16, 214, 3, 2, 2,
-	2, 18, 222, 3, 2, 2, 2, 20, 237, 3, 2, 2, 2, 22, 241, 3, 2, 2, 2, 24, 253,
-	3, 2, 2, 2, 26, 259, 3, 2, 2, 2, 28, 272, 3, 2, 2, 2, 30, 276, 3, 2, 2,
-	2, 32, 279, 3, 2, 2, 2, 34, 283, 3, 2, 2, 2, 36, 287, 3, 2, 2, 2, 38, 290,
-	3, 2, 2, 2, 40, 301, 3, 2, 2, 2, 42, 316, 3, 2, 2, 2, 44, 320, 3, 2, 2,
-	2, 46, 325, 3, 2, 2, 2, 48, 339, 3, 2, 2, 2, 50, 341, 3, 2, 2, 2, 52, 343,
-	3, 2, 2, 2, 54, 345, 3, 2, 2, 2, 56, 347, 3, 2, 2, 2, 58, 350, 3, 2, 2,
-	2, 60, 369, 3, 2, 2, 2, 62, 372, 3, 2, 2, 2, 64, 380, 3, 2, 2, 2, 66, 384,
-	3, 2, 2, 2, 68, 387, 3, 2, 2, 2, 70, 391, 3, 2, 2, 2, 72, 395, 3, 2, 2,
-	2, 74, 399, 3, 2, 2, 2, 76, 405, 3, 2, 2, 2, 78, 418, 3, 2, 2, 2, 80, 448,
-	3, 2, 2, 2, 82, 458, 3, 2, 2, 2, 84, 466, 3, 2, 2, 2, 86, 472, 3, 2, 2,
-	2, 88, 480, 3, 2, 2, 2, 90, 485, 3, 2, 2, 2, 92, 491, 3, 2, 2, 2, 94, 495,
-	3, 2, 2, 2, 96, 502, 3, 2, 2, 2, 98, 515, 3, 2, 2, 2, 100, 529, 3, 2, 2,
-	2, 102, 531, 3, 2, 2, 2, 104, 533, 3, 2, 2, 2, 106, 537, 3, 2, 2, 2, 108,
-	544, 3, 2, 2, 2, 110, 552, 3, 2, 2, 2, 112, 561, 3, 2, 2, 2, 114, 572,
-	3, 2, 2, 2, 116, 574, 3, 2, 2, 2, 118, 576, 3, 2, 2, 2, 120, 588, 3, 2,
-	2, 2, 122, 598, 3, 2, 2, 2, 124, 617, 3, 2, 2, 2, 126, 620, 3, 2, 2, 2,
-	128, 622, 3, 2, 2, 2, 130, 629, 3, 2, 2, 2, 132, 631, 3, 2, 2, 2, 134,
-	641, 3, 2, 2, 2, 136, 649, 3, 2, 2, 2, 138, 651, 3, 2, 2, 2, 140, 655,
-	3, 2, 2, 2, 142, 670, 3, 2, 2, 2, 144, 672, 3, 2, 2, 2, 146, 689, 3, 2,
-	2, 2, 148, 699, 3, 2, 2, 2, 150, 702, 3, 2, 2, 2, 152, 707, 3, 2, 2, 2,
-	154, 711, 3, 2, 2, 2, 156, 714, 3, 2, 2, 2, 158, 716, 3, 2, 2, 2, 160,
-	718, 3, 2, 2, 2, 162, 722, 3, 2, 2, 2, 164, 734, 3, 2, 2, 2, 166, 167,
-	5, 4, 3, 2, 167, 168, 7, 2, 2, 3, 168, 3, 3, 2, 2, 2, 169, 192, 5, 8, 5,
-	2, 170, 192, 5, 12, 7, 2, 171, 192, 5, 14, 8, 2, 172, 192, 5, 16, 9, 2,
-	173, 192, 5, 18, 10, 2, 174, 192, 5, 10, 6, 2, 175, 192, 5, 20, 11, 2,
-	176, 192, 5, 24, 13, 2, 177, 192, 5, 26, 14, 2, 178, 192, 5, 28, 15, 2,
-	179, 192, 5, 22, 12, 2, 180, 192, 5, 30, 16, 2, 181, 192, 5, 36, 19, 2,
-	182, 192, 5, 6, 4, 2, 183, 192, 5, 38, 20, 2, 184, 192, 5, 40, 21, 2, 185,
-	192, 5, 42, 22, 2, 186, 192, 5, 44, 23, 2, 187, 192, 5, 46, 24, 2, 188,
-	192, 5, 58, 30, 2, 189, 192, 5, 32, 17, 2, 190, 192, 5, 34, 18, 2, 191,
-	169, 3, 2, 2, 2, 191, 170, 3, 2, 2, 2, 191, 171, 3, 2, 2, 2, 191, 172,
-	3, 2, 2, 2, 191, 173, 3, 2, 2, 2, 191, 174, 3, 2, 2, 2, 191, 175, 3, 2,
-	2, 2, 191, 176, 3, 2, 2, 2, 191, 177, 3, 2, 2, 2, 191, 178, 3, 2, 2, 2,
-	191, 179, 3, 2, 2, 2, 191, 180, 3, 2, 2, 2, 191, 181, 3, 2, 2, 2, 191,
-	182, 3, 2, 2, 2, 191, 183, 3, 2, 2, 2, 191, 184, 3, 2, 2, 2, 191, 185,
-	3, 2, 2, 2, 191, 186, 3, 2, 2, 2, 191, 187, 3, 2, 2, 2, 191, 188, 3, 2,
-	2, 2, 191, 189, 3, 2, 2, 2, 191, 190, 3, 2, 2, 2, 192, 5, 3, 2, 2, 2, 193,
-	194, 7, 23, 2, 2, 194, 195, 5, 162, 82, 2, 195, 7, 3, 2, 2, 2, 196, 197,
-	7, 22, 2, 2, 197, 198, 7, 26, 2, 2, 198, 9, 3, 2, 2, 2, 199, 200, 7, 22,
-	2, 2, 200, 201, 7, 30, 2, 2, 201, 11, 3, 2, 2, 2, 202, 203, 7, 22, 2, 2,
-	203, 204, 7, 27, 2, 2, 204, 205, 7, 28, 2, 2, 205, 13, 3, 2, 2, 2, 206,
-	207, 7, 22, 2, 2, 207, 208, 7, 32, 2, 2, 208, 209, 7, 27, 2, 2, 209, 210,
-	7, 51, 2, 2, 210, 211, 5, 56, 29, 2, 211, 212, 7, 52, 2, 2, 212, 213, 5,
-	72, 37, 2, 213, 15, 3, 2, 2, 2, 214, 215, 7, 22, 2, 2, 215, 216, 7, 26,
-	2, 2, 216, 217, 7, 27, 2, 2, 217, 218, 7, 51, 2, 2, 218, 219, 5, 56, 29,
-	2, 219, 220, 7, 52, 2, 2, 220, 221, 5, 72, 37, 2, 221, 17, 3, 2, 2, 2,
-	222, 223, 7, 22, 2, 2, 223, 224, 7, 31, 2, 2, 224, 225, 7, 27, 2, 2, 225,
-	226, 7, 51, 2, 2, 226, 227, 5, 56, 29, 2, 227, 230, 7, 52, 2, 2, 228, 231,
-	5, 68, 35, 2, 229, 231, 5, 72, 37, 2, 230, 228, 3, 2, 2, 2, 230, 229, 3,
-	2, 2, 2, 231, 232, 3, 2, 2, 2, 232, 235, 7, 60, 2, 2, 233, 236, 5, 68,
-	35, 2, 234, 236, 5, 72, 37, 2, 235, 233, 3, 2, 2, 2, 235, 234, 3, 2, 2,
-	2, 236, 19, 3, 2, 2, 2, 237, 238, 7, 22, 2, 2, 238, 239, 9, 2, 2, 2, 239,
-	240, 7, 33, 2, 2, 240, 21, 3, 2, 2, 2, 241, 242, 7, 22, 2, 2, 242, 243,
-	7, 15, 2, 2, 243, 246, 7, 52, 2, 2, 244, 247, 5, 68, 35, 2, 245, 247, 5,
-	70, 36, 2, 246, 244, 3, 2, 2, 2, 246, 245, 3, 2, 2, 2, 247, 248, 3, 2,
-	2, 2, 248, 251, 7, 60, 2, 2, 249, 252, 5, 68, 35, 2, 250, 252, 5, 70, 36,
-	2, 251, 249, 3, 2, 2, 2, 251, 250, 3, 2, 2, 2, 252, 23, 3, 2, 2, 2, 253,
-	254, 7, 22, 2, 2, 254, 255, 7, 32, 2, 2, 255, 256, 7, 41, 2, 2, 256, 257,
-	7, 52, 2, 2, 257, 258, 5, 84, 43, 2, 258, 25, 3, 2, 2, 2, 259, 260, 7,
-	22, 2, 2, 260, 261, 7, 31, 2, 2, 261, 262, 7, 41, 2, 2, 262, 265, 7, 52,
-	2, 2, 263, 266, 5, 68, 35, 2, 264, 266, 5, 84, 43, 2, 265, 263, 3, 2, 2,
-	2, 265, 264, 3, 2, 2, 2, 266, 267, 3, 2, 2, 2, 267, 270, 7, 60, 2, 2, 268,
-	271, 5, 68, 35, 2, 269, 271, 5, 84, 43, 2, 270, 268, 3, 2, 2, 2, 270, 269,
-	3, 2, 2, 2, 271, 27, 3, 2, 2, 2, 272, 273, 7, 8, 2, 2, 273, 274, 7, 31,
-	2, 2, 274, 275, 5, 140, 71, 2, 275, 29, 3, 2, 2, 2, 276, 277, 7, 22, 2,
-	2, 277, 278, 7, 34, 2, 2, 278, 31, 3, 2, 2, 2, 279, 280, 7, 8, 2, 2, 280,
-	281, 7, 35, 2, 2, 281, 282, 5, 140, 71, 2, 282, 33, 3, 2, 2, 2, 283, 284,
-	7, 11, 2, 2, 284, 285, 7, 35, 2, 2, 285, 286, 5, 54, 28, 2, 286, 35, 3,
-	2, 2, 2, 287, 288, 7, 22, 2, 2, 288, 289, 7, 36, 2, 2, 289, 37, 3, 2, 2,
-	2, 290, 291, 7, 22, 2, 2, 291, 296, 7, 38, 2, 2, 292, 293, 7, 52, 2, 2,
-	293, 294, 7, 37, 2, 2, 294, 295, 7, 99, 2, 2, 295, 297, 5, 48, 25, 2, 296,
-	292, 3, 2, 2, 2, 296, 297, 3, 2, 2, 2, 297, 299, 3, 2, 2, 2, 298, 300,
-	5, 154, 78, 2, 299, 298, 3, 2, 2, 2, 299, 300, 3, 2, 2, 2, 300, 39, 3,
-	2, 2, 2, 301, 302, 7, 22, 2, 2, 302, 305, 7, 40, 2, 2, 303, 304, 7, 21,
-	2, 2, 304, 306, 5, 52, 27, 2, 305, 303, 3, 2, 2, 2, 305, 306, 3, 2, 2,
-	2, 306, 311, 3, 2, 2, 2, 307, 308, 7, 52, 2, 2, 308, 309, 7, 41, 2, 2,
-	309, 310, 7, 99, 2, 2, 310, 312, 5, 48, 25, 2, 311, 307, 3, 2, 2, 2, 311,
-	312, 3, 2, 2, 2, 312, 314, 3, 2, 2, 2, 313, 315, 5, 154, 78, 2, 314, 313,
-	3, 2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 41, 3, 2, 2, 2, 316, 317, 7, 22,
-	2, 2, 317, 318, 7, 43, 2, 2, 318, 319, 5, 74, 38, 2, 319, 43, 3, 2, 2,
-	2, 320, 321, 7, 22, 2, 2, 321, 322, 7, 44, 2, 2, 322, 323, 7, 46, 2, 2,
-	323, 324, 5, 74, 38, 2, 324, 45, 3, 2, 2, 2, 325, 326, 7, 22, 2, 2, 326,
-	327, 7, 44, 2, 2, 327, 328, 7, 49, 2, 2, 328, 329, 5, 74, 38, 2, 329, 330,
-	7, 48, 2, 2, 330, 331, 7, 47, 2, 2, 331, 332, 7, 99, 2, 2, 332, 334, 5,
-	50, 26, 2, 333, 335, 5, 76, 39, 2, 334, 333, 3, 2, 2, 2, 334, 335, 3, 2,
-	2, 2, 335, 337, 3, 2, 2, 2, 336, 338, 5, 154, 78, 2, 337, 336, 3, 2, 2,
-	2, 337, 338, 3, 2, 2, 2, 338, 47, 3, 2, 2, 2, 339, 340, 5, 162, 82, 2,
-	340, 49, 3, 2, 2, 2, 341, 342, 5, 162, 82, 2, 342, 51, 3, 2, 2, 2, 343,
-	344, 5, 162, 82, 2, 344, 53, 3, 2, 2, 2, 345, 346, 5, 162, 82, 2, 346,
-	55, 3, 2, 2, 2, 347, 348, 9, 3, 2, 2, 348, 57, 3, 2, 2, 2, 349, 351, 7,
-	56, 2, 2, 350, 349, 3, 2, 2, 2, 350, 351, 3, 2, 2, 2, 351, 352, 3, 2, 2,
-	2, 352, 353, 5, 60, 31, 2, 353, 355, 5, 74, 38, 2, 354, 356, 5, 76, 39,
-	2, 355, 354, 3, 2, 2, 2, 355, 356, 3, 2, 2, 2, 356, 358, 3, 2, 2, 2, 357,
-	359, 5, 96, 49, 2, 358, 357, 3, 2, 2, 2, 358, 359, 3, 2, 2, 2, 359, 361,
-	3, 2, 2, 2, 360, 362, 5, 104, 53, 2, 361, 360, 3, 2, 2, 2, 361, 362, 3,
-	2, 2, 2, 362, 364, 3, 2, 2, 2, 363, 365, 5, 154, 78, 2, 364, 363, 3, 2,
-	2, 2, 364, 365, 3, 2, 2, 2, 365, 367, 3, 2, 2, 2, 366, 368, 7, 57, 2, 2,
-	367, 366, 3, 2, 2, 2, 367, 368, 3, 2, 2, 2, 368, 59, 3, 2, 2, 2, 369, 370,
-	7, 58, 2, 2, 370, 371, 5, 62, 32, 2, 371, 61, 3, 2, 2, 2, 372, 377, 5,
-	64, 33, 2, 373, 374, 7, 108, 2, 2, 374, 376, 5, 64, 33, 2, 375, 373, 3,
-	2, 2, 2, 376, 379, 3, 2, 2, 2, 377, 375, 3, 2, 2, 2, 377, 378, 3, 2, 2,
-	2, 378, 63, 3, 2, 2, 2, 379, 377, 3, 2, 2, 2, 380, 382, 5, 122, 62, 2,
-	381, 383, 5, 66, 34, 2, 382, 381, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383,
-	65, 3, 2, 2, 2, 384, 385, 7, 59, 2, 2, 385, 386, 5, 162, 82, 2, 386, 67,
-	3, 2, 2, 2, 387, 388, 7, 31, 2, 2, 388, 389, 7, 99, 2, 2, 389, 390, 5,
-	162, 82, 2, 390, 69, 3, 2, 2, 2, 391, 392, 7, 35, 2, 2, 392, 393, 7, 99,
-	2, 2, 393, 394, 5, 162, 82, 2, 394, 71, 3, 2, 2, 2, 395, 396, 7, 29, 2,
-	2, 396, 397, 7, 99, 2, 2, 397, 398, 5, 162, 82, 2, 398, 73, 3, 2, 2, 2,
-	399, 400, 7, 51, 2, 2, 400, 403, 5, 156, 79, 2, 401, 402, 7, 21, 2, 2,
-	402, 404, 5, 52, 27, 2, 403, 401, 3, 2, 2, 2, 403, 404, 3, 2, 2, 2, 404,
-	75, 3, 2, 2, 2, 405, 406, 7, 52, 2, 2, 406, 407, 5, 78, 40, 2, 407, 77,
-	3, 2, 2, 2, 408, 419, 5, 80, 41, 2, 409, 410, 5, 80, 41, 2, 410, 411, 7,
-	60, 2, 2, 411, 412, 5, 88, 45, 2, 412, 419, 3, 2, 2, 2, 413, 416, 5, 88,
-	45, 2, 414, 415, 7, 60, 2, 2, 415, 417, 5, 80, 41, 2, 416, 414, 3, 2, 2,
-	2, 416, 417, 3, 2, 2, 2, 417, 419, 3, 2, 2, 2, 418, 408, 3, 2, 2, 2, 418,
-	409, 3, 2, 2, 2, 418, 413, 3, 2, 2, 2, 419, 79, 3, 2, 2, 2, 420, 421, 8,
-	41, 1, 2, 421, 422, 7, 113, 2, 2, 422, 423, 5, 80, 41, 2, 423, 424, 7,
-	114, 2, 2, 424, 449, 3, 2, 2, 2, 425, 434, 5, 158, 80, 2, 426, 435, 7,
-	99, 2, 2, 427, 435, 7, 68, 2, 2, 428, 429, 7, 69, 2, 2, 429, 435, 7, 68,
-	2, 2, 430, 435, 7, 106, 2, 2, 431, 435, 7, 107, 2, 2, 432, 435, 7, 100,
-	2, 2, 433, 435, 7, 101, 2, 2, 434, 426, 3, 2, 2, 2, 434, 427, 3, 2, 2,
-	2, 434, 428, 3, 2, 2, 2, 434, 430, 3, 2, 2, 2, 434, 431, 3, 2, 2, 2, 434,
-	432, 3, 2, 2, 2, 434, 433, 3, 2, 2, 2, 435, 436, 3, 2, 2, 2, 436, 437,
-	5, 160, 81, 2, 437, 449, 3, 2, 2, 2, 438, 442, 5, 158, 80, 2, 439, 443,
-	7, 79, 2, 2, 440, 441, 7, 69, 2, 2, 441, 443, 7, 79, 2, 2, 442, 439, 3,
-	2, 2, 2, 442, 440, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 445, 7, 113,
-	2, 2, 445, 446, 5, 82, 42, 2, 446, 447, 7, 114, 2, 2, 447, 449, 3, 2, 2,
-	2, 448, 420, 3, 2, 2, 2, 448, 425, 3, 2, 2, 2, 448, 438, 3, 2, 2, 2, 449,
-	455, 3, 2, 2, 2, 450, 451, 12, 3, 2, 2, 451, 452, 9, 4, 2, 2, 452, 454,
-	5, 80, 41, 4, 453, 450, 3, 2, 2, 2, 454, 457, 3, 2, 2, 2, 455, 453, 3,
-	2, 2, 2, 455, 456, 3, 2, 2, 2, 456, 81, 3, 2, 2, 2, 457, 455, 3, 2, 2,
-	2, 458, 463, 5, 160, 81, 2, 459, 460, 7, 108, 2, 2, 460, 462, 5, 160, 81,
-	2, 461, 459, 3, 2, 2, 2, 462, 465, 3, 2, 2, 2, 463, 461, 3, 2, 2, 2, 463,
-	464, 3, 2, 2, 2, 464, 83, 3, 2, 2, 2, 465, 463, 3, 2, 2, 2, 466, 467, 7,
-	41, 2, 2, 467, 468, 7, 79, 2, 2, 468, 469, 7, 113, 2, 2, 469, 470, 5, 86,
-	44, 2, 470, 471, 7, 114, 2, 2, 471, 85, 3, 2, 2, 2, 472, 477, 5, 162, 82,
-	2, 473, 474, 7, 108, 2, 2, 474, 476, 5, 162, 82, 2, 475, 473, 3, 2, 2,
-	2, 476, 479, 3, 2, 2, 2, 477, 475, 3, 2, 2, 2, 477, 478, 3, 2, 2, 2, 478,
-	87, 3, 2, 2, 2, 479, 477, 3, 2, 2, 2, 480, 483, 5, 90, 46, 2, 481, 482,
-	7, 60, 2, 2, 482, 484, 5, 90, 46, 2, 483, 481, 3, 2, 2, 2, 483, 484, 3,
-	2, 2, 2, 484, 89, 3, 2, 2, 2, 485, 486, 7, 77, 2, 2, 486, 489, 5, 120,
-	61, 2, 487, 490, 5, 92, 47, 2, 488, 490, 5, 162, 82, 2, 489, 487, 3, 2,
-	2, 2, 489, 488, 3, 2, 2, 2, 490, 91, 3, 2, 2, 2, 491, 493, 5, 94, 48, 2,
-	492, 494, 5, 124, 63, 2, 493, 492, 3, 2, 2, 2, 493, 494, 3, 2, 2, 2, 494,
-	93, 3, 2, 2, 2, 495, 496, 7, 78, 2, 2, 496, 498, 7, 113, 2, 2, 497, 499,
-	5, 132, 67, 2, 498, 497, 3, 2, 2, 2, 498, 499, 3, 2, 2, 2, 499, 500, 3,
-	2, 2, 2, 500, 501, 7, 114, 2, 2, 501, 95, 3, 2, 2, 2, 502, 503, 7, 72,
-	2, 2, 503, 504, 7, 74, 2, 2, 504, 510, 5, 98, 50, 2, 505, 506, 7, 62, 2,
-	2, 506, 507, 7, 113, 2, 2, 507, 508, 5, 102, 52, 2, 508, 509, 7, 114, 2,
-	2, 509, 511, 3, 2, 2, 2, 510, 505, 3, 2, 2, 2, 510, 511, 3, 2, 2, 2, 511,
-	513, 3, 2, 2, 2, 512, 514, 5, 110, 56, 2, 513, 512, 3, 2, 2, 2, 513, 514,
-	3, 2, 2, 2, 514, 97, 3, 2, 2, 2, 515, 520, 5, 100, 51, 2, 516, 517, 7,
-	108, 2, 2, 517, 519, 5, 100, 51, 2, 518, 516, 3, 2, 2, 2, 519, 522, 3,
-	2, 2, 2, 520, 518, 3, 2, 2, 2, 520, 521, 3, 2, 2, 2, 521, 99, 3, 2, 2,
-	2, 522, 520, 3, 2, 2, 2, 523, 530, 5, 162, 82, 2, 524, 525, 7, 77, 2, 2,
-	525, 526, 7, 113, 2, 2, 526, 527, 5, 124, 63, 2, 527, 528, 7, 114, 2, 2,
-	528, 530, 3, 2, 2, 2, 529, 523, 3, 2, 2, 2, 529, 524, 3, 2, 2, 2, 530,
-	101, 3, 2, 2, 2, 531, 532, 9, 5, 2, 2, 532, 103, 3, 2, 2, 2, 533, 534,
-	7, 65, 2, 2, 534, 535, 7, 74, 2, 2, 535, 536, 5, 108, 55, 2, 536, 105,
-	3, 2, 2, 2, 537, 541, 5, 122, 62, 2, 538, 540, 9, 6, 2, 2, 539, 538, 3,
-	2, 2, 2, 540, 543, 3, 2, 2, 2, 541, 539, 3, 2, 2, 2, 541, 542, 3, 2, 2,
-	2, 542, 107, 3, 2, 2, 2, 543, 541, 3, 2, 2, 2, 544, 549, 5, 106, 54, 2,
-	545, 546, 7, 108, 2, 2, 546, 548, 5, 106, 54, 2, 547, 545, 3, 2, 2, 2,
-	548, 551, 3, 2, 2, 2, 549, 547, 3, 2, 2, 2, 549, 550, 3, 2, 2, 2, 550,
-	109, 3, 2, 2, 2, 551, 549, 3, 2, 2, 2, 552, 553, 7, 73, 2, 2, 553, 554,
-	5, 112, 57, 2, 554, 111, 3, 2, 2, 2, 555, 556, 8, 57, 1, 2, 556, 557, 7,
-	113, 2, 2, 557, 558, 5, 112, 57, 2, 558, 559, 7, 114, 2, 2, 559, 562, 3,
-	2, 2, 2, 560, 562, 5, 116, 59, 2, 561, 555, 3, 2, 2, 2, 561, 560, 3, 2,
-	2, 2, 562, 569, 3, 2, 2, 2, 563, 564, 12, 4, 2, 2, 564, 565, 5, 114, 58,
-	2, 565, 566, 5, 112, 57, 5, 566, 568, 3, 2, 2, 2, 567, 563, 3, 2, 2, 2,
-	568, 571, 3, 2, 2, 2, 569, 567, 3, 2, 2, 2, 569, 570, 3, 2, 2, 2, 570,
-	113, 3, 2, 2, 2, 571, 569, 3, 2, 2, 2, 572, 573, 9, 4, 2, 2, 573, 115,
-	3, 2, 2, 2, 574, 575, 5, 118, 60, 2, 575, 117, 3, 2, 2, 2, 576, 577, 5,
-	122, 62, 2, 577, 578, 5, 120, 61, 2, 578, 579, 5, 122, 62, 2, 579, 119,
-	3, 2, 2, 2, 580, 589, 7, 99, 2, 2, 581, 589, 7, 100, 2, 2, 582, 589, 7,
-	101, 2, 2, 583, 589, 7, 104, 2, 2, 584, 589, 7, 105, 2, 2, 585, 589, 7,
-	102, 2, 2, 586, 589, 7, 103, 2, 2, 587, 589, 9, 7, 2, 2, 588, 580, 3, 2,
-	2, 2, 588, 581, 3, 2, 2, 2, 588, 582, 3, 2, 2, 2, 588, 583, 3, 2, 2, 2,
-	588, 584, 3, 2, 2, 2, 588, 585, 3, 2, 2, 2, 588, 586, 3, 2, 2, 2, 588,
-	587, 3, 2, 2, 2, 589, 121, 3, 2, 2, 2, 590, 591, 8, 62, 1, 2, 591, 592,
-	7, 113, 2, 2, 592, 593, 5, 122, 62, 2, 593, 594, 7, 114, 2, 2, 594, 599,
-	3, 2, 2, 2, 595, 599, 5, 128, 65, 2, 596, 599, 5, 136, 69, 2, 597, 599,
-	5, 124, 63, 2, 598, 590, 3, 2, 2, 2, 598, 595, 3, 2, 2, 2, 598, 596, 3,
-	2, 2, 2, 598, 597, 3, 2, 2, 2, 599, 614, 3, 2, 2, 2, 600, 601, 12, 10,
-	2, 2, 601, 602, 7, 118, 2, 2, 602, 613, 5, 122, 62, 11, 603, 604, 12, 9,
-	2, 2, 604, 605, 7, 117, 2, 2, 605, 613, 5, 122, 62, 10, 606, 607, 12, 8,
-	2, 2, 607, 608, 7, 115, 2, 2, 608, 613, 5, 122, 62, 9, 609, 610, 12, 7,
-	2, 2, 610, 611, 7, 116, 2, 2, 611, 613, 5, 122, 62, 8, 612, 600, 3, 2,
-	2, 2, 612, 603, 3, 2, 2, 2, 612, 606, 3, 2, 2, 2, 612, 609, 3, 2, 2, 2,
-	613, 616, 3, 2, 2, 2, 614, 612, 3, 2, 2, 2, 614, 615, 3, 2, 2, 2, 615,
-	123, 3, 2, 2, 2, 616, 614, 3, 2, 2, 2, 617, 618, 5, 150, 76, 2, 618, 619,
-	5, 126, 64, 2, 619, 125, 3, 2, 2, 2, 620, 621, 9, 8, 2, 2, 621, 127, 3,
-	2, 2, 2, 622, 623, 5, 130, 66, 2, 623, 625, 7, 113, 2, 2, 624, 626, 5,
-	132, 67, 2, 625, 624, 3, 2, 2, 2, 625, 626, 3, 2, 2, 2, 626, 627, 3, 2,
-	2, 2, 627, 628, 7, 114, 2, 2, 628, 129, 3, 2, 2, 2, 629, 630, 9, 9, 2,
-	2, 630, 131, 3, 2, 2, 2, 631, 636, 5, 134, 68, 2, 632, 633, 7, 108, 2,
-	2, 633, 635, 5, 134, 68, 2, 634, 632, 3, 2, 2, 2, 635, 638, 3, 2, 2, 2,
-	636, 634, 3, 2, 2, 2, 636, 637, 3, 2, 2, 2, 637, 133, 3, 2, 2, 2, 638,
-	636, 3, 2, 2, 2, 639, 642, 5, 122, 62, 2, 640, 642, 5, 80, 41, 2, 641,
-	639, 3, 2, 2, 2, 641, 640, 3, 2, 2, 2, 642, 135, 3, 2, 2, 2, 643, 645,
-	5, 162, 82, 2, 644, 646, 5, 138, 70, 2, 645, 644, 3, 2, 2, 2, 645, 646,
-	3, 2, 2, 2, 646, 650, 3, 2, 2, 2, 647, 650, 5, 152, 77, 2, 648, 650, 5,
-	150, 76, 2, 649, 643, 3, 2, 2, 2, 649, 647, 3, 2, 2, 2, 649, 648, 3, 2,
-	2, 2, 650, 137, 3, 2, 2, 2, 651, 652, 7, 111, 2, 2, 652, 653, 5, 80, 41,
-	2, 653, 654, 7, 112, 2, 2, 654, 139, 3, 2, 2, 2, 655, 656, 5, 148, 75,
-	2, 656, 141, 3, 2, 2, 2, 657, 658, 7, 109, 2, 2, 658, 663, 5, 144, 73,
-	2, 659, 660, 7, 108, 2, 2, 660, 662, 5, 144, 73, 2, 661, 659, 3, 2, 2,
-	2, 662, 665, 3, 2, 2, 2, 663, 661, 3, 2, 2, 2, 663, 664, 3, 2, 2, 2, 664,
-	666, 3, 2, 2, 2, 665, 663, 3, 2, 2, 2, 666, 667, 7, 110, 2, 2, 667, 671,
-	3, 2, 2, 2, 668, 669, 7, 109, 2, 2, 669, 671, 7, 110, 2, 2, 670, 657, 3,
-	2, 2, 2, 670, 668, 3, 2, 2, 2, 671, 143, 3, 2, 2, 2, 672, 673, 7, 6, 2,
-	2, 673, 674, 7, 98, 2, 2, 674, 675, 5, 148, 75, 2, 675, 145, 3, 2, 2, 2,
-	676, 677, 7, 111, 2, 2, 677, 682, 5, 148, 75, 2, 678, 679, 7, 108, 2, 2,
-	679, 681, 5, 148, 75, 2, 680, 678, 3, 2, 2, 2, 681, 684, 3, 2, 2, 2, 682,
-	680, 3, 2, 2, 2, 682, 683, 3, 2, 2, 2, 683, 685, 3, 2, 2, 2, 684, 682,
-	3, 2, 2, 2, 685, 686, 7, 112, 2, 2, 686, 690, 3, 2, 2, 2, 687, 688, 7,
-	111, 2, 2, 688, 690, 7, 112, 2, 2, 689, 676, 3, 2, 2, 2, 689, 687, 3, 2,
-	2, 2, 690, 147, 3, 2, 2, 2, 691, 700, 7, 6, 2, 2, 692, 700, 5, 150, 76,
-	2, 693, 700, 5, 152, 77, 2, 694, 700, 5, 142, 72, 2, 695, 700, 5, 146,
-	74, 2, 696, 700, 7, 3, 2, 2, 697, 700, 7, 4, 2, 2, 698, 700, 7, 5, 2, 2,
-	699, 691, 3, 2, 2, 2, 699, 692, 3, 2, 2, 2, 699, 693, 3, 2, 2, 2, 699,
-	694, 3, 2, 2, 2, 699, 695, 3, 2, 2, 2, 699, 696, 3, 2, 2, 2, 699, 697,
-	3, 2, 2, 2, 699, 698, 3, 2, 2, 2, 700, 149, 3, 2, 2, 2, 701, 703, 9, 10,
-	2, 2, 702, 701, 3, 2, 2, 2, 702, 703, 3, 2, 2, 2, 703, 704, 3, 2, 2, 2,
-	704, 705, 7, 122, 2, 2, 705, 151, 3, 2, 2, 2, 706, 708, 9, 10, 2, 2, 707,
-	706, 3, 2, 2, 2, 707, 708, 3, 2, 2, 2, 708, 709, 3, 2, 2, 2, 709, 710,
-	7, 123, 2, 2, 710, 153, 3, 2, 2, 2, 711, 712, 7, 53, 2, 2, 712, 713, 7,
-	122, 2, 2, 713, 155, 3, 2, 2, 2, 714, 715, 5, 162, 82, 2, 715, 157, 3,
-	2, 2, 2, 716, 717, 5, 162, 82, 2, 717, 159, 3, 2, 2, 2, 718, 719, 5, 162,
-	82, 2, 719, 161, 3, 2, 2, 2, 720, 723, 7, 121, 2, 2, 721, 723, 5, 164,
-	83, 2, 722, 720, 3, 2, 2, 2, 722, 721, 3, 2, 2, 2, 723, 731, 3, 2, 2, 2,
-	724, 727, 7, 97, 2, 2, 725, 728, 7, 121, 2, 2, 726, 728, 5, 164, 83, 2,
-	727, 725, 3, 2, 2, 2, 727, 726, 3, 2, 2, 2, 728, 730, 3, 2, 2, 2, 729,
-	724, 3, 2, 2, 2, 730, 733, 3, 2, 2, 2, 731, 729, 3, 2, 2, 2, 731, 732,
-	3, 2, 2, 2, 732, 163, 3, 2, 2, 2, 733, 731, 3, 2, 2, 2, 734, 735, 9, 11,
-	2, 2, 735, 165, 3, 2, 2, 2, 64, 191, 230, 235, 246, 251, 265, 270, 296,
-	299, 305, 311, 314, 334, 337, 350, 355, 358, 361, 364, 367, 377, 382, 403,
-	416, 418, 434, 442, 448, 455, 463, 477, 483, 489, 493, 498, 510, 513, 520,
-	529, 541, 549, 561, 569, 588, 598, 612, 614, 625, 636, 641, 645, 649, 663,
-	670, 682, 689, 699, 702, 707, 722, 727, 731,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 5, 3, 270, 10, 3, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3,
+	6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3,
+	8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3,
+	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 309, 10, 10, 3, 10,
+	3, 10, 3, 10, 5, 10, 314, 10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3,
+	12, 3, 12, 3, 12, 3, 12, 5, 12, 325, 10, 12, 3, 12, 3, 12, 3, 12, 5, 12,
+	330, 10, 12, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 336, 10, 13, 3, 14, 3,
+	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15,
+	5, 15, 350, 10, 15, 3, 15, 3, 15, 3, 15, 5, 15, 355, 10, 15, 3, 16, 3,
+	16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19,
+	3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3,
+	21, 3, 21, 5, 21, 381, 10, 21, 3, 21, 5, 21, 384, 10, 21, 3, 22, 3, 22,
+	3, 22, 3, 22, 5, 22, 390, 10, 22, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 396,
+	10, 22, 3, 22, 5, 22, 399, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3,
+	24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25,
+	3, 25, 3, 25, 5, 25, 419, 10, 25, 3, 25, 5, 25, 422, 10, 25, 3, 26, 3,
+	26, 3, 26, 3, 26, 3, 26, 5, 26, 429, 10, 26, 3, 26, 3, 26, 3, 26, 3, 26,
+	5, 26, 435, 10, 26, 3, 26, 5, 26, 438, 10, 26, 3, 27, 3, 27, 3, 27, 3,
+	27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28,
+	3, 28, 3, 28, 5, 28, 456, 10, 28, 3, 29, 3, 29, 3, 30, 3, 30, 3, 31, 3,
+	31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
+	3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40,
+	3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 499, 10, 40, 3, 40, 3, 40, 3, 40, 3,
+	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 511, 10, 40, 3, 41,
+	3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41, 519, 10, 41, 3, 41, 3, 41, 3,
+	41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41, 531, 10, 41,
+	3, 42, 3, 42, 3, 43, 3, 43, 5, 43, 537, 10, 43, 3, 44, 3, 44, 3, 45, 3,
+	45, 3, 45, 3, 45, 5, 45, 545, 10, 45, 3, 46, 3, 46, 3, 47, 3, 47, 3, 48,
+	3, 48, 3, 49, 5, 49, 554, 10, 49, 3, 49, 3, 49, 3, 49, 5, 49, 559, 10,
+	49, 3, 49, 5, 49, 562, 10, 49, 3, 49, 5, 49, 565, 10, 49, 3, 49, 5, 49,
+	568, 10, 49, 3, 49, 5, 49, 571, 10, 49, 3, 49, 5, 49, 574, 10, 49, 3, 49,
+	5, 49, 577, 10, 49, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3,
+	52, 3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 591, 10, 52, 3, 52, 3, 52, 3, 53,
+	3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3,
+	53, 3, 53, 3, 53, 3, 53, 5, 53, 610, 10, 53, 3, 54, 3, 54, 3, 54, 3, 54,
+	3, 54, 5, 54, 617, 10, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3,
+	57, 3, 57, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 7, 59, 632, 10, 59, 12, 59,
+	14, 59, 635, 11, 59, 3, 60, 3, 60, 5, 60, 639, 10, 60, 3, 61, 3, 61, 3,
+	61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64,
+	3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 5, 65, 660, 10, 65, 3, 66, 3,
+	66, 3, 66, 3, 66, 7, 66, 666, 10, 66, 12, 66, 14, 66, 669, 11, 66, 3, 66,
+	3, 66, 5, 66, 673, 10, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 680,
+	10, 66, 3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68,
+	3, 68, 3, 68, 5, 68, 693, 10, 68, 5, 68, 695, 10, 68, 3, 69, 3, 69, 3,
+	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69,
+	3, 69, 5, 69, 711, 10, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 5,
+	69, 719, 10, 69, 3, 69, 3, 69, 3, 69, 3, 69, 5, 69, 725, 10, 69, 3, 69,
+	3, 69, 3, 69, 7, 69, 730, 10, 69, 12, 69, 14, 69, 733, 11, 69, 3, 70, 3,
+	70, 3, 70, 7, 70, 738, 10, 70, 12, 70, 14, 70, 741, 11, 70, 3, 71, 3, 71,
+	3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 7, 72, 752, 10, 72, 12,
+	72, 14, 72, 755, 11, 72, 3, 73, 3, 73, 3, 73, 5, 73, 760, 10, 73, 3, 74,
+	3, 74, 3, 74, 3, 74, 3, 74, 5, 74, 767, 10, 74, 3, 75, 3, 75, 5, 75, 771,
+	10, 75, 3, 76, 3, 76, 3, 76, 5, 76, 776, 10, 76, 3, 76, 3, 76, 3, 77, 3,
+	77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 5, 77, 788, 10, 77, 3, 77,
+	5, 77, 791, 10, 77, 3, 78, 3, 78, 3, 78, 7, 78, 796, 10, 78, 12, 78, 14,
+	78, 799, 11, 78, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79,
+	5, 79, 809, 10, 79, 5, 79, 811, 10, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3,
+	80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80,
+	3, 80, 5, 80, 829, 10, 80, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3,
+	83, 3, 83, 7, 83, 839, 10, 83, 12, 83, 14, 83, 842, 11, 83, 3, 84, 3, 84,
+	3, 84, 7, 84, 847, 10, 84, 12, 84, 14, 84, 850, 11, 84, 3, 85, 3, 85, 3,
+	85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 5, 86, 861, 10, 86, 3, 86,
+	3, 86, 3, 86, 3, 86, 7, 86, 867, 10, 86, 12, 86, 14, 86, 870, 11, 86, 3,
+	87, 3, 87, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90,
+	3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 5, 90, 888, 10, 90, 3, 91, 3, 91, 3,
+	91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 5, 91, 898, 10, 91, 3, 91, 3, 91,
+	3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 7,
+	91, 912, 10, 91, 12, 91, 14, 91, 915, 11, 91, 3, 92, 3, 92, 3, 92, 3, 93,
+	3, 93, 3, 94, 3, 94, 3, 94, 5, 94, 925, 10, 94, 3, 94, 3, 94, 3, 94, 3,
+	94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 5, 94, 938, 10, 94,
+	3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 7, 96, 945, 10, 96, 12, 96, 14, 96,
+	948, 11, 96, 3, 97, 3, 97, 5, 97, 952, 10, 97, 3, 98, 3, 98, 5, 98, 956,
+	10, 98, 3, 98, 3, 98, 5, 98, 960, 10, 98, 3, 99, 3, 99, 3, 99, 3, 99, 3,
+	100, 3, 100, 3, 101, 3, 101, 3, 101, 3, 101, 7, 101, 972, 10, 101, 12,
+	101, 14, 101, 975, 11, 101, 3, 101, 3, 101, 3, 101, 3, 101, 5, 101, 981,
+	10, 101, 3, 102, 3, 102, 3, 102, 3, 102, 3, 103, 3, 103, 3, 103, 3, 103,
+	7, 103, 991, 10, 103, 12, 103, 14, 103, 994, 11, 103, 3, 103, 3, 103, 3,
+	103, 3, 103, 5, 103, 1000, 10, 103, 3, 104, 3, 104, 3, 104, 3, 104, 3,
+	104, 3, 104, 3, 104, 3, 104, 5, 104, 1010, 10, 104, 3, 105, 5, 105, 1013,
+	10, 105, 3, 105, 3, 105, 3, 106, 5, 106, 1018, 10, 106, 3, 106, 3, 106,
+	3, 107, 3, 107, 3, 107, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 109,
+	3, 109, 3, 109, 3, 110, 3, 110, 3, 111, 3, 111, 3, 112, 3, 112, 3, 113,
+	3, 113, 5, 113, 1041, 10, 113, 3, 113, 3, 113, 3, 113, 5, 113, 1046, 10,
+	113, 7, 113, 1048, 10, 113, 12, 113, 14, 113, 1051, 11, 113, 3, 114, 3,
+	114, 3, 114, 2, 5, 136, 170, 180, 115, 2, 4, 6, 8, 10, 12, 14, 16, 18,
+	20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54,
+	56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90,
+	92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120,
+	122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150,
+	152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180,
+	182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210,
+	212, 214, 216, 218, 220, 222, 224, 226, 2, 13, 3, 2, 30, 31, 3, 2, 23,
+	24, 3, 2, 44, 46, 3, 2, 75, 76, 4, 2, 78, 79, 171, 172, 3, 2, 81, 82, 4,
+	2, 83, 83, 155, 155, 3, 2, 139, 145, 5, 2, 95, 95, 97, 126, 132, 138, 3,
+	2, 164, 165, 3, 2, 7, 145, 2, 1088, 2, 228, 3, 2, 2, 2, 4, 269, 3, 2, 2,
+	2, 6, 271, 3, 2, 2, 2, 8, 274, 3, 2, 2, 2, 10, 277, 3, 2, 2, 2, 12, 280,
+	3, 2, 2, 2, 14, 284, 3, 2, 2, 2, 16, 292, 3, 2, 2, 2, 18, 300, 3, 2, 2,
+	2, 20, 315, 3, 2, 2, 2, 22, 319, 3, 2, 2, 2, 24, 331, 3, 2, 2, 2, 26, 337,
+	3, 2, 2, 2, 28, 343, 3, 2, 2, 2, 30, 356, 3, 2, 2, 2, 32, 360, 3, 2, 2,
+	2, 34, 363, 3, 2, 2, 2, 36, 367, 3, 2, 2, 2, 38, 371, 3, 2, 2, 2, 40, 374,
+	3, 2, 2, 2, 42, 385, 3, 2, 2, 2, 44, 400, 3, 2, 2, 2, 46, 404, 3, 2, 2,
+	2, 48, 409, 3, 2, 2, 2, 50, 423, 3, 2, 2, 2, 52, 439, 3, 2, 2, 2, 54, 445,
+	3, 2, 2, 2, 56, 457, 3, 2, 2, 2, 58, 459, 3, 2, 2, 2, 60, 461, 3, 2, 2,
+	2, 62, 463, 3, 2, 2, 2, 64, 465, 3, 2, 2, 2, 66, 467, 3, 2, 2, 2, 68, 474,
+	3, 2, 2, 2, 70, 478, 3, 2, 2, 2, 72, 481, 3, 2, 2, 2, 74, 485, 3, 2, 2,
+	2, 76, 489, 3, 2, 2, 2, 78, 510, 3, 2, 2, 2, 80, 530, 3, 2, 2, 2, 82, 532,
+	3, 2, 2, 2, 84, 536, 3, 2, 2, 2, 86, 538, 3, 2, 2, 2, 88, 544, 3, 2, 2,
+	2, 90, 546, 3, 2, 2, 2, 92, 548, 3, 2, 2, 2, 94, 550, 3, 2, 2, 2, 96, 553,
+	3, 2, 2, 2, 98, 578, 3, 2, 2, 2, 100, 581, 3, 2, 2, 2, 102, 585, 3, 2,
+	2, 2, 104, 609, 3, 2, 2, 2, 106, 611, 3, 2, 2, 2, 108, 618, 3, 2, 2, 2,
+	110, 622, 3, 2, 2, 2, 112, 624, 3, 2, 2, 2, 114, 626, 3, 2, 2, 2, 116,
+	628, 3, 2, 2, 2, 118, 636, 3, 2, 2, 2, 120, 640, 3, 2, 2, 2, 122, 643,
+	3, 2, 2, 2, 124, 647, 3, 2, 2, 2, 126, 651, 3, 2, 2, 2, 128, 655, 3, 2,
+	2, 2, 130, 679, 3, 2, 2, 2, 132, 681, 3, 2, 2, 2, 134, 694, 3, 2, 2, 2,
+	136, 724, 3, 2, 2, 2, 138, 734, 3, 2, 2, 2, 140, 742, 3, 2, 2, 2, 142,
+	748, 3, 2, 2, 2, 144, 756, 3, 2, 2, 2, 146, 761, 3, 2, 2, 2, 148, 768,
+	3, 2, 2, 2, 150, 772, 3, 2, 2, 2, 152, 779, 3, 2, 2, 2, 154, 792, 3, 2,
+	2, 2, 156, 810, 3, 2, 2, 2, 158, 828, 3, 2, 2, 2, 160, 830, 3, 2, 2, 2,
+	162, 832, 3, 2, 2, 2, 164, 836, 3, 2, 2, 2, 166, 843, 3, 2, 2, 2, 168,
+	851, 3, 2, 2, 2, 170, 860, 3, 2, 2, 2, 172, 871, 3, 2, 2, 2, 174, 873,
+	3, 2, 2, 2, 176, 875, 3, 2, 2, 2, 178, 887, 3, 2, 2, 2, 180, 897, 3, 2,
+	2, 2, 182, 916, 3, 2, 2, 2, 184, 919, 3, 2, 2, 2, 186, 937, 3, 2, 2, 2,
+	188, 939, 3, 2, 2, 2, 190, 941, 3, 2, 2, 2, 192, 951, 3, 2, 2, 2, 194,
+	959, 3, 2, 2, 2, 196, 961, 3, 2, 2, 2, 198, 965, 3, 2, 2, 2, 200, 980,
+	3, 2, 2, 2, 202, 982, 3, 2, 2, 2, 204, 999, 3, 2, 2, 2, 206, 1009, 3, 2,
+	2, 2, 208, 1012, 3, 2, 2, 2, 210, 1017, 3, 2, 2, 2, 212, 1021, 3, 2, 2,
+	2, 214, 1024, 3, 2, 2, 2, 216, 1029, 3, 2, 2, 2, 218, 1032, 3, 2, 2, 2,
+	220, 1034, 3, 2, 2, 2, 222, 1036, 3, 2, 2, 2, 224, 1040, 3, 2, 2, 2, 226,
+	1052, 3, 2, 2, 2, 228, 229, 5, 4, 3, 2, 229, 230, 7, 2, 2, 3, 230, 3, 3,
+	2, 2, 2, 231, 270, 5, 8, 5, 2, 232, 270, 5, 12, 7, 2, 233, 270, 5, 14,
+	8, 2, 234, 270, 5, 16, 9, 2, 235, 270, 5, 18, 10, 2, 236, 270, 5, 10, 6,
+	2, 237, 270, 5, 20, 11, 2, 238, 270, 5, 26, 14, 2, 239, 270, 5, 28, 15,
+	2, 240, 270, 5, 30, 16, 2, 241, 270, 5, 22, 12, 2, 242, 270, 5, 24, 13,
+	2, 243, 270, 5, 32, 17, 2, 244, 270, 5, 38, 20, 2, 245, 270, 5, 6, 4, 2,
+	246, 270, 5, 40, 21, 2, 247, 270, 5, 42, 22, 2, 248, 270, 5, 44, 23, 2,
+	249, 270, 5, 46, 24, 2, 250, 270, 5, 48, 25, 2, 251, 270, 5, 50, 26, 2,
+	252, 270, 5, 52, 27, 2, 253, 270, 5, 54, 28, 2, 254, 270, 5, 96, 49, 2,
+	255, 270, 5, 100, 51, 2, 256, 270, 5, 102, 52, 2, 257, 270, 5, 106, 54,
+	2, 258, 270, 5, 108, 55, 2, 259, 270, 5, 34, 18, 2, 260, 270, 5, 36, 19,
+	2, 261, 270, 5, 66, 34, 2, 262, 270, 5, 68, 35, 2, 263, 270, 5, 70, 36,
+	2, 264, 270, 5, 72, 37, 2, 265, 270, 5, 74, 38, 2, 266, 270, 5, 76, 39,
+	2, 267, 270, 5, 78, 40, 2, 268, 270, 5, 80, 41, 2, 269, 231, 3, 2, 2, 2,
+	269, 232, 3, 2, 2, 2, 269, 233, 3, 2, 2, 2, 269, 234, 3, 2, 2, 2, 269,
+	235, 3, 2, 2, 2, 269, 236, 3, 2, 2, 2, 269, 237, 3, 2, 2, 2, 269, 238,
+	3, 2, 2, 2, 269, 239, 3, 2, 2, 2, 269, 240, 3, 2, 2, 2, 269, 241, 3, 2,
+	2, 2, 269, 242, 3, 2, 2, 2, 269, 243, 3, 2, 2, 2, 269, 244, 3, 2, 2, 2,
+	269, 245, 3, 2, 2, 2, 269, 246, 3, 2, 2, 2, 269, 247, 3, 2, 2, 2, 269,
+	248, 3, 2, 2, 2, 269, 249, 3, 2, 2, 2, 269, 250, 3, 2, 2, 2, 269, 251,
+	3, 2, 2, 2, 269, 252, 3, 2, 2, 2, 269, 253, 3, 2, 2, 2, 269, 254, 3, 2,
+	2, 2, 269, 255, 3, 2, 2, 2, 269, 256, 3, 2, 2, 2, 269, 257, 3, 2, 2, 2,
+	269, 258, 3, 2, 2, 2, 269, 259, 3, 2, 2, 2, 269, 260, 3, 2, 2, 2, 269,
+	261, 3, 2, 2, 2, 269, 262, 3, 2, 2, 2, 269, 263, 3, 2, 2, 2, 269, 264,
+	3, 2, 2, 2, 269, 265, 3, 2, 2, 2, 269, 266, 3, 2, 2, 2, 269, 267, 3, 2,
+	2, 2, 269, 268, 3, 2, 2, 2, 270, 5, 3, 2, 2, 2, 271, 272, 7, 22, 2, 2,
+	272, 273, 5, 224, 113, 2, 273, 7, 3, 2, 2, 2, 274, 275, 7, 21, 2, 2, 275,
+	276, 7, 25, 2, 2, 276, 9, 3, 2, 2, 2, 277, 278, 7, 21, 2, 2, 278, 279,
+	7, 29, 2, 2, 279, 11, 3, 2, 2, 2, 280, 281, 7, 21, 2, 2, 281, 282, 7, 26,
+	2, 2, 282, 283, 7, 27, 2, 2, 283, 13, 3, 2, 2, 2, 284, 285, 7, 21, 2, 2,
+	285, 286, 7, 31, 2, 2, 286, 287, 7, 26, 2, 2, 287, 288, 7, 66, 2, 2, 288,
+	289, 5, 64, 33, 2, 289, 290, 7, 67, 2, 2, 290, 291, 5, 126, 64, 2, 291,
+	15, 3, 2, 2, 2, 292, 293, 7, 21, 2, 2, 293, 294, 7, 25, 2, 2, 294, 295,
+	7, 26, 2, 2, 295, 296, 7, 66, 2, 2, 296, 297, 5, 64, 33, 2, 297, 298, 7,
+	67, 2, 2, 298, 299, 5, 126, 64, 2, 299, 17, 3, 2, 2, 2, 300, 301, 7, 21,
+	2, 2, 301, 302, 7, 30, 2, 2, 302, 303, 7, 26, 2, 2, 303, 304, 7, 66, 2,
+	2, 304, 305, 5, 64, 33, 2, 305, 308, 7, 67, 2, 2, 306, 309, 5, 122, 62,
+	2, 307, 309, 5, 126, 64, 2, 308, 306, 3, 2, 2, 2, 308, 307, 3, 2, 2, 2,
+	309, 310, 3, 2, 2, 2, 310, 313, 7, 75, 2, 2, 311, 314, 5, 122, 62, 2, 312,
+	314, 5, 126, 64, 2, 313, 311, 3, 2, 2, 2, 313, 312, 3, 2, 2, 2, 314, 19,
+	3, 2, 2, 2, 315, 316, 7, 21, 2, 2, 316, 317, 9, 2, 2, 2, 317, 318, 7, 32,
+	2, 2, 318, 21, 3, 2, 2, 2, 319, 320, 7, 21, 2, 2, 320, 321, 7, 14, 2, 2,
+	321, 324, 7, 67, 2, 2, 322, 325, 5, 122, 62, 2, 323, 325, 5, 124, 63, 2,
+	324, 322, 3, 2, 2, 2, 324, 323, 3, 2, 2, 2, 325, 326, 3, 2, 2, 2, 326,
+	329, 7, 75, 2, 2, 327, 330, 5, 122, 62, 2, 328, 330, 5, 124, 63, 2, 329,
+	327, 3, 2, 2, 2, 329, 328, 3, 2, 2, 2, 330, 23, 3, 2, 2, 2, 331, 332, 7,
+	21, 2, 2, 332, 335, 7, 38, 2, 2, 333, 334, 7, 67, 2, 2, 334, 336, 5, 124,
+	63, 2, 335, 333, 3, 2, 2, 2, 335, 336, 3, 2, 2, 2, 336, 25, 3, 2, 2, 2,
+	337, 338, 7, 21, 2, 2, 338, 339, 7, 31, 2, 2, 339, 340, 7, 56, 2, 2, 340,
+	341, 7, 67, 2, 2, 341, 342, 5, 140, 71, 2, 342, 27, 3, 2, 2, 2, 343, 344,
+	7, 21, 2, 2, 344, 345, 7, 30, 2, 2, 345, 346, 7, 56, 2, 2, 346, 349, 7,
+	67, 2, 2, 347, 350, 5, 122, 62, 2, 348, 350, 5, 140, 71, 2, 349, 347, 3,
+	2, 2, 2, 349, 348, 3, 2, 2, 2, 350, 351, 3, 2, 2, 2, 351, 354, 7, 75, 2,
+	2, 352, 355, 5, 122, 62, 2, 353, 355, 5, 140, 71, 2, 354, 352, 3, 2, 2,
+	2, 354, 353, 3, 2, 2, 2, 355, 29, 3, 2, 2, 2, 356, 357, 7, 7, 2, 2, 357,
+	358, 7, 30, 2, 2, 358, 359, 5, 198, 100, 2, 359, 31, 3, 2, 2, 2, 360, 361,
+	7, 21, 2, 2, 361, 362, 7, 33, 2, 2, 362, 33, 3, 2, 2, 2, 363, 364, 7, 7,
+	2, 2, 364, 365, 7, 50, 2, 2, 365, 366, 5, 198, 100, 2, 366, 35, 3, 2, 2,
+	2, 367, 368, 7, 10, 2, 2, 368, 369, 7, 50, 2, 2, 369, 370, 5, 62, 32, 2,
+	370, 37, 3, 2, 2, 2, 371, 372, 7, 21, 2, 2, 372, 373, 7, 51, 2, 2, 373,
+	39, 3, 2, 2, 2, 374, 375, 7, 21, 2, 2, 375, 380, 7, 53, 2, 2, 376, 377,
+	7, 67, 2, 2, 377, 378, 7, 52, 2, 2, 378, 379, 7, 148, 2, 2, 379, 381, 5,
+	56, 29, 2, 380, 376, 3, 2, 2, 2, 380, 381, 3, 2, 2, 2, 381, 383, 3, 2,
+	2, 2, 382, 384, 5, 212, 107, 2, 383, 382, 3, 2, 2, 2, 383, 384, 3, 2, 2,
+	2, 384, 41, 3, 2, 2, 2, 385, 386, 7, 21, 2, 2, 386, 389, 7, 55, 2, 2, 387,
+	388, 7, 20, 2, 2, 388, 390, 5, 60, 31, 2, 389, 387, 3, 2, 2, 2, 389, 390,
+	3, 2, 2, 2, 390, 395, 3, 2, 2, 2, 391, 392, 7, 67, 2, 2, 392, 393, 7, 56,
+	2, 2, 393, 394, 7, 148, 2, 2, 394, 396, 5, 56, 29, 2, 395, 391, 3, 2, 2,
+	2, 395, 396, 3, 2, 2, 2, 396, 398, 3, 2, 2, 2, 397, 399, 5, 212, 107, 2,
+	398, 397, 3, 2, 2, 2, 398, 399, 3, 2, 2, 2, 399, 43, 3, 2, 2, 2, 400, 401,
+	7, 21, 2, 2, 401, 402, 7, 58, 2, 2, 402, 403, 5, 128, 65, 2, 403, 45, 3,
+	2, 2, 2, 404, 405, 7, 21, 2, 2, 405, 406, 7, 59, 2, 2, 406, 407, 7, 61,
+	2, 2, 407, 408, 5, 128, 65, 2, 408, 47, 3, 2, 2, 2, 409, 410, 7, 21, 2,
+	2, 410, 411, 7, 59, 2, 2, 411, 412, 7, 64, 2, 2, 412, 413, 5, 128, 65,
+	2, 413, 414, 7, 63, 2, 2, 414, 415, 7, 62, 2, 2, 415, 416, 7, 148, 2, 2,
+	416, 418, 5, 58, 30, 2, 417, 419, 5, 132, 67, 2, 418, 417, 3, 2, 2, 2,
+	418, 419, 3, 2, 2, 2, 419, 421, 3, 2, 2, 2, 420, 422, 5, 212, 107, 2, 421,
+	420, 3, 2, 2, 2, 421, 422, 3, 2, 2, 2, 422, 49, 3, 2, 2, 2, 423, 424, 7,
+	21, 2, 2, 424, 425, 7, 56, 2, 2, 425, 428, 7, 39, 2, 2, 426, 427, 7, 20,
+	2, 2, 427, 429, 5, 60, 31, 2, 428, 426, 3, 2, 2, 2, 428, 429, 3, 2, 2,
+	2, 429, 434, 3, 2, 2, 2, 430, 431, 7, 67, 2, 2, 431, 432, 7, 56, 2, 2,
+	432, 433, 7, 148, 2, 2, 433, 435, 5, 56, 29, 2, 434, 430, 3, 2, 2, 2, 434,
+	435, 3, 2, 2, 2, 435, 437, 3, 2, 2, 2, 436, 438, 5, 212, 107, 2, 437, 436,
+	3, 2, 2, 2, 437, 438, 3, 2, 2, 2, 438, 51, 3, 2, 2, 2, 439, 440, 7, 21,
+	2, 2, 440, 441, 7, 59, 2, 2, 441, 442, 7, 62, 2, 2, 442, 443, 7, 39, 2,
+	2, 443, 444, 5, 128, 65, 2, 444, 53, 3, 2, 2, 2, 445, 446, 7, 21, 2, 2,
+	446, 447, 7, 59, 2, 2, 447, 448, 7, 65, 2, 2, 448, 449, 7, 39, 2, 2, 449,
+	450, 5, 128, 65, 2, 450, 451, 7, 63, 2, 2, 451, 452, 7, 62, 2, 2, 452,
+	453, 7, 148, 2, 2, 453, 455, 5, 58, 30, 2, 454, 456, 5, 212, 107, 2, 455,
+	454, 3, 2, 2, 2, 455, 456, 3, 2, 2, 2, 456, 55, 3, 2, 2, 2, 457, 458, 5,
+	224, 113, 2, 458, 57, 3, 2, 2, 2, 459, 460, 5, 224, 113, 2, 460, 59, 3,
+	2, 2, 2, 461, 462, 5, 224, 113, 2, 462, 61, 3, 2, 2, 2, 463, 464, 5, 224,
+	113, 2, 464, 63, 3, 2, 2, 2, 465, 466, 9, 3, 2, 2, 466, 65, 3, 2, 2, 2,
+	467, 468, 7, 7, 2, 2, 468, 469, 7, 34, 2, 2, 469, 470, 5, 90, 46, 2, 470,
+	471, 7, 63, 2, 2, 471, 472, 7, 40, 2, 2, 472, 473, 5, 94, 48, 2, 473, 67,
+	3, 2, 2, 2, 474, 475, 7, 10, 2, 2, 475, 476, 7, 34, 2, 2, 476, 477, 5,
+	90, 46, 2, 477, 69, 3, 2, 2, 2, 478, 479, 7, 21, 2, 2, 479, 480, 7, 35,
+	2, 2, 480, 71, 3, 2, 2, 2, 481, 482, 7, 7, 2, 2, 482, 483, 7, 36, 2, 2,
+	483, 484, 5, 92, 47, 2, 484, 73, 3, 2, 2, 2, 485, 486, 7, 10, 2, 2, 486,
+	487, 7, 36, 2, 2, 487, 488, 5, 92, 47, 2, 488, 75, 3, 2, 2, 2, 489, 490,
+	7, 21, 2, 2, 490, 491, 7, 37, 2, 2, 491, 77, 3, 2, 2, 2, 492, 493, 7, 41,
+	2, 2, 493, 494, 5, 82, 42, 2, 494, 495, 7, 20, 2, 2, 495, 498, 5, 84, 43,
+	2, 496, 497, 7, 52, 2, 2, 497, 499, 5, 86, 44, 2, 498, 496, 3, 2, 2, 2,
+	498, 499, 3, 2, 2, 2, 499, 500, 3, 2, 2, 2, 500, 501, 7, 43, 2, 2, 501,
+	502, 5, 88, 45, 2, 502, 511, 3, 2, 2, 2, 503, 504, 7, 41, 2, 2, 504, 505,
+	7, 36, 2, 2, 505, 506, 5, 92, 47, 2, 506, 507, 7, 43, 2, 2, 507, 508, 7,
+	34, 2, 2, 508, 509, 5, 90, 46, 2, 509, 511, 3, 2, 2, 2, 510, 492, 3, 2,
+	2, 2, 510, 503, 3, 2, 2, 2, 511, 79, 3, 2, 2, 2, 512, 513, 7, 42, 2, 2,
+	513, 514, 5, 82, 42, 2, 514, 515, 7, 20, 2, 2, 515, 518, 5, 84, 43, 2,
+	516, 517, 7, 52, 2, 2, 517, 519, 5, 86, 44, 2, 518, 516, 3, 2, 2, 2, 518,
+	519, 3, 2, 2, 2, 519, 520, 3, 2, 2, 2, 520, 521, 7, 66, 2, 2, 521, 522,
+	5, 88, 45, 2, 522, 531, 3, 2, 2, 2, 523, 524, 7, 42, 2, 2, 524, 525, 7,
+	36, 2, 2, 525, 526, 5, 92, 47, 2, 526, 527, 7, 66, 2, 2, 527, 528, 7, 34,
+	2, 2, 528, 529, 5, 90, 46, 2, 529, 531, 3, 2, 2, 2, 530, 512, 3, 2, 2,
+	2, 530, 523, 3, 2, 2, 2, 531, 81, 3, 2, 2, 2, 532, 533, 9, 4, 2, 2, 533,
+	83, 3, 2, 2, 2, 534, 537, 5, 224, 113, 2, 535, 537, 7, 167, 2, 2, 536,
+	534, 3, 2, 2, 2, 536, 535, 3, 2, 2, 2, 537, 85, 3, 2, 2, 2, 538, 539, 5,
+	224, 113, 2, 539, 87, 3, 2, 2, 2, 540, 541, 7, 34, 2, 2, 541, 545, 5, 90,
+	46, 2, 542, 543, 7, 36, 2, 2, 543, 545, 5, 92, 47, 2, 544, 540, 3, 2, 2,
+	2, 544, 542, 3, 2, 2, 2, 545, 89, 3, 2, 2, 2, 546, 547, 5, 224, 113, 2,
+	547, 91, 3, 2, 2, 2, 548, 549, 5, 224, 113, 2, 549, 93, 3, 2, 2, 2, 550,
+	551, 5, 224, 113, 2, 551, 95, 3, 2, 2, 2, 552, 554, 7, 71, 2, 2, 553, 552,
+	3, 2, 2, 2, 553, 554, 3, 2, 2, 2, 554, 555, 3, 2, 2, 2, 555, 556, 5, 98,
+	50, 2, 556, 558, 5, 130, 66, 2, 557, 559, 5, 132, 67, 2, 558, 557, 3, 2,
+	2, 2, 558, 559, 3, 2, 2, 2, 559, 561, 3, 2, 2, 2, 560, 562, 5, 152, 77,
+	2, 561, 560, 3, 2, 2, 2, 561, 562, 3, 2, 2, 2, 562, 564, 3, 2, 2, 2, 563,
+	565, 5, 162, 82, 2, 564, 563, 3, 2, 2, 2, 564, 565, 3, 2, 2, 2, 565, 567,
+	3, 2, 2, 2, 566, 568, 5, 212, 107, 2, 567, 566, 3, 2, 2, 2, 567, 568, 3,
+	2, 2, 2, 568, 570, 3, 2, 2, 2, 569, 571, 5, 214, 108, 2, 570, 569, 3, 2,
+	2, 2, 570, 571, 3, 2, 2, 2, 571, 573, 3, 2, 2, 2, 572, 574, 7, 72, 2, 2,
+	573, 572, 3, 2, 2, 2, 573, 574, 3, 2, 2, 2, 574, 576, 3, 2, 2, 2, 575,
+	577, 5, 216, 109, 2, 576, 575, 3, 2, 2, 2, 576, 577, 3, 2, 2, 2, 577, 97,
+	3, 2, 2, 2, 578, 579, 7, 73, 2, 2, 579, 580, 5, 116, 59, 2, 580, 99, 3,
+	2, 2, 2, 581, 582, 7, 47, 2, 2, 582, 583, 5, 128, 65, 2, 583, 584, 5, 132,
+	67, 2, 584, 101, 3, 2, 2, 2, 585, 586, 7, 48, 2, 2, 586, 587, 7, 56, 2,
+	2, 587, 590, 5, 218, 110, 2, 588, 589, 7, 20, 2, 2, 589, 591, 5, 60, 31,
+	2, 590, 588, 3, 2, 2, 2, 590, 591, 3, 2, 2, 2, 591, 592, 3, 2, 2, 2, 592,
+	593, 5, 104, 53, 2, 593, 103, 3, 2, 2, 2, 594, 595, 7, 49, 2, 2, 595, 596,
+	7, 57, 2, 2, 596, 597, 5, 110, 56, 2, 597, 598, 7, 43, 2, 2, 598, 599,
+	5, 112, 57, 2, 599, 610, 3, 2, 2, 2, 600, 601, 7, 10, 2, 2, 601, 602, 7,
+	57, 2, 2, 602, 610, 5, 110, 56, 2, 603, 604, 7, 48, 2, 2, 604, 605, 7,
+	57, 2, 2, 605, 606, 5, 110, 56, 2, 606, 607, 7, 28, 2, 2, 607, 608, 5,
+	114, 58, 2, 608, 610, 3, 2, 2, 2, 609, 594, 3, 2, 2, 2, 609, 600, 3, 2,
+	2, 2, 609, 603, 3, 2, 2, 2, 610, 105, 3, 2, 2, 2, 611, 612, 7, 10, 2, 2,
+	612, 613, 7, 56, 2, 2, 613, 616, 5, 218, 110, 2, 614, 615, 7, 20, 2, 2,
+	615, 617, 5, 60, 31, 2, 616, 614, 3, 2, 2, 2, 616, 617, 3, 2, 2, 2, 617,
+	107, 3, 2, 2, 2, 618, 619, 7, 10, 2, 2, 619, 620, 7, 52, 2, 2, 620, 621,
+	5, 60, 31, 2, 621, 109, 3, 2, 2, 2, 622, 623, 5, 224, 113, 2, 623, 111,
+	3, 2, 2, 2, 624, 625, 5, 224, 113, 2, 625, 113, 3, 2, 2, 2, 626, 627, 5,
+	224, 113, 2, 627, 115, 3, 2, 2, 2, 628, 633, 5, 118, 60, 2, 629, 630, 7,
+	157, 2, 2, 630, 632, 5, 118, 60, 2, 631, 629, 3, 2, 2, 2, 632, 635, 3,
+	2, 2, 2, 633, 631, 3, 2, 2, 2, 633, 634, 3, 2, 2, 2, 634, 117, 3, 2, 2,
+	2, 635, 633, 3, 2, 2, 2, 636, 638, 5, 180, 91, 2, 637, 639, 5, 120, 61,
+	2, 638, 637, 3, 2, 2, 2, 638, 639, 3, 2, 2, 2, 639, 119, 3, 2, 2, 2, 640,
+	641, 7, 74, 2, 2, 641, 642, 5, 224, 113, 2, 642, 121, 3, 2, 2, 2, 643,
+	644, 7, 30, 2, 2, 644, 645, 7, 148, 2, 2, 645, 646, 5, 224, 113, 2, 646,
+	123, 3, 2, 2, 2, 647, 648, 7, 50, 2, 2, 648, 649, 7, 148, 2, 2, 649, 650,
+	5, 224, 113, 2, 650, 125, 3, 2, 2, 2, 651, 652, 7, 28, 2, 2, 652, 653,
+	7, 148, 2, 2, 653, 654, 5, 224, 113, 2, 654, 127, 3, 2, 2, 2, 655, 656,
+	7, 66, 2, 2, 656, 659, 5, 218, 110, 2, 657, 658, 7, 20, 2, 2, 658, 660,
+	5, 60, 31, 2, 659, 657, 3, 2, 2, 2, 659, 660, 3, 2, 2, 2, 660, 129, 3,
+	2, 2, 2, 661, 662, 7, 66, 2, 2, 662, 667, 5, 218, 110, 2, 663, 664, 7,
+	157, 2, 2, 664, 666, 5, 218, 110, 2, 665, 663, 3, 2, 2, 2, 666, 669, 3,
+	2, 2, 2, 667, 665, 3, 2, 2, 2, 667, 668, 3, 2, 2, 2, 668, 672, 3, 2, 2,
+	2, 669, 667, 3, 2, 2, 2, 670, 671, 7, 20, 2, 2, 671, 673, 5, 60, 31, 2,
+	672, 670, 3, 2, 2, 2, 672, 673, 3, 2, 2, 2, 673, 680, 3, 2, 2, 2, 674,
+	675, 7, 66, 2, 2, 675, 676, 7, 162, 2, 2, 676, 677, 5, 96, 49, 2, 677,
+	678, 7, 163, 2, 2, 678, 680, 3, 2, 2, 2, 679, 661, 3, 2, 2, 2, 679, 674,
+	3, 2, 2, 2, 680, 131, 3, 2, 2, 2, 681, 682, 7, 67, 2, 2, 682, 683, 5, 134,
+	68, 2, 683, 133, 3, 2, 2, 2, 684, 695, 5, 136, 69, 2, 685, 686, 5, 136,
+	69, 2, 686, 687, 7, 75, 2, 2, 687, 688, 5, 144, 73, 2, 688, 695, 3, 2,
+	2, 2, 689, 692, 5, 144, 73, 2, 690, 691, 7, 75, 2, 2, 691, 693, 5, 136,
+	69, 2, 692, 690, 3, 2, 2, 2, 692, 693, 3, 2, 2, 2, 693, 695, 3, 2, 2, 2,
+	694, 684, 3, 2, 2, 2, 694, 685, 3, 2, 2, 2, 694, 689, 3, 2, 2, 2, 695,
+	135, 3, 2, 2, 2, 696, 697, 8, 69, 1, 2, 697, 698, 7, 162, 2, 2, 698, 699,
+	5, 136, 69, 2, 699, 700, 7, 163, 2, 2, 700, 725, 3, 2, 2, 2, 701, 710,
+	5, 220, 111, 2, 702, 711, 7, 148, 2, 2, 703, 711, 7, 83, 2, 2, 704, 705,
+	7, 84, 2, 2, 705, 711, 7, 83, 2, 2, 706, 711, 7, 155, 2, 2, 707, 711, 7,
+	156, 2, 2, 708, 711, 7, 149, 2, 2, 709, 711, 7, 150, 2, 2, 710, 702, 3,
+	2, 2, 2, 710, 703, 3, 2, 2, 2, 710, 704, 3, 2, 2, 2, 710, 706, 3, 2, 2,
+	2, 710, 707, 3, 2, 2, 2, 710, 708, 3, 2, 2, 2, 710, 709, 3, 2, 2, 2, 711,
+	712, 3, 2, 2, 2, 712, 713, 5, 222, 112, 2, 713, 725, 3, 2, 2, 2, 714, 718,
+	5, 220, 111, 2, 715, 719, 7, 94, 2, 2, 716, 717, 7, 84, 2, 2, 717, 719,
+	7, 94, 2, 2, 718, 715, 3, 2, 2, 2, 718, 716, 3, 2, 2, 2, 719, 720, 3, 2,
+	2, 2, 720, 721, 7, 162, 2, 2, 721, 722, 5, 138, 70, 2, 722, 723, 7, 163,
+	2, 2, 723, 725, 3, 2, 2, 2, 724, 696, 3, 2, 2, 2, 724, 701, 3, 2, 2, 2,
+	724, 714, 3, 2, 2, 2, 725, 731, 3, 2, 2, 2, 726, 727, 12, 3, 2, 2, 727,
+	728, 9, 5, 2, 2, 728, 730, 5, 136, 69, 4, 729, 726, 3, 2, 2, 2, 730, 733,
+	3, 2, 2, 2, 731, 729, 3, 2, 2, 2, 731, 732, 3, 2, 2, 2, 732, 137, 3, 2,
+	2, 2, 733, 731, 3, 2, 2, 2, 734, 739, 5, 222, 112, 2, 735, 736, 7, 157,
+	2, 2, 736, 738, 5, 222, 112, 2, 737, 735, 3, 2, 2, 2, 738, 741, 3, 2, 2,
+	2, 739, 737, 3, 2, 2, 2, 739, 740, 3, 2, 2, 2, 740, 139, 3, 2, 2, 2, 741,
+	739, 3, 2, 2, 2, 742, 743, 7, 56, 2, 2, 743, 744, 7, 94, 2, 2, 744, 745,
+	7, 162, 2, 2, 745, 746, 5, 142, 72, 2, 746, 747, 7, 163, 2, 2, 747, 141,
+	3, 2, 2, 2, 748, 753, 5, 224, 113, 2, 749, 750, 7, 157, 2, 2, 750, 752,
+	5, 224, 113, 2, 751, 749, 3, 2, 2, 2, 752, 755, 3, 2, 2, 2, 753, 751, 3,
+	2, 2, 2, 753, 754, 3, 2, 2, 2, 754, 143, 3, 2, 2, 2, 755, 753, 3, 2, 2,
+	2, 756, 759, 5, 146, 74, 2, 757, 758, 7, 75, 2, 2, 758, 760, 5, 146, 74,
+	2, 759, 757, 3, 2, 2, 2, 759, 760, 3, 2, 2, 2, 760, 145, 3, 2, 2, 2, 761,
+	762, 7, 92, 2, 2, 762, 766, 5, 178, 90, 2, 763, 767, 5, 148, 75, 2, 764,
+	767, 5, 224, 113, 2, 765, 767, 5, 208, 105, 2, 766, 763, 3, 2, 2, 2, 766,
+	764, 3, 2, 2, 2, 766, 765, 3, 2, 2, 2, 767, 147, 3, 2, 2, 2, 768, 770,
+	5, 150, 76, 2, 769, 771, 5, 182, 92, 2, 770, 769, 3, 2, 2, 2, 770, 771,
+	3, 2, 2, 2, 771, 149, 3, 2, 2, 2, 772, 773, 7, 93, 2, 2, 773, 775, 7, 162,
+	2, 2, 774, 776, 5, 190, 96, 2, 775, 774, 3, 2, 2, 2, 775, 776, 3, 2, 2,
+	2, 776, 777, 3, 2, 2, 2, 777, 778, 7, 163, 2, 2, 778, 151, 3, 2, 2, 2,
+	779, 780, 7, 87, 2, 2, 780, 781, 7, 89, 2, 2, 781, 787, 5, 154, 78, 2,
+	782, 783, 7, 77, 2, 2, 783, 784, 7, 162, 2, 2, 784, 785, 5, 160, 81, 2,
+	785, 786, 7, 163, 2, 2, 786, 788, 3, 2, 2, 2, 787, 782, 3, 2, 2, 2, 787,
+	788, 3, 2, 2, 2, 788, 790, 3, 2, 2, 2, 789, 791, 5, 168, 85, 2, 790, 789,
+	3, 2, 2, 2, 790, 791, 3, 2, 2, 2, 791, 153, 3, 2, 2, 2, 792, 797, 5, 156,
+	79, 2, 793, 794, 7, 157, 2, 2, 794, 796, 5, 156, 79, 2, 795, 793, 3, 2,
+	2, 2, 796, 799, 3, 2, 2, 2, 797, 795, 3, 2, 2, 2, 797, 798, 3, 2, 2, 2,
+	798, 155, 3, 2, 2, 2, 799, 797, 3, 2, 2, 2, 800, 811, 5, 224, 113, 2, 801,
+	802, 7, 92, 2, 2, 802, 803, 7, 162, 2, 2, 803, 804, 5, 182, 92, 2, 804,
+	805, 7, 163, 2, 2, 805, 811, 3, 2, 2, 2, 806, 808, 5, 158, 80, 2, 807,
+	809, 5, 120, 61, 2, 808, 807, 3, 2, 2, 2, 808, 809, 3, 2, 2, 2, 809, 811,
+	3, 2, 2, 2, 810, 800, 3, 2, 2, 2, 810, 801, 3, 2, 2, 2, 810, 806, 3, 2,
+	2, 2, 811, 157, 3, 2, 2, 2, 812, 813, 7, 129, 2, 2, 813, 814, 7, 162, 2,
+	2, 814, 815, 5, 220, 111, 2, 815, 816, 7, 157, 2, 2, 816, 817, 5, 224,
+	113, 2, 817, 818, 7, 163, 2, 2, 818, 829, 3, 2, 2, 2, 819, 820, 7, 130,
+	2, 2, 820, 821, 7, 162, 2, 2, 821, 822, 5, 220, 111, 2, 822, 823, 7, 157,
+	2, 2, 823, 824, 5, 224, 113, 2, 824, 825, 7, 157, 2, 2, 825, 826, 5, 224,
+	113, 2, 826, 827, 7, 163, 2, 2, 827, 829, 3, 2, 2, 2, 828, 812, 3, 2, 2,
+	2, 828, 819, 3, 2, 2, 2, 829, 159, 3, 2, 2, 2, 830, 831, 9, 6, 2, 2, 831,
+	161, 3, 2, 2, 2, 832, 833, 7, 80, 2, 2, 833, 834, 7, 89, 2, 2, 834, 835,
+	5, 166, 84, 2, 835, 163, 3, 2, 2, 2, 836, 840, 5, 180, 91, 2, 837, 839,
+	9, 7, 2, 2, 838, 837, 3, 2, 2, 2, 839, 842, 3, 2, 2, 2, 840, 838, 3, 2,
+	2, 2, 840, 841, 3, 2, 2, 2, 841, 165, 3, 2, 2, 2, 842, 840, 3, 2, 2, 2,
+	843, 848, 5, 164, 83, 2, 844, 845, 7, 157, 2, 2, 845, 847, 5, 164, 83,
+	2, 846, 844, 3, 2, 2, 2, 847, 850, 3, 2, 2, 2, 848, 846, 3, 2, 2, 2, 848,
+	849, 3, 2, 2, 2, 849, 167, 3, 2, 2, 2, 850, 848, 3, 2, 2, 2, 851, 852,
+	7, 88, 2, 2, 852, 853, 5, 170, 86, 2, 853, 169, 3, 2, 2, 2, 854, 855, 8,
+	86, 1, 2, 855, 856, 7, 162, 2, 2, 856, 857, 5, 170, 86, 2, 857, 858, 7,
+	163, 2, 2, 858, 861, 3, 2, 2, 2, 859, 861, 5, 174, 88, 2, 860, 854, 3,
+	2, 2, 2, 860, 859, 3, 2, 2, 2, 861, 868, 3, 2, 2, 2, 862, 863, 12, 4, 2,
+	2, 863, 864, 5, 172, 87, 2, 864, 865, 5, 170, 86, 5, 865, 867, 3, 2, 2,
+	2, 866, 862, 3, 2, 2, 2, 867, 870, 3, 2, 2, 2, 868, 866, 3, 2, 2, 2, 868,
+	869, 3, 2, 2, 2, 869, 171, 3, 2, 2, 2, 870, 868, 3, 2, 2, 2, 871, 872,
+	9, 5, 2, 2, 872, 173, 3, 2, 2, 2, 873, 874, 5, 176, 89, 2, 874, 175, 3,
+	2, 2, 2, 875, 876, 5, 180, 91, 2, 876, 877, 5, 178, 90, 2, 877, 878, 5,
+	180, 91, 2, 878, 177, 3, 2, 2, 2, 879, 888, 7, 148, 2, 2, 880, 888, 7,
+	149, 2, 2, 881, 888, 7, 150, 2, 2, 882, 888, 7, 153, 2, 2, 883, 888, 7,
+	154, 2, 2, 884, 888, 7, 151, 2, 2, 885, 888, 7, 152, 2, 2, 886, 888, 9,
+	8, 2, 2, 887, 879, 3, 2, 2, 2, 887, 880, 3, 2, 2, 2, 887, 881, 3, 2, 2,
+	2, 887, 882, 3, 2, 2, 2, 887, 883, 3, 2, 2, 2, 887, 884, 3, 2, 2, 2, 887,
+	885, 3, 2, 2, 2, 887, 886, 3, 2, 2, 2, 888, 179, 3, 2, 2, 2, 889, 890,
+	8, 91, 1, 2, 890, 891, 7, 162, 2, 2, 891, 892, 5, 180, 91, 2, 892, 893,
+	7, 163, 2, 2, 893, 898, 3, 2, 2, 2, 894, 898, 5, 186, 94, 2, 895, 898,
+	5, 194, 98, 2, 896, 898, 5, 182, 92, 2, 897, 889, 3, 2, 2, 2, 897, 894,
+	3, 2, 2, 2, 897, 895, 3, 2, 2, 2, 897, 896, 3, 2, 2, 2, 898, 913, 3, 2,
+	2, 2, 899, 900, 12, 10, 2, 2, 900, 901, 7, 167, 2, 2, 901, 912, 5, 180,
+	91, 11, 902, 903, 12, 9, 2, 2, 903, 904, 7, 166, 2, 2, 904, 912, 5, 180,
+	91, 10, 905, 906, 12, 8, 2, 2, 906, 907, 7, 164, 2, 2, 907, 912, 5, 180,
+	91, 9, 908, 909, 12, 7, 2, 2, 909, 910, 7, 165, 2, 2, 910, 912, 5, 180,
+	91, 8, 911, 899, 3, 2, 2, 2, 911, 902, 3, 2, 2, 2, 911, 905, 3, 2, 2, 2,
+	911, 908, 3, 2, 2, 2, 912, 915, 3, 2, 2, 2, 913, 911, 3, 2, 2, 2, 913,
+	914, 3, 2, 2, 2, 914, 181, 3, 2, 2, 2, 915, 913, 3, 2, 2, 2, 916, 917,
+	5, 208, 105, 2, 917, 918, 5, 184, 93, 2, 918, 183, 3, 2, 2, 2, 919, 920,
+	9, 9, 2, 2, 920, 185, 3, 2, 2, 2, 921, 922, 5, 188, 95, 2, 922, 924, 7,
+	162, 2, 2, 923, 925, 5, 190, 96, 2, 924, 923, 3, 2, 2, 2, 924, 925, 3,
+	2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 927, 7, 163, 2, 2, 927, 938, 3, 2,
+	2, 2, 928, 929, 7, 127, 2, 2, 929, 930, 7, 162, 2, 2, 930, 931, 5, 170,
+	86, 2, 931, 932, 7, 157, 2, 2, 932, 933, 5, 180, 91, 2, 933, 934, 7, 157,
+	2, 2, 934, 935, 5, 180, 91, 2, 935, 936, 7, 163, 2, 2, 936, 938, 3, 2,
+	2, 2, 937, 921, 3, 2, 2, 2, 937, 928, 3, 2, 2, 2, 938, 187, 3, 2, 2, 2,
+	939, 940, 9, 10, 2, 2, 940, 189, 3, 2, 2, 2, 941, 946, 5, 192, 97, 2, 942,
+	943, 7, 157, 2, 2, 943, 945, 5, 192, 97, 2, 944, 942, 3, 2, 2, 2, 945,
+	948, 3, 2, 2, 2, 946, 944, 3, 2, 2, 2, 946, 947, 3, 2, 2, 2, 947, 191,
+	3, 2, 2, 2, 948, 946, 3, 2, 2, 2, 949, 952, 5, 180, 91, 2, 950, 952, 5,
+	136, 69, 2, 951, 949, 3, 2, 2, 2, 951, 950, 3, 2, 2, 2, 952, 193, 3, 2,
+	2, 2, 953, 955, 5, 224, 113, 2, 954, 956, 5, 196, 99, 2, 955, 954, 3, 2,
+	2, 2, 955, 956, 3, 2, 2, 2, 956, 960, 3, 2, 2, 2, 957, 960, 5, 210, 106,
+	2, 958, 960, 5, 208, 105, 2, 959, 953, 3, 2, 2, 2, 959, 957, 3, 2, 2, 2,
+	959, 958, 3, 2, 2, 2, 960, 195, 3, 2, 2, 2, 961, 962, 7, 160, 2, 2, 962,
+	963, 5, 136, 69, 2, 963, 964, 7, 161, 2, 2, 964, 197, 3, 2, 2, 2, 965,
+	966, 5, 206, 104, 2, 966, 199, 3, 2, 2, 2, 967, 968, 7, 158, 2, 2, 968,
+	973, 5, 202, 102, 2, 969, 970, 7, 157, 2, 2, 970, 972, 5, 202, 102, 2,
+	971, 969, 3, 2, 2, 2, 972, 975, 3, 2, 2, 2, 973, 971, 3, 2, 2, 2, 973,
+	974, 3, 2, 2, 2, 974, 976, 3, 2, 2, 2, 975, 973, 3, 2, 2, 2, 976, 977,
+	7, 159, 2, 2, 977, 981, 3, 2, 2, 2, 978, 979, 7, 158, 2, 2, 979, 981, 7,
+	159, 2, 2, 980, 967, 3, 2, 2, 2, 980, 978, 3, 2, 2, 2, 981, 201, 3, 2,
+	2, 2, 982, 983, 7, 5, 2, 2, 983, 984, 7, 147, 2, 2, 984, 985, 5, 206, 104,
+	2, 985, 203, 3, 2, 2, 2, 986, 987, 7, 160, 2, 2, 987, 992, 5, 206, 104,
+	2, 988, 989, 7, 157, 2, 2, 989, 991, 5, 206, 104, 2, 990, 988, 3, 2, 2,
+	2, 991, 994, 3, 2, 2, 2, 992, 990, 3, 2, 2, 2, 992, 993, 3, 2, 2, 2, 993,
+	995, 3, 2, 2, 2, 994, 992, 3, 2, 2, 2, 995, 996, 7, 161, 2, 2, 996, 1000,
+	3, 2, 2, 2, 997, 998, 7, 160, 2, 2, 998, 1000, 7, 161, 2, 2, 999, 986,
+	3, 2, 2, 2, 999, 997, 3, 2, 2, 2, 1000, 205, 3, 2, 2, 2, 1001, 1010, 7,
+	5, 2, 2, 1002, 1010, 5, 208, 105, 2, 1003, 1010, 5, 210, 106, 2, 1004,
+	1010, 5, 200, 101, 2, 1005, 1010, 5, 204, 103, 2, 1006, 1010, 7, 3, 2,
+	2, 1007, 1010, 7, 4, 2, 2, 1008, 1010, 7, 78, 2, 2, 1009, 1001, 3, 2, 2,
+	2, 1009, 1002, 3, 2, 2, 2, 1009, 1003, 3, 2, 2, 2, 1009, 1004, 3, 2, 2,
+	2, 1009, 1005, 3, 2, 2, 2, 1009, 1006, 3, 2, 2, 2, 1009, 1007, 3, 2, 2,
+	2, 1009, 1008, 3, 2, 2, 2, 1010, 207, 3, 2, 2, 2, 1011, 1013, 9, 11, 2,
+	2, 1012, 1011, 3, 2, 2, 2, 1012, 1013, 3, 2, 2, 2, 1013, 1014, 3, 2, 2,
+	2, 1014, 1015, 7, 171, 2, 2, 1015, 209, 3, 2, 2, 2, 1016, 1018, 9, 11,
+	2, 2, 1017, 1016, 3, 2, 2, 2, 1017, 1018, 3, 2, 2, 2, 1018, 1019, 3, 2,
+	2, 2, 1019, 1020, 7, 172, 2, 2, 1020, 211, 3, 2, 2, 2, 1021, 1022, 7, 68,
+	2, 2, 1022, 1023, 7, 171, 2, 2, 1023, 213, 3, 2, 2, 2, 1024, 1025, 7, 128,
+	2, 2, 1025, 1026, 7, 162, 2, 2, 1026, 1027, 5, 224, 113, 2, 1027, 1028,
+	7, 163, 2, 2, 1028, 215, 3, 2, 2, 2, 1029, 1030, 7, 63, 2, 2, 1030, 1031,
+	7, 131, 2, 2, 1031, 217, 3, 2, 2, 2, 1032, 1033, 5, 224, 113, 2, 1033,
+	219, 3, 2, 2, 2, 1034, 1035, 5, 224, 113, 2, 1035, 221, 3, 2, 2, 2, 1036,
+	1037, 5, 224, 113, 2, 1037, 223, 3, 2, 2, 2, 1038, 1041, 7, 170, 2, 2,
+	1039, 1041, 5, 226, 114, 2, 1040, 1038, 3, 2, 2, 2, 1040, 1039, 3, 2, 2,
+	2, 1041, 1049, 3, 2, 2, 2, 1042, 1045, 7, 146, 2, 2, 1043, 1046, 7, 170,
+	2, 2, 1044, 1046, 5, 226, 114, 2, 1045, 1043, 3, 2, 2, 2, 1045, 1044, 3,
+	2, 2, 2, 1046, 1048, 3, 2, 2, 2, 1047, 1042, 3, 2, 2, 2, 1048, 1051, 3,
+	2, 2, 2, 1049, 1047, 3, 2, 2, 2, 1049, 1050, 3, 2, 2, 2, 1050, 225, 3,
+	2, 2, 2, 1051, 1049, 3, 2, 2, 2, 1052, 1053, 9, 12, 2, 2, 1053, 227, 3,
+	2, 2, 2, 86, 269, 308, 313, 324, 329, 335, 349, 354, 380, 383, 389, 395,
+	398, 418, 421, 428, 434, 437, 455, 498, 510, 518, 530, 536, 544, 553, 558,
+	561, 564, 567, 570, 573, 576, 590, 609, 616, 633, 638, 659, 667, 672, 679,
+	692, 694, 710, 718, 724, 731, 739, 753, 759, 766, 770, 775, 787, 790, 797,
+	808, 810, 828, 840, 848, 860, 868, 887, 897, 911, 913, 924, 937, 946, 951,
+	955, 959, 973, 980, 992, 999, 1009, 1012, 1017, 1040, 1045, 1049,
 }
 
 var literalNames = []string{
-	"", "'true'", "'false'", "'null'", "", "", "", "", "", "", "", "", "",
+	"", "'true'", "'false'", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "'m'", "", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'",
-	"'!='", "'>'", "'>='", "'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'",
-	"'['", "']'", "'('", "')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'m'", "",
+	"", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='",
+	"'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('",
+	"')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
 }
 
 var symbolicNames = []string{
-	"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
+	"", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
 	"T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_TTL", "T_META_TTL",
 	"T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", "T_SHOW", "T_USE", "T_STATE_REPO",
 	"T_STATE_MACHINE", "T_MASTER", "T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES",
-	"T_STORAGE", "T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES",
-	"T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD",
-	"T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH", "T_VALUES",
-	"T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN",
-	"T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL",
-	"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
-	"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW",
-	"T_IN", "T_LOG", "T_PROFILE", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_AVG",
-	"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY",
-	"T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL",
-	"T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL",
+	"T_STORAGE", "T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_USER", "T_USERS", "T_ROLE",
+	"T_ROLES", "T_LIMITS", "T_CARDINALITY", "T_PASSWORD", "T_GRANT", "T_REVOKE",
+	"T_TO", "T_READ", "T_WRITE", "T_ADMIN", "T_DELETE", "T_ALTER", "T_RENAME",
+	"T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS",
+	"T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY",
+	"T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES",
+	"T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR",
+	"T_FILL", "T_NULL", "T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE",
+	"T_NOT", "T_BETWEEN", "T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS",
+	"T_TIME", "T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_SUM", "T_MIN", "T_MAX",
+	"T_COUNT", "T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", "T_VARIANCE", "T_FIRST_VALUE",
+	"T_LAST_VALUE", "T_MEDIAN", "T_IRATE", "T_INCREASE", "T_DERIVATIVE", "T_DELTA",
+	"T_MOVING_AVG", "T_MOVING_SUM", "T_CUMULATIVE_SUM", "T_EWMA", "T_TIME_SHIFT",
+	"T_DIFF", "T_ABS", "T_CEIL", "T_FLOOR", "T_ROUND", "T_POW", "T_SQRT", "T_CLAMP_MIN",
+	"T_CLAMP_MAX", "T_IF", "T_TZ", "T_REGEX_EXTRACT", "T_LABEL_REPLACE", "T_EXEMPLARS",
+	"T_HISTOGRAM_COUNT", "T_HISTOGRAM_SUM", "T_HISTOGRAM_AVG", "T_HISTOGRAM_FRACTION",
+	"T_HEATMAP", "T_LAST", "T_COUNT_DISTINCT", "T_SECOND", "T_MINUTE", "T_HOUR",
+	"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
+	"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL",
 	"T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB",
 	"T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL",
 	"T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
@@ -398,20 +554,27 @@ var symbolicNames = []string{
 var ruleNames = []string{
 	"statement", "statementList", "useStmt", "showMasterStmt", "showStoragesStmt",
 	"showMetadataTypesStmt", "showBrokerMetaStmt", "showMasterMetaStmt", "showStorageMetaStmt",
-	"showAliveStmt", "showReplicationStmt", "showBrokerMetricStmt", "showStorageMetricStmt",
-	"createStorageStmt", "showSchemasStmt", "createDatabaseStmt", "dropDatabaseStmt",
-	"showDatabaseStmt", "showNameSpacesStmt", "showMetricsStmt", "showFieldsStmt",
-	"showTagKeysStmt", "showTagValuesStmt", "prefix", "withTagKey", "namespace",
-	"databaseName", "source", "queryStmt", "selectExpr", "fields", "field",
-	"alias", "storageFilter", "databaseFilter", "typeFilter", "fromClause",
+	"showAliveStmt", "showReplicationStmt", "showLimitsStmt", "showBrokerMetricStmt",
+	"showStorageMetricStmt", "createStorageStmt", "showSchemasStmt", "createDatabaseStmt",
+	"dropDatabaseStmt", "showDatabaseStmt", "showNameSpacesStmt", "showMetricsStmt",
+	"showFieldsStmt", "showTagKeysStmt", "showTagValuesStmt", "showMetricCardinalityStmt",
+	"showTagKeyCardinalityStmt", "showTagValueCardinalityStmt", "prefix", "withTagKey",
+	"namespace", "databaseName", "source", "createUserStmt", "dropUserStmt",
+	"showUsersStmt", "createRoleStmt", "dropRoleStmt", "showRolesStmt", "grantStmt",
+	"revokeStmt", "privilege", "grantDatabase", "grantNamespace", "grantee",
+	"userName", "roleName", "password", "queryStmt", "selectExpr", "deleteStmt",
+	"alterMetricStmt", "alterFieldAction", "dropMetricStmt", "dropNamespaceStmt",
+	"fieldName", "targetFieldName", "fieldType", "fields", "field", "alias",
+	"storageFilter", "databaseFilter", "typeFilter", "fromClause", "queryFromClause",
 	"whereClause", "conditionExpr", "tagFilterExpr", "tagValueList", "metricListFilter",
 	"metricList", "timeRangeExpr", "timeExpr", "nowExpr", "nowFunc", "groupByClause",
-	"groupByKeys", "groupByKey", "fillOption", "orderByClause", "sortField",
-	"sortFields", "havingClause", "boolExpr", "boolExprLogicalOp", "boolExprAtom",
-	"binaryExpr", "binaryOperator", "fieldExpr", "durationLit", "intervalItem",
-	"exprFunc", "funcName", "exprFuncParams", "funcParam", "exprAtom", "identFilter",
-	"json", "obj", "pair", "arr", "value", "intNumber", "decNumber", "limitClause",
-	"metricName", "tagKey", "tagValue", "ident", "nonReservedWords",
+	"groupByKeys", "groupByKey", "tagTransformExpr", "fillOption", "orderByClause",
+	"sortField", "sortFields", "havingClause", "boolExpr", "boolExprLogicalOp",
+	"boolExprAtom", "binaryExpr", "binaryOperator", "fieldExpr", "durationLit",
+	"intervalItem", "exprFunc", "funcName", "exprFuncParams", "funcParam",
+	"exprAtom", "identFilter", "json", "obj", "pair", "arr", "value", "intNumber",
+	"decNumber", "limitClause", "timeZoneClause", "withExemplarsClause", "metricName",
+	"tagKey", "tagValue", "ident", "nonReservedWords",
 }
 
 type SQLParser struct {
@@ -445,214 +608,294 @@ func NewSQLParser(input antlr.TokenStream) *SQLParser {
 
 // SQLParser tokens.
 const (
-	SQLParserEOF             = antlr.TokenEOF
-	SQLParserT__0            = 1
-	SQLParserT__1            = 2
-	SQLParserT__2            = 3
-	SQLParserSTRING          = 4
-	SQLParserWS              = 5
-	SQLParserT_CREATE        = 6
-	SQLParserT_UPDATE        = 7
-	SQLParserT_SET           = 8
-	SQLParserT_DROP          = 9
-	SQLParserT_INTERVAL      = 10
-	SQLParserT_INTERVAL_NAME = 11
-	SQLParserT_SHARD         = 12
-	SQLParserT_REPLICATION   = 13
-	SQLParserT_TTL           = 14
-	SQLParserT_META_TTL      = 15
-	SQLParserT_PAST_TTL      = 16
-	SQLParserT_FUTURE_TTL    = 17
-	SQLParserT_KILL          = 18
-	SQLParserT_ON            = 19
-	SQLParserT_SHOW          = 20
-	SQLParserT_USE           = 21
-	SQLParserT_STATE_REPO    = 22
-	SQLParserT_STATE_MACHINE = 23
-	SQLParserT_MASTER        = 24
-	SQLParserT_METADATA      = 25
-	SQLParserT_TYPES         = 26
-	SQLParserT_TYPE          = 27
-	SQLParserT_STORAGES      = 28
-	SQLParserT_STORAGE       = 29
-	SQLParserT_BROKER        = 30
-	SQLParserT_ALIVE         = 31
-	SQLParserT_SCHEMAS       = 32
-	SQLParserT_DATASBAE      = 33
-	SQLParserT_DATASBAES     = 34
-	SQLParserT_NAMESPACE     = 35
-	SQLParserT_NAMESPACES    = 36
-	SQLParserT_NODE          = 37
-	SQLParserT_METRICS       = 38
-	SQLParserT_METRIC        = 39
-	SQLParserT_FIELD         = 40
-	SQLParserT_FIELDS        = 41
-	SQLParserT_TAG           = 42
-	SQLParserT_INFO          = 43
-	SQLParserT_KEYS          = 44
-	SQLParserT_KEY           = 45
-	SQLParserT_WITH          = 46
-	SQLParserT_VALUES        = 47
-	SQLParserT_VALUE         = 48
-	SQLParserT_FROM          = 49
-	SQLParserT_WHERE         = 50
-	SQLParserT_LIMIT         = 51
-	SQLParserT_QUERIES       = 52
-	SQLParserT_QUERY         = 53
-	SQLParserT_EXPLAIN       = 54
-	SQLParserT_WITH_VALUE    = 55
-	SQLParserT_SELECT        = 56
-	SQLParserT_AS            = 57
-	SQLParserT_AND           = 58
-	SQLParserT_OR            = 59
-	SQLParserT_FILL          = 60
-	SQLParserT_NULL          = 61
-	SQLParserT_PREVIOUS      = 62
-	SQLParserT_ORDER         = 63
-	SQLParserT_ASC           = 64
-	SQLParserT_DESC          = 65
-	SQLParserT_LIKE          = 66
-	SQLParserT_NOT           = 67
-	SQLParserT_BETWEEN       = 68
-	SQLParserT_IS            = 69
-	SQLParserT_GROUP         = 70
-	SQLParserT_HAVING        = 71
-	SQLParserT_BY            = 72
-	SQLParserT_FOR           = 73
-	SQLParserT_STATS         = 74
-	SQLParserT_TIME          = 75
-	SQLParserT_NOW           = 76
-	SQLParserT_IN            = 77
-	SQLParserT_LOG           = 78
-	SQLParserT_PROFILE       = 79
-	SQLParserT_SUM           = 80
-	SQLParserT_MIN           = 81
-	SQLParserT_MAX           = 82
-	SQLParserT_COUNT         = 83
-	SQLParserT_AVG           = 84
-	SQLParserT_STDDEV        = 85
-	SQLParserT_QUANTILE      = 86
-	SQLParserT_RATE          = 87
-	SQLParserT_SECOND        = 88
-	SQLParserT_MINUTE        = 89
-	SQLParserT_HOUR          = 90
-	SQLParserT_DAY           = 91
-	SQLParserT_WEEK          = 92
-	SQLParserT_MONTH         = 93
-	SQLParserT_YEAR          = 94
-	SQLParserT_DOT           = 95
-	SQLParserT_COLON         = 96
-	SQLParserT_EQUAL         = 97
-	SQLParserT_NOTEQUAL      = 98
-	SQLParserT_NOTEQUAL2     = 99
-	SQLParserT_GREATER       = 100
-	SQLParserT_GREATEREQUAL  = 101
-	SQLParserT_LESS          = 102
-	SQLParserT_LESSEQUAL     = 103
-	SQLParserT_REGEXP        = 104
-	SQLParserT_NEQREGEXP     = 105
-	SQLParserT_COMMA         = 106
-	SQLParserT_OPEN_B        = 107
-	SQLParserT_CLOSE_B       = 108
-	SQLParserT_OPEN_SB       = 109
-	SQLParserT_CLOSE_SB      = 110
-	SQLParserT_OPEN_P        = 111
-	SQLParserT_CLOSE_P       = 112
-	SQLParserT_ADD           = 113
-	SQLParserT_SUB           = 114
-	SQLParserT_DIV           = 115
-	SQLParserT_MUL           = 116
-	SQLParserT_MOD           = 117
-	SQLParserT_UNDERLINE     = 118
-	SQLParserL_ID            = 119
-	SQLParserL_INT           = 120
-	SQLParserL_DEC           = 121
+	SQLParserEOF                  = antlr.TokenEOF
+	SQLParserT__0                 = 1
+	SQLParserT__1                 = 2
+	SQLParserSTRING               = 3
+	SQLParserWS                   = 4
+	SQLParserT_CREATE             = 5
+	SQLParserT_UPDATE             = 6
+	SQLParserT_SET                = 7
+	SQLParserT_DROP               = 8
+	SQLParserT_INTERVAL           = 9
+	SQLParserT_INTERVAL_NAME      = 10
+	SQLParserT_SHARD              = 11
+	SQLParserT_REPLICATION        = 12
+	SQLParserT_TTL                = 13
+	SQLParserT_META_TTL           = 14
+	SQLParserT_PAST_TTL           = 15
+	SQLParserT_FUTURE_TTL         = 16
+	SQLParserT_KILL               = 17
+	SQLParserT_ON                 = 18
+	SQLParserT_SHOW               = 19
+	SQLParserT_USE                = 20
+	SQLParserT_STATE_REPO         = 21
+	SQLParserT_STATE_MACHINE      = 22
+	SQLParserT_MASTER             = 23
+	SQLParserT_METADATA           = 24
+	SQLParserT_TYPES              = 25
+	SQLParserT_TYPE               = 26
+	SQLParserT_STORAGES           = 27
+	SQLParserT_STORAGE            = 28
+	SQLParserT_BROKER             = 29
+	SQLParserT_ALIVE              = 30
+	SQLParserT_SCHEMAS            = 31
+	SQLParserT_USER               = 32
+	SQLParserT_USERS              = 33
+	SQLParserT_ROLE               = 34
+	SQLParserT_ROLES              = 35
+	SQLParserT_LIMITS             = 36
+	SQLParserT_CARDINALITY        = 37
+	SQLParserT_PASSWORD           = 38
+	SQLParserT_GRANT              = 39
+	SQLParserT_REVOKE             = 40
+	SQLParserT_TO                 = 41
+	SQLParserT_READ               = 42
+	SQLParserT_WRITE              = 43
+	SQLParserT_ADMIN              = 44
+	SQLParserT_DELETE             = 45
+	SQLParserT_ALTER              = 46
+	SQLParserT_RENAME             = 47
+	SQLParserT_DATASBAE           = 48
+	SQLParserT_DATASBAES          = 49
+	SQLParserT_NAMESPACE          = 50
+	SQLParserT_NAMESPACES         = 51
+	SQLParserT_NODE               = 52
+	SQLParserT_METRICS            = 53
+	SQLParserT_METRIC             = 54
+	SQLParserT_FIELD              = 55
+	SQLParserT_FIELDS             = 56
+	SQLParserT_TAG                = 57
+	SQLParserT_INFO               = 58
+	SQLParserT_KEYS               = 59
+	SQLParserT_KEY                = 60
+	SQLParserT_WITH               = 61
+	SQLParserT_VALUES             = 62
+	SQLParserT_VALUE              = 63
+	SQLParserT_FROM               = 64
+	SQLParserT_WHERE              = 65
+	SQLParserT_LIMIT              = 66
+	SQLParserT_QUERIES            = 67
+	SQLParserT_QUERY              = 68
+	SQLParserT_EXPLAIN            = 69
+	SQLParserT_WITH_VALUE         = 70
+	SQLParserT_SELECT             = 71
+	SQLParserT_AS                 = 72
+	SQLParserT_AND                = 73
+	SQLParserT_OR                 = 74
+	SQLParserT_FILL               = 75
+	SQLParserT_NULL               = 76
+	SQLParserT_PREVIOUS           = 77
+	SQLParserT_ORDER              = 78
+	SQLParserT_ASC                = 79
+	SQLParserT_DESC               = 80
+	SQLParserT_LIKE               = 81
+	SQLParserT_NOT                = 82
+	SQLParserT_BETWEEN            = 83
+	SQLParserT_IS                 = 84
+	SQLParserT_GROUP              = 85
+	SQLParserT_HAVING             = 86
+	SQLParserT_BY                 = 87
+	SQLParserT_FOR                = 88
+	SQLParserT_STATS              = 89
+	SQLParserT_TIME               = 90
+	SQLParserT_NOW                = 91
+	SQLParserT_IN                 = 92
+	SQLParserT_LOG                = 93
+	SQLParserT_PROFILE            = 94
+	SQLParserT_SUM                = 95
+	SQLParserT_MIN                = 96
+	SQLParserT_MAX                = 97
+	SQLParserT_COUNT              = 98
+	SQLParserT_AVG                = 99
+	SQLParserT_STDDEV             = 100
+	SQLParserT_QUANTILE           = 101
+	SQLParserT_RATE               = 102
+	SQLParserT_VARIANCE           = 103
+	SQLParserT_FIRST_VALUE        = 104
+	SQLParserT_LAST_VALUE         = 105
+	SQLParserT_MEDIAN             = 106
+	SQLParserT_IRATE              = 107
+	SQLParserT_INCREASE           = 108
+	SQLParserT_DERIVATIVE         = 109
+	SQLParserT_DELTA              = 110
+	SQLParserT_MOVING_AVG         = 111
+	SQLParserT_MOVING_SUM         = 112
+	SQLParserT_CUMULATIVE_SUM     = 113
+	SQLParserT_EWMA               = 114
+	SQLParserT_TIME_SHIFT         = 115
+	SQLParserT_DIFF               = 116
+	SQLParserT_ABS                = 117
+	SQLParserT_CEIL               = 118
+	SQLParserT_FLOOR              = 119
+	SQLParserT_ROUND              = 120
+	SQLParserT_POW                = 121
+	SQLParserT_SQRT               = 122
+	SQLParserT_CLAMP_MIN          = 123
+	SQLParserT_CLAMP_MAX          = 124
+	SQLParserT_IF                 = 125
+	SQLParserT_TZ                 = 126
+	SQLParserT_REGEX_EXTRACT      = 127
+	SQLParserT_LABEL_REPLACE      = 128
+	SQLParserT_EXEMPLARS          = 129
+	SQLParserT_HISTOGRAM_COUNT    = 130
+	SQLParserT_HISTOGRAM_SUM      = 131
+	SQLParserT_HISTOGRAM_AVG      = 132
+	SQLParserT_HISTOGRAM_FRACTION = 133
+	SQLParserT_HEATMAP            = 134
+	SQLParserT_LAST               = 135
+	SQLParserT_COUNT_DISTINCT     = 136
+	SQLParserT_SECOND             = 137
+	SQLParserT_MINUTE             = 138
+	SQLParserT_HOUR               = 139
+	SQLParserT_DAY                = 140
+	SQLParserT_WEEK               = 141
+	SQLParserT_MONTH              = 142
+	SQLParserT_YEAR               = 143
+	SQLParserT_DOT                = 144
+	SQLParserT_COLON              = 145
+	SQLParserT_EQUAL              = 146
+	SQLParserT_NOTEQUAL           = 147
+	SQLParserT_NOTEQUAL2          = 148
+	SQLParserT_GREATER            = 149
+	SQLParserT_GREATEREQUAL       = 150
+	SQLParserT_LESS               = 151
+	SQLParserT_LESSEQUAL          = 152
+	SQLParserT_REGEXP             = 153
+	SQLParserT_NEQREGEXP          = 154
+	SQLParserT_COMMA              = 155
+	SQLParserT_OPEN_B             = 156
+	SQLParserT_CLOSE_B            = 157
+	SQLParserT_OPEN_SB            = 158
+	SQLParserT_CLOSE_SB           = 159
+	SQLParserT_OPEN_P             = 160
+	SQLParserT_CLOSE_P            = 161
+	SQLParserT_ADD                = 162
+	SQLParserT_SUB                = 163
+	SQLParserT_DIV                = 164
+	SQLParserT_MUL                = 165
+	SQLParserT_MOD                = 166
+	SQLParserT_UNDERLINE          = 167
+	SQLParserL_ID                 = 168
+	SQLParserL_INT                = 169
+	SQLParserL_DEC                = 170
 )
 
 // SQLParser rules.
 const (
-	SQLParserRULE_statement             = 0
-	SQLParserRULE_statementList         = 1
-	SQLParserRULE_useStmt               = 2
-	SQLParserRULE_showMasterStmt        = 3
-	SQLParserRULE_showStoragesStmt      = 4
-	SQLParserRULE_showMetadataTypesStmt = 5
-	SQLParserRULE_showBrokerMetaStmt    = 6
-	SQLParserRULE_showMasterMetaStmt    = 7
-	SQLParserRULE_showStorageMetaStmt   = 8
-	SQLParserRULE_showAliveStmt         = 9
-	SQLParserRULE_showReplicationStmt   = 10
-	SQLParserRULE_showBrokerMetricStmt  = 11
-	SQLParserRULE_showStorageMetricStmt = 12
-	SQLParserRULE_createStorageStmt     = 13
-	SQLParserRULE_showSchemasStmt       = 14
-	SQLParserRULE_createDatabaseStmt    = 15
-	SQLParserRULE_dropDatabaseStmt      = 16
-	SQLParserRULE_showDatabaseStmt      = 17
-	SQLParserRULE_showNameSpacesStmt    = 18
-	SQLParserRULE_showMetricsStmt       = 19
-	SQLParserRULE_showFieldsStmt        = 20
-	SQLParserRULE_showTagKeysStmt       = 21
-	SQLParserRULE_showTagValuesStmt     = 22
-	SQLParserRULE_prefix                = 23
-	SQLParserRULE_withTagKey            = 24
-	SQLParserRULE_namespace             = 25
-	SQLParserRULE_databaseName          = 26
-	SQLParserRULE_source                = 27
-	SQLParserRULE_queryStmt             = 28
-	SQLParserRULE_selectExpr            = 29
-	SQLParserRULE_fields                = 30
-	SQLParserRULE_field                 = 31
-	SQLParserRULE_alias                 = 32
-	SQLParserRULE_storageFilter         = 33
-	SQLParserRULE_databaseFilter        = 34
-	SQLParserRULE_typeFilter            = 35
-	SQLParserRULE_fromClause            = 36
-	SQLParserRULE_whereClause           = 37
-	SQLParserRULE_conditionExpr         = 38
-	SQLParserRULE_tagFilterExpr         = 39
-	SQLParserRULE_tagValueList          = 40
-	SQLParserRULE_metricListFilter      = 41
-	SQLParserRULE_metricList            = 42
-	SQLParserRULE_timeRangeExpr         = 43
-	SQLParserRULE_timeExpr              = 44
-	SQLParserRULE_nowExpr               = 45
-	SQLParserRULE_nowFunc               = 46
-	SQLParserRULE_groupByClause         = 47
-	SQLParserRULE_groupByKeys           = 48
-	SQLParserRULE_groupByKey            = 49
-	SQLParserRULE_fillOption            = 50
-	SQLParserRULE_orderByClause         = 51
-	SQLParserRULE_sortField             = 52
-	SQLParserRULE_sortFields            = 53
-	SQLParserRULE_havingClause          = 54
-	SQLParserRULE_boolExpr              = 55
-	SQLParserRULE_boolExprLogicalOp     = 56
-	SQLParserRULE_boolExprAtom          = 57
-	SQLParserRULE_binaryExpr            = 58
-	SQLParserRULE_binaryOperator        = 59
-	SQLParserRULE_fieldExpr             = 60
-	SQLParserRULE_durationLit           = 61
-	SQLParserRULE_intervalItem          = 62
-	SQLParserRULE_exprFunc              = 63
-	SQLParserRULE_funcName              = 64
-	SQLParserRULE_exprFuncParams        = 65
-	SQLParserRULE_funcParam             = 66
-	SQLParserRULE_exprAtom              = 67
-	SQLParserRULE_identFilter           = 68
-	SQLParserRULE_json                  = 69
-	SQLParserRULE_obj                   = 70
-	SQLParserRULE_pair                  = 71
-	SQLParserRULE_arr                   = 72
-	SQLParserRULE_value                 = 73
-	SQLParserRULE_intNumber             = 74
-	SQLParserRULE_decNumber             = 75
-	SQLParserRULE_limitClause           = 76
-	SQLParserRULE_metricName            = 77
-	SQLParserRULE_tagKey                = 78
-	SQLParserRULE_tagValue              = 79
-	SQLParserRULE_ident                 = 80
-	SQLParserRULE_nonReservedWords      = 81
+	SQLParserRULE_statement                   = 0
+	SQLParserRULE_statementList               = 1
+	SQLParserRULE_useStmt                     = 2
+	SQLParserRULE_showMasterStmt              = 3
+	SQLParserRULE_showStoragesStmt            = 4
+	SQLParserRULE_showMetadataTypesStmt       = 5
+	SQLParserRULE_showBrokerMetaStmt          = 6
+	SQLParserRULE_showMasterMetaStmt          = 7
+	SQLParserRULE_showStorageMetaStmt         = 8
+	SQLParserRULE_showAliveStmt               = 9
+	SQLParserRULE_showReplicationStmt         = 10
+	SQLParserRULE_showLimitsStmt              = 11
+	SQLParserRULE_showBrokerMetricStmt        = 12
+	SQLParserRULE_showStorageMetricStmt       = 13
+	SQLParserRULE_createStorageStmt           = 14
+	SQLParserRULE_showSchemasStmt             = 15
+	SQLParserRULE_createDatabaseStmt          = 16
+	SQLParserRULE_dropDatabaseStmt            = 17
+	SQLParserRULE_showDatabaseStmt            = 18
+	SQLParserRULE_showNameSpacesStmt          = 19
+	SQLParserRULE_showMetricsStmt             = 20
+	SQLParserRULE_showFieldsStmt              = 21
+	SQLParserRULE_showTagKeysStmt             = 22
+	SQLParserRULE_showTagValuesStmt           = 23
+	SQLParserRULE_showMetricCardinalityStmt   = 24
+	SQLParserRULE_showTagKeyCardinalityStmt   = 25
+	SQLParserRULE_showTagValueCardinalityStmt = 26
+	SQLParserRULE_prefix                      = 27
+	SQLParserRULE_withTagKey                  = 28
+	SQLParserRULE_namespace                   = 29
+	SQLParserRULE_databaseName                = 30
+	SQLParserRULE_source                      = 31
+	SQLParserRULE_createUserStmt              = 32
+	SQLParserRULE_dropUserStmt                = 33
+	SQLParserRULE_showUsersStmt               = 34
+	SQLParserRULE_createRoleStmt              = 35
+	SQLParserRULE_dropRoleStmt                = 36
+	SQLParserRULE_showRolesStmt               = 37
+	SQLParserRULE_grantStmt                   = 38
+	SQLParserRULE_revokeStmt                  = 39
+	SQLParserRULE_privilege                   = 40
+	SQLParserRULE_grantDatabase               = 41
+	SQLParserRULE_grantNamespace              = 42
+	SQLParserRULE_grantee                     = 43
+	SQLParserRULE_userName                    = 44
+	SQLParserRULE_roleName                    = 45
+	SQLParserRULE_password                    = 46
+	SQLParserRULE_queryStmt                   = 47
+	SQLParserRULE_selectExpr                  = 48
+	SQLParserRULE_deleteStmt                  = 49
+	SQLParserRULE_alterMetricStmt             = 50
+	SQLParserRULE_alterFieldAction            = 51
+	SQLParserRULE_dropMetricStmt              = 52
+	SQLParserRULE_dropNamespaceStmt           = 53
+	SQLParserRULE_fieldName                   = 54
+	SQLParserRULE_targetFieldName             = 55
+	SQLParserRULE_fieldType                   = 56
+	SQLParserRULE_fields                      = 57
+	SQLParserRULE_field                       = 58
+	SQLParserRULE_alias                       = 59
+	SQLParserRULE_storageFilter               = 60
+	SQLParserRULE_databaseFilter              = 61
+	SQLParserRULE_typeFilter                  = 62
+	SQLParserRULE_fromClause                  = 63
+	SQLParserRULE_queryFromClause             = 64
+	SQLParserRULE_whereClause                 = 65
+	SQLParserRULE_conditionExpr               = 66
+	SQLParserRULE_tagFilterExpr               = 67
+	SQLParserRULE_tagValueList                = 68
+	SQLParserRULE_metricListFilter            = 69
+	SQLParserRULE_metricList                  = 70
+	SQLParserRULE_timeRangeExpr               = 71
+	SQLParserRULE_timeExpr                    = 72
+	SQLParserRULE_nowExpr                     = 73
+	SQLParserRULE_nowFunc                     = 74
+	SQLParserRULE_groupByClause               = 75
+	SQLParserRULE_groupByKeys                 = 76
+	SQLParserRULE_groupByKey                  = 77
+	SQLParserRULE_tagTransformExpr            = 78
+	SQLParserRULE_fillOption                  = 79
+	SQLParserRULE_orderByClause               = 80
+	SQLParserRULE_sortField                   = 81
+	SQLParserRULE_sortFields                  = 82
+	SQLParserRULE_havingClause                = 83
+	SQLParserRULE_boolExpr                    = 84
+	SQLParserRULE_boolExprLogicalOp           = 85
+	SQLParserRULE_boolExprAtom                = 86
+	SQLParserRULE_binaryExpr                  = 87
+	SQLParserRULE_binaryOperator              = 88
+	SQLParserRULE_fieldExpr                   = 89
+	SQLParserRULE_durationLit                 = 90
+	SQLParserRULE_intervalItem                = 91
+	SQLParserRULE_exprFunc                    = 92
+	SQLParserRULE_funcName                    = 93
+	SQLParserRULE_exprFuncParams              = 94
+	SQLParserRULE_funcParam                   = 95
+	SQLParserRULE_exprAtom                    = 96
+	SQLParserRULE_identFilter                 = 97
+	SQLParserRULE_json                        = 98
+	SQLParserRULE_obj                         = 99
+	SQLParserRULE_pair                        = 100
+	SQLParserRULE_arr                         = 101
+	SQLParserRULE_value                       = 102
+	SQLParserRULE_intNumber                   = 103
+	SQLParserRULE_decNumber                   = 104
+	SQLParserRULE_limitClause                 = 105
+	SQLParserRULE_timeZoneClause              = 106
+	SQLParserRULE_withExemplarsClause         = 107
+	SQLParserRULE_metricName                  = 108
+	SQLParserRULE_tagKey                      = 109
+	SQLParserRULE_tagValue                    = 110
+	SQLParserRULE_ident                       = 111
+	SQLParserRULE_nonReservedWords            = 112
 )
 
 // IStatementContext is an interface to support dynamic dispatch.
@@ -749,11 +992,11 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(164)
+		p.SetState(226)
 		p.StatementList()
 	}
 	{
-		p.SetState(165)
+		p.SetState(227)
 		p.Match(SQLParserEOF)
 	}
 
@@ -908,6 +1151,16 @@ func (s *StatementListContext) ShowReplicationStmt() IShowReplicationStmtContext
 	return t.(IShowReplicationStmtContext)
 }
 
+func (s *StatementListContext) ShowLimitsStmt() IShowLimitsStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowLimitsStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowLimitsStmtContext)
+}
+
 func (s *StatementListContext) ShowSchemasStmt() IShowSchemasStmtContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowSchemasStmtContext)(nil)).Elem(), 0)
 
@@ -988,6 +1241,36 @@ func (s *StatementListContext) ShowTagValuesStmt() IShowTagValuesStmtContext {
 	return t.(IShowTagValuesStmtContext)
 }
 
+func (s *StatementListContext) ShowMetricCardinalityStmt() IShowMetricCardinalityStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowMetricCardinalityStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMetricCardinalityStmtContext)
+}
+
+func (s *StatementListContext) ShowTagKeyCardinalityStmt() IShowTagKeyCardinalityStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowTagKeyCardinalityStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagKeyCardinalityStmtContext)
+}
+
+func (s *StatementListContext) ShowTagValueCardinalityStmt() IShowTagValueCardinalityStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowTagValueCardinalityStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagValueCardinalityStmtContext)
+}
+
 func (s *StatementListContext) QueryStmt() IQueryStmtContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IQueryStmtContext)(nil)).Elem(), 0)
 
@@ -998,6 +1281,46 @@ func (s *StatementListContext) QueryStmt() IQueryStmtContext {
 	return t.(IQueryStmtContext)
 }
 
+func (s *StatementListContext) DeleteStmt() IDeleteStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeleteStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeleteStmtContext)
+}
+
+func (s *StatementListContext) AlterMetricStmt() IAlterMetricStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAlterMetricStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterMetricStmtContext)
+}
+
+func (s *StatementListContext) DropMetricStmt() IDropMetricStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDropMetricStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropMetricStmtContext)
+}
+
+func (s *StatementListContext) DropNamespaceStmt() IDropNamespaceStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDropNamespaceStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropNamespaceStmtContext)
+}
+
 func (s *StatementListContext) CreateDatabaseStmt() ICreateDatabaseStmtContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICreateDatabaseStmtContext)(nil)).Elem(), 0)
 
@@ -1018,6 +1341,86 @@ func (s *StatementListContext) DropDatabaseStmt() IDropDatabaseStmtContext {
 	return t.(IDropDatabaseStmtContext)
 }
 
+func (s *StatementListContext) CreateUserStmt() ICreateUserStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICreateUserStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateUserStmtContext)
+}
+
+func (s *StatementListContext) DropUserStmt() IDropUserStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDropUserStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropUserStmtContext)
+}
+
+func (s *StatementListContext) ShowUsersStmt() IShowUsersStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowUsersStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowUsersStmtContext)
+}
+
+func (s *StatementListContext) CreateRoleStmt() ICreateRoleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICreateRoleStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateRoleStmtContext)
+}
+
+func (s *StatementListContext) DropRoleStmt() IDropRoleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDropRoleStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropRoleStmtContext)
+}
+
+func (s *StatementListContext) ShowRolesStmt() IShowRolesStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShowRolesStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRolesStmtContext)
+}
+
+func (s *StatementListContext) GrantStmt() IGrantStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGrantStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantStmtContext)
+}
+
+func (s *StatementListContext) RevokeStmt() IRevokeStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRevokeStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRevokeStmtContext)
+}
+
 func (s *StatementListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1058,163 +1461,275 @@ func (p *SQLParser) StatementList() (localctx IStatementListContext) {
 		}
 	}()
 
-	p.SetState(189)
+	p.SetState(267)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(167)
+			p.SetState(229)
 			p.ShowMasterStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(168)
+			p.SetState(230)
 			p.ShowMetadataTypesStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(169)
+			p.SetState(231)
 			p.ShowBrokerMetaStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(170)
+			p.SetState(232)
 			p.ShowMasterMetaStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(171)
+			p.SetState(233)
 			p.ShowStorageMetaStmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(172)
+			p.SetState(234)
 			p.ShowStoragesStmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(173)
+			p.SetState(235)
 			p.ShowAliveStmt()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(174)
+			p.SetState(236)
 			p.ShowBrokerMetricStmt()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(175)
+			p.SetState(237)
 			p.ShowStorageMetricStmt()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(176)
+			p.SetState(238)
 			p.CreateStorageStmt()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(177)
+			p.SetState(239)
 			p.ShowReplicationStmt()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(178)
-			p.ShowSchemasStmt()
+			p.SetState(240)
+			p.ShowLimitsStmt()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(179)
-			p.ShowDatabaseStmt()
+			p.SetState(241)
+			p.ShowSchemasStmt()
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(180)
-			p.UseStmt()
+			p.SetState(242)
+			p.ShowDatabaseStmt()
 		}
 
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(181)
-			p.ShowNameSpacesStmt()
+			p.SetState(243)
+			p.UseStmt()
 		}
 
 	case 16:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(182)
-			p.ShowMetricsStmt()
+			p.SetState(244)
+			p.ShowNameSpacesStmt()
 		}
 
 	case 17:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(183)
-			p.ShowFieldsStmt()
+			p.SetState(245)
+			p.ShowMetricsStmt()
 		}
 
 	case 18:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(184)
-			p.ShowTagKeysStmt()
+			p.SetState(246)
+			p.ShowFieldsStmt()
 		}
 
 	case 19:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(185)
-			p.ShowTagValuesStmt()
+			p.SetState(247)
+			p.ShowTagKeysStmt()
 		}
 
 	case 20:
 		p.EnterOuterAlt(localctx, 20)
 		{
-			p.SetState(186)
-			p.QueryStmt()
+			p.SetState(248)
+			p.ShowTagValuesStmt()
 		}
 
 	case 21:
 		p.EnterOuterAlt(localctx, 21)
 		{
-			p.SetState(187)
-			p.CreateDatabaseStmt()
+			p.SetState(249)
+			p.ShowMetricCardinalityStmt()
 		}
 
 	case 22:
 		p.EnterOuterAlt(localctx, 22)
 		{
-			p.SetState(188)
+			p.SetState(250)
+			p.ShowTagKeyCardinalityStmt()
+		}
+
+	case 23:
+		p.EnterOuterAlt(localctx, 23)
+		{
+			p.SetState(251)
+			p.ShowTagValueCardinalityStmt()
+		}
+
+	case 24:
+		p.EnterOuterAlt(localctx, 24)
+		{
+			p.SetState(252)
+			p.QueryStmt()
+		}
+
+	case 25:
+		p.EnterOuterAlt(localctx, 25)
+		{
+			p.SetState(253)
+			p.DeleteStmt()
+		}
+
+	case 26:
+		p.EnterOuterAlt(localctx, 26)
+		{
+			p.SetState(254)
+			p.AlterMetricStmt()
+		}
+
+	case 27:
+		p.EnterOuterAlt(localctx, 27)
+		{
+			p.SetState(255)
+			p.DropMetricStmt()
+		}
+
+	case 28:
+		p.EnterOuterAlt(localctx, 28)
+		{
+			p.SetState(256)
+			p.DropNamespaceStmt()
+		}
+
+	case 29:
+		p.EnterOuterAlt(localctx, 29)
+		{
+			p.SetState(257)
+			p.CreateDatabaseStmt()
+		}
+
+	case 30:
+		p.EnterOuterAlt(localctx, 30)
+		{
+			p.SetState(258)
 			p.DropDatabaseStmt()
 		}
 
+	case 31:
+		p.EnterOuterAlt(localctx, 31)
+		{
+			p.SetState(259)
+			p.CreateUserStmt()
+		}
+
+	case 32:
+		p.EnterOuterAlt(localctx, 32)
+		{
+			p.SetState(260)
+			p.DropUserStmt()
+		}
+
+	case 33:
+		p.EnterOuterAlt(localctx, 33)
+		{
+			p.SetState(261)
+			p.ShowUsersStmt()
+		}
+
+	case 34:
+		p.EnterOuterAlt(localctx, 34)
+		{
+			p.SetState(262)
+			p.CreateRoleStmt()
+		}
+
+	case 35:
+		p.EnterOuterAlt(localctx, 35)
+		{
+			p.SetState(263)
+			p.DropRoleStmt()
+		}
+
+	case 36:
+		p.EnterOuterAlt(localctx, 36)
+		{
+			p.SetState(264)
+			p.ShowRolesStmt()
+		}
+
+	case 37:
+		p.EnterOuterAlt(localctx, 37)
+		{
+			p.SetState(265)
+			p.GrantStmt()
+		}
+
+	case 38:
+		p.EnterOuterAlt(localctx, 38)
+		{
+			p.SetState(266)
+			p.RevokeStmt()
+		}
+
 	}
 
 	return localctx
@@ -1314,11 +1829,11 @@ func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(191)
+		p.SetState(269)
 		p.Match(SQLParserT_USE)
 	}
 	{
-		p.SetState(192)
+		p.SetState(270)
 		p.Ident()
 	}
 
@@ -1413,11 +1928,11 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(194)
+		p.SetState(272)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(195)
+		p.SetState(273)
 		p.Match(SQLParserT_MASTER)
 	}
 
@@ -1512,11 +2027,11 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(197)
+		p.SetState(275)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(198)
+		p.SetState(276)
 		p.Match(SQLParserT_STORAGES)
 	}
 
@@ -1615,15 +2130,15 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(200)
+		p.SetState(278)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(201)
+		p.SetState(279)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(202)
+		p.SetState(280)
 		p.Match(SQLParserT_TYPES)
 	}
 
@@ -1750,31 +2265,31 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(204)
+		p.SetState(282)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(205)
+		p.SetState(283)
 		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(206)
+		p.SetState(284)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(207)
+		p.SetState(285)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(208)
+		p.SetState(286)
 		p.Source()
 	}
 	{
-		p.SetState(209)
+		p.SetState(287)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(210)
+		p.SetState(288)
 		p.TypeFilter()
 	}
 
@@ -1901,31 +2416,31 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(212)
+		p.SetState(290)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(213)
+		p.SetState(291)
 		p.Match(SQLParserT_MASTER)
 	}
 	{
-		p.SetState(214)
+		p.SetState(292)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(215)
+		p.SetState(293)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(216)
+		p.SetState(294)
 		p.Source()
 	}
 	{
-		p.SetState(217)
+		p.SetState(295)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(218)
+		p.SetState(296)
 		p.TypeFilter()
 	}
 
@@ -2092,42 +2607,42 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(220)
+		p.SetState(298)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(221)
+		p.SetState(299)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(222)
+		p.SetState(300)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(223)
+		p.SetState(301)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(224)
+		p.SetState(302)
 		p.Source()
 	}
 	{
-		p.SetState(225)
+		p.SetState(303)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(228)
+	p.SetState(306)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(226)
+			p.SetState(304)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_TYPE:
 		{
-			p.SetState(227)
+			p.SetState(305)
 			p.TypeFilter()
 		}
 
@@ -2135,22 +2650,22 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(230)
+		p.SetState(308)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(233)
+	p.SetState(311)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(231)
+			p.SetState(309)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_TYPE:
 		{
-			p.SetState(232)
+			p.SetState(310)
 			p.TypeFilter()
 		}
 
@@ -2258,11 +2773,11 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(235)
+		p.SetState(313)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(236)
+		p.SetState(314)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_STORAGE || _la == SQLParserT_BROKER) {
@@ -2273,7 +2788,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 		}
 	}
 	{
-		p.SetState(237)
+		p.SetState(315)
 		p.Match(SQLParserT_ALIVE)
 	}
 
@@ -2422,30 +2937,30 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(239)
+		p.SetState(317)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(240)
+		p.SetState(318)
 		p.Match(SQLParserT_REPLICATION)
 	}
 	{
-		p.SetState(241)
+		p.SetState(319)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(244)
+	p.SetState(322)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(242)
+			p.SetState(320)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(243)
+			p.SetState(321)
 			p.DatabaseFilter()
 		}
 
@@ -2453,22 +2968,22 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(246)
+		p.SetState(324)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(249)
+	p.SetState(327)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(247)
+			p.SetState(325)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(248)
+			p.SetState(326)
 			p.DatabaseFilter()
 		}
 
@@ -2479,93 +2994,90 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 	return localctx
 }
 
-// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
-type IShowBrokerMetricStmtContext interface {
+// IShowLimitsStmtContext is an interface to support dynamic dispatch.
+type IShowLimitsStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
-	IsShowBrokerMetricStmtContext()
+	// IsShowLimitsStmtContext differentiates from other interfaces.
+	IsShowLimitsStmtContext()
 }
 
-type ShowBrokerMetricStmtContext struct {
+type ShowLimitsStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewEmptyShowLimitsStmtContext() *ShowLimitsStmtContext {
+	var p = new(ShowLimitsStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_showLimitsStmt
 	return p
 }
 
-func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
+func (*ShowLimitsStmtContext) IsShowLimitsStmtContext() {}
 
-func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewShowLimitsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowLimitsStmtContext {
+	var p = new(ShowLimitsStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_showLimitsStmt
 
 	return p
 }
 
-func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowLimitsStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowLimitsStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
+func (s *ShowLimitsStmtContext) T_LIMITS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMITS, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
+func (s *ShowLimitsStmtContext) T_WHERE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMetricListFilterContext)(nil)).Elem(), 0)
+func (s *ShowLimitsStmtContext) DatabaseFilter() IDatabaseFilterContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDatabaseFilterContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMetricListFilterContext)
+	return t.(IDatabaseFilterContext)
 }
 
-func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowLimitsStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowLimitsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowLimitsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokerMetricStmt(s)
+		listenerT.EnterShowLimitsStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowLimitsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokerMetricStmt(s)
+		listenerT.ExitShowLimitsStmt(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
-	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SQLParserRULE_showBrokerMetricStmt)
+func (p *SQLParser) ShowLimitsStmt() (localctx IShowLimitsStmtContext) {
+	localctx = NewShowLimitsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SQLParserRULE_showLimitsStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2585,23 +3097,155 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(251)
+		p.SetState(329)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(252)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(253)
-		p.Match(SQLParserT_METRIC)
+		p.SetState(330)
+		p.Match(SQLParserT_LIMITS)
+	}
+	p.SetState(333)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(331)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(332)
+			p.DatabaseFilter()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
+type IShowBrokerMetricStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
+	IsShowBrokerMetricStmtContext()
+}
+
+type ShowBrokerMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	return p
+}
+
+func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
+
+func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+
+	return p
+}
+
+func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMetricListFilterContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricListFilterContext)
+}
+
+func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowBrokerMetricStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowBrokerMetricStmt(s)
+	}
+}
+
+func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
+	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SQLParserRULE_showBrokerMetricStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(335)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(336)
+		p.Match(SQLParserT_BROKER)
+	}
+	{
+		p.SetState(337)
+		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(254)
+		p.SetState(338)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(255)
+		p.SetState(339)
 		p.MetricListFilter()
 	}
 
@@ -2734,7 +3378,7 @@ func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener
 
 func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
 	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SQLParserRULE_showStorageMetricStmt)
+	p.EnterRule(localctx, 26, SQLParserRULE_showStorageMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2754,34 +3398,34 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(257)
+		p.SetState(341)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(258)
+		p.SetState(342)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(259)
+		p.SetState(343)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(260)
+		p.SetState(344)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(263)
+	p.SetState(347)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(261)
+			p.SetState(345)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_METRIC:
 		{
-			p.SetState(262)
+			p.SetState(346)
 			p.MetricListFilter()
 		}
 
@@ -2789,22 +3433,22 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(265)
+		p.SetState(349)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(268)
+	p.SetState(352)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(266)
+			p.SetState(350)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_METRIC:
 		{
-			p.SetState(267)
+			p.SetState(351)
 			p.MetricListFilter()
 		}
 
@@ -2893,7 +3537,7 @@ func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SQLParserRULE_createStorageStmt)
+	p.EnterRule(localctx, 28, SQLParserRULE_createStorageStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2913,15 +3557,15 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(270)
+		p.SetState(354)
 		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(271)
+		p.SetState(355)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(272)
+		p.SetState(356)
 		p.Json()
 	}
 
@@ -2996,7 +3640,7 @@ func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SQLParserRULE_showSchemasStmt)
+	p.EnterRule(localctx, 30, SQLParserRULE_showSchemasStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3016,11 +3660,11 @@ func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(274)
+		p.SetState(358)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(275)
+		p.SetState(359)
 		p.Match(SQLParserT_SCHEMAS)
 	}
 
@@ -3105,7 +3749,7 @@ func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SQLParserRULE_createDatabaseStmt)
+	p.EnterRule(localctx, 32, SQLParserRULE_createDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3125,15 +3769,15 @@ func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(277)
+		p.SetState(361)
 		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(278)
+		p.SetState(362)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(279)
+		p.SetState(363)
 		p.Json()
 	}
 
@@ -3218,7 +3862,7 @@ func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SQLParserRULE_dropDatabaseStmt)
+	p.EnterRule(localctx, 34, SQLParserRULE_dropDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3238,15 +3882,15 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(281)
+		p.SetState(365)
 		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(282)
+		p.SetState(366)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(283)
+		p.SetState(367)
 		p.DatabaseName()
 	}
 
@@ -3321,7 +3965,7 @@ func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SQLParserRULE_showDatabaseStmt)
+	p.EnterRule(localctx, 36, SQLParserRULE_showDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3341,11 +3985,11 @@ func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(285)
+		p.SetState(369)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(286)
+		p.SetState(370)
 		p.Match(SQLParserT_DATASBAES)
 	}
 
@@ -3452,7 +4096,7 @@ func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SQLParserRULE_showNameSpacesStmt)
+	p.EnterRule(localctx, 38, SQLParserRULE_showNameSpacesStmt)
 	var _la int
 
 	defer func() {
@@ -3473,43 +4117,43 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(288)
+		p.SetState(372)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(289)
+		p.SetState(373)
 		p.Match(SQLParserT_NAMESPACES)
 	}
-	p.SetState(294)
+	p.SetState(378)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(290)
+			p.SetState(374)
 			p.Match(SQLParserT_WHERE)
 		}
 		{
-			p.SetState(291)
+			p.SetState(375)
 			p.Match(SQLParserT_NAMESPACE)
 		}
 		{
-			p.SetState(292)
+			p.SetState(376)
 			p.Match(SQLParserT_EQUAL)
 		}
 		{
-			p.SetState(293)
+			p.SetState(377)
 			p.Prefix()
 		}
 
 	}
-	p.SetState(297)
+	p.SetState(381)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(296)
+			p.SetState(380)
 			p.LimitClause()
 		}
 
@@ -3632,7 +4276,7 @@ func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SQLParserRULE_showMetricsStmt)
+	p.EnterRule(localctx, 40, SQLParserRULE_showMetricsStmt)
 	var _la int
 
 	defer func() {
@@ -3653,58 +4297,58 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(299)
+		p.SetState(383)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(300)
+		p.SetState(384)
 		p.Match(SQLParserT_METRICS)
 	}
-	p.SetState(303)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ON {
 		{
-			p.SetState(301)
+			p.SetState(385)
 			p.Match(SQLParserT_ON)
 		}
 		{
-			p.SetState(302)
+			p.SetState(386)
 			p.Namespace()
 		}
 
 	}
-	p.SetState(309)
+	p.SetState(393)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(305)
+			p.SetState(389)
 			p.Match(SQLParserT_WHERE)
 		}
 		{
-			p.SetState(306)
+			p.SetState(390)
 			p.Match(SQLParserT_METRIC)
 		}
 		{
-			p.SetState(307)
+			p.SetState(391)
 			p.Match(SQLParserT_EQUAL)
 		}
 		{
-			p.SetState(308)
+			p.SetState(392)
 			p.Prefix()
 		}
 
 	}
-	p.SetState(312)
+	p.SetState(396)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(311)
+			p.SetState(395)
 			p.LimitClause()
 		}
 
@@ -3791,7 +4435,7 @@ func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SQLParserRULE_showFieldsStmt)
+	p.EnterRule(localctx, 42, SQLParserRULE_showFieldsStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3811,15 +4455,15 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(314)
+		p.SetState(398)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(315)
+		p.SetState(399)
 		p.Match(SQLParserT_FIELDS)
 	}
 	{
-		p.SetState(316)
+		p.SetState(400)
 		p.FromClause()
 	}
 
@@ -3908,7 +4552,7 @@ func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SQLParserRULE_showTagKeysStmt)
+	p.EnterRule(localctx, 44, SQLParserRULE_showTagKeysStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3928,19 +4572,19 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(318)
+		p.SetState(402)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(319)
+		p.SetState(403)
 		p.Match(SQLParserT_TAG)
 	}
 	{
-		p.SetState(320)
+		p.SetState(404)
 		p.Match(SQLParserT_KEYS)
 	}
 	{
-		p.SetState(321)
+		p.SetState(405)
 		p.FromClause()
 	}
 
@@ -4071,7 +4715,7 @@ func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SQLParserRULE_showTagValuesStmt)
+	p.EnterRule(localctx, 46, SQLParserRULE_showTagValuesStmt)
 	var _la int
 
 	defer func() {
@@ -4092,55 +4736,55 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(323)
+		p.SetState(407)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(324)
+		p.SetState(408)
 		p.Match(SQLParserT_TAG)
 	}
 	{
-		p.SetState(325)
+		p.SetState(409)
 		p.Match(SQLParserT_VALUES)
 	}
 	{
-		p.SetState(326)
+		p.SetState(410)
 		p.FromClause()
 	}
 	{
-		p.SetState(327)
+		p.SetState(411)
 		p.Match(SQLParserT_WITH)
 	}
 	{
-		p.SetState(328)
+		p.SetState(412)
 		p.Match(SQLParserT_KEY)
 	}
 	{
-		p.SetState(329)
+		p.SetState(413)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(330)
+		p.SetState(414)
 		p.WithTagKey()
 	}
-	p.SetState(332)
+	p.SetState(416)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(331)
+			p.SetState(415)
 			p.WhereClause()
 		}
 
 	}
-	p.SetState(335)
+	p.SetState(419)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(334)
+			p.SetState(418)
 			p.LimitClause()
 		}
 
@@ -4149,77 +4793,126 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 	return localctx
 }
 
-// IPrefixContext is an interface to support dynamic dispatch.
-type IPrefixContext interface {
+// IShowMetricCardinalityStmtContext is an interface to support dynamic dispatch.
+type IShowMetricCardinalityStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPrefixContext differentiates from other interfaces.
-	IsPrefixContext()
+	// IsShowMetricCardinalityStmtContext differentiates from other interfaces.
+	IsShowMetricCardinalityStmtContext()
 }
 
-type PrefixContext struct {
+type ShowMetricCardinalityStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrefixContext() *PrefixContext {
-	var p = new(PrefixContext)
+func NewEmptyShowMetricCardinalityStmtContext() *ShowMetricCardinalityStmtContext {
+	var p = new(ShowMetricCardinalityStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showMetricCardinalityStmt
 	return p
 }
 
-func (*PrefixContext) IsPrefixContext() {}
+func (*ShowMetricCardinalityStmtContext) IsShowMetricCardinalityStmtContext() {}
 
-func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
-	var p = new(PrefixContext)
+func NewShowMetricCardinalityStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricCardinalityStmtContext {
+	var p = new(ShowMetricCardinalityStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showMetricCardinalityStmt
 
 	return p
 }
 
-func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowMetricCardinalityStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PrefixContext) Ident() IIdentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+func (s *ShowMetricCardinalityStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetricCardinalityStmtContext) AllT_METRIC() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_METRIC)
+}
+
+func (s *ShowMetricCardinalityStmtContext) T_METRIC(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, i)
+}
+
+func (s *ShowMetricCardinalityStmtContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *ShowMetricCardinalityStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowMetricCardinalityStmtContext) Namespace() INamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(INamespaceContext)
 }
 
-func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowMetricCardinalityStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMetricCardinalityStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowMetricCardinalityStmtContext) Prefix() IPrefixContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrefixContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowMetricCardinalityStmtContext) LimitClause() ILimitClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowMetricCardinalityStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowMetricCardinalityStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowMetricCardinalityStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterPrefix(s)
+		listenerT.EnterShowMetricCardinalityStmt(s)
 	}
 }
 
-func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowMetricCardinalityStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitPrefix(s)
+		listenerT.ExitShowMetricCardinalityStmt(s)
 	}
 }
 
-func (p *SQLParser) Prefix() (localctx IPrefixContext) {
-	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SQLParserRULE_prefix)
+func (p *SQLParser) ShowMetricCardinalityStmt() (localctx IShowMetricCardinalityStmtContext) {
+	localctx = NewShowMetricCardinalityStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SQLParserRULE_showMetricCardinalityStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4239,84 +4932,157 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(337)
-		p.Ident()
+		p.SetState(421)
+		p.Match(SQLParserT_SHOW)
 	}
+	{
+		p.SetState(422)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(423)
+		p.Match(SQLParserT_CARDINALITY)
+	}
+	p.SetState(426)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	return localctx
-}
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(424)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(425)
+			p.Namespace()
+		}
 
-// IWithTagKeyContext is an interface to support dynamic dispatch.
-type IWithTagKeyContext interface {
-	antlr.ParserRuleContext
+	}
+	p.SetState(432)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(428)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(429)
+			p.Match(SQLParserT_METRIC)
+		}
+		{
+			p.SetState(430)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(431)
+			p.Prefix()
+		}
 
-	// IsWithTagKeyContext differentiates from other interfaces.
-	IsWithTagKeyContext()
+	}
+	p.SetState(435)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(434)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
 }
 
-type WithTagKeyContext struct {
+// IShowTagKeyCardinalityStmtContext is an interface to support dynamic dispatch.
+type IShowTagKeyCardinalityStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowTagKeyCardinalityStmtContext differentiates from other interfaces.
+	IsShowTagKeyCardinalityStmtContext()
+}
+
+type ShowTagKeyCardinalityStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWithTagKeyContext() *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewEmptyShowTagKeyCardinalityStmtContext() *ShowTagKeyCardinalityStmtContext {
+	var p = new(ShowTagKeyCardinalityStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_showTagKeyCardinalityStmt
 	return p
 }
 
-func (*WithTagKeyContext) IsWithTagKeyContext() {}
+func (*ShowTagKeyCardinalityStmtContext) IsShowTagKeyCardinalityStmtContext() {}
 
-func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewShowTagKeyCardinalityStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeyCardinalityStmtContext {
+	var p = new(ShowTagKeyCardinalityStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_showTagKeyCardinalityStmt
 
 	return p
 }
 
-func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTagKeyCardinalityStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithTagKeyContext) Ident() IIdentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+func (s *ShowTagKeyCardinalityStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagKeyCardinalityStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagKeyCardinalityStmtContext) T_KEY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEY, 0)
+}
+
+func (s *ShowTagKeyCardinalityStmtContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *ShowTagKeyCardinalityStmtContext) FromClause() IFromClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFromClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTagKeyCardinalityStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTagKeyCardinalityStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagKeyCardinalityStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterWithTagKey(s)
+		listenerT.EnterShowTagKeyCardinalityStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagKeyCardinalityStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitWithTagKey(s)
+		listenerT.ExitShowTagKeyCardinalityStmt(s)
 	}
 }
 
-func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
-	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SQLParserRULE_withTagKey)
+func (p *SQLParser) ShowTagKeyCardinalityStmt() (localctx IShowTagKeyCardinalityStmtContext) {
+	localctx = NewShowTagKeyCardinalityStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SQLParserRULE_showTagKeyCardinalityStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4336,84 +5102,149 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(339)
-		p.Ident()
+		p.SetState(437)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(438)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(439)
+		p.Match(SQLParserT_KEY)
+	}
+	{
+		p.SetState(440)
+		p.Match(SQLParserT_CARDINALITY)
+	}
+	{
+		p.SetState(441)
+		p.FromClause()
 	}
 
 	return localctx
 }
 
-// INamespaceContext is an interface to support dynamic dispatch.
-type INamespaceContext interface {
+// IShowTagValueCardinalityStmtContext is an interface to support dynamic dispatch.
+type IShowTagValueCardinalityStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsNamespaceContext differentiates from other interfaces.
-	IsNamespaceContext()
+	// IsShowTagValueCardinalityStmtContext differentiates from other interfaces.
+	IsShowTagValueCardinalityStmtContext()
 }
 
-type NamespaceContext struct {
+type ShowTagValueCardinalityStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamespaceContext() *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewEmptyShowTagValueCardinalityStmtContext() *ShowTagValueCardinalityStmtContext {
+	var p = new(ShowTagValueCardinalityStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_showTagValueCardinalityStmt
 	return p
 }
 
-func (*NamespaceContext) IsNamespaceContext() {}
+func (*ShowTagValueCardinalityStmtContext) IsShowTagValueCardinalityStmtContext() {}
 
-func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewShowTagValueCardinalityStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValueCardinalityStmtContext {
+	var p = new(ShowTagValueCardinalityStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_showTagValueCardinalityStmt
 
 	return p
 }
 
-func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTagValueCardinalityStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamespaceContext) Ident() IIdentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+func (s *ShowTagValueCardinalityStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) T_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VALUE, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) FromClause() IFromClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFromClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTagValueCardinalityStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) T_KEY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEY, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) WithTagKey() IWithTagKeyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWithTagKeyContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithTagKeyContext)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) LimitClause() ILimitClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowTagValueCardinalityStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTagValueCardinalityStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagValueCardinalityStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNamespace(s)
+		listenerT.EnterShowTagValueCardinalityStmt(s)
 	}
 }
 
-func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagValueCardinalityStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNamespace(s)
+		listenerT.ExitShowTagValueCardinalityStmt(s)
 	}
 }
 
-func (p *SQLParser) Namespace() (localctx INamespaceContext) {
-	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SQLParserRULE_namespace)
+func (p *SQLParser) ShowTagValueCardinalityStmt() (localctx IShowTagValueCardinalityStmtContext) {
+	localctx = NewShowTagValueCardinalityStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SQLParserRULE_showTagValueCardinalityStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4433,52 +5264,95 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(341)
-		p.Ident()
+		p.SetState(443)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(444)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(445)
+		p.Match(SQLParserT_VALUE)
+	}
+	{
+		p.SetState(446)
+		p.Match(SQLParserT_CARDINALITY)
+	}
+	{
+		p.SetState(447)
+		p.FromClause()
+	}
+	{
+		p.SetState(448)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(449)
+		p.Match(SQLParserT_KEY)
+	}
+	{
+		p.SetState(450)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(451)
+		p.WithTagKey()
+	}
+	p.SetState(453)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(452)
+			p.LimitClause()
+		}
+
 	}
 
 	return localctx
 }
 
-// IDatabaseNameContext is an interface to support dynamic dispatch.
-type IDatabaseNameContext interface {
+// IPrefixContext is an interface to support dynamic dispatch.
+type IPrefixContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDatabaseNameContext differentiates from other interfaces.
-	IsDatabaseNameContext()
+	// IsPrefixContext differentiates from other interfaces.
+	IsPrefixContext()
 }
 
-type DatabaseNameContext struct {
+type PrefixContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseNameContext() *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewEmptyPrefixContext() *PrefixContext {
+	var p = new(PrefixContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_prefix
 	return p
 }
 
-func (*DatabaseNameContext) IsDatabaseNameContext() {}
+func (*PrefixContext) IsPrefixContext() {}
 
-func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
+	var p = new(PrefixContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_prefix
 
 	return p
 }
 
-func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DatabaseNameContext) Ident() IIdentContext {
+func (s *PrefixContext) Ident() IIdentContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -4488,29 +5362,29 @@ func (s *DatabaseNameContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseName(s)
+		listenerT.EnterPrefix(s)
 	}
 }
 
-func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseName(s)
+		listenerT.ExitPrefix(s)
 	}
 }
 
-func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
-	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SQLParserRULE_databaseName)
+func (p *SQLParser) Prefix() (localctx IPrefixContext) {
+	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SQLParserRULE_prefix)
 
 	defer func() {
 		p.ExitRule()
@@ -4530,82 +5404,3264 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(343)
+		p.SetState(455)
 		p.Ident()
 	}
 
 	return localctx
 }
 
-// ISourceContext is an interface to support dynamic dispatch.
-type ISourceContext interface {
+// IWithTagKeyContext is an interface to support dynamic dispatch.
+type IWithTagKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSourceContext differentiates from other interfaces.
-	IsSourceContext()
+	// IsWithTagKeyContext differentiates from other interfaces.
+	IsWithTagKeyContext()
 }
 
-type SourceContext struct {
+type WithTagKeyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceContext() *SourceContext {
-	var p = new(SourceContext)
+func NewEmptyWithTagKeyContext() *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_withTagKey
 	return p
 }
 
-func (*SourceContext) IsSourceContext() {}
+func (*WithTagKeyContext) IsWithTagKeyContext() {}
 
-func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
-	var p = new(SourceContext)
+func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_withTagKey
 
 	return p
 }
 
-func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
+func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
+func (s *WithTagKeyContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterWithTagKey(s)
+	}
+}
+
+func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitWithTagKey(s)
+	}
+}
+
+func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
+	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SQLParserRULE_withTagKey)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(457)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// INamespaceContext is an interface to support dynamic dispatch.
+type INamespaceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNamespaceContext differentiates from other interfaces.
+	IsNamespaceContext()
+}
+
+type NamespaceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamespaceContext() *NamespaceContext {
+	var p = new(NamespaceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_namespace
+	return p
+}
+
+func (*NamespaceContext) IsNamespaceContext() {}
+
+func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
+	var p = new(NamespaceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_namespace
+
+	return p
+}
+
+func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamespaceContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitNamespace(s)
+	}
+}
+
+func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SQLParserRULE_namespace)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(459)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IDatabaseNameContext is an interface to support dynamic dispatch.
+type IDatabaseNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDatabaseNameContext differentiates from other interfaces.
+	IsDatabaseNameContext()
+}
+
+type DatabaseNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDatabaseNameContext() *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseName
+	return p
+}
+
+func (*DatabaseNameContext) IsDatabaseNameContext() {}
+
+func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_databaseName
+
+	return p
+}
+
+func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DatabaseNameContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDatabaseName(s)
+	}
+}
+
+func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDatabaseName(s)
+	}
+}
+
+func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SQLParserRULE_databaseName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(461)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// ISourceContext is an interface to support dynamic dispatch.
+type ISourceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSourceContext differentiates from other interfaces.
+	IsSourceContext()
+}
+
+type SourceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySourceContext() *SourceContext {
+	var p = new(SourceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_source
+	return p
+}
+
+func (*SourceContext) IsSourceContext() {}
+
+func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
+	var p = new(SourceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_source
+
+	return p
+}
+
+func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
 }
 
-func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_REPO, 0)
+func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_REPO, 0)
+}
+
+func (s *SourceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSource(s)
+	}
+}
+
+func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSource(s)
+	}
+}
+
+func (p *SQLParser) Source() (localctx ISourceContext) {
+	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SQLParserRULE_source)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(463)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// ICreateUserStmtContext is an interface to support dynamic dispatch.
+type ICreateUserStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateUserStmtContext differentiates from other interfaces.
+	IsCreateUserStmtContext()
+}
+
+type CreateUserStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateUserStmtContext() *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createUserStmt
+	return p
+}
+
+func (*CreateUserStmtContext) IsCreateUserStmtContext() {}
+
+func NewCreateUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createUserStmt
+
+	return p
+}
+
+func (s *CreateUserStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateUserStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *CreateUserStmtContext) UserName() IUserNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUserNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *CreateUserStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *CreateUserStmtContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *CreateUserStmtContext) Password() IPasswordContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPasswordContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPasswordContext)
+}
+
+func (s *CreateUserStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateUserStmt(s)
+	}
+}
+
+func (s *CreateUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateUserStmt(s)
+	}
+}
+
+func (p *SQLParser) CreateUserStmt() (localctx ICreateUserStmtContext) {
+	localctx = NewCreateUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SQLParserRULE_createUserStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(465)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(466)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(467)
+		p.UserName()
+	}
+	{
+		p.SetState(468)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(469)
+		p.Match(SQLParserT_PASSWORD)
+	}
+	{
+		p.SetState(470)
+		p.Password()
+	}
+
+	return localctx
+}
+
+// IDropUserStmtContext is an interface to support dynamic dispatch.
+type IDropUserStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDropUserStmtContext differentiates from other interfaces.
+	IsDropUserStmtContext()
+}
+
+type DropUserStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDropUserStmtContext() *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_dropUserStmt
+	return p
+}
+
+func (*DropUserStmtContext) IsDropUserStmtContext() {}
+
+func NewDropUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_dropUserStmt
+
+	return p
+}
+
+func (s *DropUserStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropUserStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *DropUserStmtContext) UserName() IUserNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUserNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *DropUserStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDropUserStmt(s)
+	}
+}
+
+func (s *DropUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDropUserStmt(s)
+	}
+}
+
+func (p *SQLParser) DropUserStmt() (localctx IDropUserStmtContext) {
+	localctx = NewDropUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SQLParserRULE_dropUserStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(472)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(473)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(474)
+		p.UserName()
+	}
+
+	return localctx
+}
+
+// IShowUsersStmtContext is an interface to support dynamic dispatch.
+type IShowUsersStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowUsersStmtContext differentiates from other interfaces.
+	IsShowUsersStmtContext()
+}
+
+type ShowUsersStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowUsersStmtContext() *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showUsersStmt
+	return p
+}
+
+func (*ShowUsersStmtContext) IsShowUsersStmtContext() {}
+
+func NewShowUsersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showUsersStmt
+
+	return p
+}
+
+func (s *ShowUsersStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowUsersStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowUsersStmtContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
+}
+
+func (s *ShowUsersStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowUsersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowUsersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowUsersStmt(s)
+	}
+}
+
+func (s *ShowUsersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowUsersStmt(s)
+	}
+}
+
+func (p *SQLParser) ShowUsersStmt() (localctx IShowUsersStmtContext) {
+	localctx = NewShowUsersStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SQLParserRULE_showUsersStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(476)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(477)
+		p.Match(SQLParserT_USERS)
+	}
+
+	return localctx
+}
+
+// ICreateRoleStmtContext is an interface to support dynamic dispatch.
+type ICreateRoleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateRoleStmtContext differentiates from other interfaces.
+	IsCreateRoleStmtContext()
+}
+
+type CreateRoleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateRoleStmtContext() *CreateRoleStmtContext {
+	var p = new(CreateRoleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createRoleStmt
+	return p
+}
+
+func (*CreateRoleStmtContext) IsCreateRoleStmtContext() {}
+
+func NewCreateRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateRoleStmtContext {
+	var p = new(CreateRoleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createRoleStmt
+
+	return p
+}
+
+func (s *CreateRoleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateRoleStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *CreateRoleStmtContext) RoleName() IRoleNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRoleNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *CreateRoleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CreateRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateRoleStmt(s)
+	}
+}
+
+func (s *CreateRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateRoleStmt(s)
+	}
+}
+
+func (p *SQLParser) CreateRoleStmt() (localctx ICreateRoleStmtContext) {
+	localctx = NewCreateRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SQLParserRULE_createRoleStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(479)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(480)
+		p.Match(SQLParserT_ROLE)
+	}
+	{
+		p.SetState(481)
+		p.RoleName()
+	}
+
+	return localctx
+}
+
+// IDropRoleStmtContext is an interface to support dynamic dispatch.
+type IDropRoleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDropRoleStmtContext differentiates from other interfaces.
+	IsDropRoleStmtContext()
+}
+
+type DropRoleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDropRoleStmtContext() *DropRoleStmtContext {
+	var p = new(DropRoleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_dropRoleStmt
+	return p
+}
+
+func (*DropRoleStmtContext) IsDropRoleStmtContext() {}
+
+func NewDropRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropRoleStmtContext {
+	var p = new(DropRoleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_dropRoleStmt
+
+	return p
+}
+
+func (s *DropRoleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropRoleStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *DropRoleStmtContext) RoleName() IRoleNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRoleNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *DropRoleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDropRoleStmt(s)
+	}
+}
+
+func (s *DropRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDropRoleStmt(s)
+	}
+}
+
+func (p *SQLParser) DropRoleStmt() (localctx IDropRoleStmtContext) {
+	localctx = NewDropRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SQLParserRULE_dropRoleStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(483)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(484)
+		p.Match(SQLParserT_ROLE)
+	}
+	{
+		p.SetState(485)
+		p.RoleName()
+	}
+
+	return localctx
+}
+
+// IShowRolesStmtContext is an interface to support dynamic dispatch.
+type IShowRolesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowRolesStmtContext differentiates from other interfaces.
+	IsShowRolesStmtContext()
+}
+
+type ShowRolesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRolesStmtContext() *ShowRolesStmtContext {
+	var p = new(ShowRolesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRolesStmt
+	return p
+}
+
+func (*ShowRolesStmtContext) IsShowRolesStmtContext() {}
+
+func NewShowRolesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRolesStmtContext {
+	var p = new(ShowRolesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRolesStmt
+
+	return p
+}
+
+func (s *ShowRolesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRolesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRolesStmtContext) T_ROLES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLES, 0)
+}
+
+func (s *ShowRolesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRolesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRolesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRolesStmt(s)
+	}
+}
+
+func (s *ShowRolesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRolesStmt(s)
+	}
+}
+
+func (p *SQLParser) ShowRolesStmt() (localctx IShowRolesStmtContext) {
+	localctx = NewShowRolesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SQLParserRULE_showRolesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(487)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(488)
+		p.Match(SQLParserT_ROLES)
+	}
+
+	return localctx
+}
+
+// IGrantStmtContext is an interface to support dynamic dispatch.
+type IGrantStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGrantStmtContext differentiates from other interfaces.
+	IsGrantStmtContext()
+}
+
+type GrantStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantStmtContext() *GrantStmtContext {
+	var p = new(GrantStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_grantStmt
+	return p
+}
+
+func (*GrantStmtContext) IsGrantStmtContext() {}
+
+func NewGrantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantStmtContext {
+	var p = new(GrantStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_grantStmt
+
+	return p
+}
+
+func (s *GrantStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantStmtContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
+}
+
+func (s *GrantStmtContext) Privilege() IPrivilegeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrivilegeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrivilegeContext)
+}
+
+func (s *GrantStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *GrantStmtContext) GrantDatabase() IGrantDatabaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGrantDatabaseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantDatabaseContext)
+}
+
+func (s *GrantStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *GrantStmtContext) Grantee() IGranteeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGranteeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGranteeContext)
+}
+
+func (s *GrantStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *GrantStmtContext) GrantNamespace() IGrantNamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGrantNamespaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantNamespaceContext)
+}
+
+func (s *GrantStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *GrantStmtContext) RoleName() IRoleNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRoleNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *GrantStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *GrantStmtContext) UserName() IUserNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUserNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *GrantStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterGrantStmt(s)
+	}
+}
+
+func (s *GrantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitGrantStmt(s)
+	}
+}
+
+func (p *SQLParser) GrantStmt() (localctx IGrantStmtContext) {
+	localctx = NewGrantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SQLParserRULE_grantStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(508)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(490)
+			p.Match(SQLParserT_GRANT)
+		}
+		{
+			p.SetState(491)
+			p.Privilege()
+		}
+		{
+			p.SetState(492)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(493)
+			p.GrantDatabase()
+		}
+		p.SetState(496)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_NAMESPACE {
+			{
+				p.SetState(494)
+				p.Match(SQLParserT_NAMESPACE)
+			}
+			{
+				p.SetState(495)
+				p.GrantNamespace()
+			}
+
+		}
+		{
+			p.SetState(498)
+			p.Match(SQLParserT_TO)
+		}
+		{
+			p.SetState(499)
+			p.Grantee()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(501)
+			p.Match(SQLParserT_GRANT)
+		}
+		{
+			p.SetState(502)
+			p.Match(SQLParserT_ROLE)
+		}
+		{
+			p.SetState(503)
+			p.RoleName()
+		}
+		{
+			p.SetState(504)
+			p.Match(SQLParserT_TO)
+		}
+		{
+			p.SetState(505)
+			p.Match(SQLParserT_USER)
+		}
+		{
+			p.SetState(506)
+			p.UserName()
+		}
+
+	}
+
+	return localctx
+}
+
+// IRevokeStmtContext is an interface to support dynamic dispatch.
+type IRevokeStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRevokeStmtContext differentiates from other interfaces.
+	IsRevokeStmtContext()
+}
+
+type RevokeStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRevokeStmtContext() *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_revokeStmt
+	return p
+}
+
+func (*RevokeStmtContext) IsRevokeStmtContext() {}
+
+func NewRevokeStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_revokeStmt
+
+	return p
+}
+
+func (s *RevokeStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RevokeStmtContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *RevokeStmtContext) Privilege() IPrivilegeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrivilegeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrivilegeContext)
+}
+
+func (s *RevokeStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *RevokeStmtContext) GrantDatabase() IGrantDatabaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGrantDatabaseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantDatabaseContext)
+}
+
+func (s *RevokeStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RevokeStmtContext) Grantee() IGranteeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGranteeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGranteeContext)
+}
+
+func (s *RevokeStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *RevokeStmtContext) GrantNamespace() IGrantNamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGrantNamespaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantNamespaceContext)
+}
+
+func (s *RevokeStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *RevokeStmtContext) RoleName() IRoleNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRoleNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *RevokeStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *RevokeStmtContext) UserName() IUserNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUserNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *RevokeStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RevokeStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RevokeStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRevokeStmt(s)
+	}
+}
+
+func (s *RevokeStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRevokeStmt(s)
+	}
+}
+
+func (p *SQLParser) RevokeStmt() (localctx IRevokeStmtContext) {
+	localctx = NewRevokeStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SQLParserRULE_revokeStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(528)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(510)
+			p.Match(SQLParserT_REVOKE)
+		}
+		{
+			p.SetState(511)
+			p.Privilege()
+		}
+		{
+			p.SetState(512)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(513)
+			p.GrantDatabase()
+		}
+		p.SetState(516)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_NAMESPACE {
+			{
+				p.SetState(514)
+				p.Match(SQLParserT_NAMESPACE)
+			}
+			{
+				p.SetState(515)
+				p.GrantNamespace()
+			}
+
+		}
+		{
+			p.SetState(518)
+			p.Match(SQLParserT_FROM)
+		}
+		{
+			p.SetState(519)
+			p.Grantee()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(521)
+			p.Match(SQLParserT_REVOKE)
+		}
+		{
+			p.SetState(522)
+			p.Match(SQLParserT_ROLE)
+		}
+		{
+			p.SetState(523)
+			p.RoleName()
+		}
+		{
+			p.SetState(524)
+			p.Match(SQLParserT_FROM)
+		}
+		{
+			p.SetState(525)
+			p.Match(SQLParserT_USER)
+		}
+		{
+			p.SetState(526)
+			p.UserName()
+		}
+
+	}
+
+	return localctx
+}
+
+// IPrivilegeContext is an interface to support dynamic dispatch.
+type IPrivilegeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPrivilegeContext differentiates from other interfaces.
+	IsPrivilegeContext()
+}
+
+type PrivilegeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrivilegeContext() *PrivilegeContext {
+	var p = new(PrivilegeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_privilege
+	return p
+}
+
+func (*PrivilegeContext) IsPrivilegeContext() {}
+
+func NewPrivilegeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeContext {
+	var p = new(PrivilegeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_privilege
+
+	return p
+}
+
+func (s *PrivilegeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrivilegeContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
+}
+
+func (s *PrivilegeContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *PrivilegeContext) T_ADMIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADMIN, 0)
+}
+
+func (s *PrivilegeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrivilegeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrivilegeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterPrivilege(s)
+	}
+}
+
+func (s *PrivilegeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitPrivilege(s)
+	}
+}
+
+func (p *SQLParser) Privilege() (localctx IPrivilegeContext) {
+	localctx = NewPrivilegeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SQLParserRULE_privilege)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(530)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-42)&-(0x1f+1)) == 0 && ((1<<uint((_la-42)))&((1<<(SQLParserT_READ-42))|(1<<(SQLParserT_WRITE-42))|(1<<(SQLParserT_ADMIN-42)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IGrantDatabaseContext is an interface to support dynamic dispatch.
+type IGrantDatabaseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGrantDatabaseContext differentiates from other interfaces.
+	IsGrantDatabaseContext()
+}
+
+type GrantDatabaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantDatabaseContext() *GrantDatabaseContext {
+	var p = new(GrantDatabaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_grantDatabase
+	return p
+}
+
+func (*GrantDatabaseContext) IsGrantDatabaseContext() {}
+
+func NewGrantDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantDatabaseContext {
+	var p = new(GrantDatabaseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_grantDatabase
+
+	return p
+}
+
+func (s *GrantDatabaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantDatabaseContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *GrantDatabaseContext) T_MUL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MUL, 0)
+}
+
+func (s *GrantDatabaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantDatabaseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterGrantDatabase(s)
+	}
+}
+
+func (s *GrantDatabaseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitGrantDatabase(s)
+	}
+}
+
+func (p *SQLParser) GrantDatabase() (localctx IGrantDatabaseContext) {
+	localctx = NewGrantDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SQLParserRULE_grantDatabase)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(534)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_USER, SQLParserT_USERS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_LIMITS, SQLParserT_CARDINALITY, SQLParserT_PASSWORD, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_DELETE, SQLParserT_ALTER, SQLParserT_RENAME, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_VARIANCE, SQLParserT_FIRST_VALUE, SQLParserT_LAST_VALUE, SQLParserT_MEDIAN, SQLParserT_IRATE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVG, SQLParserT_MOVING_SUM, SQLParserT_CUMULATIVE_SUM, SQLParserT_EWMA, SQLParserT_TIME_SHIFT, SQLParserT_DIFF, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_POW, SQLParserT_SQRT, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_IF, SQLParserT_TZ, SQLParserT_REGEX_EXTRACT, SQLParserT_LABEL_REPLACE, SQLParserT_EXEMPLARS, SQLParserT_HISTOGRAM_COUNT, SQLParserT_HISTOGRAM_SUM, SQLParserT_HISTOGRAM_AVG, SQLParserT_HISTOGRAM_FRACTION, SQLParserT_HEATMAP, SQLParserT_LAST, SQLParserT_COUNT_DISTINCT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(532)
+			p.Ident()
+		}
+
+	case SQLParserT_MUL:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(533)
+			p.Match(SQLParserT_MUL)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IGrantNamespaceContext is an interface to support dynamic dispatch.
+type IGrantNamespaceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGrantNamespaceContext differentiates from other interfaces.
+	IsGrantNamespaceContext()
+}
+
+type GrantNamespaceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantNamespaceContext() *GrantNamespaceContext {
+	var p = new(GrantNamespaceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_grantNamespace
+	return p
+}
+
+func (*GrantNamespaceContext) IsGrantNamespaceContext() {}
+
+func NewGrantNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantNamespaceContext {
+	var p = new(GrantNamespaceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_grantNamespace
+
+	return p
+}
+
+func (s *GrantNamespaceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantNamespaceContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *GrantNamespaceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantNamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantNamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterGrantNamespace(s)
+	}
+}
+
+func (s *GrantNamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitGrantNamespace(s)
+	}
+}
+
+func (p *SQLParser) GrantNamespace() (localctx IGrantNamespaceContext) {
+	localctx = NewGrantNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SQLParserRULE_grantNamespace)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(536)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IGranteeContext is an interface to support dynamic dispatch.
+type IGranteeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGranteeContext differentiates from other interfaces.
+	IsGranteeContext()
+}
+
+type GranteeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGranteeContext() *GranteeContext {
+	var p = new(GranteeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_grantee
+	return p
+}
+
+func (*GranteeContext) IsGranteeContext() {}
+
+func NewGranteeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GranteeContext {
+	var p = new(GranteeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_grantee
+
+	return p
+}
+
+func (s *GranteeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GranteeContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *GranteeContext) UserName() IUserNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUserNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *GranteeContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *GranteeContext) RoleName() IRoleNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRoleNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *GranteeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GranteeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GranteeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterGrantee(s)
+	}
+}
+
+func (s *GranteeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitGrantee(s)
+	}
+}
+
+func (p *SQLParser) Grantee() (localctx IGranteeContext) {
+	localctx = NewGranteeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SQLParserRULE_grantee)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(542)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_USER:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(538)
+			p.Match(SQLParserT_USER)
+		}
+		{
+			p.SetState(539)
+			p.UserName()
+		}
+
+	case SQLParserT_ROLE:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(540)
+			p.Match(SQLParserT_ROLE)
+		}
+		{
+			p.SetState(541)
+			p.RoleName()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IUserNameContext is an interface to support dynamic dispatch.
+type IUserNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUserNameContext differentiates from other interfaces.
+	IsUserNameContext()
+}
+
+type UserNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUserNameContext() *UserNameContext {
+	var p = new(UserNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_userName
+	return p
+}
+
+func (*UserNameContext) IsUserNameContext() {}
+
+func NewUserNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UserNameContext {
+	var p = new(UserNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_userName
+
+	return p
+}
+
+func (s *UserNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UserNameContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *UserNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UserNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UserNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterUserName(s)
+	}
+}
+
+func (s *UserNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitUserName(s)
+	}
+}
+
+func (p *SQLParser) UserName() (localctx IUserNameContext) {
+	localctx = NewUserNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SQLParserRULE_userName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(544)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IRoleNameContext is an interface to support dynamic dispatch.
+type IRoleNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRoleNameContext differentiates from other interfaces.
+	IsRoleNameContext()
+}
+
+type RoleNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRoleNameContext() *RoleNameContext {
+	var p = new(RoleNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_roleName
+	return p
+}
+
+func (*RoleNameContext) IsRoleNameContext() {}
+
+func NewRoleNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoleNameContext {
+	var p = new(RoleNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_roleName
+
+	return p
+}
+
+func (s *RoleNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RoleNameContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *RoleNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RoleNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RoleNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRoleName(s)
+	}
+}
+
+func (s *RoleNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRoleName(s)
+	}
+}
+
+func (p *SQLParser) RoleName() (localctx IRoleNameContext) {
+	localctx = NewRoleNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SQLParserRULE_roleName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(546)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IPasswordContext is an interface to support dynamic dispatch.
+type IPasswordContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPasswordContext differentiates from other interfaces.
+	IsPasswordContext()
+}
+
+type PasswordContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPasswordContext() *PasswordContext {
+	var p = new(PasswordContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_password
+	return p
+}
+
+func (*PasswordContext) IsPasswordContext() {}
+
+func NewPasswordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PasswordContext {
+	var p = new(PasswordContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_password
+
+	return p
+}
+
+func (s *PasswordContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PasswordContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *PasswordContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PasswordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PasswordContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterPassword(s)
+	}
+}
+
+func (s *PasswordContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitPassword(s)
+	}
+}
+
+func (p *SQLParser) Password() (localctx IPasswordContext) {
+	localctx = NewPasswordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SQLParserRULE_password)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(548)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IQueryStmtContext is an interface to support dynamic dispatch.
+type IQueryStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsQueryStmtContext differentiates from other interfaces.
+	IsQueryStmtContext()
+}
+
+type QueryStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryStmtContext() *QueryStmtContext {
+	var p = new(QueryStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_queryStmt
+	return p
+}
+
+func (*QueryStmtContext) IsQueryStmtContext() {}
+
+func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
+	var p = new(QueryStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_queryStmt
+
+	return p
+}
+
+func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryStmtContext) SelectExpr() ISelectExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectExprContext)
+}
+
+func (s *QueryStmtContext) QueryFromClause() IQueryFromClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IQueryFromClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryFromClauseContext)
+}
+
+func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXPLAIN, 0)
+}
+
+func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGroupByClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByClauseContext)
+}
+
+func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderByClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByClauseContext)
+}
+
+func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *QueryStmtContext) TimeZoneClause() ITimeZoneClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITimeZoneClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITimeZoneClauseContext)
+}
+
+func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+}
+
+func (s *QueryStmtContext) WithExemplarsClause() IWithExemplarsClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWithExemplarsClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithExemplarsClauseContext)
+}
+
+func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterQueryStmt(s)
+	}
+}
+
+func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitQueryStmt(s)
+	}
+}
+
+func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
+	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SQLParserRULE_queryStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(551)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_EXPLAIN {
+		{
+			p.SetState(550)
+			p.Match(SQLParserT_EXPLAIN)
+		}
+
+	}
+	{
+		p.SetState(553)
+		p.SelectExpr()
+	}
+	{
+		p.SetState(554)
+		p.QueryFromClause()
+	}
+	p.SetState(556)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(555)
+			p.WhereClause()
+		}
+
+	}
+	p.SetState(559)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_GROUP {
+		{
+			p.SetState(558)
+			p.GroupByClause()
+		}
+
+	}
+	p.SetState(562)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ORDER {
+		{
+			p.SetState(561)
+			p.OrderByClause()
+		}
+
+	}
+	p.SetState(565)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(564)
+			p.LimitClause()
+		}
+
+	}
+	p.SetState(568)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_TZ {
+		{
+			p.SetState(567)
+			p.TimeZoneClause()
+		}
+
+	}
+	p.SetState(571)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WITH_VALUE {
+		{
+			p.SetState(570)
+			p.Match(SQLParserT_WITH_VALUE)
+		}
+
+	}
+	p.SetState(574)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WITH {
+		{
+			p.SetState(573)
+			p.WithExemplarsClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// ISelectExprContext is an interface to support dynamic dispatch.
+type ISelectExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSelectExprContext differentiates from other interfaces.
+	IsSelectExprContext()
+}
+
+type SelectExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectExprContext() *SelectExprContext {
+	var p = new(SelectExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_selectExpr
+	return p
+}
+
+func (*SelectExprContext) IsSelectExprContext() {}
+
+func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
+	var p = new(SelectExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_selectExpr
+
+	return p
+}
+
+func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SELECT, 0)
+}
+
+func (s *SelectExprContext) Fields() IFieldsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldsContext)
+}
+
+func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSelectExpr(s)
+	}
+}
+
+func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSelectExpr(s)
+	}
+}
+
+func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
+	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SQLParserRULE_selectExpr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(576)
+		p.Match(SQLParserT_SELECT)
+	}
+	{
+		p.SetState(577)
+		p.Fields()
+	}
+
+	return localctx
+}
+
+// IDeleteStmtContext is an interface to support dynamic dispatch.
+type IDeleteStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDeleteStmtContext differentiates from other interfaces.
+	IsDeleteStmtContext()
+}
+
+type DeleteStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDeleteStmtContext() *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_deleteStmt
+	return p
+}
+
+func (*DeleteStmtContext) IsDeleteStmtContext() {}
+
+func NewDeleteStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_deleteStmt
+
+	return p
+}
+
+func (s *DeleteStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DeleteStmtContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
+func (s *DeleteStmtContext) FromClause() IFromClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFromClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *DeleteStmtContext) WhereClause() IWhereClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *DeleteStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DeleteStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DeleteStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDeleteStmt(s)
+	}
+}
+
+func (s *DeleteStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDeleteStmt(s)
+	}
+}
+
+func (p *SQLParser) DeleteStmt() (localctx IDeleteStmtContext) {
+	localctx = NewDeleteStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SQLParserRULE_deleteStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(579)
+		p.Match(SQLParserT_DELETE)
+	}
+	{
+		p.SetState(580)
+		p.FromClause()
+	}
+	{
+		p.SetState(581)
+		p.WhereClause()
+	}
+
+	return localctx
+}
+
+// IAlterMetricStmtContext is an interface to support dynamic dispatch.
+type IAlterMetricStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAlterMetricStmtContext differentiates from other interfaces.
+	IsAlterMetricStmtContext()
+}
+
+type AlterMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAlterMetricStmtContext() *AlterMetricStmtContext {
+	var p = new(AlterMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_alterMetricStmt
+	return p
+}
+
+func (*AlterMetricStmtContext) IsAlterMetricStmtContext() {}
+
+func NewAlterMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterMetricStmtContext {
+	var p = new(AlterMetricStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alterMetricStmt
+
+	return p
+}
+
+func (s *AlterMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AlterMetricStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
+}
+
+func (s *AlterMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *AlterMetricStmtContext) MetricName() IMetricNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMetricNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *AlterMetricStmtContext) AlterFieldAction() IAlterFieldActionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAlterFieldActionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterFieldActionContext)
+}
+
+func (s *AlterMetricStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *AlterMetricStmtContext) Namespace() INamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *AlterMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AlterMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AlterMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterAlterMetricStmt(s)
+	}
+}
+
+func (s *AlterMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitAlterMetricStmt(s)
+	}
+}
+
+func (p *SQLParser) AlterMetricStmt() (localctx IAlterMetricStmtContext) {
+	localctx = NewAlterMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SQLParserRULE_alterMetricStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(583)
+		p.Match(SQLParserT_ALTER)
+	}
+	{
+		p.SetState(584)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(585)
+		p.MetricName()
+	}
+	p.SetState(588)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(586)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(587)
+			p.Namespace()
+		}
+
+	}
+	{
+		p.SetState(590)
+		p.AlterFieldAction()
+	}
+
+	return localctx
+}
+
+// IAlterFieldActionContext is an interface to support dynamic dispatch.
+type IAlterFieldActionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAlterFieldActionContext differentiates from other interfaces.
+	IsAlterFieldActionContext()
+}
+
+type AlterFieldActionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAlterFieldActionContext() *AlterFieldActionContext {
+	var p = new(AlterFieldActionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_alterFieldAction
+	return p
+}
+
+func (*AlterFieldActionContext) IsAlterFieldActionContext() {}
+
+func NewAlterFieldActionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterFieldActionContext {
+	var p = new(AlterFieldActionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alterFieldAction
+
+	return p
+}
+
+func (s *AlterFieldActionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AlterFieldActionContext) T_RENAME() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RENAME, 0)
+}
+
+func (s *AlterFieldActionContext) T_FIELD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIELD, 0)
+}
+
+func (s *AlterFieldActionContext) FieldName() IFieldNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *AlterFieldActionContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *AlterFieldActionContext) TargetFieldName() ITargetFieldNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITargetFieldNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITargetFieldNameContext)
+}
+
+func (s *AlterFieldActionContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *AlterFieldActionContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
+}
+
+func (s *AlterFieldActionContext) T_TYPE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TYPE, 0)
+}
+
+func (s *AlterFieldActionContext) FieldType() IFieldTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldTypeContext)
+}
+
+func (s *AlterFieldActionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AlterFieldActionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AlterFieldActionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterAlterFieldAction(s)
+	}
+}
+
+func (s *AlterFieldActionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitAlterFieldAction(s)
+	}
+}
+
+func (p *SQLParser) AlterFieldAction() (localctx IAlterFieldActionContext) {
+	localctx = NewAlterFieldActionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SQLParserRULE_alterFieldAction)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(607)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_RENAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(592)
+			p.Match(SQLParserT_RENAME)
+		}
+		{
+			p.SetState(593)
+			p.Match(SQLParserT_FIELD)
+		}
+		{
+			p.SetState(594)
+			p.FieldName()
+		}
+		{
+			p.SetState(595)
+			p.Match(SQLParserT_TO)
+		}
+		{
+			p.SetState(596)
+			p.TargetFieldName()
+		}
+
+	case SQLParserT_DROP:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(598)
+			p.Match(SQLParserT_DROP)
+		}
+		{
+			p.SetState(599)
+			p.Match(SQLParserT_FIELD)
+		}
+		{
+			p.SetState(600)
+			p.FieldName()
+		}
+
+	case SQLParserT_ALTER:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(601)
+			p.Match(SQLParserT_ALTER)
+		}
+		{
+			p.SetState(602)
+			p.Match(SQLParserT_FIELD)
+		}
+		{
+			p.SetState(603)
+			p.FieldName()
+		}
+		{
+			p.SetState(604)
+			p.Match(SQLParserT_TYPE)
+		}
+		{
+			p.SetState(605)
+			p.FieldType()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IDropMetricStmtContext is an interface to support dynamic dispatch.
+type IDropMetricStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDropMetricStmtContext differentiates from other interfaces.
+	IsDropMetricStmtContext()
+}
+
+type DropMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *SourceContext) GetRuleContext() antlr.RuleContext {
+func NewEmptyDropMetricStmtContext() *DropMetricStmtContext {
+	var p = new(DropMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
+	return p
+}
+
+func (*DropMetricStmtContext) IsDropMetricStmtContext() {}
+
+func NewDropMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropMetricStmtContext {
+	var p = new(DropMetricStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
+
+	return p
+}
+
+func (s *DropMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DropMetricStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *DropMetricStmtContext) MetricName() IMetricNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMetricNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *DropMetricStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *DropMetricStmtContext) Namespace() INamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *DropMetricStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSource(s)
+		listenerT.EnterDropMetricStmt(s)
 	}
 }
 
-func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSource(s)
+		listenerT.ExitDropMetricStmt(s)
 	}
 }
 
-func (p *SQLParser) Source() (localctx ISourceContext) {
-	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SQLParserRULE_source)
+func (p *SQLParser) DropMetricStmt() (localctx IDropMetricStmtContext) {
+	localctx = NewDropMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SQLParserRULE_dropMetricStmt)
 	var _la int
 
 	defer func() {
@@ -4626,150 +8682,220 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(345)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(609)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(610)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(611)
+		p.MetricName()
+	}
+	p.SetState(614)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(612)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(613)
+			p.Namespace()
 		}
+
 	}
 
 	return localctx
 }
 
-// IQueryStmtContext is an interface to support dynamic dispatch.
-type IQueryStmtContext interface {
+// IDropNamespaceStmtContext is an interface to support dynamic dispatch.
+type IDropNamespaceStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsQueryStmtContext differentiates from other interfaces.
-	IsQueryStmtContext()
+	// IsDropNamespaceStmtContext differentiates from other interfaces.
+	IsDropNamespaceStmtContext()
 }
 
-type QueryStmtContext struct {
+type DropNamespaceStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyQueryStmtContext() *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewEmptyDropNamespaceStmtContext() *DropNamespaceStmtContext {
+	var p = new(DropNamespaceStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_dropNamespaceStmt
 	return p
 }
 
-func (*QueryStmtContext) IsQueryStmtContext() {}
+func (*DropNamespaceStmtContext) IsDropNamespaceStmtContext() {}
 
-func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewDropNamespaceStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropNamespaceStmtContext {
+	var p = new(DropNamespaceStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_dropNamespaceStmt
 
 	return p
 }
 
-func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *QueryStmtContext) SelectExpr() ISelectExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectExprContext)(nil)).Elem(), 0)
+func (s *DropNamespaceStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *DropNamespaceStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
 
-	return t.(ISelectExprContext)
+func (s *DropNamespaceStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
 }
 
-func (s *QueryStmtContext) FromClause() IFromClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFromClauseContext)(nil)).Elem(), 0)
+func (s *DropNamespaceStmtContext) Namespace() INamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(INamespaceContext)
 }
 
-func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EXPLAIN, 0)
+func (s *DropNamespaceStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+func (s *DropNamespaceStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	if t == nil {
-		return nil
+func (s *DropNamespaceStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDropNamespaceStmt(s)
 	}
+}
 
-	return t.(IWhereClauseContext)
+func (s *DropNamespaceStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDropNamespaceStmt(s)
+	}
 }
 
-func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGroupByClauseContext)(nil)).Elem(), 0)
+func (p *SQLParser) DropNamespaceStmt() (localctx IDropNamespaceStmtContext) {
+	localctx = NewDropNamespaceStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SQLParserRULE_dropNamespaceStmt)
 
-	if t == nil {
-		return nil
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(616)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(617)
+		p.Match(SQLParserT_NAMESPACE)
+	}
+	{
+		p.SetState(618)
+		p.Namespace()
 	}
 
-	return t.(IGroupByClauseContext)
+	return localctx
 }
 
-func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderByClauseContext)(nil)).Elem(), 0)
+// IFieldNameContext is an interface to support dynamic dispatch.
+type IFieldNameContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(IOrderByClauseContext)
+	// IsFieldNameContext differentiates from other interfaces.
+	IsFieldNameContext()
 }
 
-func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+type FieldNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldNameContext() *FieldNameContext {
+	var p = new(FieldNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_fieldName
+	return p
+}
+
+func (*FieldNameContext) IsFieldNameContext() {}
+
+func NewFieldNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldNameContext {
+	var p = new(FieldNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_fieldName
+
+	return p
+}
+
+func (s *FieldNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldNameContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
-}
-
-func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+	return t.(IIdentContext)
 }
 
-func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *FieldNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FieldNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FieldNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterQueryStmt(s)
+		listenerT.EnterFieldName(s)
 	}
 }
 
-func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FieldNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitQueryStmt(s)
+		listenerT.ExitFieldName(s)
 	}
 }
 
-func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
-	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SQLParserRULE_queryStmt)
-	var _la int
+func (p *SQLParser) FieldName() (localctx IFieldNameContext) {
+	localctx = NewFieldNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SQLParserRULE_fieldName)
 
 	defer func() {
 		p.ExitRule()
@@ -4788,159 +8914,182 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(348)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_EXPLAIN {
-		{
-			p.SetState(347)
-			p.Match(SQLParserT_EXPLAIN)
-		}
-
-	}
 	{
-		p.SetState(350)
-		p.SelectExpr()
-	}
-	{
-		p.SetState(351)
-		p.FromClause()
+		p.SetState(620)
+		p.Ident()
 	}
-	p.SetState(353)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(352)
-			p.WhereClause()
-		}
+	return localctx
+}
+
+// ITargetFieldNameContext is an interface to support dynamic dispatch.
+type ITargetFieldNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTargetFieldNameContext differentiates from other interfaces.
+	IsTargetFieldNameContext()
+}
+
+type TargetFieldNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTargetFieldNameContext() *TargetFieldNameContext {
+	var p = new(TargetFieldNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_targetFieldName
+	return p
+}
+
+func (*TargetFieldNameContext) IsTargetFieldNameContext() {}
+
+func NewTargetFieldNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TargetFieldNameContext {
+	var p = new(TargetFieldNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_targetFieldName
+
+	return p
+}
+
+func (s *TargetFieldNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TargetFieldNameContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
 
+	if t == nil {
+		return nil
 	}
-	p.SetState(356)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_GROUP {
-		{
-			p.SetState(355)
-			p.GroupByClause()
-		}
+	return t.(IIdentContext)
+}
+
+func (s *TargetFieldNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	}
-	p.SetState(359)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+func (s *TargetFieldNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	if _la == SQLParserT_ORDER {
-		{
-			p.SetState(358)
-			p.OrderByClause()
-		}
+func (s *TargetFieldNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTargetFieldName(s)
+	}
+}
 
+func (s *TargetFieldNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTargetFieldName(s)
 	}
-	p.SetState(362)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+}
 
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(361)
-			p.LimitClause()
-		}
+func (p *SQLParser) TargetFieldName() (localctx ITargetFieldNameContext) {
+	localctx = NewTargetFieldNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SQLParserRULE_targetFieldName)
 
-	}
-	p.SetState(365)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	if _la == SQLParserT_WITH_VALUE {
-		{
-			p.SetState(364)
-			p.Match(SQLParserT_WITH_VALUE)
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
 
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(622)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// ISelectExprContext is an interface to support dynamic dispatch.
-type ISelectExprContext interface {
+// IFieldTypeContext is an interface to support dynamic dispatch.
+type IFieldTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSelectExprContext differentiates from other interfaces.
-	IsSelectExprContext()
+	// IsFieldTypeContext differentiates from other interfaces.
+	IsFieldTypeContext()
 }
 
-type SelectExprContext struct {
+type FieldTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectExprContext() *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewEmptyFieldTypeContext() *FieldTypeContext {
+	var p = new(FieldTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_fieldType
 	return p
 }
 
-func (*SelectExprContext) IsSelectExprContext() {}
+func (*FieldTypeContext) IsFieldTypeContext() {}
 
-func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewFieldTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldTypeContext {
+	var p = new(FieldTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_fieldType
 
 	return p
 }
 
-func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SELECT, 0)
-}
+func (s *FieldTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectExprContext) Fields() IFieldsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldsContext)(nil)).Elem(), 0)
+func (s *FieldTypeContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFieldsContext)
+	return t.(IIdentContext)
 }
 
-func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+func (s *FieldTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FieldTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FieldTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSelectExpr(s)
+		listenerT.EnterFieldType(s)
 	}
 }
 
-func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FieldTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSelectExpr(s)
+		listenerT.ExitFieldType(s)
 	}
 }
 
-func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
-	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SQLParserRULE_selectExpr)
+func (p *SQLParser) FieldType() (localctx IFieldTypeContext) {
+	localctx = NewFieldTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SQLParserRULE_fieldType)
 
 	defer func() {
 		p.ExitRule()
@@ -4960,12 +9109,8 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(367)
-		p.Match(SQLParserT_SELECT)
-	}
-	{
-		p.SetState(368)
-		p.Fields()
+		p.SetState(624)
+		p.Ident()
 	}
 
 	return localctx
@@ -5062,7 +9207,7 @@ func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Fields() (localctx IFieldsContext) {
 	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SQLParserRULE_fields)
+	p.EnterRule(localctx, 114, SQLParserRULE_fields)
 	var _la int
 
 	defer func() {
@@ -5083,24 +9228,24 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(370)
+		p.SetState(626)
 		p.Field()
 	}
-	p.SetState(375)
+	p.SetState(631)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(371)
+			p.SetState(627)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(372)
+			p.SetState(628)
 			p.Field()
 		}
 
-		p.SetState(377)
+		p.SetState(633)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -5188,7 +9333,7 @@ func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Field() (localctx IFieldContext) {
 	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SQLParserRULE_field)
+	p.EnterRule(localctx, 116, SQLParserRULE_field)
 	var _la int
 
 	defer func() {
@@ -5209,16 +9354,16 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(378)
+		p.SetState(634)
 		p.fieldExpr(0)
 	}
-	p.SetState(380)
+	p.SetState(636)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_AS {
 		{
-			p.SetState(379)
+			p.SetState(635)
 			p.Alias()
 		}
 
@@ -5301,7 +9446,7 @@ func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Alias() (localctx IAliasContext) {
 	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SQLParserRULE_alias)
+	p.EnterRule(localctx, 118, SQLParserRULE_alias)
 
 	defer func() {
 		p.ExitRule()
@@ -5321,11 +9466,11 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(382)
+		p.SetState(638)
 		p.Match(SQLParserT_AS)
 	}
 	{
-		p.SetState(383)
+		p.SetState(639)
 		p.Ident()
 	}
 
@@ -5410,7 +9555,7 @@ func (s *StorageFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SQLParserRULE_storageFilter)
+	p.EnterRule(localctx, 120, SQLParserRULE_storageFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -5430,15 +9575,15 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(385)
+		p.SetState(641)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(386)
+		p.SetState(642)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(387)
+		p.SetState(643)
 		p.Ident()
 	}
 
@@ -5523,7 +9668,7 @@ func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SQLParserRULE_databaseFilter)
+	p.EnterRule(localctx, 122, SQLParserRULE_databaseFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -5543,15 +9688,15 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(389)
+		p.SetState(645)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(390)
+		p.SetState(646)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(391)
+		p.SetState(647)
 		p.Ident()
 	}
 
@@ -5636,7 +9781,7 @@ func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SQLParserRULE_typeFilter)
+	p.EnterRule(localctx, 124, SQLParserRULE_typeFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -5656,15 +9801,15 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(393)
+		p.SetState(649)
 		p.Match(SQLParserT_TYPE)
 	}
 	{
-		p.SetState(394)
+		p.SetState(650)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(395)
+		p.SetState(651)
 		p.Ident()
 	}
 
@@ -5759,46 +9904,263 @@ func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SQLParserRULE_fromClause)
+	p.EnterRule(localctx, 126, SQLParserRULE_fromClause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(653)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(654)
+		p.MetricName()
+	}
+	p.SetState(657)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(655)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(656)
+			p.Namespace()
+		}
+
+	}
+
+	return localctx
+}
+
+// IQueryFromClauseContext is an interface to support dynamic dispatch.
+type IQueryFromClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsQueryFromClauseContext differentiates from other interfaces.
+	IsQueryFromClauseContext()
+}
+
+type QueryFromClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryFromClauseContext() *QueryFromClauseContext {
+	var p = new(QueryFromClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_queryFromClause
+	return p
+}
+
+func (*QueryFromClauseContext) IsQueryFromClauseContext() {}
+
+func NewQueryFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryFromClauseContext {
+	var p = new(QueryFromClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_queryFromClause
+
+	return p
+}
+
+func (s *QueryFromClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryFromClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *QueryFromClauseContext) AllMetricName() []IMetricNameContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMetricNameContext)(nil)).Elem())
+	var tst = make([]IMetricNameContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMetricNameContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *QueryFromClauseContext) MetricName(i int) IMetricNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMetricNameContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *QueryFromClauseContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *QueryFromClauseContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *QueryFromClauseContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *QueryFromClauseContext) Namespace() INamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *QueryFromClauseContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *QueryFromClauseContext) QueryStmt() IQueryStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IQueryStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryStmtContext)
+}
+
+func (s *QueryFromClauseContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *QueryFromClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QueryFromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QueryFromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterQueryFromClause(s)
+	}
+}
+
+func (s *QueryFromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitQueryFromClause(s)
+	}
+}
+
+func (p *SQLParser) QueryFromClause() (localctx IQueryFromClauseContext) {
+	localctx = NewQueryFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, SQLParserRULE_queryFromClause)
 	var _la int
 
-	defer func() {
-		p.ExitRule()
-	}()
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(677)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(659)
+			p.Match(SQLParserT_FROM)
+		}
+		{
+			p.SetState(660)
+			p.MetricName()
+		}
+		p.SetState(665)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SQLParserT_COMMA {
+			{
+				p.SetState(661)
+				p.Match(SQLParserT_COMMA)
+			}
+			{
+				p.SetState(662)
+				p.MetricName()
+			}
+
+			p.SetState(667)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(670)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
+		if _la == SQLParserT_ON {
+			{
+				p.SetState(668)
+				p.Match(SQLParserT_ON)
+			}
+			{
+				p.SetState(669)
+				p.Namespace()
 			}
-		}
-	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(397)
-		p.Match(SQLParserT_FROM)
-	}
-	{
-		p.SetState(398)
-		p.MetricName()
-	}
-	p.SetState(401)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+		}
 
-	if _la == SQLParserT_ON {
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(399)
-			p.Match(SQLParserT_ON)
+			p.SetState(672)
+			p.Match(SQLParserT_FROM)
 		}
 		{
-			p.SetState(400)
-			p.Namespace()
+			p.SetState(673)
+			p.Match(SQLParserT_OPEN_P)
+		}
+		{
+			p.SetState(674)
+			p.QueryStmt()
+		}
+		{
+			p.SetState(675)
+			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
@@ -5880,7 +10242,7 @@ func (s *WhereClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SQLParserRULE_whereClause)
+	p.EnterRule(localctx, 130, SQLParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -5900,11 +10262,11 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(403)
+		p.SetState(679)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(404)
+		p.SetState(680)
 		p.ConditionExpr()
 	}
 
@@ -5995,7 +10357,7 @@ func (s *ConditionExprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SQLParserRULE_conditionExpr)
+	p.EnterRule(localctx, 132, SQLParserRULE_conditionExpr)
 	var _la int
 
 	defer func() {
@@ -6014,48 +10376,48 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 		}
 	}()
 
-	p.SetState(416)
+	p.SetState(692)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(406)
+			p.SetState(682)
 			p.tagFilterExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(407)
+			p.SetState(683)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(408)
+			p.SetState(684)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(409)
+			p.SetState(685)
 			p.TimeRangeExpr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(411)
+			p.SetState(687)
 			p.TimeRangeExpr()
 		}
-		p.SetState(414)
+		p.SetState(690)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SQLParserT_AND {
 			{
-				p.SetState(412)
+				p.SetState(688)
 				p.Match(SQLParserT_AND)
 			}
 			{
-				p.SetState(413)
+				p.SetState(689)
 				p.tagFilterExpr(0)
 			}
 
@@ -6235,8 +10597,8 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx ITagFilterExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 78
-	p.EnterRecursionRule(localctx, 78, SQLParserRULE_tagFilterExpr, _p)
+	_startState := 134
+	p.EnterRecursionRule(localctx, 134, SQLParserRULE_tagFilterExpr, _p)
 	var _la int
 
 	defer func() {
@@ -6258,75 +10620,75 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(446)
+	p.SetState(722)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(419)
+			p.SetState(695)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(420)
+			p.SetState(696)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(421)
+			p.SetState(697)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(423)
+			p.SetState(699)
 			p.TagKey()
 		}
-		p.SetState(432)
+		p.SetState(708)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_EQUAL:
 			{
-				p.SetState(424)
+				p.SetState(700)
 				p.Match(SQLParserT_EQUAL)
 			}
 
 		case SQLParserT_LIKE:
 			{
-				p.SetState(425)
+				p.SetState(701)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(426)
+				p.SetState(702)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(427)
+				p.SetState(703)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_REGEXP:
 			{
-				p.SetState(428)
+				p.SetState(704)
 				p.Match(SQLParserT_REGEXP)
 			}
 
 		case SQLParserT_NEQREGEXP:
 			{
-				p.SetState(429)
+				p.SetState(705)
 				p.Match(SQLParserT_NEQREGEXP)
 			}
 
 		case SQLParserT_NOTEQUAL:
 			{
-				p.SetState(430)
+				p.SetState(706)
 				p.Match(SQLParserT_NOTEQUAL)
 			}
 
 		case SQLParserT_NOTEQUAL2:
 			{
-				p.SetState(431)
+				p.SetState(707)
 				p.Match(SQLParserT_NOTEQUAL2)
 			}
 
@@ -6334,32 +10696,32 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(434)
+			p.SetState(710)
 			p.TagValue()
 		}
 
 	case 3:
 		{
-			p.SetState(436)
+			p.SetState(712)
 			p.TagKey()
 		}
-		p.SetState(440)
+		p.SetState(716)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_IN:
 			{
-				p.SetState(437)
+				p.SetState(713)
 				p.Match(SQLParserT_IN)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(438)
+				p.SetState(714)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(439)
+				p.SetState(715)
 				p.Match(SQLParserT_IN)
 			}
 
@@ -6367,23 +10729,23 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(442)
+			p.SetState(718)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(443)
+			p.SetState(719)
 			p.TagValueList()
 		}
 		{
-			p.SetState(444)
+			p.SetState(720)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(453)
+	p.SetState(729)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -6393,13 +10755,13 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			_prevctx = localctx
 			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
-			p.SetState(448)
+			p.SetState(724)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(449)
+				p.SetState(725)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -6410,14 +10772,14 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 				}
 			}
 			{
-				p.SetState(450)
+				p.SetState(726)
 				p.tagFilterExpr(2)
 			}
 
 		}
-		p.SetState(455)
+		p.SetState(731)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -6514,7 +10876,7 @@ func (s *TagValueListContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SQLParserRULE_tagValueList)
+	p.EnterRule(localctx, 136, SQLParserRULE_tagValueList)
 	var _la int
 
 	defer func() {
@@ -6535,24 +10897,24 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(456)
+		p.SetState(732)
 		p.TagValue()
 	}
-	p.SetState(461)
+	p.SetState(737)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(457)
+			p.SetState(733)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(458)
+			p.SetState(734)
 			p.TagValue()
 		}
 
-		p.SetState(463)
+		p.SetState(739)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -6646,7 +11008,7 @@ func (s *MetricListFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SQLParserRULE_metricListFilter)
+	p.EnterRule(localctx, 138, SQLParserRULE_metricListFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -6666,24 +11028,24 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(464)
+		p.SetState(740)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(465)
+		p.SetState(741)
 		p.Match(SQLParserT_IN)
 	}
 
 	{
-		p.SetState(466)
+		p.SetState(742)
 		p.Match(SQLParserT_OPEN_P)
 	}
 	{
-		p.SetState(467)
+		p.SetState(743)
 		p.MetricList()
 	}
 	{
-		p.SetState(468)
+		p.SetState(744)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -6781,7 +11143,7 @@ func (s *MetricListContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SQLParserRULE_metricList)
+	p.EnterRule(localctx, 140, SQLParserRULE_metricList)
 	var _la int
 
 	defer func() {
@@ -6802,24 +11164,24 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(470)
+		p.SetState(746)
 		p.Ident()
 	}
-	p.SetState(475)
+	p.SetState(751)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(471)
+			p.SetState(747)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(472)
+			p.SetState(748)
 			p.Ident()
 		}
 
-		p.SetState(477)
+		p.SetState(753)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -6914,7 +11276,7 @@ func (s *TimeRangeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, SQLParserRULE_timeRangeExpr)
+	p.EnterRule(localctx, 142, SQLParserRULE_timeRangeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -6934,19 +11296,19 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(478)
+		p.SetState(754)
 		p.TimeExpr()
 	}
-	p.SetState(481)
+	p.SetState(757)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(479)
+			p.SetState(755)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(480)
+			p.SetState(756)
 			p.TimeExpr()
 		}
 
@@ -7027,6 +11389,16 @@ func (s *TimeExprContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
+func (s *TimeExprContext) IntNumber() IIntNumberContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIntNumberContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIntNumberContext)
+}
+
 func (s *TimeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -7049,7 +11421,7 @@ func (s *TimeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SQLParserRULE_timeExpr)
+	p.EnterRule(localctx, 144, SQLParserRULE_timeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -7069,28 +11441,34 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(483)
+		p.SetState(759)
 		p.Match(SQLParserT_TIME)
 	}
 	{
-		p.SetState(484)
+		p.SetState(760)
 		p.BinaryOperator()
 	}
-	p.SetState(487)
+	p.SetState(764)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(485)
+			p.SetState(761)
 			p.NowExpr()
 		}
 
 	case 2:
 		{
-			p.SetState(486)
+			p.SetState(762)
 			p.Ident()
 		}
 
+	case 3:
+		{
+			p.SetState(763)
+			p.IntNumber()
+		}
+
 	}
 
 	return localctx
@@ -7176,7 +11554,7 @@ func (s *NowExprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SQLParserRULE_nowExpr)
+	p.EnterRule(localctx, 146, SQLParserRULE_nowExpr)
 	var _la int
 
 	defer func() {
@@ -7197,16 +11575,16 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(489)
+		p.SetState(766)
 		p.NowFunc()
 	}
-	p.SetState(491)
+	p.SetState(768)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-113)&-(0x1f+1)) == 0 && ((1<<uint((_la-113)))&((1<<(SQLParserT_ADD-113))|(1<<(SQLParserT_SUB-113))|(1<<(SQLParserL_INT-113)))) != 0 {
+	if ((_la-162)&-(0x1f+1)) == 0 && ((1<<uint((_la-162)))&((1<<(SQLParserT_ADD-162))|(1<<(SQLParserT_SUB-162))|(1<<(SQLParserL_INT-162)))) != 0 {
 		{
-			p.SetState(490)
+			p.SetState(767)
 			p.DurationLit()
 		}
 
@@ -7297,7 +11675,7 @@ func (s *NowFuncContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SQLParserRULE_nowFunc)
+	p.EnterRule(localctx, 148, SQLParserRULE_nowFunc)
 	var _la int
 
 	defer func() {
@@ -7318,26 +11696,26 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(493)
+		p.SetState(770)
 		p.Match(SQLParserT_NOW)
 	}
 	{
-		p.SetState(494)
+		p.SetState(771)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(496)
+	p.SetState(773)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLParserT_CREATE)|(1<<SQLParserT_UPDATE)|(1<<SQLParserT_SET)|(1<<SQLParserT_DROP)|(1<<SQLParserT_INTERVAL)|(1<<SQLParserT_INTERVAL_NAME)|(1<<SQLParserT_SHARD)|(1<<SQLParserT_REPLICATION)|(1<<SQLParserT_TTL)|(1<<SQLParserT_META_TTL)|(1<<SQLParserT_PAST_TTL)|(1<<SQLParserT_FUTURE_TTL)|(1<<SQLParserT_KILL)|(1<<SQLParserT_ON)|(1<<SQLParserT_SHOW)|(1<<SQLParserT_USE)|(1<<SQLParserT_STATE_REPO)|(1<<SQLParserT_STATE_MACHINE)|(1<<SQLParserT_MASTER)|(1<<SQLParserT_METADATA)|(1<<SQLParserT_TYPES)|(1<<SQLParserT_TYPE)|(1<<SQLParserT_STORAGES)|(1<<SQLParserT_STORAGE)|(1<<SQLParserT_BROKER)|(1<<SQLParserT_ALIVE))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLParserT_SCHEMAS-32))|(1<<(SQLParserT_DATASBAE-32))|(1<<(SQLParserT_DATASBAES-32))|(1<<(SQLParserT_NAMESPACE-32))|(1<<(SQLParserT_NAMESPACES-32))|(1<<(SQLParserT_NODE-32))|(1<<(SQLParserT_METRICS-32))|(1<<(SQLParserT_METRIC-32))|(1<<(SQLParserT_FIELD-32))|(1<<(SQLParserT_FIELDS-32))|(1<<(SQLParserT_TAG-32))|(1<<(SQLParserT_INFO-32))|(1<<(SQLParserT_KEYS-32))|(1<<(SQLParserT_KEY-32))|(1<<(SQLParserT_WITH-32))|(1<<(SQLParserT_VALUES-32))|(1<<(SQLParserT_VALUE-32))|(1<<(SQLParserT_FROM-32))|(1<<(SQLParserT_WHERE-32))|(1<<(SQLParserT_LIMIT-32))|(1<<(SQLParserT_QUERIES-32))|(1<<(SQLParserT_QUERY-32))|(1<<(SQLParserT_EXPLAIN-32))|(1<<(SQLParserT_WITH_VALUE-32))|(1<<(SQLParserT_SELECT-32))|(1<<(SQLParserT_AS-32))|(1<<(SQLParserT_AND-32))|(1<<(SQLParserT_OR-32))|(1<<(SQLParserT_FILL-32))|(1<<(SQLParserT_NULL-32))|(1<<(SQLParserT_PREVIOUS-32))|(1<<(SQLParserT_ORDER-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLParserT_ASC-64))|(1<<(SQLParserT_DESC-64))|(1<<(SQLParserT_LIKE-64))|(1<<(SQLParserT_NOT-64))|(1<<(SQLParserT_BETWEEN-64))|(1<<(SQLParserT_IS-64))|(1<<(SQLParserT_GROUP-64))|(1<<(SQLParserT_HAVING-64))|(1<<(SQLParserT_BY-64))|(1<<(SQLParserT_FOR-64))|(1<<(SQLParserT_STATS-64))|(1<<(SQLParserT_TIME-64))|(1<<(SQLParserT_NOW-64))|(1<<(SQLParserT_IN-64))|(1<<(SQLParserT_LOG-64))|(1<<(SQLParserT_PROFILE-64))|(1<<(SQLParserT_SUM-64))|(1<<(SQLParserT_MIN-64))|(1<<(SQLParserT_MAX-64))|(1<<(SQLParserT_COUNT-64))|(1<<(SQLParserT_AVG-64))|(1<<(SQLParserT_STDDEV-64))|(1<<(SQLParserT_QUANTILE-64))|(1<<(SQLParserT_RATE-64))|(1<<(SQLParserT_SECOND-64))|(1<<(SQLParserT_MINUTE-64))|(1<<(SQLParserT_HOUR-64))|(1<<(SQLParserT_DAY-64))|(1<<(SQLParserT_WEEK-64))|(1<<(SQLParserT_MONTH-64))|(1<<(SQLParserT_YEAR-64)))) != 0) || (((_la-111)&-(0x1f+1)) == 0 && ((1<<uint((_la-111)))&((1<<(SQLParserT_OPEN_P-111))|(1<<(SQLParserT_ADD-111))|(1<<(SQLParserT_SUB-111))|(1<<(SQLParserL_ID-111))|(1<<(SQLParserL_INT-111))|(1<<(SQLParserL_DEC-111)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLParserT_CREATE)|(1<<SQLParserT_UPDATE)|(1<<SQLParserT_SET)|(1<<SQLParserT_DROP)|(1<<SQLParserT_INTERVAL)|(1<<SQLParserT_INTERVAL_NAME)|(1<<SQLParserT_SHARD)|(1<<SQLParserT_REPLICATION)|(1<<SQLParserT_TTL)|(1<<SQLParserT_META_TTL)|(1<<SQLParserT_PAST_TTL)|(1<<SQLParserT_FUTURE_TTL)|(1<<SQLParserT_KILL)|(1<<SQLParserT_ON)|(1<<SQLParserT_SHOW)|(1<<SQLParserT_USE)|(1<<SQLParserT_STATE_REPO)|(1<<SQLParserT_STATE_MACHINE)|(1<<SQLParserT_MASTER)|(1<<SQLParserT_METADATA)|(1<<SQLParserT_TYPES)|(1<<SQLParserT_TYPE)|(1<<SQLParserT_STORAGES)|(1<<SQLParserT_STORAGE)|(1<<SQLParserT_BROKER)|(1<<SQLParserT_ALIVE)|(1<<SQLParserT_SCHEMAS))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLParserT_USER-32))|(1<<(SQLParserT_USERS-32))|(1<<(SQLParserT_ROLE-32))|(1<<(SQLParserT_ROLES-32))|(1<<(SQLParserT_LIMITS-32))|(1<<(SQLParserT_CARDINALITY-32))|(1<<(SQLParserT_PASSWORD-32))|(1<<(SQLParserT_GRANT-32))|(1<<(SQLParserT_REVOKE-32))|(1<<(SQLParserT_TO-32))|(1<<(SQLParserT_READ-32))|(1<<(SQLParserT_WRITE-32))|(1<<(SQLParserT_ADMIN-32))|(1<<(SQLParserT_DELETE-32))|(1<<(SQLParserT_ALTER-32))|(1<<(SQLParserT_RENAME-32))|(1<<(SQLParserT_DATASBAE-32))|(1<<(SQLParserT_DATASBAES-32))|(1<<(SQLParserT_NAMESPACE-32))|(1<<(SQLParserT_NAMESPACES-32))|(1<<(SQLParserT_NODE-32))|(1<<(SQLParserT_METRICS-32))|(1<<(SQLParserT_METRIC-32))|(1<<(SQLParserT_FIELD-32))|(1<<(SQLParserT_FIELDS-32))|(1<<(SQLParserT_TAG-32))|(1<<(SQLParserT_INFO-32))|(1<<(SQLParserT_KEYS-32))|(1<<(SQLParserT_KEY-32))|(1<<(SQLParserT_WITH-32))|(1<<(SQLParserT_VALUES-32))|(1<<(SQLParserT_VALUE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLParserT_FROM-64))|(1<<(SQLParserT_WHERE-64))|(1<<(SQLParserT_LIMIT-64))|(1<<(SQLParserT_QUERIES-64))|(1<<(SQLParserT_QUERY-64))|(1<<(SQLParserT_EXPLAIN-64))|(1<<(SQLParserT_WITH_VALUE-64))|(1<<(SQLParserT_SELECT-64))|(1<<(SQLParserT_AS-64))|(1<<(SQLParserT_AND-64))|(1<<(SQLParserT_OR-64))|(1<<(SQLParserT_FILL-64))|(1<<(SQLParserT_NULL-64))|(1<<(SQLParserT_PREVIOUS-64))|(1<<(SQLParserT_ORDER-64))|(1<<(SQLParserT_ASC-64))|(1<<(SQLParserT_DESC-64))|(1<<(SQLParserT_LIKE-64))|(1<<(SQLParserT_NOT-64))|(1<<(SQLParserT_BETWEEN-64))|(1<<(SQLParserT_IS-64))|(1<<(SQLParserT_GROUP-64))|(1<<(SQLParserT_HAVING-64))|(1<<(SQLParserT_BY-64))|(1<<(SQLParserT_FOR-64))|(1<<(SQLParserT_STATS-64))|(1<<(SQLParserT_TIME-64))|(1<<(SQLParserT_NOW-64))|(1<<(SQLParserT_IN-64))|(1<<(SQLParserT_LOG-64))|(1<<(SQLParserT_PROFILE-64))|(1<<(SQLParserT_SUM-64)))) != 0) || (((_la-96)&-(0x1f+1)) == 0 && ((1<<uint((_la-96)))&((1<<(SQLParserT_MIN-96))|(1<<(SQLParserT_MAX-96))|(1<<(SQLParserT_COUNT-96))|(1<<(SQLParserT_AVG-96))|(1<<(SQLParserT_STDDEV-96))|(1<<(SQLParserT_QUANTILE-96))|(1<<(SQLParserT_RATE-96))|(1<<(SQLParserT_VARIANCE-96))|(1<<(SQLParserT_FIRST_VALUE-96))|(1<<(SQLParserT_LAST_VALUE-96))|(1<<(SQLParserT_MEDIAN-96))|(1<<(SQLParserT_IRATE-96))|(1<<(SQLParserT_INCREASE-96))|(1<<(SQLParserT_DERIVATIVE-96))|(1<<(SQLParserT_DELTA-96))|(1<<(SQLParserT_MOVING_AVG-96))|(1<<(SQLParserT_MOVING_SUM-96))|(1<<(SQLParserT_CUMULATIVE_SUM-96))|(1<<(SQLParserT_EWMA-96))|(1<<(SQLParserT_TIME_SHIFT-96))|(1<<(SQLParserT_DIFF-96))|(1<<(SQLParserT_ABS-96))|(1<<(SQLParserT_CEIL-96))|(1<<(SQLParserT_FLOOR-96))|(1<<(SQLParserT_ROUND-96))|(1<<(SQLParserT_POW-96))|(1<<(SQLParserT_SQRT-96))|(1<<(SQLParserT_CLAMP_MIN-96))|(1<<(SQLParserT_CLAMP_MAX-96))|(1<<(SQLParserT_IF-96))|(1<<(SQLParserT_TZ-96))|(1<<(SQLParserT_REGEX_EXTRACT-96)))) != 0) || (((_la-128)&-(0x1f+1)) == 0 && ((1<<uint((_la-128)))&((1<<(SQLParserT_LABEL_REPLACE-128))|(1<<(SQLParserT_EXEMPLARS-128))|(1<<(SQLParserT_HISTOGRAM_COUNT-128))|(1<<(SQLParserT_HISTOGRAM_SUM-128))|(1<<(SQLParserT_HISTOGRAM_AVG-128))|(1<<(SQLParserT_HISTOGRAM_FRACTION-128))|(1<<(SQLParserT_HEATMAP-128))|(1<<(SQLParserT_LAST-128))|(1<<(SQLParserT_COUNT_DISTINCT-128))|(1<<(SQLParserT_SECOND-128))|(1<<(SQLParserT_MINUTE-128))|(1<<(SQLParserT_HOUR-128))|(1<<(SQLParserT_DAY-128))|(1<<(SQLParserT_WEEK-128))|(1<<(SQLParserT_MONTH-128))|(1<<(SQLParserT_YEAR-128)))) != 0) || (((_la-160)&-(0x1f+1)) == 0 && ((1<<uint((_la-160)))&((1<<(SQLParserT_OPEN_P-160))|(1<<(SQLParserT_ADD-160))|(1<<(SQLParserT_SUB-160))|(1<<(SQLParserL_ID-160))|(1<<(SQLParserL_INT-160))|(1<<(SQLParserL_DEC-160)))) != 0) {
 		{
-			p.SetState(495)
+			p.SetState(772)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(498)
+		p.SetState(775)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -7454,7 +11832,7 @@ func (s *GroupByClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SQLParserRULE_groupByClause)
+	p.EnterRule(localctx, 150, SQLParserRULE_groupByClause)
 	var _la int
 
 	defer func() {
@@ -7475,47 +11853,47 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(500)
+		p.SetState(777)
 		p.Match(SQLParserT_GROUP)
 	}
 	{
-		p.SetState(501)
+		p.SetState(778)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(502)
+		p.SetState(779)
 		p.GroupByKeys()
 	}
-	p.SetState(508)
+	p.SetState(785)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_FILL {
 		{
-			p.SetState(503)
+			p.SetState(780)
 			p.Match(SQLParserT_FILL)
 		}
 		{
-			p.SetState(504)
+			p.SetState(781)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(505)
+			p.SetState(782)
 			p.FillOption()
 		}
 		{
-			p.SetState(506)
+			p.SetState(783)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
-	p.SetState(511)
+	p.SetState(788)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_HAVING {
 		{
-			p.SetState(510)
+			p.SetState(787)
 			p.HavingClause()
 		}
 
@@ -7615,7 +11993,7 @@ func (s *GroupByKeysContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, SQLParserRULE_groupByKeys)
+	p.EnterRule(localctx, 152, SQLParserRULE_groupByKeys)
 	var _la int
 
 	defer func() {
@@ -7636,24 +12014,24 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(513)
+		p.SetState(790)
 		p.GroupByKey()
 	}
-	p.SetState(518)
+	p.SetState(795)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(514)
+			p.SetState(791)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(515)
+			p.SetState(792)
 			p.GroupByKey()
 		}
 
-		p.SetState(520)
+		p.SetState(797)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -7694,66 +12072,274 @@ func NewGroupByKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, i
 	p.parser = parser
 	p.RuleIndex = SQLParserRULE_groupByKey
 
-	return p
+	return p
+}
+
+func (s *GroupByKeyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupByKeyContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *GroupByKeyContext) T_TIME() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIME, 0)
+}
+
+func (s *GroupByKeyContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *GroupByKeyContext) DurationLit() IDurationLitContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDurationLitContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLitContext)
+}
+
+func (s *GroupByKeyContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *GroupByKeyContext) TagTransformExpr() ITagTransformExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITagTransformExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagTransformExprContext)
+}
+
+func (s *GroupByKeyContext) Alias() IAliasContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAliasContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *GroupByKeyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupByKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupByKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterGroupByKey(s)
+	}
+}
+
+func (s *GroupByKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitGroupByKey(s)
+	}
+}
+
+func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
+	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, SQLParserRULE_groupByKey)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(808)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(798)
+			p.Ident()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(799)
+			p.Match(SQLParserT_TIME)
+		}
+		{
+			p.SetState(800)
+			p.Match(SQLParserT_OPEN_P)
+		}
+		{
+			p.SetState(801)
+			p.DurationLit()
+		}
+		{
+			p.SetState(802)
+			p.Match(SQLParserT_CLOSE_P)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(804)
+			p.TagTransformExpr()
+		}
+		p.SetState(806)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_AS {
+			{
+				p.SetState(805)
+				p.Alias()
+			}
+
+		}
+
+	}
+
+	return localctx
+}
+
+// ITagTransformExprContext is an interface to support dynamic dispatch.
+type ITagTransformExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTagTransformExprContext differentiates from other interfaces.
+	IsTagTransformExprContext()
+}
+
+type TagTransformExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTagTransformExprContext() *TagTransformExprContext {
+	var p = new(TagTransformExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_tagTransformExpr
+	return p
+}
+
+func (*TagTransformExprContext) IsTagTransformExprContext() {}
+
+func NewTagTransformExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagTransformExprContext {
+	var p = new(TagTransformExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_tagTransformExpr
+
+	return p
+}
+
+func (s *TagTransformExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TagTransformExprContext) T_REGEX_EXTRACT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REGEX_EXTRACT, 0)
 }
 
-func (s *GroupByKeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *TagTransformExprContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
 
-func (s *GroupByKeyContext) Ident() IIdentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+func (s *TagTransformExprContext) TagKey() ITagKeyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITagKeyContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(ITagKeyContext)
 }
 
-func (s *GroupByKeyContext) T_TIME() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TIME, 0)
+func (s *TagTransformExprContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
 }
 
-func (s *GroupByKeyContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
+func (s *TagTransformExprContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
 }
 
-func (s *GroupByKeyContext) DurationLit() IDurationLitContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDurationLitContext)(nil)).Elem(), 0)
+func (s *TagTransformExprContext) AllIdent() []IIdentContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IIdentContext)(nil)).Elem())
+	var tst = make([]IIdentContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IIdentContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *TagTransformExprContext) Ident(i int) IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDurationLitContext)
+	return t.(IIdentContext)
 }
 
-func (s *GroupByKeyContext) T_CLOSE_P() antlr.TerminalNode {
+func (s *TagTransformExprContext) T_CLOSE_P() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_CLOSE_P, 0)
 }
 
-func (s *GroupByKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *TagTransformExprContext) T_LABEL_REPLACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABEL_REPLACE, 0)
+}
+
+func (s *TagTransformExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TagTransformExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TagTransformExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterGroupByKey(s)
+		listenerT.EnterTagTransformExpr(s)
 	}
 }
 
-func (s *GroupByKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TagTransformExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitGroupByKey(s)
+		listenerT.ExitTagTransformExpr(s)
 	}
 }
 
-func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
-	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SQLParserRULE_groupByKey)
+func (p *SQLParser) TagTransformExpr() (localctx ITagTransformExprContext) {
+	localctx = NewTagTransformExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, SQLParserRULE_tagTransformExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -7771,35 +12357,74 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 		}
 	}()
 
-	p.SetState(527)
+	p.SetState(826)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) {
-	case 1:
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_REGEX_EXTRACT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(521)
+			p.SetState(810)
+			p.Match(SQLParserT_REGEX_EXTRACT)
+		}
+		{
+			p.SetState(811)
+			p.Match(SQLParserT_OPEN_P)
+		}
+		{
+			p.SetState(812)
+			p.TagKey()
+		}
+		{
+			p.SetState(813)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(814)
 			p.Ident()
 		}
+		{
+			p.SetState(815)
+			p.Match(SQLParserT_CLOSE_P)
+		}
 
-	case 2:
+	case SQLParserT_LABEL_REPLACE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(522)
-			p.Match(SQLParserT_TIME)
+			p.SetState(817)
+			p.Match(SQLParserT_LABEL_REPLACE)
 		}
 		{
-			p.SetState(523)
+			p.SetState(818)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(524)
-			p.DurationLit()
+			p.SetState(819)
+			p.TagKey()
 		}
 		{
-			p.SetState(525)
+			p.SetState(820)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(821)
+			p.Ident()
+		}
+		{
+			p.SetState(822)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(823)
+			p.Ident()
+		}
+		{
+			p.SetState(824)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -7881,7 +12506,7 @@ func (s *FillOptionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SQLParserRULE_fillOption)
+	p.EnterRule(localctx, 158, SQLParserRULE_fillOption)
 	var _la int
 
 	defer func() {
@@ -7902,7 +12527,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(529)
+		p.SetState(828)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_NULL || _la == SQLParserT_PREVIOUS || _la == SQLParserL_INT || _la == SQLParserL_DEC) {
@@ -7994,7 +12619,7 @@ func (s *OrderByClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 	localctx = NewOrderByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SQLParserRULE_orderByClause)
+	p.EnterRule(localctx, 160, SQLParserRULE_orderByClause)
 
 	defer func() {
 		p.ExitRule()
@@ -8014,15 +12639,15 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(531)
+		p.SetState(830)
 		p.Match(SQLParserT_ORDER)
 	}
 	{
-		p.SetState(532)
+		p.SetState(831)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(533)
+		p.SetState(832)
 		p.SortFields()
 	}
 
@@ -8115,7 +12740,7 @@ func (s *SortFieldContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SQLParserRULE_sortField)
+	p.EnterRule(localctx, 162, SQLParserRULE_sortField)
 	var _la int
 
 	defer func() {
@@ -8136,16 +12761,16 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(535)
+		p.SetState(834)
 		p.fieldExpr(0)
 	}
-	p.SetState(539)
+	p.SetState(838)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_ASC || _la == SQLParserT_DESC {
 		{
-			p.SetState(536)
+			p.SetState(835)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ASC || _la == SQLParserT_DESC) {
@@ -8156,7 +12781,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 			}
 		}
 
-		p.SetState(541)
+		p.SetState(840)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -8255,7 +12880,7 @@ func (s *SortFieldsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 	localctx = NewSortFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, SQLParserRULE_sortFields)
+	p.EnterRule(localctx, 164, SQLParserRULE_sortFields)
 	var _la int
 
 	defer func() {
@@ -8276,24 +12901,24 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(542)
+		p.SetState(841)
 		p.SortField()
 	}
-	p.SetState(547)
+	p.SetState(846)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(543)
+			p.SetState(842)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(544)
+			p.SetState(843)
 			p.SortField()
 		}
 
-		p.SetState(549)
+		p.SetState(848)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -8375,7 +13000,7 @@ func (s *HavingClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 	localctx = NewHavingClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SQLParserRULE_havingClause)
+	p.EnterRule(localctx, 166, SQLParserRULE_havingClause)
 
 	defer func() {
 		p.ExitRule()
@@ -8395,11 +13020,11 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(550)
+		p.SetState(849)
 		p.Match(SQLParserT_HAVING)
 	}
 	{
-		p.SetState(551)
+		p.SetState(850)
 		p.boolExpr(0)
 	}
 
@@ -8525,8 +13150,8 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	localctx = NewBoolExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IBoolExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 110
-	p.EnterRecursionRule(localctx, 110, SQLParserRULE_boolExpr, _p)
+	_startState := 168
+	p.EnterRecursionRule(localctx, 168, SQLParserRULE_boolExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -8547,34 +13172,34 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(559)
+	p.SetState(858)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(554)
+			p.SetState(853)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(555)
+			p.SetState(854)
 			p.boolExpr(0)
 		}
 		{
-			p.SetState(556)
+			p.SetState(855)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(558)
+			p.SetState(857)
 			p.BoolExprAtom()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(567)
+	p.SetState(866)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -8584,24 +13209,24 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			_prevctx = localctx
 			localctx = NewBoolExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_boolExpr)
-			p.SetState(561)
+			p.SetState(860)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
 			{
-				p.SetState(562)
+				p.SetState(861)
 				p.BoolExprLogicalOp()
 			}
 			{
-				p.SetState(563)
+				p.SetState(862)
 				p.boolExpr(3)
 			}
 
 		}
-		p.SetState(569)
+		p.SetState(868)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8675,7 +13300,7 @@ func (s *BoolExprLogicalOpContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 	localctx = NewBoolExprLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SQLParserRULE_boolExprLogicalOp)
+	p.EnterRule(localctx, 170, SQLParserRULE_boolExprLogicalOp)
 	var _la int
 
 	defer func() {
@@ -8696,7 +13321,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(570)
+		p.SetState(869)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -8780,7 +13405,7 @@ func (s *BoolExprAtomContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 	localctx = NewBoolExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, SQLParserRULE_boolExprAtom)
+	p.EnterRule(localctx, 172, SQLParserRULE_boolExprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -8800,7 +13425,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(572)
+		p.SetState(871)
 		p.BinaryExpr()
 	}
 
@@ -8900,7 +13525,7 @@ func (s *BinaryExprContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SQLParserRULE_binaryExpr)
+	p.EnterRule(localctx, 174, SQLParserRULE_binaryExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -8920,15 +13545,15 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(574)
+		p.SetState(873)
 		p.fieldExpr(0)
 	}
 	{
-		p.SetState(575)
+		p.SetState(874)
 		p.BinaryOperator()
 	}
 	{
-		p.SetState(576)
+		p.SetState(875)
 		p.fieldExpr(0)
 	}
 
@@ -9031,7 +13656,7 @@ func (s *BinaryOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	localctx = NewBinaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, SQLParserRULE_binaryOperator)
+	p.EnterRule(localctx, 176, SQLParserRULE_binaryOperator)
 	var _la int
 
 	defer func() {
@@ -9050,63 +13675,63 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 		}
 	}()
 
-	p.SetState(586)
+	p.SetState(885)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_EQUAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(578)
+			p.SetState(877)
 			p.Match(SQLParserT_EQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(579)
+			p.SetState(878)
 			p.Match(SQLParserT_NOTEQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL2:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(580)
+			p.SetState(879)
 			p.Match(SQLParserT_NOTEQUAL2)
 		}
 
 	case SQLParserT_LESS:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(581)
+			p.SetState(880)
 			p.Match(SQLParserT_LESS)
 		}
 
 	case SQLParserT_LESSEQUAL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(582)
+			p.SetState(881)
 			p.Match(SQLParserT_LESSEQUAL)
 		}
 
 	case SQLParserT_GREATER:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(583)
+			p.SetState(882)
 			p.Match(SQLParserT_GREATER)
 		}
 
 	case SQLParserT_GREATEREQUAL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(584)
+			p.SetState(883)
 			p.Match(SQLParserT_GREATEREQUAL)
 		}
 
 	case SQLParserT_LIKE, SQLParserT_REGEXP:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(585)
+			p.SetState(884)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_LIKE || _la == SQLParserT_REGEXP) {
@@ -9269,8 +13894,8 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	localctx = NewFieldExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IFieldExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 120
-	p.EnterRecursionRule(localctx, 120, SQLParserRULE_fieldExpr, _p)
+	_startState := 178
+	p.EnterRecursionRule(localctx, 178, SQLParserRULE_fieldExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -9291,46 +13916,46 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(596)
+	p.SetState(895)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(589)
+			p.SetState(888)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(590)
+			p.SetState(889)
 			p.fieldExpr(0)
 		}
 		{
-			p.SetState(591)
+			p.SetState(890)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(593)
+			p.SetState(892)
 			p.ExprFunc()
 		}
 
 	case 3:
 		{
-			p.SetState(594)
+			p.SetState(893)
 			p.ExprAtom()
 		}
 
 	case 4:
 		{
-			p.SetState(595)
+			p.SetState(894)
 			p.DurationLit()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(612)
+	p.SetState(911)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -9338,83 +13963,83 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(610)
+			p.SetState(909)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(598)
+				p.SetState(897)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(599)
+					p.SetState(898)
 					p.Match(SQLParserT_MUL)
 				}
 				{
-					p.SetState(600)
+					p.SetState(899)
 					p.fieldExpr(9)
 				}
 
 			case 2:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(601)
+				p.SetState(900)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(602)
+					p.SetState(901)
 					p.Match(SQLParserT_DIV)
 				}
 				{
-					p.SetState(603)
+					p.SetState(902)
 					p.fieldExpr(8)
 				}
 
 			case 3:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(604)
+				p.SetState(903)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(605)
+					p.SetState(904)
 					p.Match(SQLParserT_ADD)
 				}
 				{
-					p.SetState(606)
+					p.SetState(905)
 					p.fieldExpr(7)
 				}
 
 			case 4:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(607)
+				p.SetState(906)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(608)
+					p.SetState(907)
 					p.Match(SQLParserT_SUB)
 				}
 				{
-					p.SetState(609)
+					p.SetState(908)
 					p.fieldExpr(6)
 				}
 
 			}
 
 		}
-		p.SetState(614)
+		p.SetState(913)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9500,7 +14125,7 @@ func (s *DurationLitContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 	localctx = NewDurationLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SQLParserRULE_durationLit)
+	p.EnterRule(localctx, 180, SQLParserRULE_durationLit)
 
 	defer func() {
 		p.ExitRule()
@@ -9520,11 +14145,11 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(615)
+		p.SetState(914)
 		p.IntNumber()
 	}
 	{
-		p.SetState(616)
+		p.SetState(915)
 		p.IntervalItem()
 	}
 
@@ -9619,7 +14244,7 @@ func (s *IntervalItemContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 	localctx = NewIntervalItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SQLParserRULE_intervalItem)
+	p.EnterRule(localctx, 182, SQLParserRULE_intervalItem)
 	var _la int
 
 	defer func() {
@@ -9640,10 +14265,10 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(618)
+		p.SetState(917)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-88)&-(0x1f+1)) == 0 && ((1<<uint((_la-88)))&((1<<(SQLParserT_SECOND-88))|(1<<(SQLParserT_MINUTE-88))|(1<<(SQLParserT_HOUR-88))|(1<<(SQLParserT_DAY-88))|(1<<(SQLParserT_WEEK-88))|(1<<(SQLParserT_MONTH-88))|(1<<(SQLParserT_YEAR-88)))) != 0) {
+		if !(((_la-137)&-(0x1f+1)) == 0 && ((1<<uint((_la-137)))&((1<<(SQLParserT_SECOND-137))|(1<<(SQLParserT_MINUTE-137))|(1<<(SQLParserT_HOUR-137))|(1<<(SQLParserT_DAY-137))|(1<<(SQLParserT_WEEK-137))|(1<<(SQLParserT_MONTH-137))|(1<<(SQLParserT_YEAR-137)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -9720,6 +14345,51 @@ func (s *ExprFuncContext) ExprFuncParams() IExprFuncParamsContext {
 	return t.(IExprFuncParamsContext)
 }
 
+func (s *ExprFuncContext) T_IF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IF, 0)
+}
+
+func (s *ExprFuncContext) BoolExpr() IBoolExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBoolExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBoolExprContext)
+}
+
+func (s *ExprFuncContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *ExprFuncContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *ExprFuncContext) AllFieldExpr() []IFieldExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFieldExprContext)(nil)).Elem())
+	var tst = make([]IFieldExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IFieldExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExprFuncContext) FieldExpr(i int) IFieldExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldExprContext)
+}
+
 func (s *ExprFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -9742,7 +14412,7 @@ func (s *ExprFuncContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 	localctx = NewExprFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SQLParserRULE_exprFunc)
+	p.EnterRule(localctx, 184, SQLParserRULE_exprFunc)
 	var _la int
 
 	defer func() {
@@ -9761,29 +14431,73 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(620)
-		p.FuncName()
-	}
-	{
-		p.SetState(621)
-		p.Match(SQLParserT_OPEN_P)
-	}
-	p.SetState(623)
+	p.SetState(935)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLParserT_CREATE)|(1<<SQLParserT_UPDATE)|(1<<SQLParserT_SET)|(1<<SQLParserT_DROP)|(1<<SQLParserT_INTERVAL)|(1<<SQLParserT_INTERVAL_NAME)|(1<<SQLParserT_SHARD)|(1<<SQLParserT_REPLICATION)|(1<<SQLParserT_TTL)|(1<<SQLParserT_META_TTL)|(1<<SQLParserT_PAST_TTL)|(1<<SQLParserT_FUTURE_TTL)|(1<<SQLParserT_KILL)|(1<<SQLParserT_ON)|(1<<SQLParserT_SHOW)|(1<<SQLParserT_USE)|(1<<SQLParserT_STATE_REPO)|(1<<SQLParserT_STATE_MACHINE)|(1<<SQLParserT_MASTER)|(1<<SQLParserT_METADATA)|(1<<SQLParserT_TYPES)|(1<<SQLParserT_TYPE)|(1<<SQLParserT_STORAGES)|(1<<SQLParserT_STORAGE)|(1<<SQLParserT_BROKER)|(1<<SQLParserT_ALIVE))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLParserT_SCHEMAS-32))|(1<<(SQLParserT_DATASBAE-32))|(1<<(SQLParserT_DATASBAES-32))|(1<<(SQLParserT_NAMESPACE-32))|(1<<(SQLParserT_NAMESPACES-32))|(1<<(SQLParserT_NODE-32))|(1<<(SQLParserT_METRICS-32))|(1<<(SQLParserT_METRIC-32))|(1<<(SQLParserT_FIELD-32))|(1<<(SQLParserT_FIELDS-32))|(1<<(SQLParserT_TAG-32))|(1<<(SQLParserT_INFO-32))|(1<<(SQLParserT_KEYS-32))|(1<<(SQLParserT_KEY-32))|(1<<(SQLParserT_WITH-32))|(1<<(SQLParserT_VALUES-32))|(1<<(SQLParserT_VALUE-32))|(1<<(SQLParserT_FROM-32))|(1<<(SQLParserT_WHERE-32))|(1<<(SQLParserT_LIMIT-32))|(1<<(SQLParserT_QUERIES-32))|(1<<(SQLParserT_QUERY-32))|(1<<(SQLParserT_EXPLAIN-32))|(1<<(SQLParserT_WITH_VALUE-32))|(1<<(SQLParserT_SELECT-32))|(1<<(SQLParserT_AS-32))|(1<<(SQLParserT_AND-32))|(1<<(SQLParserT_OR-32))|(1<<(SQLParserT_FILL-32))|(1<<(SQLParserT_NULL-32))|(1<<(SQLParserT_PREVIOUS-32))|(1<<(SQLParserT_ORDER-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLParserT_ASC-64))|(1<<(SQLParserT_DESC-64))|(1<<(SQLParserT_LIKE-64))|(1<<(SQLParserT_NOT-64))|(1<<(SQLParserT_BETWEEN-64))|(1<<(SQLParserT_IS-64))|(1<<(SQLParserT_GROUP-64))|(1<<(SQLParserT_HAVING-64))|(1<<(SQLParserT_BY-64))|(1<<(SQLParserT_FOR-64))|(1<<(SQLParserT_STATS-64))|(1<<(SQLParserT_TIME-64))|(1<<(SQLParserT_NOW-64))|(1<<(SQLParserT_IN-64))|(1<<(SQLParserT_LOG-64))|(1<<(SQLParserT_PROFILE-64))|(1<<(SQLParserT_SUM-64))|(1<<(SQLParserT_MIN-64))|(1<<(SQLParserT_MAX-64))|(1<<(SQLParserT_COUNT-64))|(1<<(SQLParserT_AVG-64))|(1<<(SQLParserT_STDDEV-64))|(1<<(SQLParserT_QUANTILE-64))|(1<<(SQLParserT_RATE-64))|(1<<(SQLParserT_SECOND-64))|(1<<(SQLParserT_MINUTE-64))|(1<<(SQLParserT_HOUR-64))|(1<<(SQLParserT_DAY-64))|(1<<(SQLParserT_WEEK-64))|(1<<(SQLParserT_MONTH-64))|(1<<(SQLParserT_YEAR-64)))) != 0) || (((_la-111)&-(0x1f+1)) == 0 && ((1<<uint((_la-111)))&((1<<(SQLParserT_OPEN_P-111))|(1<<(SQLParserT_ADD-111))|(1<<(SQLParserT_SUB-111))|(1<<(SQLParserL_ID-111))|(1<<(SQLParserL_INT-111))|(1<<(SQLParserL_DEC-111)))) != 0) {
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_LOG, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_VARIANCE, SQLParserT_FIRST_VALUE, SQLParserT_LAST_VALUE, SQLParserT_MEDIAN, SQLParserT_IRATE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVG, SQLParserT_MOVING_SUM, SQLParserT_CUMULATIVE_SUM, SQLParserT_EWMA, SQLParserT_TIME_SHIFT, SQLParserT_DIFF, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_POW, SQLParserT_SQRT, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_HISTOGRAM_COUNT, SQLParserT_HISTOGRAM_SUM, SQLParserT_HISTOGRAM_AVG, SQLParserT_HISTOGRAM_FRACTION, SQLParserT_HEATMAP, SQLParserT_LAST, SQLParserT_COUNT_DISTINCT:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(622)
-			p.ExprFuncParams()
+			p.SetState(919)
+			p.FuncName()
 		}
+		{
+			p.SetState(920)
+			p.Match(SQLParserT_OPEN_P)
+		}
+		p.SetState(922)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	{
-		p.SetState(625)
-		p.Match(SQLParserT_CLOSE_P)
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLParserT_CREATE)|(1<<SQLParserT_UPDATE)|(1<<SQLParserT_SET)|(1<<SQLParserT_DROP)|(1<<SQLParserT_INTERVAL)|(1<<SQLParserT_INTERVAL_NAME)|(1<<SQLParserT_SHARD)|(1<<SQLParserT_REPLICATION)|(1<<SQLParserT_TTL)|(1<<SQLParserT_META_TTL)|(1<<SQLParserT_PAST_TTL)|(1<<SQLParserT_FUTURE_TTL)|(1<<SQLParserT_KILL)|(1<<SQLParserT_ON)|(1<<SQLParserT_SHOW)|(1<<SQLParserT_USE)|(1<<SQLParserT_STATE_REPO)|(1<<SQLParserT_STATE_MACHINE)|(1<<SQLParserT_MASTER)|(1<<SQLParserT_METADATA)|(1<<SQLParserT_TYPES)|(1<<SQLParserT_TYPE)|(1<<SQLParserT_STORAGES)|(1<<SQLParserT_STORAGE)|(1<<SQLParserT_BROKER)|(1<<SQLParserT_ALIVE)|(1<<SQLParserT_SCHEMAS))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLParserT_USER-32))|(1<<(SQLParserT_USERS-32))|(1<<(SQLParserT_ROLE-32))|(1<<(SQLParserT_ROLES-32))|(1<<(SQLParserT_LIMITS-32))|(1<<(SQLParserT_CARDINALITY-32))|(1<<(SQLParserT_PASSWORD-32))|(1<<(SQLParserT_GRANT-32))|(1<<(SQLParserT_REVOKE-32))|(1<<(SQLParserT_TO-32))|(1<<(SQLParserT_READ-32))|(1<<(SQLParserT_WRITE-32))|(1<<(SQLParserT_ADMIN-32))|(1<<(SQLParserT_DELETE-32))|(1<<(SQLParserT_ALTER-32))|(1<<(SQLParserT_RENAME-32))|(1<<(SQLParserT_DATASBAE-32))|(1<<(SQLParserT_DATASBAES-32))|(1<<(SQLParserT_NAMESPACE-32))|(1<<(SQLParserT_NAMESPACES-32))|(1<<(SQLParserT_NODE-32))|(1<<(SQLParserT_METRICS-32))|(1<<(SQLParserT_METRIC-32))|(1<<(SQLParserT_FIELD-32))|(1<<(SQLParserT_FIELDS-32))|(1<<(SQLParserT_TAG-32))|(1<<(SQLParserT_INFO-32))|(1<<(SQLParserT_KEYS-32))|(1<<(SQLParserT_KEY-32))|(1<<(SQLParserT_WITH-32))|(1<<(SQLParserT_VALUES-32))|(1<<(SQLParserT_VALUE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLParserT_FROM-64))|(1<<(SQLParserT_WHERE-64))|(1<<(SQLParserT_LIMIT-64))|(1<<(SQLParserT_QUERIES-64))|(1<<(SQLParserT_QUERY-64))|(1<<(SQLParserT_EXPLAIN-64))|(1<<(SQLParserT_WITH_VALUE-64))|(1<<(SQLParserT_SELECT-64))|(1<<(SQLParserT_AS-64))|(1<<(SQLParserT_AND-64))|(1<<(SQLParserT_OR-64))|(1<<(SQLParserT_FILL-64))|(1<<(SQLParserT_NULL-64))|(1<<(SQLParserT_PREVIOUS-64))|(1<<(SQLParserT_ORDER-64))|(1<<(SQLParserT_ASC-64))|(1<<(SQLParserT_DESC-64))|(1<<(SQLParserT_LIKE-64))|(1<<(SQLParserT_NOT-64))|(1<<(SQLParserT_BETWEEN-64))|(1<<(SQLParserT_IS-64))|(1<<(SQLParserT_GROUP-64))|(1<<(SQLParserT_HAVING-64))|(1<<(SQLParserT_BY-64))|(1<<(SQLParserT_FOR-64))|(1<<(SQLParserT_STATS-64))|(1<<(SQLParserT_TIME-64))|(1<<(SQLParserT_NOW-64))|(1<<(SQLParserT_IN-64))|(1<<(SQLParserT_LOG-64))|(1<<(SQLParserT_PROFILE-64))|(1<<(SQLParserT_SUM-64)))) != 0) || (((_la-96)&-(0x1f+1)) == 0 && ((1<<uint((_la-96)))&((1<<(SQLParserT_MIN-96))|(1<<(SQLParserT_MAX-96))|(1<<(SQLParserT_COUNT-96))|(1<<(SQLParserT_AVG-96))|(1<<(SQLParserT_STDDEV-96))|(1<<(SQLParserT_QUANTILE-96))|(1<<(SQLParserT_RATE-96))|(1<<(SQLParserT_VARIANCE-96))|(1<<(SQLParserT_FIRST_VALUE-96))|(1<<(SQLParserT_LAST_VALUE-96))|(1<<(SQLParserT_MEDIAN-96))|(1<<(SQLParserT_IRATE-96))|(1<<(SQLParserT_INCREASE-96))|(1<<(SQLParserT_DERIVATIVE-96))|(1<<(SQLParserT_DELTA-96))|(1<<(SQLParserT_MOVING_AVG-96))|(1<<(SQLParserT_MOVING_SUM-96))|(1<<(SQLParserT_CUMULATIVE_SUM-96))|(1<<(SQLParserT_EWMA-96))|(1<<(SQLParserT_TIME_SHIFT-96))|(1<<(SQLParserT_DIFF-96))|(1<<(SQLParserT_ABS-96))|(1<<(SQLParserT_CEIL-96))|(1<<(SQLParserT_FLOOR-96))|(1<<(SQLParserT_ROUND-96))|(1<<(SQLParserT_POW-96))|(1<<(SQLParserT_SQRT-96))|(1<<(SQLParserT_CLAMP_MIN-96))|(1<<(SQLParserT_CLAMP_MAX-96))|(1<<(SQLParserT_IF-96))|(1<<(SQLParserT_TZ-96))|(1<<(SQLParserT_REGEX_EXTRACT-96)))) != 0) || (((_la-128)&-(0x1f+1)) == 0 && ((1<<uint((_la-128)))&((1<<(SQLParserT_LABEL_REPLACE-128))|(1<<(SQLParserT_EXEMPLARS-128))|(1<<(SQLParserT_HISTOGRAM_COUNT-128))|(1<<(SQLParserT_HISTOGRAM_SUM-128))|(1<<(SQLParserT_HISTOGRAM_AVG-128))|(1<<(SQLParserT_HISTOGRAM_FRACTION-128))|(1<<(SQLParserT_HEATMAP-128))|(1<<(SQLParserT_LAST-128))|(1<<(SQLParserT_COUNT_DISTINCT-128))|(1<<(SQLParserT_SECOND-128))|(1<<(SQLParserT_MINUTE-128))|(1<<(SQLParserT_HOUR-128))|(1<<(SQLParserT_DAY-128))|(1<<(SQLParserT_WEEK-128))|(1<<(SQLParserT_MONTH-128))|(1<<(SQLParserT_YEAR-128)))) != 0) || (((_la-160)&-(0x1f+1)) == 0 && ((1<<uint((_la-160)))&((1<<(SQLParserT_OPEN_P-160))|(1<<(SQLParserT_ADD-160))|(1<<(SQLParserT_SUB-160))|(1<<(SQLParserL_ID-160))|(1<<(SQLParserL_INT-160))|(1<<(SQLParserL_DEC-160)))) != 0) {
+			{
+				p.SetState(921)
+				p.ExprFuncParams()
+			}
+
+		}
+		{
+			p.SetState(924)
+			p.Match(SQLParserT_CLOSE_P)
+		}
+
+	case SQLParserT_IF:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(926)
+			p.Match(SQLParserT_IF)
+		}
+		{
+			p.SetState(927)
+			p.Match(SQLParserT_OPEN_P)
+		}
+		{
+			p.SetState(928)
+			p.boolExpr(0)
+		}
+		{
+			p.SetState(929)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(930)
+			p.fieldExpr(0)
+		}
+		{
+			p.SetState(931)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(932)
+			p.fieldExpr(0)
+		}
+		{
+			p.SetState(933)
+			p.Match(SQLParserT_CLOSE_P)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -9843,20 +14557,140 @@ func (s *FuncNameContext) T_AVG() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_AVG, 0)
 }
 
-func (s *FuncNameContext) T_COUNT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COUNT, 0)
+func (s *FuncNameContext) T_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COUNT, 0)
+}
+
+func (s *FuncNameContext) T_STDDEV() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STDDEV, 0)
+}
+
+func (s *FuncNameContext) T_QUANTILE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUANTILE, 0)
+}
+
+func (s *FuncNameContext) T_RATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RATE, 0)
+}
+
+func (s *FuncNameContext) T_VARIANCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VARIANCE, 0)
+}
+
+func (s *FuncNameContext) T_FIRST_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIRST_VALUE, 0)
+}
+
+func (s *FuncNameContext) T_LAST_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LAST_VALUE, 0)
+}
+
+func (s *FuncNameContext) T_MEDIAN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MEDIAN, 0)
+}
+
+func (s *FuncNameContext) T_IRATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IRATE, 0)
+}
+
+func (s *FuncNameContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *FuncNameContext) T_DERIVATIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIVATIVE, 0)
+}
+
+func (s *FuncNameContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *FuncNameContext) T_MOVING_AVG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_AVG, 0)
+}
+
+func (s *FuncNameContext) T_MOVING_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_SUM, 0)
+}
+
+func (s *FuncNameContext) T_CUMULATIVE_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CUMULATIVE_SUM, 0)
+}
+
+func (s *FuncNameContext) T_EWMA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EWMA, 0)
+}
+
+func (s *FuncNameContext) T_TIME_SHIFT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIME_SHIFT, 0)
+}
+
+func (s *FuncNameContext) T_DIFF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DIFF, 0)
+}
+
+func (s *FuncNameContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *FuncNameContext) T_CEIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CEIL, 0)
+}
+
+func (s *FuncNameContext) T_FLOOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FLOOR, 0)
+}
+
+func (s *FuncNameContext) T_ROUND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROUND, 0)
+}
+
+func (s *FuncNameContext) T_LOG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG, 0)
+}
+
+func (s *FuncNameContext) T_POW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_POW, 0)
+}
+
+func (s *FuncNameContext) T_SQRT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SQRT, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP_MIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MIN, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP_MAX() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MAX, 0)
+}
+
+func (s *FuncNameContext) T_HISTOGRAM_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_COUNT, 0)
+}
+
+func (s *FuncNameContext) T_HISTOGRAM_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_SUM, 0)
+}
+
+func (s *FuncNameContext) T_HISTOGRAM_AVG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_AVG, 0)
+}
+
+func (s *FuncNameContext) T_HISTOGRAM_FRACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_FRACTION, 0)
 }
 
-func (s *FuncNameContext) T_STDDEV() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STDDEV, 0)
+func (s *FuncNameContext) T_HEATMAP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HEATMAP, 0)
 }
 
-func (s *FuncNameContext) T_QUANTILE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_QUANTILE, 0)
+func (s *FuncNameContext) T_LAST() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LAST, 0)
 }
 
-func (s *FuncNameContext) T_RATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_RATE, 0)
+func (s *FuncNameContext) T_COUNT_DISTINCT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COUNT_DISTINCT, 0)
 }
 
 func (s *FuncNameContext) GetRuleContext() antlr.RuleContext {
@@ -9881,7 +14715,7 @@ func (s *FuncNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, SQLParserRULE_funcName)
+	p.EnterRule(localctx, 186, SQLParserRULE_funcName)
 	var _la int
 
 	defer func() {
@@ -9902,10 +14736,10 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(627)
+		p.SetState(937)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-80)&-(0x1f+1)) == 0 && ((1<<uint((_la-80)))&((1<<(SQLParserT_SUM-80))|(1<<(SQLParserT_MIN-80))|(1<<(SQLParserT_MAX-80))|(1<<(SQLParserT_COUNT-80))|(1<<(SQLParserT_AVG-80))|(1<<(SQLParserT_STDDEV-80))|(1<<(SQLParserT_QUANTILE-80))|(1<<(SQLParserT_RATE-80)))) != 0) {
+		if !((((_la-93)&-(0x1f+1)) == 0 && ((1<<uint((_la-93)))&((1<<(SQLParserT_LOG-93))|(1<<(SQLParserT_SUM-93))|(1<<(SQLParserT_MIN-93))|(1<<(SQLParserT_MAX-93))|(1<<(SQLParserT_COUNT-93))|(1<<(SQLParserT_AVG-93))|(1<<(SQLParserT_STDDEV-93))|(1<<(SQLParserT_QUANTILE-93))|(1<<(SQLParserT_RATE-93))|(1<<(SQLParserT_VARIANCE-93))|(1<<(SQLParserT_FIRST_VALUE-93))|(1<<(SQLParserT_LAST_VALUE-93))|(1<<(SQLParserT_MEDIAN-93))|(1<<(SQLParserT_IRATE-93))|(1<<(SQLParserT_INCREASE-93))|(1<<(SQLParserT_DERIVATIVE-93))|(1<<(SQLParserT_DELTA-93))|(1<<(SQLParserT_MOVING_AVG-93))|(1<<(SQLParserT_MOVING_SUM-93))|(1<<(SQLParserT_CUMULATIVE_SUM-93))|(1<<(SQLParserT_EWMA-93))|(1<<(SQLParserT_TIME_SHIFT-93))|(1<<(SQLParserT_DIFF-93))|(1<<(SQLParserT_ABS-93))|(1<<(SQLParserT_CEIL-93))|(1<<(SQLParserT_FLOOR-93))|(1<<(SQLParserT_ROUND-93))|(1<<(SQLParserT_POW-93))|(1<<(SQLParserT_SQRT-93))|(1<<(SQLParserT_CLAMP_MIN-93))|(1<<(SQLParserT_CLAMP_MAX-93)))) != 0) || (((_la-130)&-(0x1f+1)) == 0 && ((1<<uint((_la-130)))&((1<<(SQLParserT_HISTOGRAM_COUNT-130))|(1<<(SQLParserT_HISTOGRAM_SUM-130))|(1<<(SQLParserT_HISTOGRAM_AVG-130))|(1<<(SQLParserT_HISTOGRAM_FRACTION-130))|(1<<(SQLParserT_HEATMAP-130))|(1<<(SQLParserT_LAST-130))|(1<<(SQLParserT_COUNT_DISTINCT-130)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -10007,7 +14841,7 @@ func (s *ExprFuncParamsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 	localctx = NewExprFuncParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SQLParserRULE_exprFuncParams)
+	p.EnterRule(localctx, 188, SQLParserRULE_exprFuncParams)
 	var _la int
 
 	defer func() {
@@ -10028,24 +14862,24 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(629)
+		p.SetState(939)
 		p.FuncParam()
 	}
-	p.SetState(634)
+	p.SetState(944)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(630)
+			p.SetState(940)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(631)
+			p.SetState(941)
 			p.FuncParam()
 		}
 
-		p.SetState(636)
+		p.SetState(946)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10133,7 +14967,7 @@ func (s *FuncParamContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	localctx = NewFuncParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SQLParserRULE_funcParam)
+	p.EnterRule(localctx, 190, SQLParserRULE_funcParam)
 
 	defer func() {
 		p.ExitRule()
@@ -10151,20 +14985,20 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 		}
 	}()
 
-	p.SetState(639)
+	p.SetState(949)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(637)
+			p.SetState(947)
 			p.fieldExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(638)
+			p.SetState(948)
 			p.tagFilterExpr(0)
 		}
 
@@ -10273,7 +15107,7 @@ func (s *ExprAtomContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	localctx = NewExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SQLParserRULE_exprAtom)
+	p.EnterRule(localctx, 192, SQLParserRULE_exprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -10291,21 +15125,21 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 		}
 	}()
 
-	p.SetState(647)
+	p.SetState(957)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(641)
+			p.SetState(951)
 			p.Ident()
 		}
-		p.SetState(643)
+		p.SetState(953)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(642)
+				p.SetState(952)
 				p.IdentFilter()
 			}
 
@@ -10314,14 +15148,14 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(645)
+			p.SetState(955)
 			p.DecNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(646)
+			p.SetState(956)
 			p.IntNumber()
 		}
 
@@ -10408,7 +15242,7 @@ func (s *IdentFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 	localctx = NewIdentFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SQLParserRULE_identFilter)
+	p.EnterRule(localctx, 194, SQLParserRULE_identFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -10428,15 +15262,15 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(649)
+		p.SetState(959)
 		p.Match(SQLParserT_OPEN_SB)
 	}
 	{
-		p.SetState(650)
+		p.SetState(960)
 		p.tagFilterExpr(0)
 	}
 	{
-		p.SetState(651)
+		p.SetState(961)
 		p.Match(SQLParserT_CLOSE_SB)
 	}
 
@@ -10513,7 +15347,7 @@ func (s *JsonContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Json() (localctx IJsonContext) {
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, SQLParserRULE_json)
+	p.EnterRule(localctx, 196, SQLParserRULE_json)
 
 	defer func() {
 		p.ExitRule()
@@ -10533,7 +15367,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(653)
+		p.SetState(963)
 		p.Value()
 	}
 
@@ -10639,7 +15473,7 @@ func (s *ObjContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Obj() (localctx IObjContext) {
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SQLParserRULE_obj)
+	p.EnterRule(localctx, 198, SQLParserRULE_obj)
 	var _la int
 
 	defer func() {
@@ -10658,50 +15492,50 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 		}
 	}()
 
-	p.SetState(668)
+	p.SetState(978)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(655)
+			p.SetState(965)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(656)
+			p.SetState(966)
 			p.Pair()
 		}
-		p.SetState(661)
+		p.SetState(971)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(657)
+				p.SetState(967)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(658)
+				p.SetState(968)
 				p.Pair()
 			}
 
-			p.SetState(663)
+			p.SetState(973)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(664)
+			p.SetState(974)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(666)
+			p.SetState(976)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(667)
+			p.SetState(977)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
@@ -10788,7 +15622,7 @@ func (s *PairContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Pair() (localctx IPairContext) {
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SQLParserRULE_pair)
+	p.EnterRule(localctx, 200, SQLParserRULE_pair)
 
 	defer func() {
 		p.ExitRule()
@@ -10808,15 +15642,15 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(670)
+		p.SetState(980)
 		p.Match(SQLParserSTRING)
 	}
 	{
-		p.SetState(671)
+		p.SetState(981)
 		p.Match(SQLParserT_COLON)
 	}
 	{
-		p.SetState(672)
+		p.SetState(982)
 		p.Value()
 	}
 
@@ -10922,7 +15756,7 @@ func (s *ArrContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Arr() (localctx IArrContext) {
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SQLParserRULE_arr)
+	p.EnterRule(localctx, 202, SQLParserRULE_arr)
 	var _la int
 
 	defer func() {
@@ -10941,50 +15775,50 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 		}
 	}()
 
-	p.SetState(687)
+	p.SetState(997)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(674)
+			p.SetState(984)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(675)
+			p.SetState(985)
 			p.Value()
 		}
-		p.SetState(680)
+		p.SetState(990)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(676)
+				p.SetState(986)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(677)
+				p.SetState(987)
 				p.Value()
 			}
 
-			p.SetState(682)
+			p.SetState(992)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(683)
+			p.SetState(993)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(685)
+			p.SetState(995)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(686)
+			p.SetState(996)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
@@ -11075,6 +15909,10 @@ func (s *ValueContext) Arr() IArrContext {
 	return t.(IArrContext)
 }
 
+func (s *ValueContext) T_NULL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NULL, 0)
+}
+
 func (s *ValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -11097,7 +15935,7 @@ func (s *ValueContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Value() (localctx IValueContext) {
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, SQLParserRULE_value)
+	p.EnterRule(localctx, 204, SQLParserRULE_value)
 
 	defer func() {
 		p.ExitRule()
@@ -11115,63 +15953,63 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 		}
 	}()
 
-	p.SetState(697)
+	p.SetState(1007)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(689)
+			p.SetState(999)
 			p.Match(SQLParserSTRING)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(690)
+			p.SetState(1000)
 			p.IntNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(691)
+			p.SetState(1001)
 			p.DecNumber()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(692)
+			p.SetState(1002)
 			p.Obj()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(693)
+			p.SetState(1003)
 			p.Arr()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(694)
+			p.SetState(1004)
 			p.Match(SQLParserT__0)
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(695)
+			p.SetState(1005)
 			p.Match(SQLParserT__1)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(696)
-			p.Match(SQLParserT__2)
+			p.SetState(1006)
+			p.Match(SQLParserT_NULL)
 		}
 
 	}
@@ -11202,57 +16040,288 @@ func NewEmptyIntNumberContext() *IntNumberContext {
 	return p
 }
 
-func (*IntNumberContext) IsIntNumberContext() {}
+func (*IntNumberContext) IsIntNumberContext() {}
+
+func NewIntNumberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntNumberContext {
+	var p = new(IntNumberContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_intNumber
+
+	return p
+}
+
+func (s *IntNumberContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *IntNumberContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *IntNumberContext) T_SUB() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUB, 0)
+}
+
+func (s *IntNumberContext) T_ADD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADD, 0)
+}
+
+func (s *IntNumberContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IntNumberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *IntNumberContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterIntNumber(s)
+	}
+}
+
+func (s *IntNumberContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitIntNumber(s)
+	}
+}
+
+func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
+	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 206, SQLParserRULE_intNumber)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(1010)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
+		{
+			p.SetState(1009)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
+	{
+		p.SetState(1012)
+		p.Match(SQLParserL_INT)
+	}
+
+	return localctx
+}
+
+// IDecNumberContext is an interface to support dynamic dispatch.
+type IDecNumberContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDecNumberContext differentiates from other interfaces.
+	IsDecNumberContext()
+}
+
+type DecNumberContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDecNumberContext() *DecNumberContext {
+	var p = new(DecNumberContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_decNumber
+	return p
+}
+
+func (*DecNumberContext) IsDecNumberContext() {}
+
+func NewDecNumberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecNumberContext {
+	var p = new(DecNumberContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_decNumber
+
+	return p
+}
+
+func (s *DecNumberContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DecNumberContext) L_DEC() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_DEC, 0)
+}
+
+func (s *DecNumberContext) T_SUB() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUB, 0)
+}
+
+func (s *DecNumberContext) T_ADD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADD, 0)
+}
+
+func (s *DecNumberContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DecNumberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DecNumberContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDecNumber(s)
+	}
+}
+
+func (s *DecNumberContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDecNumber(s)
+	}
+}
+
+func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
+	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 208, SQLParserRULE_decNumber)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(1015)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
+		{
+			p.SetState(1014)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
+	{
+		p.SetState(1017)
+		p.Match(SQLParserL_DEC)
+	}
+
+	return localctx
+}
+
+// ILimitClauseContext is an interface to support dynamic dispatch.
+type ILimitClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLimitClauseContext differentiates from other interfaces.
+	IsLimitClauseContext()
+}
+
+type LimitClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLimitClauseContext() *LimitClauseContext {
+	var p = new(LimitClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_limitClause
+	return p
+}
+
+func (*LimitClauseContext) IsLimitClauseContext() {}
 
-func NewIntNumberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntNumberContext {
-	var p = new(IntNumberContext)
+func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
+	var p = new(LimitClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_intNumber
+	p.RuleIndex = SQLParserRULE_limitClause
 
 	return p
 }
 
-func (s *IntNumberContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *IntNumberContext) L_INT() antlr.TerminalNode {
-	return s.GetToken(SQLParserL_INT, 0)
-}
+func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IntNumberContext) T_SUB() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SUB, 0)
+func (s *LimitClauseContext) T_LIMIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMIT, 0)
 }
 
-func (s *IntNumberContext) T_ADD() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ADD, 0)
+func (s *LimitClauseContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
 }
 
-func (s *IntNumberContext) GetRuleContext() antlr.RuleContext {
+func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IntNumberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IntNumberContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LimitClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterIntNumber(s)
+		listenerT.EnterLimitClause(s)
 	}
 }
 
-func (s *IntNumberContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LimitClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitIntNumber(s)
+		listenerT.ExitLimitClause(s)
 	}
 }
 
-func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
-	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, SQLParserRULE_intNumber)
-	var _la int
+func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
+	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 210, SQLParserRULE_limitClause)
 
 	defer func() {
 		p.ExitRule()
@@ -11271,106 +16340,101 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(700)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
-		{
-			p.SetState(699)
-			_la = p.GetTokenStream().LA(1)
-
-			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
-		}
-
+	{
+		p.SetState(1019)
+		p.Match(SQLParserT_LIMIT)
 	}
 	{
-		p.SetState(702)
+		p.SetState(1020)
 		p.Match(SQLParserL_INT)
 	}
 
 	return localctx
 }
 
-// IDecNumberContext is an interface to support dynamic dispatch.
-type IDecNumberContext interface {
+// ITimeZoneClauseContext is an interface to support dynamic dispatch.
+type ITimeZoneClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDecNumberContext differentiates from other interfaces.
-	IsDecNumberContext()
+	// IsTimeZoneClauseContext differentiates from other interfaces.
+	IsTimeZoneClauseContext()
 }
 
-type DecNumberContext struct {
+type TimeZoneClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDecNumberContext() *DecNumberContext {
-	var p = new(DecNumberContext)
+func NewEmptyTimeZoneClauseContext() *TimeZoneClauseContext {
+	var p = new(TimeZoneClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_decNumber
+	p.RuleIndex = SQLParserRULE_timeZoneClause
 	return p
 }
 
-func (*DecNumberContext) IsDecNumberContext() {}
+func (*TimeZoneClauseContext) IsTimeZoneClauseContext() {}
 
-func NewDecNumberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecNumberContext {
-	var p = new(DecNumberContext)
+func NewTimeZoneClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeZoneClauseContext {
+	var p = new(TimeZoneClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_decNumber
+	p.RuleIndex = SQLParserRULE_timeZoneClause
 
 	return p
 }
 
-func (s *DecNumberContext) GetParser() antlr.Parser { return s.parser }
+func (s *TimeZoneClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DecNumberContext) L_DEC() antlr.TerminalNode {
-	return s.GetToken(SQLParserL_DEC, 0)
+func (s *TimeZoneClauseContext) T_TZ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TZ, 0)
 }
 
-func (s *DecNumberContext) T_SUB() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SUB, 0)
+func (s *TimeZoneClauseContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
 }
 
-func (s *DecNumberContext) T_ADD() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ADD, 0)
+func (s *TimeZoneClauseContext) Ident() IIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
 }
 
-func (s *DecNumberContext) GetRuleContext() antlr.RuleContext {
+func (s *TimeZoneClauseContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *TimeZoneClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DecNumberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TimeZoneClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DecNumberContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TimeZoneClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDecNumber(s)
+		listenerT.EnterTimeZoneClause(s)
 	}
 }
 
-func (s *DecNumberContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TimeZoneClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDecNumber(s)
+		listenerT.ExitTimeZoneClause(s)
 	}
 }
 
-func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
-	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SQLParserRULE_decNumber)
-	var _la int
+func (p *SQLParser) TimeZoneClause() (localctx ITimeZoneClauseContext) {
+	localctx = NewTimeZoneClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 212, SQLParserRULE_timeZoneClause)
 
 	defer func() {
 		p.ExitRule()
@@ -11389,101 +16453,95 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(705)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
-		{
-			p.SetState(704)
-			_la = p.GetTokenStream().LA(1)
-
-			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
-		}
-
+	{
+		p.SetState(1022)
+		p.Match(SQLParserT_TZ)
 	}
 	{
-		p.SetState(707)
-		p.Match(SQLParserL_DEC)
+		p.SetState(1023)
+		p.Match(SQLParserT_OPEN_P)
+	}
+	{
+		p.SetState(1024)
+		p.Ident()
+	}
+	{
+		p.SetState(1025)
+		p.Match(SQLParserT_CLOSE_P)
 	}
 
 	return localctx
 }
 
-// ILimitClauseContext is an interface to support dynamic dispatch.
-type ILimitClauseContext interface {
+// IWithExemplarsClauseContext is an interface to support dynamic dispatch.
+type IWithExemplarsClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLimitClauseContext differentiates from other interfaces.
-	IsLimitClauseContext()
+	// IsWithExemplarsClauseContext differentiates from other interfaces.
+	IsWithExemplarsClauseContext()
 }
 
-type LimitClauseContext struct {
+type WithExemplarsClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLimitClauseContext() *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewEmptyWithExemplarsClauseContext() *WithExemplarsClauseContext {
+	var p = new(WithExemplarsClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_limitClause
+	p.RuleIndex = SQLParserRULE_withExemplarsClause
 	return p
 }
 
-func (*LimitClauseContext) IsLimitClauseContext() {}
+func (*WithExemplarsClauseContext) IsWithExemplarsClauseContext() {}
 
-func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewWithExemplarsClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithExemplarsClauseContext {
+	var p = new(WithExemplarsClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_limitClause
+	p.RuleIndex = SQLParserRULE_withExemplarsClause
 
 	return p
 }
 
-func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *WithExemplarsClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LimitClauseContext) T_LIMIT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_LIMIT, 0)
+func (s *WithExemplarsClauseContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
 }
 
-func (s *LimitClauseContext) L_INT() antlr.TerminalNode {
-	return s.GetToken(SQLParserL_INT, 0)
+func (s *WithExemplarsClauseContext) T_EXEMPLARS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXEMPLARS, 0)
 }
 
-func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *WithExemplarsClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WithExemplarsClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LimitClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WithExemplarsClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterLimitClause(s)
+		listenerT.EnterWithExemplarsClause(s)
 	}
 }
 
-func (s *LimitClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WithExemplarsClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitLimitClause(s)
+		listenerT.ExitWithExemplarsClause(s)
 	}
 }
 
-func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
-	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SQLParserRULE_limitClause)
+func (p *SQLParser) WithExemplarsClause() (localctx IWithExemplarsClauseContext) {
+	localctx = NewWithExemplarsClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 214, SQLParserRULE_withExemplarsClause)
 
 	defer func() {
 		p.ExitRule()
@@ -11503,12 +16561,12 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(709)
-		p.Match(SQLParserT_LIMIT)
+		p.SetState(1027)
+		p.Match(SQLParserT_WITH)
 	}
 	{
-		p.SetState(710)
-		p.Match(SQLParserL_INT)
+		p.SetState(1028)
+		p.Match(SQLParserT_EXEMPLARS)
 	}
 
 	return localctx
@@ -11584,7 +16642,7 @@ func (s *MetricNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 	localctx = NewMetricNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SQLParserRULE_metricName)
+	p.EnterRule(localctx, 216, SQLParserRULE_metricName)
 
 	defer func() {
 		p.ExitRule()
@@ -11604,7 +16662,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(712)
+		p.SetState(1030)
 		p.Ident()
 	}
 
@@ -11681,7 +16739,7 @@ func (s *TagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 	localctx = NewTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, SQLParserRULE_tagKey)
+	p.EnterRule(localctx, 218, SQLParserRULE_tagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -11701,7 +16759,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(714)
+		p.SetState(1032)
 		p.Ident()
 	}
 
@@ -11778,7 +16836,7 @@ func (s *TagValueContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 	localctx = NewTagValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SQLParserRULE_tagValue)
+	p.EnterRule(localctx, 220, SQLParserRULE_tagValue)
 
 	defer func() {
 		p.ExitRule()
@@ -11798,7 +16856,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(716)
+		p.SetState(1034)
 		p.Ident()
 	}
 
@@ -11904,7 +16962,7 @@ func (s *IdentContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) Ident() (localctx IIdentContext) {
 	localctx = NewIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SQLParserRULE_ident)
+	p.EnterRule(localctx, 222, SQLParserRULE_ident)
 
 	defer func() {
 		p.ExitRule()
@@ -11925,48 +16983,48 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(720)
+	p.SetState(1038)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserL_ID:
 		{
-			p.SetState(718)
+			p.SetState(1036)
 			p.Match(SQLParserL_ID)
 		}
 
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_USER, SQLParserT_USERS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_LIMITS, SQLParserT_CARDINALITY, SQLParserT_PASSWORD, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_DELETE, SQLParserT_ALTER, SQLParserT_RENAME, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_VARIANCE, SQLParserT_FIRST_VALUE, SQLParserT_LAST_VALUE, SQLParserT_MEDIAN, SQLParserT_IRATE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVG, SQLParserT_MOVING_SUM, SQLParserT_CUMULATIVE_SUM, SQLParserT_EWMA, SQLParserT_TIME_SHIFT, SQLParserT_DIFF, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_POW, SQLParserT_SQRT, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_IF, SQLParserT_TZ, SQLParserT_REGEX_EXTRACT, SQLParserT_LABEL_REPLACE, SQLParserT_EXEMPLARS, SQLParserT_HISTOGRAM_COUNT, SQLParserT_HISTOGRAM_SUM, SQLParserT_HISTOGRAM_AVG, SQLParserT_HISTOGRAM_FRACTION, SQLParserT_HEATMAP, SQLParserT_LAST, SQLParserT_COUNT_DISTINCT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 		{
-			p.SetState(719)
+			p.SetState(1037)
 			p.NonReservedWords()
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(729)
+	p.SetState(1047)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(722)
+				p.SetState(1040)
 				p.Match(SQLParserT_DOT)
 			}
-			p.SetState(725)
+			p.SetState(1043)
 			p.GetErrorHandler().Sync(p)
 
 			switch p.GetTokenStream().LA(1) {
 			case SQLParserL_ID:
 				{
-					p.SetState(723)
+					p.SetState(1041)
 					p.Match(SQLParserL_ID)
 				}
 
-			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_USER, SQLParserT_USERS, SQLParserT_ROLE, SQLParserT_ROLES, SQLParserT_LIMITS, SQLParserT_CARDINALITY, SQLParserT_PASSWORD, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_DELETE, SQLParserT_ALTER, SQLParserT_RENAME, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_VARIANCE, SQLParserT_FIRST_VALUE, SQLParserT_LAST_VALUE, SQLParserT_MEDIAN, SQLParserT_IRATE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVG, SQLParserT_MOVING_SUM, SQLParserT_CUMULATIVE_SUM, SQLParserT_EWMA, SQLParserT_TIME_SHIFT, SQLParserT_DIFF, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_POW, SQLParserT_SQRT, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_IF, SQLParserT_TZ, SQLParserT_REGEX_EXTRACT, SQLParserT_LABEL_REPLACE, SQLParserT_EXEMPLARS, SQLParserT_HISTOGRAM_COUNT, SQLParserT_HISTOGRAM_SUM, SQLParserT_HISTOGRAM_AVG, SQLParserT_HISTOGRAM_FRACTION, SQLParserT_HEATMAP, SQLParserT_LAST, SQLParserT_COUNT_DISTINCT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 				{
-					p.SetState(724)
+					p.SetState(1042)
 					p.NonReservedWords()
 				}
 
@@ -11975,9 +17033,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			}
 
 		}
-		p.SetState(731)
+		p.SetState(1049)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -12301,6 +17359,142 @@ func (s *NonReservedWordsContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *NonReservedWordsContext) T_VARIANCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VARIANCE, 0)
+}
+
+func (s *NonReservedWordsContext) T_FIRST_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIRST_VALUE, 0)
+}
+
+func (s *NonReservedWordsContext) T_LAST_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LAST_VALUE, 0)
+}
+
+func (s *NonReservedWordsContext) T_MEDIAN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MEDIAN, 0)
+}
+
+func (s *NonReservedWordsContext) T_IRATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IRATE, 0)
+}
+
+func (s *NonReservedWordsContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DERIVATIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIVATIVE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *NonReservedWordsContext) T_MOVING_AVG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_AVG, 0)
+}
+
+func (s *NonReservedWordsContext) T_MOVING_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_SUM, 0)
+}
+
+func (s *NonReservedWordsContext) T_CUMULATIVE_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CUMULATIVE_SUM, 0)
+}
+
+func (s *NonReservedWordsContext) T_EWMA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EWMA, 0)
+}
+
+func (s *NonReservedWordsContext) T_TIME_SHIFT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIME_SHIFT, 0)
+}
+
+func (s *NonReservedWordsContext) T_DIFF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DIFF, 0)
+}
+
+func (s *NonReservedWordsContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *NonReservedWordsContext) T_CEIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CEIL, 0)
+}
+
+func (s *NonReservedWordsContext) T_FLOOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FLOOR, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROUND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROUND, 0)
+}
+
+func (s *NonReservedWordsContext) T_POW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_POW, 0)
+}
+
+func (s *NonReservedWordsContext) T_SQRT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SQRT, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP_MIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MIN, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP_MAX() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MAX, 0)
+}
+
+func (s *NonReservedWordsContext) T_IF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IF, 0)
+}
+
+func (s *NonReservedWordsContext) T_TZ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TZ, 0)
+}
+
+func (s *NonReservedWordsContext) T_REGEX_EXTRACT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REGEX_EXTRACT, 0)
+}
+
+func (s *NonReservedWordsContext) T_LABEL_REPLACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABEL_REPLACE, 0)
+}
+
+func (s *NonReservedWordsContext) T_EXEMPLARS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXEMPLARS, 0)
+}
+
+func (s *NonReservedWordsContext) T_HISTOGRAM_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_COUNT, 0)
+}
+
+func (s *NonReservedWordsContext) T_HISTOGRAM_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_SUM, 0)
+}
+
+func (s *NonReservedWordsContext) T_HISTOGRAM_AVG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_AVG, 0)
+}
+
+func (s *NonReservedWordsContext) T_HISTOGRAM_FRACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_FRACTION, 0)
+}
+
+func (s *NonReservedWordsContext) T_HEATMAP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HEATMAP, 0)
+}
+
+func (s *NonReservedWordsContext) T_LAST() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LAST, 0)
+}
+
+func (s *NonReservedWordsContext) T_COUNT_DISTINCT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COUNT_DISTINCT, 0)
+}
+
 func (s *NonReservedWordsContext) T_SECOND() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SECOND, 0)
 }
@@ -12377,6 +17571,70 @@ func (s *NonReservedWordsContext) T_STATE_MACHINE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
 }
 
+func (s *NonReservedWordsContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *NonReservedWordsContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROLES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLES, 0)
+}
+
+func (s *NonReservedWordsContext) T_LIMITS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMITS, 0)
+}
+
+func (s *NonReservedWordsContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *NonReservedWordsContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *NonReservedWordsContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
+}
+
+func (s *NonReservedWordsContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *NonReservedWordsContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *NonReservedWordsContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
+}
+
+func (s *NonReservedWordsContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ADMIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADMIN, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
+}
+
+func (s *NonReservedWordsContext) T_RENAME() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RENAME, 0)
+}
+
 func (s *NonReservedWordsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -12399,7 +17657,7 @@ func (s *NonReservedWordsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 	localctx = NewNonReservedWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SQLParserRULE_nonReservedWords)
+	p.EnterRule(localctx, 224, SQLParserRULE_nonReservedWords)
 	var _la int
 
 	defer func() {
@@ -12420,10 +17678,10 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(732)
+		p.SetState(1050)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLParserT_CREATE)|(1<<SQLParserT_UPDATE)|(1<<SQLParserT_SET)|(1<<SQLParserT_DROP)|(1<<SQLParserT_INTERVAL)|(1<<SQLParserT_INTERVAL_NAME)|(1<<SQLParserT_SHARD)|(1<<SQLParserT_REPLICATION)|(1<<SQLParserT_TTL)|(1<<SQLParserT_META_TTL)|(1<<SQLParserT_PAST_TTL)|(1<<SQLParserT_FUTURE_TTL)|(1<<SQLParserT_KILL)|(1<<SQLParserT_ON)|(1<<SQLParserT_SHOW)|(1<<SQLParserT_USE)|(1<<SQLParserT_STATE_REPO)|(1<<SQLParserT_STATE_MACHINE)|(1<<SQLParserT_MASTER)|(1<<SQLParserT_METADATA)|(1<<SQLParserT_TYPES)|(1<<SQLParserT_TYPE)|(1<<SQLParserT_STORAGES)|(1<<SQLParserT_STORAGE)|(1<<SQLParserT_BROKER)|(1<<SQLParserT_ALIVE))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLParserT_SCHEMAS-32))|(1<<(SQLParserT_DATASBAE-32))|(1<<(SQLParserT_DATASBAES-32))|(1<<(SQLParserT_NAMESPACE-32))|(1<<(SQLParserT_NAMESPACES-32))|(1<<(SQLParserT_NODE-32))|(1<<(SQLParserT_METRICS-32))|(1<<(SQLParserT_METRIC-32))|(1<<(SQLParserT_FIELD-32))|(1<<(SQLParserT_FIELDS-32))|(1<<(SQLParserT_TAG-32))|(1<<(SQLParserT_INFO-32))|(1<<(SQLParserT_KEYS-32))|(1<<(SQLParserT_KEY-32))|(1<<(SQLParserT_WITH-32))|(1<<(SQLParserT_VALUES-32))|(1<<(SQLParserT_VALUE-32))|(1<<(SQLParserT_FROM-32))|(1<<(SQLParserT_WHERE-32))|(1<<(SQLParserT_LIMIT-32))|(1<<(SQLParserT_QUERIES-32))|(1<<(SQLParserT_QUERY-32))|(1<<(SQLParserT_EXPLAIN-32))|(1<<(SQLParserT_WITH_VALUE-32))|(1<<(SQLParserT_SELECT-32))|(1<<(SQLParserT_AS-32))|(1<<(SQLParserT_AND-32))|(1<<(SQLParserT_OR-32))|(1<<(SQLParserT_FILL-32))|(1<<(SQLParserT_NULL-32))|(1<<(SQLParserT_PREVIOUS-32))|(1<<(SQLParserT_ORDER-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLParserT_ASC-64))|(1<<(SQLParserT_DESC-64))|(1<<(SQLParserT_LIKE-64))|(1<<(SQLParserT_NOT-64))|(1<<(SQLParserT_BETWEEN-64))|(1<<(SQLParserT_IS-64))|(1<<(SQLParserT_GROUP-64))|(1<<(SQLParserT_HAVING-64))|(1<<(SQLParserT_BY-64))|(1<<(SQLParserT_FOR-64))|(1<<(SQLParserT_STATS-64))|(1<<(SQLParserT_TIME-64))|(1<<(SQLParserT_NOW-64))|(1<<(SQLParserT_IN-64))|(1<<(SQLParserT_LOG-64))|(1<<(SQLParserT_PROFILE-64))|(1<<(SQLParserT_SUM-64))|(1<<(SQLParserT_MIN-64))|(1<<(SQLParserT_MAX-64))|(1<<(SQLParserT_COUNT-64))|(1<<(SQLParserT_AVG-64))|(1<<(SQLParserT_STDDEV-64))|(1<<(SQLParserT_QUANTILE-64))|(1<<(SQLParserT_RATE-64))|(1<<(SQLParserT_SECOND-64))|(1<<(SQLParserT_MINUTE-64))|(1<<(SQLParserT_HOUR-64))|(1<<(SQLParserT_DAY-64))|(1<<(SQLParserT_WEEK-64))|(1<<(SQLParserT_MONTH-64))|(1<<(SQLParserT_YEAR-64)))) != 0)) {
+		if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SQLParserT_CREATE)|(1<<SQLParserT_UPDATE)|(1<<SQLParserT_SET)|(1<<SQLParserT_DROP)|(1<<SQLParserT_INTERVAL)|(1<<SQLParserT_INTERVAL_NAME)|(1<<SQLParserT_SHARD)|(1<<SQLParserT_REPLICATION)|(1<<SQLParserT_TTL)|(1<<SQLParserT_META_TTL)|(1<<SQLParserT_PAST_TTL)|(1<<SQLParserT_FUTURE_TTL)|(1<<SQLParserT_KILL)|(1<<SQLParserT_ON)|(1<<SQLParserT_SHOW)|(1<<SQLParserT_USE)|(1<<SQLParserT_STATE_REPO)|(1<<SQLParserT_STATE_MACHINE)|(1<<SQLParserT_MASTER)|(1<<SQLParserT_METADATA)|(1<<SQLParserT_TYPES)|(1<<SQLParserT_TYPE)|(1<<SQLParserT_STORAGES)|(1<<SQLParserT_STORAGE)|(1<<SQLParserT_BROKER)|(1<<SQLParserT_ALIVE)|(1<<SQLParserT_SCHEMAS))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SQLParserT_USER-32))|(1<<(SQLParserT_USERS-32))|(1<<(SQLParserT_ROLE-32))|(1<<(SQLParserT_ROLES-32))|(1<<(SQLParserT_LIMITS-32))|(1<<(SQLParserT_CARDINALITY-32))|(1<<(SQLParserT_PASSWORD-32))|(1<<(SQLParserT_GRANT-32))|(1<<(SQLParserT_REVOKE-32))|(1<<(SQLParserT_TO-32))|(1<<(SQLParserT_READ-32))|(1<<(SQLParserT_WRITE-32))|(1<<(SQLParserT_ADMIN-32))|(1<<(SQLParserT_DELETE-32))|(1<<(SQLParserT_ALTER-32))|(1<<(SQLParserT_RENAME-32))|(1<<(SQLParserT_DATASBAE-32))|(1<<(SQLParserT_DATASBAES-32))|(1<<(SQLParserT_NAMESPACE-32))|(1<<(SQLParserT_NAMESPACES-32))|(1<<(SQLParserT_NODE-32))|(1<<(SQLParserT_METRICS-32))|(1<<(SQLParserT_METRIC-32))|(1<<(SQLParserT_FIELD-32))|(1<<(SQLParserT_FIELDS-32))|(1<<(SQLParserT_TAG-32))|(1<<(SQLParserT_INFO-32))|(1<<(SQLParserT_KEYS-32))|(1<<(SQLParserT_KEY-32))|(1<<(SQLParserT_WITH-32))|(1<<(SQLParserT_VALUES-32))|(1<<(SQLParserT_VALUE-32)))) != 0) || (((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(SQLParserT_FROM-64))|(1<<(SQLParserT_WHERE-64))|(1<<(SQLParserT_LIMIT-64))|(1<<(SQLParserT_QUERIES-64))|(1<<(SQLParserT_QUERY-64))|(1<<(SQLParserT_EXPLAIN-64))|(1<<(SQLParserT_WITH_VALUE-64))|(1<<(SQLParserT_SELECT-64))|(1<<(SQLParserT_AS-64))|(1<<(SQLParserT_AND-64))|(1<<(SQLParserT_OR-64))|(1<<(SQLParserT_FILL-64))|(1<<(SQLParserT_NULL-64))|(1<<(SQLParserT_PREVIOUS-64))|(1<<(SQLParserT_ORDER-64))|(1<<(SQLParserT_ASC-64))|(1<<(SQLParserT_DESC-64))|(1<<(SQLParserT_LIKE-64))|(1<<(SQLParserT_NOT-64))|(1<<(SQLParserT_BETWEEN-64))|(1<<(SQLParserT_IS-64))|(1<<(SQLParserT_GROUP-64))|(1<<(SQLParserT_HAVING-64))|(1<<(SQLParserT_BY-64))|(1<<(SQLParserT_FOR-64))|(1<<(SQLParserT_STATS-64))|(1<<(SQLParserT_TIME-64))|(1<<(SQLParserT_NOW-64))|(1<<(SQLParserT_IN-64))|(1<<(SQLParserT_LOG-64))|(1<<(SQLParserT_PROFILE-64))|(1<<(SQLParserT_SUM-64)))) != 0) || (((_la-96)&-(0x1f+1)) == 0 && ((1<<uint((_la-96)))&((1<<(SQLParserT_MIN-96))|(1<<(SQLParserT_MAX-96))|(1<<(SQLParserT_COUNT-96))|(1<<(SQLParserT_AVG-96))|(1<<(SQLParserT_STDDEV-96))|(1<<(SQLParserT_QUANTILE-96))|(1<<(SQLParserT_RATE-96))|(1<<(SQLParserT_VARIANCE-96))|(1<<(SQLParserT_FIRST_VALUE-96))|(1<<(SQLParserT_LAST_VALUE-96))|(1<<(SQLParserT_MEDIAN-96))|(1<<(SQLParserT_IRATE-96))|(1<<(SQLParserT_INCREASE-96))|(1<<(SQLParserT_DERIVATIVE-96))|(1<<(SQLParserT_DELTA-96))|(1<<(SQLParserT_MOVING_AVG-96))|(1<<(SQLParserT_MOVING_SUM-96))|(1<<(SQLParserT_CUMULATIVE_SUM-96))|(1<<(SQLParserT_EWMA-96))|(1<<(SQLParserT_TIME_SHIFT-96))|(1<<(SQLParserT_DIFF-96))|(1<<(SQLParserT_ABS-96))|(1<<(SQLParserT_CEIL-96))|(1<<(SQLParserT_FLOOR-96))|(1<<(SQLParserT_ROUND-96))|(1<<(SQLParserT_POW-96))|(1<<(SQLParserT_SQRT-96))|(1<<(SQLParserT_CLAMP_MIN-96))|(1<<(SQLParserT_CLAMP_MAX-96))|(1<<(SQLParserT_IF-96))|(1<<(SQLParserT_TZ-96))|(1<<(SQLParserT_REGEX_EXTRACT-96)))) != 0) || (((_la-128)&-(0x1f+1)) == 0 && ((1<<uint((_la-128)))&((1<<(SQLParserT_LABEL_REPLACE-128))|(1<<(SQLParserT_EXEMPLARS-128))|(1<<(SQLParserT_HISTOGRAM_COUNT-128))|(1<<(SQLParserT_HISTOGRAM_SUM-128))|(1<<(SQLParserT_HISTOGRAM_AVG-128))|(1<<(SQLParserT_HISTOGRAM_FRACTION-128))|(1<<(SQLParserT_HEATMAP-128))|(1<<(SQLParserT_LAST-128))|(1<<(SQLParserT_COUNT_DISTINCT-128))|(1<<(SQLParserT_SECOND-128))|(1<<(SQLParserT_MINUTE-128))|(1<<(SQLParserT_HOUR-128))|(1<<(SQLParserT_DAY-128))|(1<<(SQLParserT_WEEK-128))|(1<<(SQLParserT_MONTH-128))|(1<<(SQLParserT_YEAR-128)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -12436,21 +17694,21 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 func (p *SQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 39:
+	case 67:
 		var t *TagFilterExprContext = nil
 		if localctx != nil {
 			t = localctx.(*TagFilterExprContext)
 		}
 		return p.TagFilterExpr_Sempred(t, predIndex)
 
-	case 55:
+	case 84:
 		var t *BoolExprContext = nil
 		if localctx != nil {
 			t = localctx.(*BoolExprContext)
 		}
 		return p.BoolExpr_Sempred(t, predIndex)
 
-	case 60:
+	case 89:
 		var t *FieldExprContext = nil
 		if localctx != nil {
 			t = localctx.(*FieldExprContext)
@@ -0,0 +1,172 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+// Statement represents an InfluxQL statement.
+type Statement interface {
+	statement()
+}
+
+// Expr represents an InfluxQL expression.
+type Expr interface {
+	expr()
+}
+
+// FillOption represents the fill option of empty interval.
+type FillOption int
+
+// Defines all fill options of select statement.
+const (
+	FillNull FillOption = iota
+	FillNone
+	FillValue
+	FillPrevious
+	FillLinear
+)
+
+// Field represents a select field with alias.
+type Field struct {
+	Expr  Expr
+	Alias string
+}
+
+// SelectStatement represents the select statement.
+type SelectStatement struct {
+	Fields      []*Field
+	Measurement string
+	Condition   Expr
+
+	GroupByInterval int64 // group by time interval in milliseconds
+	GroupByTags     []string
+	GroupByAll      bool   // group by *
+	GroupByRegex    string // group by /regex/
+
+	Fill      FillOption
+	FillValue float64
+
+	Descending bool
+	Limit      int
+	Offset     int
+	SLimit     int
+	SOffset    int
+}
+
+// ShowDatabasesStatement represents the show databases statement.
+type ShowDatabasesStatement struct{}
+
+// ShowMeasurementsStatement represents the show measurements statement.
+type ShowMeasurementsStatement struct {
+	MatchOp tokenType // operator of WITH MEASUREMENT clause, tokenEOF if not provided
+	Match   string    // measurement name or regex of WITH MEASUREMENT clause
+	Limit   int
+	Offset  int
+}
+
+// ShowTagKeysStatement represents the show tag keys statement.
+type ShowTagKeysStatement struct {
+	Measurement string
+	Limit       int
+	Offset      int
+}
+
+// ShowTagValuesStatement represents the show tag values statement.
+type ShowTagValuesStatement struct {
+	Measurement string
+	KeyOp       tokenType // operator of WITH KEY clause
+	Keys        []string  // tag keys(or regex) of WITH KEY clause
+	Condition   Expr
+	Limit       int
+	Offset      int
+}
+
+// ShowFieldKeysStatement represents the show field keys statement.
+type ShowFieldKeysStatement struct {
+	Measurement string
+	Limit       int
+	Offset      int
+}
+
+// CreateDatabaseStatement represents the create database statement,
+// which is sent by influxdb agents(such as telegraf) on startup.
+type CreateDatabaseStatement struct {
+	Name string
+}
+
+// VarRef represents a reference of field, tag or time.
+type VarRef struct {
+	Name string
+}
+
+// Call represents a function call.
+type Call struct {
+	Name string
+	Args []Expr
+}
+
+// NumberLiteral represents a number.
+type NumberLiteral struct {
+	Val float64
+}
+
+// DurationLiteral represents a duration in nanoseconds.
+type DurationLiteral struct {
+	Val int64
+}
+
+// StringLiteral represents a single quoted string.
+type StringLiteral struct {
+	Val string
+}
+
+// RegexLiteral represents a regular expression.
+type RegexLiteral struct {
+	Val string
+}
+
+// BinaryExpr represents an operation with two expressions.
+type BinaryExpr struct {
+	Op  tokenType
+	LHS Expr
+	RHS Expr
+}
+
+// ParenExpr represents a parenthesized expression.
+type ParenExpr struct {
+	Expr Expr
+}
+
+// Wildcard represents the wildcard(*).
+type Wildcard struct{}
+
+func (*SelectStatement) statement()           {}
+func (*ShowDatabasesStatement) statement()    {}
+func (*ShowMeasurementsStatement) statement() {}
+func (*ShowTagKeysStatement) statement()      {}
+func (*ShowTagValuesStatement) statement()    {}
+func (*ShowFieldKeysStatement) statement()    {}
+func (*CreateDatabaseStatement) statement()   {}
+
+func (*VarRef) expr()          {}
+func (*Call) expr()            {}
+func (*NumberLiteral) expr()   {}
+func (*DurationLiteral) expr() {}
+func (*StringLiteral) expr()   {}
+func (*RegexLiteral) expr()    {}
+func (*BinaryExpr) expr()      {}
+func (*ParenExpr) expr()       {}
+func (*Wildcard) expr()        {}
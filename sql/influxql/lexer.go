@@ -0,0 +1,399 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tokenType represents the type of lexical token.
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenQuotedIdentifier
+	tokenNumber
+	tokenDuration
+	tokenString
+	tokenRegex
+
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenDot
+	tokenSemicolon
+	tokenDoubleColon
+
+	tokenEqual
+	tokenNotEqual
+	tokenRegexMatch
+	tokenRegexNotMatch
+	tokenLess
+	tokenLessEqual
+	tokenGreater
+	tokenGreaterEqual
+
+	tokenAdd
+	tokenSub
+	tokenMul
+	tokenDiv
+	tokenMod
+
+	// logical operators are keywords, converted by parser
+	tokenAnd
+	tokenOr
+)
+
+// token represents a lexical token with its position in the input.
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+// String returns the string value of token.
+func (t token) String() string {
+	if t.typ == tokenEOF {
+		return "EOF"
+	}
+	return strconv.Quote(t.val)
+}
+
+// isKeyword checks if the token is the unquoted keyword(case-insensitive).
+func (t token) isKeyword(keyword string) bool {
+	return t.typ == tokenIdentifier && strings.EqualFold(t.val, keyword)
+}
+
+// durationUnits defines the time units(in nanoseconds) which can be used as duration suffix.
+var durationUnits = map[string]int64{
+	"ns": 1,
+	"u":  1000,
+	"µ":  1000,
+	"ms": 1000 * 1000,
+	"s":  1000 * 1000 * 1000,
+	"m":  60 * 1000 * 1000 * 1000,
+	"h":  60 * 60 * 1000 * 1000 * 1000,
+	"d":  24 * 60 * 60 * 1000 * 1000 * 1000,
+	"w":  7 * 24 * 60 * 60 * 1000 * 1000 * 1000,
+}
+
+// lexer splits InfluxQL statements into tokens.
+type lexer struct {
+	input  string
+	pos    int
+	tokens []token
+}
+
+// lex returns the tokens of input statements.
+func lex(input string) ([]token, error) {
+	l := &lexer{input: input}
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		l.tokens = append(l.tokens, tok)
+		if tok.typ == tokenEOF {
+			return l.tokens, nil
+		}
+	}
+}
+
+// next scans the next token from input.
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) && isSpace(l.input[l.pos]) {
+		l.pos++
+	}
+	if l.pos >= len(l.input) {
+		return token{typ: tokenEOF, pos: l.pos}, nil
+	}
+	start := l.pos
+	c := l.input[l.pos]
+	switch {
+	case c == '-' && l.peekAt(1) == '-':
+		// comment till end of line
+		for l.pos < len(l.input) && l.input[l.pos] != '\n' {
+			l.pos++
+		}
+		return l.next()
+	case c == '/' && l.peekAt(1) == '*':
+		end := strings.Index(l.input[l.pos+2:], "*/")
+		if end < 0 {
+			return token{}, fmt.Errorf("unterminated comment at position %d", start)
+		}
+		l.pos += end + 4
+		return l.next()
+	case c == '/' && l.regexAllowed():
+		return l.scanRegex()
+	case isIdentifierStart(c):
+		for l.pos < len(l.input) && isIdentifierChar(l.input[l.pos]) {
+			l.pos++
+		}
+		return token{typ: tokenIdentifier, val: l.input[start:l.pos], pos: start}, nil
+	case isDigit(c) || (c == '.' && isDigit(l.peekAt(1))):
+		return l.scanNumber()
+	case c == '"':
+		tok, err := l.scanString(c)
+		tok.typ = tokenQuotedIdentifier
+		return tok, err
+	case c == '\'':
+		return l.scanString(c)
+	}
+	l.pos++
+	var typ tokenType
+	switch c {
+	case '(':
+		typ = tokenLeftParen
+	case ')':
+		typ = tokenRightParen
+	case ',':
+		typ = tokenComma
+	case '.':
+		typ = tokenDot
+	case ';':
+		typ = tokenSemicolon
+	case '+':
+		typ = tokenAdd
+	case '-':
+		typ = tokenSub
+	case '*':
+		typ = tokenMul
+	case '/':
+		typ = tokenDiv
+	case '%':
+		typ = tokenMod
+	case ':':
+		if l.peek() != ':' {
+			return token{}, fmt.Errorf("unexpected character after ':' at position %d", start)
+		}
+		l.pos++
+		typ = tokenDoubleColon
+	case '=':
+		typ = tokenEqual
+		if l.peek() == '~' {
+			l.pos++
+			typ = tokenRegexMatch
+		}
+	case '!':
+		switch l.peek() {
+		case '=':
+			l.pos++
+			typ = tokenNotEqual
+		case '~':
+			l.pos++
+			typ = tokenRegexNotMatch
+		default:
+			return token{}, fmt.Errorf("unexpected character after '!' at position %d", start)
+		}
+	case '<':
+		typ = tokenLess
+		switch l.peek() {
+		case '=':
+			l.pos++
+			typ = tokenLessEqual
+		case '>':
+			l.pos++
+			typ = tokenNotEqual
+		}
+	case '>':
+		typ = tokenGreater
+		if l.peek() == '=' {
+			l.pos++
+			typ = tokenGreaterEqual
+		}
+	default:
+		return token{}, fmt.Errorf("unexpected character %q at position %d", c, start)
+	}
+	return token{typ: typ, val: l.input[start:l.pos], pos: start}, nil
+}
+
+// peek returns the next character without consuming it.
+func (l *lexer) peek() byte {
+	return l.peekAt(0)
+}
+
+// peekAt returns the character at offset of current position without consuming it.
+func (l *lexer) peekAt(offset int) byte {
+	if l.pos+offset >= len(l.input) {
+		return 0
+	}
+	return l.input[l.pos+offset]
+}
+
+// regexAllowed checks if '/' starts a regex literal rather than division,
+// regex is allowed after regex match operator, GROUP BY and comma.
+func (l *lexer) regexAllowed() bool {
+	if len(l.tokens) == 0 {
+		return false
+	}
+	prev := l.tokens[len(l.tokens)-1]
+	return prev.typ == tokenRegexMatch || prev.typ == tokenRegexNotMatch ||
+		prev.typ == tokenComma || prev.isKeyword("by")
+}
+
+// scanRegex scans a regex literal(like /^cpu.*/), returns the regex without slashes.
+func (l *lexer) scanRegex() (token, error) {
+	start := l.pos
+	l.pos++
+	var buf strings.Builder
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == '\\' && l.peekAt(1) == '/':
+			buf.WriteByte('/')
+			l.pos += 2
+			continue
+		case c == '/':
+			l.pos++
+			return token{typ: tokenRegex, val: buf.String(), pos: start}, nil
+		}
+		buf.WriteByte(c)
+		l.pos++
+	}
+	return token{}, fmt.Errorf("unterminated regex at position %d", start)
+}
+
+// scanNumber scans a number or a duration(like 5m, 1600000000000ms).
+func (l *lexer) scanNumber() (token, error) {
+	start := l.pos
+	for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+		l.pos++
+	}
+	// try duration, such as 5m/1h30m/100ms
+	if l.pos < len(l.input) && isDurationUnitStart(l.input[l.pos]) {
+		for l.pos < len(l.input) && (isDigit(l.input[l.pos]) || isDurationUnitStart(l.input[l.pos])) {
+			l.pos++
+		}
+		val := l.input[start:l.pos]
+		if _, err := ParseDuration(val); err != nil {
+			return token{}, err
+		}
+		return token{typ: tokenDuration, val: val, pos: start}, nil
+	}
+	if l.pos < len(l.input) && l.input[l.pos] == '.' {
+		l.pos++
+		for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+			l.pos++
+		}
+	}
+	if l.pos < len(l.input) && (l.input[l.pos] == 'e' || l.input[l.pos] == 'E') {
+		l.pos++
+		if l.pos < len(l.input) && (l.input[l.pos] == '+' || l.input[l.pos] == '-') {
+			l.pos++
+		}
+		for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+			l.pos++
+		}
+	}
+	val := l.input[start:l.pos]
+	if _, err := strconv.ParseFloat(val, 64); err != nil {
+		return token{}, fmt.Errorf("bad number %q at position %d", val, start)
+	}
+	return token{typ: tokenNumber, val: val, pos: start}, nil
+}
+
+// scanString scans a quoted string or identifier, returns the unquoted value.
+func (l *lexer) scanString(quote byte) (token, error) {
+	start := l.pos
+	l.pos++
+	var buf strings.Builder
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == '\\' && l.pos+1 < len(l.input):
+			next := l.input[l.pos+1]
+			switch next {
+			case 'n':
+				buf.WriteByte('\n')
+			case 't':
+				buf.WriteByte('\t')
+			case '\\', '\'', '"':
+				buf.WriteByte(next)
+			default:
+				buf.WriteByte(c)
+				buf.WriteByte(next)
+			}
+			l.pos += 2
+			continue
+		case c == quote:
+			l.pos++
+			return token{typ: tokenString, val: buf.String(), pos: start}, nil
+		}
+		buf.WriteByte(c)
+		l.pos++
+	}
+	return token{}, fmt.Errorf("unterminated quoted string at position %d", start)
+}
+
+// ParseDuration parses duration string(like 5m, 1h30m) into nanoseconds.
+func ParseDuration(val string) (int64, error) {
+	var (
+		result int64
+		pos    int
+	)
+	if val == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	for pos < len(val) {
+		start := pos
+		for pos < len(val) && isDigit(val[pos]) {
+			pos++
+		}
+		if start == pos {
+			return 0, fmt.Errorf("bad duration %q", val)
+		}
+		num, err := strconv.ParseInt(val[start:pos], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("bad duration %q", val)
+		}
+		unitStart := pos
+		for pos < len(val) && isDurationUnitStart(val[pos]) {
+			pos++
+		}
+		unit, ok := durationUnits[val[unitStart:pos]]
+		if !ok {
+			return 0, fmt.Errorf("bad duration %q", val)
+		}
+		result += num * unit
+	}
+	return result, nil
+}
+
+func isSpace(c byte) bool {
+	return unicode.IsSpace(rune(c))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isDurationUnitStart(c byte) bool {
+	return strings.IndexByte("nuµsmhdw", c) >= 0
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
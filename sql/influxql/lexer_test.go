@@ -0,0 +1,109 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLexer_lex(t *testing.T) {
+	tokens, err := lex(`SELECT mean("value")::field FROM cpu WHERE host =~ /a\/b/ AND time > now() - 1h30m -- comment
+		GROUP BY time(5m), /zone.*/ /* comment */; show tag keys`)
+	assert.NoError(t, err)
+	var types []tokenType
+	for _, tok := range tokens {
+		types = append(types, tok.typ)
+	}
+	assert.Equal(t, []tokenType{
+		tokenIdentifier, tokenIdentifier, tokenLeftParen, tokenQuotedIdentifier, tokenRightParen,
+		tokenDoubleColon, tokenIdentifier, tokenIdentifier, tokenIdentifier, tokenIdentifier,
+		tokenIdentifier, tokenRegexMatch, tokenRegex, tokenIdentifier, tokenIdentifier, tokenGreater,
+		tokenIdentifier, tokenLeftParen, tokenRightParen, tokenSub, tokenDuration,
+		tokenIdentifier, tokenIdentifier, tokenIdentifier, tokenLeftParen, tokenDuration, tokenRightParen,
+		tokenComma, tokenRegex, tokenSemicolon, tokenIdentifier, tokenIdentifier, tokenIdentifier, tokenEOF,
+	}, types)
+	assert.Equal(t, "value", tokens[3].val)
+	assert.Equal(t, "a/b", tokens[12].val)
+	assert.Equal(t, "1h30m", tokens[20].val)
+	assert.Equal(t, "zone.*", tokens[28].val)
+	assert.Equal(t, "EOF", tokens[33].String())
+	assert.Equal(t, `"SELECT"`, tokens[0].String())
+	assert.True(t, tokens[0].isKeyword("select"))
+	assert.False(t, tokens[3].isKeyword("value"))
+
+	tokens, err = lex(`a = 'b\'c' != c <> d < e <= f > g >= h !~ /x/ + 1.5e3 * .5 / 2 % 3`)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 26)
+	assert.Equal(t, "b'c", tokens[2].val)
+	assert.Equal(t, tokenNotEqual, tokens[5].typ)
+	assert.Equal(t, tokenLessEqual, tokens[9].typ)
+	assert.Equal(t, tokenGreaterEqual, tokens[13].typ)
+	assert.Equal(t, tokenRegex, tokens[16].typ)
+	assert.Equal(t, "1.5e3", tokens[18].val)
+	assert.Equal(t, tokenDiv, tokens[21].typ)
+}
+
+func TestLexer_lex_fail(t *testing.T) {
+	cases := []string{
+		"a ! b",
+		"a : b",
+		"a $ b",
+		"a = 'b",
+		`"a`,
+		"a =~ /b",
+		"a /* b",
+		"time(5ns5)",
+		"1.5.5e",
+	}
+	for _, c := range cases {
+		tokens, err := lex(c)
+		assert.Error(t, err, c)
+		assert.Nil(t, tokens, c)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := []struct {
+		input string
+		val   int64
+		ok    bool
+	}{
+		{input: "10ns", val: 10, ok: true},
+		{input: "5u", val: 5000, ok: true},
+		{input: "100ms", val: 100 * 1000 * 1000, ok: true},
+		{input: "1h30m", val: 90 * 60 * 1000 * 1000 * 1000, ok: true},
+		{input: "1d", val: 24 * 60 * 60 * 1000 * 1000 * 1000, ok: true},
+		{input: "1w", val: 7 * 24 * 60 * 60 * 1000 * 1000 * 1000, ok: true},
+		{input: ""},
+		{input: "m"},
+		{input: "5"},
+		{input: "5y"},
+		{input: "99999999999999999999s"},
+	}
+	for _, c := range cases {
+		val, err := ParseDuration(c.input)
+		if c.ok {
+			assert.NoError(t, err, c.input)
+			assert.Equal(t, c.val, val, c.input)
+		} else {
+			assert.Error(t, err, c.input)
+		}
+	}
+}
@@ -0,0 +1,694 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// binaryPrecedence defines the precedence of binary operators, higher value binds tighter.
+var binaryPrecedence = map[tokenType]int{
+	tokenOr:            1,
+	tokenAnd:           2,
+	tokenEqual:         3,
+	tokenNotEqual:      3,
+	tokenRegexMatch:    3,
+	tokenRegexNotMatch: 3,
+	tokenLess:          3,
+	tokenLessEqual:     3,
+	tokenGreater:       3,
+	tokenGreaterEqual:  3,
+	tokenAdd:           4,
+	tokenSub:           4,
+	tokenMul:           5,
+	tokenDiv:           5,
+	tokenMod:           5,
+}
+
+// parser represents InfluxQL parser using recursive descent.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses the InfluxQL string(statements separated by semicolon) to statements.
+func Parse(input string) ([]Statement, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	var statements []Statement
+	for {
+		for p.peek().typ == tokenSemicolon {
+			p.next()
+		}
+		if p.peek().typ == tokenEOF {
+			break
+		}
+		statement, err := p.parseStatement()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+		if tok := p.peek(); tok.typ != tokenSemicolon && tok.typ != tokenEOF {
+			return nil, p.unexpected(tok, "; or end of input")
+		}
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("empty query")
+	}
+	return statements, nil
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// expect consumes the current token if it matches the type, else returns error.
+func (p *parser) expect(typ tokenType, context string) (token, error) {
+	tok := p.next()
+	if tok.typ != typ {
+		return tok, p.unexpected(tok, context)
+	}
+	return tok, nil
+}
+
+// expectKeyword consumes the current token if it is the keyword, else returns error.
+func (p *parser) expectKeyword(keyword string) error {
+	tok := p.next()
+	if !tok.isKeyword(keyword) {
+		return p.unexpected(tok, strings.ToUpper(keyword))
+	}
+	return nil
+}
+
+// acceptKeyword consumes the current token if it is the keyword.
+func (p *parser) acceptKeyword(keyword string) bool {
+	if p.peek().isKeyword(keyword) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+// unexpected returns the error of unexpected token.
+func (p *parser) unexpected(tok token, context string) error {
+	return fmt.Errorf("unexpected %s at position %d, expected %s", tok, tok.pos, context)
+}
+
+// parseStatement parses a statement.
+func (p *parser) parseStatement() (Statement, error) {
+	tok := p.next()
+	switch {
+	case tok.isKeyword("select"):
+		return p.parseSelect()
+	case tok.isKeyword("show"):
+		return p.parseShow()
+	case tok.isKeyword("create"):
+		return p.parseCreate()
+	default:
+		return nil, p.unexpected(tok, "SELECT, SHOW or CREATE")
+	}
+}
+
+// parseSelect parses the select statement after SELECT keyword.
+func (p *parser) parseSelect() (Statement, error) {
+	s := &SelectStatement{}
+	for {
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		s.Fields = append(s.Fields, field)
+		if p.peek().typ != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if err := p.expectKeyword("from"); err != nil {
+		return nil, err
+	}
+	measurement, err := p.parseMeasurement()
+	if err != nil {
+		return nil, err
+	}
+	s.Measurement = measurement
+	if s.Condition, err = p.parseWhere(); err != nil {
+		return nil, err
+	}
+	if p.acceptKeyword("group") {
+		if err := p.expectKeyword("by"); err != nil {
+			return nil, err
+		}
+		if err := p.parseGroupBy(s); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("fill") {
+		if err := p.parseFill(s); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("order") {
+		if err := p.expectKeyword("by"); err != nil {
+			return nil, err
+		}
+		if err := p.expectKeyword("time"); err != nil {
+			return nil, err
+		}
+		if p.acceptKeyword("desc") {
+			s.Descending = true
+		} else {
+			p.acceptKeyword("asc")
+		}
+	}
+	for _, option := range []struct {
+		keyword string
+		value   *int
+	}{
+		{keyword: "limit", value: &s.Limit},
+		{keyword: "offset", value: &s.Offset},
+		{keyword: "slimit", value: &s.SLimit},
+		{keyword: "soffset", value: &s.SOffset},
+	} {
+		if p.acceptKeyword(option.keyword) {
+			if *option.value, err = p.parseInt(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if tok := p.peek(); tok.isKeyword("tz") {
+		return nil, fmt.Errorf("tz clause is not supported")
+	}
+	return s, nil
+}
+
+// parseField parses a select field with optional alias.
+func (p *parser) parseField() (*Field, error) {
+	expr, err := p.parseExpr(binaryPrecedence[tokenAdd])
+	if err != nil {
+		return nil, err
+	}
+	field := &Field{Expr: expr}
+	if p.acceptKeyword("as") {
+		if field.Alias, err = p.parseIdentifier(); err != nil {
+			return nil, err
+		}
+	}
+	return field, nil
+}
+
+// parseMeasurement parses the measurement name, database and retention policy prefix are ignored.
+func (p *parser) parseMeasurement() (string, error) {
+	if tok := p.peek(); tok.typ == tokenDiv || tok.typ == tokenRegex {
+		return "", fmt.Errorf("regex measurement is not supported")
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return "", err
+	}
+	for p.peek().typ == tokenDot {
+		p.next()
+		if p.peek().typ == tokenDot {
+			// db..measurement uses default retention policy
+			continue
+		}
+		if name, err = p.parseIdentifier(); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// parseIdentifier parses an unquoted or double quoted identifier.
+func (p *parser) parseIdentifier() (string, error) {
+	tok := p.next()
+	if tok.typ != tokenIdentifier && tok.typ != tokenQuotedIdentifier {
+		return "", p.unexpected(tok, "identifier")
+	}
+	return tok.val, nil
+}
+
+// parseInt parses a non-negative integer.
+func (p *parser) parseInt() (int, error) {
+	tok, err := p.expect(tokenNumber, "integer")
+	if err != nil {
+		return 0, err
+	}
+	val, err := strconv.Atoi(tok.val)
+	if err != nil || val < 0 {
+		return 0, fmt.Errorf("bad integer %s at position %d", tok, tok.pos)
+	}
+	return val, nil
+}
+
+// parseWhere parses the optional where clause.
+func (p *parser) parseWhere() (Expr, error) {
+	if !p.acceptKeyword("where") {
+		return nil, nil
+	}
+	return p.parseExpr(0)
+}
+
+// parseGroupBy parses the dimensions of group by clause.
+func (p *parser) parseGroupBy(s *SelectStatement) error {
+	for {
+		tok := p.peek()
+		switch {
+		case tok.typ == tokenMul:
+			p.next()
+			s.GroupByAll = true
+		case tok.typ == tokenRegex:
+			p.next()
+			s.GroupByRegex = tok.val
+		case tok.isKeyword("time") && p.tokens[p.pos+1].typ == tokenLeftParen:
+			p.next()
+			p.next()
+			durationTok, err := p.expect(tokenDuration, "duration")
+			if err != nil {
+				return err
+			}
+			interval, _ := ParseDuration(durationTok.val)
+			s.GroupByInterval = interval / nanosPerMillisecond
+			if s.GroupByInterval <= 0 {
+				return fmt.Errorf("group by time interval must be at least 1ms")
+			}
+			if p.peek().typ == tokenComma {
+				return fmt.Errorf("group by time offset is not supported")
+			}
+			if _, err := p.expect(tokenRightParen, ")"); err != nil {
+				return err
+			}
+		default:
+			tagKey, err := p.parseIdentifier()
+			if err != nil {
+				return err
+			}
+			s.GroupByTags = append(s.GroupByTags, tagKey)
+		}
+		if p.peek().typ != tokenComma {
+			return nil
+		}
+		p.next()
+	}
+}
+
+// parseFill parses the fill option after FILL keyword.
+func (p *parser) parseFill(s *SelectStatement) error {
+	if _, err := p.expect(tokenLeftParen, "("); err != nil {
+		return err
+	}
+	tok := p.next()
+	switch {
+	case tok.isKeyword("null"):
+		s.Fill = FillNull
+	case tok.isKeyword("none"):
+		s.Fill = FillNone
+	case tok.isKeyword("previous"):
+		s.Fill = FillPrevious
+	case tok.isKeyword("linear"):
+		s.Fill = FillLinear
+	case tok.typ == tokenNumber || tok.typ == tokenSub:
+		sign := 1.0
+		if tok.typ == tokenSub {
+			sign = -1
+			if tok = p.next(); tok.typ != tokenNumber {
+				return p.unexpected(tok, "number")
+			}
+		}
+		val, _ := strconv.ParseFloat(tok.val, 64)
+		s.Fill = FillValue
+		s.FillValue = sign * val
+	default:
+		return p.unexpected(tok, "fill option")
+	}
+	_, err := p.expect(tokenRightParen, ")")
+	return err
+}
+
+// parseShow parses the show statements after SHOW keyword.
+func (p *parser) parseShow() (Statement, error) {
+	tok := p.next()
+	switch {
+	case tok.isKeyword("databases"):
+		return &ShowDatabasesStatement{}, nil
+	case tok.isKeyword("measurements"):
+		return p.parseShowMeasurements()
+	case tok.isKeyword("tag"):
+		next := p.next()
+		switch {
+		case next.isKeyword("keys"):
+			return p.parseShowTagKeys()
+		case next.isKeyword("values"):
+			return p.parseShowTagValues()
+		default:
+			return nil, p.unexpected(next, "KEYS or VALUES")
+		}
+	case tok.isKeyword("field"):
+		if err := p.expectKeyword("keys"); err != nil {
+			return nil, err
+		}
+		return p.parseShowFieldKeys()
+	default:
+		return nil, p.unexpected(tok, "DATABASES, MEASUREMENTS, TAG KEYS, TAG VALUES or FIELD KEYS")
+	}
+}
+
+// parseOn skips the optional ON <database> clause, database is specified by request param.
+func (p *parser) parseOn() error {
+	if p.acceptKeyword("on") {
+		if _, err := p.parseIdentifier(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseFrom parses the optional FROM <measurement> clause.
+func (p *parser) parseFrom() (string, error) {
+	if !p.acceptKeyword("from") {
+		return "", nil
+	}
+	return p.parseMeasurement()
+}
+
+// parseLimitOffset parses the optional LIMIT and OFFSET clauses.
+func (p *parser) parseLimitOffset() (limit, offset int, err error) {
+	if p.acceptKeyword("limit") {
+		if limit, err = p.parseInt(); err != nil {
+			return 0, 0, err
+		}
+	}
+	if p.acceptKeyword("offset") {
+		if offset, err = p.parseInt(); err != nil {
+			return 0, 0, err
+		}
+	}
+	return limit, offset, nil
+}
+
+// parseShowMeasurements parses the show measurements statement.
+func (p *parser) parseShowMeasurements() (Statement, error) {
+	s := &ShowMeasurementsStatement{}
+	if err := p.parseOn(); err != nil {
+		return nil, err
+	}
+	if p.acceptKeyword("with") {
+		if err := p.expectKeyword("measurement"); err != nil {
+			return nil, err
+		}
+		op := p.next()
+		switch op.typ {
+		case tokenEqual, tokenNotEqual:
+			name, err := p.parseIdentifier()
+			if err != nil {
+				return nil, err
+			}
+			s.Match = name
+		case tokenRegexMatch, tokenRegexNotMatch:
+			regex, err := p.expect(tokenRegex, "regex")
+			if err != nil {
+				return nil, err
+			}
+			s.Match = regex.val
+		default:
+			return nil, p.unexpected(op, "=, !=, =~ or !~")
+		}
+		s.MatchOp = op.typ
+	}
+	if p.peek().isKeyword("where") {
+		return nil, fmt.Errorf("where clause of show measurements is not supported")
+	}
+	var err error
+	if s.Limit, s.Offset, err = p.parseLimitOffset(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parseShowTagKeys parses the show tag keys statement.
+func (p *parser) parseShowTagKeys() (Statement, error) {
+	s := &ShowTagKeysStatement{}
+	if err := p.parseOn(); err != nil {
+		return nil, err
+	}
+	var err error
+	if s.Measurement, err = p.parseFrom(); err != nil {
+		return nil, err
+	}
+	if p.peek().isKeyword("where") {
+		return nil, fmt.Errorf("where clause of show tag keys is not supported")
+	}
+	if s.Limit, s.Offset, err = p.parseLimitOffset(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parseShowTagValues parses the show tag values statement.
+func (p *parser) parseShowTagValues() (Statement, error) {
+	s := &ShowTagValuesStatement{}
+	if err := p.parseOn(); err != nil {
+		return nil, err
+	}
+	var err error
+	if s.Measurement, err = p.parseFrom(); err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("with"); err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("key"); err != nil {
+		return nil, err
+	}
+	op := p.next()
+	switch {
+	case op.typ == tokenEqual || op.typ == tokenNotEqual:
+		key, err := p.parseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		s.Keys = []string{key}
+	case op.typ == tokenRegexMatch || op.typ == tokenRegexNotMatch:
+		regex, err := p.expect(tokenRegex, "regex")
+		if err != nil {
+			return nil, err
+		}
+		s.Keys = []string{regex.val}
+	case op.isKeyword("in"):
+		if _, err := p.expect(tokenLeftParen, "("); err != nil {
+			return nil, err
+		}
+		for {
+			key, err := p.parseIdentifier()
+			if err != nil {
+				return nil, err
+			}
+			s.Keys = append(s.Keys, key)
+			if p.peek().typ != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		// IN is treated as equal with multi-keys
+		op.typ = tokenEqual
+	default:
+		return nil, p.unexpected(op, "=, !=, =~, !~ or IN")
+	}
+	s.KeyOp = op.typ
+	if s.Condition, err = p.parseWhere(); err != nil {
+		return nil, err
+	}
+	if s.Limit, s.Offset, err = p.parseLimitOffset(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parseShowFieldKeys parses the show field keys statement.
+func (p *parser) parseShowFieldKeys() (Statement, error) {
+	s := &ShowFieldKeysStatement{}
+	if err := p.parseOn(); err != nil {
+		return nil, err
+	}
+	var err error
+	if s.Measurement, err = p.parseFrom(); err != nil {
+		return nil, err
+	}
+	if s.Limit, s.Offset, err = p.parseLimitOffset(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parseCreate parses the create database statement, options of database are ignored.
+func (p *parser) parseCreate() (Statement, error) {
+	if err := p.expectKeyword("database"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok.typ != tokenSemicolon && tok.typ != tokenEOF; tok = p.peek() {
+		p.next()
+	}
+	return &CreateDatabaseStatement{Name: name}, nil
+}
+
+// binaryOp returns the binary operator and its precedence if current token is binary operator.
+func (p *parser) binaryOp() (op tokenType, precedence int, ok bool) {
+	tok := p.peek()
+	switch {
+	case tok.isKeyword("and"):
+		op = tokenAnd
+	case tok.isKeyword("or"):
+		op = tokenOr
+	default:
+		op = tok.typ
+	}
+	precedence, ok = binaryPrecedence[op]
+	return op, precedence, ok
+}
+
+// parseExpr parses binary expression using precedence climbing.
+func (p *parser) parseExpr(minPrecedence int) (Expr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, precedence, ok := p.binaryOp()
+		if !ok || precedence < minPrecedence {
+			return lhs, nil
+		}
+		p.next()
+		rhs, err := p.parseExpr(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: op, LHS: lhs, RHS: rhs}
+	}
+}
+
+// parseUnary parses unary expression, like -1.
+func (p *parser) parseUnary() (Expr, error) {
+	tok := p.peek()
+	if tok.typ != tokenAdd && tok.typ != tokenSub {
+		return p.parsePrimary()
+	}
+	p.next()
+	expr, err := p.parseExpr(binaryPrecedence[tokenMul] + 1)
+	if err != nil {
+		return nil, err
+	}
+	if tok.typ == tokenAdd {
+		return expr, nil
+	}
+	switch e := expr.(type) {
+	case *NumberLiteral:
+		return &NumberLiteral{Val: -e.Val}, nil
+	case *DurationLiteral:
+		return &DurationLiteral{Val: -e.Val}, nil
+	default:
+		return &BinaryExpr{Op: tokenMul, LHS: &NumberLiteral{Val: -1}, RHS: expr}, nil
+	}
+}
+
+// parsePrimary parses primary expression, like literal, var ref, function call and parenthesized expression.
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.next()
+	switch tok.typ {
+	case tokenNumber:
+		val, _ := strconv.ParseFloat(tok.val, 64)
+		return &NumberLiteral{Val: val}, nil
+	case tokenDuration:
+		val, _ := ParseDuration(tok.val)
+		return &DurationLiteral{Val: val}, nil
+	case tokenString:
+		return &StringLiteral{Val: tok.val}, nil
+	case tokenRegex:
+		return &RegexLiteral{Val: tok.val}, nil
+	case tokenMul:
+		return &Wildcard{}, nil
+	case tokenLeftParen:
+		expr, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return &ParenExpr{Expr: expr}, nil
+	case tokenIdentifier, tokenQuotedIdentifier:
+		if tok.typ == tokenIdentifier && p.peek().typ == tokenLeftParen {
+			return p.parseCall(tok)
+		}
+		if p.peek().typ == tokenDoubleColon {
+			// ignore type cast, like "value"::field
+			p.next()
+			if _, err := p.expect(tokenIdentifier, "type"); err != nil {
+				return nil, err
+			}
+		}
+		return &VarRef{Name: tok.val}, nil
+	default:
+		return nil, p.unexpected(tok, "expression")
+	}
+}
+
+// parseCall parses the function call after function name.
+func (p *parser) parseCall(name token) (Expr, error) {
+	p.next()
+	call := &Call{Name: strings.ToLower(name.val)}
+	if p.peek().typ == tokenRightParen {
+		p.next()
+		return call, nil
+	}
+	for {
+		arg, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+		if p.peek().typ != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
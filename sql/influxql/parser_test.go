@@ -0,0 +1,235 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input     string
+		statement Statement
+	}{
+		{
+			input: `SELECT mean("value") AS "avg", max(value::field) * 2 FROM "db"."rp"."cpu" ` +
+				`WHERE ("host" = 'a' OR host =~ /b.*/) AND time >= now() - 1h ` +
+				`GROUP BY time(5m), "zone", /ip/, * fill(previous) ORDER BY time DESC ` +
+				`LIMIT 10 OFFSET 1 SLIMIT 5 SOFFSET 2`,
+			statement: &SelectStatement{
+				Fields: []*Field{
+					{Expr: &Call{Name: "mean", Args: []Expr{&VarRef{Name: "value"}}}, Alias: "avg"},
+					{Expr: &BinaryExpr{
+						Op:  tokenMul,
+						LHS: &Call{Name: "max", Args: []Expr{&VarRef{Name: "value"}}},
+						RHS: &NumberLiteral{Val: 2},
+					}},
+				},
+				Measurement: "cpu",
+				Condition: &BinaryExpr{
+					Op: tokenAnd,
+					LHS: &ParenExpr{Expr: &BinaryExpr{
+						Op:  tokenOr,
+						LHS: &BinaryExpr{Op: tokenEqual, LHS: &VarRef{Name: "host"}, RHS: &StringLiteral{Val: "a"}},
+						RHS: &BinaryExpr{Op: tokenRegexMatch, LHS: &VarRef{Name: "host"}, RHS: &RegexLiteral{Val: "b.*"}},
+					}},
+					RHS: &BinaryExpr{
+						Op:  tokenGreaterEqual,
+						LHS: &VarRef{Name: "time"},
+						RHS: &BinaryExpr{
+							Op:  tokenSub,
+							LHS: &Call{Name: "now"},
+							RHS: &DurationLiteral{Val: 3600 * 1000 * 1000 * 1000},
+						},
+					},
+				},
+				GroupByInterval: 5 * 60 * 1000,
+				GroupByTags:     []string{"zone"},
+				GroupByRegex:    "ip",
+				GroupByAll:      true,
+				Fill:            FillPrevious,
+				Descending:      true,
+				Limit:           10,
+				Offset:          1,
+				SLimit:          5,
+				SOffset:         2,
+			},
+		},
+		{
+			input: "select * from db..cpu fill(-1.5) order by time asc",
+			statement: &SelectStatement{
+				Fields:      []*Field{{Expr: &Wildcard{}}},
+				Measurement: "cpu",
+				Fill:        FillValue,
+				FillValue:   -1.5,
+			},
+		},
+		{
+			input: "select -value, +value, -(value) from cpu fill(none)",
+			statement: &SelectStatement{
+				Fields: []*Field{
+					{Expr: &BinaryExpr{Op: tokenMul, LHS: &NumberLiteral{Val: -1}, RHS: &VarRef{Name: "value"}}},
+					{Expr: &VarRef{Name: "value"}},
+					{Expr: &BinaryExpr{Op: tokenMul, LHS: &NumberLiteral{Val: -1}, RHS: &ParenExpr{Expr: &VarRef{Name: "value"}}}},
+				},
+				Measurement: "cpu",
+				Fill:        FillNone,
+			},
+		},
+		{
+			input: "select value from cpu where time > -5m fill(null) fill",
+		},
+		{
+			input:     "SHOW DATABASES",
+			statement: &ShowDatabasesStatement{},
+		},
+		{
+			input:     "SHOW MEASUREMENTS ON db",
+			statement: &ShowMeasurementsStatement{},
+		},
+		{
+			input:     "SHOW MEASUREMENTS WITH MEASUREMENT =~ /cpu.*/ LIMIT 10 OFFSET 5",
+			statement: &ShowMeasurementsStatement{MatchOp: tokenRegexMatch, Match: "cpu.*", Limit: 10, Offset: 5},
+		},
+		{
+			input:     `SHOW MEASUREMENTS WITH MEASUREMENT != "cpu"`,
+			statement: &ShowMeasurementsStatement{MatchOp: tokenNotEqual, Match: "cpu"},
+		},
+		{
+			input:     `SHOW TAG KEYS ON "db" FROM "cpu" LIMIT 1`,
+			statement: &ShowTagKeysStatement{Measurement: "cpu", Limit: 1},
+		},
+		{
+			input: `SHOW TAG VALUES FROM cpu WITH KEY IN ("host", zone) WHERE ip = '1' OFFSET 2`,
+			statement: &ShowTagValuesStatement{
+				Measurement: "cpu",
+				KeyOp:       tokenEqual,
+				Keys:        []string{"host", "zone"},
+				Condition:   &BinaryExpr{Op: tokenEqual, LHS: &VarRef{Name: "ip"}, RHS: &StringLiteral{Val: "1"}},
+				Offset:      2,
+			},
+		},
+		{
+			input:     `SHOW TAG VALUES WITH KEY =~ /h.*/`,
+			statement: &ShowTagValuesStatement{KeyOp: tokenRegexMatch, Keys: []string{"h.*"}},
+		},
+		{
+			input:     `SHOW TAG VALUES WITH KEY != host`,
+			statement: &ShowTagValuesStatement{KeyOp: tokenNotEqual, Keys: []string{"host"}},
+		},
+		{
+			input:     `SHOW FIELD KEYS FROM cpu`,
+			statement: &ShowFieldKeysStatement{Measurement: "cpu"},
+		},
+		{
+			input:     `CREATE DATABASE "telegraf" WITH DURATION 1d`,
+			statement: &CreateDatabaseStatement{Name: "telegraf"},
+		},
+	}
+	for _, c := range cases {
+		statements, err := Parse(c.input)
+		if c.statement == nil {
+			assert.Error(t, err, c.input)
+			continue
+		}
+		if assert.NoError(t, err, c.input) {
+			assert.Equal(t, []Statement{c.statement}, statements, c.input)
+		}
+	}
+}
+
+func TestParse_multi_statements(t *testing.T) {
+	statements, err := Parse("show databases;; show measurements;")
+	assert.NoError(t, err)
+	assert.Equal(t, []Statement{&ShowDatabasesStatement{}, &ShowMeasurementsStatement{}}, statements)
+}
+
+func TestParse_fail(t *testing.T) {
+	cases := []string{
+		"",
+		";",
+		"a ! b",
+		"drop database db",
+		"select",
+		"select value",
+		"select value cpu",
+		"select value from /cpu/",
+		"select value from cpu.",
+		"select value as 1 from cpu",
+		"select value from cpu where",
+		"select value from cpu group",
+		"select value from cpu group host",
+		"select value from cpu group by time(1)",
+		"select value from cpu group by time(1ns)",
+		"select value from cpu group by time(1m, 5s)",
+		"select value from cpu group by time(1m",
+		"select value from cpu group by 1",
+		"select value from cpu fill 1",
+		"select value from cpu fill(abc)",
+		"select value from cpu fill(-abc)",
+		"select value from cpu fill(1",
+		"select value from cpu order time",
+		"select value from cpu order by host",
+		"select value from cpu limit a",
+		"select value from cpu limit 1.5",
+		"select value from cpu tz('Asia/Shanghai')",
+		"select value from cpu host",
+		"select value::1 from cpu",
+		"select (value from cpu",
+		"select max(value from cpu",
+		"select max(value, from cpu",
+		"select - from cpu",
+		"select value + from cpu",
+		"show",
+		"show tag",
+		"show tag a",
+		"show field",
+		"show measurements on 1",
+		"show measurements with",
+		"show measurements with measurement < cpu",
+		"show measurements with measurement = 1",
+		"show measurements with measurement =~ cpu",
+		"show measurements where a = 'b'",
+		"show measurements limit a",
+		"show measurements offset a",
+		"show tag keys from 1",
+		"show tag keys limit a",
+		"show tag keys where a = 'b'",
+		"show tag values",
+		"show tag values with",
+		"show tag values with key",
+		"show tag values with key = 1",
+		"show tag values with key =~ host",
+		"show tag values with key in host",
+		"show tag values with key in (host",
+		"show tag values with key in (1)",
+		"show tag values with key = host where",
+		"show tag values with key = host limit a",
+		"show field keys from 1",
+		"show field keys limit a",
+		"create",
+		"create database",
+	}
+	for _, c := range cases {
+		statements, err := Parse(c)
+		assert.Error(t, err, c)
+		assert.Nil(t, statements, c)
+	}
+}
@@ -0,0 +1,553 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+const (
+	nanosPerMillisecond = int64(time.Millisecond)
+	// defaultTimeRange is the look back time range of query if no time condition.
+	defaultTimeRange = timeutil.OneHour
+	// timeKey is the reserved key for time condition.
+	timeKey = "time"
+)
+
+// aggregateFuncs defines the InfluxQL aggregate functions which can be executed by LinDB.
+var aggregateFuncs = map[string]function.FuncType{
+	"mean":   function.Avg,
+	"sum":    function.Sum,
+	"min":    function.Min,
+	"max":    function.Max,
+	"count":  function.Count,
+	"first":  function.FirstValue,
+	"last":   function.LastValue,
+	"stddev": function.Stddev,
+}
+
+// timeLayouts defines the layouts of time string in time condition.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+// QueryPlan represents the lin query plan of select statement.
+type QueryPlan struct {
+	Statement *SelectStatement
+	Query     *stmt.Query
+	// Columns represents the column names of select fields, also used as alias of select items.
+	Columns []string
+	// ExpandGroupBy represents group by all tag keys(or tag keys matched by regex).
+	ExpandGroupBy bool
+	// HistogramFields represents the fields of percentile functions,
+	// which are computed from the histogram buckets of measurement.
+	HistogramFields []string
+}
+
+// NewQueryPlan lowers the select statement to lin query at the evaluation timestamp(now) in milliseconds.
+func NewQueryPlan(s *SelectStatement, namespace string, now int64) (*QueryPlan, error) {
+	l := newLowering(now)
+	query := &stmt.Query{
+		Namespace:  namespace,
+		MetricName: s.Measurement,
+		GroupBy:    s.GroupByTags,
+	}
+	plan := &QueryPlan{Statement: s, Query: query, ExpandGroupBy: s.GroupByAll || s.GroupByRegex != ""}
+	if s.GroupByRegex != "" {
+		if _, err := regexp.Compile(s.GroupByRegex); err != nil {
+			return nil, fmt.Errorf("bad group by regex: %w", err)
+		}
+	}
+	columns := make(map[string]int)
+	for _, field := range s.Fields {
+		expr, err := l.field(field.Expr)
+		if err != nil {
+			return nil, err
+		}
+		column := field.Alias
+		if column == "" {
+			column = columnName(field.Expr)
+		}
+		if count, ok := columns[column]; ok {
+			// duplicate column name, append suffix like influxdb
+			columns[column] = count + 1
+			column = column + "_" + strconv.Itoa(count+1)
+		}
+		columns[column] = 0
+		plan.Columns = append(plan.Columns, column)
+		query.SelectItems = append(query.SelectItems, &stmt.SelectItem{Expr: expr, Alias: column})
+	}
+	plan.HistogramFields = l.histogramFields
+	condition, err := l.where(s.Condition)
+	if err != nil {
+		return nil, err
+	}
+	query.Condition = condition
+	if l.end == math.MaxInt64 {
+		l.end = now
+	}
+	if l.start == math.MinInt64 {
+		l.start = l.end - defaultTimeRange
+	}
+	if l.start > l.end {
+		return nil, fmt.Errorf("invalid time range, start time is after end time")
+	}
+	query.TimeRange = timeutil.TimeRange{Start: l.start, End: l.end}
+	if s.GroupByInterval > 0 {
+		query.Interval = timeutil.Interval(s.GroupByInterval)
+	} else {
+		// aggregates all points in time range
+		query.Interval = timeutil.Interval(l.end - l.start + 1)
+	}
+	return plan, nil
+}
+
+// TagCondition converts the where clause to tag filter condition, time conditions are ignored.
+func TagCondition(expr Expr) (stmt.Expr, error) {
+	return newLowering(timeutil.Now()).where(expr)
+}
+
+// Matcher returns the matcher of measurement name based on WITH MEASUREMENT clause.
+func (s *ShowMeasurementsStatement) Matcher() (func(name string) bool, error) {
+	if s.MatchOp == tokenEOF {
+		return func(string) bool { return true }, nil
+	}
+	return newMatcher(s.MatchOp, []string{s.Match})
+}
+
+// ExactKeys returns the tag keys if WITH KEY clause matches tag keys exactly(= or IN).
+func (s *ShowTagValuesStatement) ExactKeys() ([]string, bool) {
+	if s.KeyOp == tokenEqual {
+		return s.Keys, true
+	}
+	return nil, false
+}
+
+// KeyMatcher returns the matcher of tag key based on WITH KEY clause.
+func (s *ShowTagValuesStatement) KeyMatcher() (func(key string) bool, error) {
+	return newMatcher(s.KeyOp, s.Keys)
+}
+
+// newMatcher returns the matcher of name with operator, matches if any of patterns matched(for = and =~).
+func newMatcher(op tokenType, patterns []string) (func(name string) bool, error) {
+	switch op {
+	case tokenEqual, tokenNotEqual:
+		names := make(map[string]struct{}, len(patterns))
+		for _, pattern := range patterns {
+			names[pattern] = struct{}{}
+		}
+		return func(name string) bool {
+			_, ok := names[name]
+			return ok == (op == tokenEqual)
+		}, nil
+	case tokenRegexMatch, tokenRegexNotMatch:
+		regexps := make([]*regexp.Regexp, 0, len(patterns))
+		for _, pattern := range patterns {
+			r, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("bad regex: %w", err)
+			}
+			regexps = append(regexps, r)
+		}
+		return func(name string) bool {
+			matched := false
+			for _, r := range regexps {
+				if r.MatchString(name) {
+					matched = true
+					break
+				}
+			}
+			return matched == (op == tokenRegexMatch)
+		}, nil
+	default:
+		return nil, fmt.Errorf("operator %s not supported", tokenName(op))
+	}
+}
+
+// lowering converts InfluxQL expressions into lin query expressions.
+type lowering struct {
+	now        int64
+	start, end int64 // time range narrowed by time conditions
+
+	histogramFields []string // fields of percentile functions
+}
+
+// newLowering creates a lowering with unbounded time range.
+func newLowering(now int64) *lowering {
+	return &lowering{now: now, start: math.MinInt64, end: math.MaxInt64}
+}
+
+// field converts the select field expression.
+func (l *lowering) field(expr Expr) (stmt.Expr, error) {
+	switch e := expr.(type) {
+	case *VarRef:
+		if strings.EqualFold(e.Name, timeKey) {
+			return nil, fmt.Errorf("time cannot be selected")
+		}
+		return &stmt.FieldExpr{Name: e.Name}, nil
+	case *Call:
+		return l.call(e)
+	case *NumberLiteral:
+		return &stmt.NumberLiteral{Val: e.Val}, nil
+	case *ParenExpr:
+		inner, err := l.field(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.ParenExpr{Expr: inner}, nil
+	case *BinaryExpr:
+		var op stmt.BinaryOP
+		switch e.Op {
+		case tokenAdd:
+			op = stmt.ADD
+		case tokenSub:
+			op = stmt.SUB
+		case tokenMul:
+			op = stmt.MUL
+		case tokenDiv:
+			op = stmt.DIV
+		default:
+			return nil, fmt.Errorf("binary operator %s is not supported in select field", tokenName(e.Op))
+		}
+		lhs, err := l.field(e.LHS)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := l.field(e.RHS)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.BinaryExpr{Left: lhs, Operator: op, Right: rhs}, nil
+	case *Wildcard:
+		return nil, fmt.Errorf("wildcard field is not supported")
+	default:
+		return nil, fmt.Errorf("unsupported expression in select field")
+	}
+}
+
+// call converts the aggregate function call.
+func (l *lowering) call(call *Call) (stmt.Expr, error) {
+	fieldArg := func() (*VarRef, error) {
+		if len(call.Args) == 0 {
+			return nil, fmt.Errorf("function %s requires a field argument", call.Name)
+		}
+		ref, ok := call.Args[0].(*VarRef)
+		if !ok {
+			return nil, fmt.Errorf("the first argument of function %s must be a field", call.Name)
+		}
+		return ref, nil
+	}
+	switch call.Name {
+	case "median":
+		ref, err := fieldArg()
+		if err != nil {
+			return nil, err
+		}
+		if len(call.Args) != 1 {
+			return nil, fmt.Errorf("function median requires 1 argument")
+		}
+		// median is computed from all values of field
+		return &stmt.CallExpr{FuncType: function.Median, Params: []stmt.Expr{&stmt.FieldExpr{Name: ref.Name}}}, nil
+	case "percentile":
+		ref, err := fieldArg()
+		if err != nil {
+			return nil, err
+		}
+		if len(call.Args) != 2 {
+			return nil, fmt.Errorf("function percentile requires 2 arguments")
+		}
+		n, ok := call.Args[1].(*NumberLiteral)
+		if !ok || n.Val <= 0 || n.Val > 100 {
+			return nil, fmt.Errorf("the percentile of function percentile must be a number in (0, 100]")
+		}
+		// quantile is computed from histogram of metric, checks histogram buckets of field before executing
+		l.histogramFields = append(l.histogramFields, ref.Name)
+		return &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: n.Val / 100}}}, nil
+	}
+	funcType, ok := aggregateFuncs[call.Name]
+	if !ok {
+		return nil, fmt.Errorf("function %s is not supported", call.Name)
+	}
+	ref, err := fieldArg()
+	if err != nil {
+		return nil, err
+	}
+	if len(call.Args) != 1 {
+		return nil, fmt.Errorf("function %s requires 1 argument", call.Name)
+	}
+	return &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{&stmt.FieldExpr{Name: ref.Name}}}, nil
+}
+
+// where extracts the time range from time conditions which are combined by AND,
+// returns the tag filter condition of the remaining conditions.
+func (l *lowering) where(expr Expr) (stmt.Expr, error) {
+	switch e := expr.(type) {
+	case nil:
+		return nil, nil
+	case *ParenExpr:
+		inner, err := l.where(e.Expr)
+		if err != nil || inner == nil {
+			return nil, err
+		}
+		return &stmt.ParenExpr{Expr: inner}, nil
+	case *BinaryExpr:
+		if e.Op == tokenAnd {
+			lhs, err := l.where(e.LHS)
+			if err != nil {
+				return nil, err
+			}
+			rhs, err := l.where(e.RHS)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case lhs == nil:
+				return rhs, nil
+			case rhs == nil:
+				return lhs, nil
+			default:
+				return &stmt.BinaryExpr{Left: lhs, Operator: stmt.AND, Right: rhs}, nil
+			}
+		}
+		if isTimeRef(e.LHS) || isTimeRef(e.RHS) {
+			return nil, l.timeCondition(e)
+		}
+	}
+	return l.tagCondition(expr)
+}
+
+// tagCondition converts the tag filter condition.
+func (l *lowering) tagCondition(expr Expr) (stmt.Expr, error) {
+	switch e := expr.(type) {
+	case *ParenExpr:
+		inner, err := l.tagCondition(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.ParenExpr{Expr: inner}, nil
+	case *BinaryExpr:
+		switch e.Op {
+		case tokenAnd, tokenOr:
+			lhs, err := l.tagCondition(e.LHS)
+			if err != nil {
+				return nil, err
+			}
+			rhs, err := l.tagCondition(e.RHS)
+			if err != nil {
+				return nil, err
+			}
+			op := stmt.AND
+			if e.Op == tokenOr {
+				op = stmt.OR
+			}
+			return &stmt.BinaryExpr{Left: lhs, Operator: op, Right: rhs}, nil
+		}
+		ref, ok := e.LHS.(*VarRef)
+		if !ok {
+			return nil, fmt.Errorf("the left side of condition must be a tag key")
+		}
+		if strings.EqualFold(ref.Name, timeKey) {
+			return nil, fmt.Errorf("time condition cannot be combined by OR")
+		}
+		switch rhs := e.RHS.(type) {
+		case *StringLiteral:
+			switch e.Op {
+			case tokenEqual:
+				return &stmt.EqualsExpr{Key: ref.Name, Value: rhs.Val}, nil
+			case tokenNotEqual:
+				return &stmt.NotExpr{Expr: &stmt.EqualsExpr{Key: ref.Name, Value: rhs.Val}}, nil
+			}
+		case *RegexLiteral:
+			if _, err := regexp.Compile(rhs.Val); err != nil {
+				return nil, fmt.Errorf("bad regex of condition: %w", err)
+			}
+			switch e.Op {
+			case tokenRegexMatch:
+				return &stmt.RegexExpr{Key: ref.Name, Regexp: rhs.Val}, nil
+			case tokenRegexNotMatch:
+				return &stmt.NotExpr{Expr: &stmt.RegexExpr{Key: ref.Name, Regexp: rhs.Val}}, nil
+			}
+		default:
+			return nil, fmt.Errorf("condition of %s is not supported, only tag condition is supported", ref.Name)
+		}
+		return nil, fmt.Errorf("operator %s is not supported in condition of %s", tokenName(e.Op), ref.Name)
+	default:
+		return nil, fmt.Errorf("unsupported expression in condition")
+	}
+}
+
+// timeCondition narrows the time range by the time condition.
+func (l *lowering) timeCondition(e *BinaryExpr) error {
+	op, valueExpr := e.Op, e.RHS
+	if isTimeRef(e.RHS) {
+		// flip the operator, like now() - 1h < time
+		valueExpr = e.LHS
+		switch op {
+		case tokenLess:
+			op = tokenGreater
+		case tokenLessEqual:
+			op = tokenGreaterEqual
+		case tokenGreater:
+			op = tokenLess
+		case tokenGreaterEqual:
+			op = tokenLessEqual
+		}
+	}
+	ts, err := l.timestamp(valueExpr)
+	if err != nil {
+		return err
+	}
+	switch op {
+	case tokenGreater:
+		l.start = maxInt64(l.start, ts+1)
+	case tokenGreaterEqual:
+		l.start = maxInt64(l.start, ts)
+	case tokenLess:
+		l.end = minInt64(l.end, ts-1)
+	case tokenLessEqual:
+		l.end = minInt64(l.end, ts)
+	case tokenEqual:
+		l.start = maxInt64(l.start, ts)
+		l.end = minInt64(l.end, ts)
+	default:
+		return fmt.Errorf("operator %s is not supported in time condition", tokenName(op))
+	}
+	return nil
+}
+
+// timestamp evaluates the time expression into timestamp in milliseconds,
+// number and duration literal are treated as epoch time in nanoseconds.
+func (l *lowering) timestamp(expr Expr) (int64, error) {
+	switch e := expr.(type) {
+	case *Call:
+		if e.Name == "now" && len(e.Args) == 0 {
+			return l.now, nil
+		}
+	case *DurationLiteral:
+		return e.Val / nanosPerMillisecond, nil
+	case *NumberLiteral:
+		return int64(e.Val) / nanosPerMillisecond, nil
+	case *StringLiteral:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, e.Val); err == nil {
+				return t.UnixNano() / nanosPerMillisecond, nil
+			}
+		}
+		return 0, fmt.Errorf("bad time string '%s'", e.Val)
+	case *ParenExpr:
+		return l.timestamp(e.Expr)
+	case *BinaryExpr:
+		if e.Op == tokenAdd || e.Op == tokenSub {
+			ts, err := l.timestamp(e.LHS)
+			if err != nil {
+				return 0, err
+			}
+			duration, ok := e.RHS.(*DurationLiteral)
+			if !ok {
+				return 0, fmt.Errorf("the right side of time calculation must be a duration")
+			}
+			if e.Op == tokenAdd {
+				return ts + duration.Val/nanosPerMillisecond, nil
+			}
+			return ts - duration.Val/nanosPerMillisecond, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported expression in time condition")
+}
+
+// columnName returns the column name of select field without alias like influxdb,
+// function name is used for function call, field name is used for field reference.
+func columnName(expr Expr) string {
+	switch e := expr.(type) {
+	case *Call:
+		return e.Name
+	case *VarRef:
+		return e.Name
+	case *ParenExpr:
+		return columnName(e.Expr)
+	case *BinaryExpr:
+		lhs, rhs := columnName(e.LHS), columnName(e.RHS)
+		switch {
+		case lhs == "":
+			return rhs
+		case rhs == "":
+			return lhs
+		default:
+			return lhs + "_" + rhs
+		}
+	default:
+		return ""
+	}
+}
+
+// isTimeRef checks if the expression is reference of time.
+func isTimeRef(expr Expr) bool {
+	ref, ok := expr.(*VarRef)
+	return ok && strings.EqualFold(ref.Name, timeKey)
+}
+
+// tokenName returns the string value of operator token.
+func tokenName(typ tokenType) string {
+	switch typ {
+	case tokenAnd:
+		return "AND"
+	case tokenOr:
+		return "OR"
+	case tokenEqual:
+		return "="
+	case tokenNotEqual:
+		return "!="
+	case tokenRegexMatch:
+		return "=~"
+	case tokenRegexNotMatch:
+		return "!~"
+	case tokenLess:
+		return "<"
+	case tokenLessEqual:
+		return "<="
+	case tokenGreater:
+		return ">"
+	case tokenGreaterEqual:
+		return ">="
+	case tokenMod:
+		return "%"
+	default:
+		return "unknown"
+	}
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
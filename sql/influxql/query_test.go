@@ -0,0 +1,210 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package influxql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func newSelectStatement(t *testing.T, sql string) *SelectStatement {
+	statements, err := Parse(sql)
+	assert.NoError(t, err)
+	return statements[0].(*SelectStatement)
+}
+
+func TestNewQueryPlan(t *testing.T) {
+	now := timeutil.Now()
+	s := newSelectStatement(t, `SELECT mean(value) AS avg, max(value) + 1, max(value), max(value), `+
+		`percentile(value, 99), median(value), (sum(value) - 1) * 2, count(value) / 2 `+
+		`FROM cpu WHERE time > now() - 1h AND host = 'a' AND (ip != 'b' OR zone =~ /z.*/) AND region !~ /r/ `+
+		`GROUP BY time(1m), host`)
+	plan, err := NewQueryPlan(s, "ns", now)
+	assert.NoError(t, err)
+	assert.False(t, plan.ExpandGroupBy)
+	assert.Equal(t, s, plan.Statement)
+	assert.Equal(t, []string{"avg", "max", "max_1", "max_2", "percentile", "median", "sum", "count"}, plan.Columns)
+	assert.Equal(t, "ns", plan.Query.Namespace)
+	assert.Equal(t, "cpu", plan.Query.MetricName)
+	assert.Equal(t, []string{"host"}, plan.Query.GroupBy)
+	assert.Equal(t, timeutil.TimeRange{Start: now - timeutil.OneHour + 1, End: now}, plan.Query.TimeRange)
+	assert.Equal(t, timeutil.Interval(timeutil.OneMinute), plan.Query.Interval)
+	assert.Equal(t, "host=aand(not ip=borzone=~z.*)andnot region=~r", plan.Query.Condition.Rewrite())
+	var items []string
+	for _, item := range plan.Query.SelectItems {
+		items = append(items, item.Rewrite())
+	}
+	assert.Equal(t, []string{
+		"avg(value) as avg",
+		"max(value)+1.00 as max",
+		"max(value) as max_1",
+		"max(value) as max_2",
+		"quantile(0.99) as percentile",
+		"median(value) as median",
+		"(sum(value)-1.00)*2.00 as sum",
+		"count(value)/2.00 as count",
+	}, items)
+	assert.Equal(t, []string{"value"}, plan.HistogramFields)
+
+	s = newSelectStatement(t, `SELECT last(value), stddev(value) FROM cpu `+
+		`WHERE '2022-01-01T00:00:00Z' <= time AND time < '2022-01-01 01:00:00' GROUP BY *`)
+	plan, err = NewQueryPlan(s, "", now)
+	assert.NoError(t, err)
+	assert.True(t, plan.ExpandGroupBy)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano() / int64(time.Millisecond)
+	assert.Equal(t, timeutil.TimeRange{Start: start, End: start + timeutil.OneHour - 1}, plan.Query.TimeRange)
+	assert.Equal(t, timeutil.Interval(timeutil.OneHour), plan.Query.Interval)
+	assert.Nil(t, plan.Query.Condition)
+	assert.Equal(t, []string{"last", "stddev"}, plan.Columns)
+	assert.Empty(t, plan.HistogramFields)
+
+	s = newSelectStatement(t, `SELECT sum(value) FROM cpu WHERE time >= 1640995200000000000 `+
+		`AND time <= 1640995200000ms + 1h AND (time = '2022-01-01') GROUP BY /host/`)
+	plan, err = NewQueryPlan(s, "", now)
+	assert.NoError(t, err)
+	assert.True(t, plan.ExpandGroupBy)
+	assert.Equal(t, timeutil.TimeRange{Start: start, End: start}, plan.Query.TimeRange)
+
+	s = newSelectStatement(t, `SELECT sum(value) FROM cpu WHERE now() - 1h > time`)
+	plan, err = NewQueryPlan(s, "", now)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutil.TimeRange{
+		Start: now - 2*timeutil.OneHour - 1,
+		End:   now - timeutil.OneHour - 1,
+	}, plan.Query.TimeRange)
+
+	s = newSelectStatement(t, `SELECT sum(value) FROM cpu WHERE time >= now() AND now() - 1m < time`)
+	plan, err = NewQueryPlan(s, "", now)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutil.TimeRange{Start: now, End: now}, plan.Query.TimeRange)
+}
+
+func TestNewQueryPlan_fail(t *testing.T) {
+	cases := []string{
+		"select * from cpu",
+		"select time from cpu",
+		"select 'a' from cpu",
+		"select value % 2 from cpu",
+		"select max(value) % 2 from cpu",
+		"select 2 % max(value) from cpu",
+		"select unknown(value) from cpu",
+		"select max() from cpu",
+		"select max(1) from cpu",
+		"select max(value, 1) from cpu",
+		"select percentile(value) from cpu",
+		"select percentile(value, 101) from cpu",
+		"select percentile(value, host) from cpu",
+		"select median(value, 1) from cpu",
+		"select median() from cpu",
+		"select sum(value) from cpu group by /[/",
+		"select sum(value) from cpu where host = 1",
+		"select sum(value) from cpu where host < 'a'",
+		"select sum(value) from cpu where host =~ /[/",
+		"select sum(value) from cpu where host !~ 'a'",
+		"select sum(value) from cpu where 'a' = host",
+		"select sum(value) from cpu where host",
+		"select sum(value) from cpu where (host)",
+		"select sum(value) from cpu where host = 'a' or time > now()",
+		"select sum(value) from cpu where time > now() and (host = 'a' or value > 1)",
+		"select sum(value) from cpu where time != now()",
+		"select sum(value) from cpu where time > now(1)",
+		"select sum(value) from cpu where time > 'abc'",
+		"select sum(value) from cpu where time > now() - 1",
+		"select sum(value) from cpu where time > now() - abc",
+		"select sum(value) from cpu where time > now() * 1h",
+		"select sum(value) from cpu where time > host",
+		"select sum(value) from cpu where time > (now() - 1h) and time < now() - 2h",
+	}
+	for _, c := range cases {
+		statements, err := Parse(c)
+		if !assert.NoError(t, err, c) {
+			continue
+		}
+		plan, err := NewQueryPlan(statements[0].(*SelectStatement), "", timeutil.Now())
+		assert.Error(t, err, c)
+		assert.Nil(t, plan, c)
+	}
+}
+
+func TestTagCondition(t *testing.T) {
+	statements, err := Parse("show tag values with key = host where time > now() - 1h and ip = 'a'")
+	assert.NoError(t, err)
+	condition, err := TagCondition(statements[0].(*ShowTagValuesStatement).Condition)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.EqualsExpr{Key: "ip", Value: "a"}, condition)
+
+	condition, err = TagCondition(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, condition)
+}
+
+func TestShowMeasurementsStatement_Matcher(t *testing.T) {
+	cases := []struct {
+		sql     string
+		matched []string
+	}{
+		{sql: "show measurements", matched: []string{"cpu", "mem", "disk"}},
+		{sql: "show measurements with measurement = cpu", matched: []string{"cpu"}},
+		{sql: "show measurements with measurement != cpu", matched: []string{"mem", "disk"}},
+		{sql: "show measurements with measurement =~ /m|d/", matched: []string{"mem", "disk"}},
+		{sql: "show measurements with measurement !~ /m|d/", matched: []string{"cpu"}},
+	}
+	for _, c := range cases {
+		statements, err := Parse(c.sql)
+		assert.NoError(t, err)
+		matcher, err := statements[0].(*ShowMeasurementsStatement).Matcher()
+		assert.NoError(t, err)
+		var matched []string
+		for _, name := range []string{"cpu", "mem", "disk"} {
+			if matcher(name) {
+				matched = append(matched, name)
+			}
+		}
+		assert.Equal(t, c.matched, matched, c.sql)
+	}
+	statements, _ := Parse("show measurements with measurement =~ /[/")
+	matcher, err := statements[0].(*ShowMeasurementsStatement).Matcher()
+	assert.Error(t, err)
+	assert.Nil(t, matcher)
+}
+
+func TestShowTagValuesStatement_KeyMatcher(t *testing.T) {
+	statements, _ := Parse("show tag values with key in (host, ip)")
+	s := statements[0].(*ShowTagValuesStatement)
+	keys, ok := s.ExactKeys()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"host", "ip"}, keys)
+
+	statements, _ = Parse("show tag values with key != host")
+	s = statements[0].(*ShowTagValuesStatement)
+	keys, ok = s.ExactKeys()
+	assert.False(t, ok)
+	assert.Nil(t, keys)
+	matcher, err := s.KeyMatcher()
+	assert.NoError(t, err)
+	assert.False(t, matcher("host"))
+	assert.True(t, matcher("ip"))
+
+	_, err = (&ShowTagValuesStatement{KeyOp: tokenLess}).KeyMatcher()
+	assert.Error(t, err)
+}
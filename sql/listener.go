@@ -26,17 +26,85 @@ type listener struct {
 	*grammar.BaseSQLListener
 
 	queryStmt          *queryStmtParser
+	parentQueryStmts   []*queryStmtParser // parent query statements of the subquery which is being parsed
 	metadataStmt       *metadataStmtParser
 	stateStmt          *stateStmtParser
 	metricMetadataStmt *metricMetadataStmtParser
 	useStmt            *useStmtParser
 	schemasStmt        *schemasStmtParser
 	storageStmt        *storageStmtParser
+	userStmt           *userStmtParser
+	deleteStmt         *deleteStmtParser
+	metricSchemaStmt   *metricSchemaStmtParser
 }
 
 // EnterQueryStmt is called when production queryStmt is entered.
 func (l *listener) EnterQueryStmt(ctx *grammar.QueryStmtContext) {
+	if l.queryStmt != nil {
+		// enter subquery of from clause
+		l.parentQueryStmts = append(l.parentQueryStmts, l.queryStmt)
+	}
 	l.queryStmt = newQueryStmtParse(ctx.T_EXPLAIN() != nil)
+	if len(l.parentQueryStmts) > 0 {
+		// result of subquery is aggregated by outer query, so only explicit limit cuts the series of subquery
+		l.queryStmt.limit = 0
+	}
+}
+
+// ExitQueryStmt is called when production queryStmt is exited.
+func (l *listener) ExitQueryStmt(_ *grammar.QueryStmtContext) {
+	size := len(l.parentQueryStmts)
+	if size == 0 {
+		return
+	}
+	// exit subquery, continue parsing parent query statement
+	parent := l.parentQueryStmts[size-1]
+	l.parentQueryStmts = l.parentQueryStmts[:size-1]
+	parent.completeSubQuery(l.queryStmt)
+	l.queryStmt = parent
+}
+
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (l *listener) EnterDeleteStmt(_ *grammar.DeleteStmtContext) {
+	l.deleteStmt = newDeleteStmtParse()
+	// visit from/where clause using query statement parser
+	l.queryStmt = l.deleteStmt.queryStmtParser
+}
+
+// EnterAlterMetricStmt is called when production alterMetricStmt is entered.
+func (l *listener) EnterAlterMetricStmt(_ *grammar.AlterMetricStmtContext) {
+	// statement type will be set when visit alter field action
+	l.metricSchemaStmt = newMetricSchemaStmtParse(stmt.AlterFieldType)
+}
+
+// EnterAlterFieldAction is called when production alterFieldAction is entered.
+func (l *listener) EnterAlterFieldAction(ctx *grammar.AlterFieldActionContext) {
+	l.metricSchemaStmt.visitAlterFieldAction(ctx)
+}
+
+// EnterDropMetricStmt is called when production dropMetricStmt is entered.
+func (l *listener) EnterDropMetricStmt(_ *grammar.DropMetricStmtContext) {
+	l.metricSchemaStmt = newMetricSchemaStmtParse(stmt.DropMetricType)
+}
+
+// EnterDropNamespaceStmt is called when production dropNamespaceStmt is entered.
+func (l *listener) EnterDropNamespaceStmt(_ *grammar.DropNamespaceStmtContext) {
+	l.metricSchemaStmt = newMetricSchemaStmtParse(stmt.DropNamespaceType)
+}
+
+// EnterFieldName is called when production fieldName is entered.
+func (l *listener) EnterFieldName(ctx *grammar.FieldNameContext) {
+	l.metricSchemaStmt.visitFieldName(ctx)
+}
+
+// EnterTargetFieldName is called when production targetFieldName is entered.
+func (l *listener) EnterTargetFieldName(ctx *grammar.TargetFieldNameContext) {
+	l.metricSchemaStmt.visitTargetFieldName(ctx)
+}
+
+// EnterFieldType is called when production fieldType is entered.
+func (l *listener) EnterFieldType(ctx *grammar.FieldTypeContext) {
+	l.metricSchemaStmt.visitFieldType(ctx)
 }
 
 // EnterShowMetadataTypesStmt is called when production showMetadataTypesStmt is entered.
@@ -104,6 +172,11 @@ func (l *listener) EnterShowReplicationStmt(_ *grammar.ShowReplicationStmtContex
 	l.stateStmt = newStateStmtParse(stmt.Replication)
 }
 
+// EnterShowLimitsStmt is called when production showLimitsStmt is entered.
+func (l *listener) EnterShowLimitsStmt(_ *grammar.ShowLimitsStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.Limit)
+}
+
 // EnterStorageFilter is called when production storageFilter is entered.
 func (l *listener) EnterStorageFilter(ctx *grammar.StorageFilterContext) {
 	switch {
@@ -195,6 +268,21 @@ func (l *listener) EnterShowTagValuesStmt(_ *grammar.ShowTagValuesStmtContext) {
 	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.TagValue)
 }
 
+// EnterShowMetricCardinalityStmt is called when production showMetricCardinalityStmt is entered.
+func (l *listener) EnterShowMetricCardinalityStmt(_ *grammar.ShowMetricCardinalityStmtContext) {
+	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.MetricCardinality)
+}
+
+// EnterShowTagKeyCardinalityStmt is called when production showTagKeyCardinalityStmt is entered.
+func (l *listener) EnterShowTagKeyCardinalityStmt(_ *grammar.ShowTagKeyCardinalityStmtContext) {
+	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.TagKeyCardinality)
+}
+
+// EnterShowTagValueCardinalityStmt is called when production showTagValueCardinalityStmt is entered.
+func (l *listener) EnterShowTagValueCardinalityStmt(_ *grammar.ShowTagValueCardinalityStmtContext) {
+	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.TagValueCardinality)
+}
+
 // EnterNamespace is called when production namespace is entered.
 func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 	switch {
@@ -202,6 +290,8 @@ func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 		l.queryStmt.visitNamespace(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitNamespace(ctx)
+	case l.metricSchemaStmt != nil:
+		l.metricSchemaStmt.visitNamespace(ctx)
 	}
 }
 
@@ -226,6 +316,8 @@ func (l *listener) EnterMetricName(ctx *grammar.MetricNameContext) {
 		l.queryStmt.visitMetricName(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitMetricName(ctx)
+	case l.metricSchemaStmt != nil:
+		l.metricSchemaStmt.visitMetricName(ctx)
 	}
 }
 
@@ -332,6 +424,20 @@ func (l *listener) EnterTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
 	}
 }
 
+// EnterTimeZoneClause is called when production timeZoneClause is entered.
+func (l *listener) EnterTimeZoneClause(ctx *grammar.TimeZoneClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitTimeZone(ctx)
+	}
+}
+
+// EnterWithExemplarsClause is called when production withExemplarsClause is entered.
+func (l *listener) EnterWithExemplarsClause(_ *grammar.WithExemplarsClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitWithExemplars()
+	}
+}
+
 // EnterGroupByKey is called when production groupByClause is entered.
 func (l *listener) EnterGroupByKey(ctx *grammar.GroupByKeyContext) {
 	if l.queryStmt != nil {
@@ -339,6 +445,132 @@ func (l *listener) EnterGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// EnterFillOption is called when production fillOption is entered.
+func (l *listener) EnterFillOption(ctx *grammar.FillOptionContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitFillOption(ctx)
+	}
+}
+
+// EnterHavingClause is called when production havingClause is entered.
+func (l *listener) EnterHavingClause(_ *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.enterClause(havingClause)
+	}
+}
+
+// EnterBoolExpr is called when production boolExpr is entered.
+func (l *listener) EnterBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBoolExpr(ctx)
+	}
+}
+
+// ExitBoolExpr is called when production boolExpr is exited.
+func (l *listener) ExitBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBoolExpr(ctx)
+	}
+}
+
+// EnterBinaryExpr is called when production binaryExpr is entered.
+func (l *listener) EnterBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBinaryExpr(ctx)
+	}
+}
+
+// ExitBinaryExpr is called when production binaryExpr is exited.
+func (l *listener) ExitBinaryExpr(_ *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeExpr()
+	}
+}
+
+// EnterOrderByClause is called when production orderByClause is entered.
+func (l *listener) EnterOrderByClause(_ *grammar.OrderByClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.enterClause(orderByClause)
+	}
+}
+
+// ExitSortField is called when production sortField is exited.
+func (l *listener) ExitSortField(ctx *grammar.SortFieldContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeSortField(ctx)
+	}
+}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (l *listener) EnterCreateUserStmt(_ *grammar.CreateUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.CreateUserType)
+}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (l *listener) EnterDropUserStmt(_ *grammar.DropUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.DropUserType)
+}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (l *listener) EnterShowUsersStmt(_ *grammar.ShowUsersStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.ShowUsersType)
+}
+
+// EnterCreateRoleStmt is called when production createRoleStmt is entered.
+func (l *listener) EnterCreateRoleStmt(_ *grammar.CreateRoleStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.CreateRoleType)
+}
+
+// EnterDropRoleStmt is called when production dropRoleStmt is entered.
+func (l *listener) EnterDropRoleStmt(_ *grammar.DropRoleStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.DropRoleType)
+}
+
+// EnterShowRolesStmt is called when production showRolesStmt is entered.
+func (l *listener) EnterShowRolesStmt(_ *grammar.ShowRolesStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.ShowRolesType)
+}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (l *listener) EnterGrantStmt(_ *grammar.GrantStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.GrantType)
+}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (l *listener) EnterRevokeStmt(_ *grammar.RevokeStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.RevokeType)
+}
+
+// EnterUserName is called when production userName is entered.
+func (l *listener) EnterUserName(ctx *grammar.UserNameContext) {
+	l.userStmt.visitUserName(ctx)
+}
+
+// EnterRoleName is called when production roleName is entered.
+func (l *listener) EnterRoleName(ctx *grammar.RoleNameContext) {
+	l.userStmt.visitRoleName(ctx)
+}
+
+// EnterPassword is called when production password is entered.
+func (l *listener) EnterPassword(ctx *grammar.PasswordContext) {
+	l.userStmt.visitPassword(ctx)
+}
+
+// EnterPrivilege is called when production privilege is entered.
+func (l *listener) EnterPrivilege(ctx *grammar.PrivilegeContext) {
+	l.userStmt.visitPrivilege(ctx)
+}
+
+// EnterGrantDatabase is called when production grantDatabase is entered.
+func (l *listener) EnterGrantDatabase(ctx *grammar.GrantDatabaseContext) {
+	l.userStmt.visitGrantDatabase(ctx)
+}
+
+// EnterGrantNamespace is called when production grantNamespace is entered.
+func (l *listener) EnterGrantNamespace(ctx *grammar.GrantNamespaceContext) {
+	l.userStmt.visitGrantNamespace(ctx)
+}
+
 // statement returns query statement, if failure return error
 func (l *listener) statement() (stmt.Statement, error) {
 	switch {
@@ -350,12 +582,18 @@ func (l *listener) statement() (stmt.Statement, error) {
 		return l.storageStmt.build()
 	case l.schemasStmt != nil:
 		return l.schemasStmt.build()
+	case l.deleteStmt != nil:
+		return l.deleteStmt.build()
+	case l.metricSchemaStmt != nil:
+		return l.metricSchemaStmt.build()
 	case l.queryStmt != nil:
 		return l.queryStmt.build()
 	case l.metricMetadataStmt != nil:
 		return l.metricMetadataStmt.build()
 	case l.stateStmt != nil:
 		return l.stateStmt.build()
+	case l.userStmt != nil:
+		return l.userStmt.build()
 	default:
 		return nil, nil
 	}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -106,3 +107,35 @@ func TestMetaStmt_ShowTagValues(t *testing.T) {
 			Right:    &stmt.EqualsExpr{Key: "key2", Value: "value2"},
 		}, *expr)
 }
+
+func TestMetaStmt_ShowCardinality(t *testing.T) {
+	q, err := Parse("show metric cardinality on 'ns' where metric='cpu' limit 10")
+	assert.NoError(t, err)
+	query := q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.MetricCardinality, query.Type)
+	assert.Equal(t, "ns", query.Namespace)
+	assert.Equal(t, "cpu", query.Prefix)
+	assert.Equal(t, 10, query.Limit)
+
+	q, err = Parse("show metric cardinality")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.MetricCardinality, query.Type)
+	assert.Equal(t, constants.DefaultNamespace, query.Namespace)
+	assert.Equal(t, 100, query.Limit)
+
+	q, err = Parse("show tag key cardinality from 'cpu' on 'ns'")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.TagKeyCardinality, query.Type)
+	assert.Equal(t, "cpu", query.MetricName)
+	assert.Equal(t, "ns", query.Namespace)
+
+	q, err = Parse("show tag value cardinality from 'cpu' with key = 'host' limit 5")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.TagValueCardinality, query.Type)
+	assert.Equal(t, "cpu", query.MetricName)
+	assert.Equal(t, "host", query.TagKey)
+	assert.Equal(t, 5, query.Limit)
+}
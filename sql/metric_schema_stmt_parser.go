@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// metricSchemaStmtParser represents metric schema management statement parser.
+type metricSchemaStmtParser struct {
+	schema *stmt.MetricSchema
+}
+
+// newMetricSchemaStmtParse creates a metric schema management statement parser.
+func newMetricSchemaStmtParse(schemaType stmt.MetricSchemaType) *metricSchemaStmtParser {
+	return &metricSchemaStmtParser{
+		schema: &stmt.MetricSchema{
+			Type:      schemaType,
+			Namespace: constants.DefaultNamespace,
+		},
+	}
+}
+
+// visitAlterFieldAction visits when production alter field action expression is entered.
+func (s *metricSchemaStmtParser) visitAlterFieldAction(ctx *grammar.AlterFieldActionContext) {
+	switch {
+	case ctx.T_RENAME() != nil:
+		s.schema.Type = stmt.RenameFieldType
+	case ctx.T_DROP() != nil:
+		s.schema.Type = stmt.DropFieldType
+	case ctx.T_ALTER() != nil:
+		s.schema.Type = stmt.AlterFieldType
+	}
+}
+
+// visitMetricName visits when production metric name expression is entered.
+func (s *metricSchemaStmtParser) visitMetricName(ctx *grammar.MetricNameContext) {
+	s.schema.MetricName = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitNamespace visits when production namespace expression is entered.
+func (s *metricSchemaStmtParser) visitNamespace(ctx *grammar.NamespaceContext) {
+	s.schema.Namespace = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitFieldName visits when production field name expression is entered.
+func (s *metricSchemaStmtParser) visitFieldName(ctx *grammar.FieldNameContext) {
+	s.schema.FieldName = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitTargetFieldName visits when production target field name expression is entered.
+func (s *metricSchemaStmtParser) visitTargetFieldName(ctx *grammar.TargetFieldNameContext) {
+	s.schema.NewFieldName = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitFieldType visits when production field type expression is entered.
+func (s *metricSchemaStmtParser) visitFieldType(ctx *grammar.FieldTypeContext) {
+	s.schema.FieldType = strings.ToLower(strutil.GetStringValue(ctx.Ident().GetText()))
+}
+
+// build returns the metric schema statement.
+func (s *metricSchemaStmtParser) build() (stmt.Statement, error) {
+	if s.schema.Type != stmt.DropNamespaceType && s.schema.MetricName == "" {
+		return nil, fmt.Errorf("metric name cannot be empty")
+	}
+	if s.schema.Namespace == "" {
+		return nil, fmt.Errorf("namespace cannot be empty")
+	}
+	return s.schema, nil
+}
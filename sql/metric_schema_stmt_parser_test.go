@@ -0,0 +1,100 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestMetricSchemaStatement(t *testing.T) {
+	cases := []struct {
+		sql    string
+		expect *stmt.MetricSchema
+	}{
+		{
+			sql: "alter metric cpu on 'system' rename field usage to used",
+			expect: &stmt.MetricSchema{
+				Type:         stmt.RenameFieldType,
+				Namespace:    "system",
+				MetricName:   "cpu",
+				FieldName:    "usage",
+				NewFieldName: "used",
+			},
+		},
+		{
+			sql: "alter metric 'cpu' drop field usage",
+			expect: &stmt.MetricSchema{
+				Type:       stmt.DropFieldType,
+				Namespace:  constants.DefaultNamespace,
+				MetricName: "cpu",
+				FieldName:  "usage",
+			},
+		},
+		{
+			sql: "ALTER METRIC cpu ALTER FIELD usage TYPE Max",
+			expect: &stmt.MetricSchema{
+				Type:       stmt.AlterFieldType,
+				Namespace:  constants.DefaultNamespace,
+				MetricName: "cpu",
+				FieldName:  "usage",
+				FieldType:  "max",
+			},
+		},
+		{
+			sql: "drop metric cpu on system",
+			expect: &stmt.MetricSchema{
+				Type:       stmt.DropMetricType,
+				Namespace:  "system",
+				MetricName: "cpu",
+			},
+		},
+		{
+			sql: "drop namespace system",
+			expect: &stmt.MetricSchema{
+				Type:      stmt.DropNamespaceType,
+				Namespace: "system",
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, q)
+		})
+	}
+}
+
+func TestMetricSchemaStatement_Fail(t *testing.T) {
+	_, err := Parse("alter metric cpu rename field usage")
+	assert.Error(t, err)
+	_, err = Parse("drop metric")
+	assert.Error(t, err)
+	_, err = newMetricSchemaStmtParse(stmt.DropMetricType).build()
+	assert.Error(t, err)
+	p := newMetricSchemaStmtParse(stmt.DropNamespaceType)
+	p.schema.Namespace = ""
+	_, err = p.build()
+	assert.Error(t, err)
+}
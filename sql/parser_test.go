@@ -110,6 +110,16 @@ func TestShowReplication(t *testing.T) {
 	assert.Equal(t, &stmt.State{Type: stmt.Replication, StorageName: "s", Database: "d"}, query)
 }
 
+func TestShowLimits(t *testing.T) {
+	query, err := Parse("show limits")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Limit}, query)
+
+	query, err = Parse("show limits where database=d")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Limit, Database: "d"}, query)
+}
+
 func TestShowBrokerMetric(t *testing.T) {
 	query, err := Parse("show broker metric where metric in (a,b)")
 	assert.NoError(t, err)
@@ -0,0 +1,162 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+// Expr represents a PromQL expression node.
+type Expr interface {
+	// Type returns the value type of the expression evaluated.
+	Type() ValueType
+}
+
+// ValueType represents the type of value which expression evaluated.
+type ValueType string
+
+// Defines all value types of PromQL.
+const (
+	ValueTypeScalar ValueType = "scalar"
+	ValueTypeVector ValueType = "vector"
+	ValueTypeMatrix ValueType = "matrix"
+	ValueTypeString ValueType = "string"
+)
+
+// MatchType represents the type of label matcher.
+type MatchType int
+
+// Defines all label matcher types.
+const (
+	MatchEqual MatchType = iota + 1
+	MatchNotEqual
+	MatchRegexp
+	MatchNotRegexp
+)
+
+// String returns the string value of match type.
+func (m MatchType) String() string {
+	switch m {
+	case MatchEqual:
+		return "="
+	case MatchNotEqual:
+		return "!="
+	case MatchRegexp:
+		return "=~"
+	case MatchNotRegexp:
+		return "!~"
+	default:
+		return "unknown"
+	}
+}
+
+// LabelMatcher represents a label filter, like host="1.1.1.1".
+type LabelMatcher struct {
+	Name  string
+	Type  MatchType
+	Value string
+}
+
+// NumberLiteral represents a number.
+type NumberLiteral struct {
+	Val float64
+}
+
+// StringLiteral represents a string.
+type StringLiteral struct {
+	Val string
+}
+
+// VectorSelector represents a series selector, like cpu{host="1.1.1.1"}.
+type VectorSelector struct {
+	Name     string
+	Matchers []*LabelMatcher
+	Offset   int64 // offset in milliseconds
+}
+
+// MatrixSelector represents a range vector selector, like cpu[5m].
+type MatrixSelector struct {
+	VectorSelector *VectorSelector
+	Range          int64 // range in milliseconds
+}
+
+// AggregateExpr represents an aggregation operation on a vector, like sum by (host) (cpu).
+type AggregateExpr struct {
+	Op       string   // aggregation operator, like sum/avg
+	Expr     Expr     // vector expression for aggregating
+	Param    Expr     // parameter used by some aggregators, like topk/quantile
+	Grouping []string // label names used for grouping
+	Without  bool     // whether to drop the labels in grouping
+}
+
+// Call represents a function call, like rate(cpu[5m]).
+type Call struct {
+	Func string
+	Args []Expr
+}
+
+// BinaryExpr represents a binary expression between two expressions.
+type BinaryExpr struct {
+	Op         string
+	LHS, RHS   Expr
+	ReturnBool bool
+}
+
+// ParenExpr represents a parenthesized expression.
+type ParenExpr struct {
+	Expr Expr
+}
+
+// UnaryExpr represents an unary operation on another expression.
+type UnaryExpr struct {
+	Op   string
+	Expr Expr
+}
+
+// Type returns the value type of number literal.
+func (e *NumberLiteral) Type() ValueType { return ValueTypeScalar }
+
+// Type returns the value type of string literal.
+func (e *StringLiteral) Type() ValueType { return ValueTypeString }
+
+// Type returns the value type of vector selector.
+func (e *VectorSelector) Type() ValueType { return ValueTypeVector }
+
+// Type returns the value type of matrix selector.
+func (e *MatrixSelector) Type() ValueType { return ValueTypeMatrix }
+
+// Type returns the value type of aggregation.
+func (e *AggregateExpr) Type() ValueType { return ValueTypeVector }
+
+// Type returns the value type of function call.
+func (e *Call) Type() ValueType {
+	if fn, ok := functions[e.Func]; ok {
+		return fn.returnType
+	}
+	return ValueTypeVector
+}
+
+// Type returns the value type of binary expression.
+func (e *BinaryExpr) Type() ValueType {
+	if e.LHS.Type() == ValueTypeScalar && e.RHS.Type() == ValueTypeScalar {
+		return ValueTypeScalar
+	}
+	return ValueTypeVector
+}
+
+// Type returns the value type of parenthesized expression.
+func (e *ParenExpr) Type() ValueType { return e.Expr.Type() }
+
+// Type returns the value type of unary expression.
+func (e *UnaryExpr) Type() ValueType { return e.Expr.Type() }
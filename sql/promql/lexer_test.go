@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLexer_lex(t *testing.T) {
+	tokens, err := lex(`sum by (host) (rate(cpu{host=~"1.*",ip!='a\'b'}[5m] offset 1h30m)) >= 1.5e3 # comment`)
+	assert.NoError(t, err)
+	var types []tokenType
+	for _, tok := range tokens {
+		types = append(types, tok.typ)
+	}
+	assert.Equal(t, []tokenType{
+		tokenIdentifier, tokenIdentifier, tokenLeftParen, tokenIdentifier, tokenRightParen,
+		tokenLeftParen, tokenIdentifier, tokenLeftParen, tokenIdentifier, tokenLeftBrace,
+		tokenIdentifier, tokenRegexMatch, tokenString, tokenComma,
+		tokenIdentifier, tokenNotEqual, tokenString, tokenRightBrace,
+		tokenLeftBracket, tokenDuration, tokenRightBracket, tokenIdentifier, tokenDuration,
+		tokenRightParen, tokenRightParen, tokenGreaterEqual, tokenNumber, tokenEOF,
+	}, types)
+	assert.Equal(t, "1.*", tokens[12].val)
+	assert.Equal(t, "a'b", tokens[16].val)
+	assert.Equal(t, "1h30m", tokens[22].val)
+	assert.Equal(t, "1.5e3", tokens[26].val)
+	assert.Equal(t, "EOF", tokens[27].String())
+	assert.Equal(t, `"sum"`, tokens[0].String())
+
+	tokens, err = lex("a == b != c < d <= e > f + g - h * i / j % k ^ l =~ `x`")
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 26)
+	assert.Equal(t, tokenLess, tokens[5].typ)
+	assert.Equal(t, tokenGreater, tokens[9].typ)
+	assert.Equal(t, "x", tokens[24].val)
+}
+
+func TestLexer_lex_fail(t *testing.T) {
+	cases := []string{
+		"a ! b",
+		"a $ b",
+		`a{b="c}`,
+		"1mh",
+		`a{b="\x"}`,
+	}
+	for _, c := range cases {
+		_, err := lex(c)
+		assert.Error(t, err, c)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := []struct {
+		val      string
+		duration int64
+		hasErr   bool
+	}{
+		{val: "100ms", duration: 100},
+		{val: "5s", duration: 5000},
+		{val: "1h30m", duration: 90 * 60 * 1000},
+		{val: "1d", duration: 24 * 60 * 60 * 1000},
+		{val: "1w", duration: 7 * 24 * 60 * 60 * 1000},
+		{val: "1y", duration: 365 * 24 * 60 * 60 * 1000},
+		{val: "", hasErr: true},
+		{val: "m", hasErr: true},
+		{val: "5", hasErr: true},
+		{val: "5x", hasErr: true},
+		{val: "99999999999999999999s", hasErr: true},
+	}
+	for _, c := range cases {
+		duration, err := ParseDuration(c.val)
+		if c.hasErr {
+			assert.Error(t, err, c.val)
+		} else {
+			assert.NoError(t, err, c.val)
+			assert.Equal(t, c.duration, duration, c.val)
+		}
+	}
+}
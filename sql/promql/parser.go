@@ -0,0 +1,489 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// funcSignature represents the signature of PromQL function.
+type funcSignature struct {
+	argTypes   []ValueType
+	returnType ValueType
+}
+
+// functions defines all functions which can be parsed.
+var functions = map[string]*funcSignature{
+	"rate":               {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"irate":              {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"increase":           {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"delta":              {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"avg_over_time":      {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"sum_over_time":      {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"min_over_time":      {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"max_over_time":      {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"count_over_time":    {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"last_over_time":     {argTypes: []ValueType{ValueTypeMatrix}, returnType: ValueTypeVector},
+	"histogram_quantile": {argTypes: []ValueType{ValueTypeScalar, ValueTypeVector}, returnType: ValueTypeVector},
+}
+
+// aggregators defines all aggregation operators which can be parsed,
+// value is whether the aggregator requires a parameter.
+var aggregators = map[string]bool{
+	"sum":      false,
+	"min":      false,
+	"max":      false,
+	"avg":      false,
+	"count":    false,
+	"stddev":   false,
+	"stdvar":   false,
+	"group":    false,
+	"topk":     true,
+	"bottomk":  true,
+	"quantile": true,
+}
+
+// binaryPrecedence defines the precedence of binary operators, higher value binds tighter.
+var binaryPrecedence = map[tokenType]int{
+	tokenEqual:        3,
+	tokenNotEqual:     3,
+	tokenLess:         3,
+	tokenLessEqual:    3,
+	tokenGreater:      3,
+	tokenGreaterEqual: 3,
+	tokenAdd:          4,
+	tokenSub:          4,
+	tokenMul:          5,
+	tokenDiv:          5,
+	tokenMod:          5,
+	tokenPow:          6,
+}
+
+// setOperators defines the logical/set binary operators(keywords).
+var setOperators = map[string]int{
+	"or":     1,
+	"and":    2,
+	"unless": 2,
+}
+
+// parser represents PromQL parser using recursive descent.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses the PromQL expression string to an expression tree.
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, p.unexpected(tok, "end of input")
+	}
+	return expr, nil
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// expect consumes the current token if it matches the type, else returns error.
+func (p *parser) expect(typ tokenType, context string) (token, error) {
+	tok := p.next()
+	if tok.typ != typ {
+		return tok, p.unexpected(tok, context)
+	}
+	return tok, nil
+}
+
+// unexpected returns the error of unexpected token.
+func (p *parser) unexpected(tok token, context string) error {
+	return fmt.Errorf("unexpected %s at position %d, expected %s", tok, tok.pos, context)
+}
+
+// binaryOp returns the precedence of binary operator if current token is binary operator.
+func (p *parser) binaryOp() (op string, precedence int, ok bool) {
+	tok := p.peek()
+	if tok.typ == tokenIdentifier {
+		precedence, ok = setOperators[strings.ToLower(tok.val)]
+		return strings.ToLower(tok.val), precedence, ok
+	}
+	precedence, ok = binaryPrecedence[tok.typ]
+	return tok.val, precedence, ok
+}
+
+// parseExpr parses binary expression using precedence climbing.
+func (p *parser) parseExpr(minPrecedence int) (Expr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, precedence, ok := p.binaryOp()
+		if !ok || precedence < minPrecedence {
+			return lhs, nil
+		}
+		p.next()
+		returnBool := false
+		if tok := p.peek(); tok.typ == tokenIdentifier {
+			switch strings.ToLower(tok.val) {
+			case "bool":
+				if precedence != binaryPrecedence[tokenEqual] {
+					return nil, fmt.Errorf("bool modifier can only be used on comparison operators")
+				}
+				returnBool = true
+				p.next()
+			case "on", "ignoring", "group_left", "group_right":
+				return nil, fmt.Errorf("vector matching(%s) is not supported", tok.val)
+			}
+		}
+		nextPrecedence := precedence + 1
+		if op == "^" {
+			// right associative
+			nextPrecedence = precedence
+		}
+		rhs, err := p.parseExpr(nextPrecedence)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: op, LHS: lhs, RHS: rhs, ReturnBool: returnBool}
+	}
+}
+
+// parseUnary parses unary expression, like -1.
+func (p *parser) parseUnary() (Expr, error) {
+	tok := p.peek()
+	if tok.typ != tokenAdd && tok.typ != tokenSub {
+		return p.parsePrimary()
+	}
+	p.next()
+	// unary operator binds weaker than ^
+	expr, err := p.parseExpr(binaryPrecedence[tokenPow])
+	if err != nil {
+		return nil, err
+	}
+	if tok.typ == tokenAdd {
+		return expr, nil
+	}
+	if number, ok := expr.(*NumberLiteral); ok {
+		number.Val = -number.Val
+		return number, nil
+	}
+	return &UnaryExpr{Op: tok.val, Expr: expr}, nil
+}
+
+// parsePrimary parses the primary expression with optional range/offset modifiers.
+func (p *parser) parsePrimary() (Expr, error) {
+	expr, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().typ == tokenLeftBracket {
+		expr, err = p.parseRange(expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if tok := p.peek(); tok.typ == tokenIdentifier && strings.ToLower(tok.val) == "offset" {
+		p.next()
+		if err := p.parseOffset(expr); err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+// parseAtom parses number/string/paren/selector/function/aggregation expression.
+func (p *parser) parseAtom() (Expr, error) {
+	tok := p.next()
+	switch tok.typ {
+	case tokenNumber:
+		val, err := strconv.ParseFloat(tok.val, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &NumberLiteral{Val: val}, nil
+	case tokenString:
+		return &StringLiteral{Val: tok.val}, nil
+	case tokenLeftParen:
+		expr, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, "')'"); err != nil {
+			return nil, err
+		}
+		return &ParenExpr{Expr: expr}, nil
+	case tokenLeftBrace:
+		p.pos--
+		return p.parseVectorSelector("")
+	case tokenIdentifier:
+		name := tok.val
+		lowerName := strings.ToLower(name)
+		if _, ok := aggregators[lowerName]; ok {
+			next := p.peek()
+			isGrouping := next.typ == tokenIdentifier &&
+				(strings.ToLower(next.val) == "by" || strings.ToLower(next.val) == "without")
+			if next.typ == tokenLeftParen || isGrouping {
+				return p.parseAggregateExpr(lowerName)
+			}
+		}
+		if p.peek().typ == tokenLeftParen {
+			return p.parseCall(name)
+		}
+		return p.parseVectorSelector(name)
+	default:
+		return nil, p.unexpected(tok, "expression")
+	}
+}
+
+// parseVectorSelector parses series selector, like cpu{host="1.1.1.1"}.
+func (p *parser) parseVectorSelector(name string) (Expr, error) {
+	selector := &VectorSelector{Name: name}
+	if p.peek().typ == tokenLeftBrace {
+		p.next()
+		for p.peek().typ != tokenRightBrace {
+			matcher, err := p.parseLabelMatcher()
+			if err != nil {
+				return nil, err
+			}
+			if matcher.Name == "__name__" && matcher.Type == MatchEqual {
+				if selector.Name != "" && selector.Name != matcher.Value {
+					return nil, fmt.Errorf("metric name must not be set twice: %q or %q", selector.Name, matcher.Value)
+				}
+				selector.Name = matcher.Value
+			} else {
+				selector.Matchers = append(selector.Matchers, matcher)
+			}
+			if p.peek().typ != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenRightBrace, "'}'"); err != nil {
+			return nil, err
+		}
+	}
+	if selector.Name == "" {
+		return nil, fmt.Errorf("vector selector must contain a metric name")
+	}
+	return selector, nil
+}
+
+// parseLabelMatcher parses a label matcher, like host="1.1.1.1".
+func (p *parser) parseLabelMatcher() (*LabelMatcher, error) {
+	nameTok, err := p.expect(tokenIdentifier, "label name")
+	if err != nil {
+		return nil, err
+	}
+	matcher := &LabelMatcher{Name: nameTok.val}
+	opTok := p.next()
+	switch opTok.typ {
+	case tokenAssign:
+		matcher.Type = MatchEqual
+	case tokenNotEqual:
+		matcher.Type = MatchNotEqual
+	case tokenRegexMatch:
+		matcher.Type = MatchRegexp
+	case tokenRegexNotMatch:
+		matcher.Type = MatchNotRegexp
+	default:
+		return nil, p.unexpected(opTok, "label matching operator")
+	}
+	valueTok, err := p.expect(tokenString, "label value")
+	if err != nil {
+		return nil, err
+	}
+	matcher.Value = valueTok.val
+	return matcher, nil
+}
+
+// parseRange parses range selector, like [5m].
+func (p *parser) parseRange(expr Expr) (Expr, error) {
+	p.next()
+	selector, ok := expr.(*VectorSelector)
+	if !ok {
+		return nil, fmt.Errorf("ranges only allowed for vector selectors")
+	}
+	durationTok, err := p.expect(tokenDuration, "duration")
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().typ != tokenRightBracket {
+		return nil, fmt.Errorf("subquery is not supported")
+	}
+	p.next()
+	rangeVal, err := ParseDuration(durationTok.val)
+	if err != nil {
+		return nil, err
+	}
+	return &MatrixSelector{VectorSelector: selector, Range: rangeVal}, nil
+}
+
+// parseOffset parses offset modifier, like offset 5m.
+func (p *parser) parseOffset(expr Expr) error {
+	durationTok, err := p.expect(tokenDuration, "duration")
+	if err != nil {
+		return err
+	}
+	offset, err := ParseDuration(durationTok.val)
+	if err != nil {
+		return err
+	}
+	switch e := expr.(type) {
+	case *VectorSelector:
+		e.Offset = offset
+	case *MatrixSelector:
+		e.VectorSelector.Offset = offset
+	default:
+		return fmt.Errorf("offset modifier must be preceded by a selector")
+	}
+	return nil
+}
+
+// parseCall parses function call, like rate(cpu[5m]).
+func (p *parser) parseCall(name string) (Expr, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function with name %q", name)
+	}
+	args, err := p.parseArgs()
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != len(fn.argTypes) {
+		return nil, fmt.Errorf("expected %d argument(s) in call to %q, got %d", len(fn.argTypes), name, len(args))
+	}
+	for idx, arg := range args {
+		if arg.Type() != fn.argTypes[idx] {
+			return nil, fmt.Errorf("expected type %s in call to %q, got %s", fn.argTypes[idx], name, arg.Type())
+		}
+	}
+	return &Call{Func: name, Args: args}, nil
+}
+
+// parseArgs parses the argument list in parentheses.
+func (p *parser) parseArgs() ([]Expr, error) {
+	if _, err := p.expect(tokenLeftParen, "'('"); err != nil {
+		return nil, err
+	}
+	var args []Expr
+	for p.peek().typ != tokenRightParen {
+		arg, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.peek().typ != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokenRightParen, "')'"); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// parseAggregateExpr parses aggregation, grouping clause can be before or after the arguments,
+// like sum by (host) (cpu) or sum(cpu) by (host).
+func (p *parser) parseAggregateExpr(op string) (Expr, error) {
+	agg := &AggregateExpr{Op: op}
+	hasGrouping := false
+	if tok := p.peek(); tok.typ == tokenIdentifier {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+		hasGrouping = true
+	}
+	args, err := p.parseArgs()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); !hasGrouping && tok.typ == tokenIdentifier {
+		switch strings.ToLower(tok.val) {
+		case "by", "without":
+			if err := p.parseGrouping(agg); err != nil {
+				return nil, err
+			}
+		}
+	}
+	expectArgs := 1
+	if aggregators[op] {
+		expectArgs = 2
+	}
+	if len(args) != expectArgs {
+		return nil, fmt.Errorf("wrong number of arguments for aggregate expression provided, expected %d, got %d",
+			expectArgs, len(args))
+	}
+	if expectArgs == 2 {
+		agg.Param = args[0]
+	}
+	agg.Expr = args[expectArgs-1]
+	if agg.Expr.Type() != ValueTypeVector {
+		return nil, fmt.Errorf("expected type %s in aggregation %q, got %s", ValueTypeVector, op, agg.Expr.Type())
+	}
+	return agg, nil
+}
+
+// parseGrouping parses by/without label list.
+func (p *parser) parseGrouping(agg *AggregateExpr) error {
+	tok := p.next()
+	switch strings.ToLower(tok.val) {
+	case "by":
+	case "without":
+		agg.Without = true
+	default:
+		return p.unexpected(tok, "by or without")
+	}
+	if _, err := p.expect(tokenLeftParen, "'('"); err != nil {
+		return err
+	}
+	agg.Grouping = []string{}
+	for p.peek().typ != tokenRightParen {
+		labelTok, err := p.expect(tokenIdentifier, "label name")
+		if err != nil {
+			return err
+		}
+		agg.Grouping = append(agg.Grouping, labelTok.val)
+		if p.peek().typ != tokenComma {
+			break
+		}
+		p.next()
+	}
+	_, err := p.expect(tokenRightParen, "')'")
+	return err
+}
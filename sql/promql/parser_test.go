@@ -0,0 +1,203 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input string
+		expr  Expr
+	}{
+		{
+			input: "1",
+			expr:  &NumberLiteral{Val: 1},
+		},
+		{
+			input: "-1.5",
+			expr:  &NumberLiteral{Val: -1.5},
+		},
+		{
+			input: `"abc"`,
+			expr:  &StringLiteral{Val: "abc"},
+		},
+		{
+			input: "cpu",
+			expr:  &VectorSelector{Name: "cpu"},
+		},
+		{
+			input: `{__name__="cpu",host="a"}`,
+			expr: &VectorSelector{Name: "cpu", Matchers: []*LabelMatcher{
+				{Name: "host", Type: MatchEqual, Value: "a"},
+			}},
+		},
+		{
+			input: `cpu{host!="a",ip=~"1.*",zone!~"z",}`,
+			expr: &VectorSelector{Name: "cpu", Matchers: []*LabelMatcher{
+				{Name: "host", Type: MatchNotEqual, Value: "a"},
+				{Name: "ip", Type: MatchRegexp, Value: "1.*"},
+				{Name: "zone", Type: MatchNotRegexp, Value: "z"},
+			}},
+		},
+		{
+			input: "cpu offset 5m",
+			expr:  &VectorSelector{Name: "cpu", Offset: 5 * 60 * 1000},
+		},
+		{
+			input: "rate(cpu[5m] offset 1m)",
+			expr: &Call{Func: "rate", Args: []Expr{
+				&MatrixSelector{VectorSelector: &VectorSelector{Name: "cpu", Offset: 60 * 1000}, Range: 5 * 60 * 1000},
+			}},
+		},
+		{
+			input: "sum by (host, ip) (cpu)",
+			expr:  &AggregateExpr{Op: "sum", Expr: &VectorSelector{Name: "cpu"}, Grouping: []string{"host", "ip"}},
+		},
+		{
+			input: "SUM(cpu) without (host)",
+			expr:  &AggregateExpr{Op: "sum", Expr: &VectorSelector{Name: "cpu"}, Grouping: []string{"host"}, Without: true},
+		},
+		{
+			input: "topk(5, cpu)",
+			expr:  &AggregateExpr{Op: "topk", Expr: &VectorSelector{Name: "cpu"}, Param: &NumberLiteral{Val: 5}},
+		},
+		{
+			input: "sum",
+			expr:  &VectorSelector{Name: "sum"},
+		},
+		{
+			input: "1 + 2 * 3",
+			expr: &BinaryExpr{Op: "+", LHS: &NumberLiteral{Val: 1},
+				RHS: &BinaryExpr{Op: "*", LHS: &NumberLiteral{Val: 2}, RHS: &NumberLiteral{Val: 3}}},
+		},
+		{
+			input: "2 ^ 3 ^ 2",
+			expr: &BinaryExpr{Op: "^", LHS: &NumberLiteral{Val: 2},
+				RHS: &BinaryExpr{Op: "^", LHS: &NumberLiteral{Val: 3}, RHS: &NumberLiteral{Val: 2}}},
+		},
+		{
+			input: "(1 - 2) - 3",
+			expr: &BinaryExpr{Op: "-",
+				LHS: &ParenExpr{Expr: &BinaryExpr{Op: "-", LHS: &NumberLiteral{Val: 1}, RHS: &NumberLiteral{Val: 2}}},
+				RHS: &NumberLiteral{Val: 3}},
+		},
+		{
+			input: "-cpu",
+			expr:  &UnaryExpr{Op: "-", Expr: &VectorSelector{Name: "cpu"}},
+		},
+		{
+			input: "+cpu",
+			expr:  &VectorSelector{Name: "cpu"},
+		},
+		{
+			input: "cpu > bool 1 or mem and disk",
+			expr: &BinaryExpr{Op: "or",
+				LHS: &BinaryExpr{Op: ">", LHS: &VectorSelector{Name: "cpu"}, RHS: &NumberLiteral{Val: 1}, ReturnBool: true},
+				RHS: &BinaryExpr{Op: "and", LHS: &VectorSelector{Name: "mem"}, RHS: &VectorSelector{Name: "disk"}}},
+		},
+		{
+			input: "histogram_quantile(0.99, sum by (le) (rate(latency_bucket[1m])))",
+			expr: &Call{Func: "histogram_quantile", Args: []Expr{
+				&NumberLiteral{Val: 0.99},
+				&AggregateExpr{Op: "sum", Grouping: []string{"le"}, Expr: &Call{Func: "rate", Args: []Expr{
+					&MatrixSelector{VectorSelector: &VectorSelector{Name: "latency_bucket"}, Range: 60 * 1000},
+				}}},
+			}},
+		},
+	}
+	for _, c := range cases {
+		expr, err := Parse(c.input)
+		assert.NoError(t, err, c.input)
+		assert.Equal(t, c.expr, expr, c.input)
+	}
+}
+
+func TestParse_fail(t *testing.T) {
+	cases := []string{
+		"",
+		"a $ b",
+		"cpu)",
+		"(cpu",
+		"{}",
+		`{host="a"}`,
+		`cpu{__name__="mem"}`,
+		`cpu{host}`,
+		`cpu{host=a}`,
+		`cpu{host="a"`,
+		`cpu{1="a"}`,
+		"cpu[5]",
+		"cpu[5m:1m]",
+		"cpu[5m",
+		"(cpu)[5m]",
+		"cpu offset",
+		"cpu offset x",
+		"1 offset 5m",
+		"unknown(cpu)",
+		"rate(cpu)",
+		"rate(cpu[5m], 1)",
+		"rate(cpu[5m]",
+		"rate(cpu[5m],",
+		"sum(cpu, mem)",
+		"topk(cpu)",
+		"sum(cpu[5m])",
+		"sum by host (cpu)",
+		"sum by (1) (cpu)",
+		"sum by (host (cpu)",
+		"sum by (host) cpu",
+		"sum(cpu) by host",
+		"sum(rate(cpu))",
+		"sum(cpu",
+		"cpu + on(host) mem",
+		"cpu + bool mem",
+		"cpu + ",
+		"- ",
+		"- (1",
+		"1 + (2",
+	}
+	for _, c := range cases {
+		expr, err := Parse(c)
+		assert.Error(t, err, c)
+		assert.Nil(t, expr, c)
+	}
+}
+
+func TestExpr_Type(t *testing.T) {
+	assert.Equal(t, ValueTypeScalar, (&NumberLiteral{}).Type())
+	assert.Equal(t, ValueTypeString, (&StringLiteral{}).Type())
+	assert.Equal(t, ValueTypeVector, (&VectorSelector{}).Type())
+	assert.Equal(t, ValueTypeMatrix, (&MatrixSelector{}).Type())
+	assert.Equal(t, ValueTypeVector, (&AggregateExpr{}).Type())
+	assert.Equal(t, ValueTypeVector, (&Call{Func: "rate"}).Type())
+	assert.Equal(t, ValueTypeVector, (&Call{Func: "unknown"}).Type())
+	assert.Equal(t, ValueTypeScalar, (&BinaryExpr{LHS: &NumberLiteral{}, RHS: &NumberLiteral{}}).Type())
+	assert.Equal(t, ValueTypeVector, (&BinaryExpr{LHS: &NumberLiteral{}, RHS: &VectorSelector{}}).Type())
+	assert.Equal(t, ValueTypeMatrix, (&ParenExpr{Expr: &MatrixSelector{}}).Type())
+	assert.Equal(t, ValueTypeVector, (&UnaryExpr{Expr: &VectorSelector{}}).Type())
+}
+
+func TestMatchType_String(t *testing.T) {
+	assert.Equal(t, "=", MatchEqual.String())
+	assert.Equal(t, "!=", MatchNotEqual.String())
+	assert.Equal(t, "=~", MatchRegexp.String())
+	assert.Equal(t, "!~", MatchNotRegexp.String())
+	assert.Equal(t, "unknown", MatchType(0).String())
+}
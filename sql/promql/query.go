@@ -0,0 +1,540 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+const (
+	// MetricNameLabel represents the reserved label name of metric name.
+	MetricNameLabel = "__name__"
+	// defaultLookback represents the default look back duration of instant query.
+	defaultLookback = 5 * timeutil.OneMinute
+)
+
+// QueryPlan represents the LinDB query lowered from PromQL expression.
+type QueryPlan struct {
+	Query *stmt.Query // metric query, nil if expression is scalar
+
+	// IsScalar indicates the expression evaluated to a constant scalar without any selector.
+	IsScalar bool
+	Scalar   float64
+
+	// ExpandGroupBy indicates each result series keeps its labels, so the grouping tag keys
+	// need to be expanded with all tag keys of the metric(excludes ExcludeTagKeys).
+	ExpandGroupBy  bool
+	ExcludeTagKeys []string
+	// KeepMetricName indicates result series need to keep __name__ label.
+	KeepMetricName bool
+	// HistogramQuantile indicates result series are histogram bucket series grouped by le label,
+	// the quantile need to be calculated from the buckets of each series.
+	HistogramQuantile bool
+	Quantile          float64
+	// Offset represents the offset modifier of selector in milliseconds,
+	// result timestamps need to be shifted back with it.
+	Offset int64
+}
+
+// NewInstantQueryPlan creates the query plan for evaluating expression at the given time.
+func NewInstantQueryPlan(expr Expr, namespace string, timestamp int64) (*QueryPlan, error) {
+	return newQueryPlan(expr, namespace, timestamp, timestamp, 0)
+}
+
+// NewRangeQueryPlan creates the query plan for evaluating expression over a range of time.
+func NewRangeQueryPlan(expr Expr, namespace string, start, end, step int64) (*QueryPlan, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("zero or negative query resolution step widths are not accepted")
+	}
+	if end < start {
+		return nil, fmt.Errorf("end timestamp must not be before start time")
+	}
+	return newQueryPlan(expr, namespace, start, end, step)
+}
+
+// newQueryPlan lowers the expression onto LinDB query statement.
+func newQueryPlan(expr Expr, namespace string, start, end, step int64) (*QueryPlan, error) {
+	if expr.Type() == ValueTypeString || expr.Type() == ValueTypeMatrix {
+		return nil, fmt.Errorf("invalid expression type %q for query, must be scalar or instant vector", expr.Type())
+	}
+	if expr.Type() == ValueTypeScalar {
+		// constant expression, like 1+1
+		val, err := evalScalar(expr)
+		if err != nil {
+			return nil, err
+		}
+		return &QueryPlan{IsScalar: true, Scalar: val}, nil
+	}
+	l := &lowering{}
+	plan := &QueryPlan{}
+	var (
+		selectItem stmt.Expr
+		err        error
+	)
+	if call, ok := histogramQuantileCall(expr); ok {
+		plan.HistogramQuantile = true
+		plan.Quantile, selectItem, err = l.lowerHistogramQuantile(call)
+	} else {
+		selectItem, err = l.lower(expr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+	query := &stmt.Query{
+		Namespace:   namespace,
+		MetricName:  l.metricName,
+		SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: selectItem, Alias: constants.PrometheusValueField}},
+		Condition:   l.condition,
+		FieldNames:  l.fieldNames(),
+	}
+	interval := step
+	lookback := l.maxRange
+	if lookback <= 0 {
+		lookback = defaultLookback
+	}
+	if step == 0 {
+		// instant query evaluates the last point in look back window
+		interval = l.maxRange
+		start -= lookback
+	}
+	query.TimeRange = timeutil.TimeRange{Start: start - l.offset, End: end - l.offset}
+	query.Interval = timeutil.Interval(interval)
+
+	plan.Query = query
+	plan.Offset = l.offset
+	switch {
+	case l.grouping == nil:
+		plan.ExpandGroupBy = true
+		plan.KeepMetricName = isSelector(expr)
+	case l.grouping.Without:
+		plan.ExpandGroupBy = true
+		for _, tagKey := range l.grouping.Grouping {
+			// bucket series always keep le label
+			if l.histogram && tagKey == constants.PrometheusBucketLabel {
+				continue
+			}
+			plan.ExcludeTagKeys = append(plan.ExcludeTagKeys, tagKey)
+		}
+	default:
+		query.GroupBy = append(query.GroupBy, l.grouping.Grouping...)
+		if l.histogram && !containsString(query.GroupBy, constants.PrometheusBucketLabel) {
+			query.GroupBy = append(query.GroupBy, constants.PrometheusBucketLabel)
+		}
+	}
+	return plan, nil
+}
+
+// histogramQuantileCall returns histogram_quantile call if it is the outermost function of expression.
+func histogramQuantileCall(expr Expr) (*Call, bool) {
+	switch e := expr.(type) {
+	case *ParenExpr:
+		return histogramQuantileCall(e.Expr)
+	case *Call:
+		return e, e.Func == "histogram_quantile"
+	default:
+		return nil, false
+	}
+}
+
+// containsString checks if the string slice contains the value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelector checks if the expression is a plain vector selector.
+func isSelector(expr Expr) bool {
+	switch e := expr.(type) {
+	case *VectorSelector:
+		return true
+	case *ParenExpr:
+		return isSelector(e.Expr)
+	default:
+		return false
+	}
+}
+
+// lowering represents the context of lowering PromQL expression onto LinDB select item.
+type lowering struct {
+	metricName string
+	matchers   string
+	condition  stmt.Expr
+	offset     int64
+	maxRange   int64
+	fields     map[string]struct{}
+	histogram  bool
+	grouping   *AggregateExpr
+}
+
+// fieldNames returns the sorted field names which query used.
+func (l *lowering) fieldNames() []string {
+	var names []string
+	for name := range l.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lower transforms the PromQL expression to LinDB select item expression.
+func (l *lowering) lower(expr Expr) (stmt.Expr, error) {
+	switch e := expr.(type) {
+	case *NumberLiteral:
+		return &stmt.NumberLiteral{Val: e.Val}, nil
+	case *StringLiteral:
+		return nil, fmt.Errorf("string literal is not supported in expression")
+	case *ParenExpr:
+		inner, err := l.lower(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.ParenExpr{Expr: inner}, nil
+	case *UnaryExpr:
+		inner, err := l.lower(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.BinaryExpr{Left: &stmt.NumberLiteral{Val: 0}, Operator: stmt.SUB, Right: inner}, nil
+	case *VectorSelector:
+		if err := l.selector(e, 0); err != nil {
+			return nil, err
+		}
+		return l.field(), nil
+	case *MatrixSelector:
+		if err := l.selector(e.VectorSelector, e.Range); err != nil {
+			return nil, err
+		}
+		return l.field(), nil
+	case *Call:
+		return l.lowerCall(e)
+	case *AggregateExpr:
+		return l.lowerAggregate(e)
+	case *BinaryExpr:
+		return l.lowerBinary(e)
+	default:
+		return nil, fmt.Errorf("unknown expression type %T", expr)
+	}
+}
+
+// field returns the field expression of prometheus sample value.
+func (l *lowering) field() stmt.Expr {
+	if l.fields == nil {
+		l.fields = make(map[string]struct{})
+	}
+	l.fields[constants.PrometheusValueField] = struct{}{}
+	return &stmt.FieldExpr{Name: constants.PrometheusValueField}
+}
+
+// selector registers the metric and tag filter of vector selector,
+// all selectors in one expression must select the same series.
+func (l *lowering) selector(selector *VectorSelector, rangeVal int64) error {
+	metricName := selector.Name
+	if l.histogram && !strings.HasSuffix(metricName, constants.PrometheusBucketSuffix) {
+		return fmt.Errorf("histogram_quantile requires histogram bucket series with suffix %q, got %q",
+			constants.PrometheusBucketSuffix, metricName)
+	}
+	condition, err := newCondition(selector.Matchers)
+	if err != nil {
+		return err
+	}
+	matchers := ""
+	if condition != nil {
+		matchers = condition.Rewrite()
+	}
+	if l.metricName != "" {
+		if l.metricName != metricName || l.matchers != matchers || l.offset != selector.Offset {
+			return fmt.Errorf("binary operation between different series selectors is not supported")
+		}
+	}
+	l.metricName = metricName
+	l.matchers = matchers
+	l.condition = condition
+	l.offset = selector.Offset
+	if rangeVal > l.maxRange {
+		l.maxRange = rangeVal
+	}
+	return nil
+}
+
+// lowerCall lowers function call onto LinDB function.
+func (l *lowering) lowerCall(call *Call) (stmt.Expr, error) {
+	if call.Func == "histogram_quantile" {
+		return nil, fmt.Errorf("histogram_quantile is only supported as the outermost function")
+	}
+	inner, err := l.lower(call.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	switch call.Func {
+	case "rate":
+		return &stmt.CallExpr{FuncType: function.Rate, Params: []stmt.Expr{inner}}, nil
+	case "irate":
+		return &stmt.CallExpr{FuncType: function.IRate, Params: []stmt.Expr{inner}}, nil
+	case "increase":
+		return &stmt.CallExpr{FuncType: function.Increase, Params: []stmt.Expr{inner}}, nil
+	case "delta":
+		return &stmt.CallExpr{FuncType: function.Delta, Params: []stmt.Expr{inner}}, nil
+	case "sum_over_time":
+		return &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{inner}}, nil
+	case "avg_over_time":
+		return &stmt.CallExpr{FuncType: function.Avg, Params: []stmt.Expr{inner}}, nil
+	case "min_over_time":
+		return &stmt.CallExpr{FuncType: function.Min, Params: []stmt.Expr{inner}}, nil
+	case "max_over_time":
+		return &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{inner}}, nil
+	case "count_over_time":
+		return &stmt.CallExpr{FuncType: function.Count, Params: []stmt.Expr{inner}}, nil
+	case "last_over_time":
+		return inner, nil
+	default:
+		return nil, fmt.Errorf("function %q is not supported", call.Func)
+	}
+}
+
+// lowerHistogramQuantile lowers the bucket series expression of histogram_quantile(φ, bucket_series),
+// bucket series are gauge series with le label(e.g. written by prometheus remote write), the expression is
+// evaluated for each bucket series, then the quantile is calculated from the buckets by query api.
+func (l *lowering) lowerHistogramQuantile(call *Call) (float64, stmt.Expr, error) {
+	phi, err := evalScalar(call.Args[0])
+	if err != nil {
+		return 0, nil, err
+	}
+	if phi <= 0 || phi > 1 {
+		return 0, nil, fmt.Errorf("quantile value should be in range (0, 1], got %v", phi)
+	}
+	// check bucket series expression, bucket series may be wrapped by rate/aggregation
+	expr := call.Args[1]
+	for checked := false; !checked; {
+		switch e := expr.(type) {
+		case *VectorSelector, *MatrixSelector:
+			checked = true
+		case *ParenExpr:
+			expr = e.Expr
+		case *Call:
+			switch e.Func {
+			case "rate", "irate", "increase", "sum_over_time":
+				expr = e.Args[0]
+			default:
+				return 0, nil, fmt.Errorf("function %q is not supported in histogram_quantile", e.Func)
+			}
+		case *AggregateExpr:
+			if e.Op != "sum" {
+				return 0, nil, fmt.Errorf("aggregation %q is not supported in histogram_quantile", e.Op)
+			}
+			expr = e.Expr
+		default:
+			return 0, nil, fmt.Errorf("histogram_quantile requires histogram bucket series")
+		}
+	}
+	l.histogram = true
+	bucketExpr, err := l.lower(call.Args[1])
+	if err != nil {
+		return 0, nil, err
+	}
+	return phi, bucketExpr, nil
+}
+
+// lowerAggregate lowers aggregation, series are aggregated by grouping tag keys,
+// the aggregator is applied on field if the inner expression is a plain selector,
+// else only sum is supported, because the series of derived expression are summed when merging.
+func (l *lowering) lowerAggregate(agg *AggregateExpr) (stmt.Expr, error) {
+	var funcType function.FuncType
+	switch agg.Op {
+	case "sum":
+		funcType = function.Sum
+	case "min":
+		funcType = function.Min
+	case "max":
+		funcType = function.Max
+	case "avg":
+		funcType = function.Avg
+	case "count":
+		funcType = function.Count
+	case "stddev":
+		funcType = function.Stddev
+	default:
+		return nil, fmt.Errorf("aggregation %q is not supported", agg.Op)
+	}
+	if err := l.setGrouping(agg); err != nil {
+		return nil, err
+	}
+	inner, err := l.lower(agg.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := inner.(*stmt.FieldExpr); ok {
+		return &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{inner}}, nil
+	}
+	// derived series(e.g. rate) of same group are summed when merging, other aggregations cannot be applied on them.
+	if funcType != function.Sum {
+		return nil, fmt.Errorf("aggregation %q over derived series is not supported, only sum is supported", agg.Op)
+	}
+	return inner, nil
+}
+
+// setGrouping sets the grouping of aggregation, all aggregations in one expression must use same grouping.
+func (l *lowering) setGrouping(agg *AggregateExpr) error {
+	if l.grouping == nil {
+		l.grouping = agg
+		return nil
+	}
+	if l.grouping.Without != agg.Without ||
+		strings.Join(l.grouping.Grouping, ",") != strings.Join(agg.Grouping, ",") {
+		return fmt.Errorf("aggregations with different grouping labels are not supported")
+	}
+	return nil
+}
+
+// lowerBinary lowers arithmetic binary expression.
+func (l *lowering) lowerBinary(expr *BinaryExpr) (stmt.Expr, error) {
+	var op stmt.BinaryOP
+	switch expr.Op {
+	case "+":
+		op = stmt.ADD
+	case "-":
+		op = stmt.SUB
+	case "*":
+		op = stmt.MUL
+	case "/":
+		op = stmt.DIV
+	default:
+		return nil, fmt.Errorf("binary operator %q is not supported", expr.Op)
+	}
+	left, err := l.lower(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	right, err := l.lower(expr.RHS)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt.BinaryExpr{Left: left, Operator: op, Right: right}, nil
+}
+
+// newCondition builds tag filter condition from label matchers.
+func newCondition(matchers []*LabelMatcher) (stmt.Expr, error) {
+	var condition stmt.Expr
+	for _, matcher := range matchers {
+		if matcher.Name == MetricNameLabel {
+			return nil, fmt.Errorf("only equal matcher is supported for metric name")
+		}
+		var expr stmt.Expr
+		switch matcher.Type {
+		case MatchEqual:
+			expr = &stmt.EqualsExpr{Key: matcher.Name, Value: matcher.Value}
+		case MatchNotEqual:
+			expr = &stmt.NotExpr{Expr: &stmt.EqualsExpr{Key: matcher.Name, Value: matcher.Value}}
+		case MatchRegexp:
+			// prometheus regex is fully anchored
+			expr = &stmt.RegexExpr{Key: matcher.Name, Regexp: "^(?:" + matcher.Value + ")$"}
+		case MatchNotRegexp:
+			expr = &stmt.NotExpr{Expr: &stmt.RegexExpr{Key: matcher.Name, Regexp: "^(?:" + matcher.Value + ")$"}}
+		default:
+			return nil, fmt.Errorf("unknown label matcher type")
+		}
+		if condition == nil {
+			condition = expr
+		} else {
+			condition = &stmt.BinaryExpr{Left: condition, Operator: stmt.AND, Right: expr}
+		}
+	}
+	return condition, nil
+}
+
+// evalScalar evaluates the constant scalar expression.
+func evalScalar(expr Expr) (float64, error) {
+	switch e := expr.(type) {
+	case *NumberLiteral:
+		return e.Val, nil
+	case *ParenExpr:
+		return evalScalar(e.Expr)
+	case *UnaryExpr:
+		val, err := evalScalar(e.Expr)
+		return -val, err
+	case *BinaryExpr:
+		lhs, err := evalScalar(e.LHS)
+		if err != nil {
+			return 0, err
+		}
+		rhs, err := evalScalar(e.RHS)
+		if err != nil {
+			return 0, err
+		}
+		return scalarBinary(e.Op, lhs, rhs)
+	default:
+		return 0, fmt.Errorf("expected scalar expression")
+	}
+}
+
+// scalarBinary calculates the binary operation between two scalars.
+func scalarBinary(op string, lhs, rhs float64) (float64, error) {
+	switch op {
+	case "+":
+		return lhs + rhs, nil
+	case "-":
+		return lhs - rhs, nil
+	case "*":
+		return lhs * rhs, nil
+	case "/":
+		return lhs / rhs, nil
+	case "%":
+		return math.Mod(lhs, rhs), nil
+	case "^":
+		return math.Pow(lhs, rhs), nil
+	case "==", "!=", ">", "<", ">=", "<=":
+		if compare(op, lhs, rhs) {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("binary operator %q is not supported between scalars", op)
+	}
+}
+
+// compare compares two scalars by comparison operator.
+func compare(op string, lhs, rhs float64) bool {
+	switch op {
+	case "==":
+		return lhs == rhs
+	case "!=":
+		return lhs != rhs
+	case ">":
+		return lhs > rhs
+	case "<":
+		return lhs < rhs
+	case ">=":
+		return lhs >= rhs
+	default:
+		return lhs <= rhs
+	}
+}
@@ -0,0 +1,275 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestNewInstantQueryPlan(t *testing.T) {
+	now := timeutil.Now()
+	expr, _ := Parse(`cpu{host="a",ip!="b",zone=~"z.*",region!~"r"} offset 1m`)
+	plan, err := NewInstantQueryPlan(expr, "", now)
+	assert.NoError(t, err)
+	assert.True(t, plan.ExpandGroupBy)
+	assert.True(t, plan.KeepMetricName)
+	assert.Equal(t, timeutil.OneMinute, plan.Offset)
+	assert.Equal(t, constants.DefaultNamespace, plan.Query.Namespace)
+	assert.Equal(t, "cpu", plan.Query.MetricName)
+	assert.Equal(t, []string{constants.PrometheusValueField}, plan.Query.FieldNames)
+	assert.Equal(t, timeutil.TimeRange{
+		Start: now - defaultLookback - timeutil.OneMinute,
+		End:   now - timeutil.OneMinute,
+	}, plan.Query.TimeRange)
+	assert.Equal(t, timeutil.Interval(0), plan.Query.Interval)
+	assert.Equal(t, "host=aandnot ip=bandzone=~^(?:z.*)$andnot region=~^(?:r)$",
+		plan.Query.Condition.Rewrite())
+	assert.Equal(t, "value as value", plan.Query.SelectItems[0].Rewrite())
+
+	expr, _ = Parse("rate(cpu[2m])")
+	plan, err = NewInstantQueryPlan(expr, "ns", now)
+	assert.NoError(t, err)
+	assert.True(t, plan.ExpandGroupBy)
+	assert.False(t, plan.KeepMetricName)
+	assert.Equal(t, "ns", plan.Query.Namespace)
+	assert.Equal(t, timeutil.TimeRange{Start: now - 2*timeutil.OneMinute, End: now}, plan.Query.TimeRange)
+	assert.Equal(t, timeutil.Interval(2*timeutil.OneMinute), plan.Query.Interval)
+	assert.Equal(t, "rate(value) as value", plan.Query.SelectItems[0].Rewrite())
+	assert.Nil(t, plan.Query.Condition)
+}
+
+func TestNewInstantQueryPlan_scalar(t *testing.T) {
+	expr, _ := Parse("(1 + 2) * 3 - -1")
+	plan, err := NewInstantQueryPlan(expr, "", timeutil.Now())
+	assert.NoError(t, err)
+	assert.True(t, plan.IsScalar)
+	assert.Equal(t, 10.0, plan.Scalar)
+	assert.Nil(t, plan.Query)
+
+	cases := []struct {
+		input string
+		val   float64
+	}{
+		{input: "7 % 4", val: 3},
+		{input: "2 ^ 3", val: 8},
+		{input: "1 / 2", val: 0.5},
+		{input: "1 == bool 1", val: 1},
+		{input: "1 != bool 1", val: 0},
+		{input: "1 > bool 2", val: 0},
+		{input: "1 < bool 2", val: 1},
+		{input: "1 >= bool 2", val: 0},
+		{input: "1 <= bool 2", val: 1},
+	}
+	for _, c := range cases {
+		expr, err = Parse(c.input)
+		assert.NoError(t, err, c.input)
+		plan, err = NewInstantQueryPlan(expr, "", timeutil.Now())
+		assert.NoError(t, err, c.input)
+		assert.Equal(t, c.val, plan.Scalar, c.input)
+	}
+
+	expr, _ = Parse("1 or 2")
+	_, err = NewInstantQueryPlan(expr, "", timeutil.Now())
+	assert.Error(t, err)
+	expr, _ = Parse("(1 or 2) + 1")
+	_, err = NewInstantQueryPlan(expr, "", timeutil.Now())
+	assert.Error(t, err)
+	expr, _ = Parse("1 + (1 or 2)")
+	_, err = NewInstantQueryPlan(expr, "", timeutil.Now())
+	assert.Error(t, err)
+	_, err = evalScalar(&VectorSelector{Name: "cpu"})
+	assert.Error(t, err)
+}
+
+func TestNewRangeQueryPlan(t *testing.T) {
+	expr, _ := Parse("sum by (host) (rate(cpu[5m])) * 100")
+	plan, err := NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.False(t, plan.ExpandGroupBy)
+	assert.False(t, plan.KeepMetricName)
+	assert.Equal(t, []string{"host"}, plan.Query.GroupBy)
+	assert.Equal(t, timeutil.TimeRange{Start: 1000, End: 61000}, plan.Query.TimeRange)
+	assert.Equal(t, timeutil.Interval(10000), plan.Query.Interval)
+	assert.Equal(t, "rate(value)*100.00 as value", plan.Query.SelectItems[0].Rewrite())
+
+	// only sum can be applied on derived series
+	for _, input := range []string{
+		"max(rate(cpu[5m]))",
+		"avg by (host) (rate(cpu[5m]))",
+		"min(increase(cpu[5m]))",
+		"count(rate(cpu[5m]))",
+		"stddev(rate(cpu[5m]))",
+		"max(cpu * 2)",
+	} {
+		expr, _ = Parse(input)
+		_, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+		assert.Error(t, err, input)
+	}
+
+	expr, _ = Parse("max(cpu) without (host) / min(cpu) without (host)")
+	plan, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.True(t, plan.ExpandGroupBy)
+	assert.Equal(t, []string{"host"}, plan.ExcludeTagKeys)
+	assert.Nil(t, plan.Query.GroupBy)
+	assert.Equal(t, "max(value)/min(value) as value", plan.Query.SelectItems[0].Rewrite())
+
+	expr, _ = Parse("-cpu")
+	plan, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.00-value as value", plan.Query.SelectItems[0].Rewrite())
+
+	expr, _ = Parse("(cpu)")
+	plan, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.True(t, plan.KeepMetricName)
+	assert.Equal(t, "(value) as value", plan.Query.SelectItems[0].Rewrite())
+
+	_, err = NewRangeQueryPlan(expr, "", 1000, 61000, 0)
+	assert.Error(t, err)
+	_, err = NewRangeQueryPlan(expr, "", 61000, 1000, 1000)
+	assert.Error(t, err)
+}
+
+func TestNewQueryPlan_functions(t *testing.T) {
+	cases := []struct {
+		input    string
+		funcType function.FuncType
+	}{
+		{input: "irate(cpu[1m])", funcType: function.IRate},
+		{input: "increase(cpu[1m])", funcType: function.Increase},
+		{input: "delta(cpu[1m])", funcType: function.Delta},
+		{input: "sum_over_time(cpu[1m])", funcType: function.Sum},
+		{input: "avg_over_time(cpu[1m])", funcType: function.Avg},
+		{input: "min_over_time(cpu[1m])", funcType: function.Min},
+		{input: "max_over_time(cpu[1m])", funcType: function.Max},
+		{input: "count_over_time(cpu[1m])", funcType: function.Count},
+		{input: "sum(cpu)", funcType: function.Sum},
+		{input: "min(cpu)", funcType: function.Min},
+		{input: "max(cpu)", funcType: function.Max},
+		{input: "avg(cpu)", funcType: function.Avg},
+		{input: "count(cpu)", funcType: function.Count},
+		{input: "stddev(cpu)", funcType: function.Stddev},
+	}
+	for _, c := range cases {
+		expr, err := Parse(c.input)
+		assert.NoError(t, err, c.input)
+		plan, err := NewInstantQueryPlan(expr, "", timeutil.Now())
+		assert.NoError(t, err, c.input)
+		item := plan.Query.SelectItems[0].(*stmt.SelectItem)
+		assert.Equal(t, c.funcType, item.Expr.(*stmt.CallExpr).FuncType, c.input)
+	}
+	expr, _ := Parse("last_over_time(cpu[1m])")
+	plan, err := NewInstantQueryPlan(expr, "", timeutil.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "value as value", plan.Query.SelectItems[0].Rewrite())
+}
+
+func TestNewQueryPlan_histogramQuantile(t *testing.T) {
+	expr, _ := Parse("histogram_quantile(0.99, sum by (le, host) (rate(latency_bucket{host=\"a\"}[1m])))")
+	plan, err := NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.True(t, plan.HistogramQuantile)
+	assert.Equal(t, 0.99, plan.Quantile)
+	assert.Equal(t, "latency_bucket", plan.Query.MetricName)
+	assert.Equal(t, []string{"le", "host"}, plan.Query.GroupBy)
+	assert.Equal(t, []string{constants.PrometheusValueField}, plan.Query.FieldNames)
+	assert.Equal(t, "rate(value) as value", plan.Query.SelectItems[0].Rewrite())
+
+	// le label is added into grouping
+	expr, _ = Parse("histogram_quantile(0.5, sum by (host) (increase(latency_bucket[1m])))")
+	plan, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"host", "le"}, plan.Query.GroupBy)
+	assert.Equal(t, "increase(value) as value", plan.Query.SelectItems[0].Rewrite())
+
+	expr, _ = Parse("(histogram_quantile(0.9, (latency_bucket)))")
+	plan, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.True(t, plan.HistogramQuantile)
+	assert.True(t, plan.ExpandGroupBy)
+	assert.False(t, plan.KeepMetricName)
+	assert.Empty(t, plan.ExcludeTagKeys)
+
+	expr, _ = Parse("histogram_quantile(0.9, sum without (host, le) (latency_bucket))")
+	plan, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+	assert.NoError(t, err)
+	assert.True(t, plan.ExpandGroupBy)
+	assert.Equal(t, []string{"host"}, plan.ExcludeTagKeys)
+	assert.Equal(t, "sum(value) as value", plan.Query.SelectItems[0].Rewrite())
+
+	cases := []string{
+		"histogram_quantile(2, latency_bucket)",
+		"histogram_quantile(1 or 2, latency_bucket)",
+		"histogram_quantile(0.9, latency)",
+		"histogram_quantile(0.9, avg_over_time(latency_bucket[1m]))",
+		"histogram_quantile(0.9, max(latency_bucket))",
+		"histogram_quantile(0.9, latency_bucket * 2)",
+		`histogram_quantile(0.9, latency_bucket{__name__=~"a"})`,
+		"histogram_quantile(0.9, sum by (le) (latency_bucket)) / sum by (host) (latency_count)",
+		"histogram_quantile(0.9, rate(latency_bucket[1m])) * 1000",
+	}
+	for _, c := range cases {
+		expr, err = Parse(c)
+		assert.NoError(t, err, c)
+		_, err = NewRangeQueryPlan(expr, "", 1000, 61000, 10000)
+		assert.Error(t, err, c)
+	}
+}
+
+func TestNewQueryPlan_fail(t *testing.T) {
+	cases := []string{
+		`"abc"`,
+		"cpu[5m]",
+		`cpu + "a"`,
+		"cpu / mem",
+		`cpu / cpu{host="a"}`,
+		"cpu / cpu offset 1m",
+		"cpu % 2",
+		"cpu > 1",
+		"cpu or cpu",
+		"topk(5, cpu)",
+		"sum by (host) (cpu) / sum(cpu)",
+		`cpu{__name__=~"c.*"}`,
+		"-(cpu or cpu)",
+		"(cpu or cpu)",
+		"sum(cpu or cpu)",
+		"rate(mem[1m]) + (cpu or cpu)",
+		"rate(mem[1m]) + cpu",
+	}
+	for _, c := range cases {
+		expr, err := Parse(c)
+		assert.NoError(t, err, c)
+		plan, err := NewInstantQueryPlan(expr, "", timeutil.Now())
+		assert.Error(t, err, c)
+		assert.Nil(t, plan, c)
+	}
+	_, err := (&lowering{}).lower(&Call{Func: "unknown", Args: []Expr{&VectorSelector{Name: "cpu"}}})
+	assert.Error(t, err)
+	_, err = (&lowering{}).lower(nil)
+	assert.Error(t, err)
+	_, err = newCondition([]*LabelMatcher{{Name: "host"}})
+	assert.Error(t, err)
+}
@@ -31,20 +31,43 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 )
 
+// queryClause represents the clause of query statement which is parsing
+type queryClause int
+
+const (
+	selectClause queryClause = iota
+	havingClause
+	orderByClause
+)
+
 // queryStmtParser represents query statement parser using visitor
 type queryStmtParser struct {
 	baseStmtParser
 	explain bool
+	clause  queryClause
+
+	metricNames []string
+	subQuery    *stmt.Query
 
 	selectItems []stmt.Expr
 	fieldNames  map[string]struct{}
 
 	startTime int64
 	endTime   int64
+	// time strings of time range, which are parsed in session time zone after parsing whole statement
+	startTimeStr string
+	endTimeStr   string
+	timeZone     string
 
-	groupBy  []string
-	interval int64
-	fieldID  int
+	groupBy           []string
+	groupByTransforms []*stmt.TagTransform
+	interval          int64
+	fieldID           int
+	fill              stmt.FillType
+	fillValue         float64
+	having            stmt.Expr
+	orderBy           []stmt.Expr
+	withExemplars     bool
 }
 
 // newQueryStmtParse create a query statement parser
@@ -66,11 +89,18 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	if err := q.validation(); err != nil {
 		return nil, err
 	}
+	if err := q.parseTimeStr(); err != nil {
+		return nil, err
+	}
 
 	query := &stmt.Query{}
 	query.Explain = q.explain
 	query.Namespace = q.namespace
 	query.MetricName = q.metricName
+	if len(q.metricNames) > 1 {
+		query.MetricNames = q.metricNames
+	}
+	query.SubQuery = q.subQuery
 	query.SelectItems = q.selectItems
 	query.Condition = q.condition
 
@@ -84,6 +114,40 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 		return fieldNames[i] < fieldNames[j]
 	})
 	query.FieldNames = fieldNames
+	if err := q.validateJoin(query); err != nil {
+		return nil, err
+	}
+	if err := q.validateResultRefs(); err != nil {
+		return nil, err
+	}
+	if q.withExemplars && (q.subQuery != nil || query.IsJoin()) {
+		return nil, fmt.Errorf("with exemplars is not supported in subquery or cross-metric query")
+	}
+
+	if q.subQuery != nil {
+		if err := q.validateSubQuery(query); err != nil {
+			return nil, err
+		}
+		// outer query aggregates the result of subquery, which has the same time range and interval
+		query.Namespace = q.subQuery.Namespace
+		query.TimeRange = q.subQuery.TimeRange
+		query.Interval = q.subQuery.Interval
+		query.TimeZone = q.subQuery.TimeZone
+		query.GroupBy = q.groupBy
+		query.GroupByTransforms = q.groupByTransforms
+		query.Fill = q.fill
+		query.FillValue = q.fillValue
+		query.Having = q.having
+		query.OrderBy = q.orderBy
+		query.Limit = q.limit
+		return query, nil
+	}
+
+	for _, item := range query.SelectItems {
+		if err := validateHistogramFunc(item); err != nil {
+			return nil, err
+		}
+	}
 
 	now := timeutil.Now()
 	query.TimeRange = timeutil.TimeRange{Start: q.startTime, End: q.endTime}
@@ -98,8 +162,15 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	}
 
 	query.Interval = timeutil.Interval(q.interval)
+	query.TimeZone = q.timeZone
 	query.GroupBy = q.groupBy
+	query.GroupByTransforms = q.groupByTransforms
+	query.Fill = q.fill
+	query.FillValue = q.fillValue
+	query.Having = q.having
+	query.OrderBy = q.orderBy
 	query.Limit = q.limit
+	query.WithExemplars = q.withExemplars
 	return query, nil
 }
 
@@ -108,7 +179,7 @@ func (q *queryStmtParser) validation() error {
 	if q.err != nil {
 		return q.err
 	}
-	if q.metricName == "" {
+	if q.metricName == "" && q.subQuery == nil {
 		return fmt.Errorf("metric name cannot be empty")
 	}
 	if len(q.selectItems) == 0 {
@@ -117,22 +188,374 @@ func (q *queryStmtParser) validation() error {
 	return nil
 }
 
+// validateJoin validates the fields of cross-metric query,
+// each field needs to be referenced with metric name, and each metric needs to be referenced by field.
+func (q *queryStmtParser) validateJoin(query *stmt.Query) error {
+	if !query.IsJoin() {
+		return nil
+	}
+	referenced := make(map[string]struct{})
+	for _, fieldName := range query.FieldNames {
+		metricName, _, ok := query.SplitJoinField(fieldName)
+		if !ok {
+			return fmt.Errorf("field: %s need be referenced with metric name in cross-metric query", fieldName)
+		}
+		referenced[metricName] = struct{}{}
+	}
+	for _, metricName := range query.MetricNames {
+		if _, ok := referenced[metricName]; !ok {
+			return fmt.Errorf("metric: %s is not referenced by select fields", metricName)
+		}
+	}
+	return nil
+}
+
+// validateSubQuery validates the outer query which aggregates the result of subquery,
+// the fields/group by tag keys of outer query need be in the result of subquery.
+func (q *queryStmtParser) validateSubQuery(query *stmt.Query) error {
+	if q.condition != nil || q.startTime > 0 || q.endTime > 0 || q.interval > 0 || q.timeZone != "" {
+		return fmt.Errorf("time range, interval, time zone and where condition need be specified in subquery")
+	}
+	if len(q.groupByTransforms) > 0 {
+		return fmt.Errorf("tag transform of group by need be specified in subquery")
+	}
+	subQuery := q.subQuery
+	resultFields := make(map[string]struct{})
+	for _, item := range subQuery.SelectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if ok && selectItem.Alias != "" {
+			resultFields[selectItem.Alias] = struct{}{}
+		} else {
+			resultFields[item.Rewrite()] = struct{}{}
+		}
+	}
+	for _, fieldName := range query.FieldNames {
+		if _, ok := resultFields[fieldName]; !ok {
+			return fmt.Errorf("field: %s not found in the result of subquery", fieldName)
+		}
+	}
+	for _, tagKey := range q.groupBy {
+		found := false
+		for _, key := range subQuery.GroupBy {
+			if key == tagKey {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("group by tag key: %s not found in the group by of subquery", tagKey)
+		}
+	}
+	return nil
+}
+
+// validateResultRefs validates the references of having/order by clause,
+// which need be the result of select items or the aggregation of them, like having max(f) > 1 order by f.
+func (q *queryStmtParser) validateResultRefs() error {
+	resultFields := make(map[string]struct{})
+	for _, item := range q.selectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if ok && selectItem.Alias != "" {
+			resultFields[selectItem.Alias] = struct{}{}
+		} else {
+			resultFields[item.Rewrite()] = struct{}{}
+		}
+	}
+	if q.having != nil {
+		if err := validateResultRef("having", q.having, resultFields); err != nil {
+			return err
+		}
+	}
+	for _, item := range q.orderBy {
+		orderBy, ok := item.(*stmt.OrderByExpr)
+		if !ok {
+			continue
+		}
+		if err := validateResultRef("order by", orderBy.Expr, resultFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateResultRef validates the expression of having/order by clause,
+// which is evaluated to scalar value by the result of select items.
+func validateResultRef(clause string, expr stmt.Expr, resultFields map[string]struct{}) error {
+	if _, ok := resultFields[expr.Rewrite()]; ok {
+		return nil
+	}
+	switch e := expr.(type) {
+	case *stmt.NumberLiteral:
+		return nil
+	case *stmt.ParenExpr:
+		return validateResultRef(clause, e.Expr, resultFields)
+	case *stmt.BinaryExpr:
+		if err := validateResultRef(clause, e.Left, resultFields); err != nil {
+			return err
+		}
+		return validateResultRef(clause, e.Right, resultFields)
+	case *stmt.CallExpr:
+		switch e.FuncType {
+		case function.Sum, function.Min, function.Max, function.Count, function.Avg, function.LastValue:
+		default:
+			return fmt.Errorf("function: %s not support in %s clause", e.FuncType, clause)
+		}
+		if len(e.Params) != 1 {
+			return fmt.Errorf("function: %s need one param in %s clause", e.FuncType, clause)
+		}
+		if _, ok := resultFields[e.Params[0].Rewrite()]; !ok {
+			return fmt.Errorf("field: %s of %s clause not found in select list", e.Params[0].Rewrite(), clause)
+		}
+		return nil
+	default:
+		return fmt.Errorf("field: %s of %s clause not found in select list", expr.Rewrite(), clause)
+	}
+}
+
+// validateHistogramFunc validates the functions calculated by histogram fields of metric(e.g. quantile/histogram_fraction),
+// which cannot be applied on the field of select list(only supported by the query of subquery result).
+func validateHistogramFunc(expr stmt.Expr) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return validateHistogramFunc(e.Expr)
+	case *stmt.ParenExpr:
+		return validateHistogramFunc(e.Expr)
+	case *stmt.BinaryExpr:
+		if err := validateHistogramFunc(e.Left); err != nil {
+			return err
+		}
+		return validateHistogramFunc(e.Right)
+	case *stmt.CallExpr:
+		switch e.FuncType {
+		case function.HistogramCount, function.HistogramSum, function.HistogramAvg, function.Heatmap:
+			if len(e.Params) > 0 {
+				return fmt.Errorf("%s cannot be applied on field: %s, "+
+					"%s() is calculated by histogram fields of metric", e.FuncType, e.Params[0].Rewrite(), e.FuncType)
+			}
+		case function.Quantile, function.HistogramFraction:
+			if len(e.Params) != 1 {
+				return fmt.Errorf("%s only accepts one number argument, "+
+					"%s(value) is calculated by histogram fields of metric", e.FuncType, e.FuncType)
+			}
+			if _, ok := e.Params[0].(*stmt.NumberLiteral); !ok {
+				return fmt.Errorf("%s cannot be applied on field: %s, "+
+					"%s(value) is calculated by histogram fields of metric", e.FuncType, e.Params[0].Rewrite(), e.FuncType)
+			}
+		}
+		for _, param := range e.Params {
+			if err := validateHistogramFunc(param); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // resetExprStack resets expr stack for next parse fragment
 func (q *queryStmtParser) resetExprStack() {
 	q.exprStack = collections.NewStack()
 }
 
+// visitMetricName visits when production metricName expression is entered,
+// query statement may reference multiple metrics, like select a.f/b.f from a, b.
+func (q *queryStmtParser) visitMetricName(ctx *grammar.MetricNameContext) {
+	metricName := strutil.GetStringValue(ctx.Ident().GetText())
+	for _, m := range q.metricNames {
+		if m == metricName {
+			q.err = fmt.Errorf("metric: %s is duplicated in from clause", metricName)
+			return
+		}
+	}
+	if q.metricName == "" {
+		q.metricName = metricName
+	}
+	q.metricNames = append(q.metricNames, metricName)
+}
+
+// completeSubQuery completes the subquery of from clause, like select max(f) from (select avg(f) as f from a)
+func (q *queryStmtParser) completeSubQuery(subQueryStmt *queryStmtParser) {
+	subQuery, err := subQueryStmt.build()
+	if err != nil {
+		q.err = err
+		return
+	}
+	if subQueryStmt.withExemplars {
+		q.err = fmt.Errorf("with exemplars is not supported in subquery or cross-metric query")
+		return
+	}
+	q.subQuery = subQuery.(*stmt.Query)
+}
+
+// visitFillOption visits when production fill option expression is entered
+func (q *queryStmtParser) visitFillOption(ctx *grammar.FillOptionContext) {
+	switch {
+	case ctx.T_PREVIOUS() != nil:
+		q.fill = stmt.FillPrevious
+	case ctx.L_INT() != nil || ctx.L_DEC() != nil:
+		val, err := strconv.ParseFloat(ctx.GetText(), 64)
+		if err != nil {
+			q.err = err
+			return
+		}
+		q.fill = stmt.FillValue
+		q.fillValue = val
+	default:
+		// fill(null) keeps the empty slots
+		q.fill = stmt.FillNone
+	}
+}
+
+// enterClause enters a new clause which has field expressions, resets expr stack for it
+func (q *queryStmtParser) enterClause(clause queryClause) {
+	q.clause = clause
+	q.resetExprStack()
+}
+
+// visitBoolExpr visits when production bool expression of having clause is entered
+func (q *queryStmtParser) visitBoolExpr(ctx *grammar.BoolExprContext) {
+	switch {
+	case ctx.T_OPEN_P() != nil:
+		q.exprStack.Push(&stmt.ParenExpr{})
+	case ctx.BoolExprLogicalOp() != nil:
+		logicalOp, ok := ctx.BoolExprLogicalOp().(*grammar.BoolExprLogicalOpContext)
+		if !ok {
+			return
+		}
+		if logicalOp.T_AND() != nil {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.AND})
+		} else {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.OR})
+		}
+	}
+}
+
+// completeBoolExpr completes a bool expression of having clause
+func (q *queryStmtParser) completeBoolExpr(ctx *grammar.BoolExprContext) {
+	if ctx.T_OPEN_P() != nil || ctx.BoolExprLogicalOp() != nil {
+		q.completeExpr()
+	}
+}
+
+// visitBinaryExpr visits when production binary(compare) expression of having clause is entered
+func (q *queryStmtParser) visitBinaryExpr(ctx *grammar.BinaryExprContext) {
+	op, ok := ctx.BinaryOperator().(*grammar.BinaryOperatorContext)
+	if !ok {
+		return
+	}
+	var operator stmt.BinaryOP
+	switch {
+	case op.T_EQUAL() != nil:
+		operator = stmt.EQUAL
+	case op.T_NOTEQUAL() != nil || op.T_NOTEQUAL2() != nil:
+		operator = stmt.NOTEQUAL
+	case op.T_LESS() != nil:
+		operator = stmt.LESS
+	case op.T_LESSEQUAL() != nil:
+		operator = stmt.LESSEQUAL
+	case op.T_GREATER() != nil:
+		operator = stmt.GREATER
+	case op.T_GREATEREQUAL() != nil:
+		operator = stmt.GREATEREQUAL
+	default:
+		q.err = fmt.Errorf("not support operator: %s in having clause", op.GetText())
+		operator = stmt.UNKNOWN
+	}
+	q.exprStack.Push(&stmt.BinaryExpr{Operator: operator})
+}
+
+// completeSortField completes a sort field of order by clause, sets sort direction
+func (q *queryStmtParser) completeSortField(ctx *grammar.SortFieldContext) {
+	if len(q.orderBy) == 0 {
+		return
+	}
+	orderBy, ok := q.orderBy[len(q.orderBy)-1].(*stmt.OrderByExpr)
+	if ok {
+		orderBy.Desc = len(ctx.AllT_DESC()) > 0
+	}
+}
+
+// completeExpr completes the expression in the top of expr stack,
+// sets it as param of parent expression or as root expression of current clause.
+func (q *queryStmtParser) completeExpr() {
+	cur := q.exprStack.Pop()
+	if cur == nil {
+		return
+	}
+	expr, ok := cur.(stmt.Expr)
+	if !ok {
+		return
+	}
+	if q.exprStack.Empty() {
+		q.completeRootExpr(expr)
+	} else {
+		q.setExprParam(expr)
+	}
+}
+
+// completeRootExpr completes a root expression of current clause
+func (q *queryStmtParser) completeRootExpr(expr stmt.Expr) {
+	switch q.clause {
+	case havingClause:
+		q.having = expr
+	case orderByClause:
+		q.orderBy = append(q.orderBy, &stmt.OrderByExpr{Expr: expr})
+	default:
+		q.selectItems = append(q.selectItems, &stmt.SelectItem{Expr: expr})
+	}
+}
+
 // visitGroupByKey visits when production groupBy key expression is entered
 func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	switch {
 	case ctx.Ident() != nil:
 		tagKey := strutil.GetStringValue(ctx.Ident().GetText())
+		for _, transform := range q.groupByTransforms {
+			if transform.Name == tagKey {
+				q.err = fmt.Errorf("duplicate group by tag: %s, need alias for tag transform", tagKey)
+				return
+			}
+		}
 		q.groupBy = append(q.groupBy, tagKey)
 	case ctx.DurationLit() != nil:
 		q.interval = q.parseDuration(ctx.DurationLit())
+	case ctx.TagTransformExpr() != nil:
+		transform := q.parseTagTransform(ctx.TagTransformExpr().(*grammar.TagTransformExprContext))
+		if alias := ctx.Alias(); alias != nil {
+			transform.Name = strutil.GetStringValue(alias.(*grammar.AliasContext).Ident().GetText())
+		}
+		if _, err := transform.Compile(); err != nil {
+			q.err = err
+			return
+		}
+		for _, groupTag := range q.groupBy {
+			if groupTag == transform.Name {
+				q.err = fmt.Errorf("duplicate group by tag: %s, need alias for tag transform", groupTag)
+				return
+			}
+		}
+		q.groupBy = append(q.groupBy, transform.Name)
+		q.groupByTransforms = append(q.groupByTransforms, transform)
 	}
 }
 
+// parseTagTransform parses the tag transform of group by, like regex_extract(host, '^(\w+)-')
+// or label_replace(host, '^(\w+)-.*$', '$1').
+func (q *queryStmtParser) parseTagTransform(ctx *grammar.TagTransformExprContext) *stmt.TagTransform {
+	tagKey := strutil.GetStringValue(ctx.TagKey().GetText())
+	transform := &stmt.TagTransform{
+		Name:   tagKey,
+		TagKey: tagKey,
+		Regex:  strutil.GetStringValue(ctx.Ident(0).GetText()),
+	}
+	if ctx.T_LABEL_REPLACE() != nil {
+		transform.Type = stmt.LabelReplace
+		transform.Replacement = strutil.GetStringValue(ctx.Ident(1).GetText())
+	} else {
+		transform.Type = stmt.RegexExtract
+	}
+	return transform
+}
+
 // visitTimeRangeExpr visits when production timeRange expression is entered
 func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
 	timeExprCtxList := ctx.AllTimeExpr()
@@ -142,10 +565,15 @@ func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext)
 			continue
 		}
 		var timestamp int64
+		var timeStr string
 		var err error
 		switch {
 		case timeExprCtx.Ident() != nil:
-			timestamp, err = timeutil.ParseTimestamp(strutil.GetStringValue(timeExprCtx.Ident().GetText()))
+			// time zone clause is after where clause, so parse time string in session time zone later
+			timeStr = strutil.GetStringValue(timeExprCtx.Ident().GetText())
+		case timeExprCtx.IntNumber() != nil:
+			// epoch millisecond
+			timestamp, err = strconv.ParseInt(timeExprCtx.IntNumber().GetText(), 10, 64)
 		case timeExprCtx.NowExpr() != nil:
 			timestamp = timeutil.Now()
 			durationExpr, durationExist := timeExprCtx.NowExpr().(*grammar.NowExprContext)
@@ -167,13 +595,49 @@ func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext)
 		}
 		if binaryOpCtx.T_GREATER() != nil || binaryOpCtx.T_GREATEREQUAL() != nil {
 			q.startTime = timestamp
+			q.startTimeStr = timeStr
 		}
 		if binaryOpCtx.T_LESS() != nil || binaryOpCtx.T_LESSEQUAL() != nil {
 			q.endTime = timestamp
+			q.endTimeStr = timeStr
 		}
 	}
 }
 
+// visitTimeZone visits when production time zone clause is entered, like tz('Asia/Shanghai') or tz('+08:00')
+func (q *queryStmtParser) visitTimeZone(ctx *grammar.TimeZoneClauseContext) {
+	timeZone := strutil.GetStringValue(ctx.Ident().GetText())
+	if _, err := timeutil.LoadLocation(timeZone); err != nil {
+		q.err = err
+		return
+	}
+	q.timeZone = timeZone
+}
+
+// visitWithExemplars visits when production with exemplars clause is entered.
+func (q *queryStmtParser) visitWithExemplars() {
+	q.withExemplars = true
+}
+
+// parseTimeStr parses the time strings of time range in session time zone, local zone if time zone not set.
+func (q *queryStmtParser) parseTimeStr() error {
+	location, err := timeutil.LoadLocation(q.timeZone)
+	if err != nil {
+		return err
+	}
+	if q.startTimeStr != "" {
+		if q.startTime, err = timeutil.ParseTimestampInLocation(q.startTimeStr, location); err != nil {
+			return err
+		}
+	}
+	if q.endTimeStr != "" {
+		if q.endTime, err = timeutil.ParseTimestampInLocation(q.endTimeStr, location); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // parseDuration parses time duration from duration string
 func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
 	if ctx == nil {
@@ -220,7 +684,12 @@ func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
 func (q *queryStmtParser) visitFieldExpr(ctx *grammar.FieldExprContext) {
 	switch {
 	case ctx.ExprFunc() != nil:
-		q.exprStack.Push(&stmt.CallExpr{})
+		callExpr := &stmt.CallExpr{}
+		if exprFunc, ok := ctx.ExprFunc().(*grammar.ExprFuncContext); ok && exprFunc.T_IF() != nil {
+			// if(cond, a, b) has no function name
+			callExpr.FuncType = function.If
+		}
+		q.exprStack.Push(callExpr)
 	case ctx.T_OPEN_P() != nil:
 		q.exprStack.Push(&stmt.ParenExpr{})
 	case ctx.T_MUL() != nil:
@@ -231,11 +700,20 @@ func (q *queryStmtParser) visitFieldExpr(ctx *grammar.FieldExprContext) {
 		q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.ADD})
 	case ctx.T_SUB() != nil:
 		q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.SUB})
+	case ctx.DurationLit() != nil:
+		// duration only used as function param, e.g. time_shift(f, 1d)
+		if !q.exprStack.Empty() {
+			q.setExprParam(&stmt.DurationLiteral{Val: timeutil.Interval(q.parseDuration(ctx.DurationLit()))})
+		}
 	}
 }
 
 // visitAlias visits when production alias expression is entered
 func (q *queryStmtParser) visitAlias(ctx *grammar.AliasContext) {
+	if _, ok := ctx.GetParent().(*grammar.GroupByKeyContext); ok {
+		// alias of tag transform, parsed with group by key
+		return
+	}
 	if len(q.selectItems) == 0 {
 		return
 	}
@@ -271,6 +749,66 @@ func (q *queryStmtParser) visitFuncName(ctx *grammar.FuncNameContext) {
 		callExpr.FuncType = function.Quantile
 	case ctx.T_RATE() != nil:
 		callExpr.FuncType = function.Rate
+	case ctx.T_VARIANCE() != nil:
+		callExpr.FuncType = function.Variance
+	case ctx.T_FIRST_VALUE() != nil:
+		callExpr.FuncType = function.FirstValue
+	case ctx.T_LAST_VALUE() != nil:
+		callExpr.FuncType = function.LastValue
+	case ctx.T_MEDIAN() != nil:
+		callExpr.FuncType = function.Median
+	case ctx.T_IRATE() != nil:
+		callExpr.FuncType = function.IRate
+	case ctx.T_INCREASE() != nil:
+		callExpr.FuncType = function.Increase
+	case ctx.T_DERIVATIVE() != nil:
+		callExpr.FuncType = function.Derivative
+	case ctx.T_DELTA() != nil:
+		callExpr.FuncType = function.Delta
+	case ctx.T_MOVING_AVG() != nil:
+		callExpr.FuncType = function.MovingAvg
+	case ctx.T_MOVING_SUM() != nil:
+		callExpr.FuncType = function.MovingSum
+	case ctx.T_CUMULATIVE_SUM() != nil:
+		callExpr.FuncType = function.CumulativeSum
+	case ctx.T_EWMA() != nil:
+		callExpr.FuncType = function.Ewma
+	case ctx.T_TIME_SHIFT() != nil:
+		callExpr.FuncType = function.TimeShift
+	case ctx.T_DIFF() != nil:
+		callExpr.FuncType = function.Diff
+	case ctx.T_ABS() != nil:
+		callExpr.FuncType = function.Abs
+	case ctx.T_CEIL() != nil:
+		callExpr.FuncType = function.Ceil
+	case ctx.T_FLOOR() != nil:
+		callExpr.FuncType = function.Floor
+	case ctx.T_ROUND() != nil:
+		callExpr.FuncType = function.Round
+	case ctx.T_LOG() != nil:
+		callExpr.FuncType = function.Log
+	case ctx.T_POW() != nil:
+		callExpr.FuncType = function.Pow
+	case ctx.T_SQRT() != nil:
+		callExpr.FuncType = function.Sqrt
+	case ctx.T_CLAMP_MIN() != nil:
+		callExpr.FuncType = function.ClampMin
+	case ctx.T_CLAMP_MAX() != nil:
+		callExpr.FuncType = function.ClampMax
+	case ctx.T_HISTOGRAM_COUNT() != nil:
+		callExpr.FuncType = function.HistogramCount
+	case ctx.T_HISTOGRAM_SUM() != nil:
+		callExpr.FuncType = function.HistogramSum
+	case ctx.T_HISTOGRAM_AVG() != nil:
+		callExpr.FuncType = function.HistogramAvg
+	case ctx.T_HISTOGRAM_FRACTION() != nil:
+		callExpr.FuncType = function.HistogramFraction
+	case ctx.T_HEATMAP() != nil:
+		callExpr.FuncType = function.Heatmap
+	case ctx.T_LAST() != nil:
+		callExpr.FuncType = function.LastValue
+	case ctx.T_COUNT_DISTINCT() != nil:
+		callExpr.FuncType = function.CountDistinct
 	}
 }
 
@@ -283,7 +821,7 @@ func (q *queryStmtParser) completeFuncExpr() {
 			q.setExprParam(expr)
 		}
 		if q.exprStack.Empty() {
-			q.selectItems = append(q.selectItems, &stmt.SelectItem{Expr: expr})
+			q.completeRootExpr(expr)
 		}
 	}
 }
@@ -294,11 +832,14 @@ func (q *queryStmtParser) visitExprAtom(ctx *grammar.ExprAtomContext) {
 	case ctx.Ident() != nil:
 		val := strutil.GetStringValue(ctx.Ident().GetText())
 		if q.exprStack.Empty() {
-			q.selectItems = append(q.selectItems, &stmt.SelectItem{Expr: &stmt.FieldExpr{Name: val}})
+			q.completeRootExpr(&stmt.FieldExpr{Name: val})
 		} else {
 			q.setExprParam(&stmt.FieldExpr{Name: val})
 		}
-		q.fieldNames[val] = struct{}{}
+		if q.clause == selectClause {
+			// having/order by clause references the result of select items, not the field of metric
+			q.fieldNames[val] = struct{}{}
+		}
 	case ctx.DecNumber() != nil || ctx.IntNumber() != nil:
 		valStr := ""
 		switch {
@@ -311,6 +852,8 @@ func (q *queryStmtParser) visitExprAtom(ctx *grammar.ExprAtomContext) {
 		val, _ := strconv.ParseFloat(valStr, 64)
 		if !q.exprStack.Empty() {
 			q.setExprParam(&stmt.NumberLiteral{Val: val})
+		} else if q.clause != selectClause {
+			q.completeRootExpr(&stmt.NumberLiteral{Val: val})
 		}
 	default:
 	}
@@ -338,7 +881,7 @@ func (q *queryStmtParser) completeFieldExpr(ctx *grammar.FieldExprContext) {
 			q.setExprParam(expr)
 		}
 		if q.exprStack.Empty() {
-			q.selectItems = append(q.selectItems, &stmt.SelectItem{Expr: expr})
+			q.completeRootExpr(expr)
 		}
 	}
 }
@@ -266,6 +266,218 @@ func TestComplexSelectItem(t *testing.T) {
 		query.SelectItems)
 }
 
+func TestAggregateFunc(t *testing.T) {
+	cases := []struct {
+		sql      string
+		funcType function.FuncType
+	}{
+		{sql: "select variance(f) from memory", funcType: function.Variance},
+		{sql: "select first_value(f) from memory", funcType: function.FirstValue},
+		{sql: "select last_value(f) from memory", funcType: function.LastValue},
+		{sql: "select median() from memory", funcType: function.Median},
+		{sql: "select count(f) from memory", funcType: function.Count},
+		{sql: "select irate(f) from memory", funcType: function.IRate},
+		{sql: "select increase(f) from memory", funcType: function.Increase},
+		{sql: "select derivative(f) from memory", funcType: function.Derivative},
+		{sql: "select delta(f) from memory", funcType: function.Delta},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		callExpr := query.SelectItems[0].(*stmt.SelectItem).Expr.(*stmt.CallExpr)
+		assert.Equal(t, tt.funcType, callExpr.FuncType, tt.sql)
+	}
+	// median of field is calculated by the values of field
+	for _, sql := range []string{
+		"select median(f) from memory",
+		"select max(f) + median(f) from memory",
+		"select abs(median(f)) from memory",
+	} {
+		q, err := Parse(sql)
+		assert.NoError(t, err, sql)
+		assert.Equal(t, []string{"f"}, q.(*stmt.Query).FieldNames, sql)
+	}
+	// function name can be used as identifier
+	q, err := Parse("select median from memory")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"median"}, q.(*stmt.Query).FieldNames)
+}
+
+func TestTransformFunc(t *testing.T) {
+	cases := []struct {
+		sql    string
+		expect stmt.Expr
+	}{
+		{
+			sql: "select moving_avg(f, 5) from memory",
+			expect: &stmt.CallExpr{FuncType: function.MovingAvg,
+				Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: 5}}},
+		},
+		{
+			sql: "select moving_sum(sum(f), 3) from memory",
+			expect: &stmt.CallExpr{FuncType: function.MovingSum,
+				Params: []stmt.Expr{
+					&stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+					&stmt.NumberLiteral{Val: 3}}},
+		},
+		{
+			sql:    "select cumulative_sum(f) from memory",
+			expect: &stmt.CallExpr{FuncType: function.CumulativeSum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		},
+		{
+			sql: "select ewma(f, 0.3) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Ewma,
+				Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: 0.3}}},
+		},
+		{
+			sql: "select time_shift(f, 1d) from memory",
+			expect: &stmt.CallExpr{FuncType: function.TimeShift,
+				Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.DurationLiteral{Val: timeutil.Interval(timeutil.OneDay)}}},
+		},
+		{
+			sql:    "select diff(f) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Diff, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, []stmt.Expr{&stmt.SelectItem{Expr: tt.expect}}, query.SelectItems, tt.sql)
+		assert.Equal(t, []string{"f"}, query.FieldNames, tt.sql)
+	}
+}
+
+func TestScalarFunc(t *testing.T) {
+	field := func(name string) stmt.Expr { return &stmt.FieldExpr{Name: name} }
+	number := func(val float64) stmt.Expr { return &stmt.NumberLiteral{Val: val} }
+	cases := []struct {
+		sql    string
+		expect stmt.Expr
+	}{
+		{
+			sql:    "select abs(f) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Abs, Params: []stmt.Expr{field("f")}},
+		},
+		{
+			sql:    "select ceil(f) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Ceil, Params: []stmt.Expr{field("f")}},
+		},
+		{
+			sql:    "select floor(f) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Floor, Params: []stmt.Expr{field("f")}},
+		},
+		{
+			sql:    "select round(f, 2) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Round, Params: []stmt.Expr{field("f"), number(2)}},
+		},
+		{
+			sql:    "select log(f) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Log, Params: []stmt.Expr{field("f")}},
+		},
+		{
+			sql:    "select pow(f, 2) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Pow, Params: []stmt.Expr{field("f"), number(2)}},
+		},
+		{
+			sql: "select sqrt(sum(f)) from memory",
+			expect: &stmt.CallExpr{FuncType: function.Sqrt, Params: []stmt.Expr{
+				&stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{field("f")}}}},
+		},
+		{
+			sql:    "select clamp_min(f, 0) from memory",
+			expect: &stmt.CallExpr{FuncType: function.ClampMin, Params: []stmt.Expr{field("f"), number(0)}},
+		},
+		{
+			sql:    "select clamp_max(f, 100) from memory",
+			expect: &stmt.CallExpr{FuncType: function.ClampMax, Params: []stmt.Expr{field("f"), number(100)}},
+		},
+		{
+			sql: "select if(f > 10 and f < 100, f / 1024, 0) from memory",
+			expect: &stmt.CallExpr{FuncType: function.If, Params: []stmt.Expr{
+				&stmt.BinaryExpr{
+					Left:     &stmt.BinaryExpr{Left: field("f"), Operator: stmt.GREATER, Right: number(10)},
+					Operator: stmt.AND,
+					Right:    &stmt.BinaryExpr{Left: field("f"), Operator: stmt.LESS, Right: number(100)},
+				},
+				&stmt.BinaryExpr{Left: field("f"), Operator: stmt.DIV, Right: number(1024)},
+				number(0),
+			}},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, []stmt.Expr{&stmt.SelectItem{Expr: tt.expect}}, query.SelectItems, tt.sql)
+		assert.Equal(t, []string{"f"}, query.FieldNames, tt.sql)
+	}
+	// function names are not reserved
+	q, err := Parse("select log, round from memory")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"log", "round"}, q.(*stmt.Query).FieldNames)
+}
+
+func TestHistogramFunc(t *testing.T) {
+	cases := []struct {
+		sql    string
+		expect stmt.Expr
+	}{
+		{sql: "select histogram_count() from latency", expect: &stmt.CallExpr{FuncType: function.HistogramCount}},
+		{sql: "select histogram_sum() from latency", expect: &stmt.CallExpr{FuncType: function.HistogramSum}},
+		{sql: "select histogram_avg() from latency", expect: &stmt.CallExpr{FuncType: function.HistogramAvg}},
+		{sql: "select heatmap() from latency", expect: &stmt.CallExpr{FuncType: function.Heatmap}},
+		{
+			sql: "select histogram_fraction(0.5) from latency",
+			expect: &stmt.CallExpr{FuncType: function.HistogramFraction,
+				Params: []stmt.Expr{&stmt.NumberLiteral{Val: 0.5}}},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, []stmt.Expr{&stmt.SelectItem{Expr: tt.expect}}, query.SelectItems, tt.sql)
+	}
+	// histogram functions only calculate by histogram fields of metric
+	for _, sql := range []string{
+		"select histogram_fraction(f, 0.5) from latency",
+		"select histogram_fraction(f) from latency",
+		"select histogram_fraction() from latency",
+		"select quantile(f, 0.99) from latency",
+		"select histogram_count(f) from latency",
+		"select histogram_sum(f) from latency",
+		"select histogram_avg(f) from latency",
+		"select heatmap(f) from latency",
+		"select abs(histogram_fraction(f)) from latency",
+	} {
+		_, err := Parse(sql)
+		assert.Error(t, err, sql)
+	}
+	// function names are not reserved
+	q, err := Parse("select heatmap from latency")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"heatmap"}, q.(*stmt.Query).FieldNames)
+}
+
+func TestStringFieldFunc(t *testing.T) {
+	q, err := Parse("select last(version), count_distinct(status) from app")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, []string{"status", "version"}, query.FieldNames)
+	assert.Equal(t, []stmt.Expr{
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.LastValue,
+			Params: []stmt.Expr{&stmt.FieldExpr{Name: "version"}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.CountDistinct,
+			Params: []stmt.Expr{&stmt.FieldExpr{Name: "status"}}}},
+	}, query.SelectItems)
+	// function names are not reserved
+	q, err = Parse("select last from app")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"last"}, q.(*stmt.Query).FieldNames)
+}
+
 func TestMathExpress(t *testing.T) {
 	// math expression
 	sql := "select max(sum(c)+c*d/e) from memory"
@@ -334,6 +546,75 @@ func TestTimeRange(t *testing.T) {
 	sql = "select f from cpu where time>'20190410 11:00:00' and time<'20190410 10:00:00'"
 	_, err = Parse(sql)
 	assert.Error(t, err)
+
+	// ISO-8601 with zone offset and epoch millisecond
+	sql = "select f from cpu where time>='2026-10-01T00:00:00+08:00' and time<1790870400000"
+	q, err = Parse(sql)
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, int64(1790784000000), query.TimeRange.Start)
+	assert.Equal(t, int64(1790870400000), query.TimeRange.End)
+	assert.Empty(t, query.TimeZone)
+
+	_, err = Parse("select f from cpu where time>'2026-10-01T00:00:00+8'")
+	assert.Error(t, err)
+}
+
+func TestTimeZone(t *testing.T) {
+	// time string without zone offset is parsed in session time zone
+	q, err := Parse("select f from cpu where time>='2026-10-01 00:00:00' and time<'2026-10-02T00:00:00' " +
+		"group by time(1d) limit 10 tz('Asia/Shanghai')")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "Asia/Shanghai", query.TimeZone)
+	assert.Equal(t, int64(1790784000000), query.TimeRange.Start)
+	assert.Equal(t, int64(1790870400000), query.TimeRange.End)
+	assert.Equal(t, timeutil.Interval(timeutil.OneDay), query.Interval)
+	assert.Equal(t, 10, query.Limit)
+
+	q, err = Parse("select f from cpu where time>='2026-10-01 00:00:00' tz('-04:00')")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "-04:00", query.TimeZone)
+	assert.Equal(t, int64(1790827200000), query.TimeRange.Start)
+
+	// zone offset of time string takes precedence over session time zone
+	q, err = Parse("select f from cpu where time>='2026-10-01T00:00:00Z' tz('Asia/Shanghai')")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, int64(1790812800000), query.TimeRange.Start)
+
+	_, err = Parse("select f from cpu tz('Unknown/Zone')")
+	assert.Error(t, err)
+}
+
+func TestWithExemplars(t *testing.T) {
+	q, err := Parse("select f from cpu")
+	assert.NoError(t, err)
+	assert.False(t, q.(*stmt.Query).WithExemplars)
+
+	q, err = Parse("select f from cpu where host='1.1.1.1' group by host limit 10 tz('Asia/Shanghai') with exemplars")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.True(t, query.WithExemplars)
+	assert.Equal(t, []string{"host"}, query.GroupBy)
+	assert.Equal(t, "Asia/Shanghai", query.TimeZone)
+
+	q, err = Parse("select f from cpu with exemplars")
+	assert.NoError(t, err)
+	assert.True(t, q.(*stmt.Query).WithExemplars)
+
+	// exemplars is non-reserved word
+	q, err = Parse("select exemplars from cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"exemplars"}, q.(*stmt.Query).FieldNames)
+
+	_, err = Parse("select max(f) from (select sum(f) as f from cpu with exemplars)")
+	assert.Error(t, err)
+	_, err = Parse("select max(f) from (select sum(f) as f from cpu) with exemplars")
+	assert.Error(t, err)
+	_, err = Parse("select a.f/b.f from a, b with exemplars")
+	assert.Error(t, err)
 }
 
 func TestInterval(t *testing.T) {
@@ -389,6 +670,115 @@ func TestGroupBy(t *testing.T) {
 	assert.Equal(t, "/data", query.GroupBy[1])
 }
 
+func TestGroupBy_TagTransform(t *testing.T) {
+	q, err := Parse("select f from cpu group by regex_extract(host, '^(\\w+)-'), label_replace(ip, '(\\d+)\\..*', '$1') as seg, host")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+
+	q, err = Parse("select f as v from cpu group by regex_extract(host, '^(\\w+)-') as dc, label_replace(ip, '(\\d+)\\..*', '$1'), host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, []string{"dc", "ip", "host"}, query.GroupBy)
+	assert.Equal(t, []*stmt.TagTransform{
+		{Type: stmt.RegexExtract, Name: "dc", TagKey: "host", Regex: "^(\\w+)-"},
+		{Type: stmt.LabelReplace, Name: "ip", TagKey: "ip", Regex: "(\\d+)\\..*", Replacement: "$1"},
+	}, query.GroupByTransforms)
+	// alias of tag transform is not alias of select item
+	assert.Equal(t, "v", query.SelectItems[0].(*stmt.SelectItem).Alias)
+
+	cases := []string{
+		// invalid regex
+		"select f from cpu group by regex_extract(host, '(')",
+		// duplicate group by tag
+		"select f from cpu group by host, regex_extract(host, '^(\\w+)-')",
+		// tag transform of outer query
+		"select sum(f) from (select max(f) as f from cpu group by host) group by regex_extract(host, '^(\\w+)-')",
+	}
+	for _, sql := range cases {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestFill(t *testing.T) {
+	cases := []struct {
+		sql       string
+		fill      stmt.FillType
+		fillValue float64
+	}{
+		{sql: "select f from cpu group by host", fill: stmt.FillNone},
+		{sql: "select f from cpu group by host fill(null)", fill: stmt.FillNone},
+		{sql: "select f from cpu group by host fill(previous)", fill: stmt.FillPrevious},
+		{sql: "select f from cpu group by host fill(10)", fill: stmt.FillValue, fillValue: 10},
+		{sql: "select f from cpu group by host fill(1.5)", fill: stmt.FillValue, fillValue: 1.5},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, tt.fill, query.Fill, tt.sql)
+		assert.Equal(t, tt.fillValue, query.FillValue, tt.sql)
+	}
+}
+
+func TestHaving(t *testing.T) {
+	q, err := Parse("select max(f) as m from cpu group by host having m > 10")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, []string{"f"}, query.FieldNames)
+	assert.Len(t, query.SelectItems, 1)
+	assert.Equal(t, &stmt.BinaryExpr{
+		Left:     &stmt.FieldExpr{Name: "m"},
+		Operator: stmt.GREATER,
+		Right:    &stmt.NumberLiteral{Val: 10},
+	}, query.Having)
+
+	q, err = Parse("select f from cpu group by host having (avg(f) >= 1 and f+1 < 100) or max(f) != 5")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, []string{"f"}, query.FieldNames)
+	assert.Equal(t, "(avg(f)>=1.00andf+1.00<100.00)ormax(f)!=5.00", query.Having.Rewrite())
+
+	_, err = Parse("select f from cpu group by host having f like '1'")
+	assert.Error(t, err)
+
+	// having references the field/function not in select list
+	for _, sql := range []string{
+		"select max(f) as m from cpu group by host having f > 10",
+		"select f from cpu group by host having max(g) > 10",
+		"select f from cpu group by host having stddev(f) > 10",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	q, err := Parse("select f, max(g) from cpu group by host order by max(g) desc, f asc, f limit 10")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, []string{"f", "g"}, query.FieldNames)
+	assert.Len(t, query.SelectItems, 2)
+	assert.Equal(t, []stmt.Expr{
+		&stmt.OrderByExpr{Expr: &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "g"}}}, Desc: true},
+		&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "f"}},
+		&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "f"}},
+	}, query.OrderBy)
+	assert.Equal(t, 10, query.Limit)
+
+	q, err = Parse("select f from cpu group by host having f > 1 order by sum(f)/2 desc")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "f>1.00", query.Having.Rewrite())
+	assert.Equal(t, "sum(f)/2.00 desc", query.OrderBy[0].Rewrite())
+
+	// order by references the field not in select list
+	_, err = Parse("select f from cpu group by host order by sum(g)")
+	assert.Error(t, err)
+	_, err = Parse("select f as v from cpu group by host order by f")
+	assert.Error(t, err)
+}
+
 func TestEmptyCondition(t *testing.T) {
 	sql := "select f from cpu"
 	q, err := Parse(sql)
@@ -397,6 +787,103 @@ func TestEmptyCondition(t *testing.T) {
 	assert.Nil(t, query.Condition)
 }
 
+func TestCrossMetricQuery(t *testing.T) {
+	q, err := Parse("select a.count / b.count as ratio from a, b on ns group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "a", query.MetricName)
+	assert.Equal(t, []string{"a", "b"}, query.MetricNames)
+	assert.Equal(t, "ns", query.Namespace)
+	assert.True(t, query.IsJoin())
+	assert.Equal(t, []string{"a.count", "b.count"}, query.FieldNames)
+	assert.Equal(t, []stmt.Expr{&stmt.SelectItem{
+		Expr: &stmt.BinaryExpr{
+			Left:     &stmt.FieldExpr{Name: "a.count"},
+			Operator: stmt.DIV,
+			Right:    &stmt.FieldExpr{Name: "b.count"},
+		},
+		Alias: "ratio",
+	}}, query.SelectItems)
+
+	q, err = Parse("select f from cpu")
+	assert.NoError(t, err)
+	assert.False(t, q.(*stmt.Query).IsJoin())
+	assert.Nil(t, q.(*stmt.Query).MetricNames)
+
+	// field without metric name
+	_, err = Parse("select a.count / count from a, b")
+	assert.Error(t, err)
+	// metric not referenced
+	_, err = Parse("select a.count from a, b")
+	assert.Error(t, err)
+	// duplicated metric
+	_, err = Parse("select a.count from a, a")
+	assert.Error(t, err)
+}
+
+func TestSubQuery(t *testing.T) {
+	q, err := Parse("select max(avg_cpu) from (select avg(usage) as avg_cpu from cpu on ns " +
+		"where time>now()-2h group by host, node, time(5m)) group by node having max(avg_cpu) > 10 limit 5")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.NotNil(t, query.SubQuery)
+	assert.Equal(t, "cpu", query.SubQuery.MetricName)
+	assert.Equal(t, []string{"usage"}, query.SubQuery.FieldNames)
+	assert.Equal(t, []string{"host", "node"}, query.SubQuery.GroupBy)
+	assert.Equal(t, "", query.MetricName)
+	assert.Equal(t, "ns", query.Namespace)
+	assert.Equal(t, []string{"avg_cpu"}, query.FieldNames)
+	assert.Equal(t, []string{"node"}, query.GroupBy)
+	assert.Equal(t, query.SubQuery.TimeRange, query.TimeRange)
+	assert.Equal(t, timeutil.Interval(5*timeutil.OneMinute), query.Interval)
+	assert.NotNil(t, query.Having)
+	assert.Equal(t, 5, query.Limit)
+	// default limit not applied to subquery
+	assert.Equal(t, 0, query.SubQuery.Limit)
+	assert.Equal(t, []stmt.Expr{&stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "avg_cpu"}}},
+	}}, query.SelectItems)
+
+	// nested subquery
+	q, err = Parse("select sum(f) from (select max(f) as f from (select f from cpu group by host, node) group by node)")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "cpu", query.SubQuery.SubQuery.MetricName)
+	assert.Equal(t, []string{"node"}, query.SubQuery.GroupBy)
+	assert.Equal(t, 20, query.Limit)
+
+	// explicit limit of subquery
+	q, err = Parse("select max(f) from (select f from cpu group by host limit 30)")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, 30, query.SubQuery.Limit)
+
+	// outer query inherits time zone of subquery
+	q, err = Parse("select max(f) from (select f from cpu group by host, time(1d) tz('Asia/Shanghai'))")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "Asia/Shanghai", query.TimeZone)
+
+	cases := []string{
+		// field not in subquery
+		"select max(f) from (select avg(usage) from cpu)",
+		// group by tag key not in subquery
+		"select max(f) from (select f from cpu group by host) group by node",
+		// time range in outer query
+		"select max(f) from (select f from cpu) where time>now()-1h",
+		// interval in outer query
+		"select max(f) from (select f from cpu) group by time(5m)",
+		// time zone in outer query
+		"select max(f) from (select f from cpu) tz('Asia/Shanghai')",
+		// subquery invalid
+		"select max(f) from (select f from cpu where time>now()+1h and time<now()-1h)",
+	}
+	for _, sql := range cases {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
 func TestEqualsExpr(t *testing.T) {
 	// equals
 	sql := "select f from cpu where ip='1.1.1.1'"
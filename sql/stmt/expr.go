@@ -24,6 +24,7 @@ import (
 
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./expr.go -destination=./expr_mock.go -package=stmt
@@ -69,6 +70,11 @@ type NumberLiteral struct {
 	Val float64 `json:"val"`
 }
 
+// DurationLiteral represents a duration, e.g. 1d.
+type DurationLiteral struct {
+	Val timeutil.Interval `json:"val"`
+}
+
 // CallExpr represents a function call expression
 type CallExpr struct {
 	FuncType function.FuncType
@@ -101,6 +107,18 @@ type innerBinaryExpr struct {
 	Operator BinaryOP        `json:"operator"`
 }
 
+// OrderByExpr represents an order by expression with sort direction
+type OrderByExpr struct {
+	Expr Expr
+	Desc bool
+}
+
+// innerOrderByExpr represents inner wrapper of order by expr for json marshal
+type innerOrderByExpr struct {
+	exprData
+	Desc bool `json:"desc"`
+}
+
 // EqualsExpr represents an equals expression
 type EqualsExpr struct {
 	Key   string `json:"key"`
@@ -130,6 +148,14 @@ type NotExpr struct {
 	Expr Expr
 }
 
+// Rewrite rewrites the order by expr after parse
+func (e *OrderByExpr) Rewrite() string {
+	if e.Desc {
+		return fmt.Sprintf("%s desc", e.Expr.Rewrite())
+	}
+	return fmt.Sprintf("%s asc", e.Expr.Rewrite())
+}
+
 // Rewrite rewrites the select item expr after parse
 func (e *SelectItem) Rewrite() string {
 	if e.Alias == "" {
@@ -162,6 +188,11 @@ func (e *NumberLiteral) Rewrite() string {
 	return fmt.Sprintf("%.2f", e.Val)
 }
 
+// Rewrite rewrites the duration literal after parse
+func (e *DurationLiteral) Rewrite() string {
+	return e.Val.String()
+}
+
 // Rewrite rewrites the binary expr after parse
 func (e *BinaryExpr) Rewrite() string {
 	return fmt.Sprintf("%s%s%s", e.Left.Rewrite(), BinaryOPString(e.Operator), e.Right.Rewrite())
@@ -205,6 +236,8 @@ func Marshal(expr Expr) []byte {
 		return encoding.JSONMarshal(&exprData{Type: "equals", Expr: encoding.JSONMarshal(expr)})
 	case *NumberLiteral:
 		return encoding.JSONMarshal(&exprData{Type: "number", Expr: encoding.JSONMarshal(expr)})
+	case *DurationLiteral:
+		return encoding.JSONMarshal(&exprData{Type: "duration", Expr: encoding.JSONMarshal(expr)})
 	case *FieldExpr:
 		return encoding.JSONMarshal(&exprData{Type: "field", Expr: encoding.JSONMarshal(expr)})
 	case *NotExpr:
@@ -220,6 +253,15 @@ func Marshal(expr Expr) []byte {
 			Alias: e.Alias,
 		}
 		return encoding.JSONMarshal(&inner)
+	case *OrderByExpr:
+		inner := innerOrderByExpr{
+			exprData: exprData{
+				Type: "orderBy",
+				Expr: Marshal(e.Expr),
+			},
+			Desc: e.Desc,
+		}
+		return encoding.JSONMarshal(&inner)
 	case *CallExpr:
 		inner := innerCallExpr{
 			Type:     "call",
@@ -260,6 +302,8 @@ func Unmarshal(value []byte) (Expr, error) {
 		return unmarshal(&expr, &EqualsExpr{})
 	case "number":
 		return unmarshal(&expr, &NumberLiteral{})
+	case "duration":
+		return unmarshal(&expr, &DurationLiteral{})
 	case field:
 		return unmarshal(&expr, &FieldExpr{})
 	case "paren":
@@ -272,6 +316,8 @@ func Unmarshal(value []byte) (Expr, error) {
 		return unmarshalBinary(value)
 	case "selectItem":
 		return unmarshalSelectItem(value)
+	case "orderBy":
+		return unmarshalOrderBy(value)
 	case "call":
 		return unmarshalCall(value)
 	case "not":
@@ -317,6 +363,20 @@ func unmarshalSelectItem(value []byte) (Expr, error) {
 	return &SelectItem{Alias: innerExpr.Alias, Expr: e}, nil
 }
 
+// unmarshalOrderBy parses value to order by expr
+func unmarshalOrderBy(value []byte) (Expr, error) {
+	innerExpr := innerOrderByExpr{}
+	err := encoding.JSONUnmarshal(value, &innerExpr)
+	if err != nil {
+		return nil, err
+	}
+	e, err := Unmarshal(innerExpr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderByExpr{Expr: e, Desc: innerExpr.Desc}, nil
+}
+
 // unmarshalBinary parses value to binary expr
 func unmarshalBinary(value []byte) (Expr, error) {
 	innerExpr := innerBinaryExpr{}
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestExpr_Rewrite(t *testing.T) {
@@ -97,6 +98,10 @@ func TestExpr_Unmarshal_Fail(t *testing.T) {
 	assert.NotNil(t, err)
 	_, err = unmarshalSelectItem([]byte("{\"type\":\"selectItem\",\"expr\":[\"213\"]}"))
 	assert.NotNil(t, err)
+	_, err = unmarshalOrderBy([]byte("324"))
+	assert.NotNil(t, err)
+	_, err = unmarshalOrderBy([]byte("{\"type\":\"orderBy\",\"expr\":[\"213\"]}"))
+	assert.NotNil(t, err)
 	_, err = unmarshalBinary([]byte("123"))
 	assert.NotNil(t, err)
 	_, err = unmarshalBinary([]byte("{\"type\":\"binary\",\"left\":\"123\"}"))
@@ -106,6 +111,16 @@ func TestExpr_Unmarshal_Fail(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestOrderByExpr_Marshal(t *testing.T) {
+	expr := &OrderByExpr{Expr: &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "f"}}}, Desc: true}
+	assert.Equal(t, "max(f) desc", expr.Rewrite())
+	assert.Equal(t, "f asc", (&OrderByExpr{Expr: &FieldExpr{Name: "f"}}).Rewrite())
+	data := Marshal(expr)
+	exprData, err := Unmarshal(data)
+	assert.NoError(t, err)
+	assert.Equal(t, expr, exprData)
+}
+
 func TestRegexExpr_Marshal(t *testing.T) {
 	expr := &RegexExpr{Key: "tagKey", Regexp: "Regexp"}
 	data := Marshal(expr)
@@ -163,6 +178,19 @@ func TestNumberLiteral_Marshal(t *testing.T) {
 	assert.Equal(t, *expr, *e)
 }
 
+func TestDurationLiteral_Marshal(t *testing.T) {
+	expr := &CallExpr{
+		FuncType: function.TimeShift,
+		Params:   []Expr{&FieldExpr{Name: "f"}, &DurationLiteral{Val: timeutil.Interval(timeutil.OneDay)}},
+	}
+	assert.Equal(t, "time_shift(f,1d)", expr.Rewrite())
+	data := Marshal(expr)
+	exprData, err := Unmarshal(data)
+	assert.NoError(t, err)
+	e := exprData.(*CallExpr)
+	assert.Equal(t, *expr, *e)
+}
+
 func TestSelectItem_Marshal(t *testing.T) {
 	expr := &SelectItem{Expr: &FieldExpr{Name: "f"}, Alias: "f1"}
 	data := Marshal(expr)
@@ -33,6 +33,12 @@ const (
 	TagKey
 	TagValue
 	Field
+	// MetricCardinality represents the number of series for each metric.
+	MetricCardinality
+	// TagKeyCardinality represents the number of distinct tag values for each tag key of metric.
+	TagKeyCardinality
+	// TagValueCardinality represents the number of series for each tag value under tag key.
+	TagValueCardinality
 )
 
 // String returns string value of metadata type
@@ -48,11 +54,22 @@ func (m MetricMetadataType) String() string {
 		return "tagKey"
 	case TagValue:
 		return "tagValue"
+	case MetricCardinality:
+		return "metricCardinality"
+	case TagKeyCardinality:
+		return "tagKeyCardinality"
+	case TagValueCardinality:
+		return "tagValueCardinality"
 	default:
 		return unknown
 	}
 }
 
+// IsCardinality returns if the metadata type is cardinality analysis.
+func (m MetricMetadataType) IsCardinality() bool {
+	return m == MetricCardinality || m == TagKeyCardinality || m == TagValueCardinality
+}
+
 // MetricMetadata represents search metric metadata statement
 type MetricMetadata struct {
 	Namespace  string             // namespace
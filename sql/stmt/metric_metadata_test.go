@@ -31,6 +31,9 @@ func TestMetadataType_String(t *testing.T) {
 	assert.Equal(t, "field", Field.String())
 	assert.Equal(t, "tagKey", TagKey.String())
 	assert.Equal(t, "tagValue", TagValue.String())
+	assert.Equal(t, "metricCardinality", MetricCardinality.String())
+	assert.Equal(t, "tagKeyCardinality", TagKeyCardinality.String())
+	assert.Equal(t, "tagValueCardinality", TagValueCardinality.String())
 	assert.Equal(t, "unknown", MetricMetadataType(0).String())
 }
 
@@ -75,3 +78,10 @@ func TestMetadata_Marshal_Fail(t *testing.T) {
 func TestMetricMetadata_StatementType(t *testing.T) {
 	assert.Equal(t, MetricMetadataStatement, (&MetricMetadata{}).StatementType())
 }
+
+func TestMetricMetadataType_IsCardinality(t *testing.T) {
+	assert.True(t, MetricCardinality.IsCardinality())
+	assert.True(t, TagKeyCardinality.IsCardinality())
+	assert.True(t, TagValueCardinality.IsCardinality())
+	assert.False(t, TagValue.IsCardinality())
+}
@@ -0,0 +1,49 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// MetricSchemaType represents metric schema management statement type.
+type MetricSchemaType int
+
+const (
+	// RenameFieldType represents rename field of metric statement.
+	RenameFieldType MetricSchemaType = iota + 1
+	// DropFieldType represents drop field of metric statement.
+	DropFieldType
+	// AlterFieldType represents change field type of metric statement.
+	AlterFieldType
+	// DropMetricType represents drop metric statement.
+	DropMetricType
+	// DropNamespaceType represents drop namespace statement.
+	DropNamespaceType
+)
+
+// MetricSchema represents metric schema management statement, such as rename/drop field, drop metric etc.
+type MetricSchema struct {
+	Type         MetricSchemaType `json:"type"`
+	Namespace    string           `json:"namespace,omitempty"`
+	MetricName   string           `json:"metricName,omitempty"`
+	FieldName    string           `json:"fieldName,omitempty"`
+	NewFieldName string           `json:"newFieldName,omitempty"` // rename field will be used.
+	FieldType    string           `json:"fieldType,omitempty"`    // alter field type will be used.
+}
+
+// StatementType returns metric schema statement type.
+func (m *MetricSchema) StatementType() StatementType {
+	return MetricSchemaStatement
+}
@@ -0,0 +1,45 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestMetricSchema_Marshal(t *testing.T) {
+	schemaStmt := MetricSchema{
+		Type:       AlterFieldType,
+		Namespace:  "ns",
+		MetricName: "cpu",
+		FieldName:  "usage",
+		FieldType:  "max",
+	}
+	data := encoding.JSONMarshal(&schemaStmt)
+	schemaStmt1 := MetricSchema{}
+	err := encoding.JSONUnmarshal(data, &schemaStmt1)
+	assert.NoError(t, err)
+	assert.Equal(t, schemaStmt, schemaStmt1)
+}
+
+func TestMetricSchema_StatementType(t *testing.T) {
+	assert.Equal(t, MetricSchemaStatement, (&MetricSchema{}).StatementType())
+}
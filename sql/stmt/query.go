@@ -19,16 +19,95 @@ package stmt
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
+// FillType represents the policy of filling the empty slots of query result.
+type FillType int
+
+// Defines all fill types of query result.
+const (
+	// FillNone keeps the empty slots, same as fill(null).
+	FillNone FillType = iota
+	// FillPrevious fills the empty slot with previous value.
+	FillPrevious
+	// FillValue fills the empty slot with given value.
+	FillValue
+)
+
+// TagTransformType represents the type of tag value transform for grouping.
+type TagTransformType int
+
+// Defines all types of tag value transform.
+const (
+	// RegexExtract extracts the first capture group(or whole match if no group) of regex from tag value,
+	// empty value if tag value not matched.
+	RegexExtract TagTransformType = iota + 1
+	// LabelReplace replaces the tag value with replacement if whole tag value matches regex,
+	// keeps tag value if not matched, like label_replace of prometheus.
+	LabelReplace
+)
+
+// TagTransform represents the derived group tag which transforms the values of source tag key,
+// e.g. regex_extract(host, '^(\w+)-') as dc.
+type TagTransform struct {
+	Type        TagTransformType `json:"type"`
+	Name        string           `json:"name"`   // name of derived tag in result, source tag key if no alias
+	TagKey      string           `json:"tagKey"` // source tag key
+	Regex       string           `json:"regex"`
+	Replacement string           `json:"replacement,omitempty"`
+}
+
+// Compile compiles the regex of transform, returns the function which transforms tag value.
+func (t *TagTransform) Compile() (func(tagValue string) string, error) {
+	switch t.Type {
+	case RegexExtract:
+		re, err := regexp.Compile(t.Regex)
+		if err != nil {
+			return nil, err
+		}
+		return func(tagValue string) string {
+			matches := re.FindStringSubmatch(tagValue)
+			switch len(matches) {
+			case 0:
+				return ""
+			case 1:
+				return matches[0]
+			default:
+				return matches[1]
+			}
+		}, nil
+	case LabelReplace:
+		re, err := regexp.Compile("^(?:" + t.Regex + ")$")
+		if err != nil {
+			return nil, err
+		}
+		replacement := t.Replacement
+		return func(tagValue string) string {
+			indexes := re.FindStringSubmatchIndex(tagValue)
+			if indexes == nil {
+				return tagValue
+			}
+			return string(re.ExpandString(nil, replacement, tagValue, indexes))
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown tag transform type: %d", t.Type)
+	}
+}
+
 // Query represents search statement
 type Query struct {
 	Explain     bool     // need explain query execute stat
 	Namespace   string   // namespace
 	MetricName  string   // like table name
+	MetricNames []string // all metric names if query references multiple metrics, like select a.f/b.f from a, b
+	SubQuery    *Query   // subquery of from clause, query aggregates the result series of subquery
 	SelectItems []Expr   // select list, such as field, function call, math expression etc.
 	FieldNames  []string // select field names
 	Condition   Expr     // tag filter condition expression
@@ -38,9 +117,16 @@ type Query struct {
 	Interval        timeutil.Interval  // down sampling interval
 	IntervalRatio   int                // down sampling interval ratio
 	StorageInterval timeutil.Interval  // down sampling storage interval, data find
+	TimeZone        string             // session time zone, aligns the time slots of down sampling, local zone if empty
 
-	GroupBy []string // group by tag keys
-	Limit   int      // num. of time series list for result
+	GroupBy           []string        // group by tag keys, name of derived tag if group by tag transform
+	GroupByTransforms []*TagTransform // derived group tags, like regex_extract(host, '^(\w+)-') as dc
+	Fill              FillType        // fill policy for empty slots of result
+	FillValue         float64         // value for filling empty slots if fill type is FillValue
+	Having            Expr            // filter expression for grouped series
+	OrderBy           []Expr          // order by expression list for grouped series
+	Limit             int             // num. of time series list for result
+	WithExemplars     bool            // returns the exemplars of result series, like select ... with exemplars
 }
 
 // StatementType returns metric query type.
@@ -53,11 +139,60 @@ func (q *Query) HasGroupBy() bool {
 	return len(q.GroupBy) > 0
 }
 
+// GetTagTransform returns the tag transform of derived group tag, returns nil if group tag is source tag key.
+func (q *Query) GetTagTransform(groupTag string) *TagTransform {
+	for _, t := range q.GroupByTransforms {
+		if t.Name == groupTag {
+			return t
+		}
+	}
+	return nil
+}
+
+// GroupByTagKey returns the source tag key of group tag.
+func (q *Query) GroupByTagKey(groupTag string) string {
+	if t := q.GetTagTransform(groupTag); t != nil {
+		return t.TagKey
+	}
+	return groupTag
+}
+
+// Location returns the location of session time zone, returns local zone if time zone not set or invalid.
+func (q *Query) Location() *time.Location {
+	loc, err := timeutil.LoadLocation(q.TimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// IsJoin returns whether query references multiple metrics,
+// the fields of which are joined by group tags and time slots.
+func (q *Query) IsJoin() bool {
+	return len(q.MetricNames) > 1
+}
+
+// SplitJoinField splits the field name referenced by cross-metric query into metric name and field name,
+// e.g. a.count => (a, count), the longest metric name is matched if metric name contains dot.
+func (q *Query) SplitJoinField(fieldName string) (metricName, name string, ok bool) {
+	for _, m := range q.MetricNames {
+		if len(m) > len(metricName) && len(fieldName) > len(m)+1 && strings.HasPrefix(fieldName, m+".") {
+			metricName = m
+		}
+	}
+	if metricName == "" {
+		return "", "", false
+	}
+	return metricName, fieldName[len(metricName)+1:], true
+}
+
 // innerQuery represents a wrapper of query for json encoding
 type innerQuery struct {
 	Explain     bool              `json:"Explain,omitempty"`
 	Namespace   string            `json:"namespace,omitempty"`
 	MetricName  string            `json:"metricName,omitempty"`
+	MetricNames []string          `json:"metricNames,omitempty"`
+	SubQuery    *Query            `json:"subQuery,omitempty"`
 	SelectItems []json.RawMessage `json:"selectItems,omitempty"`
 	FieldNames  []string          `json:"fieldNames,omitempty"`
 	Condition   json.RawMessage   `json:"condition,omitempty"`
@@ -66,29 +201,47 @@ type innerQuery struct {
 	Interval        timeutil.Interval  `json:"interval,omitempty"`
 	IntervalRatio   int                `json:"intervalRatio,omitempty"`
 	StorageInterval timeutil.Interval  `json:"storageInterval,omitempty"`
+	TimeZone        string             `json:"timeZone,omitempty"`
 
-	GroupBy []string `json:"groupBy,omitempty"`
-	Limit   int      `json:"limit,omitempty"`
+	GroupBy           []string          `json:"groupBy,omitempty"`
+	GroupByTransforms []*TagTransform   `json:"groupByTransforms,omitempty"`
+	Fill              FillType          `json:"fill,omitempty"`
+	FillValue         float64           `json:"fillValue,omitempty"`
+	Having            json.RawMessage   `json:"having,omitempty"`
+	OrderBy           []json.RawMessage `json:"orderBy,omitempty"`
+	Limit             int               `json:"limit,omitempty"`
+	WithExemplars     bool              `json:"withExemplars,omitempty"`
 }
 
 // MarshalJSON returns json data of query
 func (q *Query) MarshalJSON() ([]byte, error) {
 	inner := innerQuery{
-		Explain:         q.Explain,
-		MetricName:      q.MetricName,
-		Namespace:       q.Namespace,
-		Condition:       Marshal(q.Condition),
-		FieldNames:      q.FieldNames,
-		TimeRange:       q.TimeRange,
-		Interval:        q.Interval,
-		IntervalRatio:   q.IntervalRatio,
-		StorageInterval: q.StorageInterval,
-		GroupBy:         q.GroupBy,
-		Limit:           q.Limit,
+		Explain:           q.Explain,
+		MetricName:        q.MetricName,
+		MetricNames:       q.MetricNames,
+		SubQuery:          q.SubQuery,
+		Namespace:         q.Namespace,
+		Condition:         Marshal(q.Condition),
+		FieldNames:        q.FieldNames,
+		TimeRange:         q.TimeRange,
+		Interval:          q.Interval,
+		IntervalRatio:     q.IntervalRatio,
+		StorageInterval:   q.StorageInterval,
+		TimeZone:          q.TimeZone,
+		GroupBy:           q.GroupBy,
+		GroupByTransforms: q.GroupByTransforms,
+		Fill:              q.Fill,
+		FillValue:         q.FillValue,
+		Having:            Marshal(q.Having),
+		Limit:             q.Limit,
+		WithExemplars:     q.WithExemplars,
 	}
 	for _, item := range q.SelectItems {
 		inner.SelectItems = append(inner.SelectItems, Marshal(item))
 	}
+	for _, item := range q.OrderBy {
+		inner.OrderBy = append(inner.OrderBy, Marshal(item))
+	}
 	return encoding.JSONMarshal(&inner), nil
 }
 
@@ -105,6 +258,13 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 		}
 		q.Condition = condition
 	}
+	if inner.Having != nil {
+		having, err := Unmarshal(inner.Having)
+		if err != nil {
+			return err
+		}
+		q.Having = having
+	}
 	var selectItems []Expr
 	for _, item := range inner.SelectItems {
 		selectItem, err := Unmarshal(item)
@@ -113,8 +273,18 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 		}
 		selectItems = append(selectItems, selectItem)
 	}
+	var orderBy []Expr
+	for _, item := range inner.OrderBy {
+		orderByItem, err := Unmarshal(item)
+		if err != nil {
+			return err
+		}
+		orderBy = append(orderBy, orderByItem)
+	}
 	q.Explain = inner.Explain
 	q.MetricName = inner.MetricName
+	q.MetricNames = inner.MetricNames
+	q.SubQuery = inner.SubQuery
 	q.Namespace = inner.Namespace
 	q.SelectItems = selectItems
 	q.FieldNames = inner.FieldNames
@@ -122,7 +292,13 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 	q.Interval = inner.Interval
 	q.IntervalRatio = inner.IntervalRatio
 	q.StorageInterval = inner.StorageInterval
+	q.TimeZone = inner.TimeZone
 	q.GroupBy = inner.GroupBy
+	q.GroupByTransforms = inner.GroupByTransforms
+	q.Fill = inner.Fill
+	q.FillValue = inner.FillValue
+	q.OrderBy = orderBy
 	q.Limit = inner.Limit
+	q.WithExemplars = inner.WithExemplars
 	return nil
 }
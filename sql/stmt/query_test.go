@@ -19,6 +19,7 @@ package stmt
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -29,8 +30,14 @@ import (
 
 func TestQuery_Marshal(t *testing.T) {
 	query := Query{
-		Namespace:  "ns",
-		MetricName: "test",
+		Namespace:   "ns",
+		MetricName:  "test",
+		MetricNames: []string{"test", "test2"},
+		SubQuery: &Query{
+			MetricName:  "test",
+			SelectItems: []Expr{&SelectItem{Expr: &FieldExpr{Name: "a"}}},
+			GroupBy:     []string{"a"},
+		},
 		SelectItems: []Expr{
 			&SelectItem{Expr: &FieldExpr{Name: "a"}},
 			&SelectItem{Expr: &FieldExpr{Name: "b"}},
@@ -65,8 +72,21 @@ func TestQuery_Marshal(t *testing.T) {
 		},
 		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
 		Interval:  1000,
-		GroupBy:   []string{"a", "b", "c"},
-		Limit:     100,
+		TimeZone:  "Asia/Shanghai",
+		GroupBy:   []string{"a", "b", "c", "dc"},
+		GroupByTransforms: []*TagTransform{
+			{Type: RegexExtract, Name: "dc", TagKey: "host", Regex: "^(\\w+)-"},
+		},
+		Fill:      FillValue,
+		FillValue: 1.5,
+		Having: &BinaryExpr{
+			Left:     &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "a"}}},
+			Operator: GREATER,
+			Right:    &NumberLiteral{Val: 10},
+		},
+		OrderBy:       []Expr{&OrderByExpr{Expr: &FieldExpr{Name: "a"}, Desc: true}},
+		Limit:         100,
+		WithExemplars: true,
 	}
 
 	data := encoding.JSONMarshal(&query)
@@ -75,6 +95,75 @@ func TestQuery_Marshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, query, query1)
 	assert.True(t, query.HasGroupBy())
+	assert.True(t, query.IsJoin())
+	assert.False(t, (&Query{MetricName: "test"}).IsJoin())
+}
+
+func TestQuery_SplitJoinField(t *testing.T) {
+	query := &Query{MetricNames: []string{"cpu", "cpu.load", "mem"}}
+	cases := []struct {
+		fieldName  string
+		metricName string
+		name       string
+		ok         bool
+	}{
+		{fieldName: "cpu.usage", metricName: "cpu", name: "usage", ok: true},
+		{fieldName: "cpu.load.value", metricName: "cpu.load", name: "value", ok: true},
+		{fieldName: "mem.used", metricName: "mem", name: "used", ok: true},
+		{fieldName: "mem.", ok: false},
+		{fieldName: "disk.used", ok: false},
+		{fieldName: "usage", ok: false},
+	}
+	for _, tt := range cases {
+		metricName, name, ok := query.SplitJoinField(tt.fieldName)
+		assert.Equal(t, tt.ok, ok, tt.fieldName)
+		assert.Equal(t, tt.metricName, metricName, tt.fieldName)
+		assert.Equal(t, tt.name, name, tt.fieldName)
+	}
+}
+
+func TestQuery_Location(t *testing.T) {
+	assert.Equal(t, time.Local, (&Query{}).Location())
+	assert.Equal(t, time.Local, (&Query{TimeZone: "Unknown/Zone"}).Location())
+	assert.Equal(t, "Asia/Shanghai", (&Query{TimeZone: "Asia/Shanghai"}).Location().String())
+}
+
+func TestQuery_GroupByTagKey(t *testing.T) {
+	query := &Query{
+		GroupBy: []string{"host", "dc"},
+		GroupByTransforms: []*TagTransform{
+			{Type: RegexExtract, Name: "dc", TagKey: "host", Regex: "^(\\w+)-"},
+		},
+	}
+	assert.Nil(t, query.GetTagTransform("host"))
+	assert.NotNil(t, query.GetTagTransform("dc"))
+	assert.Equal(t, "host", query.GroupByTagKey("host"))
+	assert.Equal(t, "host", query.GroupByTagKey("dc"))
+}
+
+func TestTagTransform_Compile(t *testing.T) {
+	cases := []struct {
+		transform TagTransform
+		tagValue  string
+		expect    string
+	}{
+		{TagTransform{Type: RegexExtract, Regex: "^(\\w+)-"}, "sh-host-1", "sh"},
+		{TagTransform{Type: RegexExtract, Regex: "^\\w+"}, "sh-host-1", "sh"},
+		{TagTransform{Type: RegexExtract, Regex: "^(\\d+)-"}, "sh-host-1", ""},
+		{TagTransform{Type: LabelReplace, Regex: "(\\w+)-host-(\\d+)", Replacement: "$1-$2"}, "sh-host-1", "sh-1"},
+		{TagTransform{Type: LabelReplace, Regex: "(\\w+)-host", Replacement: "$1"}, "sh-host-1", "sh-host-1"},
+	}
+	for _, tt := range cases {
+		fn, err := tt.transform.Compile()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expect, fn(tt.tagValue))
+	}
+	_, err := (&TagTransform{Type: RegexExtract, Regex: "("}).Compile()
+	assert.Error(t, err)
+	_, err = (&TagTransform{Type: LabelReplace, Regex: "("}).Compile()
+	assert.Error(t, err)
+	_, err = (&TagTransform{Regex: "a"}).Compile()
+	assert.Error(t, err)
 }
 
 func TestQuery_Marshal_Fail(t *testing.T) {
@@ -85,6 +174,10 @@ func TestQuery_Marshal_Fail(t *testing.T) {
 	assert.NotNil(t, err)
 	err = query.UnmarshalJSON([]byte("{\"selectItems\":[\"123\"]}"))
 	assert.NotNil(t, err)
+	err = query.UnmarshalJSON([]byte("{\"having\":\"123\"}"))
+	assert.NotNil(t, err)
+	err = query.UnmarshalJSON([]byte("{\"orderBy\":[\"123\"]}"))
+	assert.NotNil(t, err)
 }
 
 func TestQuery_StatementType(t *testing.T) {
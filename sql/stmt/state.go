@@ -33,6 +33,8 @@ const (
 	BrokerMetric
 	// StorageMetric represents show current storage's metric statement
 	StorageMetric
+	// Limit represents show series limits and usage of database statement.
+	Limit
 )
 
 // State represents show state statement.
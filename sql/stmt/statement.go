@@ -27,6 +27,9 @@ const (
 	StateStatement
 	MetricMetadataStatement
 	QueryStatement
+	UserStatement
+	DeleteStatement
+	MetricSchemaStatement
 )
 
 // Statement represents LinDB query language statement
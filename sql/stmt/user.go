@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// UserType represents user/role/grant statement type.
+type UserType int
+
+const (
+	// CreateUserType represents create user statement.
+	CreateUserType UserType = iota + 1
+	// DropUserType represents drop user statement.
+	DropUserType
+	// ShowUsersType represents show all users statement.
+	ShowUsersType
+	// CreateRoleType represents create role statement.
+	CreateRoleType
+	// DropRoleType represents drop role statement.
+	DropRoleType
+	// ShowRolesType represents show all roles statement.
+	ShowRolesType
+	// GrantType represents grant permission/role statement.
+	GrantType
+	// RevokeType represents revoke permission/role statement.
+	RevokeType
+)
+
+// User represents user/role/grant management statement.
+type User struct {
+	Type     UserType
+	UserName string // user name, grant/revoke role to user also uses it.
+	Password string // create user will be used.
+	RoleName string // role name, grant/revoke permission to role also uses it.
+
+	// grant/revoke permission will be used.
+	Permission string // read/write/admin
+	Database   string // database name, * means all databases
+	Namespace  string // empty means all namespaces
+}
+
+// StatementType returns user query type.
+func (u *User) StatementType() StatementType {
+	return UserStatement
+}
@@ -0,0 +1,100 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestUserStatement(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt *stmt.User
+	}{
+		{
+			sql:  "create user test with password 'test@123'",
+			stmt: &stmt.User{Type: stmt.CreateUserType, UserName: "test", Password: "test@123"},
+		},
+		{
+			sql:  "drop user 'test'",
+			stmt: &stmt.User{Type: stmt.DropUserType, UserName: "test"},
+		},
+		{
+			sql:  "show users",
+			stmt: &stmt.User{Type: stmt.ShowUsersType},
+		},
+		{
+			sql:  "create role dev",
+			stmt: &stmt.User{Type: stmt.CreateRoleType, RoleName: "dev"},
+		},
+		{
+			sql:  "drop role dev",
+			stmt: &stmt.User{Type: stmt.DropRoleType, RoleName: "dev"},
+		},
+		{
+			sql:  "show roles",
+			stmt: &stmt.User{Type: stmt.ShowRolesType},
+		},
+		{
+			sql: "grant read on db to user test",
+			stmt: &stmt.User{Type: stmt.GrantType, UserName: "test",
+				Permission: "read", Database: "db"},
+		},
+		{
+			sql: "grant WRITE on * namespace ns to role dev",
+			stmt: &stmt.User{Type: stmt.GrantType, RoleName: "dev",
+				Permission: "WRITE", Database: "*", Namespace: "ns"},
+		},
+		{
+			sql:  "grant role dev to user test",
+			stmt: &stmt.User{Type: stmt.GrantType, UserName: "test", RoleName: "dev"},
+		},
+		{
+			sql: "revoke admin on 'db' from user admin",
+			stmt: &stmt.User{Type: stmt.RevokeType, UserName: "admin",
+				Permission: "admin", Database: "db"},
+		},
+		{
+			sql:  "revoke role dev from user test",
+			stmt: &stmt.User{Type: stmt.RevokeType, UserName: "test", RoleName: "dev"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.stmt, q)
+		})
+	}
+}
+
+func TestUserStatement_Err(t *testing.T) {
+	for _, sql := range []string{
+		"create user test",
+		"grant read to user test",
+		"grant select on db to user test",
+	} {
+		_, err := Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
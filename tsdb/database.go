@@ -33,6 +33,7 @@ import (
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/objstore"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/tsdb/metadb"
 	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
@@ -48,10 +49,16 @@ type Database interface {
 	NumOfShards() int
 	// GetOption returns the database options
 	GetOption() *option.DatabaseOption
+	// Bucket returns the bucket of tiered storage, return nil if tiered storage not enabled.
+	Bucket() objstore.Bucket
 	// CreateShards creates families for data partition
 	CreateShards(shardIDs []models.ShardID) error
+	// SetLimits persists the series limits of database, then applies them to all shards.
+	SetLimits(limits *option.Limits) error
 	// GetShard returns shard by given shard id
 	GetShard(shardID models.ShardID) (Shard, bool)
+	// GetShards returns all shards of database order by shard id.
+	GetShards() []Shard
 	// ExecutorPool returns the pool for querying tasks
 	ExecutorPool() *ExecutorPool
 	// Closer closes database's underlying resource
@@ -89,6 +96,7 @@ type database struct {
 	shardSet       shardSet        // atomic value
 	metadata       metadb.Metadata // underlying metric metadata
 	metaStore      kv.Store        // underlying meta kv store
+	bucket         objstore.Bucket // bucket of tiered storage(cold segments)
 	isFlushing     atomic.Bool     // restrict flusher concurrency
 	flushCondition *sync.Cond      // flush condition
 
@@ -135,6 +143,13 @@ func newDatabase(
 		flushCondition: sync.NewCond(&sync.Mutex{}),
 		statistics:     metrics.NewDatabaseStatistics(databaseName),
 	}
+	if cfg.Option.Tiered != nil {
+		bucket, err := newBucketFunc(cfg.Option.Tiered)
+		if err != nil {
+			return nil, fmt.Errorf("create bucket of tiered storage for database[%s] error: %s", databaseName, err)
+		}
+		db.bucket = bucket
+	}
 	dbPath, err0 := createDatabasePath(databaseName)
 	if err0 != nil {
 		return nil, err0
@@ -191,6 +206,11 @@ func (db *database) GetOption() *option.DatabaseOption {
 	return db.config.Option
 }
 
+// Bucket returns the bucket of tiered storage, return nil if tiered storage not enabled.
+func (db *database) Bucket() objstore.Bucket {
+	return db.bucket
+}
+
 // CreateShards creates families for data partition
 func (db *database) CreateShards(
 	shardIDs []models.ShardID,
@@ -210,6 +230,27 @@ func (db *database) CreateShards(
 	return nil
 }
 
+// SetLimits persists the series limits of database, then applies them to all shards.
+func (db *database) SetLimits(limits *option.Limits) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.config.Option == nil {
+		return nil
+	}
+	// copy engine option, keep the option of shard immutable
+	opt := *db.config.Option
+	opt.Limits = limits
+	newCfg := &databaseConfig{Option: &opt, ShardIDs: db.config.ShardIDs}
+	if err := db.dumpDatabaseConfig(newCfg); err != nil {
+		return err
+	}
+	for _, entry := range db.shardSet.Entries() {
+		entry.shard.IndexDatabase().SetLimits(limits)
+	}
+	return nil
+}
+
 // createShard creates a new shard based on option
 func (db *database) createShard(shardID models.ShardID) error {
 	// be careful need do mutex unlock
@@ -245,6 +286,16 @@ func (db *database) GetShard(shardID models.ShardID) (Shard, bool) {
 	return db.shardSet.GetShard(shardID)
 }
 
+// GetShards returns all shards of database order by shard id.
+func (db *database) GetShards() []Shard {
+	entries := db.shardSet.Entries()
+	shards := make([]Shard, len(entries))
+	for idx := range entries {
+		shards[idx] = entries[idx].shard
+	}
+	return shards
+}
+
 // ExecutorPool returns the query task execute pool
 func (db *database) ExecutorPool() *ExecutorPool {
 	return db.executorPool
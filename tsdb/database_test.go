@@ -33,8 +33,10 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/objstore"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
 
@@ -201,6 +203,55 @@ func TestDatabase_New(t *testing.T) {
 	}
 }
 
+func TestDatabase_New_Tiered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		encodeToml = ltoml.EncodeToml
+		mkDirIfNotExist = fileutil.MkDirIfNotExist
+		newMetadataFunc = metadb.NewMetadata
+		newBucketFunc = objstore.NewBucket
+		kv.InitStoreManager(nil)
+		ctrl.Finish()
+	}()
+	encodeToml = func(fileName string, v interface{}) error {
+		return nil
+	}
+	mkDirIfNotExist = func(path string) error {
+		return nil
+	}
+	storeMgr := kv.NewMockStoreManager(ctrl)
+	store := kv.NewMockStore(ctrl)
+	kv.InitStoreManager(storeMgr)
+	cfg := &databaseConfig{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{{Interval: 10}},
+			Tiered: &option.Tiered{
+				ColdAfter: timeutil.Interval(timeutil.OneDay),
+				Backend:   option.TieredBackendLocal,
+				Dir:       t.TempDir(),
+			},
+		},
+	}
+	// case 1: create bucket err
+	newBucketFunc = func(tiered *option.Tiered) (objstore.Bucket, error) {
+		return nil, fmt.Errorf("err")
+	}
+	db, err := newDatabase("db", cfg, nil)
+	assert.Error(t, err)
+	assert.Nil(t, db)
+	// case 2: create database with tiered storage
+	newBucketFunc = objstore.NewBucket
+	storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).Return(store, nil)
+	store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil)
+	newMetadataFunc = func(ctx context.Context, databaseName, parent string,
+		tagFamily kv.Family) (metadb.Metadata, error) {
+		return metadb.NewMockMetadata(ctrl), nil
+	}
+	db, err = newDatabase("db", cfg, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, db.Bucket())
+}
+
 func TestDatabase_CreateShards(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -294,6 +345,41 @@ func TestDatabase_CreateShards(t *testing.T) {
 	})
 }
 
+func TestDatabase_SetLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		encodeToml = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+	limits := &option.Limits{MaxSeriesPerShard: 10}
+	shard := NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	db := &database{
+		config:   &databaseConfig{ShardIDs: []models.ShardID{1}},
+		shardSet: *newShardSet(),
+	}
+	db.shardSet.InsertShard(models.ShardID(1), shard)
+	assert.Equal(t, []Shard{shard}, db.GetShards())
+	// option not exist
+	assert.NoError(t, db.SetLimits(limits))
+
+	db.config.Option = &option.DatabaseOption{}
+	encodeToml = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, db.SetLimits(limits))
+	assert.Nil(t, db.GetOption().Limits)
+
+	encodeToml = func(fileName string, v interface{}) error {
+		return nil
+	}
+	indexDB.EXPECT().SetLimits(limits)
+	assert.NoError(t, db.SetLimits(limits))
+	assert.Equal(t, limits, db.GetOption().Limits)
+	assert.Equal(t, []models.ShardID{1}, db.config.ShardIDs)
+}
+
 func TestDatabase_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
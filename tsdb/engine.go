@@ -20,6 +20,7 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/lindb/lindb/config"
@@ -138,6 +139,13 @@ func (e *engine) CreateShards(
 			engineLogger.Info("create database successfully",
 				logger.String("database", databaseName))
 		}
+	} else if databaseOption != nil && !reflect.DeepEqual(db.GetOption().Limits, databaseOption.Limits) {
+		// series limits of database changed, apply new limits
+		if err := db.SetLimits(databaseOption.Limits); err != nil {
+			engineLogger.Error("failed to set series limits of database",
+				logger.String("database", databaseName), logger.Error(err))
+			return err
+		}
 	}
 
 	// create families for database
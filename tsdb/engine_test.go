@@ -321,6 +321,7 @@ func TestEngine_CreateShards(t *testing.T) {
 		name     string
 		db       string
 		shardIDs []models.ShardID
+		limits   *option.Limits
 		prepare  func(e *engine)
 		wantErr  bool
 	}{
@@ -333,6 +334,7 @@ func TestEngine_CreateShards(t *testing.T) {
 			db:       "test",
 			shardIDs: []models.ShardID{1},
 			prepare: func(e *engine) {
+				mockDatabase.EXPECT().GetOption().Return(&option.DatabaseOption{})
 				mockDatabase.EXPECT().CreateShards(gomock.Any()).Return(fmt.Errorf("err"))
 			},
 			wantErr: true,
@@ -342,6 +344,30 @@ func TestEngine_CreateShards(t *testing.T) {
 			db:       "test",
 			shardIDs: []models.ShardID{1},
 			prepare: func(e *engine) {
+				mockDatabase.EXPECT().GetOption().Return(&option.DatabaseOption{})
+				mockDatabase.EXPECT().CreateShards(gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "set limits failure",
+			db:       "test",
+			shardIDs: []models.ShardID{1},
+			limits:   &option.Limits{MaxSeriesPerShard: 10},
+			prepare: func(e *engine) {
+				mockDatabase.EXPECT().GetOption().Return(&option.DatabaseOption{})
+				mockDatabase.EXPECT().SetLimits(&option.Limits{MaxSeriesPerShard: 10}).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "set limits successfully",
+			db:       "test",
+			shardIDs: []models.ShardID{1},
+			limits:   &option.Limits{MaxSeriesPerShard: 10},
+			prepare: func(e *engine) {
+				mockDatabase.EXPECT().GetOption().Return(&option.DatabaseOption{})
+				mockDatabase.EXPECT().SetLimits(&option.Limits{MaxSeriesPerShard: 10}).Return(nil)
 				mockDatabase.EXPECT().CreateShards(gomock.Any()).Return(nil)
 			},
 			wantErr: false,
@@ -383,7 +409,7 @@ func TestEngine_CreateShards(t *testing.T) {
 			if tt.prepare != nil {
 				tt.prepare(e)
 			}
-			err := e.CreateShards(tt.db, &option.DatabaseOption{}, tt.shardIDs...)
+			err := e.CreateShards(tt.db, &option.DatabaseOption{Limits: tt.limits}, tt.shardIDs...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("newShard() error = %v, wantErr %v", err, tt.wantErr)
 			}
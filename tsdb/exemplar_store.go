@@ -0,0 +1,161 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/tblstore/exemplar"
+)
+
+//go:generate mockgen -source=./exemplar_store.go -destination=./exemplar_store_mock.go -package=tsdb
+
+// ExemplarStore stores the exemplars of series per time slot in a bounded side family of shard.
+type ExemplarStore interface {
+	// WriteRow collects the exemplars of metric row with the timestamp of time slot.
+	WriteRow(row *metric.StorageRow, timestamp int64)
+	// Find finds the exemplars of series under metric in time range.
+	Find(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) ([]exemplar.Exemplar, error)
+	// Flush flushes memory exemplars into kv store.
+	Flush() error
+}
+
+// exemplarStore implements ExemplarStore interface.
+type exemplarStore struct {
+	family kv.Family
+
+	mutable   map[metric.ID][]exemplar.Exemplar
+	immutable map[metric.ID][]exemplar.Exemplar
+	flushLock sync.Mutex // restrict flush concurrency
+	rwMutex   sync.RWMutex
+}
+
+// newExemplarStore creates an exemplar store based on kv family.
+func newExemplarStore(family kv.Family) ExemplarStore {
+	return &exemplarStore{
+		family:  family,
+		mutable: make(map[metric.ID][]exemplar.Exemplar),
+	}
+}
+
+// WriteRow collects the exemplars of metric row with the timestamp of time slot.
+func (s *exemplarStore) WriteRow(row *metric.StorageRow, timestamp int64) {
+	var exemplars []exemplar.Exemplar
+	collect := func(traceID, spanID []byte, duration int64) {
+		exemplars = append(exemplars, exemplar.Exemplar{
+			SeriesID:  row.SeriesID,
+			Timestamp: timestamp,
+			TraceID:   append([]byte(nil), traceID...),
+			SpanID:    append([]byte(nil), spanID...),
+			Duration:  duration,
+		})
+	}
+	itr := row.NewSimpleFieldIterator()
+	for itr.HasNext() {
+		itr.NextExemplars(collect)
+	}
+	if compoundItr, ok := row.NewCompoundFieldIterator(); ok {
+		compoundItr.Exemplars(collect)
+	}
+	if len(exemplars) == 0 {
+		return
+	}
+
+	s.rwMutex.Lock()
+	defer s.rwMutex.Unlock()
+
+	rs := append(s.mutable[row.MetricID], exemplars...)
+	// keep memory bounded before flushing
+	if len(rs) > 2*exemplar.MaxPerMetric {
+		rs = exemplar.Compact(rs, exemplar.MaxPerSlot, exemplar.MaxPerMetric)
+	}
+	s.mutable[row.MetricID] = rs
+}
+
+// Find finds the exemplars of series under metric in time range.
+func (s *exemplarStore) Find(
+	metricID metric.ID,
+	seriesIDs *roaring.Bitmap,
+	timeRange timeutil.TimeRange,
+) ([]exemplar.Exemplar, error) {
+	s.rwMutex.RLock()
+	rs := exemplar.Filter(s.mutable[metricID], seriesIDs, timeRange)
+	rs = append(rs, exemplar.Filter(s.immutable[metricID], seriesIDs, timeRange)...)
+	s.rwMutex.RUnlock()
+
+	snapshot := s.family.GetSnapshot()
+	defer snapshot.Close()
+
+	readers, err := snapshot.FindReaders(uint32(metricID))
+	if err != nil {
+		return nil, err
+	}
+	if len(readers) > 0 {
+		exemplars, err := exemplar.NewReader(readers).Find(uint32(metricID), seriesIDs, timeRange)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, exemplars...)
+	}
+	return exemplar.Compact(rs, exemplar.MaxPerSlot, exemplar.MaxPerMetric), nil
+}
+
+// Flush flushes memory exemplars into kv store.
+func (s *exemplarStore) Flush() error {
+	s.flushLock.Lock()
+	defer s.flushLock.Unlock()
+
+	s.rwMutex.Lock()
+	if len(s.mutable) == 0 {
+		s.rwMutex.Unlock()
+		return nil
+	}
+	s.immutable = s.mutable
+	s.mutable = make(map[metric.ID][]exemplar.Exemplar)
+	s.rwMutex.Unlock()
+
+	kvFlusher := s.family.NewFlusher()
+	defer kvFlusher.Release()
+
+	flusher := exemplar.NewFlusher(kvFlusher)
+	metricIDs := make([]metric.ID, 0, len(s.immutable))
+	for metricID := range s.immutable {
+		metricIDs = append(metricIDs, metricID)
+	}
+	// kv table requires key in order
+	sort.Slice(metricIDs, func(i, j int) bool { return metricIDs[i] < metricIDs[j] })
+	for _, metricID := range metricIDs {
+		if err := flusher.FlushMetric(uint32(metricID), s.immutable[metricID]); err != nil {
+			return err
+		}
+	}
+	if err := flusher.Commit(); err != nil {
+		return err
+	}
+	// finally, clear immutable
+	s.rwMutex.Lock()
+	s.immutable = nil
+	s.rwMutex.Unlock()
+	return nil
+}
@@ -0,0 +1,138 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
+	"github.com/lindb/lindb/tsdb/tblstore/exemplar"
+)
+
+func TestExemplarStore_WriteRow_Find(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader := table.NewMockReader(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+
+	store := newExemplarStore(family)
+	// row without exemplars
+	rows := mockBatchRows(&protoMetricsV1.Metric{
+		Name: "test",
+		SimpleFields: []*protoMetricsV1.SimpleField{{
+			Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1,
+		}},
+	})
+	assert.False(t, rows[0].HasExemplars())
+	store.WriteRow(&rows[0], 10)
+
+	rows = mockBatchRows(&protoMetricsV1.Metric{
+		Name: "test",
+		SimpleFields: []*protoMetricsV1.SimpleField{{
+			Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1,
+			Exemplars: []*protoMetricsV1.Exemplar{{TraceId: []byte("t1"), SpanId: []byte("s1"), Duration: 10}},
+		}},
+		CompoundField: &protoMetricsV1.CompoundField{
+			Min: 1, Max: 1, Sum: 1, Count: 1,
+			ExplicitBounds: []float64{1, 2, math.Inf(1)},
+			Values:         []float64{0, 1, 0},
+			Exemplars:      []*protoMetricsV1.Exemplar{{TraceId: []byte("t2"), Duration: 20}},
+		},
+	})
+	row := rows[0]
+	assert.True(t, row.HasExemplars())
+	row.MetricID = 10
+	row.SeriesID = 1
+	store.WriteRow(&row, 10)
+
+	// find from memory and kv store
+	snapshot.EXPECT().FindReaders(uint32(10)).Return([]table.Reader{reader}, nil)
+	reader.EXPECT().Get(uint32(10)).Return(exemplar.Encode([]exemplar.Exemplar{
+		{SeriesID: 1, Timestamp: 20, TraceID: []byte("t3")},
+	}), nil)
+	rs, err := store.Find(10, roaring.BitmapOf(1), timeutil.TimeRange{Start: 0, End: 100})
+	assert.NoError(t, err)
+	var traces []string
+	for _, e := range rs {
+		traces = append(traces, string(e.TraceID))
+	}
+	assert.Equal(t, []string{"t2", "t1", "t3"}, traces)
+
+	// find readers err
+	snapshot.EXPECT().FindReaders(uint32(10)).Return(nil, fmt.Errorf("err"))
+	rs, err = store.Find(10, roaring.BitmapOf(1), timeutil.TimeRange{Start: 0, End: 100})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// read block err
+	snapshot.EXPECT().FindReaders(uint32(10)).Return([]table.Reader{reader}, nil)
+	reader.EXPECT().Get(uint32(10)).Return([]byte{5, 1}, nil)
+	rs, err = store.Find(10, roaring.BitmapOf(1), timeutil.TimeRange{Start: 0, End: 100})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func TestExemplarStore_Flush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	flusher := kv.NewMockFlusher(ctrl)
+	flusher.EXPECT().Release().AnyTimes()
+	family.EXPECT().NewFlusher().Return(flusher).AnyTimes()
+	store := newExemplarStore(family)
+	// no data
+	assert.NoError(t, store.Flush())
+
+	writeExemplars := func() {
+		s := store.(*exemplarStore)
+		s.mutable[20] = []exemplar.Exemplar{{SeriesID: 1, Timestamp: 10, TraceID: []byte("t1")}}
+		s.mutable[10] = []exemplar.Exemplar{{SeriesID: 1, Timestamp: 10, TraceID: []byte("t2")}}
+	}
+	// flush metric err
+	writeExemplars()
+	flusher.EXPECT().Add(uint32(10), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, store.Flush())
+	// commit err
+	writeExemplars()
+	gomock.InOrder(
+		flusher.EXPECT().Add(uint32(10), gomock.Any()).Return(nil),
+		flusher.EXPECT().Add(uint32(20), gomock.Any()).Return(nil),
+		flusher.EXPECT().Commit().Return(fmt.Errorf("err")),
+	)
+	assert.Error(t, store.Flush())
+	// flush successfully
+	writeExemplars()
+	flusher.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	flusher.EXPECT().Commit().Return(nil)
+	assert.NoError(t, store.Flush())
+	assert.Nil(t, store.(*exemplarStore).immutable)
+}
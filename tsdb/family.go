@@ -24,6 +24,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/lindb/roaring"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/config"
@@ -141,6 +142,8 @@ func newDataFamily(
 	}
 
 	f.indicator = fmt.Sprintf("%s/%s/%d", dbName, shardIDStr, familyTime)
+	// purge deleted series data when does compaction job
+	family.SetMergeContext(metricsdata.DeletedSeriesContext, metricsdata.DeletedSeriesFn(f.getDeletedSeries))
 
 	// add data family into global family manager
 	GetFamilyManager().AddFamily(f)
@@ -304,6 +307,14 @@ func (f *dataFamily) MemDBSize() int64 {
 // Filter filters the data based on metric/version/seriesIDs,
 // if it finds data then returns the FilterResultSet, else returns nil
 func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []flow.FilterResultSet, err error) {
+	executeCtx, err = f.filterDeletedSeries(executeCtx)
+	if err != nil {
+		return nil, err
+	}
+	if executeCtx.SeriesIDsAfterFiltering.IsEmpty() {
+		// all series are deleted in current family
+		return nil, nil
+	}
 	memRS, err := f.memoryFilter(executeCtx)
 	if err != nil {
 		return nil, err
@@ -317,6 +328,37 @@ func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []f
 	return
 }
 
+// filterDeletedSeries filters out the series which are deleted within whole family time range,
+// returns new shard execute context if it has deleted series.
+func (f *dataFamily) filterDeletedSeries(executeCtx *flow.ShardExecuteContext) (*flow.ShardExecuteContext, error) {
+	tombstones, err := f.shard.IndexDatabase().GetTombstones(executeCtx.StorageExecuteCtx.MetricID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tombstones) == 0 {
+		return executeCtx, nil
+	}
+	deletedSeriesIDs := tombstones.GetDeletedSeriesIDs(f.timeRange)
+	if deletedSeriesIDs.IsEmpty() {
+		return executeCtx, nil
+	}
+	// copy shard execute context, because series ids after filtering are shared by all families
+	newExecuteCtx := *executeCtx
+	newExecuteCtx.SeriesIDsAfterFiltering = roaring.AndNot(executeCtx.SeriesIDsAfterFiltering, deletedSeriesIDs)
+	return &newExecuteCtx, nil
+}
+
+// getDeletedSeries returns the series ids which are deleted within whole family time range.
+func (f *dataFamily) getDeletedSeries(metricID uint32) *roaring.Bitmap {
+	tombstones, err := f.shard.IndexDatabase().GetTombstones(metric.ID(metricID))
+	if err != nil {
+		f.logger.Warn("get tombstones failure, cannot purge deleted series",
+			logger.String("family", f.indicator), logger.Uint32("metricID", metricID), logger.Error(err))
+		return nil
+	}
+	return tombstones.GetDeletedSeriesIDs(f.timeRange)
+}
+
 func (f *dataFamily) memoryFilter(shardExecuteContext *flow.ShardExecuteContext) (resultSet []flow.FilterResultSet, err error) {
 	memFilter := func(memDB memdb.MemoryDatabase) error {
 		rs, err := memDB.Filter(shardExecuteContext)
@@ -413,6 +455,10 @@ func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 		if err = db.WriteRow(&row); err == nil {
 			f.statistics.WriteMetrics.Incr()
 			f.statistics.WriteFields.Add(float64(len(row.FieldIDs)))
+			if row.HasExemplars() {
+				// keep exemplars with the timestamp of time slot
+				f.shard.ExemplarStore().WriteRow(&row, f.familyTime+int64(row.SlotIndex)*f.interval.Int64())
+			}
 		} else {
 			f.statistics.WriteMetricFailures.Incr()
 			f.logger.Error("failed writing row", logger.String("family", f.indicator), logger.Error(err))
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
@@ -39,6 +40,7 @@ import (
 	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
 	"github.com/lindb/lindb/series/metric"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
@@ -60,6 +62,7 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	snapshot.EXPECT().GetCurrent().Return(v)
 	snapshot.EXPECT().Close()
 	family.EXPECT().GetSnapshot().Return(snapshot)
+	family.EXPECT().SetMergeContext(metricsdata.DeletedSeriesContext, gomock.Any())
 	shard := NewMockShard(ctrl)
 	shard.EXPECT().Database().Return(database)
 	shard.EXPECT().ShardID().Return(models.ShardID(1))
@@ -74,6 +77,29 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDataFamily_getDeletedSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	f := &dataFamily{
+		shard:     shard,
+		timeRange: timeutil.TimeRange{Start: 10, End: 50},
+		logger:    logger.GetLogger("TSDB", "family"),
+	}
+	// case 1: get tombstones failure
+	indexDB.EXPECT().GetTombstones(metric.ID(1)).Return(nil, fmt.Errorf("err"))
+	assert.Nil(t, f.getDeletedSeries(1))
+	// case 2: get deleted series
+	indexDB.EXPECT().GetTombstones(metric.ID(1)).Return(indexdb.Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 0, End: 100}, SeriesIDs: roaring.BitmapOf(1, 2)},
+		{TimeRange: timeutil.TimeRange{Start: 0, End: 20}, SeriesIDs: roaring.BitmapOf(3)},
+	}, nil)
+	assert.Equal(t, roaring.BitmapOf(1, 2), f.getDeletedSeries(1))
+}
+
 func TestDataFamily_Filter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -86,11 +112,49 @@ func TestDataFamily_Filter(t *testing.T) {
 	reader.EXPECT().Path().Return("test").AnyTimes()
 	now := timeutil.Now()
 	cases := []struct {
-		name    string
-		prepare func(f *dataFamily)
-		len     int
-		wantErr bool
+		name       string
+		tombstones func(indexDB *indexdb.MockIndexDatabase)
+		prepare    func(f *dataFamily)
+		len        int
+		wantErr    bool
 	}{
+		{
+			name: "get tombstones failure",
+			tombstones: func(indexDB *indexdb.MockIndexDatabase) {
+				indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "all series deleted in family",
+			tombstones: func(indexDB *indexdb.MockIndexDatabase) {
+				indexDB.EXPECT().GetTombstones(gomock.Any()).Return(indexdb.Tombstones{
+					{TimeRange: timeutil.TimeRange{Start: 0, End: now + timeutil.OneHour}, SeriesIDs: roaring.BitmapOf(1, 2, 3)},
+				}, nil)
+			},
+			wantErr: false,
+			len:     0,
+		},
+		{
+			name: "filter deleted series",
+			tombstones: func(indexDB *indexdb.MockIndexDatabase) {
+				indexDB.EXPECT().GetTombstones(gomock.Any()).Return(indexdb.Tombstones{
+					{TimeRange: timeutil.TimeRange{Start: 0, End: now + timeutil.OneHour}, SeriesIDs: roaring.BitmapOf(1, 2)},
+					{TimeRange: timeutil.TimeRange{Start: 0, End: now - timeutil.OneHour}, SeriesIDs: roaring.BitmapOf(3)},
+				}, nil)
+			},
+			prepare: func(f *dataFamily) {
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.mutableMemDB = memDB
+				memDB.EXPECT().Filter(gomock.Any()).DoAndReturn(func(ctx *flow.ShardExecuteContext) ([]flow.FilterResultSet, error) {
+					assert.Equal(t, roaring.BitmapOf(3), ctx.SeriesIDsAfterFiltering)
+					return []flow.FilterResultSet{nil}, nil
+				})
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+			},
+			wantErr: false,
+			len:     1,
+		},
 		{
 			name: "filter memory database failure",
 			prepare: func(f *dataFamily) {
@@ -190,14 +254,26 @@ func TestDataFamily_Filter(t *testing.T) {
 				newReaderFunc = metricsdata.NewReader
 				newFilterFunc = metricsdata.NewFilter
 			}()
+			indexDB := indexdb.NewMockIndexDatabase(ctrl)
+			shard := NewMockShard(ctrl)
+			shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+			if tt.tombstones != nil {
+				tt.tombstones(indexDB)
+			} else {
+				indexDB.EXPECT().GetTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
+			}
 			f := &dataFamily{
+				shard:      shard,
 				familyTime: now,
+				timeRange:  timeutil.TimeRange{Start: now, End: now + timeutil.OneHour},
 				family:     family,
 			}
 			if tt.prepare != nil {
 				tt.prepare(f)
 			}
+			seriesIDs := roaring.BitmapOf(1, 2, 3)
 			rs, err := f.Filter(&flow.ShardExecuteContext{
+				SeriesIDsAfterFiltering: seriesIDs,
 				StorageExecuteCtx: &flow.StorageExecuteContext{
 					MetricID: 1,
 					Query: &stmtpkg.Query{
@@ -213,6 +289,8 @@ func TestDataFamily_Filter(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Len(t, rs, tt.len)
 			}
+			// shared series ids cannot be changed
+			assert.Equal(t, roaring.BitmapOf(1, 2, 3), seriesIDs)
 		})
 	}
 }
@@ -669,6 +747,28 @@ func TestDataFamily_WriteRows(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "write metric with exemplars",
+			prepare: func() []metric.StorageRow {
+				exemplarStore := NewMockExemplarStore(ctrl)
+				shard.EXPECT().ExemplarStore().Return(exemplarStore)
+				memDB.EXPECT().WriteRow(gomock.Any()).Return(nil)
+				exemplarStore.EXPECT().WriteRow(gomock.Any(), gomock.Any())
+				rows := mockBatchRows(&protoMetricsV1.Metric{
+					Name:      "test",
+					Timestamp: timeutil.Now(),
+					SimpleFields: []*protoMetricsV1.SimpleField{{
+						Name:      "f1",
+						Value:     1.0,
+						Type:      protoMetricsV1.SimpleFieldType_DELTA_SUM,
+						Exemplars: []*protoMetricsV1.Exemplar{{TraceId: []byte("trace")}},
+					}},
+				})
+				rows[0].Writable = true
+				return rows
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range cases {
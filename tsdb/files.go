@@ -51,6 +51,7 @@ const (
 	indexParentDir   = "index"
 	forwardIndexDir  = "forward"
 	invertedIndexDir = "inverted"
+	exemplarDir      = "exemplar"
 	bufferDir        = "buffer"
 )
 
@@ -20,6 +20,7 @@ package tsdb
 import (
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/objstore"
 	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/metadb"
@@ -43,6 +44,7 @@ var (
 	newIntervalSegmentFunc = newIntervalSegment
 	newIndexDBFunc         = indexdb.NewIndexDatabase
 	newMemoryDBFunc        = memdb.NewMemoryDatabase
+	newBucketFunc          = objstore.NewBucket
 	newDataFamilyFunc      = newDataFamily
 	newMetricDataFlusher   = metricsdata.NewFlusher
 )
@@ -18,11 +18,15 @@
 package indexdb
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 	"path"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fileutil"
@@ -41,6 +45,22 @@ var (
 
 const SeriesDB = "series"
 
+// tombstoneKeySuffix is the suffix of metric tombstones' key(metric id + suffix).
+const tombstoneKeySuffix byte = 0xFF
+
+// seriesCountKeySuffix is the suffix of metric series count's key(metric id + suffix).
+const seriesCountKeySuffix byte = 0xFE
+
+var (
+	// droppedTagKeysKey is the key of dropped tag key ids.
+	droppedTagKeysKey = []byte("__$$dropped_tag_keys$$__")
+	// seriesCountKeyPrefix is the prefix of namespace series count's key(prefix + namespace),
+	// also is the key of database series count.
+	seriesCountKeyPrefix = []byte("__$$series_count$$__")
+	// seriesCountSeededKey is the key of the flag which marks the series counts are seeded by existing series.
+	seriesCountSeededKey = []byte("__$$series_count_seeded$$__")
+)
+
 // IDMappingBackend represents the id mapping backend storage,
 // save series data(tags hash => series id) under metric
 type IDMappingBackend interface {
@@ -53,6 +73,24 @@ type IDMappingBackend interface {
 	getSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, err error)
 	// genSeries generates series id by metric id/tags hash.
 	genSeriesID(metricID metric.ID, tagsHash uint64, seriesID uint32) error
+	// getTombstones gets the tombstones of metric, if not exist return empty tombstones.
+	getTombstones(metricID metric.ID) (Tombstones, error)
+	// saveTombstones persists the tombstones of metric.
+	saveTombstones(metricID metric.ID, tombstones Tombstones) error
+	// dropMetric removes the series sequence and all series ids mapping of metric, keeps the tombstones.
+	dropMetric(metricID metric.ID) error
+	// getDroppedTagKeys gets the dropped tag key ids, if not exist return empty bitmap.
+	getDroppedTagKeys() (*roaring.Bitmap, error)
+	// saveDroppedTagKeys persists the dropped tag key ids.
+	saveDroppedTagKeys(tagKeyIDs *roaring.Bitmap) error
+	// getSeriesCount gets the number of series by count key, if not exist return 0.
+	getSeriesCount(key []byte) (uint32, error)
+	// saveSeriesCount persists the number of series by count key.
+	saveSeriesCount(key []byte, count uint32) error
+	// countSeries counts the number of series ids mapping of metric.
+	countSeries(metricID metric.ID) (uint32, error)
+	// isEmpty checks if the backend storage hasn't any data.
+	isEmpty() (bool, error)
 	// sync the backend memory data into persist storage.
 	sync() error
 }
@@ -134,6 +172,118 @@ func (imb *idMappingBackend) genSeriesID(metricID metric.ID, tagsHash uint64, se
 	return imb.db.Put(key, scratch[:])
 }
 
+// getTombstones gets the tombstones of metric, if not exist return empty tombstones.
+func (imb *idMappingBackend) getTombstones(metricID metric.ID) (Tombstones, error) {
+	val, exist, err := imb.db.Get(tombstoneKey(metricID))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+	var tombstones Tombstones
+	if err := tombstones.UnmarshalBinary(val); err != nil {
+		return nil, err
+	}
+	return tombstones, nil
+}
+
+// saveTombstones persists the tombstones of metric.
+func (imb *idMappingBackend) saveTombstones(metricID metric.ID, tombstones Tombstones) error {
+	val, err := tombstones.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return imb.db.Put(tombstoneKey(metricID), val)
+}
+
+// dropMetric removes the series sequence, series count and all series ids mapping of metric, keeps the tombstones.
+func (imb *idMappingBackend) dropMetric(metricID metric.ID) error {
+	keys, err := imb.db.IterKeys(metricID.MarshalBinary(), math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	tombstone := tombstoneKey(metricID)
+	for _, key := range keys {
+		if bytes.Equal(key, tombstone) {
+			// keep tombstones for purging series data when compaction
+			continue
+		}
+		if err := imb.db.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDroppedTagKeys gets the dropped tag key ids, if not exist return empty bitmap.
+func (imb *idMappingBackend) getDroppedTagKeys() (*roaring.Bitmap, error) {
+	val, exist, err := imb.db.Get(droppedTagKeysKey)
+	if err != nil {
+		return nil, err
+	}
+	tagKeyIDs := roaring.New()
+	if !exist {
+		return tagKeyIDs, nil
+	}
+	if err := tagKeyIDs.UnmarshalBinary(val); err != nil {
+		return nil, err
+	}
+	return tagKeyIDs, nil
+}
+
+// saveDroppedTagKeys persists the dropped tag key ids.
+func (imb *idMappingBackend) saveDroppedTagKeys(tagKeyIDs *roaring.Bitmap) error {
+	val, err := tagKeyIDs.ToBytes()
+	if err != nil {
+		return err
+	}
+	return imb.db.Put(droppedTagKeysKey, val)
+}
+
+// getSeriesCount gets the number of series by count key, if not exist return 0.
+func (imb *idMappingBackend) getSeriesCount(key []byte) (uint32, error) {
+	val, exist, err := imb.db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if !exist {
+		return 0, nil
+	}
+	return binary.LittleEndian.Uint32(val), nil
+}
+
+// saveSeriesCount persists the number of series by count key.
+func (imb *idMappingBackend) saveSeriesCount(key []byte, count uint32) error {
+	return unique.SaveSequence(imb.db, key, count)
+}
+
+// countSeries counts the number of series ids mapping of metric.
+func (imb *idMappingBackend) countSeries(metricID metric.ID) (uint32, error) {
+	mID := metricID.MarshalBinary()
+	keys, err := imb.db.IterKeys(mID, math.MaxInt32)
+	if err != nil {
+		return 0, err
+	}
+	count := uint32(0)
+	for _, key := range keys {
+		// series key is metric id + tags hash, skip sequence/tombstone/series count key of metric
+		if len(key) == len(mID)+8 {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// isEmpty checks if the backend storage hasn't any data.
+func (imb *idMappingBackend) isEmpty() (bool, error) {
+	keys, err := imb.db.IterKeys(nil, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) == 0, nil
+}
+
 // Close closes the backend storage resource.
 func (imb *idMappingBackend) Close() error {
 	return imb.db.Close()
@@ -143,3 +293,18 @@ func (imb *idMappingBackend) Close() error {
 func (imb *idMappingBackend) sync() error {
 	return imb.db.Flush()
 }
+
+// tombstoneKey returns the key of metric tombstones.
+func tombstoneKey(metricID metric.ID) []byte {
+	return append(metricID.MarshalBinary(), tombstoneKeySuffix)
+}
+
+// metricSeriesCountKey returns the key of metric series count.
+func metricSeriesCountKey(metricID metric.ID) []byte {
+	return append(metricID.MarshalBinary(), seriesCountKeySuffix)
+}
+
+// namespaceSeriesCountKey returns the key of namespace series count.
+func namespaceSeriesCountKey(namespace string) []byte {
+	return append(append([]byte{}, seriesCountKeyPrefix...), namespace...)
+}
@@ -22,10 +22,12 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/pkg/unique"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
@@ -258,3 +260,159 @@ func TestIdMappingBackend_saveSeriesSequence(t *testing.T) {
 	store.EXPECT().Put([]byte{1, 0, 0, 0}, []byte{10, 0, 0, 0}).Return(nil)
 	assert.NoError(t, backend.saveSeriesSequence(metric.ID(1), uint32(10)))
 }
+
+func TestIDMappingBackend_tombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+
+	tombstones := Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 10, End: 100}, SeriesIDs: roaring.BitmapOf(1, 2)},
+	}
+	data, _ := tombstones.MarshalBinary()
+	idStore.EXPECT().Put([]byte{2, 0, 0, 0, tombstoneKeySuffix}, data).Return(nil)
+	assert.NoError(t, backend.saveTombstones(metric.ID(2), tombstones))
+
+	idStore.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
+	rs, err := backend.getTombstones(metric.ID(2))
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	idStore.EXPECT().Get(gomock.Any()).Return(nil, false, nil)
+	rs, err = backend.getTombstones(metric.ID(2))
+	assert.NoError(t, err)
+	assert.Empty(t, rs)
+	idStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 10, 1}, true, nil)
+	rs, err = backend.getTombstones(metric.ID(2))
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	idStore.EXPECT().Get(gomock.Any()).Return(data, true, nil)
+	rs, err = backend.getTombstones(metric.ID(2))
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+}
+
+func TestIDMappingBackend_dropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+
+	idStore.EXPECT().IterKeys([]byte{2, 0, 0, 0}, gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, backend.dropMetric(metric.ID(2)))
+
+	keys := [][]byte{{2, 0, 0, 0}, {2, 0, 0, 0, tombstoneKeySuffix}, {2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		{2, 0, 0, 0, seriesCountKeySuffix}}
+	idStore.EXPECT().IterKeys([]byte{2, 0, 0, 0}, gomock.Any()).Return(keys, nil)
+	idStore.EXPECT().Delete(keys[0]).Return(fmt.Errorf("err"))
+	assert.Error(t, backend.dropMetric(metric.ID(2)))
+
+	// keep tombstones
+	idStore.EXPECT().IterKeys([]byte{2, 0, 0, 0}, gomock.Any()).Return(keys, nil)
+	idStore.EXPECT().Delete(keys[0]).Return(nil)
+	idStore.EXPECT().Delete(keys[2]).Return(nil)
+	idStore.EXPECT().Delete(keys[3]).Return(nil)
+	assert.NoError(t, backend.dropMetric(metric.ID(2)))
+}
+
+func TestIDMappingBackend_seriesCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+
+	key := namespaceSeriesCountKey("ns")
+	assert.Equal(t, append([]byte("__$$series_count$$__"), "ns"...), key)
+	assert.Equal(t, []byte("__$$series_count$$__"), seriesCountKeyPrefix)
+	assert.Equal(t, []byte{2, 0, 0, 0, seriesCountKeySuffix}, metricSeriesCountKey(2))
+
+	idStore.EXPECT().Put(key, []byte{10, 0, 0, 0}).Return(nil)
+	assert.NoError(t, backend.saveSeriesCount(key, 10))
+
+	idStore.EXPECT().Get(key).Return(nil, false, fmt.Errorf("err"))
+	count, err := backend.getSeriesCount(key)
+	assert.Error(t, err)
+	assert.Zero(t, count)
+	idStore.EXPECT().Get(key).Return(nil, false, nil)
+	count, err = backend.getSeriesCount(key)
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+	idStore.EXPECT().Get(key).Return([]byte{10, 0, 0, 0}, true, nil)
+	count, err = backend.getSeriesCount(key)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), count)
+}
+
+func TestIDMappingBackend_countSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+
+	idStore.EXPECT().IterKeys([]byte{2, 0, 0, 0}, gomock.Any()).Return(nil, fmt.Errorf("err"))
+	count, err := backend.countSeries(metric.ID(2))
+	assert.Error(t, err)
+	assert.Zero(t, count)
+
+	keys := [][]byte{{2, 0, 0, 0}, {2, 0, 0, 0, tombstoneKeySuffix}, {2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0},
+		{2, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0}, {2, 0, 0, 0, seriesCountKeySuffix}}
+	idStore.EXPECT().IterKeys([]byte{2, 0, 0, 0}, gomock.Any()).Return(keys, nil)
+	count, err = backend.countSeries(metric.ID(2))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), count)
+}
+
+func TestIDMappingBackend_isEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+
+	idStore.EXPECT().IterKeys(nil, 1).Return(nil, fmt.Errorf("err"))
+	empty, err := backend.isEmpty()
+	assert.Error(t, err)
+	assert.False(t, empty)
+	idStore.EXPECT().IterKeys(nil, 1).Return(nil, nil)
+	empty, err = backend.isEmpty()
+	assert.NoError(t, err)
+	assert.True(t, empty)
+	idStore.EXPECT().IterKeys(nil, 1).Return([][]byte{{1, 0, 0, 0}}, nil)
+	empty, err = backend.isEmpty()
+	assert.NoError(t, err)
+	assert.False(t, empty)
+}
+
+func TestIDMappingBackend_droppedTagKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+
+	tagKeyIDs := roaring.BitmapOf(1, 2)
+	data, _ := tagKeyIDs.ToBytes()
+	idStore.EXPECT().Put(droppedTagKeysKey, data).Return(nil)
+	assert.NoError(t, backend.saveDroppedTagKeys(tagKeyIDs))
+
+	idStore.EXPECT().Get(droppedTagKeysKey).Return(nil, false, fmt.Errorf("err"))
+	rs, err := backend.getDroppedTagKeys()
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	idStore.EXPECT().Get(droppedTagKeysKey).Return(nil, false, nil)
+	rs, err = backend.getDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.True(t, rs.IsEmpty())
+	idStore.EXPECT().Get(droppedTagKeysKey).Return([]byte{1, 2, 3}, true, nil)
+	rs, err = backend.getDroppedTagKeys()
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	idStore.EXPECT().Get(droppedTagKeysKey).Return(data, true, nil)
+	rs, err = backend.getDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, tagKeyIDs.ToArray(), rs.ToArray())
+}
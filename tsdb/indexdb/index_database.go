@@ -20,6 +20,8 @@ package indexdb
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"sync"
 
 	"github.com/lindb/roaring"
@@ -29,6 +31,10 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -42,13 +48,18 @@ var (
 
 // indexDatabase implements IndexDatabase interface
 type indexDatabase struct {
-	path             string
-	ctx              context.Context
-	cancel           context.CancelFunc
-	backend          IDMappingBackend              // id mapping backend storage
-	metricID2Mapping map[metric.ID]MetricIDMapping // key: metric id, value: metric id mapping
-	metadata         metadb.Metadata               // the metadata for generating ID of metric, field
-	index            InvertedIndex
+	path              string
+	ctx               context.Context
+	cancel            context.CancelFunc
+	backend           IDMappingBackend              // id mapping backend storage
+	metricID2Mapping  map[metric.ID]MetricIDMapping // key: metric id, value: metric id mapping
+	metadata          metadb.Metadata               // the metadata for generating ID of metric, field
+	index             InvertedIndex
+	tombstones        map[metric.ID]Tombstones // key: metric id, value: tombstones of metric
+	droppedTagKeys    *roaring.Bitmap          // tag key ids of dropped metrics, lazy load from backend
+	limits            *option.Limits           // series cardinality limits
+	seriesCounts      map[string]uint32        // key: series count key, value: number of series, lazy load from backend
+	dirtySeriesCounts map[string]struct{}      // key: series count key which is changed but not persisted
 
 	statistics *metrics.IndexDBStatistics
 
@@ -66,14 +77,26 @@ func NewIndexDatabase(ctx context.Context, parent string, metadata metadb.Metada
 	}
 	c, cancel := context.WithCancel(ctx)
 	db := &indexDatabase{
-		path:             parent,
-		ctx:              c,
-		cancel:           cancel,
-		backend:          backend,
-		metadata:         metadata,
-		metricID2Mapping: make(map[metric.ID]MetricIDMapping),
-		index:            newInvertedIndex(metadata, forwardFamily, invertedFamily),
-		statistics:       metrics.NewIndexDBStatistics(metadata.DatabaseName()),
+		path:              parent,
+		ctx:               c,
+		cancel:            cancel,
+		backend:           backend,
+		metadata:          metadata,
+		metricID2Mapping:  make(map[metric.ID]MetricIDMapping),
+		tombstones:        make(map[metric.ID]Tombstones),
+		seriesCounts:      make(map[string]uint32),
+		dirtySeriesCounts: make(map[string]struct{}),
+		index:             newInvertedIndex(metadata, forwardFamily, invertedFamily),
+		statistics:        metrics.NewIndexDBStatistics(metadata.DatabaseName()),
+	}
+	// seed the series counts when opening, so that writing series not blocked by counting existing series
+	if err := db.seedSeriesCounts(); err != nil {
+		cancel()
+		if closeErr := backend.Close(); closeErr != nil {
+			indexLogger.Warn("close id mapping backend failure",
+				logger.String("path", parent), logger.Error(closeErr))
+		}
+		return nil, err
 	}
 
 	return db, nil
@@ -86,13 +109,56 @@ func (db *indexDatabase) SuggestTagValues(tagKeyID tag.KeyID, tagValuePrefix str
 
 // GetGroupingContext returns the context of group by
 func (db *indexDatabase) GetGroupingContext(ctx *flow.ShardExecuteContext) error {
-	return db.index.GetGroupingContext(ctx)
+	if err := db.index.GetGroupingContext(ctx); err != nil {
+		return err
+	}
+	return db.transformGroupingTagValues(ctx)
+}
+
+// transformGroupingTagValues transforms the tag values of group tags which are derived from source tag key,
+// so that the series with same derived tag value are merged into one group when building group.
+func (db *indexDatabase) transformGroupingTagValues(ctx *flow.ShardExecuteContext) error {
+	transforms := ctx.StorageExecuteCtx.GroupingTagTransforms
+	if len(transforms) == 0 {
+		return nil
+	}
+	seriesIDs := ctx.SeriesIDsAfterFiltering
+	tagValueIDs := make([]*roaring.Bitmap, len(transforms))
+	for idx := range tagValueIDs {
+		tagValueIDs[idx] = roaring.New()
+	}
+	highKeys := seriesIDs.GetHighKeys()
+	for i, highKey := range highKeys {
+		ids := ctx.GroupingContext.ScanTagValueIDs(highKey, seriesIDs.GetContainerAtIndex(i))
+		for idx, transform := range transforms {
+			if transform != nil {
+				tagValueIDs[idx].Or(ids[idx])
+			}
+		}
+	}
+	for idx, transform := range transforms {
+		if transform == nil {
+			continue
+		}
+		unresolved := transform.Unresolved(tagValueIDs[idx])
+		if unresolved.IsEmpty() {
+			continue
+		}
+		tagValues := make(map[uint32]string) // tag value id => tag value
+		// collect tag values will remove found tag value ids, so need clone it
+		err := db.metadata.TagMetadata().CollectTagValues(ctx.StorageExecuteCtx.GroupByTagKeyIDs[idx], unresolved.Clone(), tagValues)
+		if err != nil {
+			return err
+		}
+		transform.Resolve(unresolved, tagValues)
+	}
+	return nil
 }
 
 // GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 // if generate a new series id returns isCreate is true
-// if generate fail return err
-func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64,
+// if generate fail return err, if the number of series exceeds the limits return series.ErrTooManySeries
+func (db *indexDatabase) GetOrCreateSeriesID(namespace, metricName string, metricID metric.ID, tagsHash uint64,
 ) (seriesID uint32, isCreated bool, err error) {
 	db.rwMutex.Lock()
 	defer db.rwMutex.Unlock()
@@ -124,6 +190,10 @@ func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64
 	if err != nil && !errors.Is(err, constants.ErrNotFound) {
 		return series.EmptySeriesID, false, err
 	}
+	// check series limits before generating new series id
+	if err := db.checkSeriesLimits(namespace, metricName, metricID); err != nil {
+		return series.EmptySeriesID, false, err
+	}
 	// check if sequence need store
 	seq := metricIDMapping.SeriesSequence()
 	if !seq.HasNext() {
@@ -140,6 +210,9 @@ func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64
 	if err := db.backend.genSeriesID(metricID, tagsHash, seriesID); err != nil {
 		return series.EmptySeriesID, false, err
 	}
+	if err := db.incSeriesCount(namespace, metricID); err != nil {
+		return series.EmptySeriesID, false, err
+	}
 
 	return seriesID, true, nil
 }
@@ -154,6 +227,11 @@ func (db *indexDatabase) GetSeriesIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap
 	return db.index.GetSeriesIDsForTag(tagKeyID)
 }
 
+// GetSeriesCountByTagValue returns the number of series for each tag value id under spec tag key
+func (db *indexDatabase) GetSeriesCountByTagValue(tagKeyID tag.KeyID) (map[uint32]int, error) {
+	return db.index.GetSeriesCountByTagValue(tagKeyID)
+}
+
 // GetSeriesIDsForMetric gets series ids for spec metric name
 func (db *indexDatabase) GetSeriesIDsForMetric(namespace, metricName string) (*roaring.Bitmap, error) {
 	// get all tags under metric
@@ -186,10 +264,397 @@ func (db *indexDatabase) BuildInvertIndex(
 	db.statistics.BuildInvertedIndex.Incr()
 }
 
+// DeleteSeries marks the series ids of metric deleted within time range,
+// deleted series will be filtered when query and be removed when compaction,
+// the series deleted first time release their quota of database/namespace/metric.
+func (db *indexDatabase) DeleteSeries(namespace string, metricID metric.ID,
+	seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange,
+) error {
+	if seriesIDs == nil || seriesIDs.IsEmpty() {
+		return nil
+	}
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	tombstones, err := db.loadTombstones(metricID)
+	if err != nil {
+		return err
+	}
+	countKeys := [][]byte{seriesCountKeyPrefix, namespaceSeriesCountKey(namespace), metricSeriesCountKey(metricID)}
+	counts := make([]uint32, len(countKeys))
+	for idx, key := range countKeys {
+		if counts[idx], err = db.getSeriesCount(key); err != nil {
+			return err
+		}
+	}
+	newDeleted := seriesIDs.Clone()
+	for _, t := range tombstones {
+		newDeleted.AndNot(t.SeriesIDs)
+	}
+	released := uint32(newDeleted.GetCardinality())
+	if metricCount := counts[len(counts)-1]; released > metricCount {
+		// series ids maybe not exist(e.g. series without tags), cannot release more than the series of metric
+		released = metricCount
+	}
+	// copy on write, because query maybe read old tombstones concurrently
+	newTombstones := make(Tombstones, 0, len(tombstones)+1)
+	merged := false
+	for _, t := range tombstones {
+		if t.TimeRange == timeRange {
+			// merge series ids into tombstone with same time range
+			t = &Tombstone{TimeRange: timeRange, SeriesIDs: roaring.Or(t.SeriesIDs, seriesIDs)}
+			merged = true
+		}
+		newTombstones = append(newTombstones, t)
+	}
+	if !merged {
+		newTombstones = append(newTombstones, &Tombstone{TimeRange: timeRange, SeriesIDs: seriesIDs.Clone()})
+	}
+	if err := db.backend.saveTombstones(metricID, newTombstones); err != nil {
+		return err
+	}
+	if err := db.backend.sync(); err != nil {
+		return err
+	}
+	db.tombstones[metricID] = newTombstones
+	if released > 0 {
+		for idx, key := range countKeys {
+			if counts[idx] > released {
+				db.setSeriesCount(key, counts[idx]-released)
+			} else {
+				db.setSeriesCount(key, 0)
+			}
+		}
+	}
+	db.statistics.DeleteSeries.Add(float64(seriesIDs.GetCardinality()))
+	return nil
+}
+
+// GetTombstones returns the tombstones of metric.
+func (db *indexDatabase) GetTombstones(metricID metric.ID) (Tombstones, error) {
+	db.rwMutex.RLock()
+	tombstones, ok := db.tombstones[metricID]
+	db.rwMutex.RUnlock()
+	if ok {
+		return tombstones, nil
+	}
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+	return db.loadTombstones(metricID)
+}
+
+// loadTombstones loads the tombstones of metric from backend storage if not cached, must hold write lock.
+func (db *indexDatabase) loadTombstones(metricID metric.ID) (Tombstones, error) {
+	if tombstones, ok := db.tombstones[metricID]; ok {
+		return tombstones, nil
+	}
+	tombstones, err := db.backend.getTombstones(metricID)
+	if err != nil {
+		return nil, err
+	}
+	db.tombstones[metricID] = tombstones
+	return tombstones, nil
+}
+
+// DropMetric drops the all series of metric, the series ids mapping is removed immediately,
+// series data and the inverted index of metric's tag keys will be removed when compaction.
+func (db *indexDatabase) DropMetric(namespace string, metricID metric.ID, tagKeyIDs []tag.KeyID) (int, error) {
+	var seriesIDs *roaring.Bitmap
+	if len(tagKeyIDs) == 0 {
+		// if metric hasn't any tags, only has default series id(0)
+		seriesIDs = roaring.BitmapOf(series.IDWithoutTags)
+	} else {
+		var err error
+		seriesIDs, err = db.index.GetSeriesIDsForTags(tagKeyIDs)
+		if err != nil {
+			return 0, err
+		}
+	}
+	// mark all series deleted within whole time range
+	if err := db.DeleteSeries(namespace, metricID, seriesIDs, timeutil.TimeRange{Start: 0, End: math.MaxInt64}); err != nil {
+		return 0, err
+	}
+
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	metricCountKey := metricSeriesCountKey(metricID)
+	metricSeriesCount, err := db.getSeriesCount(metricCountKey)
+	if err != nil {
+		return 0, err
+	}
+	if err := db.backend.dropMetric(metricID); err != nil {
+		return 0, err
+	}
+	delete(db.metricID2Mapping, metricID)
+	delete(db.seriesCounts, string(metricCountKey))
+	delete(db.dirtySeriesCounts, string(metricCountKey))
+	// release the series quota of database/namespace
+	for _, key := range [][]byte{seriesCountKeyPrefix, namespaceSeriesCountKey(namespace)} {
+		count, err := db.getSeriesCount(key)
+		if err != nil {
+			return 0, err
+		}
+		if count > metricSeriesCount {
+			count -= metricSeriesCount
+		} else {
+			count = 0
+		}
+		db.setSeriesCount(key, count)
+	}
+	if err := db.persistSeriesCounts(); err != nil {
+		return 0, err
+	}
+
+	if len(tagKeyIDs) > 0 {
+		droppedTagKeys, err := db.loadDroppedTagKeys()
+		if err != nil {
+			return 0, err
+		}
+		// copy on write, because compaction maybe read old dropped tag keys concurrently
+		newDroppedTagKeys := droppedTagKeys.Clone()
+		for _, tagKeyID := range tagKeyIDs {
+			newDroppedTagKeys.Add(uint32(tagKeyID))
+		}
+		if err := db.backend.saveDroppedTagKeys(newDroppedTagKeys); err != nil {
+			return 0, err
+		}
+		db.droppedTagKeys = newDroppedTagKeys
+	}
+	if err := db.backend.sync(); err != nil {
+		return 0, err
+	}
+	return int(seriesIDs.GetCardinality()), nil
+}
+
+// IsTagKeyDropped checks if the tag key is dropped, the inverted index of dropped
+// tag key will be removed when compaction.
+func (db *indexDatabase) IsTagKeyDropped(tagKeyID tag.KeyID) bool {
+	db.rwMutex.RLock()
+	droppedTagKeys := db.droppedTagKeys
+	db.rwMutex.RUnlock()
+	if droppedTagKeys == nil {
+		db.rwMutex.Lock()
+		var err error
+		droppedTagKeys, err = db.loadDroppedTagKeys()
+		db.rwMutex.Unlock()
+		if err != nil {
+			indexLogger.Warn("load dropped tag keys failure, cannot purge inverted index",
+				logger.String("path", db.path), logger.Error(err))
+			return false
+		}
+	}
+	return droppedTagKeys.Contains(uint32(tagKeyID))
+}
+
+// loadDroppedTagKeys loads the dropped tag keys from backend storage if not cached, must hold write lock.
+func (db *indexDatabase) loadDroppedTagKeys() (*roaring.Bitmap, error) {
+	if db.droppedTagKeys != nil {
+		return db.droppedTagKeys, nil
+	}
+	droppedTagKeys, err := db.backend.getDroppedTagKeys()
+	if err != nil {
+		return nil, err
+	}
+	db.droppedTagKeys = droppedTagKeys
+	return droppedTagKeys, nil
+}
+
+// SetLimits sets the series cardinality limits.
+func (db *indexDatabase) SetLimits(limits *option.Limits) {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	db.limits = limits
+}
+
+// GetLimitUsage returns the number of series against each limit, the database's usage is always returned.
+func (db *indexDatabase) GetLimitUsage() ([]models.SeriesLimitUsage, error) {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	limits := db.limits
+	count, err := db.getSeriesCount(seriesCountKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	rs := []models.SeriesLimitUsage{{Scope: models.DatabaseLimitScope, Name: db.metadata.DatabaseName(), Usage: count}}
+	if limits == nil {
+		return rs, nil
+	}
+	rs[0].Limit = limits.MaxSeriesPerShard
+	for _, limit := range limits.Namespaces {
+		count, err := db.getSeriesCount(namespaceSeriesCountKey(limit.Namespace))
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, models.SeriesLimitUsage{
+			Scope: models.NamespaceLimitScope,
+			Name:  limit.Namespace,
+			Limit: limit.MaxSeriesPerShard,
+			Usage: count,
+		})
+	}
+	for _, limit := range limits.Metrics {
+		usage := models.SeriesLimitUsage{
+			Scope: models.MetricLimitScope,
+			Name:  limit.GetNamespace() + "/" + limit.Metric,
+			Limit: limit.MaxSeriesPerShard,
+		}
+		metricID, err := db.metadata.MetadataDatabase().GetMetricID(limit.GetNamespace(), limit.Metric)
+		if err != nil && !errors.Is(err, constants.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			if usage.Usage, err = db.getSeriesCount(metricSeriesCountKey(metricID)); err != nil {
+				return nil, err
+			}
+		}
+		rs = append(rs, usage)
+	}
+	return rs, nil
+}
+
+// checkSeriesLimits checks if the number of series reaches the limits of database/namespace/metric,
+// if reached return series.ErrTooManySeries, must hold write lock.
+func (db *indexDatabase) checkSeriesLimits(namespace, metricName string, metricID metric.ID) error {
+	limits := db.limits
+	if limits == nil {
+		return nil
+	}
+	check := func(key []byte, limit uint32, scope models.LimitScope, name string) error {
+		if limit == 0 {
+			return nil
+		}
+		count, err := db.getSeriesCount(key)
+		if err != nil {
+			return err
+		}
+		if count >= limit {
+			return fmt.Errorf("%w, %s: %s, limit: %d", series.ErrTooManySeries, scope, name, limit)
+		}
+		return nil
+	}
+	if err := check(seriesCountKeyPrefix, limits.MaxSeriesPerShard,
+		models.DatabaseLimitScope, db.metadata.DatabaseName()); err != nil {
+		return err
+	}
+	if err := check(namespaceSeriesCountKey(namespace), limits.GetNamespaceLimit(namespace),
+		models.NamespaceLimitScope, namespace); err != nil {
+		return err
+	}
+	return check(metricSeriesCountKey(metricID), limits.GetMetricLimit(namespace, metricName),
+		models.MetricLimitScope, namespace+"/"+metricName)
+}
+
+// incSeriesCount increases the number of series of database/namespace/metric in memory,
+// the counts are persisted when flush, must hold write lock.
+func (db *indexDatabase) incSeriesCount(namespace string, metricID metric.ID) error {
+	for _, key := range [][]byte{seriesCountKeyPrefix, namespaceSeriesCountKey(namespace), metricSeriesCountKey(metricID)} {
+		count, err := db.getSeriesCount(key)
+		if err != nil {
+			return err
+		}
+		db.setSeriesCount(key, count+1)
+	}
+	return nil
+}
+
+// getSeriesCount returns the number of series by count key, loads from backend storage if not cached,
+// must hold write lock.
+func (db *indexDatabase) getSeriesCount(key []byte) (uint32, error) {
+	if count, ok := db.seriesCounts[string(key)]; ok {
+		return count, nil
+	}
+	count, err := db.backend.getSeriesCount(key)
+	if err != nil {
+		return 0, err
+	}
+	db.seriesCounts[string(key)] = count
+	return count, nil
+}
+
+// setSeriesCount caches the number of series by count key, marks it dirty for persisting, must hold write lock.
+func (db *indexDatabase) setSeriesCount(key []byte, count uint32) {
+	db.seriesCounts[string(key)] = count
+	db.dirtySeriesCounts[string(key)] = struct{}{}
+}
+
+// persistSeriesCounts persists the dirty series counts into backend storage, must hold write lock.
+func (db *indexDatabase) persistSeriesCounts() error {
+	for key := range db.dirtySeriesCounts {
+		if err := db.backend.saveSeriesCount([]byte(key), db.seriesCounts[key]); err != nil {
+			return err
+		}
+		delete(db.dirtySeriesCounts, key)
+	}
+	return nil
+}
+
+// seedSeriesCounts counts the existing series of all metrics once when opening, because the series created
+// before series counting is supported are not counted.
+func (db *indexDatabase) seedSeriesCounts() error {
+	seeded, err := db.backend.getSeriesCount(seriesCountSeededKey)
+	if err != nil {
+		return err
+	}
+	if seeded > 0 {
+		return nil
+	}
+	empty, err := db.backend.isEmpty()
+	if err != nil {
+		return err
+	}
+	if !empty {
+		if err := db.countExistingSeries(); err != nil {
+			return err
+		}
+	}
+	db.setSeriesCount(seriesCountSeededKey, 1)
+	return nil
+}
+
+// countExistingSeries counts the number of series of database/namespace/metric from backend storage.
+func (db *indexDatabase) countExistingSeries() error {
+	metadata := db.metadata.MetadataDatabase()
+	namespaces, err := metadata.SuggestNamespace("", math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	total := uint32(0)
+	for _, namespace := range namespaces {
+		metricNames, err := metadata.SuggestMetrics(namespace, "", math.MaxInt32)
+		if err != nil {
+			return err
+		}
+		nsCount := uint32(0)
+		for _, metricName := range metricNames {
+			metricID, err := metadata.GetMetricID(namespace, metricName)
+			if err != nil {
+				return err
+			}
+			count, err := db.backend.countSeries(metricID)
+			if err != nil {
+				return err
+			}
+			db.setSeriesCount(metricSeriesCountKey(metricID), count)
+			nsCount += count
+		}
+		db.setSeriesCount(namespaceSeriesCountKey(namespace), nsCount)
+		total += nsCount
+	}
+	db.setSeriesCount(seriesCountKeyPrefix, total)
+	return nil
+}
+
 // Flush flushes index data to disk
 func (db *indexDatabase) Flush() error {
 	// TODO need flush metric level time series sequence?
 	db.rwMutex.Lock()
+	if err := db.persistSeriesCounts(); err != nil {
+		db.rwMutex.Unlock()
+		return err
+	}
 	if err := db.backend.sync(); err != nil {
 		db.rwMutex.Unlock()
 		return err
@@ -20,7 +20,9 @@ package indexdb
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -30,11 +32,15 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/pkg/unique"
 	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
 
@@ -131,6 +137,8 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 		metricID2Mapping: map[metric.ID]MetricIDMapping{
 			2: mapping,
 		},
+		seriesCounts:      make(map[string]uint32),
+		dirtySeriesCounts: make(map[string]struct{}),
 	}
 
 	cases := []struct {
@@ -268,7 +276,29 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 			},
 		},
 		{
-
+			name:     "increase series count failure",
+			metricID: 2,
+			tagsHash: 334,
+			prepare: func() {
+				mapping.EXPECT().GetSeriesID(gomock.Any()).Return(series.EmptySeriesID, false)
+				mapping.EXPECT().GenSeriesID(gomock.Any()).Return(uint32(334))
+				mapping.EXPECT().SeriesSequence().Return(sequence)
+				sequence.EXPECT().HasNext().Return(true)
+				backend.EXPECT().getSeriesID(gomock.Any(), gomock.Any()).Return(series.EmptySeriesID, constants.ErrNotFound)
+				backend.EXPECT().genSeriesID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				backend.EXPECT().getSeriesCount(gomock.Any()).Return(uint32(0), fmt.Errorf("err"))
+			},
+			out: struct {
+				seriesID uint32
+				isCreate bool
+				err      error
+			}{
+				seriesID: series.EmptySeriesID,
+				isCreate: false,
+				err:      fmt.Errorf("err"),
+			},
+		},
+		{
 			name:     "save series id successfully from backend",
 			metricID: 2,
 			tagsHash: 333,
@@ -279,6 +309,7 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 				sequence.EXPECT().HasNext().Return(true)
 				backend.EXPECT().getSeriesID(gomock.Any(), gomock.Any()).Return(series.EmptySeriesID, constants.ErrNotFound)
 				backend.EXPECT().genSeriesID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				backend.EXPECT().getSeriesCount(gomock.Any()).Return(uint32(0), nil).Times(3)
 			},
 			out: struct {
 				seriesID uint32
@@ -334,7 +365,7 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 				tt.prepare()
 			}
 
-			seriesID, isCreate, err := db.GetOrCreateSeriesID(tt.metricID, tt.tagsHash)
+			seriesID, isCreate, err := db.GetOrCreateSeriesID("ns", "cpu", tt.metricID, tt.tagsHash)
 			assert.Equal(t, tt.out.seriesID, seriesID)
 			assert.Equal(t, tt.out.isCreate, isCreate)
 			assert.Equal(t, tt.out.err, err)
@@ -365,6 +396,38 @@ func TestIndexDatabase_GetGroupingContext(t *testing.T) {
 	err = db.GetGroupingContext(shardExecuteCtx)
 	assert.NoError(t, err)
 	assert.Nil(t, shardExecuteCtx.GroupingContext)
+	// get grouping context failure
+	index.EXPECT().GetGroupingContext(gomock.Any()).Return(fmt.Errorf("err"))
+	err = db.GetGroupingContext(shardExecuteCtx)
+	assert.Error(t, err)
+
+	// transform tag values of group tag
+	transform, err := flow.NewTagValueTransform(&stmt.TagTransform{Type: stmt.RegexExtract, Regex: "^(\\w+)-"})
+	assert.NoError(t, err)
+	groupingCtx := flow.NewMockGroupingContext(ctrl)
+	shardExecuteCtx.GroupingContext = groupingCtx
+	shardExecuteCtx.StorageExecuteCtx.GroupingTagTransforms = []*flow.TagValueTransform{nil, transform}
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	meta.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	index.EXPECT().GetGroupingContext(gomock.Any()).Return(nil).AnyTimes()
+	groupingCtx.EXPECT().ScanTagValueIDs(gomock.Any(), gomock.Any()).
+		Return([]*roaring.Bitmap{roaring.BitmapOf(1), roaring.BitmapOf(10, 20)}).AnyTimes()
+	tagMeta.EXPECT().CollectTagValues(tag.KeyID(2), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	err = db.GetGroupingContext(shardExecuteCtx)
+	assert.Error(t, err)
+	tagMeta.EXPECT().CollectTagValues(tag.KeyID(2), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ tag.KeyID, _ *roaring.Bitmap, tagValues map[uint32]string) error {
+			tagValues[10] = "sh-1"
+			tagValues[20] = "bj-1"
+			return nil
+		})
+	err = db.GetGroupingContext(shardExecuteCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), transform.DerivedID(10))
+	assert.Equal(t, uint32(1), transform.DerivedID(20))
+	// all tag values transformed
+	err = db.GetGroupingContext(shardExecuteCtx)
+	assert.NoError(t, err)
 
 	index.EXPECT().Flush().Return(nil)
 	err = db.Close()
@@ -431,6 +494,7 @@ func TestIndexDatabase_Close(t *testing.T) {
 	createBackendFn = func(parent string) (IDMappingBackend, error) {
 		return backend, nil
 	}
+	backend.EXPECT().getSeriesCount(seriesCountSeededKey).Return(uint32(1), nil)
 	backend.EXPECT().sync().Return(nil)
 
 	meta := metadb.NewMockMetadata(ctrl)
@@ -458,6 +522,7 @@ func TestIndexDatabase_Flush(t *testing.T) {
 	createBackendFn = func(parent string) (IDMappingBackend, error) {
 		return backend, nil
 	}
+	backend.EXPECT().getSeriesCount(seriesCountSeededKey).Return(uint32(1), nil)
 
 	meta := metadb.NewMockMetadata(ctrl)
 	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
@@ -468,4 +533,321 @@ func TestIndexDatabase_Flush(t *testing.T) {
 
 	backend.EXPECT().sync().Return(fmt.Errorf("err"))
 	assert.Error(t, db.Flush())
+
+	// persist series counts failure
+	db.(*indexDatabase).setSeriesCount(seriesCountKeyPrefix, 10)
+	backend.EXPECT().saveSeriesCount(seriesCountKeyPrefix, uint32(10)).Return(fmt.Errorf("err"))
+	assert.Error(t, db.Flush())
+	backend.EXPECT().saveSeriesCount(seriesCountKeyPrefix, uint32(10)).Return(nil)
+	backend.EXPECT().sync().Return(nil)
+	assert.NoError(t, db.Flush())
+	// series counts persisted
+	backend.EXPECT().sync().Return(nil)
+	assert.NoError(t, db.Flush())
+}
+
+func TestIndexDatabase_DeleteSeries(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createBackendFn = newIDMappingBackend
+		ctrl.Finish()
+	}()
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+	backend.EXPECT().getSeriesCount(seriesCountSeededKey).Return(uint32(1), nil)
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+
+	timeRange := timeutil.TimeRange{Start: 10, End: 100}
+	// empty series ids
+	assert.NoError(t, db.DeleteSeries("ns", 1, roaring.New(), timeRange))
+	// load tombstones failure
+	backend.EXPECT().getTombstones(metric.ID(1)).Return(nil, fmt.Errorf("err")).Times(2)
+	assert.Error(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(1), timeRange))
+	tombstones, err := db.GetTombstones(1)
+	assert.Error(t, err)
+	assert.Nil(t, tombstones)
+	// get series count failure
+	backend.EXPECT().getTombstones(metric.ID(1)).Return(nil, nil)
+	backend.EXPECT().getSeriesCount(seriesCountKeyPrefix).Return(uint32(0), fmt.Errorf("err"))
+	assert.Error(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(1), timeRange))
+	backend.EXPECT().getSeriesCount(gomock.Any()).Return(uint32(0), nil).AnyTimes()
+	// save tombstones failure
+	backend.EXPECT().saveTombstones(metric.ID(1), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(1), timeRange))
+	// sync failure
+	backend.EXPECT().saveTombstones(metric.ID(1), gomock.Any()).Return(nil)
+	backend.EXPECT().sync().Return(fmt.Errorf("err"))
+	assert.Error(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(1), timeRange))
+	// tombstones not changed after failure
+	tombstones, err = db.GetTombstones(1)
+	assert.NoError(t, err)
+	assert.Empty(t, tombstones)
+
+	backend.EXPECT().saveTombstones(metric.ID(1), gomock.Any()).Return(nil).Times(3)
+	backend.EXPECT().sync().Return(nil).Times(3)
+	assert.NoError(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(1), timeRange))
+	// merge same time range
+	assert.NoError(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(2), timeRange))
+	assert.NoError(t, db.DeleteSeries("ns", 1, roaring.BitmapOf(3), timeutil.TimeRange{Start: 10, End: 200}))
+	tombstones, err = db.GetTombstones(1)
+	assert.NoError(t, err)
+	assert.Len(t, tombstones, 2)
+	assert.Equal(t, roaring.BitmapOf(1, 2), tombstones[0].SeriesIDs)
+	assert.Equal(t, roaring.BitmapOf(3), tombstones[1].SeriesIDs)
+	assert.Equal(t, roaring.BitmapOf(1, 2, 3), tombstones.GetDeletedSeriesIDs(timeutil.TimeRange{Start: 20, End: 30}))
+}
+
+func TestIndexDatabase_DropMetric(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createBackendFn = newIDMappingBackend
+		ctrl.Finish()
+	}()
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+	backend.EXPECT().getSeriesCount(seriesCountSeededKey).Return(uint32(1), nil)
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	index := NewMockInvertedIndex(ctrl)
+	db.(*indexDatabase).index = index
+	backend.EXPECT().getTombstones(gomock.Any()).Return(nil, nil).AnyTimes()
+	backend.EXPECT().saveTombstones(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	backend.EXPECT().getSeriesCount(gomock.Any()).Return(uint32(0), nil).AnyTimes()
+	backend.EXPECT().saveSeriesCount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// get series ids failure
+	index.EXPECT().GetSeriesIDsForTags([]tag.KeyID{1, 2}).Return(nil, fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", 1, []tag.KeyID{1, 2})
+	assert.Error(t, err)
+	// mark series deleted failure
+	backend.EXPECT().sync().Return(fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", 1, nil)
+	assert.Error(t, err)
+	// drop metric from backend failure
+	backend.EXPECT().sync().Return(nil)
+	backend.EXPECT().dropMetric(metric.ID(1)).Return(fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", 1, nil)
+	assert.Error(t, err)
+	// load dropped tag keys failure
+	index.EXPECT().GetSeriesIDsForTags([]tag.KeyID{1, 2}).Return(roaring.BitmapOf(1, 2, 3), nil).AnyTimes()
+	backend.EXPECT().sync().Return(nil)
+	backend.EXPECT().dropMetric(metric.ID(1)).Return(nil)
+	backend.EXPECT().getDroppedTagKeys().Return(nil, fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", 1, []tag.KeyID{1, 2})
+	assert.Error(t, err)
+	backend.EXPECT().getDroppedTagKeys().Return(nil, fmt.Errorf("err"))
+	assert.False(t, db.IsTagKeyDropped(1))
+	// save dropped tag keys failure
+	backend.EXPECT().sync().Return(nil)
+	backend.EXPECT().dropMetric(metric.ID(1)).Return(nil)
+	backend.EXPECT().getDroppedTagKeys().Return(roaring.BitmapOf(5), nil)
+	backend.EXPECT().saveDroppedTagKeys(gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", 1, []tag.KeyID{1, 2})
+	assert.Error(t, err)
+	assert.False(t, db.IsTagKeyDropped(1))
+	assert.True(t, db.IsTagKeyDropped(5))
+	// sync failure
+	backend.EXPECT().sync().Return(nil)
+	backend.EXPECT().dropMetric(metric.ID(1)).Return(nil)
+	backend.EXPECT().saveDroppedTagKeys(gomock.Any()).Return(nil)
+	backend.EXPECT().sync().Return(fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", 1, []tag.KeyID{1, 2})
+	assert.Error(t, err)
+	// drop successfully
+	backend.EXPECT().sync().Return(nil).Times(2)
+	backend.EXPECT().dropMetric(metric.ID(1)).Return(nil)
+	backend.EXPECT().saveDroppedTagKeys(roaring.BitmapOf(1, 2, 5)).Return(nil)
+	n, err := db.DropMetric("ns", 1, []tag.KeyID{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.True(t, db.IsTagKeyDropped(1))
+	assert.True(t, db.IsTagKeyDropped(2))
+	assert.False(t, db.IsTagKeyDropped(3))
+	// all series are deleted
+	tombstones, err := db.GetTombstones(1)
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(0, 1, 2, 3), tombstones.GetDeletedSeriesIDs(timeutil.TimeRange{Start: 10, End: 20}))
+}
+
+func TestIndexDatabase_SeriesLimits(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, db.Close())
+	}()
+
+	// no limits
+	rs, err := db.GetLimitUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SeriesLimitUsage{{Scope: models.DatabaseLimitScope, Name: "test"}}, rs)
+
+	db.SetLimits(&option.Limits{
+		MaxSeriesPerShard: 5,
+		Namespaces:        []option.NamespaceLimit{{Namespace: "ns", MaxSeriesPerShard: 3}},
+		Metrics: []option.MetricLimit{
+			{Namespace: "ns", Metric: "cpu", MaxSeriesPerShard: 2},
+			{Metric: "memory", MaxSeriesPerShard: 10},
+		},
+	})
+	create := func(namespace, metricName string, metricID metric.ID, tagsHash uint64) error {
+		_, _, err := db.GetOrCreateSeriesID(namespace, metricName, metricID, tagsHash)
+		return err
+	}
+	// metric limit
+	assert.NoError(t, create("ns", "cpu", 1, 1))
+	assert.NoError(t, create("ns", "cpu", 1, 2))
+	assert.True(t, errors.Is(create("ns", "cpu", 1, 3), series.ErrTooManySeries))
+	// exist series not limit
+	assert.NoError(t, create("ns", "cpu", 1, 1))
+	// namespace limit
+	assert.NoError(t, create("ns", "disk", 2, 1))
+	assert.True(t, errors.Is(create("ns", "disk", 2, 2), series.ErrTooManySeries))
+	// database limit
+	assert.NoError(t, create("ns2", "cpu", 3, 1))
+	assert.NoError(t, create("ns2", "cpu", 3, 2))
+	assert.True(t, errors.Is(create("ns2", "cpu", 3, 3), series.ErrTooManySeries))
+
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+	metaDB.EXPECT().GetMetricID(constants.DefaultNamespace, "memory").Return(metric.ID(0), constants.ErrNotFound)
+	rs, err = db.GetLimitUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SeriesLimitUsage{
+		{Scope: models.DatabaseLimitScope, Name: "test", Limit: 5, Usage: 5},
+		{Scope: models.NamespaceLimitScope, Name: "ns", Limit: 3, Usage: 3},
+		{Scope: models.MetricLimitScope, Name: "ns/cpu", Limit: 2, Usage: 2},
+		{Scope: models.MetricLimitScope, Name: constants.DefaultNamespace + "/memory", Limit: 10},
+	}, rs)
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+	rs, err = db.GetLimitUsage()
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+
+	// delete series releases the series quota
+	timeRange := timeutil.TimeRange{Start: 10, End: 100}
+	assert.NoError(t, db.DeleteSeries("ns", 2, roaring.BitmapOf(1), timeRange))
+	// deleted series not release quota again
+	assert.NoError(t, db.DeleteSeries("ns", 2, roaring.BitmapOf(1), timeutil.TimeRange{Start: 100, End: 200}))
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+	metaDB.EXPECT().GetMetricID(constants.DefaultNamespace, "memory").Return(metric.ID(0), constants.ErrNotFound)
+	rs, err = db.GetLimitUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4), rs[0].Usage)
+	assert.Equal(t, uint32(2), rs[1].Usage)
+	assert.NoError(t, create("ns", "disk", 2, 2))
+
+	// drop metric releases the series quota
+	_, err = db.DropMetric("ns", 1, nil)
+	assert.NoError(t, err)
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+	metaDB.EXPECT().GetMetricID(constants.DefaultNamespace, "memory").Return(metric.ID(0), constants.ErrNotFound)
+	rs, err = db.GetLimitUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), rs[0].Usage)
+	assert.Equal(t, uint32(1), rs[1].Usage)
+	assert.Equal(t, uint32(0), rs[2].Usage)
+	assert.NoError(t, create("ns", "cpu", 1, 3))
+}
+
+func TestIndexDatabase_SeedSeriesCounts(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createBackendFn = newIDMappingBackend
+		ctrl.Finish()
+	}()
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+	// get seeded flag failure
+	backend.EXPECT().getSeriesCount(seriesCountSeededKey).Return(uint32(0), fmt.Errorf("err"))
+	backend.EXPECT().Close().Return(fmt.Errorf("err"))
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, db)
+	// check empty failure
+	backend.EXPECT().getSeriesCount(seriesCountSeededKey).Return(uint32(0), nil)
+	backend.EXPECT().isEmpty().Return(false, fmt.Errorf("err"))
+	backend.EXPECT().Close().Return(nil)
+	db, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, db)
+	createBackendFn = newIDMappingBackend
+
+	// series created before series counting is supported
+	legacyBackend, err := newIDMappingBackend(testPath)
+	assert.NoError(t, err)
+	assert.NoError(t, legacyBackend.genSeriesID(1, 1, 1))
+	assert.NoError(t, legacyBackend.genSeriesID(1, 2, 2))
+	assert.NoError(t, legacyBackend.genSeriesID(2, 1, 1))
+	assert.NoError(t, legacyBackend.sync())
+	assert.NoError(t, legacyBackend.Close())
+
+	// seed failure
+	metaDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+	_, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.Error(t, err)
+	metaDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return([]string{"ns"}, nil)
+	metaDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+	_, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.Error(t, err)
+	metaDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return([]string{"ns"}, nil)
+	metaDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return([]string{"cpu", "disk"}, nil)
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+	_, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.Error(t, err)
+	// seed successfully when opening
+	metaDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return([]string{"ns"}, nil)
+	metaDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return([]string{"cpu", "disk"}, nil)
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+	metaDB.EXPECT().GetMetricID("ns", "disk").Return(metric.ID(2), nil)
+	db, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	rs, err := db.GetLimitUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), rs[0].Usage)
+	_, _, err = db.GetOrCreateSeriesID("ns", "cpu", 1, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Close())
+
+	// reopen, series counts are persisted, not seed again
+	db, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, db.Close())
+	}()
+	db.SetLimits(&option.Limits{Namespaces: []option.NamespaceLimit{{Namespace: "ns", MaxSeriesPerShard: 4}}})
+	rs, err = db.GetLimitUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4), rs[0].Usage)
+	assert.Equal(t, uint32(4), rs[1].Usage)
+	_, _, err = db.GetOrCreateSeriesID("ns", "disk", 2, 2)
+	assert.True(t, errors.Is(err, series.ErrTooManySeries))
 }
@@ -20,10 +20,16 @@ package indexdb
 import (
 	"io"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
 )
 
 //go:generate mockgen -source ./interface.go -destination=./interface_mock.go -package=indexdb
@@ -48,11 +54,32 @@ type IndexDatabase interface {
 	series.Filter
 	// GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 	// if generate a new series id returns isCreate is true
-	// if generate fail return err
-	GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, isCreated bool, err error)
+	// if generate fail return err, if the number of series exceeds the limits return series.ErrTooManySeries
+	GetOrCreateSeriesID(namespace, metricName string, metricID metric.ID,
+		tagsHash uint64) (seriesID uint32, isCreated bool, err error)
 	// BuildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
 	BuildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32)
+	// DeleteSeries marks the series ids of metric deleted within time range,
+	// deleted series will be filtered when query and be removed when compaction,
+	// the series deleted first time release their quota of database/namespace/metric.
+	DeleteSeries(namespace string, metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error
+	// GetTombstones returns the tombstones of metric.
+	GetTombstones(metricID metric.ID) (Tombstones, error)
+	// DropMetric drops the all series of metric, returns the number of dropped series,
+	// the series ids mapping is removed immediately, series data and
+	// the inverted index of metric's tag keys will be removed when compaction.
+	DropMetric(namespace string, metricID metric.ID, tagKeyIDs []tag.KeyID) (int, error)
+	// IsTagKeyDropped checks if the tag key is dropped, the inverted index of dropped
+	// tag key will be removed when compaction.
+	IsTagKeyDropped(tagKeyID tag.KeyID) bool
+	// SetLimits sets the series cardinality limits.
+	SetLimits(limits *option.Limits)
+	// GetLimitUsage returns the number of series against each limit, the database's usage is always returned.
+	GetLimitUsage() ([]models.SeriesLimitUsage, error)
+	// GetSeriesCountByTagValue returns the number of series for each tag value id under spec tag key,
+	// used for analyzing the cardinality of tag values.
+	GetSeriesCountByTagValue(tagKeyID tag.KeyID) (map[uint32]int, error)
 	// Flush flushes index data to disk
 	Flush() error
 }
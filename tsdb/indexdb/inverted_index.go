@@ -51,6 +51,8 @@ type InvertedIndex interface {
 	GetSeriesIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error)
 	// GetSeriesIDsForTags gets series ids for spec tag keys of metric
 	GetSeriesIDsForTags(tagKeyIDs []tag.KeyID) (*roaring.Bitmap, error)
+	// GetSeriesCountByTagValue returns the number of series for each tag value id under spec tag key
+	GetSeriesCountByTagValue(tagKeyID tag.KeyID) (map[uint32]int, error)
 	// GetGroupingContext returns the context of group by
 	GetGroupingContext(ctx *flow.ShardExecuteContext) error
 	// buildInvertIndex builds the inverted index for tag value => series ids,
@@ -159,6 +161,46 @@ func (index *invertedIndex) GetSeriesIDsForTags(tagKeyIDs []tag.KeyID) (*roaring
 	return result, nil
 }
 
+// GetSeriesCountByTagValue returns the number of series for each tag value id under spec tag key
+func (index *invertedIndex) GetSeriesCountByTagValue(tagKeyID tag.KeyID) (map[uint32]int, error) {
+	// get kv store snapshot
+	snapshot := index.forwardFamily.GetSnapshot()
+	defer snapshot.Close()
+
+	result := make(map[uint32]int)
+	seriesIDs, err := index.getSeriesIDsForTag(tagKeyID, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if seriesIDs.IsEmpty() {
+		return result, nil
+	}
+	scanners, err := index.getGroupingScanners(tagKeyID, seriesIDs, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	// series maybe exist in both memory and kv store, count it only once
+	visited := roaring.New()
+	for _, highKey := range seriesIDs.GetHighKeys() {
+		baseSeriesID := uint32(highKey) << 16
+		for _, scanner := range scanners {
+			lowSeriesIDs, tagValueIDs := scanner.GetSeriesAndTagValue(highKey)
+			if lowSeriesIDs == nil {
+				continue
+			}
+			it := lowSeriesIDs.PeekableIterator()
+			idx := 0
+			for it.HasNext() {
+				if visited.CheckedAdd(baseSeriesID | uint32(it.Next())) {
+					result[tagValueIDs[idx]]++
+				}
+				idx++
+			}
+		}
+	}
+	return result, nil
+}
+
 func (index *invertedIndex) GetGroupingContext(ctx *flow.ShardExecuteContext) error {
 	// get kv store snapshot
 	snapshot := index.forwardFamily.GetSnapshot()
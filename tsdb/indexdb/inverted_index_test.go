@@ -177,6 +177,61 @@ func TestInvertedIndex_GetSeriesIDsForTag(t *testing.T) {
 	assert.Equal(t, roaring.BitmapOf(1, 2, 3), seriesIDs)
 }
 
+func TestInvertedIndex_GetSeriesCountByTagValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newForwardReaderFunc = tagindex.NewForwardReader
+		ctrl.Finish()
+	}()
+	reader := tagindex.NewMockForwardReader(ctrl)
+	newForwardReaderFunc = func(readers []table.Reader) tagindex.ForwardReader {
+		return reader
+	}
+
+	index := prepareInvertedIndex(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	idx := index.(*invertedIndex)
+	idx.forwardFamily = family
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+
+	// case 1: find readers err
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	result, err := index.GetSeriesCountByTagValue(1)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	// case 2: tag key not exist
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+	result, err = index.GetSeriesCountByTagValue(4)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+	// case 3: count series in memory
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil).Times(2)
+	result, err = index.GetSeriesCountByTagValue(1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]int{1: 2}, result)
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil).Times(2)
+	result, err = index.GetSeriesCountByTagValue(2)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]int{1: 1, 2: 1}, result)
+	// case 4: get grouping scanner err
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{table.NewMockReader(ctrl)}, nil).AnyTimes()
+	reader.EXPECT().GetSeriesIDsForTagKeyID(gomock.Any()).Return(roaring.BitmapOf(1, 3), nil)
+	reader.EXPECT().GetGroupingScanner(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	result, err = index.GetSeriesCountByTagValue(1)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	// case 5: series exist in memory and kv store, count only once
+	scanner := flow.NewMockGroupingScanner(ctrl)
+	reader.EXPECT().GetSeriesIDsForTagKeyID(gomock.Any()).Return(roaring.BitmapOf(1, 3), nil)
+	reader.EXPECT().GetGroupingScanner(gomock.Any(), gomock.Any()).Return([]flow.GroupingScanner{scanner}, nil)
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(0)).Return(roaring.BitmapOf(1, 3).GetContainerAtIndex(0), []uint32{1, 5})
+	result, err = index.GetSeriesCountByTagValue(1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]int{1: 2, 5: 1}, result)
+}
+
 func TestInvertedIndex_GetGroupingContext(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
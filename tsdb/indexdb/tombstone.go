@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"bytes"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// Tombstone represents the series ids of metric which are deleted within the time range.
+type Tombstone struct {
+	TimeRange timeutil.TimeRange
+	SeriesIDs *roaring.Bitmap
+}
+
+// Covers checks if tombstone covers whole given time range.
+func (t *Tombstone) Covers(timeRange timeutil.TimeRange) bool {
+	return t.TimeRange.Start <= timeRange.Start && t.TimeRange.End >= timeRange.End
+}
+
+// Tombstones represents all tombstones of metric.
+type Tombstones []*Tombstone
+
+// GetDeletedSeriesIDs returns the series ids which are deleted within whole given time range.
+func (ts Tombstones) GetDeletedSeriesIDs(timeRange timeutil.TimeRange) *roaring.Bitmap {
+	seriesIDs := roaring.New()
+	for _, t := range ts {
+		if t.Covers(timeRange) {
+			seriesIDs.Or(t.SeriesIDs)
+		}
+	}
+	return seriesIDs
+}
+
+// Overlap returns the tombstones which overlap with given time range, but not cover it.
+func (ts Tombstones) Overlap(timeRange timeutil.TimeRange) (rs Tombstones) {
+	for _, t := range ts {
+		if t.TimeRange.Overlap(timeRange) && !t.Covers(timeRange) {
+			rs = append(rs, t)
+		}
+	}
+	return
+}
+
+// MarshalBinary marshals tombstones to binary data.
+func (ts Tombstones) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	writer := stream.NewBufferWriter(&buf)
+	for _, t := range ts {
+		seriesIDs, err := t.SeriesIDs.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		writer.PutVarint64(t.TimeRange.Start)
+		writer.PutVarint64(t.TimeRange.End)
+		writer.PutUvarint32(uint32(len(seriesIDs)))
+		writer.PutBytes(seriesIDs)
+	}
+	return buf.Bytes(), writer.Error()
+}
+
+// UnmarshalBinary unmarshals binary data to tombstones.
+func (ts *Tombstones) UnmarshalBinary(data []byte) error {
+	reader := stream.NewReader(data)
+	var rs Tombstones
+	for !reader.Empty() && reader.Error() == nil {
+		start := reader.ReadVarint64()
+		end := reader.ReadVarint64()
+		length := reader.ReadUvarint32()
+		seriesIDs := roaring.New()
+		if err := seriesIDs.UnmarshalBinary(reader.ReadBytes(int(length))); err != nil {
+			return err
+		}
+		rs = append(rs, &Tombstone{
+			TimeRange: timeutil.TimeRange{Start: start, End: end},
+			SeriesIDs: seriesIDs,
+		})
+	}
+	if err := reader.Error(); err != nil {
+		return err
+	}
+	*ts = rs
+	return nil
+}
@@ -0,0 +1,66 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestTombstones_GetDeletedSeriesIDs(t *testing.T) {
+	tombstones := Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 10, End: 100}, SeriesIDs: roaring.BitmapOf(1, 2)},
+		{TimeRange: timeutil.TimeRange{Start: 50, End: 200}, SeriesIDs: roaring.BitmapOf(3)},
+	}
+	assert.Equal(t, roaring.BitmapOf(1, 2, 3), tombstones.GetDeletedSeriesIDs(timeutil.TimeRange{Start: 60, End: 80}))
+	assert.Equal(t, roaring.BitmapOf(1, 2), tombstones.GetDeletedSeriesIDs(timeutil.TimeRange{Start: 10, End: 40}))
+	assert.True(t, tombstones.GetDeletedSeriesIDs(timeutil.TimeRange{Start: 0, End: 80}).IsEmpty())
+
+	assert.Len(t, tombstones.Overlap(timeutil.TimeRange{Start: 60, End: 80}), 0)
+	rs := tombstones.Overlap(timeutil.TimeRange{Start: 0, End: 80})
+	assert.Equal(t, tombstones, rs)
+	rs = tombstones.Overlap(timeutil.TimeRange{Start: 150, End: 300})
+	assert.Equal(t, Tombstones{tombstones[1]}, rs)
+}
+
+func TestTombstones_Marshal(t *testing.T) {
+	tombstones := Tombstones{
+		{TimeRange: timeutil.TimeRange{Start: 10, End: 100}, SeriesIDs: roaring.BitmapOf(1, 2)},
+		{TimeRange: timeutil.TimeRange{Start: 50, End: 200}, SeriesIDs: roaring.BitmapOf(3, 65536)},
+	}
+	data, err := tombstones.MarshalBinary()
+	assert.NoError(t, err)
+	var tombstones2 Tombstones
+	assert.NoError(t, tombstones2.UnmarshalBinary(data))
+	assert.Len(t, tombstones2, 2)
+	for idx := range tombstones {
+		assert.Equal(t, tombstones[idx].TimeRange, tombstones2[idx].TimeRange)
+		assert.Equal(t, tombstones[idx].SeriesIDs.ToArray(), tombstones2[idx].SeriesIDs.ToArray())
+	}
+	// empty
+	data, err = Tombstones{}.MarshalBinary()
+	assert.NoError(t, err)
+	assert.NoError(t, tombstones2.UnmarshalBinary(data))
+	assert.Empty(t, tombstones2)
+	// bad data
+	assert.Error(t, tombstones2.UnmarshalBinary([]byte{1, 2, 10, 1, 2, 3}))
+}
@@ -22,7 +22,9 @@ import (
 	"path"
 	"sync"
 
+	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/objstore"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -37,7 +39,7 @@ type IntervalSegment interface {
 	GetDataFamilies(timeRange timeutil.TimeRange) []DataFamily
 	// Close closes interval segment, release resource
 	Close()
-	// TTL expires segment base on time to live.
+	// TTL expires segment base on time to live, moves cold segment into tiered storage if enabled.
 	TTL() error
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
@@ -161,15 +163,21 @@ func (s *intervalSegment) Close() {
 	}
 }
 
-// TTL expires segment base on time to live.
+// TTL expires segment base on time to live, moves cold segment into tiered storage if enabled.
 func (s *intervalSegment) TTL() error {
 	now := timeutil.Now()
 	expireInterval := s.interval.Retention.Int64()
+	tiered := s.shard.Database().GetOption().Tiered
+	bucket := s.shard.Database().Bucket()
 
 	return s.walkSegment(func(segmentName string, segmentTime int64) {
 		// add 2 hours buffer, for some cases stop write.
 		if now-segmentTime > expireInterval+2*timeutil.OneHour {
-			s.dropSegment(segmentName)
+			s.dropSegment(segmentName, bucket)
+			return
+		}
+		if bucket != nil && tiered.IsCold(s.interval.Interval, segmentTime, now) {
+			s.moveToBackend(segmentName)
 		}
 	})
 }
@@ -209,8 +217,54 @@ func (s *intervalSegment) walkSegment(fn func(segmentName string, segmentTime in
 	return nil
 }
 
-// dropSegment drops segment's data.
-func (s *intervalSegment) dropSegment(segmentName string) {
+// moveToBackend moves the data files of cold segment into tiered storage, if local data files exist.
+func (s *intervalSegment) moveToBackend(segmentName string) {
+	if !s.hasLocalTableFiles(segmentName) {
+		// segment moved already
+		return
+	}
+	segment, err := s.getOrLoadSegment(segmentName)
+	if err != nil {
+		s.logger.Warn("load cold segment failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName),
+			logger.Error(err))
+		return
+	}
+	if err := segment.MoveToBackend(); err != nil {
+		s.logger.Warn("move cold segment into tiered storage failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName),
+			logger.Error(err))
+		return
+	}
+	s.logger.Info("move cold segment into tiered storage successfully",
+		logger.String("path", s.dir), logger.String("segment", segmentName))
+}
+
+// hasLocalTableFiles checks if any table file of segment is kept in local,
+// table files maybe generated by flush/compaction after segment moved into tiered storage.
+func (s *intervalSegment) hasLocalTableFiles(segmentName string) bool {
+	segmentPath := path.Join(s.dir, segmentName)
+	names, err := listDir(segmentPath)
+	if err != nil {
+		return false
+	}
+	for _, name := range names {
+		// list family dir, ignore the files of store(manifest/lock etc.)
+		fileNames, err := listDir(path.Join(segmentPath, name))
+		if err != nil {
+			continue
+		}
+		for _, fileName := range fileNames {
+			if fileDesc := version.ParseFileName(fileName); fileDesc != nil && fileDesc.FileType == version.TypeTable {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dropSegment drops segment's data, includes the data files in tiered storage.
+func (s *intervalSegment) dropSegment(segmentName string, bucket objstore.Bucket) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	segment, ok := s.segments[segmentName]
@@ -225,6 +279,28 @@ func (s *intervalSegment) dropSegment(segmentName string) {
 			logger.Error(err))
 		return
 	}
+	if bucket != nil {
+		s.deleteFromBucket(segmentName, bucket)
+	}
 	s.logger.Info("do segment ttl successfully",
 		logger.String("path", s.dir), logger.String("segment", segmentName))
 }
+
+// deleteFromBucket deletes the data files of segment in tiered storage.
+func (s *intervalSegment) deleteFromBucket(segmentName string, bucket objstore.Bucket) {
+	prefix := ShardSegmentIndicator(s.shard.Database().Name(), s.shard.ShardID(), s.interval.Interval, segmentName) + "/"
+	keys, err := bucket.List(prefix)
+	if err != nil {
+		s.logger.Warn("list segment files in tiered storage failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName),
+			logger.Error(err))
+		return
+	}
+	for _, key := range keys {
+		if err := bucket.Delete(key); err != nil {
+			s.logger.Warn("delete segment file in tiered storage failure",
+				logger.String("path", s.dir), logger.String("segment", segmentName),
+				logger.String("key", key), logger.Error(err))
+		}
+	}
+}
@@ -19,14 +19,17 @@ package tsdb
 
 import (
 	"fmt"
+	"path"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/objstore"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -305,8 +308,43 @@ func TestIntervalSegment_TTL(t *testing.T) {
 
 	now := timeutil.Now() - 40*timeutil.OneDay
 	segmentDir := timeutil.FormatTimestamp(now, "20060102")
+	coldSegmentDir := timeutil.FormatTimestamp(timeutil.Now()-5*timeutil.OneDay, "20060102")
 
 	segment := NewMockSegment(ctrl)
+	mockBucket := objstore.NewMockBucket(ctrl)
+	var bucket objstore.Bucket
+	dbOption := &option.DatabaseOption{}
+	shard := NewMockShard(ctrl)
+	db := NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	db.EXPECT().Name().Return("test-db").AnyTimes()
+	db.EXPECT().GetOption().Return(dbOption).AnyTimes()
+	db.EXPECT().Bucket().DoAndReturn(func() objstore.Bucket {
+		return bucket
+	}).AnyTimes()
+	enableTiered := func() {
+		bucket = mockBucket
+		dbOption.Tiered = &option.Tiered{
+			ColdAfter: timeutil.Interval(timeutil.OneDay),
+			Backend:   option.TieredBackendLocal,
+		}
+	}
+	// listColdSegment lists the segment dir which contains cold segment with family files
+	listColdSegment := func(familyFiles ...string) func(path string) ([]string, error) {
+		return func(p string) ([]string, error) {
+			switch p {
+			case "":
+				return []string{coldSegmentDir}, nil
+			case coldSegmentDir:
+				return []string{"1", version.Tiered}, nil
+			case path.Join(coldSegmentDir, "1"):
+				return familyFiles, nil
+			default:
+				return nil, fmt.Errorf("not a directory")
+			}
+		}
+	}
 	cases := []struct {
 		name    string
 		prepare func()
@@ -356,6 +394,103 @@ func TestIntervalSegment_TTL(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "list segment files in tiered storage failure",
+			prepare: func() {
+				enableTiered()
+				listDir = func(path string) ([]string, error) {
+					return []string{segmentDir}, nil
+				}
+				segment.EXPECT().Close()
+				removeDir = func(path string) error {
+					return nil
+				}
+				mockBucket.EXPECT().List("test-db/shard/1/segment/day/"+segmentDir+"/").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete segment files in tiered storage",
+			prepare: func() {
+				enableTiered()
+				listDir = func(path string) ([]string, error) {
+					return []string{segmentDir}, nil
+				}
+				segment.EXPECT().Close()
+				removeDir = func(path string) error {
+					return nil
+				}
+				mockBucket.EXPECT().List(gomock.Any()).Return([]string{"a", "b"}, nil)
+				mockBucket.EXPECT().Delete("a").Return(fmt.Errorf("err"))
+				mockBucket.EXPECT().Delete("b").Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "cold segment moved already",
+			prepare: func() {
+				enableTiered()
+				listDir = listColdSegment("MANIFEST-000001")
+			},
+			wantErr: false,
+		},
+		{
+			name: "list cold segment dir failure",
+			prepare: func() {
+				enableTiered()
+				listDir = func(p string) ([]string, error) {
+					if p == "" {
+						return []string{coldSegmentDir}, nil
+					}
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "load cold segment failure",
+			prepare: func() {
+				enableTiered()
+				listDir = listColdSegment(version.Table(1))
+				newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "move cold segment failure",
+			prepare: func() {
+				enableTiered()
+				listDir = listColdSegment(version.Table(1))
+				newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+					return segment, nil
+				}
+				segment.EXPECT().MoveToBackend().Return(fmt.Errorf("err"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "move cold segment successfully",
+			prepare: func() {
+				enableTiered()
+				listDir = listColdSegment(version.Table(1))
+				newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+					return segment, nil
+				}
+				segment.EXPECT().MoveToBackend().Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "tiered storage not enabled",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return []string{coldSegmentDir}, nil
+				}
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range cases {
@@ -363,8 +498,14 @@ func TestIntervalSegment_TTL(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			defer func() {
 				listDir = fileutil.ListDir
+				removeDir = fileutil.RemoveDir
+				fileExist = fileutil.Exist
+				newSegmentFunc = newSegment
+				bucket = nil
+				dbOption.Tiered = nil
 			}()
 			s := &intervalSegment{
+				shard: shard,
 				interval: option.Interval{
 					Interval:  timeutil.Interval(10 * timeutil.OneSecond),
 					Retention: timeutil.Interval(30 * timeutil.OneDay),
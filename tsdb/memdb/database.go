@@ -18,6 +18,7 @@
 package memdb
 
 import (
+	"errors"
 	"io"
 	"sync"
 	"time"
@@ -196,11 +197,28 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 
 	simpleFieldItr := row.NewSimpleFieldIterator()
 	for simpleFieldItr.HasNext() {
+		fieldType := simpleFieldItr.NextType()
+		fieldValue := simpleFieldItr.NextValue()
+		if fieldType == field.StringField {
+			// string value is stored in dictionary of metric, field stores the dictionary code
+			code, writtenDictSize, err := mStore.AddStringValue(simpleFieldItr.NextStringValue())
+			if errors.Is(err, field.ErrTooManyStringValues) {
+				// dictionary is full, drop string value, keep writing other fields of row
+				md.statistics.DroppedStringValues.Incr()
+				fieldIDIdx++
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			size += writtenDictSize
+			fieldValue = code
+		}
 		writtenLinFieldSize, err := md.writeLinField(
 			row.SlotIndex,
 			row.FieldIDs[fieldIDIdx],
-			simpleFieldItr.NextType(),
-			simpleFieldItr.NextValue(),
+			fieldType,
+			fieldValue,
 			mStore, tStore,
 		)
 		if err != nil {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/proto/gen/v1/flatMetricsV1"
 	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
@@ -225,6 +227,48 @@ func TestMemoryDatabase_Write(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMemoryDatabase_Write_StringDictionaryFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bufferMgr := NewMockBufferManager(ctrl)
+	buf, err := newDataPointBuffer(filepath.Join(t.TempDir(), "db_dir"))
+	assert.NoError(t, err)
+	bufferMgr.EXPECT().AllocBuffer().Return(buf, nil).AnyTimes()
+	mdINTF, err := NewMemoryDatabase(MemoryDatabaseCfg{BufferMgr: bufferMgr})
+	assert.NoError(t, err)
+	md := mdINTF.(*memoryDatabase)
+
+	mStore := md.getOrCreateMStore(1)
+	for i := 0; i < field.MaxStringValues; i++ {
+		_, _, err = mStore.AddStringValue([]byte(strconv.Itoa(i)))
+		assert.NoError(t, err)
+	}
+
+	rb, releaseFunc := metric.NewRowBuilder()
+	defer releaseFunc(rb)
+	rb.AddMetricName([]byte("app"))
+	assert.NoError(t, rb.AddStringField([]byte("status"), []byte("free text")))
+	assert.NoError(t, rb.AddSimpleField([]byte("idle"), flatMetricsV1.SimpleFieldTypeGauge, 1))
+	data, err := rb.Build()
+	assert.NoError(t, err)
+	var br metric.StorageBatchRows
+	br.UnmarshalRows(data)
+	row := br.Rows()[0]
+	row.MetricID = 1
+	row.SeriesID = 10
+	row.SlotIndex = 1
+	row.FieldIDs = []field.ID{1, 2}
+	// string value dropped, numeric field still written
+	assert.NoError(t, md.WriteRow(&row))
+	tStore, _ := mStore.GetOrCreateTStore(10)
+	_, ok := tStore.GetFStore(1)
+	assert.False(t, ok)
+	_, ok = tStore.GetFStore(2)
+	assert.True(t, ok)
+	assert.NoError(t, md.Close())
+}
+
 func TestMemoryDatabase_Write_err(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
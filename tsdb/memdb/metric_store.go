@@ -61,6 +61,9 @@ type mStoreINTF interface {
 	GetSlotRange() *timeutil.SlotRange
 	// AddField adds field meta into metric level
 	AddField(fieldID field.ID, fieldType field.Type)
+	// AddStringValue adds the value of string field into dictionary of metric,
+	// returns the dictionary code and written size.
+	AddStringValue(value []byte) (code float64, writtenSize int, err error)
 	// GetOrCreateTStore constructs the index and return a tStore
 	GetOrCreateTStore(seriesID uint32) (tStore tStoreINTF, created bool)
 	// FlushMetricsDataTo flushes metric-block of mStore to the Writer.
@@ -73,8 +76,9 @@ type metricStore struct {
 
 	MetricStore
 
-	slotRange *timeutil.SlotRange
-	fields    field.Metas // field metadata
+	slotRange  *timeutil.SlotRange
+	fields     field.Metas      // field metadata
+	dictionary field.Dictionary // dictionary of string fields
 }
 
 // newMetricStore returns a new mStoreINTF.
@@ -122,6 +126,23 @@ func (ms *metricStore) AddField(fieldID field.ID, fieldType field.Type) {
 	}
 }
 
+// AddStringValue adds the value of string field into dictionary of metric
+func (ms *metricStore) AddStringValue(value []byte) (code float64, writtenSize int, err error) {
+	if ms.dictionary == nil {
+		ms.dictionary = make(field.Dictionary)
+	}
+	beforeLen := len(ms.dictionary)
+	if code, err = ms.dictionary.Add(string(value)); err != nil {
+		return 0, 0, err
+	}
+	if len(ms.dictionary) > beforeLen {
+		// code + string internal pointer/len + value
+		writtenSize = 8 + 16 + len(value)
+		ms.capacity.Add(int32(writtenSize))
+	}
+	return code, writtenSize, nil
+}
+
 func (ms *metricStore) mStoreSize() int {
 	var size int
 	size += cap(ms.MetricStore.values)*24 + 24
@@ -154,6 +175,7 @@ func (ms *metricStore) FlushMetricsDataTo(flusher metricsdata.Flusher, flushCtx
 	}
 	// prepare for flushing metric
 	flusher.PrepareMetric(flushCtx.metricID, ms.fields)
+	flusher.FlushDictionary(ms.dictionary)
 	// set current family's slot range
 	flushCtx.Start, flushCtx.End = slotRange.GetRange()
 	if err := ms.WalkEntry(func(key uint32, value tStoreINTF) error {
@@ -112,7 +112,7 @@ func (rs *memFilterResultSet) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 	}
 
 	// must use lowContainer from store, because get series index based on container
-	return newMetricStoreLoader(rs.db, lowContainer, rs.store.values[highContainerIdx], *rs.store.slotRange, rs.fields)
+	return newMetricStoreLoader(rs.db, rs.store, lowContainer, rs.store.values[highContainerIdx], *rs.store.slotRange, rs.fields)
 }
 
 // Close release the resource during doing query operation.
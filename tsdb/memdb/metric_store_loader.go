@@ -28,6 +28,7 @@ import (
 // metricStoreLoader implements flow.DataLoader interface that loads metric data from memory storage.
 type metricStoreLoader struct {
 	db               MemoryDatabase
+	store            *metricStore
 	lowContainer     roaring.Container
 	timeSeriesStores []tStoreINTF
 	slotRange        timeutil.SlotRange // slot range of metric store
@@ -36,6 +37,7 @@ type metricStoreLoader struct {
 
 // newMetricStoreLoader creates a memory storage metric loader.
 func newMetricStoreLoader(db MemoryDatabase,
+	store *metricStore,
 	lowContainer roaring.Container,
 	timeSeriesStores []tStoreINTF,
 	slotRange timeutil.SlotRange,
@@ -43,6 +45,7 @@ func newMetricStoreLoader(db MemoryDatabase,
 ) flow.DataLoader {
 	return &metricStoreLoader{
 		db:               db,
+		store:            store,
 		lowContainer:     lowContainer,
 		timeSeriesStores: timeSeriesStores,
 		slotRange:        slotRange,
@@ -55,6 +58,9 @@ func (s *metricStoreLoader) Load(loadCtx *flow.DataLoadContext) {
 	release := s.db.WithLock()
 	defer release()
 
+	// dictionary of string fields is changed when writing, so adds it under lock
+	loadCtx.AddDictionary(s.store.dictionary)
+
 	loadCtx.IterateLowSeriesIDs(s.lowContainer, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
 		store := s.timeSeriesStores[seriesIdxFromStorage]
 		// read series data of fields
@@ -23,9 +23,11 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
 )
 
 func TestMetricStoreLoader_Load(t *testing.T) {
@@ -37,10 +39,26 @@ func TestMetricStoreLoader_Load(t *testing.T) {
 	db.EXPECT().WithLock().Return(mutex.Unlock)
 	// case 1: series not exist
 	mutex.Lock()
-	s := newMetricStoreLoader(db, roaring.BitmapOf(10, 100).GetContainer(0),
+	s := newMetricStoreLoader(db, &metricStore{}, roaring.BitmapOf(10, 100).GetContainer(0),
 		nil, timeutil.SlotRange{}, nil)
 	s.Load(&flow.DataLoadContext{
 		SeriesIDHighKey:       0,
 		LowSeriesIDsContainer: roaring.BitmapOf(10, 100).GetContainer(0),
 	})
+
+	// case 2: load dictionary of string fields
+	db.EXPECT().WithLock().Return(mutex.Unlock)
+	mutex.Lock()
+	store := &metricStore{}
+	_, _, _ = store.AddStringValue([]byte("v1.0.0"))
+	s = newMetricStoreLoader(db, store, roaring.BitmapOf(10, 100).GetContainer(0),
+		nil, timeutil.SlotRange{}, nil)
+	ctx := &flow.DataLoadContext{
+		SeriesIDHighKey:       0,
+		LowSeriesIDsContainer: roaring.BitmapOf(10, 100).GetContainer(0),
+	}
+	s.Load(ctx)
+	value, ok := ctx.Dictionary.Get(field.StringCode("v1.0.0"))
+	assert.True(t, ok)
+	assert.Equal(t, "v1.0.0", value)
 }
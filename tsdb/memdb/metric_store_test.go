@@ -19,6 +19,7 @@ package memdb
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -53,6 +54,27 @@ func TestMetricStore_AddField(t *testing.T) {
 	assert.Equal(t, field.Meta{ID: 2, Type: field.MinField}, mStore.fields[1])
 }
 
+func TestMetricStore_AddStringValue(t *testing.T) {
+	mStoreInterface := newMetricStore()
+	mStore := mStoreInterface.(*metricStore)
+	code, size, err := mStoreInterface.AddStringValue([]byte("v1"))
+	assert.NoError(t, err)
+	assert.Equal(t, field.StringCode("v1"), code)
+	assert.Equal(t, 26, size)
+	// value exists
+	code, size, err = mStoreInterface.AddStringValue([]byte("v1"))
+	assert.NoError(t, err)
+	assert.Equal(t, field.StringCode("v1"), code)
+	assert.Zero(t, size)
+	// too many values
+	for i := 0; i < field.MaxStringValues; i++ {
+		_, _, _ = mStoreInterface.AddStringValue([]byte(strconv.Itoa(i)))
+	}
+	_, _, err = mStoreInterface.AddStringValue([]byte("v2"))
+	assert.Equal(t, field.ErrTooManyStringValues, err)
+	assert.Len(t, mStore.dictionary, field.MaxStringValues)
+}
+
 func TestMetricStore_SetTimestamp(t *testing.T) {
 	mStoreInterface := newMetricStore()
 	mStoreInterface.SetSlot(10)
@@ -95,6 +117,7 @@ func TestMetricStore_FlushMetricsDataTo(t *testing.T) {
 	mStoreInterface.AddField(2, field.MinField)
 	gomock.InOrder(
 		flusher.EXPECT().PrepareMetric(gomock.Any(), gomock.Any()),
+		flusher.EXPECT().FlushDictionary(gomock.Any()),
 		tStore.EXPECT().FlushFieldsTo(gomock.Any(), gomock.Any()).Return(nil),
 		flusher.EXPECT().FlushSeries(uint32(10)).Return(nil),
 		flusher.EXPECT().CommitMetric(gomock.Any()).Return(nil),
@@ -103,6 +126,7 @@ func TestMetricStore_FlushMetricsDataTo(t *testing.T) {
 	assert.NoError(t, err)
 	// case 4: flush field err
 	flusher.EXPECT().PrepareMetric(gomock.Any(), gomock.Any())
+	flusher.EXPECT().FlushDictionary(gomock.Any())
 	tStore.EXPECT().FlushFieldsTo(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	err = mStoreInterface.FlushMetricsDataTo(flusher, &flushContext{})
 	assert.Error(t, err)
@@ -112,6 +136,7 @@ func TestMetricStore_FlushMetricsDataTo(t *testing.T) {
 	}
 	gomock.InOrder(
 		flusher.EXPECT().PrepareMetric(gomock.Any(), gomock.Any()),
+		flusher.EXPECT().FlushDictionary(gomock.Any()),
 	)
 	err = mStoreInterface.FlushMetricsDataTo(flusher, &flushContext{})
 	assert.Error(t, err)
@@ -64,6 +64,21 @@ type IDGetter interface {
 	GetAllHistogramFields(namespace, metricName string) (fields field.Metas, err error)
 }
 
+// SchemaManager represents the schema management of metric level, such as rename/drop field etc.
+type SchemaManager interface {
+	// RenameField renames the field of metric, the field id is kept.
+	RenameField(namespace, metricName string, oldName, newName field.Name) error
+	// DropField drops the field of metric, the dropped field id will not be reused.
+	DropField(namespace, metricName string, fieldName field.Name) error
+	// ChangeFieldType changes the type of field, assigns a new field id for the field,
+	// so that the data written with old type is invisible.
+	ChangeFieldType(namespace, metricName string, fieldName field.Name, fieldType field.Type) (field.ID, error)
+	// DropMetric drops the metric include all fields/tag keys.
+	DropMetric(namespace, metricName string) error
+	// DropNamespace drops the namespace include all metrics of it.
+	DropNamespace(namespace string) error
+}
+
 // Metadata represents all metadata of TSDB, like metric/tag metadata
 type Metadata interface {
 	io.Closer
@@ -81,6 +96,7 @@ type MetadataDatabase interface {
 	io.Closer
 	IDGetter
 	IDGenerator
+	SchemaManager
 	series.MetricMetaSuggester
 
 	// SuggestNamespace suggests the namespace by namespace's prefix
@@ -59,6 +59,9 @@ var (
 	storageDBNames = []string{NamespaceDB, MetricDB, TagKeyDB, FieldDB}
 )
 
+// fieldMaxIDKeySuffix is the suffix of metric's max field id key(metric id + suffix).
+const fieldMaxIDKeySuffix byte = 0xFF
+
 // sequenceItem represents sequence metadata.
 type sequenceItem struct {
 	sequence unique.Sequence
@@ -87,6 +90,13 @@ type MetadataBackend interface {
 	// getAllFields returns the  all fields by metric id,
 	// if not exist return empty.
 	getAllFields(metricID metric.ID) (fields field.Metas, max field.ID, err error)
+	// saveFields replaces the all fields of given metric id,
+	// keeps the max field id so that the dropped field's id will not be reused.
+	saveFields(metricID metric.ID, fields field.Metas, maxFieldID field.ID) error
+	// dropMetric removes the metric include all fields/tag keys by namespace and metric name.
+	dropMetric(namespace, metricName string) error
+	// dropNamespace removes the namespace, all metrics of namespace must be dropped before.
+	dropNamespace(namespace string) error
 
 	// getOrCreateMetricMetadata creates metric metadata if not exist, else load metric metadata from backend storage.
 	getOrCreateMetricMetadata(namespace, metricName string) (MetricMetadata, error)
@@ -350,9 +360,65 @@ func (mb *metadataBackend) getAllFields(metricID metric.ID) (fields field.Metas,
 	if err != nil {
 		return nil, 0, err
 	}
+	// max field id maybe dropped, read max field id if exist
+	maxVal, exist, err := mb.field.Get(fieldMaxIDKey(metricID))
+	if err != nil {
+		return nil, 0, err
+	}
+	if exist && len(maxVal) > 0 && field.ID(maxVal[0]) > max {
+		max = field.ID(maxVal[0])
+	}
 	return
 }
 
+// saveFields replaces the all fields of given metric id,
+// keeps the max field id so that the dropped field's id will not be reused.
+func (mb *metadataBackend) saveFields(metricID metric.ID, fields field.Metas, maxFieldID field.ID) error {
+	var buf []byte
+	for idx := range fields {
+		val, err := fields[idx].MarshalBinary()
+		if err != nil {
+			return err
+		}
+		buf = append(buf, val...)
+	}
+	if err := mb.field.Put(fieldMaxIDKey(metricID), []byte{byte(maxFieldID)}); err != nil {
+		return err
+	}
+	return mb.field.Put(metricID.MarshalBinary(), buf)
+}
+
+// dropMetric removes the metric include all fields/tag keys by namespace and metric name.
+func (mb *metadataBackend) dropMetric(namespace, metricName string) error {
+	metricID, err := mb.getMetricID(namespace, metricName)
+	if err != nil {
+		return err
+	}
+	namespaceVal, _, err := mb.namespace.Get([]byte(namespace))
+	if err != nil {
+		return err
+	}
+	var key []byte
+	key = append(key, namespaceVal...)
+	key = append(key, metricName...)
+	if err := mb.metric.Delete(key); err != nil {
+		return err
+	}
+	metricKey := metricID.MarshalBinary()
+	if err := mb.field.Delete(metricKey); err != nil {
+		return err
+	}
+	if err := mb.field.Delete(fieldMaxIDKey(metricID)); err != nil {
+		return err
+	}
+	return mb.tagKey.Delete(metricKey)
+}
+
+// dropNamespace removes the namespace, all metrics of namespace must be dropped before.
+func (mb *metadataBackend) dropNamespace(namespace string) error {
+	return mb.namespace.Delete([]byte(namespace))
+}
+
 // getOrCreateMetricMetadata creates metric metadata if not exist, else load metric metadata from backend storage.
 func (mb *metadataBackend) getOrCreateMetricMetadata(namespace, metricName string) (MetricMetadata, error) {
 	nsKey := []byte(namespace)
@@ -477,3 +543,8 @@ func nextSequence(seq unique.Sequence, store unique.IDStore, key []byte) (uint32
 	}
 	return seq.Next(), nil
 }
+
+// fieldMaxIDKey returns the key of metric's max field id.
+func fieldMaxIDKey(metricID metric.ID) []byte {
+	return append(metricID.MarshalBinary(), fieldMaxIDKeySuffix)
+}
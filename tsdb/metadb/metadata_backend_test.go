@@ -623,6 +623,126 @@ func TestMetadataBackend_saveField(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMetadataBackend_saveFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := unique.NewMockIDStore(ctrl)
+	backend := &metadataBackend{
+		field: store,
+	}
+	f := field.Meta{
+		ID:   1,
+		Type: field.SumField,
+		Name: "field",
+	}
+	v, err := f.MarshalBinary()
+	assert.NoError(t, err)
+	// case 1: save max field id failure
+	store.EXPECT().Put([]byte{2, 0, 0, 0, 0xFF}, []byte{3}).Return(fmt.Errorf("err"))
+	err = backend.saveFields(metric.ID(2), field.Metas{f}, 3)
+	assert.Error(t, err)
+	// case 2: save fields successfully
+	store.EXPECT().Put([]byte{2, 0, 0, 0, 0xFF}, []byte{3}).Return(nil)
+	store.EXPECT().Put([]byte{2, 0, 0, 0}, v).Return(nil)
+	err = backend.saveFields(metric.ID(2), field.Metas{f}, 3)
+	assert.NoError(t, err)
+}
+
+func TestMetadataBackend_dropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nsStore := unique.NewMockIDStore(ctrl)
+	metricStore := unique.NewMockIDStore(ctrl)
+	fieldStore := unique.NewMockIDStore(ctrl)
+	tagStore := unique.NewMockIDStore(ctrl)
+	backend := &metadataBackend{
+		namespace: nsStore,
+		metric:    metricStore,
+		field:     fieldStore,
+		tagKey:    tagStore,
+	}
+	nsID := []byte{1, 0, 0, 0}
+	metricKey := append(append([]byte{}, nsID...), "cpu"...)
+	mockMetric := func() {
+		nsStore.EXPECT().Get([]byte("ns")).Return(nsID, true, nil).Times(2)
+		metricStore.EXPECT().Get(metricKey).Return([]byte{2, 0, 0, 0}, true, nil)
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "metric not found",
+			prepare: func() {
+				nsStore.EXPECT().Get([]byte("ns")).Return(nil, false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete metric failure",
+			prepare: func() {
+				mockMetric()
+				metricStore.EXPECT().Delete(metricKey).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete fields failure",
+			prepare: func() {
+				mockMetric()
+				metricStore.EXPECT().Delete(metricKey).Return(nil)
+				fieldStore.EXPECT().Delete([]byte{2, 0, 0, 0}).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete max field id failure",
+			prepare: func() {
+				mockMetric()
+				metricStore.EXPECT().Delete(metricKey).Return(nil)
+				fieldStore.EXPECT().Delete([]byte{2, 0, 0, 0}).Return(nil)
+				fieldStore.EXPECT().Delete([]byte{2, 0, 0, 0, 0xFF}).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "drop metric successfully",
+			prepare: func() {
+				mockMetric()
+				metricStore.EXPECT().Delete(metricKey).Return(nil)
+				fieldStore.EXPECT().Delete([]byte{2, 0, 0, 0}).Return(nil)
+				fieldStore.EXPECT().Delete([]byte{2, 0, 0, 0, 0xFF}).Return(nil)
+				tagStore.EXPECT().Delete([]byte{2, 0, 0, 0}).Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			err := backend.dropMetric("ns", "cpu")
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+		})
+	}
+}
+
+func TestMetadataBackend_dropNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nsStore := unique.NewMockIDStore(ctrl)
+	backend := &metadataBackend{
+		namespace: nsStore,
+	}
+	nsStore.EXPECT().Delete([]byte("ns")).Return(nil)
+	assert.NoError(t, backend.dropNamespace("ns"))
+}
+
 func TestMetadataBackend_getAllFields(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -704,6 +824,7 @@ func TestMetadataBackend_getAllFields(t *testing.T) {
 				}
 
 				fieldStore.EXPECT().Get(gomock.Any()).Return(buf, true, nil)
+				fieldStore.EXPECT().Get(gomock.Any()).Return(nil, false, nil)
 			},
 			out: struct {
 				fields field.Metas
@@ -726,6 +847,40 @@ func TestMetadataBackend_getAllFields(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "get max field id failure",
+			prepare: func(fieldStore *unique.MockIDStore) {
+				fieldStore.EXPECT().Get(gomock.Any()).Return(nil, true, nil)
+				fieldStore.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
+			},
+			out: struct {
+				fields field.Metas
+				max    field.ID
+				err    error
+			}{
+				fields: nil,
+				max:    field.ID(0),
+				err:    fmt.Errorf("err"),
+			},
+		},
+		{
+			name: "max field id dropped",
+			prepare: func(fieldStore *unique.MockIDStore) {
+				data, err := (&field.Meta{Name: "f", Type: field.SumField, ID: 2}).MarshalBinary()
+				assert.NoError(t, err)
+				fieldStore.EXPECT().Get(gomock.Any()).Return(data, true, nil)
+				fieldStore.EXPECT().Get(gomock.Any()).Return([]byte{5}, true, nil)
+			},
+			out: struct {
+				fields field.Metas
+				max    field.ID
+				err    error
+			}{
+				fields: field.Metas{{Name: "f", Type: field.SumField, ID: 2}},
+				max:    field.ID(5),
+				err:    nil,
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -20,6 +20,7 @@ package metadb
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/lindb/lindb/constants"
@@ -215,7 +216,12 @@ func (mdb *metadataDatabase) GenFieldID(
 	if fieldType == field.Unknown {
 		return field.EmptyFieldID, series.ErrFieldTypeUnspecified
 	}
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped after gen metric id
+		mdb.statistics.GenFieldIDFailures.Incr()
+		return field.EmptyFieldID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
@@ -247,12 +253,17 @@ func (mdb *metadataDatabase) GenFieldID(
 // GenTagKeyID generates the tag key id in the memory
 // !!!!! NOTICE: metric metadata must be existed in memory, because gen metric has been saved
 func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string) (tagKeyID tag.KeyID, err error) {
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped after gen metric id
+		mdb.statistics.GenTagKeyIDFailures.Incr()
+		return tag.EmptyTagKeyID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
 	// read from memory metric metadata
-	tagKeyID, ok := metricMetadata.getTagKeyID(tagKey)
+	tagKeyID, ok = metricMetadata.getTagKeyID(tagKey)
 	if ok {
 		return tagKeyID, nil
 	}
@@ -273,6 +284,134 @@ func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string) (
 	return tagKeyID, nil
 }
 
+// RenameField renames the field of metric, the field id is kept.
+func (mdb *metadataDatabase) RenameField(namespace, metricName string, oldName, newName field.Name) error {
+	return mdb.alterFields(namespace, metricName, func(fields field.Metas, maxID field.ID) (field.Metas, field.ID, error) {
+		if _, ok := fields.Find(newName); ok {
+			return nil, 0, fmt.Errorf("%w, field: %s", series.ErrFieldExist, newName)
+		}
+		idx, err := findField(fields, oldName)
+		if err != nil {
+			return nil, 0, err
+		}
+		fields[idx].Name = newName
+		return fields, maxID, nil
+	})
+}
+
+// DropField drops the field of metric, the dropped field id will not be reused.
+func (mdb *metadataDatabase) DropField(namespace, metricName string, fieldName field.Name) error {
+	return mdb.alterFields(namespace, metricName, func(fields field.Metas, maxID field.ID) (field.Metas, field.ID, error) {
+		idx, err := findField(fields, fieldName)
+		if err != nil {
+			return nil, 0, err
+		}
+		return append(fields[:idx], fields[idx+1:]...), maxID, nil
+	})
+}
+
+// ChangeFieldType changes the type of field, assigns a new field id for the field,
+// so that the data written with old type is invisible.
+func (mdb *metadataDatabase) ChangeFieldType(
+	namespace, metricName string,
+	fieldName field.Name, fieldType field.Type,
+) (fieldID field.ID, err error) {
+	if fieldType == field.Unknown {
+		return field.EmptyFieldID, series.ErrFieldTypeUnspecified
+	}
+	err = mdb.alterFields(namespace, metricName, func(fields field.Metas, maxID field.ID) (field.Metas, field.ID, error) {
+		idx, err := findField(fields, fieldName)
+		if err != nil {
+			return nil, 0, err
+		}
+		if fields[idx].Type == fieldType {
+			// type not changed, keep field id
+			fieldID = fields[idx].ID
+			return fields, maxID, nil
+		}
+		if int(maxID) >= constants.DefaultMaxFieldsCount {
+			return nil, 0, series.ErrTooManyFields
+		}
+		maxID++
+		fieldID = maxID
+		fields[idx].ID = fieldID
+		fields[idx].Type = fieldType
+		return fields, maxID, nil
+	})
+	if err != nil {
+		return field.EmptyFieldID, err
+	}
+	return fieldID, nil
+}
+
+// DropMetric drops the metric include all fields/tag keys.
+func (mdb *metadataDatabase) DropMetric(namespace, metricName string) error {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	return mdb.dropMetric(namespace, metricName)
+}
+
+// DropNamespace drops the namespace include all metrics of it.
+func (mdb *metadataDatabase) DropNamespace(namespace string) error {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	metricNames, err := mdb.backend.suggestMetricName(namespace, "", math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	for _, metricName := range metricNames {
+		if err := mdb.dropMetric(namespace, metricName); err != nil {
+			return err
+		}
+	}
+	return mdb.backend.dropNamespace(namespace)
+}
+
+// dropMetric drops the metric from backend storage, then removes it from memory cache,
+// !!!!! NOTICE: must hold write lock.
+func (mdb *metadataDatabase) dropMetric(namespace, metricName string) error {
+	if err := mdb.backend.dropMetric(namespace, metricName); err != nil {
+		return err
+	}
+	delete(mdb.metrics, metric.JoinNamespaceMetric(namespace, metricName))
+	return nil
+}
+
+// alterFields alters the fields of metric by given function, then persists the fields and
+// updates the memory cache if alter successfully.
+func (mdb *metadataDatabase) alterFields(
+	namespace, metricName string,
+	alter func(fields field.Metas, maxID field.ID) (field.Metas, field.ID, error),
+) error {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	key := metric.JoinNamespaceMetric(namespace, metricName)
+	metricMetadata, ok := mdb.metrics[key]
+	if !ok {
+		metricID, err := mdb.backend.getMetricID(namespace, metricName)
+		if err != nil {
+			return err
+		}
+		metricMetadata, err = mdb.backend.getMetricMetadata(metricID)
+		if err != nil {
+			return err
+		}
+		mdb.metrics[key] = metricMetadata
+	}
+	fields, maxID, err := alter(metricMetadata.getAllFields(), metricMetadata.getFieldMaxID())
+	if err != nil {
+		return err
+	}
+	if err := mdb.backend.saveFields(metricMetadata.getMetricID(), fields, maxID); err != nil {
+		return err
+	}
+	metricMetadata.updateFields(fields, maxID)
+	return nil
+}
+
 // Sync syncs the backend storage.
 func (mdb *metadataDatabase) Sync() error {
 	mdb.rwMux.Lock()
@@ -301,3 +440,13 @@ func (mdb *metadataDatabase) getMetricMetadataFromCache(namespace, metricName st
 
 	return metricMetadata, ok
 }
+
+// findField returns the index of field by field name, if not exist return constants.ErrFieldNotFound.
+func findField(fields field.Metas, fieldName field.Name) (int, error) {
+	for idx := range fields {
+		if fields[idx].Name == fieldName {
+			return idx, nil
+		}
+	}
+	return -1, fmt.Errorf("%w, field: %s", constants.ErrFieldNotFound, fieldName)
+}
@@ -19,6 +19,7 @@ package metadb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -793,6 +794,183 @@ func TestMetadataDatabase_GenTagKeyID(t *testing.T) {
 	}
 }
 
+func TestMetadataDatabase_AlterFields(t *testing.T) {
+	dir := t.TempDir()
+	db := newMockMetadataDatabase(t, dir)
+	_, err := db.GenMetricID("ns", "cpu")
+	assert.NoError(t, err)
+	for _, f := range []field.Meta{
+		{Name: "f1", Type: field.SumField},
+		{Name: "f2", Type: field.SumField},
+		{Name: "f3", Type: field.MaxField},
+	} {
+		_, err = db.GenFieldID("ns", "cpu", f.Name, f.Type)
+		assert.NoError(t, err)
+	}
+	// metric not exist
+	err = db.RenameField("ns", "mem", "f1", "f4")
+	assert.True(t, errors.Is(err, constants.ErrNotFound))
+	// field not exist
+	err = db.RenameField("ns", "cpu", "f5", "f4")
+	assert.True(t, errors.Is(err, constants.ErrFieldNotFound))
+	err = db.DropField("ns", "cpu", "f5")
+	assert.True(t, errors.Is(err, constants.ErrFieldNotFound))
+	_, err = db.ChangeFieldType("ns", "cpu", "f5", field.MaxField)
+	assert.True(t, errors.Is(err, constants.ErrFieldNotFound))
+	// new field name exist
+	err = db.RenameField("ns", "cpu", "f1", "f2")
+	assert.True(t, errors.Is(err, series.ErrFieldExist))
+	// unknown field type
+	_, err = db.ChangeFieldType("ns", "cpu", "f1", field.Unknown)
+	assert.Equal(t, series.ErrFieldTypeUnspecified, err)
+
+	err = db.RenameField("ns", "cpu", "f1", "f4")
+	assert.NoError(t, err)
+	err = db.DropField("ns", "cpu", "f3")
+	assert.NoError(t, err)
+	// type not changed
+	id, err := db.ChangeFieldType("ns", "cpu", "f2", field.SumField)
+	assert.NoError(t, err)
+	assert.Equal(t, field.ID(2), id)
+	id, err = db.ChangeFieldType("ns", "cpu", "f2", field.MaxField)
+	assert.NoError(t, err)
+	assert.Equal(t, field.ID(4), id)
+	// write with new type
+	id, err = db.GenFieldID("ns", "cpu", "f2", field.MaxField)
+	assert.NoError(t, err)
+	assert.Equal(t, field.ID(4), id)
+	// dropped field id cannot be reused
+	id, err = db.GenFieldID("ns", "cpu", "f3", field.SumField)
+	assert.NoError(t, err)
+	assert.Equal(t, field.ID(5), id)
+	assert.NoError(t, db.DropField("ns", "cpu", "f3"))
+
+	expect := field.Metas{
+		{ID: 1, Name: "f4", Type: field.SumField},
+		{ID: 4, Name: "f2", Type: field.MaxField},
+	}
+	fields, err := db.GetAllFields("ns", "cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, expect, fields)
+	// re-open, load fields from backend storage
+	assert.NoError(t, db.Close())
+	db = newMockMetadataDatabase(t, dir)
+	fields, err = db.GetAllFields("ns", "cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, expect, fields)
+	// load metric metadata when alter fields
+	assert.NoError(t, db.RenameField("ns", "cpu", "f4", "f1"))
+	_, err = db.GenMetricID("ns", "cpu")
+	assert.NoError(t, err)
+	id, err = db.GenFieldID("ns", "cpu", "f3", field.SumField)
+	assert.NoError(t, err)
+	assert.Equal(t, field.ID(6), id)
+	assert.NoError(t, db.Close())
+}
+
+func TestMetadataDatabase_AlterFields_Failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	db := newMockMetadataDatabase(t, t.TempDir())
+	// load metric metadata failure
+	mockBackend.EXPECT().getMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil)
+	mockBackend.EXPECT().getMetricMetadata(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, db.DropField("ns", "cpu", "f1"))
+	// save fields failure
+	meta := NewMockMetricMetadata(ctrl)
+	db.(*metadataDatabase).metrics[metric.JoinNamespaceMetric("ns", "cpu")] = meta
+	meta.EXPECT().getAllFields().Return(field.Metas{{ID: 1, Name: "f1", Type: field.SumField}}).AnyTimes()
+	meta.EXPECT().getFieldMaxID().Return(field.ID(constants.DefaultMaxFieldsCount)).Times(2)
+	meta.EXPECT().getMetricID().Return(metric.ID(1))
+	mockBackend.EXPECT().saveFields(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, db.DropField("ns", "cpu", "f1"))
+	// too many fields
+	_, err := db.ChangeFieldType("ns", "cpu", "f1", field.MaxField)
+	assert.Equal(t, series.ErrTooManyFields, err)
+}
+
+func TestMetadataDatabase_DropMetric(t *testing.T) {
+	db := newMockMetadataDatabase(t, t.TempDir())
+	defer func() {
+		assert.NoError(t, db.Close())
+	}()
+	err := db.DropMetric("ns", "cpu")
+	assert.True(t, errors.Is(err, constants.ErrMetricIDNotFound))
+	metricID, err := db.GenMetricID("ns", "cpu")
+	assert.NoError(t, err)
+	_, err = db.GenTagKeyID("ns", "cpu", "host")
+	assert.NoError(t, err)
+	_, err = db.GenFieldID("ns", "cpu", "f1", field.SumField)
+	assert.NoError(t, err)
+
+	assert.NoError(t, db.DropMetric("ns", "cpu"))
+	_, err = db.GetMetricID("ns", "cpu")
+	assert.True(t, errors.Is(err, constants.ErrMetricIDNotFound))
+	_, err = db.GenFieldID("ns", "cpu", "f1", field.SumField)
+	assert.True(t, errors.Is(err, constants.ErrMetricIDNotFound))
+	_, err = db.GenTagKeyID("ns", "cpu", "host")
+	assert.True(t, errors.Is(err, constants.ErrMetricIDNotFound))
+	// create metric again with new metric id
+	newMetricID, err := db.GenMetricID("ns", "cpu")
+	assert.NoError(t, err)
+	assert.NotEqual(t, metricID, newMetricID)
+	fields, err := db.GetAllFields("ns", "cpu")
+	assert.NoError(t, err)
+	assert.Empty(t, fields)
+	tagKeys, err := db.GetAllTagKeys("ns", "cpu")
+	assert.NoError(t, err)
+	assert.Empty(t, tagKeys)
+}
+
+func TestMetadataDatabase_DropNamespace(t *testing.T) {
+	db := newMockMetadataDatabase(t, t.TempDir())
+	defer func() {
+		assert.NoError(t, db.Close())
+	}()
+	for _, name := range []string{"cpu", "mem"} {
+		_, err := db.GenMetricID("ns", name)
+		assert.NoError(t, err)
+	}
+	_, err := db.GenMetricID("ns2", "cpu")
+	assert.NoError(t, err)
+
+	assert.NoError(t, db.DropNamespace("ns"))
+	namespaces, err := db.SuggestNamespace("ns", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns2"}, namespaces)
+	for _, name := range []string{"cpu", "mem"} {
+		_, err = db.GetMetricID("ns", name)
+		assert.True(t, errors.Is(err, constants.ErrMetricIDNotFound))
+	}
+	_, err = db.GetMetricID("ns2", "cpu")
+	assert.NoError(t, err)
+}
+
+func TestMetadataDatabase_DropNamespace_Failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	db := newMockMetadataDatabase(t, t.TempDir())
+	mockBackend.EXPECT().suggestMetricName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, db.DropNamespace("ns"))
+	mockBackend.EXPECT().suggestMetricName(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"cpu"}, nil)
+	mockBackend.EXPECT().dropMetric(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, db.DropNamespace("ns"))
+}
+
 func TestMetadataDatabase_Close_Sync(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -45,6 +45,10 @@ type MetricMetadata interface {
 	getField(fieldName field.Name) (field.Meta, bool)
 	// getAllFields returns the all fields of the metric
 	getAllFields() (fields field.Metas)
+	// getFieldMaxID returns the max field id which has been assigned
+	getFieldMaxID() field.ID
+	// updateFields replaces the all fields of the metric with field id sequence
+	updateFields(fields field.Metas, fieldMaxID field.ID)
 
 	// createTagKey creates the tag key
 	createTagKey(tagKey string, tagKeyID tag.KeyID)
@@ -119,6 +123,17 @@ func (mm *metricMetadata) getAllFields() (fields field.Metas) {
 	return
 }
 
+// getFieldMaxID returns the max field id which has been assigned
+func (mm *metricMetadata) getFieldMaxID() field.ID {
+	return field.ID(mm.fieldIDSeq.Load())
+}
+
+// updateFields replaces the all fields of the metric with field id sequence
+func (mm *metricMetadata) updateFields(fields field.Metas, fieldMaxID field.ID) {
+	mm.fields = fields
+	mm.fieldIDSeq.Store(int32(fieldMaxID))
+}
+
 // createTagKey creates the tag key
 // 1. checks the tag keys if limited, if limit return series.ErrTooManyTagKeys
 func (mm *metricMetadata) createTagKey(tagKey string, tagKeyID tag.KeyID) {
@@ -116,6 +116,18 @@ func TestMetricMetadata_getField(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestMetricMetadata_updateFields(t *testing.T) {
+	m := newMetricMetadata(metric.ID(2))
+	m.initialize(field.Metas{{ID: 1, Type: field.SumField, Name: "sum"}}, 1, nil)
+	assert.Equal(t, field.ID(1), m.getFieldMaxID())
+	m.updateFields(field.Metas{{ID: 2, Type: field.MaxField, Name: "sum"}}, 2)
+	assert.Equal(t, field.ID(2), m.getFieldMaxID())
+	assert.Equal(t, field.Metas{{ID: 2, Type: field.MaxField, Name: "sum"}}, m.getAllFields())
+	f, err := m.createField("min", field.MinField)
+	assert.NoError(t, err)
+	assert.Equal(t, field.ID(3), f.ID)
+}
+
 func TestMetricMetadata_createTagKey(t *testing.T) {
 	m := newMetricMetadata(metric.ID(2))
 	mid := m.getMetricID()
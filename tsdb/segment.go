@@ -27,6 +27,7 @@ import (
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
@@ -45,6 +46,8 @@ type Segment interface {
 	GetDataFamilies(timeRange timeutil.TimeRange) []DataFamily
 	// NeedEvict checks segment if it can evict, long term no read operation.
 	NeedEvict() bool
+	// MoveToBackend moves the data files of segment into tiered storage.
+	MoveToBackend() error
 	// Close closes segment, include kv store.
 	Close()
 }
@@ -80,12 +83,22 @@ func newSegment(shard Shard, segmentName string, interval timeutil.Interval) (Se
 		// if interval == writeable interval and database set auto rollup intervals
 		sort.Sort(intervals) // need sort interval
 		var rollup []timeutil.Interval
-		for _, rollupInterval := range intervals {
+		for idx, rollupInterval := range intervals {
 			rollup = append(rollup, rollupInterval.Interval)
+			if idx > 0 && len(rollupInterval.Aggregates) > 0 {
+				storeOption.RollupAggregates = append(storeOption.RollupAggregates, kv.RollupAggregate{
+					Interval:   rollupInterval.Interval,
+					Aggregates: rollupInterval.Aggregates,
+				})
+			}
 		}
 		storeOption.Rollup = rollup[1:]
 		storeOption.Source = interval
 	}
+	if bucket := shard.Database().Bucket(); bucket != nil {
+		// tiered storage enabled, data files of cold segment are read back lazily from bucket
+		storeOption.Backend = table.NewBucketFileBackend(bucket, indicator)
+	}
 	kvStore, err := kv.GetStoreManager().CreateStore(indicator, storeOption)
 	if err != nil {
 		return nil, fmt.Errorf("create kv store for segment error:%s", err)
@@ -142,6 +155,11 @@ func (s *segment) NeedEvict() bool {
 	return diff > ahead
 }
 
+// MoveToBackend moves the data files of segment into tiered storage.
+func (s *segment) MoveToBackend() error {
+	return s.kvStore.MoveToBackend()
+}
+
 // GetOrCreateDataFamily returns the data family based on timestamp.
 func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	calc := s.interval.Calculator()
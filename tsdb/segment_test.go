@@ -20,6 +20,7 @@ package tsdb
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,8 @@ import (
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/objstore"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -43,8 +46,12 @@ func TestSegment_New(t *testing.T) {
 	kv.InitStoreManager(storeMgr)
 	store := kv.NewMockStore(ctrl)
 
+	var bucket objstore.Bucket
 	database := NewMockDatabase(ctrl)
 	database.EXPECT().Name().Return("test").AnyTimes()
+	database.EXPECT().Bucket().DoAndReturn(func() objstore.Bucket {
+		return bucket
+	}).AnyTimes()
 	shard := NewMockShard(ctrl)
 	interval := timeutil.Interval(timeutil.OneSecond * 10)
 	shard.EXPECT().Database().Return(database).AnyTimes()
@@ -78,6 +85,41 @@ func TestSegment_New(t *testing.T) {
 				storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).Return(store, nil)
 			},
 		},
+		{
+			name:        "create segment successfully and set rollup aggregates",
+			segmentName: segmentName,
+			prepare: func() {
+				database.EXPECT().GetOption().Return(&option.DatabaseOption{
+					Intervals: option.Intervals{
+						{Interval: timeutil.Interval(timeutil.OneHour), Aggregates: []string{"max"}},
+						{Interval: interval},
+						{Interval: timeutil.Interval(5 * timeutil.OneMinute)},
+					},
+				})
+				storeMgr.EXPECT().CreateStore(gomock.Any(), kv.StoreOption{
+					Levels: 2,
+					TTL:    ltoml.Duration(time.Hour),
+					Source: interval,
+					Rollup: []timeutil.Interval{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneHour)},
+					RollupAggregates: []kv.RollupAggregate{
+						{Interval: timeutil.Interval(timeutil.OneHour), Aggregates: []string{"max"}},
+					},
+				}).Return(store, nil)
+			},
+		},
+		{
+			name:        "create segment successfully and set tiered storage backend",
+			segmentName: segmentName,
+			prepare: func() {
+				bucket = objstore.NewMockBucket(ctrl)
+				database.EXPECT().GetOption().Return(&option.DatabaseOption{Intervals: option.Intervals{{Interval: interval}}})
+				storeMgr.EXPECT().CreateStore("test/shard/1/segment/day/20190904", gomock.Any()).
+					DoAndReturn(func(_ string, storeOption kv.StoreOption) (kv.Store, error) {
+						assert.NotNil(t, storeOption.Backend)
+						return store, nil
+					})
+			},
+		},
 		{
 			name:        "parse segment name err",
 			segmentName: "xx",
@@ -99,6 +141,7 @@ func TestSegment_New(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			defer func() {
 				newDataFamilyFunc = newDataFamily
+				bucket = nil
 			}()
 			if tt.prepare != nil {
 				tt.prepare()
@@ -369,3 +412,15 @@ func TestSegment_NeedEvict(t *testing.T) {
 	s.lastReadTime.Store(timeutil.Now() - 7*timeutil.OneHour - timeutil.OneMinute)
 	assert.True(t, s.NeedEvict())
 }
+
+func TestSegment_MoveToBackend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	s := &segment{kvStore: store}
+	store.EXPECT().MoveToBackend().Return(fmt.Errorf("err"))
+	assert.Error(t, s.MoveToBackend())
+	store.EXPECT().MoveToBackend().Return(nil)
+	assert.NoError(t, s.MoveToBackend())
+}
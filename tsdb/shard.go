@@ -19,6 +19,7 @@ package tsdb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sort"
@@ -38,9 +39,11 @@ import (
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/metadb"
+	"github.com/lindb/lindb/tsdb/tblstore/exemplar"
 	"github.com/lindb/lindb/tsdb/tblstore/tagindex"
 )
 
@@ -62,10 +65,13 @@ type Shard interface {
 	GetDataFamilies(intervalType timeutil.IntervalType, timeRange timeutil.TimeRange) []DataFamily
 	// IndexDatabase returns the index-database
 	IndexDatabase() indexdb.IndexDatabase
+	// ExemplarStore returns the exemplar store of shard.
+	ExemplarStore() ExemplarStore
 	// BufferManager returns write temp memory manager.
 	BufferManager() memdb.BufferManager
-	// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
-	LookupRowMetricMeta(rows []metric.StorageRow) error
+	// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch,
+	// returns the number of rows rejected because of exceeding series limits.
+	LookupRowMetricMeta(rows []metric.StorageRow) (rejectedRows int, err error)
 	// FlushIndex flushes index data to disk.
 	FlushIndex() error
 	// WaitFlushIndexCompleted waits flush index job completed.
@@ -102,6 +108,7 @@ type shard struct {
 	indexStore     kv.Store  // kv stores
 	forwardFamily  kv.Family // forward store
 	invertedFamily kv.Family // inverted store
+	exemplarStore  ExemplarStore
 	logger         *logger.Logger
 
 	statistics *metrics.ShardStatistics
@@ -186,6 +193,9 @@ func (s *shard) CurrentInterval() timeutil.Interval { return s.interval }
 
 func (s *shard) IndexDatabase() indexdb.IndexDatabase { return s.indexDB }
 
+// ExemplarStore returns the exemplar store of shard.
+func (s *shard) ExemplarStore() ExemplarStore { return s.exemplarStore }
+
 // BufferManager returns write temp memory manager.
 func (s *shard) BufferManager() memdb.BufferManager {
 	return s.bufferMgr
@@ -245,7 +255,7 @@ func (s *shard) lookupRowMeta(row *metric.StorageRow) (err error) {
 		// if metric without tags, uses default series id(0)
 		row.SeriesID = series.IDWithoutTags
 	} else {
-		row.SeriesID, isCreated, err = s.indexDB.GetOrCreateSeriesID(row.MetricID, row.TagsHash())
+		row.SeriesID, isCreated, err = s.indexDB.GetOrCreateSeriesID(namespace, metricName, row.MetricID, row.TagsHash())
 		if err != nil {
 			return err
 		}
@@ -318,10 +328,15 @@ Done:
 	return nil
 }
 
-// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
-func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) error {
+// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch,
+// returns the number of rows rejected because of exceeding series limits.
+func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) (rejectedRows int, err error) {
 	for idx := range rows {
 		if err := s.lookupRowMeta(&rows[idx]); err != nil {
+			if errors.Is(err, series.ErrTooManySeries) {
+				rejectedRows++
+				s.statistics.RejectedRows.Incr()
+			}
 			s.statistics.LookupMetricMetaFailures.Incr()
 			s.logger.Error("failed to lookup meta of row",
 				logger.String("database", s.db.Name()),
@@ -329,7 +344,7 @@ func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) error {
 			continue
 		}
 	}
-	return nil
+	return rejectedRows, nil
 }
 
 func (s *shard) Close() error {
@@ -341,6 +356,11 @@ func (s *shard) Close() error {
 			return err
 		}
 	}
+	if s.exemplarStore != nil {
+		if err := s.exemplarStore.Flush(); err != nil {
+			return err
+		}
+	}
 	if s.indexStore != nil {
 		if err := kv.GetStoreManager().CloseStore(s.indexStore.Name()); err != nil {
 			return err
@@ -383,6 +403,14 @@ func (s *shard) FlushIndex() (err error) {
 		logger.String("database", s.db.Name()),
 		logger.Any("shardID", s.id),
 	)
+	// exemplar flush
+	if err = s.exemplarStore.Flush(); err != nil {
+		s.logger.Error("failed to flush exemplars",
+			logger.String("database", s.db.Name()),
+			logger.Any("shardID", s.id),
+			logger.Error(err))
+		return err
+	}
 
 	return nil
 }
@@ -440,6 +468,15 @@ func (s *shard) initIndexDatabase() error {
 	if err != nil {
 		return err
 	}
+	exemplarFamily, err := s.indexStore.CreateFamily(
+		exemplarDir,
+		kv.FamilyOption{
+			CompactThreshold: 0,
+			Merger:           string(exemplar.MergerName)})
+	if err != nil {
+		return err
+	}
+	s.exemplarStore = newExemplarStore(exemplarFamily)
 	s.indexDB, err = newIndexDBFunc(
 		context.TODO(),
 		shardMetaPath(s.db.Name(), s.id),
@@ -448,5 +485,17 @@ func (s *shard) initIndexDatabase() error {
 	if err != nil {
 		return err
 	}
+	if s.option != nil {
+		s.indexDB.SetLimits(s.option.Limits)
+	}
+	// purge inverted index of dropped tag keys when does compaction job
+	droppedFn := tagindex.DroppedTagKeyFn(s.isTagKeyDropped)
+	s.forwardFamily.SetMergeContext(tagindex.DroppedTagKeyContext, droppedFn)
+	s.invertedFamily.SetMergeContext(tagindex.DroppedTagKeyContext, droppedFn)
 	return nil
 }
+
+// isTagKeyDropped checks if the tag key is dropped.
+func (s *shard) isTagKeyDropped(tagKeyID uint32) bool {
+	return s.indexDB.IsTagKeyDropped(tag.KeyID(tagKeyID))
+}
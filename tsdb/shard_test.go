@@ -36,12 +36,14 @@ import (
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoMetricsV1 "github.com/lindb/lindb/proto/gen/v1/linmetrics"
+	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/metadb"
+	"github.com/lindb/lindb/tsdb/tblstore/tagindex"
 )
 
 func TestShard_New(t *testing.T) {
@@ -128,6 +130,23 @@ func TestShard_New(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "create exemplar family err",
+			prepare: func() {
+				store := kv.NewMockStore(ctrl)
+				gomock.InOrder(
+					db.EXPECT().GetOption().Return(&option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}),
+					storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).
+						Return(store, nil),
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil),               // forward
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil),               // inverted
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err")), // exemplar
+					store.EXPECT().Name().Return("test"),
+					storeMgr.EXPECT().CloseStore("test").Return(nil),
+				)
+			},
+			wantErr: true,
+		},
 		{
 			name: "create index db err",
 			prepare: func() {
@@ -142,6 +161,7 @@ func TestShard_New(t *testing.T) {
 						Return(store, nil),
 					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil), // forward
 					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil), // inverted
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil), // exemplar
 					store.EXPECT().Name().Return("test"),
 					storeMgr.EXPECT().CloseStore("test").Return(fmt.Errorf("err")),
 				)
@@ -151,17 +171,26 @@ func TestShard_New(t *testing.T) {
 		{
 			name: "create shard successfully",
 			prepare: func() {
+				limits := &option.Limits{MaxSeriesPerShard: 10}
+				indexDB := indexdb.NewMockIndexDatabase(ctrl)
+				indexDB.EXPECT().SetLimits(limits)
 				newIndexDBFunc = func(ctx context.Context, parent string, metadata metadb.Metadata,
 					forwardFamily kv.Family, invertedFamily kv.Family) (indexdb.IndexDatabase, error) {
-					return nil, nil
+					return indexDB, nil
 				}
 				store := kv.NewMockStore(ctrl)
+				family := kv.NewMockFamily(ctrl)
+				family.EXPECT().SetMergeContext(tagindex.DroppedTagKeyContext, gomock.Any()).Times(2)
 				gomock.InOrder(
-					db.EXPECT().GetOption().Return(&option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}),
+					db.EXPECT().GetOption().Return(&option.DatabaseOption{
+						Intervals: option.Intervals{{Interval: 10 * 1000}},
+						Limits:    limits,
+					}),
 					storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).
 						Return(store, nil),
-					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil), // forward
-					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil), // inverted
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(family, nil), // forward
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(family, nil), // inverted
+					store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(family, nil), // exemplar
 				)
 			},
 		},
@@ -301,6 +330,18 @@ func TestShard_Close(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "flush exemplars err",
+			prepare: func() {
+				exemplarStore := NewMockExemplarStore(ctrl)
+				s.exemplarStore = exemplarStore
+				gomock.InOrder(
+					index.EXPECT().Close().Return(nil),
+					exemplarStore.EXPECT().Flush().Return(fmt.Errorf("err")),
+				)
+			},
+			wantErr: true,
+		},
 		{
 			name: "close store err",
 			prepare: func() {
@@ -331,6 +372,7 @@ func TestShard_Close(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			defer func() {
 				s.indexStore = nil
+				s.exemplarStore = nil
 			}()
 			if tt.prepare != nil {
 				tt.prepare()
@@ -350,8 +392,10 @@ func TestShard_Flush(t *testing.T) {
 	index := indexdb.NewMockIndexDatabase(ctrl)
 	db := NewMockDatabase(ctrl)
 	db.EXPECT().Name().Return("test").AnyTimes()
+	exemplarStore := NewMockExemplarStore(ctrl)
 	s := &shard{
 		indexDB:        index,
+		exemplarStore:  exemplarStore,
 		db:             db,
 		flushCondition: sync.NewCond(&sync.Mutex{}),
 		statistics:     metrics.NewShardStatistics("data", "1"),
@@ -375,10 +419,19 @@ func TestShard_Flush(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "flush exemplars err",
+			prepare: func() {
+				index.EXPECT().Flush().Return(nil)
+				exemplarStore.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
 		{
 			name: "flush successfully",
 			prepare: func() {
 				index.EXPECT().Flush().Return(nil)
+				exemplarStore.EXPECT().Flush().Return(nil)
 			},
 		},
 	}
@@ -434,7 +487,7 @@ func TestShard_Write(t *testing.T) {
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			err := s.LookupRowMetricMeta(mockBatchRows(&protoMetricsV1.Metric{
+			_, err := s.LookupRowMetricMeta(mockBatchRows(&protoMetricsV1.Metric{
 				Name:      "test",
 				Timestamp: timeutil.Now(),
 				SimpleFields: []*protoMetricsV1.SimpleField{{
@@ -450,6 +503,45 @@ func TestShard_Write(t *testing.T) {
 	}
 }
 
+func TestShard_RejectedRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("tet").AnyTimes()
+	s := &shard{
+		indexDB:    indexDB,
+		db:         db,
+		metadata:   metadata,
+		statistics: metrics.NewShardStatistics("data", "1"),
+		logger:     logger.GetLogger("TSDB", "test"),
+	}
+	metadataDB.EXPECT().GenMetricID(constants.DefaultNamespace, "test").Return(metric.ID(10), nil).AnyTimes()
+	indexDB.EXPECT().GetOrCreateSeriesID(constants.DefaultNamespace, "test", metric.ID(10), gomock.Any()).
+		Return(uint32(0), false, fmt.Errorf("%w, metric: test, limit: 10", series.ErrTooManySeries))
+	indexDB.EXPECT().GetOrCreateSeriesID(constants.DefaultNamespace, "test", metric.ID(10), gomock.Any()).
+		Return(uint32(0), false, fmt.Errorf("err"))
+	for _, expect := range []int{1, 0} {
+		rejectedRows, err := s.LookupRowMetricMeta(mockBatchRows(&protoMetricsV1.Metric{
+			Name:      "test",
+			Timestamp: timeutil.Now(),
+			Tags:      tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
+			SimpleFields: []*protoMetricsV1.SimpleField{{
+				Name:  "f1",
+				Value: 1.0,
+				Type:  protoMetricsV1.SimpleFieldType_DELTA_SUM,
+			}},
+		}))
+		assert.NoError(t, err)
+		// only rows rejected by series limits are counted
+		assert.Equal(t, expect, rejectedRows)
+	}
+}
+
 func TestShard_lookupRowMeta(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -485,7 +577,7 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			tags: tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
 			prepare: func() {
 				metadataDB.EXPECT().GenMetricID(constants.DefaultNamespace, "test").Return(metric.ID(10), nil).AnyTimes()
-				indexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
+				indexDB.EXPECT().GetOrCreateSeriesID(constants.DefaultNamespace, "test", metric.ID(10), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
@@ -495,9 +587,19 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			prepare: func() {
 				metadataDB.EXPECT().GenMetricID(constants.DefaultNamespace, "test").Return(metric.ID(10), nil).AnyTimes()
 				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(field.ID(1), nil)
-				indexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10), gomock.Any()).Return(uint32(10), false, nil)
+				indexDB.EXPECT().GetOrCreateSeriesID(constants.DefaultNamespace, "test", metric.ID(10), gomock.Any()).Return(uint32(10), false, nil)
 			},
 		},
+		{
+			name: "too many series",
+			tags: tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
+			prepare: func() {
+				metadataDB.EXPECT().GenMetricID(constants.DefaultNamespace, "test").Return(metric.ID(10), nil).AnyTimes()
+				indexDB.EXPECT().GetOrCreateSeriesID(constants.DefaultNamespace, "test", metric.ID(10), gomock.Any()).
+					Return(uint32(0), false, series.ErrTooManySeries)
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range cases {
 		tt := tt
@@ -530,8 +632,11 @@ func TestShard_WaitFlushIndexCompleted(t *testing.T) {
 	index := indexdb.NewMockIndexDatabase(ctrl)
 	db := NewMockDatabase(ctrl)
 	db.EXPECT().Name().Return("test").AnyTimes()
+	exemplarStore := NewMockExemplarStore(ctrl)
+	exemplarStore.EXPECT().Flush().Return(nil).AnyTimes()
 	s := &shard{
 		indexDB:        index,
+		exemplarStore:  exemplarStore,
 		db:             db,
 		flushCondition: sync.NewCond(&sync.Mutex{}),
 		statistics:     metrics.NewShardStatistics("data", "1"),
@@ -608,3 +713,12 @@ func mockBatchRows(m *protoMetricsV1.Metric) []metric.StorageRow {
 	br.UnmarshalRows(buf.Bytes())
 	return br.Rows()
 }
+
+func TestShard_isTagKeyDropped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	s := &shard{indexDB: indexDB}
+	indexDB.EXPECT().IsTagKeyDropped(tag.KeyID(10)).Return(true)
+	assert.True(t, s.isTagKeyDropped(10))
+}
@@ -0,0 +1,149 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+var (
+	// MaxPerSlot represents the max exemplars kept for each series in a time slot.
+	MaxPerSlot = 4
+	// MaxPerMetric represents the max exemplars kept for each metric in a family,
+	// the oldest exemplars will be dropped if exceed.
+	MaxPerMetric = 10000
+)
+
+// Exemplar represents a trace sample attached to a series point.
+type Exemplar struct {
+	SeriesID  uint32
+	Timestamp int64 // timestamp of the time slot which exemplar belongs to
+	TraceID   []byte
+	SpanID    []byte
+	Duration  int64
+}
+
+//  ━━━━━━━━━━━━━━━━━━━━━━━━━━━Layout of Exemplar Block━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+//
+// ┌──────────┬─────────────────────────────────────────────────────────────┬─────┐
+// │  Count   │                          Exemplar                           │ ... │
+// ├──────────┼──────────┬──────────┬──────────┬────────────┬──────────────┼─────┤
+// │ uvarint  │ SeriesID │Timestamp │ Duration │  TraceID   │    SpanID    │     │
+// │          │ uvarint  │  varint  │  varint  │ len+bytes  │  len+bytes   │     │
+// └──────────┴──────────┴──────────┴──────────┴────────────┴──────────────┴─────┘
+//
+// exemplars are sorted by series id and timestamp.
+
+// Encode encodes the exemplars into a block.
+func Encode(exemplars []Exemplar) []byte {
+	writer := stream.NewBufferWriter(nil)
+	writer.PutUvarint32(uint32(len(exemplars)))
+	for idx := range exemplars {
+		e := &exemplars[idx]
+		writer.PutUvarint32(e.SeriesID)
+		writer.PutVarint64(e.Timestamp)
+		writer.PutVarint64(e.Duration)
+		writer.PutUvarint32(uint32(len(e.TraceID)))
+		writer.PutBytes(e.TraceID)
+		writer.PutUvarint32(uint32(len(e.SpanID)))
+		writer.PutBytes(e.SpanID)
+	}
+	data, _ := writer.Bytes()
+	return data
+}
+
+// Decode decodes the exemplars from a block.
+func Decode(block []byte) ([]Exemplar, error) {
+	reader := stream.NewReader(block)
+	count := reader.ReadUvarint32()
+	if reader.Error() != nil {
+		return nil, reader.Error()
+	}
+	exemplars := make([]Exemplar, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var e Exemplar
+		e.SeriesID = reader.ReadUvarint32()
+		e.Timestamp = reader.ReadVarint64()
+		e.Duration = reader.ReadVarint64()
+		e.TraceID = reader.ReadBytes(int(reader.ReadUvarint32()))
+		e.SpanID = reader.ReadBytes(int(reader.ReadUvarint32()))
+		if reader.Error() != nil {
+			return nil, reader.Error()
+		}
+		exemplars = append(exemplars, e)
+	}
+	return exemplars, nil
+}
+
+// Compact removes duplicate exemplars, then bounds the exemplars by max per slot/metric,
+// for each series in a time slot keeps the exemplars with the largest duration.
+// returns the compacted exemplars sorted by series id and timestamp.
+func Compact(exemplars []Exemplar, maxPerSlot, maxPerMetric int) []Exemplar {
+	if len(exemplars) == 0 {
+		return exemplars
+	}
+	sort.SliceStable(exemplars, func(i, j int) bool {
+		a, b := &exemplars[i], &exemplars[j]
+		if a.SeriesID != b.SeriesID {
+			return a.SeriesID < b.SeriesID
+		}
+		if a.Timestamp != b.Timestamp {
+			return a.Timestamp < b.Timestamp
+		}
+		return a.Duration > b.Duration
+	})
+	rs := exemplars[:0]
+	slotStart := 0
+	for idx := range exemplars {
+		e := exemplars[idx]
+		if slotStart < len(rs) && (rs[slotStart].SeriesID != e.SeriesID || rs[slotStart].Timestamp != e.Timestamp) {
+			slotStart = len(rs)
+		}
+		slot := rs[slotStart:]
+		if len(slot) >= maxPerSlot || contains(slot, &e) {
+			continue
+		}
+		rs = append(rs, e)
+	}
+	if len(rs) <= maxPerMetric {
+		return rs
+	}
+	// drop the oldest exemplars
+	sort.SliceStable(rs, func(i, j int) bool { return rs[i].Timestamp > rs[j].Timestamp })
+	rs = rs[:maxPerMetric]
+	sort.SliceStable(rs, func(i, j int) bool {
+		if rs[i].SeriesID != rs[j].SeriesID {
+			return rs[i].SeriesID < rs[j].SeriesID
+		}
+		return rs[i].Timestamp < rs[j].Timestamp
+	})
+	return rs
+}
+
+// contains checks if the exemplars contain the same trace span.
+func contains(exemplars []Exemplar, e *Exemplar) bool {
+	for idx := range exemplars {
+		if bytes.Equal(exemplars[idx].TraceID, e.TraceID) && bytes.Equal(exemplars[idx].SpanID, e.SpanID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode_Decode(t *testing.T) {
+	exemplars := []Exemplar{
+		{SeriesID: 1, Timestamp: 10, TraceID: []byte("trace-1"), SpanID: []byte("span-1"), Duration: 100},
+		{SeriesID: 2, Timestamp: -10, TraceID: []byte("trace-2"), Duration: 200},
+	}
+	rs, err := Decode(Encode(exemplars))
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+	assert.Equal(t, exemplars[0], rs[0])
+	assert.Equal(t, uint32(2), rs[1].SeriesID)
+	assert.Equal(t, int64(-10), rs[1].Timestamp)
+	assert.Empty(t, rs[1].SpanID)
+
+	rs, err = Decode(Encode(nil))
+	assert.NoError(t, err)
+	assert.Empty(t, rs)
+
+	// bad block
+	_, err = Decode(nil)
+	assert.Error(t, err)
+	_, err = Decode([]byte{5, 1})
+	assert.Error(t, err)
+}
+
+func TestCompact(t *testing.T) {
+	assert.Empty(t, Compact(nil, 2, 10))
+
+	exemplars := []Exemplar{
+		{SeriesID: 2, Timestamp: 10, TraceID: []byte("t1"), Duration: 10},
+		{SeriesID: 1, Timestamp: 20, TraceID: []byte("t2"), Duration: 10},
+		{SeriesID: 1, Timestamp: 10, TraceID: []byte("t3"), Duration: 10},
+		{SeriesID: 1, Timestamp: 10, TraceID: []byte("t4"), Duration: 30},
+		{SeriesID: 1, Timestamp: 10, TraceID: []byte("t4"), Duration: 30},
+		{SeriesID: 1, Timestamp: 10, TraceID: []byte("t5"), Duration: 20},
+	}
+	rs := Compact(exemplars, 2, 10)
+	var traces []string
+	for _, e := range rs {
+		traces = append(traces, string(e.TraceID))
+	}
+	// dedup, keep largest duration in slot
+	assert.Equal(t, []string{"t4", "t5", "t2", "t1"}, traces)
+
+	// drop oldest exemplars
+	rs = Compact(rs, 2, 3)
+	traces = traces[:0]
+	for _, e := range rs {
+		traces = append(traces, string(e.TraceID))
+	}
+	assert.Equal(t, []string{"t4", "t5", "t2"}, traces)
+}
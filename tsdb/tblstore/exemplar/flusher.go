@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"github.com/lindb/lindb/kv"
+)
+
+//go:generate mockgen -source ./flusher.go -destination=./flusher_mock.go -package exemplar
+
+// Flusher is a wrapper of kv.Builder, provides the ability to build exemplar table.
+type Flusher interface {
+	// FlushMetric writes the exemplars of metric, exemplars will be compacted before writing,
+	// the given exemplars are not modified.
+	FlushMetric(metricID uint32, exemplars []Exemplar) error
+	// Commit commits the exemplar table.
+	Commit() error
+}
+
+// flusher implements Flusher.
+type flusher struct {
+	kvFlusher kv.Flusher
+}
+
+// NewFlusher returns a new exemplar Flusher.
+func NewFlusher(kvFlusher kv.Flusher) Flusher {
+	return &flusher{kvFlusher: kvFlusher}
+}
+
+// FlushMetric writes the exemplars of metric, exemplars will be compacted before writing.
+func (f *flusher) FlushMetric(metricID uint32, exemplars []Exemplar) error {
+	exemplars = Compact(append([]Exemplar(nil), exemplars...), MaxPerSlot, MaxPerMetric)
+	if len(exemplars) == 0 {
+		return nil
+	}
+	return f.kvFlusher.Add(metricID, Encode(exemplars))
+}
+
+// Commit commits the exemplar table.
+func (f *flusher) Commit() error {
+	return f.kvFlusher.Commit()
+}
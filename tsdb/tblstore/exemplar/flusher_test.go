@@ -0,0 +1,40 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+)
+
+func TestFlusher_FlushMetric(t *testing.T) {
+	nopFlusher := kv.NewNopFlusher()
+	f := NewFlusher(nopFlusher)
+	// empty exemplars
+	assert.NoError(t, f.FlushMetric(10, nil))
+	assert.Empty(t, nopFlusher.Bytes())
+
+	assert.NoError(t, f.FlushMetric(10, []Exemplar{{SeriesID: 1, Timestamp: 10, TraceID: []byte("t1")}}))
+	rs, err := Decode(nopFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+	assert.NoError(t, f.Commit())
+}
@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"github.com/lindb/lindb/kv"
+)
+
+var MergerName kv.MergerType = "ExemplarMerger"
+
+// init registers exemplar merger create function
+func init() {
+	kv.RegisterMerger(MergerName, NewMerger)
+}
+
+// merger implements kv.Merger for merging exemplars for each metric.
+type merger struct {
+	kvFlusher kv.Flusher
+}
+
+// NewMerger creates a merger for compacting exemplars.
+func NewMerger(kvFlusher kv.Flusher) (kv.Merger, error) {
+	return &merger{kvFlusher: kvFlusher}, nil
+}
+
+func (m *merger) Init(_ map[string]interface{}) {}
+
+// Merge merges the multi exemplar blocks into one bounded block for same metric.
+func (m *merger) Merge(metricID uint32, dataBlocks [][]byte) error {
+	var exemplars []Exemplar
+	for _, dataBlock := range dataBlocks {
+		rs, err := Decode(dataBlock)
+		if err != nil {
+			return err
+		}
+		exemplars = append(exemplars, rs...)
+	}
+	exemplars = Compact(exemplars, MaxPerSlot, MaxPerMetric)
+	if len(exemplars) == 0 {
+		return nil
+	}
+	return m.kvFlusher.Add(metricID, Encode(exemplars))
+}
@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	nopFlusher := kv.NewNopFlusher()
+	m, err := NewMerger(nopFlusher)
+	assert.NoError(t, err)
+	m.Init(nil)
+
+	// no data
+	assert.NoError(t, m.Merge(10, nil))
+	assert.Empty(t, nopFlusher.Bytes())
+
+	err = m.Merge(10, [][]byte{
+		Encode([]Exemplar{{SeriesID: 1, Timestamp: 10, TraceID: []byte("t1")}}),
+		Encode([]Exemplar{
+			{SeriesID: 1, Timestamp: 10, TraceID: []byte("t1")},
+			{SeriesID: 2, Timestamp: 10, TraceID: []byte("t2")},
+		}),
+	})
+	assert.NoError(t, err)
+	rs, err := Decode(nopFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+
+	// bad block
+	assert.Error(t, m.Merge(10, [][]byte{{5, 1}}))
+}
@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source ./reader.go -destination=./reader_mock.go -package exemplar
+
+// Reader reads exemplars from exemplar table.
+type Reader interface {
+	// Find finds the exemplars of series under metric in time range.
+	Find(metricID uint32, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) ([]Exemplar, error)
+}
+
+// reader implements Reader.
+type reader struct {
+	readers []table.Reader
+}
+
+// NewReader creates an exemplar Reader.
+func NewReader(readers []table.Reader) Reader {
+	return &reader{readers: readers}
+}
+
+// Find finds the exemplars of series under metric in time range.
+func (r *reader) Find(metricID uint32, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) ([]Exemplar, error) {
+	var rs []Exemplar
+	for _, tableReader := range r.readers {
+		block, err := tableReader.Get(metricID)
+		if err != nil {
+			// metric not exist in this table
+			continue
+		}
+		exemplars, err := Decode(block)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, Filter(exemplars, seriesIDs, timeRange)...)
+	}
+	return rs, nil
+}
+
+// Filter returns the exemplars which match series ids and time range.
+func Filter(exemplars []Exemplar, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) []Exemplar {
+	var rs []Exemplar
+	for idx := range exemplars {
+		e := &exemplars[idx]
+		if timeRange.Contains(e.Timestamp) && seriesIDs.Contains(e.SeriesID) {
+			rs = append(rs, *e)
+		}
+	}
+	return rs
+}
@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exemplar
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestReader_Find(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader1 := table.NewMockReader(ctrl)
+	reader2 := table.NewMockReader(ctrl)
+	reader3 := table.NewMockReader(ctrl)
+	r := NewReader([]table.Reader{reader1, reader2, reader3})
+	reader1.EXPECT().Get(uint32(10)).Return(Encode([]Exemplar{
+		{SeriesID: 1, Timestamp: 10, TraceID: []byte("t1")},
+		{SeriesID: 2, Timestamp: 10, TraceID: []byte("t2")},
+		{SeriesID: 1, Timestamp: 100, TraceID: []byte("t3")},
+	}), nil)
+	reader2.EXPECT().Get(uint32(10)).Return(nil, fmt.Errorf("err"))
+	reader3.EXPECT().Get(uint32(10)).Return(Encode([]Exemplar{
+		{SeriesID: 1, Timestamp: 20, TraceID: []byte("t4")},
+	}), nil)
+	rs, err := r.Find(10, roaring.BitmapOf(1), timeutil.TimeRange{Start: 0, End: 50})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+	assert.Equal(t, []byte("t1"), rs[0].TraceID)
+	assert.Equal(t, []byte("t4"), rs[1].TraceID)
+
+	// bad block
+	reader1.EXPECT().Get(uint32(10)).Return([]byte{5, 1}, nil)
+	rs, err = r.Find(10, roaring.BitmapOf(1), timeutil.TimeRange{Start: 0, End: 50})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
@@ -34,6 +34,9 @@ type FieldReader interface {
 	// GetFieldData returns the field data by field id,
 	// if metricReader is completed, return nil, if found data returns field data else returns nil
 	GetFieldData(fieldID field.ID) []byte
+	// GetAggregateData returns the data of rollup aggregate by field id and agg type,
+	// returns nil if the aggregate not materialized in this block.
+	GetAggregateData(fieldID field.ID, aggType field.AggType) []byte
 	// Reset resets the field data for reading
 	Reset(seriesEntry []byte, slotRange timeutil.SlotRange)
 	// Close closes the metricReader
@@ -46,14 +49,14 @@ type fieldReader struct {
 	seriesEntry  []byte
 	fieldOffsets *encoding.FixedOffsetDecoder
 	fieldDatas   []byte
-	fieldIndexes map[field.ID]int
+	fieldIndexes map[fieldKey]int
 	fieldCount   int
 
 	completed bool // !!!!NOTICE: need reset completed
 }
 
 // newFieldReader creates the field metricReader
-func newFieldReader(fieldIndexes map[field.ID]int, seriesEntry []byte, slotRange timeutil.SlotRange) FieldReader {
+func newFieldReader(fieldIndexes map[fieldKey]int, seriesEntry []byte, slotRange timeutil.SlotRange) FieldReader {
 	r := &fieldReader{
 		fieldIndexes: fieldIndexes,
 		fieldCount:   len(fieldIndexes),
@@ -99,10 +102,16 @@ func (r *fieldReader) SlotRange() timeutil.SlotRange {
 // GetFieldData returns the field data by field id,
 // if metricReader is completed, return nil, if found data returns field data else returns nil
 func (r *fieldReader) GetFieldData(fieldID field.ID) []byte {
+	return r.GetAggregateData(fieldID, 0)
+}
+
+// GetAggregateData returns the data of rollup aggregate by field id and agg type,
+// agg type = 0 means the field data self.
+func (r *fieldReader) GetAggregateData(fieldID field.ID, aggType field.AggType) []byte {
 	if r.completed {
 		return nil
 	}
-	idx, ok := r.fieldIndexes[fieldID]
+	idx, ok := r.fieldIndexes[fieldKey{id: fieldID, aggType: aggType}]
 	if !ok {
 		return nil
 	}
@@ -40,7 +40,7 @@ import (
 // Level3: compressed field data
 //
 // flush step:
-// 1. flush field metas of metric level
+// 1. flush field metas(and dictionary of string fields) of metric level
 // 2. flush field store of one series
 // 3. flush series id
 // 4. flush metric data include field metadata and all series ids data
@@ -50,6 +50,9 @@ type Flusher interface {
 		metricID uint32,
 		fieldMetas field.Metas,
 	)
+	// FlushDictionary sets the dictionary of string fields for current metric,
+	// it will be written after field metas when committing metric.
+	FlushDictionary(dictionary field.Dictionary)
 	// FlushField writes a compressed field data to writer.
 	// It will be called in order with field metas even if field data is empty
 	FlushField(data []byte) error
@@ -102,6 +105,15 @@ type flusher struct {
 	// ├──────────┼──────────┼──────────┼──────────┼──────────┼──────────┤
 	// │  1 Byte  │  1 Bytes │ 1 Byte   │  1 Bytes │ 1 Byte   │          │
 	// └──────────┴──────────┴──────────┴──────────┴──────────┴──────────┘
+	// rollup aggregate of field(materialized when rollup) writes rollupAggFlag|AggType as field type.
+	//
+	// Level2(Dictionary, optional, only exists if metric has string fields)
+	// ┌─────────────────────────────────────────────────────────────────┐
+	// │                      Dictionary                                 │
+	// ├──────────┬──────────┬──────────┬──────────┬──────────┬──────────┤
+	// │   Count  │   Code   │  Length  │  Value   │   Code   │          │
+	// │(uvarint) │ (uint64) │(uvarint) │          │ (uint64) │  ......  │
+	// └──────────┴──────────┴──────────┴──────────┴──────────┴──────────┘
 	//
 	// Level2 (KV table: Series Bucket Footer)
 	// ┌──────────────────────────────────────────────────────┐
@@ -118,6 +130,7 @@ type flusher struct {
 	// Resets it after completed writing a metric
 	Level2 struct {
 		fieldMetas     field.Metas
+		dictionary     field.Dictionary
 		seriesIDs      *roaring.Bitmap
 		highKeyOffsets *encoding.FixedOffsetEncoder
 		footer         [dataFooterSize]byte
@@ -220,6 +233,11 @@ func (w *flusher) PrepareMetric(
 	w.prepareEncoder()
 }
 
+// FlushDictionary sets the dictionary of string fields for current metric.
+func (w *flusher) FlushDictionary(dictionary field.Dictionary) {
+	w.Level2.dictionary = dictionary
+}
+
 // prepareEncoder prepares tsd encoder slice based on current field metas.
 func (w *flusher) prepareEncoder() {
 	countOfFixEncode := len(w.Level2.fieldMetas) - len(w.encoders)
@@ -347,6 +365,7 @@ func (w *flusher) flushLevel2SeriesBucket() error {
 
 func (w *flusher) reset() {
 	w.Level2.fieldMetas = w.Level2.fieldMetas[:0]
+	w.Level2.dictionary = nil
 	w.Level2.seriesIDs.Clear()
 	w.Level2.highKeyOffsets.Reset()
 
@@ -379,14 +398,28 @@ func (w *flusher) CommitMetric(slotRange timeutil.SlotRange) error {
 	}
 	// write field-id, field-type list
 	for _, fm := range w.Level2.fieldMetas {
+		fType := byte(fm.Type)
+		if fm.AggType > 0 {
+			fType = rollupAggFlag | byte(fm.AggType)
+		}
 		// write field-id, field-type
 		if _, err := w.kvWriter.Write([]byte{
 			byte(fm.ID),
-			byte(fm.Type),
+			fType,
 		}); err != nil {
 			return err
 		}
 	}
+	// write dictionary of string fields
+	if len(w.Level2.dictionary) > 0 {
+		data, err := w.Level2.dictionary.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if _, err := w.kvWriter.Write(data); err != nil {
+			return err
+		}
+	}
 	// write series ids bitmap
 	seriesIDAt := w.kvWriter.Size()
 	if _, err := w.Level2.seriesIDs.WriteTo(w.kvWriter); err != nil {
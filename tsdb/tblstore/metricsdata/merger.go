@@ -18,6 +18,7 @@
 package metricsdata
 
 import (
+	"math"
 	"sort"
 
 	"github.com/lindb/roaring"
@@ -30,6 +31,13 @@ import (
 
 var MetricDataMerger kv.MergerType = "MetricDataMerger"
 
+// DeletedSeriesContext represents the context key of deleted series function.
+const DeletedSeriesContext = "DeletedSeriesContext"
+
+// DeletedSeriesFn returns the series ids which are deleted for given metric id,
+// merger purges those series data when does compaction job.
+type DeletedSeriesFn func(metricID uint32) *roaring.Bitmap
+
 // init registers metric data merger create function
 func init() {
 	kv.RegisterMerger(MetricDataMerger, NewMerger)
@@ -37,8 +45,9 @@ func init() {
 
 type mergerContext struct {
 	scanners     []*dataScanner
-	seriesIDs    *roaring.Bitmap // target series ids
-	targetFields field.Metas     // target fields
+	seriesIDs    *roaring.Bitmap  // target series ids
+	targetFields field.Metas      // target fields
+	dictionary   field.Dictionary // dictionary of string fields
 
 	targetRange, sourceRange timeutil.SlotRange
 	ratio                    uint16
@@ -50,6 +59,7 @@ type merger struct {
 	dataFlusher  Flusher
 	seriesMerger SeriesMerger
 	rollup       kv.Rollup
+	deleted      DeletedSeriesFn
 }
 
 // NewMerger creates a metric data merger
@@ -70,6 +80,10 @@ func (m *merger) Init(params map[string]interface{}) {
 	if ok {
 		m.rollup = rollupCtx.(kv.Rollup)
 	}
+	deletedCtx, ok := params[DeletedSeriesContext]
+	if ok {
+		m.deleted = deletedCtx.(DeletedSeriesFn)
+	}
 }
 
 // Merge merges the multi metric data into one target metric data for same metric id
@@ -80,8 +94,20 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 	if err != nil {
 		return err
 	}
+	// purge deleted series
+	if m.deleted != nil {
+		deletedSeriesIDs := m.deleted(key)
+		if deletedSeriesIDs != nil && !deletedSeriesIDs.IsEmpty() {
+			mergeCtx.seriesIDs.AndNot(deletedSeriesIDs)
+			if mergeCtx.seriesIDs.IsEmpty() {
+				// all series deleted, drop metric data
+				return nil
+			}
+		}
+	}
 	// 2. Prepare metric
 	m.dataFlusher.PrepareMetric(key, mergeCtx.targetFields)
+	m.dataFlusher.FlushDictionary(mergeCtx.dictionary)
 	// 3. merge series data by roaring container
 	highKeys := mergeCtx.seriesIDs.GetHighKeys()
 	decodeStreams := make([]*encoding.TSDDecoder, blockCount) // make decodeStreams for reuse
@@ -153,21 +179,25 @@ func (m *merger) prepare(metricBlocks [][]byte) (*mergerContext, error) {
 		}
 		// merge target fields under metric level
 		for _, f := range reader.GetFields() {
-			_, ok := ctx.targetFields.GetFromID(f.ID)
-			if !ok {
+			if !containsField(ctx.targetFields, f) {
 				ctx.targetFields = ctx.targetFields.Insert(f)
 			}
 		}
+		// merge dictionary of string fields, codes are stable across files, so data needn't re-encode
+		if dictionary := reader.GetDictionary(); len(dictionary) > 0 {
+			if ctx.dictionary == nil {
+				ctx.dictionary = make(field.Dictionary)
+			}
+			ctx.dictionary.Merge(dictionary)
+		}
 		// create data scanner
 		if ctx.scanners[idx], err = newDataScanner(reader); err != nil {
 			return nil, err
 		}
 	}
-	// sort by field id
-	sort.Slice(ctx.targetFields, func(i, j int) bool { return ctx.targetFields[i].ID < ctx.targetFields[j].ID })
-
 	// check if rollup job
 	if m.rollup != nil {
+		ctx.targetFields = m.addRollupAggregates(ctx.targetFields)
 		// calc target time slot range and interval ratio
 		ctx.targetRange.Start = m.rollup.CalcSlot(m.rollup.GetTimestamp(ctx.sourceRange.Start))
 		ctx.targetRange.End = m.rollup.CalcSlot(m.rollup.GetTimestamp(ctx.sourceRange.End))
@@ -178,5 +208,44 @@ func (m *merger) prepare(metricBlocks [][]byte) (*mergerContext, error) {
 		ctx.targetRange.End = ctx.sourceRange.End
 		ctx.ratio = 1
 	}
+	// sort by field id, rollup aggregates follow the field
+	sort.Slice(ctx.targetFields, func(i, j int) bool {
+		if ctx.targetFields[i].ID == ctx.targetFields[j].ID {
+			return ctx.targetFields[i].AggType < ctx.targetFields[j].AggType
+		}
+		return ctx.targetFields[i].ID < ctx.targetFields[j].ID
+	})
 	return ctx, nil
 }
+
+// addRollupAggregates adds the metas of rollup aggregates for gauge fields,
+// those aggregates are materialized from raw data points when doing rollup.
+func (m *merger) addRollupAggregates(fields field.Metas) field.Metas {
+	aggTypes := field.RollupAggTypes(m.rollup.Aggregates())
+	if len(aggTypes) == 0 {
+		return fields
+	}
+	for _, f := range fields {
+		if f.Type != field.GaugeField || f.AggType > 0 {
+			continue
+		}
+		for _, aggType := range aggTypes {
+			aggField := field.Meta{ID: f.ID, Type: aggType.RollupFieldType(), Name: f.Name, AggType: aggType}
+			// field count of metric level is stored as one byte
+			if len(fields) < math.MaxUint8 && !containsField(fields, aggField) {
+				fields = fields.Insert(aggField)
+			}
+		}
+	}
+	return fields
+}
+
+// containsField checks if the field(or rollup aggregate of field) exists in field list.
+func containsField(fields field.Metas, f field.Meta) bool {
+	for _, fm := range fields {
+		if fm.ID == f.ID && fm.AggType == f.AggType {
+			return true
+		}
+	}
+	return false
+}
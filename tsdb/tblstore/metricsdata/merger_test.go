@@ -145,6 +145,7 @@ func TestMerger_Compact_Merge(t *testing.T) {
 	// case 2: series merge err
 	flusher.EXPECT().PrepareMetric(uint32(1),
 		field.Metas{{ID: 2, Type: field.SumField}, {ID: 10, Type: field.MinField}}).AnyTimes()
+	flusher.EXPECT().FlushDictionary(gomock.Any()).AnyTimes()
 
 	seriesMerger.EXPECT().merge(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(fmt.Errorf("err"))
@@ -191,6 +192,37 @@ func TestMerger_Compact_Merge(t *testing.T) {
 	assert.Nil(t, nopFlusher.Bytes())
 }
 
+func TestMerger_Purge_Deleted_Series(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	flusher := NewMockFlusher(ctrl)
+	seriesMerger := NewMockSeriesMerger(ctrl)
+	merge, _ := NewMerger(kv.NewNopFlusher())
+	m := merge.(*merger)
+	m.dataFlusher = flusher
+	m.seriesMerger = seriesMerger
+	merge.Init(map[string]interface{}{DeletedSeriesContext: DeletedSeriesFn(func(metricID uint32) *roaring.Bitmap {
+		if metricID == 1 {
+			return roaring.BitmapOf(1, 2, 4)
+		}
+		return roaring.BitmapOf(2)
+	})})
+	// case 1: all series deleted
+	err := merge.Merge(1, [][]byte{mockMetricMergeBlock([]uint32{1, 2, 4}, 10, 10)})
+	assert.NoError(t, err)
+	// case 2: purge deleted series
+	flusher.EXPECT().PrepareMetric(uint32(2), gomock.Any())
+	flusher.EXPECT().FlushDictionary(gomock.Any())
+	seriesMerger.EXPECT().merge(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	gomock.InOrder(
+		flusher.EXPECT().FlushSeries(uint32(1)),
+		flusher.EXPECT().FlushSeries(uint32(4)),
+		flusher.EXPECT().CommitMetric(timeutil.SlotRange{Start: 10, End: 10}).Return(nil),
+	)
+	err = merge.Merge(2, [][]byte{mockMetricMergeBlock([]uint32{1, 2, 4}, 10, 10)})
+	assert.NoError(t, err)
+}
+
 func TestMerger_Rollup_Merge(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -207,6 +239,8 @@ func TestMerger_Rollup_Merge(t *testing.T) {
 	// case 1: rollup merge success
 	flusher.EXPECT().PrepareMetric(uint32(1),
 		field.Metas{{ID: 2, Type: field.SumField}, {ID: 10, Type: field.MinField}}).AnyTimes()
+	flusher.EXPECT().FlushDictionary(gomock.Any()).AnyTimes()
+	rollup.EXPECT().Aggregates().Return(nil)
 	rollup.EXPECT().IntervalRatio().Return(uint16(10))
 	rollup.EXPECT().GetTimestamp(uint16(10)).Return(int64(100))
 	rollup.EXPECT().CalcSlot(int64(100)).Return(uint16(0))
@@ -232,6 +266,100 @@ func TestMerger_Rollup_Merge(t *testing.T) {
 	assert.False(t, len(nopFlusher.Bytes()) > 0) // data flush is mock
 }
 
+func TestMerger_Rollup_Aggregates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rollup := kv.NewMockRollup(ctrl)
+	rollup.EXPECT().Aggregates().Return([]string{"max", "count"}).AnyTimes()
+	rollup.EXPECT().IntervalRatio().Return(uint16(3)).AnyTimes()
+	rollup.EXPECT().GetTimestamp(gomock.Any()).DoAndReturn(func(slot uint16) int64 {
+		return int64(slot)
+	}).AnyTimes()
+	rollup.EXPECT().CalcSlot(gomock.Any()).DoAndReturn(func(timestamp int64) uint16 {
+		return uint16(timestamp / 3)
+	}).AnyTimes()
+	rollup.EXPECT().BaseSlot().Return(uint16(0)).AnyTimes()
+
+	// raw data points of gauge field
+	rawFlusher := kv.NewNopFlusher()
+	f, _ := NewFlusher(rawFlusher)
+	f.PrepareMetric(10, field.Metas{{ID: 1, Type: field.GaugeField}})
+	encoder := encoding.NewTSDEncoder(0)
+	for _, value := range []float64{1, 5, 3, 2, 8, 4} {
+		encoder.AppendTime(true)
+		encoder.AppendValue(math.Float64bits(value))
+	}
+	data, _ := encoder.BytesWithoutTime()
+	_ = f.FlushField(data)
+	_ = f.FlushSeries(1)
+	_ = f.CommitMetric(timeutil.SlotRange{Start: 0, End: 5})
+
+	// rollup materializes max/count
+	rollupFlusher := kv.NewNopFlusher()
+	merge, _ := NewMerger(rollupFlusher)
+	merge.Init(map[string]interface{}{kv.RollupContext: rollup})
+	assert.NoError(t, merge.Merge(10, [][]byte{rawFlusher.Bytes()}))
+	r, err := NewReader("test", rollupFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, field.Metas{
+		{ID: 1, Type: field.GaugeField},
+		{ID: 1, Type: field.SumField, AggType: field.Count},
+		{ID: 1, Type: field.MaxField, AggType: field.Max},
+	}, r.GetFields())
+
+	load := func(r MetricReader) (values []float64, aggValues map[field.AggType][]float64) {
+		aggValues = make(map[field.AggType][]float64)
+		read := func(getter encoding.TSDValueGetter) (rs []float64) {
+			for slot := uint16(0); slot <= 1; slot++ {
+				value, _ := getter.GetValue(slot)
+				rs = append(rs, value)
+			}
+			return
+		}
+		ctx := &flow.DataLoadContext{
+			LowSeriesIDsContainer: roaring.BitmapOf(1).GetContainerAtIndex(0),
+			ShardExecuteCtx: &flow.ShardExecuteContext{
+				StorageExecuteCtx: &flow.StorageExecuteContext{
+					Fields: field.Metas{{ID: 1, Type: field.GaugeField}},
+					Query:  &stmt.Query{},
+				},
+			},
+			DownSamplingRollup: func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int,
+				getter encoding.TSDValueGetter, aggTypes []field.AggType, aggGetters []encoding.TSDValueGetter) {
+				values = read(getter)
+				for idx, aggType := range aggTypes {
+					aggValues[aggType] = read(aggGetters[idx])
+				}
+			},
+			Decoder: encoding.GetTSDDecoder(),
+		}
+		loader := r.Load(ctx)
+		ctx.Grouping()
+		loader.Load(ctx)
+		return
+	}
+	values, aggValues := load(r)
+	assert.Equal(t, []float64{3, 4}, values)
+	assert.Equal(t, map[field.AggType][]float64{
+		field.Count: {3, 3},
+		field.Max:   {5, 8},
+	}, aggValues)
+
+	// compaction merges the materialized aggregates
+	compactFlusher := kv.NewNopFlusher()
+	merge, _ = NewMerger(compactFlusher)
+	assert.NoError(t, merge.Merge(10, [][]byte{rollupFlusher.Bytes(), rollupFlusher.Bytes()}))
+	r, err = NewReader("test", compactFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Len(t, r.GetFields(), 3)
+	values, aggValues = load(r)
+	assert.Equal(t, []float64{3, 4}, values)
+	assert.Equal(t, map[field.AggType][]float64{
+		field.Count: {6, 6},
+		field.Max:   {5, 8},
+	}, aggValues)
+}
+
 func mockMetricMergeBlock(seriesIDs []uint32, start, end uint16) []byte {
 	nopKVFlusher := kv.NewNopFlusher()
 	flusher, _ := NewFlusher(nopKVFlusher)
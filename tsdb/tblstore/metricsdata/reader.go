@@ -42,6 +42,9 @@ const (
 		4 // crc32 checksum
 
 	fieldNotFound = -1
+
+	// rollupAggFlag marks the field meta is a rollup aggregate, the low bits of field type are agg type.
+	rollupAggFlag = 0x80
 )
 
 // MetricReader represents the metric block metricReader
@@ -52,6 +55,8 @@ type MetricReader interface {
 	GetSeriesIDs() *roaring.Bitmap
 	// GetFields returns the field metas in this sst file
 	GetFields() field.Metas
+	// GetDictionary returns the dictionary of string fields in this sst file, returns nil if not exist
+	GetDictionary() field.Dictionary
 	// GetTimeRange returns the time range in this sst file
 	GetTimeRange() timeutil.SlotRange
 	// Load loads the data from sst file, then returns the file metric scanner.
@@ -68,10 +73,18 @@ type metricReader struct {
 	highKeyOffsets *encoding.FixedOffsetDecoder
 	seriesIDs      *roaring.Bitmap
 	fields         field.Metas
+	dictionary     field.Dictionary
 	crc32CheckSum  uint32
 	timeRange      timeutil.SlotRange
 
-	readFieldIndexes []int // read field indexes be used when query metric data
+	readFieldIndexes []int               // read field indexes be used when query metric data
+	readRollupAggs   []*rollupAggregates // rollup aggregates of read fields, nil if not materialized
+}
+
+// rollupAggregates represents the rollup aggregates of field which are materialized when rollup.
+type rollupAggregates struct {
+	aggTypes []field.AggType
+	indexes  []int
 }
 
 // NewReader creates a metric block metricReader
@@ -101,6 +114,11 @@ func (r *metricReader) GetFields() field.Metas {
 	return r.fields
 }
 
+// GetDictionary returns the dictionary of string fields in this sst file
+func (r *metricReader) GetDictionary() field.Dictionary {
+	return r.dictionary
+}
+
 // GetTimeRange returns the time range in this sst file
 func (r *metricReader) GetTimeRange() timeutil.SlotRange {
 	return r.timeRange
@@ -109,16 +127,32 @@ func (r *metricReader) GetTimeRange() timeutil.SlotRange {
 // prepare the field aggregator based on query condition.
 func (r *metricReader) prepare(fields field.Metas) (found bool) {
 	fieldMap := make(map[field.ID]int)
+	var aggMap map[field.ID]*rollupAggregates
 	for idx, fieldMeta := range r.fields {
-		fieldMap[fieldMeta.ID] = idx
+		if fieldMeta.AggType == 0 {
+			fieldMap[fieldMeta.ID] = idx
+			continue
+		}
+		if aggMap == nil {
+			aggMap = make(map[field.ID]*rollupAggregates)
+		}
+		aggs, ok := aggMap[fieldMeta.ID]
+		if !ok {
+			aggs = &rollupAggregates{}
+			aggMap[fieldMeta.ID] = aggs
+		}
+		aggs.aggTypes = append(aggs.aggTypes, fieldMeta.AggType)
+		aggs.indexes = append(aggs.indexes, idx)
 	}
 	r.readFieldIndexes = make([]int, len(fields))
+	r.readRollupAggs = make([]*rollupAggregates, len(fields))
 	for idx, f := range fields { // sort by field ids
 		fieldIdx, ok := fieldMap[f.ID]
 		if !ok {
 			r.readFieldIndexes[idx] = fieldNotFound
 		} else {
 			r.readFieldIndexes[idx] = fieldIdx
+			r.readRollupAggs[idx] = aggMap[f.ID]
 			found = true
 		}
 	}
@@ -163,6 +197,7 @@ func (r *metricReader) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 		// field not found
 		return nil
 	}
+	ctx.AddDictionary(r.dictionary)
 	seriesEntriesBlock := level3Block[:lowKeyOffsetsAt]
 	// must use lowContainer from store, because get series index based on container
 	return newMetricLoader(r, seriesEntriesBlock, lowContainer, lowKeyOffsetsDecoder)
@@ -196,6 +231,11 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 		fieldBlock, err := fieldOffsetsDecoder.GetBlock(readIdx, seriesEntryBlock[:fieldOffsetsAt])
 		if err == nil {
 			decoder.ResetWithTimeRange(fieldBlock, r.timeRange.Start, r.timeRange.End)
+			if aggs := r.readRollupAggs[queryIdx]; aggs != nil && ctx.DownSamplingRollup != nil {
+				// read field data with rollup aggregates
+				r.readRollupAggregates(ctx, seriesIdx, queryIdx, aggs, fieldOffsetsDecoder, seriesEntryBlock[:fieldOffsetsAt])
+				continue
+			}
 			// read field data
 			ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, decoder)
 		}
@@ -203,6 +243,36 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 	encoding.ReleaseFixedOffsetDecoder(fieldOffsetsDecoder)
 }
 
+// readRollupAggregates reads the data of rollup aggregates, then does down sampling with field data.
+func (r *metricReader) readRollupAggregates(
+	ctx *flow.DataLoadContext,
+	seriesIdx uint16, queryIdx int,
+	aggs *rollupAggregates,
+	fieldOffsetsDecoder *encoding.FixedOffsetDecoder,
+	fieldDatas []byte,
+) {
+	var aggTypes []field.AggType
+	var aggGetters []encoding.TSDValueGetter
+	var aggDecoders []*encoding.TSDDecoder
+	defer func() {
+		for _, aggDecoder := range aggDecoders {
+			encoding.ReleaseTSDDecoder(aggDecoder)
+		}
+	}()
+	for idx, aggIdx := range aggs.indexes {
+		aggBlock, err := fieldOffsetsDecoder.GetBlock(aggIdx, fieldDatas)
+		if err != nil || len(aggBlock) == 0 {
+			continue
+		}
+		aggDecoder := encoding.GetTSDDecoder()
+		aggDecoder.ResetWithTimeRange(aggBlock, r.timeRange.Start, r.timeRange.End)
+		aggDecoders = append(aggDecoders, aggDecoder)
+		aggGetters = append(aggGetters, aggDecoder)
+		aggTypes = append(aggTypes, aggs.aggTypes[idx])
+	}
+	ctx.DownSamplingRollup(r.timeRange, seriesIdx, queryIdx, ctx.Decoder, aggTypes, aggGetters)
+}
+
 // initReader initializes the metricReader context includes tag value ids/high offsets
 func (r *metricReader) initReader() error {
 	if len(r.metricBlock) <= dataFooterSize {
@@ -232,15 +302,33 @@ func (r *metricReader) initReader() error {
 		if cursor+1 >= seriesIDsStartPos {
 			return fmt.Errorf("corruted field metas, field count: %d", fieldCount)
 		}
-		r.fields[i] = field.Meta{
-			ID:   field.ID(r.metricBlock[cursor]),
-			Type: field.Type(r.metricBlock[cursor+1]),
+		fType := r.metricBlock[cursor+1]
+		if fType&rollupAggFlag != 0 {
+			aggType := field.AggType(fType &^ rollupAggFlag)
+			r.fields[i] = field.Meta{
+				ID:      field.ID(r.metricBlock[cursor]),
+				Type:    aggType.RollupFieldType(),
+				AggType: aggType,
+			}
+		} else {
+			r.fields[i] = field.Meta{
+				ID:   field.ID(r.metricBlock[cursor]),
+				Type: field.Type(fType),
+			}
 		}
 		cursor += 2
 	}
 	if fieldCount == 0 {
 		return fmt.Errorf("field count is zero")
 	}
+	// read dictionary of string fields if exist
+	if cursor < seriesIDsStartPos {
+		dictionary, err := field.UnmarshalDictionary(r.metricBlock[cursor:seriesIDsStartPos])
+		if err != nil {
+			return err
+		}
+		r.dictionary = dictionary
+	}
 	// read series ids
 	seriesIDs := roaring.New()
 	if err := encoding.BitmapUnmarshal(seriesIDs, r.metricBlock[seriesIDsStartPos:]); err != nil {
@@ -254,11 +342,18 @@ func (r *metricReader) initReader() error {
 	return err
 }
 
+// fieldKey represents the key of field data in series entry,
+// the rollup aggregates share the field id with the field.
+type fieldKey struct {
+	id      field.ID
+	aggType field.AggType
+}
+
 // fieldIndexes returns field indexes of metric level
-func (r *metricReader) fieldIndexes() map[field.ID]int {
-	result := make(map[field.ID]int)
+func (r *metricReader) fieldIndexes() map[fieldKey]int {
+	result := make(map[fieldKey]int)
 	for idx, f := range r.fields {
-		result[f.ID] = idx
+		result[fieldKey{id: f.ID, aggType: f.AggType}] = idx
 	}
 	return result
 }
@@ -293,7 +388,7 @@ func newDataScanner(r MetricReader) (*dataScanner, error) {
 }
 
 // fieldIndexes returns field indexes of metric level
-func (s *dataScanner) fieldIndexes() map[field.ID]int {
+func (s *dataScanner) fieldIndexes() map[fieldKey]int {
 	return s.reader.fieldIndexes()
 }
 
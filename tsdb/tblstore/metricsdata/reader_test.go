@@ -191,6 +191,32 @@ func TestReader_scan(t *testing.T) {
 	assert.Empty(t, seriesEntry)
 }
 
+func TestReader_Dictionary(t *testing.T) {
+	// case 1: block without dictionary
+	r, err := NewReader("1.sst", mockMetricBlock())
+	assert.NoError(t, err)
+	assert.Empty(t, r.GetDictionary())
+	// case 2: block with dictionary
+	dict := field.Dictionary{}
+	code, _ := dict.Add("running")
+	nopKVFlusher := kv.NewNopFlusher()
+	flusher, _ := NewFlusher(nopKVFlusher)
+	flusher.PrepareMetric(10, field.Metas{{ID: 2, Type: field.StringField}})
+	flusher.FlushDictionary(dict)
+	encoder := encoding.NewTSDEncoder(5)
+	encoder.AppendTime(bit.One)
+	encoder.AppendValue(math.Float64bits(code))
+	data, _ := encoder.BytesWithoutTime()
+	_ = flusher.FlushField(data)
+	_ = flusher.FlushSeries(10)
+	_ = flusher.CommitMetric(timeutil.SlotRange{Start: 5, End: 5})
+	r, err = NewReader("1.sst", nopKVFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, field.Metas{{ID: 2, Type: field.StringField}}, r.GetFields())
+	assert.Equal(t, dict, r.GetDictionary())
+	assert.EqualValues(t, []uint32{10}, r.GetSeriesIDs().ToArray())
+}
+
 func mockMetricBlock() []byte {
 	nopKVFlusher := kv.NewNopFlusher()
 	flusher, _ := NewFlusher(nopKVFlusher)
@@ -51,17 +51,31 @@ func (sm *seriesMerger) merge(
 	streams []*encoding.TSDDecoder,
 	fieldReaders []FieldReader,
 ) error {
+	var pointValues []bool
 	for idx, f := range mergeCtx.targetFields {
 		fieldID := f.ID
 		encodeStream := sm.flusher.GetEncoder(idx)
 		encodeStream.RestWithStartTime(mergeCtx.targetRange.Start)
 
+		if f.AggType > 0 && pointValues == nil {
+			pointValues = make([]bool, len(fieldReaders))
+		}
 		for idx, reader := range fieldReaders {
 			if reader == nil {
 				// if series id not exist, metricReader is nil
 				continue
 			}
-			fieldData := reader.GetFieldData(fieldID)
+			var fieldData []byte
+			if f.AggType > 0 {
+				// rollup aggregate, if not materialized in source block(raw data), calc it by field data
+				fieldData = reader.GetAggregateData(fieldID, f.AggType)
+				pointValues[idx] = len(fieldData) == 0
+				if pointValues[idx] {
+					fieldData = reader.GetFieldData(fieldID)
+				}
+			} else {
+				fieldData = reader.GetFieldData(fieldID)
+			}
 			if len(fieldData) > 0 {
 				if streams[idx] == nil {
 					// new tsd decoder
@@ -75,11 +89,19 @@ func (sm *seriesMerger) merge(
 		// merges field data from source time range => target time range,
 		// compact merge: source range = target range and ratio = 1
 		// rollup merge: source range[5,182]=>target range[0,6], ratio:30, source interval:10s, target interval:5min
-		aggregation.DownSamplingMultiSeriesInto(
-			mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
-			f.Type, streams,
-			encodeStream.EmitDownSamplingValue,
-		)
+		if f.AggType > 0 {
+			aggregation.DownSamplingAggregateInto(
+				mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
+				f.AggType, streams, pointValues,
+				encodeStream.EmitDownSamplingValue,
+			)
+		} else {
+			aggregation.DownSamplingMultiSeriesInto(
+				mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
+				f.Type, streams,
+				encodeStream.EmitDownSamplingValue,
+			)
+		}
 
 		data, err := encodeStream.BytesWithoutTime()
 		if err != nil {
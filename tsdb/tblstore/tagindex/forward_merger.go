@@ -25,6 +25,13 @@ import (
 
 var SeriesForwardMerger kv.MergerType = "SeriesForwardMerger"
 
+// DroppedTagKeyContext represents the context key of dropped tag key function.
+const DroppedTagKeyContext = "DroppedTagKeyContext"
+
+// DroppedTagKeyFn checks if the tag key is dropped,
+// merger purges the index data of dropped tag key when does compaction job.
+type DroppedTagKeyFn func(tagKeyID uint32) bool
+
 // init registers series forward merger create function
 func init() {
 	kv.RegisterMerger(SeriesForwardMerger, NewForwardMerger)
@@ -34,9 +41,12 @@ func init() {
 type forwardMerger struct {
 	forwardFlusher ForwardFlusher
 	kvFlusher      kv.Flusher
+	dropped        DroppedTagKeyFn
 }
 
-func (m *forwardMerger) Init(_ map[string]interface{}) {}
+func (m *forwardMerger) Init(params map[string]interface{}) {
+	m.dropped = getDroppedTagKeyFn(params)
+}
 
 // NewForwardMerger creates a forward merger
 func NewForwardMerger(flusher kv.Flusher) (kv.Merger, error) {
@@ -52,6 +62,10 @@ func NewForwardMerger(flusher kv.Flusher) (kv.Merger, error) {
 
 // Merge merges the multi forward index data into a forward index for same tag key id
 func (m *forwardMerger) Merge(key uint32, values [][]byte) error {
+	if m.dropped != nil && m.dropped(key) {
+		// tag key dropped, purge forward index data
+		return nil
+	}
 	var scanners []*tagForwardScanner
 	seriesIDs := roaring.New() // target merged series ids
 	// 1. prepare tag forward scanner
@@ -87,3 +101,12 @@ func (m *forwardMerger) Merge(key uint32, values [][]byte) error {
 	// flush all series ids under this tag key
 	return m.forwardFlusher.CommitTagKey(seriesIDs)
 }
+
+// getDroppedTagKeyFn returns the dropped tag key function from merge context if exist.
+func getDroppedTagKeyFn(params map[string]interface{}) DroppedTagKeyFn {
+	droppedCtx, ok := params[DroppedTagKeyContext]
+	if ok {
+		return droppedCtx.(DroppedTagKeyFn)
+	}
+	return nil
+}
@@ -64,6 +64,20 @@ func TestForwardMerger_Merge(t *testing.T) {
 	assert.Nil(t, nopFlusher2.Bytes())
 }
 
+func TestForwardMerger_Purge_Dropped_TagKey(t *testing.T) {
+	nopFlusher := kv.NewNopFlusher()
+	merge, _ := NewForwardMerger(nopFlusher)
+	merge.Init(map[string]interface{}{
+		DroppedTagKeyContext: DroppedTagKeyFn(func(tagKeyID uint32) bool {
+			return tagKeyID == 1
+		}),
+	})
+	assert.NoError(t, merge.Merge(1, mockMergeForwardBlock()))
+	assert.Nil(t, nopFlusher.Bytes())
+	assert.NoError(t, merge.Merge(2, mockMergeForwardBlock()))
+	assert.NotNil(t, nopFlusher.Bytes())
+}
+
 func mockMergeForwardBlock() (block [][]byte) {
 	nopKVFlusher1 := kv.NewNopFlusher()
 	forwardFlusher, _ := NewForwardFlusher(nopKVFlusher1)
@@ -35,6 +35,7 @@ func init() {
 type invertedMerger struct {
 	invertedFlusher InvertedFlusher
 	kvFlusher       kv.Flusher
+	dropped         DroppedTagKeyFn
 }
 
 // NewInvertedMerger creates a inverted merger
@@ -49,10 +50,16 @@ func NewInvertedMerger(flusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-func (m *invertedMerger) Init(_ map[string]interface{}) {}
+func (m *invertedMerger) Init(params map[string]interface{}) {
+	m.dropped = getDroppedTagKeyFn(params)
+}
 
 // Merge merges the multi inverted index data into a inverted index for same tag key id
 func (m *invertedMerger) Merge(key uint32, values [][]byte) error {
+	if m.dropped != nil && m.dropped(key) {
+		// tag key dropped, purge inverted index data
+		return nil
+	}
 	var scanners []*tagInvertedScanner
 	targetTagValueIDs := roaring.New() // target merged tag value ids
 	// 1. prepare tag inverted scanner
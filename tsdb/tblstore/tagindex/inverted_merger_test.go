@@ -85,6 +85,21 @@ func TestInvertedMerger_Merge(t *testing.T) {
 	assert.Len(t, nopFlusher.Bytes(), 0)
 }
 
+func TestInvertedMerger_Purge_Dropped_TagKey(t *testing.T) {
+	encoding.BitmapUnmarshal = bitmapUnmarshal
+	nopFlusher := kv.NewNopFlusher()
+	merge, _ := NewInvertedMerger(nopFlusher)
+	merge.Init(map[string]interface{}{
+		DroppedTagKeyContext: DroppedTagKeyFn(func(tagKeyID uint32) bool {
+			return tagKeyID == 1
+		}),
+	})
+	assert.NoError(t, merge.Merge(1, mockInvertedMergeData()))
+	assert.Nil(t, nopFlusher.Bytes())
+	assert.NoError(t, merge.Merge(2, mockInvertedMergeData()))
+	assert.NotNil(t, nopFlusher.Bytes())
+}
+
 func mockInvertedMergeData() (data [][]byte) {
 	nopKVFlusher := kv.NewNopFlusher()
 	seriesFlusher, _ := NewInvertedFlusher(nopKVFlusher)